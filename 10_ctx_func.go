@@ -1,3 +1,5 @@
+// Code generated by powerfunc/generator from ctx_func.go.tmpl. DO NOT EDIT.
+
 package powerfunc
 
 import (
@@ -13,10 +15,15 @@ func (f CtxFunc10[P0, P1, P2, P3, P4, P5, P6, P7, P8, P9]) Exec(ctx context.Cont
 }
 
 func (f CtxFunc10[P0, P1, P2, P3, P4, P5, P6, P7, P8, P9]) Timing(loggers ...func(d time.Duration)) CtxFunc10[P0, P1, P2, P3, P4, P5, P6, P7, P8, P9] {
+	return f.TimingWithClock(RealClock(), loggers...)
+}
+
+// TimingWithClock is like Timing, but measures the execution time with clock.
+func (f CtxFunc10[P0, P1, P2, P3, P4, P5, P6, P7, P8, P9]) TimingWithClock(clock Clock, loggers ...func(d time.Duration)) CtxFunc10[P0, P1, P2, P3, P4, P5, P6, P7, P8, P9] {
 	return func(ctx context.Context, p0 P0, p1 P1, p2 P2, p3 P3, p4 P4, p5 P5, p6 P6, p7 P7, p8 P8, p9 P9) {
-		start := time.Now()
+		start := clock.Now()
 		defer func() {
-			dur := time.Since(start)
+			dur := clock.Since(start)
 			for _, logger := range loggers {
 				logger(dur)
 			}
@@ -60,73 +67,185 @@ func (f CtxFunc10[P0, P1, P2, P3, P4, P5, P6, P7, P8, P9]) WithCancel() CtxFunc1
 	}
 }
 
+// WithValue returns a CtxFunc10 calling the CtxFunc10 with a context
+// carrying val for key, see context.WithValue.
+func (f CtxFunc10[P0, P1, P2, P3, P4, P5, P6, P7, P8, P9]) WithValue(key, val any) CtxFunc10[P0, P1, P2, P3, P4, P5, P6, P7, P8, P9] {
+	return func(ctx context.Context, p0 P0, p1 P1, p2 P2, p3 P3, p4 P4, p5 P5, p6 P6, p7 P7, p8 P8, p9 P9) {
+		ctx = context.WithValue(ctx, key, val)
+		f(ctx, p0, p1, p2, p3, p4, p5, p6, p7, p8, p9)
+	}
+}
+
+// WithValueFrom returns a CtxFunc10 calling the CtxFunc10 with a context
+// carrying the value returned by value for its key. value gets the arguments
+// of every call.
+func (f CtxFunc10[P0, P1, P2, P3, P4, P5, P6, P7, P8, P9]) WithValueFrom(value func(ctx context.Context, p0 P0, p1 P1, p2 P2, p3 P3, p4 P4, p5 P5, p6 P6, p7 P7, p8 P8, p9 P9) (key, val any)) CtxFunc10[P0, P1, P2, P3, P4, P5, P6, P7, P8, P9] {
+	return func(ctx context.Context, p0 P0, p1 P1, p2 P2, p3 P3, p4 P4, p5 P5, p6 P6, p7 P7, p8 P8, p9 P9) {
+		key, val := value(ctx, p0, p1, p2, p3, p4, p5, p6, p7, p8, p9)
+		ctx = context.WithValue(ctx, key, val)
+		f(ctx, p0, p1, p2, p3, p4, p5, p6, p7, p8, p9)
+	}
+}
+
+// Detached returns a CtxFunc10 calling the CtxFunc10 with a context that is
+// never cancelled but keeps the values of the caller's context, see
+// context.WithoutCancel. Useful for cleanups that must run to completion.
+func (f CtxFunc10[P0, P1, P2, P3, P4, P5, P6, P7, P8, P9]) Detached() CtxFunc10[P0, P1, P2, P3, P4, P5, P6, P7, P8, P9] {
+	return func(ctx context.Context, p0 P0, p1 P1, p2 P2, p3 P3, p4 P4, p5 P5, p6 P6, p7 P7, p8 P8, p9 P9) {
+		ctx = context.WithoutCancel(ctx)
+		f(ctx, p0, p1, p2, p3, p4, p5, p6, p7, p8, p9)
+	}
+}
+
+// WithTimeoutCause is like WithTimeout, but the context is cancelled with
+// cause when the timeout expires, see context.WithTimeoutCause.
+func (f CtxFunc10[P0, P1, P2, P3, P4, P5, P6, P7, P8, P9]) WithTimeoutCause(timeout time.Duration, cause error) CtxFunc10[P0, P1, P2, P3, P4, P5, P6, P7, P8, P9] {
+	return func(ctx context.Context, p0 P0, p1 P1, p2 P2, p3 P3, p4 P4, p5 P5, p6 P6, p7 P7, p8 P8, p9 P9) {
+		ctx, cancel := context.WithTimeoutCause(ctx, timeout, cause)
+		defer cancel()
+		f(ctx, p0, p1, p2, p3, p4, p5, p6, p7, p8, p9)
+	}
+}
+
+// WithDeadlineCause is like WithDeadline, but the context is cancelled with
+// cause when the deadline expires, see context.WithDeadlineCause.
+func (f CtxFunc10[P0, P1, P2, P3, P4, P5, P6, P7, P8, P9]) WithDeadlineCause(deadline time.Time, cause error) CtxFunc10[P0, P1, P2, P3, P4, P5, P6, P7, P8, P9] {
+	return func(ctx context.Context, p0 P0, p1 P1, p2 P2, p3 P3, p4 P4, p5 P5, p6 P6, p7 P7, p8 P8, p9 P9) {
+		ctx, cancel := context.WithDeadlineCause(ctx, deadline, cause)
+		defer cancel()
+		f(ctx, p0, p1, p2, p3, p4, p5, p6, p7, p8, p9)
+	}
+}
+
+// Debounce returns a CtxFunc10 that delays its execution until wait has elapsed
+// since the last call, along with the Debouncer controlling it.
+// Only the most recent call, and its context, is kept and eventually invoked.
+func (f CtxFunc10[P0, P1, P2, P3, P4, P5, P6, P7, P8, P9]) Debounce(wait time.Duration, opts ...DebounceOption) (CtxFunc10[P0, P1, P2, P3, P4, P5, P6, P7, P8, P9], *Debouncer) {
+	d := newDebouncer(wait, opts...)
+	return func(ctx context.Context, p0 P0, p1 P1, p2 P2, p3 P3, p4 P4, p5 P5, p6 P6, p7 P7, p8 P8, p9 P9) {
+		d.call(func() {
+			f(ctx, p0, p1, p2, p3, p4, p5, p6, p7, p8, p9)
+		})
+	}, d
+}
+
+// Throttle returns a CtxFunc10 that executes at most once per interval, along
+// with the Debouncer controlling it.
+// The first call is invoked immediately, and the most recent call made
+// during the interval is invoked at the end of it.
+// The options, e.g. DebounceClock, apply to the underlying Debouncer.
+func (f CtxFunc10[P0, P1, P2, P3, P4, P5, P6, P7, P8, P9]) Throttle(interval time.Duration, opts ...DebounceOption) (CtxFunc10[P0, P1, P2, P3, P4, P5, P6, P7, P8, P9], *Debouncer) {
+	d := newThrottler(interval, opts...)
+	return func(ctx context.Context, p0 P0, p1 P1, p2 P2, p3 P3, p4 P4, p5 P5, p6 P6, p7 P7, p8 P8, p9 P9) {
+		d.call(func() {
+			f(ctx, p0, p1, p2, p3, p4, p5, p6, p7, p8, p9)
+		})
+	}, d
+}
+
+// Once returns a CtxFunc10 that only executes the first time it is called,
+// along with the Once controlling it.
+// The shared execution does not inherit the cancellation of the caller's
+// context: a caller whose context is done returns without waiting for it,
+// while the execution carries on for the other callers.
+func (f CtxFunc10[P0, P1, P2, P3, P4, P5, P6, P7, P8, P9]) Once(opts ...OnceOption) (CtxFunc10[P0, P1, P2, P3, P4, P5, P6, P7, P8, P9], *Once) {
+	o := newOnce(opts...)
+	return func(ctx context.Context, p0 P0, p1 P1, p2 P2, p3 P3, p4 P4, p5 P5, p6 P6, p7 P7, p8 P8, p9 P9) {
+		_, _ = o.do(ctx, true, func() (any, error) {
+			ctx := context.WithoutCancel(ctx)
+			f(ctx, p0, p1, p2, p3, p4, p5, p6, p7, p8, p9)
+			return nil, nil
+		})
+	}, o
+}
+
+// Lazy returns a CtxFunc10 that executes the first time it is called,
+// along with the Once controlling it.
+// A CtxFunc10 cannot fail, so Lazy behaves like Once unless it panics, in which
+// case the next call executes it again.
+func (f CtxFunc10[P0, P1, P2, P3, P4, P5, P6, P7, P8, P9]) Lazy(opts ...OnceOption) (CtxFunc10[P0, P1, P2, P3, P4, P5, P6, P7, P8, P9], *Once) {
+	o := newLazy(opts...)
+	return func(ctx context.Context, p0 P0, p1 P1, p2 P2, p3 P3, p4 P4, p5 P5, p6 P6, p7 P7, p8 P8, p9 P9) {
+		_, _ = o.do(ctx, true, func() (any, error) {
+			ctx := context.WithoutCancel(ctx)
+			f(ctx, p0, p1, p2, p3, p4, p5, p6, p7, p8, p9)
+			return nil, nil
+		})
+	}, o
+}
+
+// Every returns a runner that calls the CtxFunc10 every interval until its
+// context is done. See CtxFunc10Error.Every for the available options.
+func (f CtxFunc10[P0, P1, P2, P3, P4, P5, P6, P7, P8, P9]) Every(interval time.Duration, opts ...EveryOption) CtxFunc10Error[P0, P1, P2, P3, P4, P5, P6, P7, P8, P9] {
+	return f.Fallible().Every(interval, opts...)
+}
 
+// Curry10 returns a CtxFunc with the first 10 arguments of the CtxFunc10 bound.
 func (f CtxFunc10[P0, P1, P2, P3, P4, P5, P6, P7, P8, P9]) Curry10(p0 P0, p1 P1, p2 P2, p3 P3, p4 P4, p5 P5, p6 P6, p7 P7, p8 P8, p9 P9) CtxFunc {
-	return func(ctx context.Context)  {
+	return func(ctx context.Context) {
 		f(ctx, p0, p1, p2, p3, p4, p5, p6, p7, p8, p9)
 	}
 }
-	
 
+// Curry9 returns a CtxFunc1[P9] with the first 9 arguments of the CtxFunc10 bound.
 func (f CtxFunc10[P0, P1, P2, P3, P4, P5, P6, P7, P8, P9]) Curry9(p0 P0, p1 P1, p2 P2, p3 P3, p4 P4, p5 P5, p6 P6, p7 P7, p8 P8) CtxFunc1[P9] {
-	return func(ctx context.Context, p9 P9)  {
+	return func(ctx context.Context, p9 P9) {
 		f(ctx, p0, p1, p2, p3, p4, p5, p6, p7, p8, p9)
 	}
 }
-	
 
+// Curry8 returns a CtxFunc2[P8, P9] with the first 8 arguments of the CtxFunc10 bound.
 func (f CtxFunc10[P0, P1, P2, P3, P4, P5, P6, P7, P8, P9]) Curry8(p0 P0, p1 P1, p2 P2, p3 P3, p4 P4, p5 P5, p6 P6, p7 P7) CtxFunc2[P8, P9] {
-	return func(ctx context.Context, p8 P8, p9 P9)  {
+	return func(ctx context.Context, p8 P8, p9 P9) {
 		f(ctx, p0, p1, p2, p3, p4, p5, p6, p7, p8, p9)
 	}
 }
-	
 
+// Curry7 returns a CtxFunc3[P7, P8, P9] with the first 7 arguments of the CtxFunc10 bound.
 func (f CtxFunc10[P0, P1, P2, P3, P4, P5, P6, P7, P8, P9]) Curry7(p0 P0, p1 P1, p2 P2, p3 P3, p4 P4, p5 P5, p6 P6) CtxFunc3[P7, P8, P9] {
-	return func(ctx context.Context, p7 P7, p8 P8, p9 P9)  {
+	return func(ctx context.Context, p7 P7, p8 P8, p9 P9) {
 		f(ctx, p0, p1, p2, p3, p4, p5, p6, p7, p8, p9)
 	}
 }
-	
 
+// Curry6 returns a CtxFunc4[P6, P7, P8, P9] with the first 6 arguments of the CtxFunc10 bound.
 func (f CtxFunc10[P0, P1, P2, P3, P4, P5, P6, P7, P8, P9]) Curry6(p0 P0, p1 P1, p2 P2, p3 P3, p4 P4, p5 P5) CtxFunc4[P6, P7, P8, P9] {
-	return func(ctx context.Context, p6 P6, p7 P7, p8 P8, p9 P9)  {
+	return func(ctx context.Context, p6 P6, p7 P7, p8 P8, p9 P9) {
 		f(ctx, p0, p1, p2, p3, p4, p5, p6, p7, p8, p9)
 	}
 }
-	
 
+// Curry5 returns a CtxFunc5[P5, P6, P7, P8, P9] with the first 5 arguments of the CtxFunc10 bound.
 func (f CtxFunc10[P0, P1, P2, P3, P4, P5, P6, P7, P8, P9]) Curry5(p0 P0, p1 P1, p2 P2, p3 P3, p4 P4) CtxFunc5[P5, P6, P7, P8, P9] {
-	return func(ctx context.Context, p5 P5, p6 P6, p7 P7, p8 P8, p9 P9)  {
+	return func(ctx context.Context, p5 P5, p6 P6, p7 P7, p8 P8, p9 P9) {
 		f(ctx, p0, p1, p2, p3, p4, p5, p6, p7, p8, p9)
 	}
 }
-	
 
+// Curry4 returns a CtxFunc6[P4, P5, P6, P7, P8, P9] with the first 4 arguments of the CtxFunc10 bound.
 func (f CtxFunc10[P0, P1, P2, P3, P4, P5, P6, P7, P8, P9]) Curry4(p0 P0, p1 P1, p2 P2, p3 P3) CtxFunc6[P4, P5, P6, P7, P8, P9] {
-	return func(ctx context.Context, p4 P4, p5 P5, p6 P6, p7 P7, p8 P8, p9 P9)  {
+	return func(ctx context.Context, p4 P4, p5 P5, p6 P6, p7 P7, p8 P8, p9 P9) {
 		f(ctx, p0, p1, p2, p3, p4, p5, p6, p7, p8, p9)
 	}
 }
-	
 
+// Curry3 returns a CtxFunc7[P3, P4, P5, P6, P7, P8, P9] with the first 3 arguments of the CtxFunc10 bound.
 func (f CtxFunc10[P0, P1, P2, P3, P4, P5, P6, P7, P8, P9]) Curry3(p0 P0, p1 P1, p2 P2) CtxFunc7[P3, P4, P5, P6, P7, P8, P9] {
-	return func(ctx context.Context, p3 P3, p4 P4, p5 P5, p6 P6, p7 P7, p8 P8, p9 P9)  {
+	return func(ctx context.Context, p3 P3, p4 P4, p5 P5, p6 P6, p7 P7, p8 P8, p9 P9) {
 		f(ctx, p0, p1, p2, p3, p4, p5, p6, p7, p8, p9)
 	}
 }
-	
 
+// Curry2 returns a CtxFunc8[P2, P3, P4, P5, P6, P7, P8, P9] with the first 2 arguments of the CtxFunc10 bound.
 func (f CtxFunc10[P0, P1, P2, P3, P4, P5, P6, P7, P8, P9]) Curry2(p0 P0, p1 P1) CtxFunc8[P2, P3, P4, P5, P6, P7, P8, P9] {
-	return func(ctx context.Context, p2 P2, p3 P3, p4 P4, p5 P5, p6 P6, p7 P7, p8 P8, p9 P9)  {
+	return func(ctx context.Context, p2 P2, p3 P3, p4 P4, p5 P5, p6 P6, p7 P7, p8 P8, p9 P9) {
 		f(ctx, p0, p1, p2, p3, p4, p5, p6, p7, p8, p9)
 	}
 }
-	
 
+// Curry1 returns a CtxFunc9[P1, P2, P3, P4, P5, P6, P7, P8, P9] with the first argument of the CtxFunc10 bound.
 func (f CtxFunc10[P0, P1, P2, P3, P4, P5, P6, P7, P8, P9]) Curry1(p0 P0) CtxFunc9[P1, P2, P3, P4, P5, P6, P7, P8, P9] {
-	return func(ctx context.Context, p1 P1, p2 P2, p3 P3, p4 P4, p5 P5, p6 P6, p7 P7, p8 P8, p9 P9)  {
+	return func(ctx context.Context, p1 P1, p2 P2, p3 P3, p4 P4, p5 P5, p6 P6, p7 P7, p8 P8, p9 P9) {
 		f(ctx, p0, p1, p2, p3, p4, p5, p6, p7, p8, p9)
 	}
 }
-	
\ No newline at end of file
@@ -1,3 +1,5 @@
+// Code generated by powerfunc/generator from ctx_func_error.go.tmpl. DO NOT EDIT.
+
 package powerfunc
 
 import (
@@ -13,10 +15,15 @@ func (f CtxFunc10Error[P0, P1, P2, P3, P4, P5, P6, P7, P8, P9]) Exec(ctx context
 }
 
 func (f CtxFunc10Error[P0, P1, P2, P3, P4, P5, P6, P7, P8, P9]) Timing(loggers ...func(d time.Duration)) CtxFunc10Error[P0, P1, P2, P3, P4, P5, P6, P7, P8, P9] {
+	return f.TimingWithClock(RealClock(), loggers...)
+}
+
+// TimingWithClock is like Timing, but measures the execution time with clock.
+func (f CtxFunc10Error[P0, P1, P2, P3, P4, P5, P6, P7, P8, P9]) TimingWithClock(clock Clock, loggers ...func(d time.Duration)) CtxFunc10Error[P0, P1, P2, P3, P4, P5, P6, P7, P8, P9] {
 	return func(ctx context.Context, p0 P0, p1 P1, p2 P2, p3 P3, p4 P4, p5 P5, p6 P6, p7 P7, p8 P8, p9 P9) error {
-		start := time.Now()
+		start := clock.Now()
 		defer func() {
-			dur := time.Since(start)
+			dur := clock.Since(start)
 			for _, logger := range loggers {
 				logger(dur)
 			}
@@ -53,24 +60,98 @@ func (f CtxFunc10Error[P0, P1, P2, P3, P4, P5, P6, P7, P8, P9]) WithCancel() Ctx
 	}
 }
 
-// Retry returns a Function that will retry the Function until it returns
-// a nil error or the tryAgain function returns false.
-func (f CtxFunc10Error[P0, P1, P2, P3, P4, P5, P6, P7, P8, P9]) Retry(tryAgain func(attempts int, err error) bool) CtxFunc10Error[P0, P1, P2, P3, P4, P5, P6, P7, P8, P9] {
+// WithValue returns a CtxFunc10Error calling the CtxFunc10Error with a context
+// carrying val for key, see context.WithValue.
+func (f CtxFunc10Error[P0, P1, P2, P3, P4, P5, P6, P7, P8, P9]) WithValue(key, val any) CtxFunc10Error[P0, P1, P2, P3, P4, P5, P6, P7, P8, P9] {
 	return func(ctx context.Context, p0 P0, p1 P1, p2 P2, p3 P3, p4 P4, p5 P5, p6 P6, p7 P7, p8 P8, p9 P9) error {
-		var err error
-		attempts := 1
-		for {
-			err = f(ctx, p0, p1, p2, p3, p4, p5, p6, p7, p8, p9)
-			if err != nil && tryAgain(attempts, err) {
-				break
-			}
-			attempts++
+		ctx = context.WithValue(ctx, key, val)
+		return f(ctx, p0, p1, p2, p3, p4, p5, p6, p7, p8, p9)
+	}
+}
+
+// WithValueFrom returns a CtxFunc10Error calling the CtxFunc10Error with a context
+// carrying the value returned by value for its key. value gets the arguments
+// of every call.
+func (f CtxFunc10Error[P0, P1, P2, P3, P4, P5, P6, P7, P8, P9]) WithValueFrom(value func(ctx context.Context, p0 P0, p1 P1, p2 P2, p3 P3, p4 P4, p5 P5, p6 P6, p7 P7, p8 P8, p9 P9) (key, val any)) CtxFunc10Error[P0, P1, P2, P3, P4, P5, P6, P7, P8, P9] {
+	return func(ctx context.Context, p0 P0, p1 P1, p2 P2, p3 P3, p4 P4, p5 P5, p6 P6, p7 P7, p8 P8, p9 P9) error {
+		key, val := value(ctx, p0, p1, p2, p3, p4, p5, p6, p7, p8, p9)
+		ctx = context.WithValue(ctx, key, val)
+		return f(ctx, p0, p1, p2, p3, p4, p5, p6, p7, p8, p9)
+	}
+}
+
+// Detached returns a CtxFunc10Error calling the CtxFunc10Error with a context that is
+// never cancelled but keeps the values of the caller's context, see
+// context.WithoutCancel. Useful for cleanups that must run to completion.
+func (f CtxFunc10Error[P0, P1, P2, P3, P4, P5, P6, P7, P8, P9]) Detached() CtxFunc10Error[P0, P1, P2, P3, P4, P5, P6, P7, P8, P9] {
+	return func(ctx context.Context, p0 P0, p1 P1, p2 P2, p3 P3, p4 P4, p5 P5, p6 P6, p7 P7, p8 P8, p9 P9) error {
+		ctx = context.WithoutCancel(ctx)
+		return f(ctx, p0, p1, p2, p3, p4, p5, p6, p7, p8, p9)
+	}
+}
+
+// WithTimeoutCause is like WithTimeout, but the context is cancelled with
+// cause when the timeout expires, see context.WithTimeoutCause.
+// When the CtxFunc10Error then fails with the error of the context, the returned
+// error wraps cause as well, to tell which timeout expired.
+func (f CtxFunc10Error[P0, P1, P2, P3, P4, P5, P6, P7, P8, P9]) WithTimeoutCause(timeout time.Duration, cause error) CtxFunc10Error[P0, P1, P2, P3, P4, P5, P6, P7, P8, P9] {
+	return func(ctx context.Context, p0 P0, p1 P1, p2 P2, p3 P3, p4 P4, p5 P5, p6 P6, p7 P7, p8 P8, p9 P9) error {
+		ctx, cancel := context.WithTimeoutCause(ctx, timeout, cause)
+		defer cancel()
+		err := f(ctx, p0, p1, p2, p3, p4, p5, p6, p7, p8, p9)
+		return withCause(ctx, err)
+	}
+}
+
+// WithDeadlineCause is like WithDeadline, but the context is cancelled with
+// cause when the deadline expires, see context.WithDeadlineCause.
+// When the CtxFunc10Error then fails with the error of the context, the returned
+// error wraps cause as well, to tell which deadline expired.
+func (f CtxFunc10Error[P0, P1, P2, P3, P4, P5, P6, P7, P8, P9]) WithDeadlineCause(deadline time.Time, cause error) CtxFunc10Error[P0, P1, P2, P3, P4, P5, P6, P7, P8, P9] {
+	return func(ctx context.Context, p0 P0, p1 P1, p2 P2, p3 P3, p4 P4, p5 P5, p6 P6, p7 P7, p8 P8, p9 P9) error {
+		ctx, cancel := context.WithDeadlineCause(ctx, deadline, cause)
+		defer cancel()
+		err := f(ctx, p0, p1, p2, p3, p4, p5, p6, p7, p8, p9)
+		return withCause(ctx, err)
+	}
+}
+
+// WithBudget returns a CtxFunc10Error giving the CtxFunc10Error a fraction of the
+// remaining deadline of the caller, capped at max when max is positive,
+// instead of a fixed timeout. Without a deadline, the CtxFunc10Error gets max, or
+// no timeout if max is not positive.
+// When the share is below min, the CtxFunc10Error is not called and a
+// *BudgetError, matching ErrBudgetExhausted, is returned.
+func (f CtxFunc10Error[P0, P1, P2, P3, P4, P5, P6, P7, P8, P9]) WithBudget(fraction float64, min, max time.Duration) CtxFunc10Error[P0, P1, P2, P3, P4, P5, P6, P7, P8, P9] {
+	return func(ctx context.Context, p0 P0, p1 P1, p2 P2, p3 P3, p4 P4, p5 P5, p6 P6, p7 P7, p8 P8, p9 P9) error {
+		timeout, bounded, err := budget(ctx, fraction, min, max)
+		if err != nil {
+			return err
 		}
-		return err
+		if bounded {
+			var cancel context.CancelFunc
+			ctx, cancel = context.WithTimeout(ctx, timeout)
+			defer cancel()
+		}
+		return f(ctx, p0, p1, p2, p3, p4, p5, p6, p7, p8, p9)
 	}
 }
 
-// Must returns a Func10Value that will panic if the CtxFunc10Result returns an error.
+// Retry returns a CtxFunc10Error that will retry the CtxFunc10Error until it returns
+// a nil error or the tryAgain function returns false, in which case it
+// returns a *RetryError holding the errors of all the attempts.
+// The options set the backoff between attempts and hooks, e.g. OnRetry.
+// RetryAttemptTimeout gives every attempt its own deadline within the one of
+// the caller.
+func (f CtxFunc10Error[P0, P1, P2, P3, P4, P5, P6, P7, P8, P9]) Retry(tryAgain func(attempts int, err error) bool, opts ...RetryOption) CtxFunc10Error[P0, P1, P2, P3, P4, P5, P6, P7, P8, P9] {
+	return func(ctx context.Context, p0 P0, p1 P1, p2 P2, p3 P3, p4 P4, p5 P5, p6 P6, p7 P7, p8 P8, p9 P9) error {
+		return retry(ctx, tryAgain, opts, func(ctx context.Context) error {
+			return f(ctx, p0, p1, p2, p3, p4, p5, p6, p7, p8, p9)
+		})
+	}
+}
+
+// Must returns a CtxFunc10 that will panic if the CtxFunc10Error returns an error.
 func (f CtxFunc10Error[P0, P1, P2, P3, P4, P5, P6, P7, P8, P9]) Must() CtxFunc10[P0, P1, P2, P3, P4, P5, P6, P7, P8, P9] {
 	return func(ctx context.Context, p0 P0, p1 P1, p2 P2, p3 P3, p4 P4, p5 P5, p6 P6, p7 P7, p8 P8, p9 P9) {
 		err := f(ctx, p0, p1, p2, p3, p4, p5, p6, p7, p8, p9)
@@ -80,7 +161,7 @@ func (f CtxFunc10Error[P0, P1, P2, P3, P4, P5, P6, P7, P8, P9]) Must() CtxFunc10
 	}
 }
 
-// OnErr returns a CtxFunc10Result that will wrap the error returned by the CtxFunc10Result
+// OnErr returns a CtxFunc10Error that will wrap the error returned by the CtxFunc10Error
 // with the provided message.
 func (f CtxFunc10Error[P0, P1, P2, P3, P4, P5, P6, P7, P8, P9]) OnErr(msg string) CtxFunc10Error[P0, P1, P2, P3, P4, P5, P6, P7, P8, P9] {
 	return func(ctx context.Context, p0 P0, p1 P1, p2 P2, p3 P3, p4 P4, p5 P5, p6 P6, p7 P7, p8 P8, p9 P9) error {
@@ -92,73 +173,130 @@ func (f CtxFunc10Error[P0, P1, P2, P3, P4, P5, P6, P7, P8, P9]) OnErr(msg string
 	}
 }
 
+// Once returns a CtxFunc10Error that only executes the first time it is called
+// and returns the cached error afterwards, along with the Once controlling it.
+// Use OnceRetryOnError or OnceBackoff to only cache a success.
+// The shared execution does not inherit the cancellation of the caller's
+// context: a caller whose context is done stops waiting and gets ctx.Err(),
+// while the execution carries on for the other callers.
+func (f CtxFunc10Error[P0, P1, P2, P3, P4, P5, P6, P7, P8, P9]) Once(opts ...OnceOption) (CtxFunc10Error[P0, P1, P2, P3, P4, P5, P6, P7, P8, P9], *Once) {
+	o := newOnce(opts...)
+	return func(ctx context.Context, p0 P0, p1 P1, p2 P2, p3 P3, p4 P4, p5 P5, p6 P6, p7 P7, p8 P8, p9 P9) error {
+		_, err := o.do(ctx, true, func() (any, error) {
+			ctx := context.WithoutCancel(ctx)
+			return nil, f(ctx, p0, p1, p2, p3, p4, p5, p6, p7, p8, p9)
+		})
+		return err
+	}, o
+}
+
+// Lazy returns a CtxFunc10Error that executes until it succeeds once, along
+// with the Once controlling it.
+// Failures are not cached, unless a backoff is provided with OnceBackoff.
+func (f CtxFunc10Error[P0, P1, P2, P3, P4, P5, P6, P7, P8, P9]) Lazy(opts ...OnceOption) (CtxFunc10Error[P0, P1, P2, P3, P4, P5, P6, P7, P8, P9], *Once) {
+	o := newLazy(opts...)
+	return func(ctx context.Context, p0 P0, p1 P1, p2 P2, p3 P3, p4 P4, p5 P5, p6 P6, p7 P7, p8 P8, p9 P9) error {
+		_, err := o.do(ctx, true, func() (any, error) {
+			ctx := context.WithoutCancel(ctx)
+			return nil, f(ctx, p0, p1, p2, p3, p4, p5, p6, p7, p8, p9)
+		})
+		return err
+	}, o
+}
+
+// Every returns a runner that calls the CtxFunc10Error every interval until
+// its context is done, in which case the runner returns nil.
+// The runner only returns an error when EveryMaxConsecutiveErrors is
+// provided and reached.
+// Every panics if interval is not positive, like time.NewTicker.
+func (f CtxFunc10Error[P0, P1, P2, P3, P4, P5, P6, P7, P8, P9]) Every(interval time.Duration, opts ...EveryOption) CtxFunc10Error[P0, P1, P2, P3, P4, P5, P6, P7, P8, P9] {
+	checkEveryInterval(interval)
+	return func(ctx context.Context, p0 P0, p1 P1, p2 P2, p3 P3, p4 P4, p5 P5, p6 P6, p7 P7, p8 P8, p9 P9) error {
+		return every(ctx, interval, opts, func() error {
+			return f(ctx, p0, p1, p2, p3, p4, p5, p6, p7, p8, p9)
+		})
+	}
+}
+
+// InjectFaults returns a CtxFunc10Error injecting the errors, panics and latency
+// configured by cfg in its calls, to test how the callers cope with them.
+// See FaultConfig, and DisableFaults for the global kill-switch.
+func (f CtxFunc10Error[P0, P1, P2, P3, P4, P5, P6, P7, P8, P9]) InjectFaults(cfg FaultConfig) CtxFunc10Error[P0, P1, P2, P3, P4, P5, P6, P7, P8, P9] {
+	fi := newFaultInjector(cfg)
+	return func(ctx context.Context, p0 P0, p1 P1, p2 P2, p3 P3, p4 P4, p5 P5, p6 P6, p7 P7, p8 P8, p9 P9) error {
+		if err := fi.inject(ctx, p0, p1, p2, p3, p4, p5, p6, p7, p8, p9); err != nil {
+			return err
+		}
+		return f(ctx, p0, p1, p2, p3, p4, p5, p6, p7, p8, p9)
+	}
+}
 
+// Curry10 returns a CtxFuncError with the first 10 arguments of the CtxFunc10Error bound.
 func (f CtxFunc10Error[P0, P1, P2, P3, P4, P5, P6, P7, P8, P9]) Curry10(p0 P0, p1 P1, p2 P2, p3 P3, p4 P4, p5 P5, p6 P6, p7 P7, p8 P8, p9 P9) CtxFuncError {
 	return func(ctx context.Context) error {
 		return f(ctx, p0, p1, p2, p3, p4, p5, p6, p7, p8, p9)
 	}
 }
-	
 
+// Curry9 returns a CtxFunc1Error[P9] with the first 9 arguments of the CtxFunc10Error bound.
 func (f CtxFunc10Error[P0, P1, P2, P3, P4, P5, P6, P7, P8, P9]) Curry9(p0 P0, p1 P1, p2 P2, p3 P3, p4 P4, p5 P5, p6 P6, p7 P7, p8 P8) CtxFunc1Error[P9] {
 	return func(ctx context.Context, p9 P9) error {
 		return f(ctx, p0, p1, p2, p3, p4, p5, p6, p7, p8, p9)
 	}
 }
-	
 
+// Curry8 returns a CtxFunc2Error[P8, P9] with the first 8 arguments of the CtxFunc10Error bound.
 func (f CtxFunc10Error[P0, P1, P2, P3, P4, P5, P6, P7, P8, P9]) Curry8(p0 P0, p1 P1, p2 P2, p3 P3, p4 P4, p5 P5, p6 P6, p7 P7) CtxFunc2Error[P8, P9] {
 	return func(ctx context.Context, p8 P8, p9 P9) error {
 		return f(ctx, p0, p1, p2, p3, p4, p5, p6, p7, p8, p9)
 	}
 }
-	
 
+// Curry7 returns a CtxFunc3Error[P7, P8, P9] with the first 7 arguments of the CtxFunc10Error bound.
 func (f CtxFunc10Error[P0, P1, P2, P3, P4, P5, P6, P7, P8, P9]) Curry7(p0 P0, p1 P1, p2 P2, p3 P3, p4 P4, p5 P5, p6 P6) CtxFunc3Error[P7, P8, P9] {
 	return func(ctx context.Context, p7 P7, p8 P8, p9 P9) error {
 		return f(ctx, p0, p1, p2, p3, p4, p5, p6, p7, p8, p9)
 	}
 }
-	
 
+// Curry6 returns a CtxFunc4Error[P6, P7, P8, P9] with the first 6 arguments of the CtxFunc10Error bound.
 func (f CtxFunc10Error[P0, P1, P2, P3, P4, P5, P6, P7, P8, P9]) Curry6(p0 P0, p1 P1, p2 P2, p3 P3, p4 P4, p5 P5) CtxFunc4Error[P6, P7, P8, P9] {
 	return func(ctx context.Context, p6 P6, p7 P7, p8 P8, p9 P9) error {
 		return f(ctx, p0, p1, p2, p3, p4, p5, p6, p7, p8, p9)
 	}
 }
-	
 
+// Curry5 returns a CtxFunc5Error[P5, P6, P7, P8, P9] with the first 5 arguments of the CtxFunc10Error bound.
 func (f CtxFunc10Error[P0, P1, P2, P3, P4, P5, P6, P7, P8, P9]) Curry5(p0 P0, p1 P1, p2 P2, p3 P3, p4 P4) CtxFunc5Error[P5, P6, P7, P8, P9] {
 	return func(ctx context.Context, p5 P5, p6 P6, p7 P7, p8 P8, p9 P9) error {
 		return f(ctx, p0, p1, p2, p3, p4, p5, p6, p7, p8, p9)
 	}
 }
-	
 
+// Curry4 returns a CtxFunc6Error[P4, P5, P6, P7, P8, P9] with the first 4 arguments of the CtxFunc10Error bound.
 func (f CtxFunc10Error[P0, P1, P2, P3, P4, P5, P6, P7, P8, P9]) Curry4(p0 P0, p1 P1, p2 P2, p3 P3) CtxFunc6Error[P4, P5, P6, P7, P8, P9] {
 	return func(ctx context.Context, p4 P4, p5 P5, p6 P6, p7 P7, p8 P8, p9 P9) error {
 		return f(ctx, p0, p1, p2, p3, p4, p5, p6, p7, p8, p9)
 	}
 }
-	
 
+// Curry3 returns a CtxFunc7Error[P3, P4, P5, P6, P7, P8, P9] with the first 3 arguments of the CtxFunc10Error bound.
 func (f CtxFunc10Error[P0, P1, P2, P3, P4, P5, P6, P7, P8, P9]) Curry3(p0 P0, p1 P1, p2 P2) CtxFunc7Error[P3, P4, P5, P6, P7, P8, P9] {
 	return func(ctx context.Context, p3 P3, p4 P4, p5 P5, p6 P6, p7 P7, p8 P8, p9 P9) error {
 		return f(ctx, p0, p1, p2, p3, p4, p5, p6, p7, p8, p9)
 	}
 }
-	
 
+// Curry2 returns a CtxFunc8Error[P2, P3, P4, P5, P6, P7, P8, P9] with the first 2 arguments of the CtxFunc10Error bound.
 func (f CtxFunc10Error[P0, P1, P2, P3, P4, P5, P6, P7, P8, P9]) Curry2(p0 P0, p1 P1) CtxFunc8Error[P2, P3, P4, P5, P6, P7, P8, P9] {
 	return func(ctx context.Context, p2 P2, p3 P3, p4 P4, p5 P5, p6 P6, p7 P7, p8 P8, p9 P9) error {
 		return f(ctx, p0, p1, p2, p3, p4, p5, p6, p7, p8, p9)
 	}
 }
-	
 
+// Curry1 returns a CtxFunc9Error[P1, P2, P3, P4, P5, P6, P7, P8, P9] with the first argument of the CtxFunc10Error bound.
 func (f CtxFunc10Error[P0, P1, P2, P3, P4, P5, P6, P7, P8, P9]) Curry1(p0 P0) CtxFunc9Error[P1, P2, P3, P4, P5, P6, P7, P8, P9] {
 	return func(ctx context.Context, p1 P1, p2 P2, p3 P3, p4 P4, p5 P5, p6 P6, p7 P7, p8 P8, p9 P9) error {
 		return f(ctx, p0, p1, p2, p3, p4, p5, p6, p7, p8, p9)
 	}
 }
-	
\ No newline at end of file
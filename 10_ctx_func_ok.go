@@ -0,0 +1,281 @@
+// Code generated by powerfunc/generator from ctx_func_ok.go.tmpl. DO NOT EDIT.
+
+package powerfunc
+
+import (
+	"context"
+	"fmt"
+	"time"
+)
+
+type CtxFunc10Ok[R, P0, P1, P2, P3, P4, P5, P6, P7, P8, P9 any] func(ctx context.Context, p0 P0, p1 P1, p2 P2, p3 P3, p4 P4, p5 P5, p6 P6, p7 P7, p8 P8, p9 P9) (R, bool)
+
+func (f CtxFunc10Ok[R, P0, P1, P2, P3, P4, P5, P6, P7, P8, P9]) Exec(ctx context.Context, p0 P0, p1 P1, p2 P2, p3 P3, p4 P4, p5 P5, p6 P6, p7 P7, p8 P8, p9 P9) (R, bool) {
+	return f(ctx, p0, p1, p2, p3, p4, p5, p6, p7, p8, p9)
+}
+
+func (f CtxFunc10Ok[R, P0, P1, P2, P3, P4, P5, P6, P7, P8, P9]) Timing(loggers ...func(d time.Duration)) CtxFunc10Ok[R, P0, P1, P2, P3, P4, P5, P6, P7, P8, P9] {
+	return f.TimingWithClock(RealClock(), loggers...)
+}
+
+// TimingWithClock is like Timing, but measures the execution time with clock.
+func (f CtxFunc10Ok[R, P0, P1, P2, P3, P4, P5, P6, P7, P8, P9]) TimingWithClock(clock Clock, loggers ...func(d time.Duration)) CtxFunc10Ok[R, P0, P1, P2, P3, P4, P5, P6, P7, P8, P9] {
+	return func(ctx context.Context, p0 P0, p1 P1, p2 P2, p3 P3, p4 P4, p5 P5, p6 P6, p7 P7, p8 P8, p9 P9) (R, bool) {
+		start := clock.Now()
+		defer func() {
+			dur := clock.Since(start)
+			for _, logger := range loggers {
+				logger(dur)
+			}
+			if len(loggers) == 0 {
+				// Default logger
+				fmt.Println(dur)
+			}
+		}()
+		return f(ctx, p0, p1, p2, p3, p4, p5, p6, p7, p8, p9)
+	}
+}
+
+func (f CtxFunc10Ok[R, P0, P1, P2, P3, P4, P5, P6, P7, P8, P9]) WithTimeout(timeout time.Duration) CtxFunc10Ok[R, P0, P1, P2, P3, P4, P5, P6, P7, P8, P9] {
+	return func(ctx context.Context, p0 P0, p1 P1, p2 P2, p3 P3, p4 P4, p5 P5, p6 P6, p7 P7, p8 P8, p9 P9) (R, bool) {
+		ctx, cancel := context.WithTimeout(ctx, timeout)
+		defer cancel()
+		return f(ctx, p0, p1, p2, p3, p4, p5, p6, p7, p8, p9)
+	}
+}
+
+func (f CtxFunc10Ok[R, P0, P1, P2, P3, P4, P5, P6, P7, P8, P9]) WithDeadline(deadline time.Time) CtxFunc10Ok[R, P0, P1, P2, P3, P4, P5, P6, P7, P8, P9] {
+	return func(ctx context.Context, p0 P0, p1 P1, p2 P2, p3 P3, p4 P4, p5 P5, p6 P6, p7 P7, p8 P8, p9 P9) (R, bool) {
+		ctx, cancel := context.WithDeadline(ctx, deadline)
+		defer cancel()
+		return f(ctx, p0, p1, p2, p3, p4, p5, p6, p7, p8, p9)
+	}
+}
+
+func (f CtxFunc10Ok[R, P0, P1, P2, P3, P4, P5, P6, P7, P8, P9]) WithCancel() CtxFunc10Ok[R, P0, P1, P2, P3, P4, P5, P6, P7, P8, P9] {
+	return func(ctx context.Context, p0 P0, p1 P1, p2 P2, p3 P3, p4 P4, p5 P5, p6 P6, p7 P7, p8 P8, p9 P9) (R, bool) {
+		ctx, cancel := context.WithCancel(ctx)
+		defer cancel()
+		return f(ctx, p0, p1, p2, p3, p4, p5, p6, p7, p8, p9)
+	}
+}
+
+// WithValue returns a CtxFunc10Ok calling the CtxFunc10Ok with a context
+// carrying val for key, see context.WithValue.
+func (f CtxFunc10Ok[R, P0, P1, P2, P3, P4, P5, P6, P7, P8, P9]) WithValue(key, val any) CtxFunc10Ok[R, P0, P1, P2, P3, P4, P5, P6, P7, P8, P9] {
+	return func(ctx context.Context, p0 P0, p1 P1, p2 P2, p3 P3, p4 P4, p5 P5, p6 P6, p7 P7, p8 P8, p9 P9) (R, bool) {
+		ctx = context.WithValue(ctx, key, val)
+		return f(ctx, p0, p1, p2, p3, p4, p5, p6, p7, p8, p9)
+	}
+}
+
+// WithValueFrom returns a CtxFunc10Ok calling the CtxFunc10Ok with a context
+// carrying the value returned by value for its key. value gets the arguments
+// of every call.
+func (f CtxFunc10Ok[R, P0, P1, P2, P3, P4, P5, P6, P7, P8, P9]) WithValueFrom(value func(ctx context.Context, p0 P0, p1 P1, p2 P2, p3 P3, p4 P4, p5 P5, p6 P6, p7 P7, p8 P8, p9 P9) (key, val any)) CtxFunc10Ok[R, P0, P1, P2, P3, P4, P5, P6, P7, P8, P9] {
+	return func(ctx context.Context, p0 P0, p1 P1, p2 P2, p3 P3, p4 P4, p5 P5, p6 P6, p7 P7, p8 P8, p9 P9) (R, bool) {
+		key, val := value(ctx, p0, p1, p2, p3, p4, p5, p6, p7, p8, p9)
+		ctx = context.WithValue(ctx, key, val)
+		return f(ctx, p0, p1, p2, p3, p4, p5, p6, p7, p8, p9)
+	}
+}
+
+// Detached returns a CtxFunc10Ok calling the CtxFunc10Ok with a context that is
+// never cancelled but keeps the values of the caller's context, see
+// context.WithoutCancel. Useful for cleanups that must run to completion.
+func (f CtxFunc10Ok[R, P0, P1, P2, P3, P4, P5, P6, P7, P8, P9]) Detached() CtxFunc10Ok[R, P0, P1, P2, P3, P4, P5, P6, P7, P8, P9] {
+	return func(ctx context.Context, p0 P0, p1 P1, p2 P2, p3 P3, p4 P4, p5 P5, p6 P6, p7 P7, p8 P8, p9 P9) (R, bool) {
+		ctx = context.WithoutCancel(ctx)
+		return f(ctx, p0, p1, p2, p3, p4, p5, p6, p7, p8, p9)
+	}
+}
+
+// WithTimeoutCause is like WithTimeout, but the context is cancelled with
+// cause when the timeout expires, see context.WithTimeoutCause.
+func (f CtxFunc10Ok[R, P0, P1, P2, P3, P4, P5, P6, P7, P8, P9]) WithTimeoutCause(timeout time.Duration, cause error) CtxFunc10Ok[R, P0, P1, P2, P3, P4, P5, P6, P7, P8, P9] {
+	return func(ctx context.Context, p0 P0, p1 P1, p2 P2, p3 P3, p4 P4, p5 P5, p6 P6, p7 P7, p8 P8, p9 P9) (R, bool) {
+		ctx, cancel := context.WithTimeoutCause(ctx, timeout, cause)
+		defer cancel()
+		return f(ctx, p0, p1, p2, p3, p4, p5, p6, p7, p8, p9)
+	}
+}
+
+// WithDeadlineCause is like WithDeadline, but the context is cancelled with
+// cause when the deadline expires, see context.WithDeadlineCause.
+func (f CtxFunc10Ok[R, P0, P1, P2, P3, P4, P5, P6, P7, P8, P9]) WithDeadlineCause(deadline time.Time, cause error) CtxFunc10Ok[R, P0, P1, P2, P3, P4, P5, P6, P7, P8, P9] {
+	return func(ctx context.Context, p0 P0, p1 P1, p2 P2, p3 P3, p4 P4, p5 P5, p6 P6, p7 P7, p8 P8, p9 P9) (R, bool) {
+		ctx, cancel := context.WithDeadlineCause(ctx, deadline, cause)
+		defer cancel()
+		return f(ctx, p0, p1, p2, p3, p4, p5, p6, p7, p8, p9)
+	}
+}
+
+// Retry returns a CtxFunc10Ok that will retry the CtxFunc10Ok until it returns
+// true or the tryAgain function returns false.
+// The attempts that returned false fail with a placeholder error, given to
+// tryAgain and to the hooks of the options, e.g. OnRetry, so that any
+// RetryPolicy can be used.
+func (f CtxFunc10Ok[R, P0, P1, P2, P3, P4, P5, P6, P7, P8, P9]) Retry(tryAgain RetryPolicy, opts ...RetryOption) CtxFunc10Ok[R, P0, P1, P2, P3, P4, P5, P6, P7, P8, P9] {
+	return func(ctx context.Context, p0 P0, p1 P1, p2 P2, p3 P3, p4 P4, p5 P5, p6 P6, p7 P7, p8 P8, p9 P9) (R, bool) {
+		var v R
+		var ok bool
+		err := retry(ctx, func(attempts int, err error) bool {
+			return ctx.Err() == nil && tryAgain(attempts, err)
+		}, opts, func(ctx context.Context) error {
+			v, ok = f(ctx, p0, p1, p2, p3, p4, p5, p6, p7, p8, p9)
+			if !ok {
+				return errNotOk
+			}
+			return nil
+		})
+		return v, err == nil
+	}
+}
+
+// Must returns a CtxFunc10Value that will panic if the CtxFunc10Ok returns false.
+func (f CtxFunc10Ok[R, P0, P1, P2, P3, P4, P5, P6, P7, P8, P9]) Must() CtxFunc10Value[R, P0, P1, P2, P3, P4, P5, P6, P7, P8, P9] {
+	return func(ctx context.Context, p0 P0, p1 P1, p2 P2, p3 P3, p4 P4, p5 P5, p6 P6, p7 P7, p8 P8, p9 P9) R {
+		v, ok := f(ctx, p0, p1, p2, p3, p4, p5, p6, p7, p8, p9)
+		if !ok {
+			panic("powerfunc: CtxFunc10Ok returned false")
+		}
+		return v
+	}
+}
+
+// Fallback returns a CtxFunc10Value that will return the provided value if the
+// CtxFunc10Ok returns false.
+func (f CtxFunc10Ok[R, P0, P1, P2, P3, P4, P5, P6, P7, P8, P9]) Fallback(val R) CtxFunc10Value[R, P0, P1, P2, P3, P4, P5, P6, P7, P8, P9] {
+	return func(ctx context.Context, p0 P0, p1 P1, p2 P2, p3 P3, p4 P4, p5 P5, p6 P6, p7 P7, p8 P8, p9 P9) R {
+		v, ok := f(ctx, p0, p1, p2, p3, p4, p5, p6, p7, p8, p9)
+		if !ok {
+			return val
+		}
+		return v
+	}
+}
+
+// OrElse returns a CtxFunc10Value that will return the provided value if the
+// CtxFunc10Ok returns false. It is an alias of Fallback.
+func (f CtxFunc10Ok[R, P0, P1, P2, P3, P4, P5, P6, P7, P8, P9]) OrElse(val R) CtxFunc10Value[R, P0, P1, P2, P3, P4, P5, P6, P7, P8, P9] {
+	return f.Fallback(val)
+}
+
+// OrElseGet returns a CtxFunc10Value that will call elsef if the CtxFunc10Ok
+// returns false.
+func (f CtxFunc10Ok[R, P0, P1, P2, P3, P4, P5, P6, P7, P8, P9]) OrElseGet(elsef CtxFunc10Value[R, P0, P1, P2, P3, P4, P5, P6, P7, P8, P9]) CtxFunc10Value[R, P0, P1, P2, P3, P4, P5, P6, P7, P8, P9] {
+	return func(ctx context.Context, p0 P0, p1 P1, p2 P2, p3 P3, p4 P4, p5 P5, p6 P6, p7 P7, p8 P8, p9 P9) R {
+		v, ok := f(ctx, p0, p1, p2, p3, p4, p5, p6, p7, p8, p9)
+		if !ok {
+			return elsef(ctx, p0, p1, p2, p3, p4, p5, p6, p7, p8, p9)
+		}
+		return v
+	}
+}
+
+// ToResult transforms a CtxFunc10Ok into a CtxFunc10Result returning the
+// provided error when the CtxFunc10Ok returns false.
+func (f CtxFunc10Ok[R, P0, P1, P2, P3, P4, P5, P6, P7, P8, P9]) ToResult(err error) CtxFunc10Result[R, P0, P1, P2, P3, P4, P5, P6, P7, P8, P9] {
+	return func(ctx context.Context, p0 P0, p1 P1, p2 P2, p3 P3, p4 P4, p5 P5, p6 P6, p7 P7, p8 P8, p9 P9) (R, error) {
+		v, ok := f(ctx, p0, p1, p2, p3, p4, p5, p6, p7, p8, p9)
+		if !ok {
+			return v, err
+		}
+		return v, nil
+	}
+}
+
+// Filter returns a CtxFunc10Ok that will return false if the value returned by
+// the CtxFunc10Ok does not satisfy keep.
+func (f CtxFunc10Ok[R, P0, P1, P2, P3, P4, P5, P6, P7, P8, P9]) Filter(keep func(R) bool) CtxFunc10Ok[R, P0, P1, P2, P3, P4, P5, P6, P7, P8, P9] {
+	return func(ctx context.Context, p0 P0, p1 P1, p2 P2, p3 P3, p4 P4, p5 P5, p6 P6, p7 P7, p8 P8, p9 P9) (R, bool) {
+		v, ok := f(ctx, p0, p1, p2, p3, p4, p5, p6, p7, p8, p9)
+		if !ok || !keep(v) {
+			var zero R
+			return zero, false
+		}
+		return v, true
+	}
+}
+
+// Map applies the provided function to the value returned by the CtxFunc10Ok,
+// if it returns true.
+func (f CtxFunc10Ok[R, P0, P1, P2, P3, P4, P5, P6, P7, P8, P9]) Map(fn func(R) R) CtxFunc10Ok[R, P0, P1, P2, P3, P4, P5, P6, P7, P8, P9] {
+	return func(ctx context.Context, p0 P0, p1 P1, p2 P2, p3 P3, p4 P4, p5 P5, p6 P6, p7 P7, p8 P8, p9 P9) (R, bool) {
+		v, ok := f(ctx, p0, p1, p2, p3, p4, p5, p6, p7, p8, p9)
+		if !ok {
+			return v, false
+		}
+		return fn(v), true
+	}
+}
+
+// Curry10 returns a CtxFuncOk[R] with the first 10 arguments of the CtxFunc10Ok bound.
+func (f CtxFunc10Ok[R, P0, P1, P2, P3, P4, P5, P6, P7, P8, P9]) Curry10(p0 P0, p1 P1, p2 P2, p3 P3, p4 P4, p5 P5, p6 P6, p7 P7, p8 P8, p9 P9) CtxFuncOk[R] {
+	return func(ctx context.Context) (R, bool) {
+		return f(ctx, p0, p1, p2, p3, p4, p5, p6, p7, p8, p9)
+	}
+}
+
+// Curry9 returns a CtxFunc1Ok[R, P9] with the first 9 arguments of the CtxFunc10Ok bound.
+func (f CtxFunc10Ok[R, P0, P1, P2, P3, P4, P5, P6, P7, P8, P9]) Curry9(p0 P0, p1 P1, p2 P2, p3 P3, p4 P4, p5 P5, p6 P6, p7 P7, p8 P8) CtxFunc1Ok[R, P9] {
+	return func(ctx context.Context, p9 P9) (R, bool) {
+		return f(ctx, p0, p1, p2, p3, p4, p5, p6, p7, p8, p9)
+	}
+}
+
+// Curry8 returns a CtxFunc2Ok[R, P8, P9] with the first 8 arguments of the CtxFunc10Ok bound.
+func (f CtxFunc10Ok[R, P0, P1, P2, P3, P4, P5, P6, P7, P8, P9]) Curry8(p0 P0, p1 P1, p2 P2, p3 P3, p4 P4, p5 P5, p6 P6, p7 P7) CtxFunc2Ok[R, P8, P9] {
+	return func(ctx context.Context, p8 P8, p9 P9) (R, bool) {
+		return f(ctx, p0, p1, p2, p3, p4, p5, p6, p7, p8, p9)
+	}
+}
+
+// Curry7 returns a CtxFunc3Ok[R, P7, P8, P9] with the first 7 arguments of the CtxFunc10Ok bound.
+func (f CtxFunc10Ok[R, P0, P1, P2, P3, P4, P5, P6, P7, P8, P9]) Curry7(p0 P0, p1 P1, p2 P2, p3 P3, p4 P4, p5 P5, p6 P6) CtxFunc3Ok[R, P7, P8, P9] {
+	return func(ctx context.Context, p7 P7, p8 P8, p9 P9) (R, bool) {
+		return f(ctx, p0, p1, p2, p3, p4, p5, p6, p7, p8, p9)
+	}
+}
+
+// Curry6 returns a CtxFunc4Ok[R, P6, P7, P8, P9] with the first 6 arguments of the CtxFunc10Ok bound.
+func (f CtxFunc10Ok[R, P0, P1, P2, P3, P4, P5, P6, P7, P8, P9]) Curry6(p0 P0, p1 P1, p2 P2, p3 P3, p4 P4, p5 P5) CtxFunc4Ok[R, P6, P7, P8, P9] {
+	return func(ctx context.Context, p6 P6, p7 P7, p8 P8, p9 P9) (R, bool) {
+		return f(ctx, p0, p1, p2, p3, p4, p5, p6, p7, p8, p9)
+	}
+}
+
+// Curry5 returns a CtxFunc5Ok[R, P5, P6, P7, P8, P9] with the first 5 arguments of the CtxFunc10Ok bound.
+func (f CtxFunc10Ok[R, P0, P1, P2, P3, P4, P5, P6, P7, P8, P9]) Curry5(p0 P0, p1 P1, p2 P2, p3 P3, p4 P4) CtxFunc5Ok[R, P5, P6, P7, P8, P9] {
+	return func(ctx context.Context, p5 P5, p6 P6, p7 P7, p8 P8, p9 P9) (R, bool) {
+		return f(ctx, p0, p1, p2, p3, p4, p5, p6, p7, p8, p9)
+	}
+}
+
+// Curry4 returns a CtxFunc6Ok[R, P4, P5, P6, P7, P8, P9] with the first 4 arguments of the CtxFunc10Ok bound.
+func (f CtxFunc10Ok[R, P0, P1, P2, P3, P4, P5, P6, P7, P8, P9]) Curry4(p0 P0, p1 P1, p2 P2, p3 P3) CtxFunc6Ok[R, P4, P5, P6, P7, P8, P9] {
+	return func(ctx context.Context, p4 P4, p5 P5, p6 P6, p7 P7, p8 P8, p9 P9) (R, bool) {
+		return f(ctx, p0, p1, p2, p3, p4, p5, p6, p7, p8, p9)
+	}
+}
+
+// Curry3 returns a CtxFunc7Ok[R, P3, P4, P5, P6, P7, P8, P9] with the first 3 arguments of the CtxFunc10Ok bound.
+func (f CtxFunc10Ok[R, P0, P1, P2, P3, P4, P5, P6, P7, P8, P9]) Curry3(p0 P0, p1 P1, p2 P2) CtxFunc7Ok[R, P3, P4, P5, P6, P7, P8, P9] {
+	return func(ctx context.Context, p3 P3, p4 P4, p5 P5, p6 P6, p7 P7, p8 P8, p9 P9) (R, bool) {
+		return f(ctx, p0, p1, p2, p3, p4, p5, p6, p7, p8, p9)
+	}
+}
+
+// Curry2 returns a CtxFunc8Ok[R, P2, P3, P4, P5, P6, P7, P8, P9] with the first 2 arguments of the CtxFunc10Ok bound.
+func (f CtxFunc10Ok[R, P0, P1, P2, P3, P4, P5, P6, P7, P8, P9]) Curry2(p0 P0, p1 P1) CtxFunc8Ok[R, P2, P3, P4, P5, P6, P7, P8, P9] {
+	return func(ctx context.Context, p2 P2, p3 P3, p4 P4, p5 P5, p6 P6, p7 P7, p8 P8, p9 P9) (R, bool) {
+		return f(ctx, p0, p1, p2, p3, p4, p5, p6, p7, p8, p9)
+	}
+}
+
+// Curry1 returns a CtxFunc9Ok[R, P1, P2, P3, P4, P5, P6, P7, P8, P9] with the first argument of the CtxFunc10Ok bound.
+func (f CtxFunc10Ok[R, P0, P1, P2, P3, P4, P5, P6, P7, P8, P9]) Curry1(p0 P0) CtxFunc9Ok[R, P1, P2, P3, P4, P5, P6, P7, P8, P9] {
+	return func(ctx context.Context, p1 P1, p2 P2, p3 P3, p4 P4, p5 P5, p6 P6, p7 P7, p8 P8, p9 P9) (R, bool) {
+		return f(ctx, p0, p1, p2, p3, p4, p5, p6, p7, p8, p9)
+	}
+}
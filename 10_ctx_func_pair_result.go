@@ -0,0 +1,291 @@
+// Code generated by powerfunc/generator from ctx_func_pair_result.go.tmpl. DO NOT EDIT.
+
+package powerfunc
+
+import (
+	"context"
+	"fmt"
+	"time"
+)
+
+type CtxFunc10PairResult[R1, R2, P0, P1, P2, P3, P4, P5, P6, P7, P8, P9 any] func(ctx context.Context, p0 P0, p1 P1, p2 P2, p3 P3, p4 P4, p5 P5, p6 P6, p7 P7, p8 P8, p9 P9) (R1, R2, error)
+
+func (f CtxFunc10PairResult[R1, R2, P0, P1, P2, P3, P4, P5, P6, P7, P8, P9]) Exec(ctx context.Context, p0 P0, p1 P1, p2 P2, p3 P3, p4 P4, p5 P5, p6 P6, p7 P7, p8 P8, p9 P9) (R1, R2, error) {
+	return f(ctx, p0, p1, p2, p3, p4, p5, p6, p7, p8, p9)
+}
+
+func (f CtxFunc10PairResult[R1, R2, P0, P1, P2, P3, P4, P5, P6, P7, P8, P9]) Timing(loggers ...func(d time.Duration)) CtxFunc10PairResult[R1, R2, P0, P1, P2, P3, P4, P5, P6, P7, P8, P9] {
+	return f.TimingWithClock(RealClock(), loggers...)
+}
+
+// TimingWithClock is like Timing, but measures the execution time with clock.
+func (f CtxFunc10PairResult[R1, R2, P0, P1, P2, P3, P4, P5, P6, P7, P8, P9]) TimingWithClock(clock Clock, loggers ...func(d time.Duration)) CtxFunc10PairResult[R1, R2, P0, P1, P2, P3, P4, P5, P6, P7, P8, P9] {
+	return func(ctx context.Context, p0 P0, p1 P1, p2 P2, p3 P3, p4 P4, p5 P5, p6 P6, p7 P7, p8 P8, p9 P9) (R1, R2, error) {
+		start := clock.Now()
+		defer func() {
+			dur := clock.Since(start)
+			for _, logger := range loggers {
+				logger(dur)
+			}
+			if len(loggers) == 0 {
+				// Default logger
+				fmt.Println(dur)
+			}
+		}()
+		return f(ctx, p0, p1, p2, p3, p4, p5, p6, p7, p8, p9)
+	}
+}
+
+func (f CtxFunc10PairResult[R1, R2, P0, P1, P2, P3, P4, P5, P6, P7, P8, P9]) WithTimeout(timeout time.Duration) CtxFunc10PairResult[R1, R2, P0, P1, P2, P3, P4, P5, P6, P7, P8, P9] {
+	return func(ctx context.Context, p0 P0, p1 P1, p2 P2, p3 P3, p4 P4, p5 P5, p6 P6, p7 P7, p8 P8, p9 P9) (R1, R2, error) {
+		ctx, cancel := context.WithTimeout(ctx, timeout)
+		defer cancel()
+		return f(ctx, p0, p1, p2, p3, p4, p5, p6, p7, p8, p9)
+	}
+}
+
+func (f CtxFunc10PairResult[R1, R2, P0, P1, P2, P3, P4, P5, P6, P7, P8, P9]) WithDeadline(deadline time.Time) CtxFunc10PairResult[R1, R2, P0, P1, P2, P3, P4, P5, P6, P7, P8, P9] {
+	return func(ctx context.Context, p0 P0, p1 P1, p2 P2, p3 P3, p4 P4, p5 P5, p6 P6, p7 P7, p8 P8, p9 P9) (R1, R2, error) {
+		ctx, cancel := context.WithDeadline(ctx, deadline)
+		defer cancel()
+		return f(ctx, p0, p1, p2, p3, p4, p5, p6, p7, p8, p9)
+	}
+}
+
+func (f CtxFunc10PairResult[R1, R2, P0, P1, P2, P3, P4, P5, P6, P7, P8, P9]) WithCancel() CtxFunc10PairResult[R1, R2, P0, P1, P2, P3, P4, P5, P6, P7, P8, P9] {
+	return func(ctx context.Context, p0 P0, p1 P1, p2 P2, p3 P3, p4 P4, p5 P5, p6 P6, p7 P7, p8 P8, p9 P9) (R1, R2, error) {
+		ctx, cancel := context.WithCancel(ctx)
+		defer cancel()
+		return f(ctx, p0, p1, p2, p3, p4, p5, p6, p7, p8, p9)
+	}
+}
+
+// WithValue returns a CtxFunc10PairResult calling the CtxFunc10PairResult with a context
+// carrying val for key, see context.WithValue.
+func (f CtxFunc10PairResult[R1, R2, P0, P1, P2, P3, P4, P5, P6, P7, P8, P9]) WithValue(key, val any) CtxFunc10PairResult[R1, R2, P0, P1, P2, P3, P4, P5, P6, P7, P8, P9] {
+	return func(ctx context.Context, p0 P0, p1 P1, p2 P2, p3 P3, p4 P4, p5 P5, p6 P6, p7 P7, p8 P8, p9 P9) (R1, R2, error) {
+		ctx = context.WithValue(ctx, key, val)
+		return f(ctx, p0, p1, p2, p3, p4, p5, p6, p7, p8, p9)
+	}
+}
+
+// WithValueFrom returns a CtxFunc10PairResult calling the CtxFunc10PairResult with a context
+// carrying the value returned by value for its key. value gets the arguments
+// of every call.
+func (f CtxFunc10PairResult[R1, R2, P0, P1, P2, P3, P4, P5, P6, P7, P8, P9]) WithValueFrom(value func(ctx context.Context, p0 P0, p1 P1, p2 P2, p3 P3, p4 P4, p5 P5, p6 P6, p7 P7, p8 P8, p9 P9) (key, val any)) CtxFunc10PairResult[R1, R2, P0, P1, P2, P3, P4, P5, P6, P7, P8, P9] {
+	return func(ctx context.Context, p0 P0, p1 P1, p2 P2, p3 P3, p4 P4, p5 P5, p6 P6, p7 P7, p8 P8, p9 P9) (R1, R2, error) {
+		key, val := value(ctx, p0, p1, p2, p3, p4, p5, p6, p7, p8, p9)
+		ctx = context.WithValue(ctx, key, val)
+		return f(ctx, p0, p1, p2, p3, p4, p5, p6, p7, p8, p9)
+	}
+}
+
+// Detached returns a CtxFunc10PairResult calling the CtxFunc10PairResult with a context that is
+// never cancelled but keeps the values of the caller's context, see
+// context.WithoutCancel. Useful for cleanups that must run to completion.
+func (f CtxFunc10PairResult[R1, R2, P0, P1, P2, P3, P4, P5, P6, P7, P8, P9]) Detached() CtxFunc10PairResult[R1, R2, P0, P1, P2, P3, P4, P5, P6, P7, P8, P9] {
+	return func(ctx context.Context, p0 P0, p1 P1, p2 P2, p3 P3, p4 P4, p5 P5, p6 P6, p7 P7, p8 P8, p9 P9) (R1, R2, error) {
+		ctx = context.WithoutCancel(ctx)
+		return f(ctx, p0, p1, p2, p3, p4, p5, p6, p7, p8, p9)
+	}
+}
+
+// WithTimeoutCause is like WithTimeout, but the context is cancelled with
+// cause when the timeout expires, see context.WithTimeoutCause.
+// When the CtxFunc10PairResult then fails with the error of the context, the returned
+// error wraps cause as well, to tell which timeout expired.
+func (f CtxFunc10PairResult[R1, R2, P0, P1, P2, P3, P4, P5, P6, P7, P8, P9]) WithTimeoutCause(timeout time.Duration, cause error) CtxFunc10PairResult[R1, R2, P0, P1, P2, P3, P4, P5, P6, P7, P8, P9] {
+	return func(ctx context.Context, p0 P0, p1 P1, p2 P2, p3 P3, p4 P4, p5 P5, p6 P6, p7 P7, p8 P8, p9 P9) (R1, R2, error) {
+		ctx, cancel := context.WithTimeoutCause(ctx, timeout, cause)
+		defer cancel()
+		r0, r1, err := f(ctx, p0, p1, p2, p3, p4, p5, p6, p7, p8, p9)
+		return r0, r1, withCause(ctx, err)
+	}
+}
+
+// WithDeadlineCause is like WithDeadline, but the context is cancelled with
+// cause when the deadline expires, see context.WithDeadlineCause.
+// When the CtxFunc10PairResult then fails with the error of the context, the returned
+// error wraps cause as well, to tell which deadline expired.
+func (f CtxFunc10PairResult[R1, R2, P0, P1, P2, P3, P4, P5, P6, P7, P8, P9]) WithDeadlineCause(deadline time.Time, cause error) CtxFunc10PairResult[R1, R2, P0, P1, P2, P3, P4, P5, P6, P7, P8, P9] {
+	return func(ctx context.Context, p0 P0, p1 P1, p2 P2, p3 P3, p4 P4, p5 P5, p6 P6, p7 P7, p8 P8, p9 P9) (R1, R2, error) {
+		ctx, cancel := context.WithDeadlineCause(ctx, deadline, cause)
+		defer cancel()
+		r0, r1, err := f(ctx, p0, p1, p2, p3, p4, p5, p6, p7, p8, p9)
+		return r0, r1, withCause(ctx, err)
+	}
+}
+
+// WithBudget returns a CtxFunc10PairResult giving the CtxFunc10PairResult a fraction of the
+// remaining deadline of the caller, capped at max when max is positive,
+// instead of a fixed timeout. Without a deadline, the CtxFunc10PairResult gets max, or
+// no timeout if max is not positive.
+// When the share is below min, the CtxFunc10PairResult is not called and a
+// *BudgetError, matching ErrBudgetExhausted, is returned.
+func (f CtxFunc10PairResult[R1, R2, P0, P1, P2, P3, P4, P5, P6, P7, P8, P9]) WithBudget(fraction float64, min, max time.Duration) CtxFunc10PairResult[R1, R2, P0, P1, P2, P3, P4, P5, P6, P7, P8, P9] {
+	return func(ctx context.Context, p0 P0, p1 P1, p2 P2, p3 P3, p4 P4, p5 P5, p6 P6, p7 P7, p8 P8, p9 P9) (R1, R2, error) {
+		timeout, bounded, err := budget(ctx, fraction, min, max)
+		if err != nil {
+			var r0 R1
+			var r1 R2
+			return r0, r1, err
+		}
+		if bounded {
+			var cancel context.CancelFunc
+			ctx, cancel = context.WithTimeout(ctx, timeout)
+			defer cancel()
+		}
+		return f(ctx, p0, p1, p2, p3, p4, p5, p6, p7, p8, p9)
+	}
+}
+
+// Retry returns a CtxFunc10PairResult that will retry the CtxFunc10PairResult until it returns
+// a nil error or the tryAgain function returns false, in which case it
+// returns a *RetryError holding the errors of all the attempts.
+// The options set the backoff between attempts and hooks, e.g. OnRetry.
+// RetryAttemptTimeout gives every attempt its own deadline within the one of
+// the caller.
+func (f CtxFunc10PairResult[R1, R2, P0, P1, P2, P3, P4, P5, P6, P7, P8, P9]) Retry(tryAgain func(attempts int, err error) bool, opts ...RetryOption) CtxFunc10PairResult[R1, R2, P0, P1, P2, P3, P4, P5, P6, P7, P8, P9] {
+	return func(ctx context.Context, p0 P0, p1 P1, p2 P2, p3 P3, p4 P4, p5 P5, p6 P6, p7 P7, p8 P8, p9 P9) (R1, R2, error) {
+		var r0 R1
+		var r1 R2
+		err := retry(ctx, tryAgain, opts, func(ctx context.Context) error {
+			var err error
+			r0, r1, err = f(ctx, p0, p1, p2, p3, p4, p5, p6, p7, p8, p9)
+			return err
+		})
+		return r0, r1, err
+	}
+}
+
+// Must returns a CtxFunc10Pair that will panic if the CtxFunc10PairResult returns an error.
+func (f CtxFunc10PairResult[R1, R2, P0, P1, P2, P3, P4, P5, P6, P7, P8, P9]) Must() CtxFunc10Pair[R1, R2, P0, P1, P2, P3, P4, P5, P6, P7, P8, P9] {
+	return func(ctx context.Context, p0 P0, p1 P1, p2 P2, p3 P3, p4 P4, p5 P5, p6 P6, p7 P7, p8 P8, p9 P9) (R1, R2) {
+		v1, v2, err := f(ctx, p0, p1, p2, p3, p4, p5, p6, p7, p8, p9)
+		if err != nil {
+			panic(err)
+		}
+		return v1, v2
+	}
+}
+
+// OnErr returns a CtxFunc10PairResult that will wrap the error returned by the
+// CtxFunc10PairResult with the provided message.
+func (f CtxFunc10PairResult[R1, R2, P0, P1, P2, P3, P4, P5, P6, P7, P8, P9]) OnErr(msg string) CtxFunc10PairResult[R1, R2, P0, P1, P2, P3, P4, P5, P6, P7, P8, P9] {
+	return func(ctx context.Context, p0 P0, p1 P1, p2 P2, p3 P3, p4 P4, p5 P5, p6 P6, p7 P7, p8 P8, p9 P9) (R1, R2, error) {
+		v1, v2, err := f(ctx, p0, p1, p2, p3, p4, p5, p6, p7, p8, p9)
+		if err != nil {
+			return v1, v2, fmt.Errorf("%s: %w", msg, err)
+		}
+		return v1, v2, nil
+	}
+}
+
+// MapErr applies the provided function to the error returned by the
+// CtxFunc10PairResult, if there is an error.
+func (f CtxFunc10PairResult[R1, R2, P0, P1, P2, P3, P4, P5, P6, P7, P8, P9]) MapErr(fn func(error) error) CtxFunc10PairResult[R1, R2, P0, P1, P2, P3, P4, P5, P6, P7, P8, P9] {
+	return func(ctx context.Context, p0 P0, p1 P1, p2 P2, p3 P3, p4 P4, p5 P5, p6 P6, p7 P7, p8 P8, p9 P9) (R1, R2, error) {
+		v1, v2, err := f(ctx, p0, p1, p2, p3, p4, p5, p6, p7, p8, p9)
+		if err != nil {
+			return v1, v2, fn(err)
+		}
+		return v1, v2, nil
+	}
+}
+
+// Fallback returns a CtxFunc10Pair that will return the provided values if the
+// CtxFunc10PairResult returns an error.
+func (f CtxFunc10PairResult[R1, R2, P0, P1, P2, P3, P4, P5, P6, P7, P8, P9]) Fallback(val1 R1, val2 R2) CtxFunc10Pair[R1, R2, P0, P1, P2, P3, P4, P5, P6, P7, P8, P9] {
+	return func(ctx context.Context, p0 P0, p1 P1, p2 P2, p3 P3, p4 P4, p5 P5, p6 P6, p7 P7, p8 P8, p9 P9) (R1, R2) {
+		v1, v2, err := f(ctx, p0, p1, p2, p3, p4, p5, p6, p7, p8, p9)
+		if err != nil {
+			return val1, val2
+		}
+		return v1, v2
+	}
+}
+
+// InjectFaults returns a CtxFunc10PairResult injecting the errors, panics and latency
+// configured by cfg in its calls, to test how the callers cope with them.
+// See FaultConfig, and DisableFaults for the global kill-switch.
+func (f CtxFunc10PairResult[R1, R2, P0, P1, P2, P3, P4, P5, P6, P7, P8, P9]) InjectFaults(cfg FaultConfig) CtxFunc10PairResult[R1, R2, P0, P1, P2, P3, P4, P5, P6, P7, P8, P9] {
+	fi := newFaultInjector(cfg)
+	return func(ctx context.Context, p0 P0, p1 P1, p2 P2, p3 P3, p4 P4, p5 P5, p6 P6, p7 P7, p8 P8, p9 P9) (R1, R2, error) {
+		if err := fi.inject(ctx, p0, p1, p2, p3, p4, p5, p6, p7, p8, p9); err != nil {
+			var r0 R1
+			var r1 R2
+			return r0, r1, err
+		}
+		return f(ctx, p0, p1, p2, p3, p4, p5, p6, p7, p8, p9)
+	}
+}
+
+// Curry10 returns a CtxFuncPairResult[R1, R2] with the first 10 arguments of the CtxFunc10PairResult bound.
+func (f CtxFunc10PairResult[R1, R2, P0, P1, P2, P3, P4, P5, P6, P7, P8, P9]) Curry10(p0 P0, p1 P1, p2 P2, p3 P3, p4 P4, p5 P5, p6 P6, p7 P7, p8 P8, p9 P9) CtxFuncPairResult[R1, R2] {
+	return func(ctx context.Context) (R1, R2, error) {
+		return f(ctx, p0, p1, p2, p3, p4, p5, p6, p7, p8, p9)
+	}
+}
+
+// Curry9 returns a CtxFunc1PairResult[R1, R2, P9] with the first 9 arguments of the CtxFunc10PairResult bound.
+func (f CtxFunc10PairResult[R1, R2, P0, P1, P2, P3, P4, P5, P6, P7, P8, P9]) Curry9(p0 P0, p1 P1, p2 P2, p3 P3, p4 P4, p5 P5, p6 P6, p7 P7, p8 P8) CtxFunc1PairResult[R1, R2, P9] {
+	return func(ctx context.Context, p9 P9) (R1, R2, error) {
+		return f(ctx, p0, p1, p2, p3, p4, p5, p6, p7, p8, p9)
+	}
+}
+
+// Curry8 returns a CtxFunc2PairResult[R1, R2, P8, P9] with the first 8 arguments of the CtxFunc10PairResult bound.
+func (f CtxFunc10PairResult[R1, R2, P0, P1, P2, P3, P4, P5, P6, P7, P8, P9]) Curry8(p0 P0, p1 P1, p2 P2, p3 P3, p4 P4, p5 P5, p6 P6, p7 P7) CtxFunc2PairResult[R1, R2, P8, P9] {
+	return func(ctx context.Context, p8 P8, p9 P9) (R1, R2, error) {
+		return f(ctx, p0, p1, p2, p3, p4, p5, p6, p7, p8, p9)
+	}
+}
+
+// Curry7 returns a CtxFunc3PairResult[R1, R2, P7, P8, P9] with the first 7 arguments of the CtxFunc10PairResult bound.
+func (f CtxFunc10PairResult[R1, R2, P0, P1, P2, P3, P4, P5, P6, P7, P8, P9]) Curry7(p0 P0, p1 P1, p2 P2, p3 P3, p4 P4, p5 P5, p6 P6) CtxFunc3PairResult[R1, R2, P7, P8, P9] {
+	return func(ctx context.Context, p7 P7, p8 P8, p9 P9) (R1, R2, error) {
+		return f(ctx, p0, p1, p2, p3, p4, p5, p6, p7, p8, p9)
+	}
+}
+
+// Curry6 returns a CtxFunc4PairResult[R1, R2, P6, P7, P8, P9] with the first 6 arguments of the CtxFunc10PairResult bound.
+func (f CtxFunc10PairResult[R1, R2, P0, P1, P2, P3, P4, P5, P6, P7, P8, P9]) Curry6(p0 P0, p1 P1, p2 P2, p3 P3, p4 P4, p5 P5) CtxFunc4PairResult[R1, R2, P6, P7, P8, P9] {
+	return func(ctx context.Context, p6 P6, p7 P7, p8 P8, p9 P9) (R1, R2, error) {
+		return f(ctx, p0, p1, p2, p3, p4, p5, p6, p7, p8, p9)
+	}
+}
+
+// Curry5 returns a CtxFunc5PairResult[R1, R2, P5, P6, P7, P8, P9] with the first 5 arguments of the CtxFunc10PairResult bound.
+func (f CtxFunc10PairResult[R1, R2, P0, P1, P2, P3, P4, P5, P6, P7, P8, P9]) Curry5(p0 P0, p1 P1, p2 P2, p3 P3, p4 P4) CtxFunc5PairResult[R1, R2, P5, P6, P7, P8, P9] {
+	return func(ctx context.Context, p5 P5, p6 P6, p7 P7, p8 P8, p9 P9) (R1, R2, error) {
+		return f(ctx, p0, p1, p2, p3, p4, p5, p6, p7, p8, p9)
+	}
+}
+
+// Curry4 returns a CtxFunc6PairResult[R1, R2, P4, P5, P6, P7, P8, P9] with the first 4 arguments of the CtxFunc10PairResult bound.
+func (f CtxFunc10PairResult[R1, R2, P0, P1, P2, P3, P4, P5, P6, P7, P8, P9]) Curry4(p0 P0, p1 P1, p2 P2, p3 P3) CtxFunc6PairResult[R1, R2, P4, P5, P6, P7, P8, P9] {
+	return func(ctx context.Context, p4 P4, p5 P5, p6 P6, p7 P7, p8 P8, p9 P9) (R1, R2, error) {
+		return f(ctx, p0, p1, p2, p3, p4, p5, p6, p7, p8, p9)
+	}
+}
+
+// Curry3 returns a CtxFunc7PairResult[R1, R2, P3, P4, P5, P6, P7, P8, P9] with the first 3 arguments of the CtxFunc10PairResult bound.
+func (f CtxFunc10PairResult[R1, R2, P0, P1, P2, P3, P4, P5, P6, P7, P8, P9]) Curry3(p0 P0, p1 P1, p2 P2) CtxFunc7PairResult[R1, R2, P3, P4, P5, P6, P7, P8, P9] {
+	return func(ctx context.Context, p3 P3, p4 P4, p5 P5, p6 P6, p7 P7, p8 P8, p9 P9) (R1, R2, error) {
+		return f(ctx, p0, p1, p2, p3, p4, p5, p6, p7, p8, p9)
+	}
+}
+
+// Curry2 returns a CtxFunc8PairResult[R1, R2, P2, P3, P4, P5, P6, P7, P8, P9] with the first 2 arguments of the CtxFunc10PairResult bound.
+func (f CtxFunc10PairResult[R1, R2, P0, P1, P2, P3, P4, P5, P6, P7, P8, P9]) Curry2(p0 P0, p1 P1) CtxFunc8PairResult[R1, R2, P2, P3, P4, P5, P6, P7, P8, P9] {
+	return func(ctx context.Context, p2 P2, p3 P3, p4 P4, p5 P5, p6 P6, p7 P7, p8 P8, p9 P9) (R1, R2, error) {
+		return f(ctx, p0, p1, p2, p3, p4, p5, p6, p7, p8, p9)
+	}
+}
+
+// Curry1 returns a CtxFunc9PairResult[R1, R2, P1, P2, P3, P4, P5, P6, P7, P8, P9] with the first argument of the CtxFunc10PairResult bound.
+func (f CtxFunc10PairResult[R1, R2, P0, P1, P2, P3, P4, P5, P6, P7, P8, P9]) Curry1(p0 P0) CtxFunc9PairResult[R1, R2, P1, P2, P3, P4, P5, P6, P7, P8, P9] {
+	return func(ctx context.Context, p1 P1, p2 P2, p3 P3, p4 P4, p5 P5, p6 P6, p7 P7, p8 P8, p9 P9) (R1, R2, error) {
+		return f(ctx, p0, p1, p2, p3, p4, p5, p6, p7, p8, p9)
+	}
+}
@@ -0,0 +1,257 @@
+// Code generated by powerfunc/generator from ctx_func_variadic_error.go.tmpl. DO NOT EDIT.
+
+package powerfunc
+
+import (
+	"context"
+	"fmt"
+	"time"
+)
+
+type CtxFunc10VariadicError[V, P0, P1, P2, P3, P4, P5, P6, P7, P8, P9 any] func(ctx context.Context, p0 P0, p1 P1, p2 P2, p3 P3, p4 P4, p5 P5, p6 P6, p7 P7, p8 P8, p9 P9, xs ...V) error
+
+func (f CtxFunc10VariadicError[V, P0, P1, P2, P3, P4, P5, P6, P7, P8, P9]) Exec(ctx context.Context, p0 P0, p1 P1, p2 P2, p3 P3, p4 P4, p5 P5, p6 P6, p7 P7, p8 P8, p9 P9, xs ...V) error {
+	return f(ctx, p0, p1, p2, p3, p4, p5, p6, p7, p8, p9, xs...)
+}
+
+func (f CtxFunc10VariadicError[V, P0, P1, P2, P3, P4, P5, P6, P7, P8, P9]) Timing(loggers ...func(d time.Duration)) CtxFunc10VariadicError[V, P0, P1, P2, P3, P4, P5, P6, P7, P8, P9] {
+	return f.TimingWithClock(RealClock(), loggers...)
+}
+
+// TimingWithClock is like Timing, but measures the execution time with clock.
+func (f CtxFunc10VariadicError[V, P0, P1, P2, P3, P4, P5, P6, P7, P8, P9]) TimingWithClock(clock Clock, loggers ...func(d time.Duration)) CtxFunc10VariadicError[V, P0, P1, P2, P3, P4, P5, P6, P7, P8, P9] {
+	return func(ctx context.Context, p0 P0, p1 P1, p2 P2, p3 P3, p4 P4, p5 P5, p6 P6, p7 P7, p8 P8, p9 P9, xs ...V) error {
+		start := clock.Now()
+		defer func() {
+			dur := clock.Since(start)
+			for _, logger := range loggers {
+				logger(dur)
+			}
+			if len(loggers) == 0 {
+				// Default logger
+				fmt.Println(dur)
+			}
+		}()
+		return f(ctx, p0, p1, p2, p3, p4, p5, p6, p7, p8, p9, xs...)
+	}
+}
+
+func (f CtxFunc10VariadicError[V, P0, P1, P2, P3, P4, P5, P6, P7, P8, P9]) WithTimeout(timeout time.Duration) CtxFunc10VariadicError[V, P0, P1, P2, P3, P4, P5, P6, P7, P8, P9] {
+	return func(ctx context.Context, p0 P0, p1 P1, p2 P2, p3 P3, p4 P4, p5 P5, p6 P6, p7 P7, p8 P8, p9 P9, xs ...V) error {
+		ctx, cancel := context.WithTimeout(ctx, timeout)
+		defer cancel()
+		return f(ctx, p0, p1, p2, p3, p4, p5, p6, p7, p8, p9, xs...)
+	}
+}
+
+func (f CtxFunc10VariadicError[V, P0, P1, P2, P3, P4, P5, P6, P7, P8, P9]) WithDeadline(deadline time.Time) CtxFunc10VariadicError[V, P0, P1, P2, P3, P4, P5, P6, P7, P8, P9] {
+	return func(ctx context.Context, p0 P0, p1 P1, p2 P2, p3 P3, p4 P4, p5 P5, p6 P6, p7 P7, p8 P8, p9 P9, xs ...V) error {
+		ctx, cancel := context.WithDeadline(ctx, deadline)
+		defer cancel()
+		return f(ctx, p0, p1, p2, p3, p4, p5, p6, p7, p8, p9, xs...)
+	}
+}
+
+func (f CtxFunc10VariadicError[V, P0, P1, P2, P3, P4, P5, P6, P7, P8, P9]) WithCancel() CtxFunc10VariadicError[V, P0, P1, P2, P3, P4, P5, P6, P7, P8, P9] {
+	return func(ctx context.Context, p0 P0, p1 P1, p2 P2, p3 P3, p4 P4, p5 P5, p6 P6, p7 P7, p8 P8, p9 P9, xs ...V) error {
+		ctx, cancel := context.WithCancel(ctx)
+		defer cancel()
+		return f(ctx, p0, p1, p2, p3, p4, p5, p6, p7, p8, p9, xs...)
+	}
+}
+
+// WithValue returns a CtxFunc10VariadicError calling the CtxFunc10VariadicError with a context
+// carrying val for key, see context.WithValue.
+func (f CtxFunc10VariadicError[V, P0, P1, P2, P3, P4, P5, P6, P7, P8, P9]) WithValue(key, val any) CtxFunc10VariadicError[V, P0, P1, P2, P3, P4, P5, P6, P7, P8, P9] {
+	return func(ctx context.Context, p0 P0, p1 P1, p2 P2, p3 P3, p4 P4, p5 P5, p6 P6, p7 P7, p8 P8, p9 P9, xs ...V) error {
+		ctx = context.WithValue(ctx, key, val)
+		return f(ctx, p0, p1, p2, p3, p4, p5, p6, p7, p8, p9, xs...)
+	}
+}
+
+// WithValueFrom returns a CtxFunc10VariadicError calling the CtxFunc10VariadicError with a context
+// carrying the value returned by value for its key. value gets the arguments
+// of every call.
+func (f CtxFunc10VariadicError[V, P0, P1, P2, P3, P4, P5, P6, P7, P8, P9]) WithValueFrom(value func(ctx context.Context, p0 P0, p1 P1, p2 P2, p3 P3, p4 P4, p5 P5, p6 P6, p7 P7, p8 P8, p9 P9, xs ...V) (key, val any)) CtxFunc10VariadicError[V, P0, P1, P2, P3, P4, P5, P6, P7, P8, P9] {
+	return func(ctx context.Context, p0 P0, p1 P1, p2 P2, p3 P3, p4 P4, p5 P5, p6 P6, p7 P7, p8 P8, p9 P9, xs ...V) error {
+		key, val := value(ctx, p0, p1, p2, p3, p4, p5, p6, p7, p8, p9, xs...)
+		ctx = context.WithValue(ctx, key, val)
+		return f(ctx, p0, p1, p2, p3, p4, p5, p6, p7, p8, p9, xs...)
+	}
+}
+
+// Detached returns a CtxFunc10VariadicError calling the CtxFunc10VariadicError with a context that is
+// never cancelled but keeps the values of the caller's context, see
+// context.WithoutCancel. Useful for cleanups that must run to completion.
+func (f CtxFunc10VariadicError[V, P0, P1, P2, P3, P4, P5, P6, P7, P8, P9]) Detached() CtxFunc10VariadicError[V, P0, P1, P2, P3, P4, P5, P6, P7, P8, P9] {
+	return func(ctx context.Context, p0 P0, p1 P1, p2 P2, p3 P3, p4 P4, p5 P5, p6 P6, p7 P7, p8 P8, p9 P9, xs ...V) error {
+		ctx = context.WithoutCancel(ctx)
+		return f(ctx, p0, p1, p2, p3, p4, p5, p6, p7, p8, p9, xs...)
+	}
+}
+
+// WithTimeoutCause is like WithTimeout, but the context is cancelled with
+// cause when the timeout expires, see context.WithTimeoutCause.
+// When the CtxFunc10VariadicError then fails with the error of the context, the returned
+// error wraps cause as well, to tell which timeout expired.
+func (f CtxFunc10VariadicError[V, P0, P1, P2, P3, P4, P5, P6, P7, P8, P9]) WithTimeoutCause(timeout time.Duration, cause error) CtxFunc10VariadicError[V, P0, P1, P2, P3, P4, P5, P6, P7, P8, P9] {
+	return func(ctx context.Context, p0 P0, p1 P1, p2 P2, p3 P3, p4 P4, p5 P5, p6 P6, p7 P7, p8 P8, p9 P9, xs ...V) error {
+		ctx, cancel := context.WithTimeoutCause(ctx, timeout, cause)
+		defer cancel()
+		err := f(ctx, p0, p1, p2, p3, p4, p5, p6, p7, p8, p9, xs...)
+		return withCause(ctx, err)
+	}
+}
+
+// WithDeadlineCause is like WithDeadline, but the context is cancelled with
+// cause when the deadline expires, see context.WithDeadlineCause.
+// When the CtxFunc10VariadicError then fails with the error of the context, the returned
+// error wraps cause as well, to tell which deadline expired.
+func (f CtxFunc10VariadicError[V, P0, P1, P2, P3, P4, P5, P6, P7, P8, P9]) WithDeadlineCause(deadline time.Time, cause error) CtxFunc10VariadicError[V, P0, P1, P2, P3, P4, P5, P6, P7, P8, P9] {
+	return func(ctx context.Context, p0 P0, p1 P1, p2 P2, p3 P3, p4 P4, p5 P5, p6 P6, p7 P7, p8 P8, p9 P9, xs ...V) error {
+		ctx, cancel := context.WithDeadlineCause(ctx, deadline, cause)
+		defer cancel()
+		err := f(ctx, p0, p1, p2, p3, p4, p5, p6, p7, p8, p9, xs...)
+		return withCause(ctx, err)
+	}
+}
+
+// WithBudget returns a CtxFunc10VariadicError giving the CtxFunc10VariadicError a fraction of the
+// remaining deadline of the caller, capped at max when max is positive,
+// instead of a fixed timeout. Without a deadline, the CtxFunc10VariadicError gets max, or
+// no timeout if max is not positive.
+// When the share is below min, the CtxFunc10VariadicError is not called and a
+// *BudgetError, matching ErrBudgetExhausted, is returned.
+func (f CtxFunc10VariadicError[V, P0, P1, P2, P3, P4, P5, P6, P7, P8, P9]) WithBudget(fraction float64, min, max time.Duration) CtxFunc10VariadicError[V, P0, P1, P2, P3, P4, P5, P6, P7, P8, P9] {
+	return func(ctx context.Context, p0 P0, p1 P1, p2 P2, p3 P3, p4 P4, p5 P5, p6 P6, p7 P7, p8 P8, p9 P9, xs ...V) error {
+		timeout, bounded, err := budget(ctx, fraction, min, max)
+		if err != nil {
+			return err
+		}
+		if bounded {
+			var cancel context.CancelFunc
+			ctx, cancel = context.WithTimeout(ctx, timeout)
+			defer cancel()
+		}
+		return f(ctx, p0, p1, p2, p3, p4, p5, p6, p7, p8, p9, xs...)
+	}
+}
+
+// Retry returns a CtxFunc10VariadicError that will retry the CtxFunc10VariadicError until it returns
+// a nil error or the tryAgain function returns false, in which case it
+// returns a *RetryError holding the errors of all the attempts.
+// The options set the backoff between attempts and hooks, e.g. OnRetry.
+// RetryAttemptTimeout gives every attempt its own deadline within the one of
+// the caller.
+func (f CtxFunc10VariadicError[V, P0, P1, P2, P3, P4, P5, P6, P7, P8, P9]) Retry(tryAgain func(attempts int, err error) bool, opts ...RetryOption) CtxFunc10VariadicError[V, P0, P1, P2, P3, P4, P5, P6, P7, P8, P9] {
+	return func(ctx context.Context, p0 P0, p1 P1, p2 P2, p3 P3, p4 P4, p5 P5, p6 P6, p7 P7, p8 P8, p9 P9, xs ...V) error {
+		return retry(ctx, tryAgain, opts, func(ctx context.Context) error {
+			return f(ctx, p0, p1, p2, p3, p4, p5, p6, p7, p8, p9, xs...)
+		})
+	}
+}
+
+// OnErr returns a CtxFunc10VariadicError that will wrap the error with the
+// provided message.
+func (f CtxFunc10VariadicError[V, P0, P1, P2, P3, P4, P5, P6, P7, P8, P9]) OnErr(msg string) CtxFunc10VariadicError[V, P0, P1, P2, P3, P4, P5, P6, P7, P8, P9] {
+	return func(ctx context.Context, p0 P0, p1 P1, p2 P2, p3 P3, p4 P4, p5 P5, p6 P6, p7 P7, p8 P8, p9 P9, xs ...V) error {
+		err := f(ctx, p0, p1, p2, p3, p4, p5, p6, p7, p8, p9, xs...)
+		if err != nil {
+			return fmt.Errorf("%s: %w", msg, err)
+		}
+		return nil
+	}
+}
+
+// CurryVariadic returns a CtxFunc10VariadicError with some of its variadic
+// arguments bound. The bound arguments come before the ones passed to the
+// returned function.
+func (f CtxFunc10VariadicError[V, P0, P1, P2, P3, P4, P5, P6, P7, P8, P9]) CurryVariadic(bound ...V) CtxFunc10VariadicError[V, P0, P1, P2, P3, P4, P5, P6, P7, P8, P9] {
+	return func(ctx context.Context, p0 P0, p1 P1, p2 P2, p3 P3, p4 P4, p5 P5, p6 P6, p7 P7, p8 P8, p9 P9, xs ...V) error {
+		xs = append(append(make([]V, 0, len(bound)+len(xs)), bound...), xs...)
+		return f(ctx, p0, p1, p2, p3, p4, p5, p6, p7, p8, p9, xs...)
+	}
+}
+
+// InjectFaults returns a CtxFunc10VariadicError injecting the errors, panics and latency
+// configured by cfg in its calls, to test how the callers cope with them.
+// See FaultConfig, and DisableFaults for the global kill-switch.
+func (f CtxFunc10VariadicError[V, P0, P1, P2, P3, P4, P5, P6, P7, P8, P9]) InjectFaults(cfg FaultConfig) CtxFunc10VariadicError[V, P0, P1, P2, P3, P4, P5, P6, P7, P8, P9] {
+	fi := newFaultInjector(cfg)
+	return func(ctx context.Context, p0 P0, p1 P1, p2 P2, p3 P3, p4 P4, p5 P5, p6 P6, p7 P7, p8 P8, p9 P9, xs ...V) error {
+		if err := fi.inject(ctx, p0, p1, p2, p3, p4, p5, p6, p7, p8, p9, xs); err != nil {
+			return err
+		}
+		return f(ctx, p0, p1, p2, p3, p4, p5, p6, p7, p8, p9, xs...)
+	}
+}
+
+// Curry10 returns a CtxFuncVariadicError[V] with the first 10 arguments of the CtxFunc10VariadicError bound.
+func (f CtxFunc10VariadicError[V, P0, P1, P2, P3, P4, P5, P6, P7, P8, P9]) Curry10(p0 P0, p1 P1, p2 P2, p3 P3, p4 P4, p5 P5, p6 P6, p7 P7, p8 P8, p9 P9) CtxFuncVariadicError[V] {
+	return func(ctx context.Context, xs ...V) error {
+		return f(ctx, p0, p1, p2, p3, p4, p5, p6, p7, p8, p9, xs...)
+	}
+}
+
+// Curry9 returns a CtxFunc1VariadicError[V, P9] with the first 9 arguments of the CtxFunc10VariadicError bound.
+func (f CtxFunc10VariadicError[V, P0, P1, P2, P3, P4, P5, P6, P7, P8, P9]) Curry9(p0 P0, p1 P1, p2 P2, p3 P3, p4 P4, p5 P5, p6 P6, p7 P7, p8 P8) CtxFunc1VariadicError[V, P9] {
+	return func(ctx context.Context, p9 P9, xs ...V) error {
+		return f(ctx, p0, p1, p2, p3, p4, p5, p6, p7, p8, p9, xs...)
+	}
+}
+
+// Curry8 returns a CtxFunc2VariadicError[V, P8, P9] with the first 8 arguments of the CtxFunc10VariadicError bound.
+func (f CtxFunc10VariadicError[V, P0, P1, P2, P3, P4, P5, P6, P7, P8, P9]) Curry8(p0 P0, p1 P1, p2 P2, p3 P3, p4 P4, p5 P5, p6 P6, p7 P7) CtxFunc2VariadicError[V, P8, P9] {
+	return func(ctx context.Context, p8 P8, p9 P9, xs ...V) error {
+		return f(ctx, p0, p1, p2, p3, p4, p5, p6, p7, p8, p9, xs...)
+	}
+}
+
+// Curry7 returns a CtxFunc3VariadicError[V, P7, P8, P9] with the first 7 arguments of the CtxFunc10VariadicError bound.
+func (f CtxFunc10VariadicError[V, P0, P1, P2, P3, P4, P5, P6, P7, P8, P9]) Curry7(p0 P0, p1 P1, p2 P2, p3 P3, p4 P4, p5 P5, p6 P6) CtxFunc3VariadicError[V, P7, P8, P9] {
+	return func(ctx context.Context, p7 P7, p8 P8, p9 P9, xs ...V) error {
+		return f(ctx, p0, p1, p2, p3, p4, p5, p6, p7, p8, p9, xs...)
+	}
+}
+
+// Curry6 returns a CtxFunc4VariadicError[V, P6, P7, P8, P9] with the first 6 arguments of the CtxFunc10VariadicError bound.
+func (f CtxFunc10VariadicError[V, P0, P1, P2, P3, P4, P5, P6, P7, P8, P9]) Curry6(p0 P0, p1 P1, p2 P2, p3 P3, p4 P4, p5 P5) CtxFunc4VariadicError[V, P6, P7, P8, P9] {
+	return func(ctx context.Context, p6 P6, p7 P7, p8 P8, p9 P9, xs ...V) error {
+		return f(ctx, p0, p1, p2, p3, p4, p5, p6, p7, p8, p9, xs...)
+	}
+}
+
+// Curry5 returns a CtxFunc5VariadicError[V, P5, P6, P7, P8, P9] with the first 5 arguments of the CtxFunc10VariadicError bound.
+func (f CtxFunc10VariadicError[V, P0, P1, P2, P3, P4, P5, P6, P7, P8, P9]) Curry5(p0 P0, p1 P1, p2 P2, p3 P3, p4 P4) CtxFunc5VariadicError[V, P5, P6, P7, P8, P9] {
+	return func(ctx context.Context, p5 P5, p6 P6, p7 P7, p8 P8, p9 P9, xs ...V) error {
+		return f(ctx, p0, p1, p2, p3, p4, p5, p6, p7, p8, p9, xs...)
+	}
+}
+
+// Curry4 returns a CtxFunc6VariadicError[V, P4, P5, P6, P7, P8, P9] with the first 4 arguments of the CtxFunc10VariadicError bound.
+func (f CtxFunc10VariadicError[V, P0, P1, P2, P3, P4, P5, P6, P7, P8, P9]) Curry4(p0 P0, p1 P1, p2 P2, p3 P3) CtxFunc6VariadicError[V, P4, P5, P6, P7, P8, P9] {
+	return func(ctx context.Context, p4 P4, p5 P5, p6 P6, p7 P7, p8 P8, p9 P9, xs ...V) error {
+		return f(ctx, p0, p1, p2, p3, p4, p5, p6, p7, p8, p9, xs...)
+	}
+}
+
+// Curry3 returns a CtxFunc7VariadicError[V, P3, P4, P5, P6, P7, P8, P9] with the first 3 arguments of the CtxFunc10VariadicError bound.
+func (f CtxFunc10VariadicError[V, P0, P1, P2, P3, P4, P5, P6, P7, P8, P9]) Curry3(p0 P0, p1 P1, p2 P2) CtxFunc7VariadicError[V, P3, P4, P5, P6, P7, P8, P9] {
+	return func(ctx context.Context, p3 P3, p4 P4, p5 P5, p6 P6, p7 P7, p8 P8, p9 P9, xs ...V) error {
+		return f(ctx, p0, p1, p2, p3, p4, p5, p6, p7, p8, p9, xs...)
+	}
+}
+
+// Curry2 returns a CtxFunc8VariadicError[V, P2, P3, P4, P5, P6, P7, P8, P9] with the first 2 arguments of the CtxFunc10VariadicError bound.
+func (f CtxFunc10VariadicError[V, P0, P1, P2, P3, P4, P5, P6, P7, P8, P9]) Curry2(p0 P0, p1 P1) CtxFunc8VariadicError[V, P2, P3, P4, P5, P6, P7, P8, P9] {
+	return func(ctx context.Context, p2 P2, p3 P3, p4 P4, p5 P5, p6 P6, p7 P7, p8 P8, p9 P9, xs ...V) error {
+		return f(ctx, p0, p1, p2, p3, p4, p5, p6, p7, p8, p9, xs...)
+	}
+}
+
+// Curry1 returns a CtxFunc9VariadicError[V, P1, P2, P3, P4, P5, P6, P7, P8, P9] with the first argument of the CtxFunc10VariadicError bound.
+func (f CtxFunc10VariadicError[V, P0, P1, P2, P3, P4, P5, P6, P7, P8, P9]) Curry1(p0 P0) CtxFunc9VariadicError[V, P1, P2, P3, P4, P5, P6, P7, P8, P9] {
+	return func(ctx context.Context, p1 P1, p2 P2, p3 P3, p4 P4, p5 P5, p6 P6, p7 P7, p8 P8, p9 P9, xs ...V) error {
+		return f(ctx, p0, p1, p2, p3, p4, p5, p6, p7, p8, p9, xs...)
+	}
+}
@@ -1,13 +1,14 @@
-//go:generate go run ./generator -arity 10
+// Code generated by powerfunc/generator from func.go.tmpl. DO NOT EDIT.
 
 package powerfunc
 
 import (
+	"context"
 	"fmt"
 	"time"
 )
 
-// Func10 is a function that takes 0 arguments and returns no values.
+// Func10 is a function that takes 10 arguments and returns no values.
 type Func10[P0, P1, P2, P3, P4, P5, P6, P7, P8, P9 any] func(p0 P0, p1 P1, p2 P2, p3 P3, p4 P4, p5 P5, p6 P6, p7 P7, p8 P8, p9 P9)
 
 // Exec executes the Function.
@@ -20,10 +21,15 @@ func (f Func10[P0, P1, P2, P3, P4, P5, P6, P7, P8, P9]) Exec(p0 P0, p1 P1, p2 P2
 // Timing returns a Func10 that will log the execution time of the Func10.
 // If no loggers are provided, the default logger (fmt.Println) will be used.
 func (f Func10[P0, P1, P2, P3, P4, P5, P6, P7, P8, P9]) Timing(loggers ...func(d time.Duration)) Func10[P0, P1, P2, P3, P4, P5, P6, P7, P8, P9] {
+	return f.TimingWithClock(RealClock(), loggers...)
+}
+
+// TimingWithClock is like Timing, but measures the execution time with clock.
+func (f Func10[P0, P1, P2, P3, P4, P5, P6, P7, P8, P9]) TimingWithClock(clock Clock, loggers ...func(d time.Duration)) Func10[P0, P1, P2, P3, P4, P5, P6, P7, P8, P9] {
 	return func(p0 P0, p1 P1, p2 P2, p3 P3, p4 P4, p5 P5, p6 P6, p7 P7, p8 P8, p9 P9) {
-		start := time.Now()
+		start := clock.Now()
 		defer func() {
-			dur := time.Since(start)
+			dur := clock.Since(start)
 			for _, logger := range loggers {
 				logger(dur)
 			}
@@ -46,73 +52,124 @@ func (f Func10[P0, P1, P2, P3, P4, P5, P6, P7, P8, P9]) Fallible() Func10Error[P
 	}
 }
 
+// Debounce returns a Func10 that delays its execution until wait has elapsed
+// since the last call, along with the Debouncer controlling it.
+// Only the most recent call is kept and eventually invoked.
+func (f Func10[P0, P1, P2, P3, P4, P5, P6, P7, P8, P9]) Debounce(wait time.Duration, opts ...DebounceOption) (Func10[P0, P1, P2, P3, P4, P5, P6, P7, P8, P9], *Debouncer) {
+	d := newDebouncer(wait, opts...)
+	return func(p0 P0, p1 P1, p2 P2, p3 P3, p4 P4, p5 P5, p6 P6, p7 P7, p8 P8, p9 P9) {
+		d.call(func() {
+			f(p0, p1, p2, p3, p4, p5, p6, p7, p8, p9)
+		})
+	}, d
+}
+
+// Throttle returns a Func10 that executes at most once per interval, along
+// with the Debouncer controlling it.
+// The first call is invoked immediately, and the most recent call made
+// during the interval is invoked at the end of it.
+// The options, e.g. DebounceClock, apply to the underlying Debouncer.
+func (f Func10[P0, P1, P2, P3, P4, P5, P6, P7, P8, P9]) Throttle(interval time.Duration, opts ...DebounceOption) (Func10[P0, P1, P2, P3, P4, P5, P6, P7, P8, P9], *Debouncer) {
+	d := newThrottler(interval, opts...)
+	return func(p0 P0, p1 P1, p2 P2, p3 P3, p4 P4, p5 P5, p6 P6, p7 P7, p8 P8, p9 P9) {
+		d.call(func() {
+			f(p0, p1, p2, p3, p4, p5, p6, p7, p8, p9)
+		})
+	}, d
+}
+
+// Once returns a Func10 that only executes the first time it is called,
+// along with the Once controlling it.
+func (f Func10[P0, P1, P2, P3, P4, P5, P6, P7, P8, P9]) Once(opts ...OnceOption) (Func10[P0, P1, P2, P3, P4, P5, P6, P7, P8, P9], *Once) {
+	o := newOnce(opts...)
+	return func(p0 P0, p1 P1, p2 P2, p3 P3, p4 P4, p5 P5, p6 P6, p7 P7, p8 P8, p9 P9) {
+		_, _ = o.do(context.Background(), false, func() (any, error) {
+			f(p0, p1, p2, p3, p4, p5, p6, p7, p8, p9)
+			return nil, nil
+		})
+	}, o
+}
+
+// Lazy returns a Func10 that executes the first time it is called,
+// along with the Once controlling it.
+// A Func10 cannot fail, so Lazy behaves like Once unless it panics, in which
+// case the next call executes it again.
+func (f Func10[P0, P1, P2, P3, P4, P5, P6, P7, P8, P9]) Lazy(opts ...OnceOption) (Func10[P0, P1, P2, P3, P4, P5, P6, P7, P8, P9], *Once) {
+	o := newLazy(opts...)
+	return func(p0 P0, p1 P1, p2 P2, p3 P3, p4 P4, p5 P5, p6 P6, p7 P7, p8 P8, p9 P9) {
+		_, _ = o.do(context.Background(), false, func() (any, error) {
+			f(p0, p1, p2, p3, p4, p5, p6, p7, p8, p9)
+			return nil, nil
+		})
+	}, o
+}
 
+// Curry10 returns a Func with the first 10 arguments of the Func10 bound.
 func (f Func10[P0, P1, P2, P3, P4, P5, P6, P7, P8, P9]) Curry10(p0 P0, p1 P1, p2 P2, p3 P3, p4 P4, p5 P5, p6 P6, p7 P7, p8 P8, p9 P9) Func {
-	return func()  {
+	return func() {
 		f(p0, p1, p2, p3, p4, p5, p6, p7, p8, p9)
 	}
 }
-	
 
+// Curry9 returns a Func1[P9] with the first 9 arguments of the Func10 bound.
 func (f Func10[P0, P1, P2, P3, P4, P5, P6, P7, P8, P9]) Curry9(p0 P0, p1 P1, p2 P2, p3 P3, p4 P4, p5 P5, p6 P6, p7 P7, p8 P8) Func1[P9] {
-	return func(p9 P9)  {
+	return func(p9 P9) {
 		f(p0, p1, p2, p3, p4, p5, p6, p7, p8, p9)
 	}
 }
-	
 
+// Curry8 returns a Func2[P8, P9] with the first 8 arguments of the Func10 bound.
 func (f Func10[P0, P1, P2, P3, P4, P5, P6, P7, P8, P9]) Curry8(p0 P0, p1 P1, p2 P2, p3 P3, p4 P4, p5 P5, p6 P6, p7 P7) Func2[P8, P9] {
-	return func(p8 P8, p9 P9)  {
+	return func(p8 P8, p9 P9) {
 		f(p0, p1, p2, p3, p4, p5, p6, p7, p8, p9)
 	}
 }
-	
 
+// Curry7 returns a Func3[P7, P8, P9] with the first 7 arguments of the Func10 bound.
 func (f Func10[P0, P1, P2, P3, P4, P5, P6, P7, P8, P9]) Curry7(p0 P0, p1 P1, p2 P2, p3 P3, p4 P4, p5 P5, p6 P6) Func3[P7, P8, P9] {
-	return func(p7 P7, p8 P8, p9 P9)  {
+	return func(p7 P7, p8 P8, p9 P9) {
 		f(p0, p1, p2, p3, p4, p5, p6, p7, p8, p9)
 	}
 }
-	
 
+// Curry6 returns a Func4[P6, P7, P8, P9] with the first 6 arguments of the Func10 bound.
 func (f Func10[P0, P1, P2, P3, P4, P5, P6, P7, P8, P9]) Curry6(p0 P0, p1 P1, p2 P2, p3 P3, p4 P4, p5 P5) Func4[P6, P7, P8, P9] {
-	return func(p6 P6, p7 P7, p8 P8, p9 P9)  {
+	return func(p6 P6, p7 P7, p8 P8, p9 P9) {
 		f(p0, p1, p2, p3, p4, p5, p6, p7, p8, p9)
 	}
 }
-	
 
+// Curry5 returns a Func5[P5, P6, P7, P8, P9] with the first 5 arguments of the Func10 bound.
 func (f Func10[P0, P1, P2, P3, P4, P5, P6, P7, P8, P9]) Curry5(p0 P0, p1 P1, p2 P2, p3 P3, p4 P4) Func5[P5, P6, P7, P8, P9] {
-	return func(p5 P5, p6 P6, p7 P7, p8 P8, p9 P9)  {
+	return func(p5 P5, p6 P6, p7 P7, p8 P8, p9 P9) {
 		f(p0, p1, p2, p3, p4, p5, p6, p7, p8, p9)
 	}
 }
-	
 
+// Curry4 returns a Func6[P4, P5, P6, P7, P8, P9] with the first 4 arguments of the Func10 bound.
 func (f Func10[P0, P1, P2, P3, P4, P5, P6, P7, P8, P9]) Curry4(p0 P0, p1 P1, p2 P2, p3 P3) Func6[P4, P5, P6, P7, P8, P9] {
-	return func(p4 P4, p5 P5, p6 P6, p7 P7, p8 P8, p9 P9)  {
+	return func(p4 P4, p5 P5, p6 P6, p7 P7, p8 P8, p9 P9) {
 		f(p0, p1, p2, p3, p4, p5, p6, p7, p8, p9)
 	}
 }
-	
 
+// Curry3 returns a Func7[P3, P4, P5, P6, P7, P8, P9] with the first 3 arguments of the Func10 bound.
 func (f Func10[P0, P1, P2, P3, P4, P5, P6, P7, P8, P9]) Curry3(p0 P0, p1 P1, p2 P2) Func7[P3, P4, P5, P6, P7, P8, P9] {
-	return func(p3 P3, p4 P4, p5 P5, p6 P6, p7 P7, p8 P8, p9 P9)  {
+	return func(p3 P3, p4 P4, p5 P5, p6 P6, p7 P7, p8 P8, p9 P9) {
 		f(p0, p1, p2, p3, p4, p5, p6, p7, p8, p9)
 	}
 }
-	
 
+// Curry2 returns a Func8[P2, P3, P4, P5, P6, P7, P8, P9] with the first 2 arguments of the Func10 bound.
 func (f Func10[P0, P1, P2, P3, P4, P5, P6, P7, P8, P9]) Curry2(p0 P0, p1 P1) Func8[P2, P3, P4, P5, P6, P7, P8, P9] {
-	return func(p2 P2, p3 P3, p4 P4, p5 P5, p6 P6, p7 P7, p8 P8, p9 P9)  {
+	return func(p2 P2, p3 P3, p4 P4, p5 P5, p6 P6, p7 P7, p8 P8, p9 P9) {
 		f(p0, p1, p2, p3, p4, p5, p6, p7, p8, p9)
 	}
 }
-	
 
+// Curry1 returns a Func9[P1, P2, P3, P4, P5, P6, P7, P8, P9] with the first argument of the Func10 bound.
 func (f Func10[P0, P1, P2, P3, P4, P5, P6, P7, P8, P9]) Curry1(p0 P0) Func9[P1, P2, P3, P4, P5, P6, P7, P8, P9] {
-	return func(p1 P1, p2 P2, p3 P3, p4 P4, p5 P5, p6 P6, p7 P7, p8 P8, p9 P9)  {
+	return func(p1 P1, p2 P2, p3 P3, p4 P4, p5 P5, p6 P6, p7 P7, p8 P8, p9 P9) {
 		f(p0, p1, p2, p3, p4, p5, p6, p7, p8, p9)
 	}
 }
-	
\ No newline at end of file
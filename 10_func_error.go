@@ -1,11 +1,14 @@
+// Code generated by powerfunc/generator from func_error.go.tmpl. DO NOT EDIT.
+
 package powerfunc
 
 import (
+	"context"
 	"fmt"
 	"time"
 )
 
-// Func10Error is a function that takes 0 arguments and returns an error.
+// Func10Error is a function that takes 10 arguments and returns an error.
 type Func10Error[P0, P1, P2, P3, P4, P5, P6, P7, P8, P9 any] func(p0 P0, p1 P1, p2 P2, p3 P3, p4 P4, p5 P5, p6 P6, p7 P7, p8 P8, p9 P9) error
 
 // Exec executes the function and returns the error.
@@ -15,13 +18,18 @@ func (f Func10Error[P0, P1, P2, P3, P4, P5, P6, P7, P8, P9]) Exec(p0 P0, p1 P1,
 	return f(p0, p1, p2, p3, p4, p5, p6, p7, p8, p9)
 }
 
-// Timing returns a Func10 that will log the execution time of the Func10.
+// Timing returns a Func10Error that will log the execution time of the Func10Error.
 // If no loggers are provided, the default logger (fmt.Println) will be used.
 func (f Func10Error[P0, P1, P2, P3, P4, P5, P6, P7, P8, P9]) Timing(loggers ...func(d time.Duration)) Func10Error[P0, P1, P2, P3, P4, P5, P6, P7, P8, P9] {
+	return f.TimingWithClock(RealClock(), loggers...)
+}
+
+// TimingWithClock is like Timing, but measures the execution time with clock.
+func (f Func10Error[P0, P1, P2, P3, P4, P5, P6, P7, P8, P9]) TimingWithClock(clock Clock, loggers ...func(d time.Duration)) Func10Error[P0, P1, P2, P3, P4, P5, P6, P7, P8, P9] {
 	return func(p0 P0, p1 P1, p2 P2, p3 P3, p4 P4, p5 P5, p6 P6, p7 P7, p8 P8, p9 P9) error {
-		start := time.Now()
+		start := clock.Now()
 		defer func() {
-			dur := time.Since(start)
+			dur := clock.Since(start)
 			for _, logger := range loggers {
 				logger(dur)
 			}
@@ -34,20 +42,15 @@ func (f Func10Error[P0, P1, P2, P3, P4, P5, P6, P7, P8, P9]) Timing(loggers ...f
 	}
 }
 
-// Retry returns a Function that will retry the Function until it returns
-// a nil error or the tryAgain function returns false.
-func (f Func10Error[P0, P1, P2, P3, P4, P5, P6, P7, P8, P9]) Retry(tryAgain func(attempts int, err error) bool) Func10Error[P0, P1, P2, P3, P4, P5, P6, P7, P8, P9] {
+// Retry returns a Func10Error that will retry the Func10Error until it returns
+// a nil error or the tryAgain function returns false, in which case it
+// returns a *RetryError holding the errors of all the attempts.
+// The options set the backoff between attempts and hooks, e.g. OnRetry.
+func (f Func10Error[P0, P1, P2, P3, P4, P5, P6, P7, P8, P9]) Retry(tryAgain func(attempts int, err error) bool, opts ...RetryOption) Func10Error[P0, P1, P2, P3, P4, P5, P6, P7, P8, P9] {
 	return func(p0 P0, p1 P1, p2 P2, p3 P3, p4 P4, p5 P5, p6 P6, p7 P7, p8 P8, p9 P9) error {
-		var err error
-		attempts := 1
-		for {
-			err = f(p0, p1, p2, p3, p4, p5, p6, p7, p8, p9)
-			if err == nil || !tryAgain(attempts, err) {
-				break
-			}
-			attempts++
-		}
-		return err
+		return retryNoContext(tryAgain, opts, func() error {
+			return f(p0, p1, p2, p3, p4, p5, p6, p7, p8, p9)
+		})
 	}
 }
 
@@ -72,73 +75,131 @@ func (f Func10Error[P0, P1, P2, P3, P4, P5, P6, P7, P8, P9]) OnErr(msg string) F
 	}
 }
 
+// Once returns a Func10Error that only executes the first time it is called
+// and returns the cached error afterwards, along with the Once controlling it.
+// Use OnceRetryOnError or OnceBackoff to only cache a success.
+func (f Func10Error[P0, P1, P2, P3, P4, P5, P6, P7, P8, P9]) Once(opts ...OnceOption) (Func10Error[P0, P1, P2, P3, P4, P5, P6, P7, P8, P9], *Once) {
+	o := newOnce(opts...)
+	return func(p0 P0, p1 P1, p2 P2, p3 P3, p4 P4, p5 P5, p6 P6, p7 P7, p8 P8, p9 P9) error {
+		_, err := o.do(context.Background(), false, func() (any, error) {
+			return nil, f(p0, p1, p2, p3, p4, p5, p6, p7, p8, p9)
+		})
+		return err
+	}, o
+}
+
+// Lazy returns a Func10Error that executes until it succeeds once, along
+// with the Once controlling it.
+// Failures are not cached, unless a backoff is provided with OnceBackoff.
+func (f Func10Error[P0, P1, P2, P3, P4, P5, P6, P7, P8, P9]) Lazy(opts ...OnceOption) (Func10Error[P0, P1, P2, P3, P4, P5, P6, P7, P8, P9], *Once) {
+	o := newLazy(opts...)
+	return func(p0 P0, p1 P1, p2 P2, p3 P3, p4 P4, p5 P5, p6 P6, p7 P7, p8 P8, p9 P9) error {
+		_, err := o.do(context.Background(), false, func() (any, error) {
+			return nil, f(p0, p1, p2, p3, p4, p5, p6, p7, p8, p9)
+		})
+		return err
+	}, o
+}
+
+// InjectFaults returns a Func10Error injecting the errors, panics and latency
+// configured by cfg in its calls, to test how the callers cope with them.
+// See FaultConfig, and DisableFaults for the global kill-switch.
+// The faults cannot be enabled per request with OptIn, as there is no
+// context.
+func (f Func10Error[P0, P1, P2, P3, P4, P5, P6, P7, P8, P9]) InjectFaults(cfg FaultConfig) Func10Error[P0, P1, P2, P3, P4, P5, P6, P7, P8, P9] {
+	fi := newFaultInjector(cfg)
+	return func(p0 P0, p1 P1, p2 P2, p3 P3, p4 P4, p5 P5, p6 P6, p7 P7, p8 P8, p9 P9) error {
+		if err := fi.inject(context.Background(), p0, p1, p2, p3, p4, p5, p6, p7, p8, p9); err != nil {
+			return err
+		}
+		return f(p0, p1, p2, p3, p4, p5, p6, p7, p8, p9)
+	}
+}
+
+// WithTimeout returns a Func10Error that runs the Func10Error in its own
+// goroutine and returns a *TimeoutError, matching ErrTimeout, if it does not
+// return within timeout. The goroutine is then abandoned: it keeps running
+// until the Func10Error returns, and is counted by Abandoned.
+// Prefer the WithTimeout method of a function taking a context, which can
+// actually be cancelled.
+func (f Func10Error[P0, P1, P2, P3, P4, P5, P6, P7, P8, P9]) WithTimeout(timeout time.Duration, opts ...TimeoutOption) Func10Error[P0, P1, P2, P3, P4, P5, P6, P7, P8, P9] {
+	return func(p0 P0, p1 P1, p2 P2, p3 P3, p4 P4, p5 P5, p6 P6, p7 P7, p8 P8, p9 P9) error {
+		var err error
+		if terr := callWithTimeout(timeout, opts, func() {
+			err = f(p0, p1, p2, p3, p4, p5, p6, p7, p8, p9)
+		}); terr != nil {
+			return terr
+		}
+		return err
+	}
+}
 
+// Curry10 returns a FuncError with the first 10 arguments of the Func10Error bound.
 func (f Func10Error[P0, P1, P2, P3, P4, P5, P6, P7, P8, P9]) Curry10(p0 P0, p1 P1, p2 P2, p3 P3, p4 P4, p5 P5, p6 P6, p7 P7, p8 P8, p9 P9) FuncError {
 	return func() error {
 		return f(p0, p1, p2, p3, p4, p5, p6, p7, p8, p9)
 	}
 }
-	
 
+// Curry9 returns a Func1Error[P9] with the first 9 arguments of the Func10Error bound.
 func (f Func10Error[P0, P1, P2, P3, P4, P5, P6, P7, P8, P9]) Curry9(p0 P0, p1 P1, p2 P2, p3 P3, p4 P4, p5 P5, p6 P6, p7 P7, p8 P8) Func1Error[P9] {
 	return func(p9 P9) error {
 		return f(p0, p1, p2, p3, p4, p5, p6, p7, p8, p9)
 	}
 }
-	
 
+// Curry8 returns a Func2Error[P8, P9] with the first 8 arguments of the Func10Error bound.
 func (f Func10Error[P0, P1, P2, P3, P4, P5, P6, P7, P8, P9]) Curry8(p0 P0, p1 P1, p2 P2, p3 P3, p4 P4, p5 P5, p6 P6, p7 P7) Func2Error[P8, P9] {
 	return func(p8 P8, p9 P9) error {
 		return f(p0, p1, p2, p3, p4, p5, p6, p7, p8, p9)
 	}
 }
-	
 
+// Curry7 returns a Func3Error[P7, P8, P9] with the first 7 arguments of the Func10Error bound.
 func (f Func10Error[P0, P1, P2, P3, P4, P5, P6, P7, P8, P9]) Curry7(p0 P0, p1 P1, p2 P2, p3 P3, p4 P4, p5 P5, p6 P6) Func3Error[P7, P8, P9] {
 	return func(p7 P7, p8 P8, p9 P9) error {
 		return f(p0, p1, p2, p3, p4, p5, p6, p7, p8, p9)
 	}
 }
-	
 
+// Curry6 returns a Func4Error[P6, P7, P8, P9] with the first 6 arguments of the Func10Error bound.
 func (f Func10Error[P0, P1, P2, P3, P4, P5, P6, P7, P8, P9]) Curry6(p0 P0, p1 P1, p2 P2, p3 P3, p4 P4, p5 P5) Func4Error[P6, P7, P8, P9] {
 	return func(p6 P6, p7 P7, p8 P8, p9 P9) error {
 		return f(p0, p1, p2, p3, p4, p5, p6, p7, p8, p9)
 	}
 }
-	
 
+// Curry5 returns a Func5Error[P5, P6, P7, P8, P9] with the first 5 arguments of the Func10Error bound.
 func (f Func10Error[P0, P1, P2, P3, P4, P5, P6, P7, P8, P9]) Curry5(p0 P0, p1 P1, p2 P2, p3 P3, p4 P4) Func5Error[P5, P6, P7, P8, P9] {
 	return func(p5 P5, p6 P6, p7 P7, p8 P8, p9 P9) error {
 		return f(p0, p1, p2, p3, p4, p5, p6, p7, p8, p9)
 	}
 }
-	
 
+// Curry4 returns a Func6Error[P4, P5, P6, P7, P8, P9] with the first 4 arguments of the Func10Error bound.
 func (f Func10Error[P0, P1, P2, P3, P4, P5, P6, P7, P8, P9]) Curry4(p0 P0, p1 P1, p2 P2, p3 P3) Func6Error[P4, P5, P6, P7, P8, P9] {
 	return func(p4 P4, p5 P5, p6 P6, p7 P7, p8 P8, p9 P9) error {
 		return f(p0, p1, p2, p3, p4, p5, p6, p7, p8, p9)
 	}
 }
-	
 
+// Curry3 returns a Func7Error[P3, P4, P5, P6, P7, P8, P9] with the first 3 arguments of the Func10Error bound.
 func (f Func10Error[P0, P1, P2, P3, P4, P5, P6, P7, P8, P9]) Curry3(p0 P0, p1 P1, p2 P2) Func7Error[P3, P4, P5, P6, P7, P8, P9] {
 	return func(p3 P3, p4 P4, p5 P5, p6 P6, p7 P7, p8 P8, p9 P9) error {
 		return f(p0, p1, p2, p3, p4, p5, p6, p7, p8, p9)
 	}
 }
-	
 
+// Curry2 returns a Func8Error[P2, P3, P4, P5, P6, P7, P8, P9] with the first 2 arguments of the Func10Error bound.
 func (f Func10Error[P0, P1, P2, P3, P4, P5, P6, P7, P8, P9]) Curry2(p0 P0, p1 P1) Func8Error[P2, P3, P4, P5, P6, P7, P8, P9] {
 	return func(p2 P2, p3 P3, p4 P4, p5 P5, p6 P6, p7 P7, p8 P8, p9 P9) error {
 		return f(p0, p1, p2, p3, p4, p5, p6, p7, p8, p9)
 	}
 }
-	
 
+// Curry1 returns a Func9Error[P1, P2, P3, P4, P5, P6, P7, P8, P9] with the first argument of the Func10Error bound.
 func (f Func10Error[P0, P1, P2, P3, P4, P5, P6, P7, P8, P9]) Curry1(p0 P0) Func9Error[P1, P2, P3, P4, P5, P6, P7, P8, P9] {
 	return func(p1 P1, p2 P2, p3 P3, p4 P4, p5 P5, p6 P6, p7 P7, p8 P8, p9 P9) error {
 		return f(p0, p1, p2, p3, p4, p5, p6, p7, p8, p9)
 	}
 }
-	
\ No newline at end of file
@@ -0,0 +1,211 @@
+// Code generated by powerfunc/generator from func_ok.go.tmpl. DO NOT EDIT.
+
+package powerfunc
+
+import (
+	"fmt"
+	"time"
+)
+
+// Func10Ok is a function that takes 10 arguments and returns a value and a
+// boolean reporting whether the value is valid, like a map lookup.
+type Func10Ok[R, P0, P1, P2, P3, P4, P5, P6, P7, P8, P9 any] func(p0 P0, p1 P1, p2 P2, p3 P3, p4 P4, p5 P5, p6 P6, p7 P7, p8 P8, p9 P9) (R, bool)
+
+// Exec executes the function.
+// Convenience method to remove some of the confusion that
+// `f.SomeMethod()()` can bring.
+func (f Func10Ok[R, P0, P1, P2, P3, P4, P5, P6, P7, P8, P9]) Exec(p0 P0, p1 P1, p2 P2, p3 P3, p4 P4, p5 P5, p6 P6, p7 P7, p8 P8, p9 P9) (R, bool) {
+	return f(p0, p1, p2, p3, p4, p5, p6, p7, p8, p9)
+}
+
+// Timing returns a Func10Ok that will log the execution time of the Func10Ok.
+// If no loggers are provided, the default logger (fmt.Println) will be used.
+func (f Func10Ok[R, P0, P1, P2, P3, P4, P5, P6, P7, P8, P9]) Timing(loggers ...func(d time.Duration)) Func10Ok[R, P0, P1, P2, P3, P4, P5, P6, P7, P8, P9] {
+	return f.TimingWithClock(RealClock(), loggers...)
+}
+
+// TimingWithClock is like Timing, but measures the execution time with clock.
+func (f Func10Ok[R, P0, P1, P2, P3, P4, P5, P6, P7, P8, P9]) TimingWithClock(clock Clock, loggers ...func(d time.Duration)) Func10Ok[R, P0, P1, P2, P3, P4, P5, P6, P7, P8, P9] {
+	return func(p0 P0, p1 P1, p2 P2, p3 P3, p4 P4, p5 P5, p6 P6, p7 P7, p8 P8, p9 P9) (R, bool) {
+		start := clock.Now()
+		defer func() {
+			dur := clock.Since(start)
+			for _, logger := range loggers {
+				logger(dur)
+			}
+			if len(loggers) == 0 {
+				// Default logger
+				fmt.Println(dur)
+			}
+		}()
+		return f(p0, p1, p2, p3, p4, p5, p6, p7, p8, p9)
+	}
+}
+
+// Retry returns a Func10Ok that will retry the Func10Ok until it returns
+// true or the tryAgain function returns false.
+// The attempts that returned false fail with a placeholder error, given to
+// tryAgain and to the hooks of the options, e.g. OnRetry, so that any
+// RetryPolicy can be used.
+func (f Func10Ok[R, P0, P1, P2, P3, P4, P5, P6, P7, P8, P9]) Retry(tryAgain RetryPolicy, opts ...RetryOption) Func10Ok[R, P0, P1, P2, P3, P4, P5, P6, P7, P8, P9] {
+	return func(p0 P0, p1 P1, p2 P2, p3 P3, p4 P4, p5 P5, p6 P6, p7 P7, p8 P8, p9 P9) (R, bool) {
+		var v R
+		var ok bool
+		err := retryNoContext(tryAgain, opts, func() error {
+			v, ok = f(p0, p1, p2, p3, p4, p5, p6, p7, p8, p9)
+			if !ok {
+				return errNotOk
+			}
+			return nil
+		})
+		return v, err == nil
+	}
+}
+
+// Must returns a Func10Value that will panic if the Func10Ok returns false.
+func (f Func10Ok[R, P0, P1, P2, P3, P4, P5, P6, P7, P8, P9]) Must() Func10Value[R, P0, P1, P2, P3, P4, P5, P6, P7, P8, P9] {
+	return func(p0 P0, p1 P1, p2 P2, p3 P3, p4 P4, p5 P5, p6 P6, p7 P7, p8 P8, p9 P9) R {
+		v, ok := f(p0, p1, p2, p3, p4, p5, p6, p7, p8, p9)
+		if !ok {
+			panic("powerfunc: Func10Ok returned false")
+		}
+		return v
+	}
+}
+
+// Fallback returns a Func10Value that will return the provided value if the
+// Func10Ok returns false.
+func (f Func10Ok[R, P0, P1, P2, P3, P4, P5, P6, P7, P8, P9]) Fallback(val R) Func10Value[R, P0, P1, P2, P3, P4, P5, P6, P7, P8, P9] {
+	return func(p0 P0, p1 P1, p2 P2, p3 P3, p4 P4, p5 P5, p6 P6, p7 P7, p8 P8, p9 P9) R {
+		v, ok := f(p0, p1, p2, p3, p4, p5, p6, p7, p8, p9)
+		if !ok {
+			return val
+		}
+		return v
+	}
+}
+
+// OrElse returns a Func10Value that will return the provided value if the
+// Func10Ok returns false. It is an alias of Fallback.
+func (f Func10Ok[R, P0, P1, P2, P3, P4, P5, P6, P7, P8, P9]) OrElse(val R) Func10Value[R, P0, P1, P2, P3, P4, P5, P6, P7, P8, P9] {
+	return f.Fallback(val)
+}
+
+// OrElseGet returns a Func10Value that will call elsef if the Func10Ok
+// returns false.
+func (f Func10Ok[R, P0, P1, P2, P3, P4, P5, P6, P7, P8, P9]) OrElseGet(elsef Func10Value[R, P0, P1, P2, P3, P4, P5, P6, P7, P8, P9]) Func10Value[R, P0, P1, P2, P3, P4, P5, P6, P7, P8, P9] {
+	return func(p0 P0, p1 P1, p2 P2, p3 P3, p4 P4, p5 P5, p6 P6, p7 P7, p8 P8, p9 P9) R {
+		v, ok := f(p0, p1, p2, p3, p4, p5, p6, p7, p8, p9)
+		if !ok {
+			return elsef(p0, p1, p2, p3, p4, p5, p6, p7, p8, p9)
+		}
+		return v
+	}
+}
+
+// ToResult transforms a Func10Ok into a Func10Result returning the provided
+// error when the Func10Ok returns false.
+func (f Func10Ok[R, P0, P1, P2, P3, P4, P5, P6, P7, P8, P9]) ToResult(err error) Func10Result[R, P0, P1, P2, P3, P4, P5, P6, P7, P8, P9] {
+	return func(p0 P0, p1 P1, p2 P2, p3 P3, p4 P4, p5 P5, p6 P6, p7 P7, p8 P8, p9 P9) (R, error) {
+		v, ok := f(p0, p1, p2, p3, p4, p5, p6, p7, p8, p9)
+		if !ok {
+			return v, err
+		}
+		return v, nil
+	}
+}
+
+// Filter returns a Func10Ok that will return false if the value returned by
+// the Func10Ok does not satisfy keep.
+func (f Func10Ok[R, P0, P1, P2, P3, P4, P5, P6, P7, P8, P9]) Filter(keep func(R) bool) Func10Ok[R, P0, P1, P2, P3, P4, P5, P6, P7, P8, P9] {
+	return func(p0 P0, p1 P1, p2 P2, p3 P3, p4 P4, p5 P5, p6 P6, p7 P7, p8 P8, p9 P9) (R, bool) {
+		v, ok := f(p0, p1, p2, p3, p4, p5, p6, p7, p8, p9)
+		if !ok || !keep(v) {
+			var zero R
+			return zero, false
+		}
+		return v, true
+	}
+}
+
+// Map applies the provided function to the value returned by the Func10Ok,
+// if it returns true.
+func (f Func10Ok[R, P0, P1, P2, P3, P4, P5, P6, P7, P8, P9]) Map(fn func(R) R) Func10Ok[R, P0, P1, P2, P3, P4, P5, P6, P7, P8, P9] {
+	return func(p0 P0, p1 P1, p2 P2, p3 P3, p4 P4, p5 P5, p6 P6, p7 P7, p8 P8, p9 P9) (R, bool) {
+		v, ok := f(p0, p1, p2, p3, p4, p5, p6, p7, p8, p9)
+		if !ok {
+			return v, false
+		}
+		return fn(v), true
+	}
+}
+
+// Curry10 returns a FuncOk[R] with the first 10 arguments of the Func10Ok bound.
+func (f Func10Ok[R, P0, P1, P2, P3, P4, P5, P6, P7, P8, P9]) Curry10(p0 P0, p1 P1, p2 P2, p3 P3, p4 P4, p5 P5, p6 P6, p7 P7, p8 P8, p9 P9) FuncOk[R] {
+	return func() (R, bool) {
+		return f(p0, p1, p2, p3, p4, p5, p6, p7, p8, p9)
+	}
+}
+
+// Curry9 returns a Func1Ok[R, P9] with the first 9 arguments of the Func10Ok bound.
+func (f Func10Ok[R, P0, P1, P2, P3, P4, P5, P6, P7, P8, P9]) Curry9(p0 P0, p1 P1, p2 P2, p3 P3, p4 P4, p5 P5, p6 P6, p7 P7, p8 P8) Func1Ok[R, P9] {
+	return func(p9 P9) (R, bool) {
+		return f(p0, p1, p2, p3, p4, p5, p6, p7, p8, p9)
+	}
+}
+
+// Curry8 returns a Func2Ok[R, P8, P9] with the first 8 arguments of the Func10Ok bound.
+func (f Func10Ok[R, P0, P1, P2, P3, P4, P5, P6, P7, P8, P9]) Curry8(p0 P0, p1 P1, p2 P2, p3 P3, p4 P4, p5 P5, p6 P6, p7 P7) Func2Ok[R, P8, P9] {
+	return func(p8 P8, p9 P9) (R, bool) {
+		return f(p0, p1, p2, p3, p4, p5, p6, p7, p8, p9)
+	}
+}
+
+// Curry7 returns a Func3Ok[R, P7, P8, P9] with the first 7 arguments of the Func10Ok bound.
+func (f Func10Ok[R, P0, P1, P2, P3, P4, P5, P6, P7, P8, P9]) Curry7(p0 P0, p1 P1, p2 P2, p3 P3, p4 P4, p5 P5, p6 P6) Func3Ok[R, P7, P8, P9] {
+	return func(p7 P7, p8 P8, p9 P9) (R, bool) {
+		return f(p0, p1, p2, p3, p4, p5, p6, p7, p8, p9)
+	}
+}
+
+// Curry6 returns a Func4Ok[R, P6, P7, P8, P9] with the first 6 arguments of the Func10Ok bound.
+func (f Func10Ok[R, P0, P1, P2, P3, P4, P5, P6, P7, P8, P9]) Curry6(p0 P0, p1 P1, p2 P2, p3 P3, p4 P4, p5 P5) Func4Ok[R, P6, P7, P8, P9] {
+	return func(p6 P6, p7 P7, p8 P8, p9 P9) (R, bool) {
+		return f(p0, p1, p2, p3, p4, p5, p6, p7, p8, p9)
+	}
+}
+
+// Curry5 returns a Func5Ok[R, P5, P6, P7, P8, P9] with the first 5 arguments of the Func10Ok bound.
+func (f Func10Ok[R, P0, P1, P2, P3, P4, P5, P6, P7, P8, P9]) Curry5(p0 P0, p1 P1, p2 P2, p3 P3, p4 P4) Func5Ok[R, P5, P6, P7, P8, P9] {
+	return func(p5 P5, p6 P6, p7 P7, p8 P8, p9 P9) (R, bool) {
+		return f(p0, p1, p2, p3, p4, p5, p6, p7, p8, p9)
+	}
+}
+
+// Curry4 returns a Func6Ok[R, P4, P5, P6, P7, P8, P9] with the first 4 arguments of the Func10Ok bound.
+func (f Func10Ok[R, P0, P1, P2, P3, P4, P5, P6, P7, P8, P9]) Curry4(p0 P0, p1 P1, p2 P2, p3 P3) Func6Ok[R, P4, P5, P6, P7, P8, P9] {
+	return func(p4 P4, p5 P5, p6 P6, p7 P7, p8 P8, p9 P9) (R, bool) {
+		return f(p0, p1, p2, p3, p4, p5, p6, p7, p8, p9)
+	}
+}
+
+// Curry3 returns a Func7Ok[R, P3, P4, P5, P6, P7, P8, P9] with the first 3 arguments of the Func10Ok bound.
+func (f Func10Ok[R, P0, P1, P2, P3, P4, P5, P6, P7, P8, P9]) Curry3(p0 P0, p1 P1, p2 P2) Func7Ok[R, P3, P4, P5, P6, P7, P8, P9] {
+	return func(p3 P3, p4 P4, p5 P5, p6 P6, p7 P7, p8 P8, p9 P9) (R, bool) {
+		return f(p0, p1, p2, p3, p4, p5, p6, p7, p8, p9)
+	}
+}
+
+// Curry2 returns a Func8Ok[R, P2, P3, P4, P5, P6, P7, P8, P9] with the first 2 arguments of the Func10Ok bound.
+func (f Func10Ok[R, P0, P1, P2, P3, P4, P5, P6, P7, P8, P9]) Curry2(p0 P0, p1 P1) Func8Ok[R, P2, P3, P4, P5, P6, P7, P8, P9] {
+	return func(p2 P2, p3 P3, p4 P4, p5 P5, p6 P6, p7 P7, p8 P8, p9 P9) (R, bool) {
+		return f(p0, p1, p2, p3, p4, p5, p6, p7, p8, p9)
+	}
+}
+
+// Curry1 returns a Func9Ok[R, P1, P2, P3, P4, P5, P6, P7, P8, P9] with the first argument of the Func10Ok bound.
+func (f Func10Ok[R, P0, P1, P2, P3, P4, P5, P6, P7, P8, P9]) Curry1(p0 P0) Func9Ok[R, P1, P2, P3, P4, P5, P6, P7, P8, P9] {
+	return func(p1 P1, p2 P2, p3 P3, p4 P4, p5 P5, p6 P6, p7 P7, p8 P8, p9 P9) (R, bool) {
+		return f(p0, p1, p2, p3, p4, p5, p6, p7, p8, p9)
+	}
+}
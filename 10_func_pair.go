@@ -0,0 +1,122 @@
+// Code generated by powerfunc/generator from func_pair.go.tmpl. DO NOT EDIT.
+
+package powerfunc
+
+import (
+	"fmt"
+	"time"
+)
+
+// Func10Pair is a function that takes 10 arguments and returns two values.
+type Func10Pair[R1, R2, P0, P1, P2, P3, P4, P5, P6, P7, P8, P9 any] func(p0 P0, p1 P1, p2 P2, p3 P3, p4 P4, p5 P5, p6 P6, p7 P7, p8 P8, p9 P9) (R1, R2)
+
+// Exec executes the function.
+// Convenience method to remove some of the confusion that
+// `f.SomeMethod()()` can bring.
+func (f Func10Pair[R1, R2, P0, P1, P2, P3, P4, P5, P6, P7, P8, P9]) Exec(p0 P0, p1 P1, p2 P2, p3 P3, p4 P4, p5 P5, p6 P6, p7 P7, p8 P8, p9 P9) (R1, R2) {
+	return f(p0, p1, p2, p3, p4, p5, p6, p7, p8, p9)
+}
+
+// Timing returns a Func10Pair that will log the execution time of the Func10Pair.
+// If no loggers are provided, the default logger (fmt.Println) will be used.
+func (f Func10Pair[R1, R2, P0, P1, P2, P3, P4, P5, P6, P7, P8, P9]) Timing(loggers ...func(d time.Duration)) Func10Pair[R1, R2, P0, P1, P2, P3, P4, P5, P6, P7, P8, P9] {
+	return f.TimingWithClock(RealClock(), loggers...)
+}
+
+// TimingWithClock is like Timing, but measures the execution time with clock.
+func (f Func10Pair[R1, R2, P0, P1, P2, P3, P4, P5, P6, P7, P8, P9]) TimingWithClock(clock Clock, loggers ...func(d time.Duration)) Func10Pair[R1, R2, P0, P1, P2, P3, P4, P5, P6, P7, P8, P9] {
+	return func(p0 P0, p1 P1, p2 P2, p3 P3, p4 P4, p5 P5, p6 P6, p7 P7, p8 P8, p9 P9) (R1, R2) {
+		start := clock.Now()
+		defer func() {
+			dur := clock.Since(start)
+			for _, logger := range loggers {
+				logger(dur)
+			}
+			if len(loggers) == 0 {
+				// Default logger
+				fmt.Println(dur)
+			}
+		}()
+		return f(p0, p1, p2, p3, p4, p5, p6, p7, p8, p9)
+	}
+}
+
+// Fallible transforms a Func10Pair into a Func10PairResult.
+// The returned Func10PairResult will never return an error.
+// Useful when passing a Func10Pair to a function that expects a Func10PairResult.
+func (f Func10Pair[R1, R2, P0, P1, P2, P3, P4, P5, P6, P7, P8, P9]) Fallible() Func10PairResult[R1, R2, P0, P1, P2, P3, P4, P5, P6, P7, P8, P9] {
+	return func(p0 P0, p1 P1, p2 P2, p3 P3, p4 P4, p5 P5, p6 P6, p7 P7, p8 P8, p9 P9) (R1, R2, error) {
+		v1, v2 := f(p0, p1, p2, p3, p4, p5, p6, p7, p8, p9)
+		return v1, v2, nil
+	}
+}
+
+// Curry10 returns a FuncPair[R1, R2] with the first 10 arguments of the Func10Pair bound.
+func (f Func10Pair[R1, R2, P0, P1, P2, P3, P4, P5, P6, P7, P8, P9]) Curry10(p0 P0, p1 P1, p2 P2, p3 P3, p4 P4, p5 P5, p6 P6, p7 P7, p8 P8, p9 P9) FuncPair[R1, R2] {
+	return func() (R1, R2) {
+		return f(p0, p1, p2, p3, p4, p5, p6, p7, p8, p9)
+	}
+}
+
+// Curry9 returns a Func1Pair[R1, R2, P9] with the first 9 arguments of the Func10Pair bound.
+func (f Func10Pair[R1, R2, P0, P1, P2, P3, P4, P5, P6, P7, P8, P9]) Curry9(p0 P0, p1 P1, p2 P2, p3 P3, p4 P4, p5 P5, p6 P6, p7 P7, p8 P8) Func1Pair[R1, R2, P9] {
+	return func(p9 P9) (R1, R2) {
+		return f(p0, p1, p2, p3, p4, p5, p6, p7, p8, p9)
+	}
+}
+
+// Curry8 returns a Func2Pair[R1, R2, P8, P9] with the first 8 arguments of the Func10Pair bound.
+func (f Func10Pair[R1, R2, P0, P1, P2, P3, P4, P5, P6, P7, P8, P9]) Curry8(p0 P0, p1 P1, p2 P2, p3 P3, p4 P4, p5 P5, p6 P6, p7 P7) Func2Pair[R1, R2, P8, P9] {
+	return func(p8 P8, p9 P9) (R1, R2) {
+		return f(p0, p1, p2, p3, p4, p5, p6, p7, p8, p9)
+	}
+}
+
+// Curry7 returns a Func3Pair[R1, R2, P7, P8, P9] with the first 7 arguments of the Func10Pair bound.
+func (f Func10Pair[R1, R2, P0, P1, P2, P3, P4, P5, P6, P7, P8, P9]) Curry7(p0 P0, p1 P1, p2 P2, p3 P3, p4 P4, p5 P5, p6 P6) Func3Pair[R1, R2, P7, P8, P9] {
+	return func(p7 P7, p8 P8, p9 P9) (R1, R2) {
+		return f(p0, p1, p2, p3, p4, p5, p6, p7, p8, p9)
+	}
+}
+
+// Curry6 returns a Func4Pair[R1, R2, P6, P7, P8, P9] with the first 6 arguments of the Func10Pair bound.
+func (f Func10Pair[R1, R2, P0, P1, P2, P3, P4, P5, P6, P7, P8, P9]) Curry6(p0 P0, p1 P1, p2 P2, p3 P3, p4 P4, p5 P5) Func4Pair[R1, R2, P6, P7, P8, P9] {
+	return func(p6 P6, p7 P7, p8 P8, p9 P9) (R1, R2) {
+		return f(p0, p1, p2, p3, p4, p5, p6, p7, p8, p9)
+	}
+}
+
+// Curry5 returns a Func5Pair[R1, R2, P5, P6, P7, P8, P9] with the first 5 arguments of the Func10Pair bound.
+func (f Func10Pair[R1, R2, P0, P1, P2, P3, P4, P5, P6, P7, P8, P9]) Curry5(p0 P0, p1 P1, p2 P2, p3 P3, p4 P4) Func5Pair[R1, R2, P5, P6, P7, P8, P9] {
+	return func(p5 P5, p6 P6, p7 P7, p8 P8, p9 P9) (R1, R2) {
+		return f(p0, p1, p2, p3, p4, p5, p6, p7, p8, p9)
+	}
+}
+
+// Curry4 returns a Func6Pair[R1, R2, P4, P5, P6, P7, P8, P9] with the first 4 arguments of the Func10Pair bound.
+func (f Func10Pair[R1, R2, P0, P1, P2, P3, P4, P5, P6, P7, P8, P9]) Curry4(p0 P0, p1 P1, p2 P2, p3 P3) Func6Pair[R1, R2, P4, P5, P6, P7, P8, P9] {
+	return func(p4 P4, p5 P5, p6 P6, p7 P7, p8 P8, p9 P9) (R1, R2) {
+		return f(p0, p1, p2, p3, p4, p5, p6, p7, p8, p9)
+	}
+}
+
+// Curry3 returns a Func7Pair[R1, R2, P3, P4, P5, P6, P7, P8, P9] with the first 3 arguments of the Func10Pair bound.
+func (f Func10Pair[R1, R2, P0, P1, P2, P3, P4, P5, P6, P7, P8, P9]) Curry3(p0 P0, p1 P1, p2 P2) Func7Pair[R1, R2, P3, P4, P5, P6, P7, P8, P9] {
+	return func(p3 P3, p4 P4, p5 P5, p6 P6, p7 P7, p8 P8, p9 P9) (R1, R2) {
+		return f(p0, p1, p2, p3, p4, p5, p6, p7, p8, p9)
+	}
+}
+
+// Curry2 returns a Func8Pair[R1, R2, P2, P3, P4, P5, P6, P7, P8, P9] with the first 2 arguments of the Func10Pair bound.
+func (f Func10Pair[R1, R2, P0, P1, P2, P3, P4, P5, P6, P7, P8, P9]) Curry2(p0 P0, p1 P1) Func8Pair[R1, R2, P2, P3, P4, P5, P6, P7, P8, P9] {
+	return func(p2 P2, p3 P3, p4 P4, p5 P5, p6 P6, p7 P7, p8 P8, p9 P9) (R1, R2) {
+		return f(p0, p1, p2, p3, p4, p5, p6, p7, p8, p9)
+	}
+}
+
+// Curry1 returns a Func9Pair[R1, R2, P1, P2, P3, P4, P5, P6, P7, P8, P9] with the first argument of the Func10Pair bound.
+func (f Func10Pair[R1, R2, P0, P1, P2, P3, P4, P5, P6, P7, P8, P9]) Curry1(p0 P0) Func9Pair[R1, R2, P1, P2, P3, P4, P5, P6, P7, P8, P9] {
+	return func(p1 P1, p2 P2, p3 P3, p4 P4, p5 P5, p6 P6, p7 P7, p8 P8, p9 P9) (R1, R2) {
+		return f(p0, p1, p2, p3, p4, p5, p6, p7, p8, p9)
+	}
+}
@@ -1,27 +1,35 @@
+// Code generated by powerfunc/generator from func_value.go.tmpl. DO NOT EDIT.
+
 package powerfunc
 
 import (
+	"context"
 	"fmt"
 	"time"
 )
 
-// Func10 is a function that takes 0 arguments and returns a value.
-type Func10Value[T, P0, P1, P2, P3, P4, P5, P6, P7, P8, P9 any] func(p0 P0, p1 P1, p2 P2, p3 P3, p4 P4, p5 P5, p6 P6, p7 P7, p8 P8, p9 P9) T
+// Func10Value is a function that takes 10 arguments and returns a value.
+type Func10Value[R, P0, P1, P2, P3, P4, P5, P6, P7, P8, P9 any] func(p0 P0, p1 P1, p2 P2, p3 P3, p4 P4, p5 P5, p6 P6, p7 P7, p8 P8, p9 P9) R
 
 // Exec executes the function.
 // Convenience method to remove some of the confusion that
 // `f.SomeMethod()()` can bring.
-func (f Func10Value[T, P0, P1, P2, P3, P4, P5, P6, P7, P8, P9]) Exec(p0 P0, p1 P1, p2 P2, p3 P3, p4 P4, p5 P5, p6 P6, p7 P7, p8 P8, p9 P9) T {
+func (f Func10Value[R, P0, P1, P2, P3, P4, P5, P6, P7, P8, P9]) Exec(p0 P0, p1 P1, p2 P2, p3 P3, p4 P4, p5 P5, p6 P6, p7 P7, p8 P8, p9 P9) R {
 	return f(p0, p1, p2, p3, p4, p5, p6, p7, p8, p9)
 }
 
-// Timing returns a Func10 that will log the execution time of the Func10.
+// Timing returns a Func10Value that will log the execution time of the Func10Value.
 // If no loggers are provided, the default logger (fmt.Println) will be used.
-func (f Func10Value[T, P0, P1, P2, P3, P4, P5, P6, P7, P8, P9]) Timing(loggers ...func(d time.Duration)) Func10Value[T, P0, P1, P2, P3, P4, P5, P6, P7, P8, P9] {
-	return func(p0 P0, p1 P1, p2 P2, p3 P3, p4 P4, p5 P5, p6 P6, p7 P7, p8 P8, p9 P9) T {
-		start := time.Now()
+func (f Func10Value[R, P0, P1, P2, P3, P4, P5, P6, P7, P8, P9]) Timing(loggers ...func(d time.Duration)) Func10Value[R, P0, P1, P2, P3, P4, P5, P6, P7, P8, P9] {
+	return f.TimingWithClock(RealClock(), loggers...)
+}
+
+// TimingWithClock is like Timing, but measures the execution time with clock.
+func (f Func10Value[R, P0, P1, P2, P3, P4, P5, P6, P7, P8, P9]) TimingWithClock(clock Clock, loggers ...func(d time.Duration)) Func10Value[R, P0, P1, P2, P3, P4, P5, P6, P7, P8, P9] {
+	return func(p0 P0, p1 P1, p2 P2, p3 P3, p4 P4, p5 P5, p6 P6, p7 P7, p8 P8, p9 P9) R {
+		start := clock.Now()
 		defer func() {
-			dur := time.Since(start)
+			dur := clock.Since(start)
 			for _, logger := range loggers {
 				logger(dur)
 			}
@@ -37,79 +45,124 @@ func (f Func10Value[T, P0, P1, P2, P3, P4, P5, P6, P7, P8, P9]) Timing(loggers .
 // Fallible transforms a Func10Value into a Func10Result.
 // The returned Func10Result will never return an error.
 // Useful when passing a Func10Value to a function that expects a Func10Result.
-func (f Func10Value[T, P0, P1, P2, P3, P4, P5, P6, P7, P8, P9]) Fallible() Func10Result[T, P0, P1, P2, P3, P4, P5, P6, P7, P8, P9] {
-	return func(p0 P0, p1 P1, p2 P2, p3 P3, p4 P4, p5 P5, p6 P6, p7 P7, p8 P8, p9 P9) (T, error) {
+func (f Func10Value[R, P0, P1, P2, P3, P4, P5, P6, P7, P8, P9]) Fallible() Func10Result[R, P0, P1, P2, P3, P4, P5, P6, P7, P8, P9] {
+	return func(p0 P0, p1 P1, p2 P2, p3 P3, p4 P4, p5 P5, p6 P6, p7 P7, p8 P8, p9 P9) (R, error) {
 		return f(p0, p1, p2, p3, p4, p5, p6, p7, p8, p9), nil
 	}
 }
 
+// Once returns a Func10Value that only executes the first time it is called
+// and returns the cached value afterwards, along with the Once controlling it.
+func (f Func10Value[R, P0, P1, P2, P3, P4, P5, P6, P7, P8, P9]) Once(opts ...OnceOption) (Func10Value[R, P0, P1, P2, P3, P4, P5, P6, P7, P8, P9], *Once) {
+	o := newOnce(opts...)
+	return func(p0 P0, p1 P1, p2 P2, p3 P3, p4 P4, p5 P5, p6 P6, p7 P7, p8 P8, p9 P9) R {
+		v, _ := o.do(context.Background(), false, func() (any, error) {
+			return f(p0, p1, p2, p3, p4, p5, p6, p7, p8, p9), nil
+		})
+		val, _ := v.(R)
+		return val
+	}, o
+}
+
+// Lazy returns a Func10Value that computes its value the first time it is
+// called and returns the cached value afterwards, along with the Once
+// controlling it.
+func (f Func10Value[R, P0, P1, P2, P3, P4, P5, P6, P7, P8, P9]) Lazy(opts ...OnceOption) (Func10Value[R, P0, P1, P2, P3, P4, P5, P6, P7, P8, P9], *Once) {
+	o := newLazy(opts...)
+	return func(p0 P0, p1 P1, p2 P2, p3 P3, p4 P4, p5 P5, p6 P6, p7 P7, p8 P8, p9 P9) R {
+		v, _ := o.do(context.Background(), false, func() (any, error) {
+			return f(p0, p1, p2, p3, p4, p5, p6, p7, p8, p9), nil
+		})
+		val, _ := v.(R)
+		return val
+	}, o
+}
+
+// WithTimeout returns a Func10Result that runs the Func10Value in its own
+// goroutine and returns a *TimeoutError, matching ErrTimeout, if it does not
+// return within timeout. The goroutine is then abandoned: it keeps running
+// until the Func10Value returns, and is counted by Abandoned.
+// Prefer the WithTimeout method of a function taking a context, which can
+// actually be cancelled.
+func (f Func10Value[R, P0, P1, P2, P3, P4, P5, P6, P7, P8, P9]) WithTimeout(timeout time.Duration, opts ...TimeoutOption) Func10Result[R, P0, P1, P2, P3, P4, P5, P6, P7, P8, P9] {
+	return func(p0 P0, p1 P1, p2 P2, p3 P3, p4 P4, p5 P5, p6 P6, p7 P7, p8 P8, p9 P9) (R, error) {
+		var r0 R
+		if terr := callWithTimeout(timeout, opts, func() {
+			r0 = f(p0, p1, p2, p3, p4, p5, p6, p7, p8, p9)
+		}); terr != nil {
+			var zero R
+			return zero, terr
+		}
+		return r0, nil
+	}
+}
 
+// Curry10 returns a FuncValue[R] with the first 10 arguments of the Func10Value bound.
 func (f Func10Value[R, P0, P1, P2, P3, P4, P5, P6, P7, P8, P9]) Curry10(p0 P0, p1 P1, p2 P2, p3 P3, p4 P4, p5 P5, p6 P6, p7 P7, p8 P8, p9 P9) FuncValue[R] {
 	return func() R {
 		return f(p0, p1, p2, p3, p4, p5, p6, p7, p8, p9)
 	}
 }
-	
 
+// Curry9 returns a Func1Value[R, P9] with the first 9 arguments of the Func10Value bound.
 func (f Func10Value[R, P0, P1, P2, P3, P4, P5, P6, P7, P8, P9]) Curry9(p0 P0, p1 P1, p2 P2, p3 P3, p4 P4, p5 P5, p6 P6, p7 P7, p8 P8) Func1Value[R, P9] {
 	return func(p9 P9) R {
 		return f(p0, p1, p2, p3, p4, p5, p6, p7, p8, p9)
 	}
 }
-	
 
+// Curry8 returns a Func2Value[R, P8, P9] with the first 8 arguments of the Func10Value bound.
 func (f Func10Value[R, P0, P1, P2, P3, P4, P5, P6, P7, P8, P9]) Curry8(p0 P0, p1 P1, p2 P2, p3 P3, p4 P4, p5 P5, p6 P6, p7 P7) Func2Value[R, P8, P9] {
 	return func(p8 P8, p9 P9) R {
 		return f(p0, p1, p2, p3, p4, p5, p6, p7, p8, p9)
 	}
 }
-	
 
+// Curry7 returns a Func3Value[R, P7, P8, P9] with the first 7 arguments of the Func10Value bound.
 func (f Func10Value[R, P0, P1, P2, P3, P4, P5, P6, P7, P8, P9]) Curry7(p0 P0, p1 P1, p2 P2, p3 P3, p4 P4, p5 P5, p6 P6) Func3Value[R, P7, P8, P9] {
 	return func(p7 P7, p8 P8, p9 P9) R {
 		return f(p0, p1, p2, p3, p4, p5, p6, p7, p8, p9)
 	}
 }
-	
 
+// Curry6 returns a Func4Value[R, P6, P7, P8, P9] with the first 6 arguments of the Func10Value bound.
 func (f Func10Value[R, P0, P1, P2, P3, P4, P5, P6, P7, P8, P9]) Curry6(p0 P0, p1 P1, p2 P2, p3 P3, p4 P4, p5 P5) Func4Value[R, P6, P7, P8, P9] {
 	return func(p6 P6, p7 P7, p8 P8, p9 P9) R {
 		return f(p0, p1, p2, p3, p4, p5, p6, p7, p8, p9)
 	}
 }
-	
 
+// Curry5 returns a Func5Value[R, P5, P6, P7, P8, P9] with the first 5 arguments of the Func10Value bound.
 func (f Func10Value[R, P0, P1, P2, P3, P4, P5, P6, P7, P8, P9]) Curry5(p0 P0, p1 P1, p2 P2, p3 P3, p4 P4) Func5Value[R, P5, P6, P7, P8, P9] {
 	return func(p5 P5, p6 P6, p7 P7, p8 P8, p9 P9) R {
 		return f(p0, p1, p2, p3, p4, p5, p6, p7, p8, p9)
 	}
 }
-	
 
+// Curry4 returns a Func6Value[R, P4, P5, P6, P7, P8, P9] with the first 4 arguments of the Func10Value bound.
 func (f Func10Value[R, P0, P1, P2, P3, P4, P5, P6, P7, P8, P9]) Curry4(p0 P0, p1 P1, p2 P2, p3 P3) Func6Value[R, P4, P5, P6, P7, P8, P9] {
 	return func(p4 P4, p5 P5, p6 P6, p7 P7, p8 P8, p9 P9) R {
 		return f(p0, p1, p2, p3, p4, p5, p6, p7, p8, p9)
 	}
 }
-	
 
+// Curry3 returns a Func7Value[R, P3, P4, P5, P6, P7, P8, P9] with the first 3 arguments of the Func10Value bound.
 func (f Func10Value[R, P0, P1, P2, P3, P4, P5, P6, P7, P8, P9]) Curry3(p0 P0, p1 P1, p2 P2) Func7Value[R, P3, P4, P5, P6, P7, P8, P9] {
 	return func(p3 P3, p4 P4, p5 P5, p6 P6, p7 P7, p8 P8, p9 P9) R {
 		return f(p0, p1, p2, p3, p4, p5, p6, p7, p8, p9)
 	}
 }
-	
 
+// Curry2 returns a Func8Value[R, P2, P3, P4, P5, P6, P7, P8, P9] with the first 2 arguments of the Func10Value bound.
 func (f Func10Value[R, P0, P1, P2, P3, P4, P5, P6, P7, P8, P9]) Curry2(p0 P0, p1 P1) Func8Value[R, P2, P3, P4, P5, P6, P7, P8, P9] {
 	return func(p2 P2, p3 P3, p4 P4, p5 P5, p6 P6, p7 P7, p8 P8, p9 P9) R {
 		return f(p0, p1, p2, p3, p4, p5, p6, p7, p8, p9)
 	}
 }
-	
 
+// Curry1 returns a Func9Value[R, P1, P2, P3, P4, P5, P6, P7, P8, P9] with the first argument of the Func10Value bound.
 func (f Func10Value[R, P0, P1, P2, P3, P4, P5, P6, P7, P8, P9]) Curry1(p0 P0) Func9Value[R, P1, P2, P3, P4, P5, P6, P7, P8, P9] {
 	return func(p1 P1, p2 P2, p3 P3, p4 P4, p5 P5, p6 P6, p7 P7, p8 P8, p9 P9) R {
 		return f(p0, p1, p2, p3, p4, p5, p6, p7, p8, p9)
 	}
 }
-	
\ No newline at end of file
@@ -0,0 +1,181 @@
+// Code generated by powerfunc/generator from func_variadic_error.go.tmpl. DO NOT EDIT.
+
+package powerfunc
+
+import (
+	"context"
+	"fmt"
+	"time"
+)
+
+// Func10VariadicError is a function that takes a variable number of
+// arguments and returns an error.
+type Func10VariadicError[V, P0, P1, P2, P3, P4, P5, P6, P7, P8, P9 any] func(p0 P0, p1 P1, p2 P2, p3 P3, p4 P4, p5 P5, p6 P6, p7 P7, p8 P8, p9 P9, xs ...V) error
+
+// Exec executes the function and returns the error.
+// Convenience method to remove some of the confusion that
+// `f.SomeMethod()()` can bring.
+func (f Func10VariadicError[V, P0, P1, P2, P3, P4, P5, P6, P7, P8, P9]) Exec(p0 P0, p1 P1, p2 P2, p3 P3, p4 P4, p5 P5, p6 P6, p7 P7, p8 P8, p9 P9, xs ...V) error {
+	return f(p0, p1, p2, p3, p4, p5, p6, p7, p8, p9, xs...)
+}
+
+// Timing returns a Func10VariadicError that will log the execution time of the Func10VariadicError.
+// If no loggers are provided, the default logger (fmt.Println) will be used.
+func (f Func10VariadicError[V, P0, P1, P2, P3, P4, P5, P6, P7, P8, P9]) Timing(loggers ...func(d time.Duration)) Func10VariadicError[V, P0, P1, P2, P3, P4, P5, P6, P7, P8, P9] {
+	return f.TimingWithClock(RealClock(), loggers...)
+}
+
+// TimingWithClock is like Timing, but measures the execution time with clock.
+func (f Func10VariadicError[V, P0, P1, P2, P3, P4, P5, P6, P7, P8, P9]) TimingWithClock(clock Clock, loggers ...func(d time.Duration)) Func10VariadicError[V, P0, P1, P2, P3, P4, P5, P6, P7, P8, P9] {
+	return func(p0 P0, p1 P1, p2 P2, p3 P3, p4 P4, p5 P5, p6 P6, p7 P7, p8 P8, p9 P9, xs ...V) error {
+		start := clock.Now()
+		defer func() {
+			dur := clock.Since(start)
+			for _, logger := range loggers {
+				logger(dur)
+			}
+			if len(loggers) == 0 {
+				// Default logger
+				fmt.Println(dur)
+			}
+		}()
+		return f(p0, p1, p2, p3, p4, p5, p6, p7, p8, p9, xs...)
+	}
+}
+
+// Retry returns a Func10VariadicError that will retry the Func10VariadicError until it returns
+// a nil error or the tryAgain function returns false, in which case it
+// returns a *RetryError holding the errors of all the attempts.
+// The options set the backoff between attempts and hooks, e.g. OnRetry.
+func (f Func10VariadicError[V, P0, P1, P2, P3, P4, P5, P6, P7, P8, P9]) Retry(tryAgain func(attempts int, err error) bool, opts ...RetryOption) Func10VariadicError[V, P0, P1, P2, P3, P4, P5, P6, P7, P8, P9] {
+	return func(p0 P0, p1 P1, p2 P2, p3 P3, p4 P4, p5 P5, p6 P6, p7 P7, p8 P8, p9 P9, xs ...V) error {
+		return retryNoContext(tryAgain, opts, func() error {
+			return f(p0, p1, p2, p3, p4, p5, p6, p7, p8, p9, xs...)
+		})
+	}
+}
+
+// OnErr returns a Func10VariadicError that will wrap the error with the
+// provided message.
+func (f Func10VariadicError[V, P0, P1, P2, P3, P4, P5, P6, P7, P8, P9]) OnErr(msg string) Func10VariadicError[V, P0, P1, P2, P3, P4, P5, P6, P7, P8, P9] {
+	return func(p0 P0, p1 P1, p2 P2, p3 P3, p4 P4, p5 P5, p6 P6, p7 P7, p8 P8, p9 P9, xs ...V) error {
+		err := f(p0, p1, p2, p3, p4, p5, p6, p7, p8, p9, xs...)
+		if err != nil {
+			return fmt.Errorf("%s: %w", msg, err)
+		}
+		return nil
+	}
+}
+
+// WithTimeout returns a Func10VariadicError that runs the Func10VariadicError in its own
+// goroutine and returns a *TimeoutError, matching ErrTimeout, if it does not
+// return within timeout. The goroutine is then abandoned: it keeps running
+// until the Func10VariadicError returns, and is counted by Abandoned.
+// Prefer the WithTimeout method of a function taking a context, which can
+// actually be cancelled.
+func (f Func10VariadicError[V, P0, P1, P2, P3, P4, P5, P6, P7, P8, P9]) WithTimeout(timeout time.Duration, opts ...TimeoutOption) Func10VariadicError[V, P0, P1, P2, P3, P4, P5, P6, P7, P8, P9] {
+	return func(p0 P0, p1 P1, p2 P2, p3 P3, p4 P4, p5 P5, p6 P6, p7 P7, p8 P8, p9 P9, xs ...V) error {
+		var err error
+		if terr := callWithTimeout(timeout, opts, func() {
+			err = f(p0, p1, p2, p3, p4, p5, p6, p7, p8, p9, xs...)
+		}); terr != nil {
+			return terr
+		}
+		return err
+	}
+}
+
+// CurryVariadic returns a Func10VariadicError with some of its variadic
+// arguments bound. The bound arguments come before the ones passed to the
+// returned function.
+func (f Func10VariadicError[V, P0, P1, P2, P3, P4, P5, P6, P7, P8, P9]) CurryVariadic(bound ...V) Func10VariadicError[V, P0, P1, P2, P3, P4, P5, P6, P7, P8, P9] {
+	return func(p0 P0, p1 P1, p2 P2, p3 P3, p4 P4, p5 P5, p6 P6, p7 P7, p8 P8, p9 P9, xs ...V) error {
+		xs = append(append(make([]V, 0, len(bound)+len(xs)), bound...), xs...)
+		return f(p0, p1, p2, p3, p4, p5, p6, p7, p8, p9, xs...)
+	}
+}
+
+// InjectFaults returns a Func10VariadicError injecting the errors, panics and latency
+// configured by cfg in its calls, to test how the callers cope with them.
+// See FaultConfig, and DisableFaults for the global kill-switch.
+// The faults cannot be enabled per request with OptIn, as there is no
+// context.
+func (f Func10VariadicError[V, P0, P1, P2, P3, P4, P5, P6, P7, P8, P9]) InjectFaults(cfg FaultConfig) Func10VariadicError[V, P0, P1, P2, P3, P4, P5, P6, P7, P8, P9] {
+	fi := newFaultInjector(cfg)
+	return func(p0 P0, p1 P1, p2 P2, p3 P3, p4 P4, p5 P5, p6 P6, p7 P7, p8 P8, p9 P9, xs ...V) error {
+		if err := fi.inject(context.Background(), p0, p1, p2, p3, p4, p5, p6, p7, p8, p9, xs); err != nil {
+			return err
+		}
+		return f(p0, p1, p2, p3, p4, p5, p6, p7, p8, p9, xs...)
+	}
+}
+
+// Curry10 returns a FuncVariadicError[V] with the first 10 arguments of the Func10VariadicError bound.
+func (f Func10VariadicError[V, P0, P1, P2, P3, P4, P5, P6, P7, P8, P9]) Curry10(p0 P0, p1 P1, p2 P2, p3 P3, p4 P4, p5 P5, p6 P6, p7 P7, p8 P8, p9 P9) FuncVariadicError[V] {
+	return func(xs ...V) error {
+		return f(p0, p1, p2, p3, p4, p5, p6, p7, p8, p9, xs...)
+	}
+}
+
+// Curry9 returns a Func1VariadicError[V, P9] with the first 9 arguments of the Func10VariadicError bound.
+func (f Func10VariadicError[V, P0, P1, P2, P3, P4, P5, P6, P7, P8, P9]) Curry9(p0 P0, p1 P1, p2 P2, p3 P3, p4 P4, p5 P5, p6 P6, p7 P7, p8 P8) Func1VariadicError[V, P9] {
+	return func(p9 P9, xs ...V) error {
+		return f(p0, p1, p2, p3, p4, p5, p6, p7, p8, p9, xs...)
+	}
+}
+
+// Curry8 returns a Func2VariadicError[V, P8, P9] with the first 8 arguments of the Func10VariadicError bound.
+func (f Func10VariadicError[V, P0, P1, P2, P3, P4, P5, P6, P7, P8, P9]) Curry8(p0 P0, p1 P1, p2 P2, p3 P3, p4 P4, p5 P5, p6 P6, p7 P7) Func2VariadicError[V, P8, P9] {
+	return func(p8 P8, p9 P9, xs ...V) error {
+		return f(p0, p1, p2, p3, p4, p5, p6, p7, p8, p9, xs...)
+	}
+}
+
+// Curry7 returns a Func3VariadicError[V, P7, P8, P9] with the first 7 arguments of the Func10VariadicError bound.
+func (f Func10VariadicError[V, P0, P1, P2, P3, P4, P5, P6, P7, P8, P9]) Curry7(p0 P0, p1 P1, p2 P2, p3 P3, p4 P4, p5 P5, p6 P6) Func3VariadicError[V, P7, P8, P9] {
+	return func(p7 P7, p8 P8, p9 P9, xs ...V) error {
+		return f(p0, p1, p2, p3, p4, p5, p6, p7, p8, p9, xs...)
+	}
+}
+
+// Curry6 returns a Func4VariadicError[V, P6, P7, P8, P9] with the first 6 arguments of the Func10VariadicError bound.
+func (f Func10VariadicError[V, P0, P1, P2, P3, P4, P5, P6, P7, P8, P9]) Curry6(p0 P0, p1 P1, p2 P2, p3 P3, p4 P4, p5 P5) Func4VariadicError[V, P6, P7, P8, P9] {
+	return func(p6 P6, p7 P7, p8 P8, p9 P9, xs ...V) error {
+		return f(p0, p1, p2, p3, p4, p5, p6, p7, p8, p9, xs...)
+	}
+}
+
+// Curry5 returns a Func5VariadicError[V, P5, P6, P7, P8, P9] with the first 5 arguments of the Func10VariadicError bound.
+func (f Func10VariadicError[V, P0, P1, P2, P3, P4, P5, P6, P7, P8, P9]) Curry5(p0 P0, p1 P1, p2 P2, p3 P3, p4 P4) Func5VariadicError[V, P5, P6, P7, P8, P9] {
+	return func(p5 P5, p6 P6, p7 P7, p8 P8, p9 P9, xs ...V) error {
+		return f(p0, p1, p2, p3, p4, p5, p6, p7, p8, p9, xs...)
+	}
+}
+
+// Curry4 returns a Func6VariadicError[V, P4, P5, P6, P7, P8, P9] with the first 4 arguments of the Func10VariadicError bound.
+func (f Func10VariadicError[V, P0, P1, P2, P3, P4, P5, P6, P7, P8, P9]) Curry4(p0 P0, p1 P1, p2 P2, p3 P3) Func6VariadicError[V, P4, P5, P6, P7, P8, P9] {
+	return func(p4 P4, p5 P5, p6 P6, p7 P7, p8 P8, p9 P9, xs ...V) error {
+		return f(p0, p1, p2, p3, p4, p5, p6, p7, p8, p9, xs...)
+	}
+}
+
+// Curry3 returns a Func7VariadicError[V, P3, P4, P5, P6, P7, P8, P9] with the first 3 arguments of the Func10VariadicError bound.
+func (f Func10VariadicError[V, P0, P1, P2, P3, P4, P5, P6, P7, P8, P9]) Curry3(p0 P0, p1 P1, p2 P2) Func7VariadicError[V, P3, P4, P5, P6, P7, P8, P9] {
+	return func(p3 P3, p4 P4, p5 P5, p6 P6, p7 P7, p8 P8, p9 P9, xs ...V) error {
+		return f(p0, p1, p2, p3, p4, p5, p6, p7, p8, p9, xs...)
+	}
+}
+
+// Curry2 returns a Func8VariadicError[V, P2, P3, P4, P5, P6, P7, P8, P9] with the first 2 arguments of the Func10VariadicError bound.
+func (f Func10VariadicError[V, P0, P1, P2, P3, P4, P5, P6, P7, P8, P9]) Curry2(p0 P0, p1 P1) Func8VariadicError[V, P2, P3, P4, P5, P6, P7, P8, P9] {
+	return func(p2 P2, p3 P3, p4 P4, p5 P5, p6 P6, p7 P7, p8 P8, p9 P9, xs ...V) error {
+		return f(p0, p1, p2, p3, p4, p5, p6, p7, p8, p9, xs...)
+	}
+}
+
+// Curry1 returns a Func9VariadicError[V, P1, P2, P3, P4, P5, P6, P7, P8, P9] with the first argument of the Func10VariadicError bound.
+func (f Func10VariadicError[V, P0, P1, P2, P3, P4, P5, P6, P7, P8, P9]) Curry1(p0 P0) Func9VariadicError[V, P1, P2, P3, P4, P5, P6, P7, P8, P9] {
+	return func(p1 P1, p2 P2, p3 P3, p4 P4, p5 P5, p6 P6, p7 P7, p8 P8, p9 P9, xs ...V) error {
+		return f(p0, p1, p2, p3, p4, p5, p6, p7, p8, p9, xs...)
+	}
+}
@@ -1,3 +1,5 @@
+// Code generated by powerfunc/generator from ctx_func.go.tmpl. DO NOT EDIT.
+
 package powerfunc
 
 import (
@@ -13,10 +15,15 @@ func (f CtxFunc1[P0]) Exec(ctx context.Context, p0 P0) {
 }
 
 func (f CtxFunc1[P0]) Timing(loggers ...func(d time.Duration)) CtxFunc1[P0] {
+	return f.TimingWithClock(RealClock(), loggers...)
+}
+
+// TimingWithClock is like Timing, but measures the execution time with clock.
+func (f CtxFunc1[P0]) TimingWithClock(clock Clock, loggers ...func(d time.Duration)) CtxFunc1[P0] {
 	return func(ctx context.Context, p0 P0) {
-		start := time.Now()
+		start := clock.Now()
 		defer func() {
-			dur := time.Since(start)
+			dur := clock.Since(start)
 			for _, logger := range loggers {
 				logger(dur)
 			}
@@ -60,10 +67,122 @@ func (f CtxFunc1[P0]) WithCancel() CtxFunc1[P0] {
 	}
 }
 
+// WithValue returns a CtxFunc1 calling the CtxFunc1 with a context
+// carrying val for key, see context.WithValue.
+func (f CtxFunc1[P0]) WithValue(key, val any) CtxFunc1[P0] {
+	return func(ctx context.Context, p0 P0) {
+		ctx = context.WithValue(ctx, key, val)
+		f(ctx, p0)
+	}
+}
+
+// WithValueFrom returns a CtxFunc1 calling the CtxFunc1 with a context
+// carrying the value returned by value for its key. value gets the arguments
+// of every call.
+func (f CtxFunc1[P0]) WithValueFrom(value func(ctx context.Context, p0 P0) (key, val any)) CtxFunc1[P0] {
+	return func(ctx context.Context, p0 P0) {
+		key, val := value(ctx, p0)
+		ctx = context.WithValue(ctx, key, val)
+		f(ctx, p0)
+	}
+}
+
+// Detached returns a CtxFunc1 calling the CtxFunc1 with a context that is
+// never cancelled but keeps the values of the caller's context, see
+// context.WithoutCancel. Useful for cleanups that must run to completion.
+func (f CtxFunc1[P0]) Detached() CtxFunc1[P0] {
+	return func(ctx context.Context, p0 P0) {
+		ctx = context.WithoutCancel(ctx)
+		f(ctx, p0)
+	}
+}
+
+// WithTimeoutCause is like WithTimeout, but the context is cancelled with
+// cause when the timeout expires, see context.WithTimeoutCause.
+func (f CtxFunc1[P0]) WithTimeoutCause(timeout time.Duration, cause error) CtxFunc1[P0] {
+	return func(ctx context.Context, p0 P0) {
+		ctx, cancel := context.WithTimeoutCause(ctx, timeout, cause)
+		defer cancel()
+		f(ctx, p0)
+	}
+}
+
+// WithDeadlineCause is like WithDeadline, but the context is cancelled with
+// cause when the deadline expires, see context.WithDeadlineCause.
+func (f CtxFunc1[P0]) WithDeadlineCause(deadline time.Time, cause error) CtxFunc1[P0] {
+	return func(ctx context.Context, p0 P0) {
+		ctx, cancel := context.WithDeadlineCause(ctx, deadline, cause)
+		defer cancel()
+		f(ctx, p0)
+	}
+}
+
+// Debounce returns a CtxFunc1 that delays its execution until wait has elapsed
+// since the last call, along with the Debouncer controlling it.
+// Only the most recent call, and its context, is kept and eventually invoked.
+func (f CtxFunc1[P0]) Debounce(wait time.Duration, opts ...DebounceOption) (CtxFunc1[P0], *Debouncer) {
+	d := newDebouncer(wait, opts...)
+	return func(ctx context.Context, p0 P0) {
+		d.call(func() {
+			f(ctx, p0)
+		})
+	}, d
+}
+
+// Throttle returns a CtxFunc1 that executes at most once per interval, along
+// with the Debouncer controlling it.
+// The first call is invoked immediately, and the most recent call made
+// during the interval is invoked at the end of it.
+// The options, e.g. DebounceClock, apply to the underlying Debouncer.
+func (f CtxFunc1[P0]) Throttle(interval time.Duration, opts ...DebounceOption) (CtxFunc1[P0], *Debouncer) {
+	d := newThrottler(interval, opts...)
+	return func(ctx context.Context, p0 P0) {
+		d.call(func() {
+			f(ctx, p0)
+		})
+	}, d
+}
+
+// Once returns a CtxFunc1 that only executes the first time it is called,
+// along with the Once controlling it.
+// The shared execution does not inherit the cancellation of the caller's
+// context: a caller whose context is done returns without waiting for it,
+// while the execution carries on for the other callers.
+func (f CtxFunc1[P0]) Once(opts ...OnceOption) (CtxFunc1[P0], *Once) {
+	o := newOnce(opts...)
+	return func(ctx context.Context, p0 P0) {
+		_, _ = o.do(ctx, true, func() (any, error) {
+			ctx := context.WithoutCancel(ctx)
+			f(ctx, p0)
+			return nil, nil
+		})
+	}, o
+}
+
+// Lazy returns a CtxFunc1 that executes the first time it is called,
+// along with the Once controlling it.
+// A CtxFunc1 cannot fail, so Lazy behaves like Once unless it panics, in which
+// case the next call executes it again.
+func (f CtxFunc1[P0]) Lazy(opts ...OnceOption) (CtxFunc1[P0], *Once) {
+	o := newLazy(opts...)
+	return func(ctx context.Context, p0 P0) {
+		_, _ = o.do(ctx, true, func() (any, error) {
+			ctx := context.WithoutCancel(ctx)
+			f(ctx, p0)
+			return nil, nil
+		})
+	}, o
+}
+
+// Every returns a runner that calls the CtxFunc1 every interval until its
+// context is done. See CtxFunc1Error.Every for the available options.
+func (f CtxFunc1[P0]) Every(interval time.Duration, opts ...EveryOption) CtxFunc1Error[P0] {
+	return f.Fallible().Every(interval, opts...)
+}
 
+// Curry1 returns a CtxFunc with the first argument of the CtxFunc1 bound.
 func (f CtxFunc1[P0]) Curry1(p0 P0) CtxFunc {
-	return func(ctx context.Context)  {
+	return func(ctx context.Context) {
 		f(ctx, p0)
 	}
 }
-	
\ No newline at end of file
@@ -1,3 +1,5 @@
+// Code generated by powerfunc/generator from ctx_func_error.go.tmpl. DO NOT EDIT.
+
 package powerfunc
 
 import (
@@ -13,10 +15,15 @@ func (f CtxFunc1Error[P0]) Exec(ctx context.Context, p0 P0) error {
 }
 
 func (f CtxFunc1Error[P0]) Timing(loggers ...func(d time.Duration)) CtxFunc1Error[P0] {
+	return f.TimingWithClock(RealClock(), loggers...)
+}
+
+// TimingWithClock is like Timing, but measures the execution time with clock.
+func (f CtxFunc1Error[P0]) TimingWithClock(clock Clock, loggers ...func(d time.Duration)) CtxFunc1Error[P0] {
 	return func(ctx context.Context, p0 P0) error {
-		start := time.Now()
+		start := clock.Now()
 		defer func() {
-			dur := time.Since(start)
+			dur := clock.Since(start)
 			for _, logger := range loggers {
 				logger(dur)
 			}
@@ -53,24 +60,98 @@ func (f CtxFunc1Error[P0]) WithCancel() CtxFunc1Error[P0] {
 	}
 }
 
-// Retry returns a Function that will retry the Function until it returns
-// a nil error or the tryAgain function returns false.
-func (f CtxFunc1Error[P0]) Retry(tryAgain func(attempts int, err error) bool) CtxFunc1Error[P0] {
+// WithValue returns a CtxFunc1Error calling the CtxFunc1Error with a context
+// carrying val for key, see context.WithValue.
+func (f CtxFunc1Error[P0]) WithValue(key, val any) CtxFunc1Error[P0] {
 	return func(ctx context.Context, p0 P0) error {
-		var err error
-		attempts := 1
-		for {
-			err = f(ctx, p0)
-			if err != nil && tryAgain(attempts, err) {
-				break
-			}
-			attempts++
+		ctx = context.WithValue(ctx, key, val)
+		return f(ctx, p0)
+	}
+}
+
+// WithValueFrom returns a CtxFunc1Error calling the CtxFunc1Error with a context
+// carrying the value returned by value for its key. value gets the arguments
+// of every call.
+func (f CtxFunc1Error[P0]) WithValueFrom(value func(ctx context.Context, p0 P0) (key, val any)) CtxFunc1Error[P0] {
+	return func(ctx context.Context, p0 P0) error {
+		key, val := value(ctx, p0)
+		ctx = context.WithValue(ctx, key, val)
+		return f(ctx, p0)
+	}
+}
+
+// Detached returns a CtxFunc1Error calling the CtxFunc1Error with a context that is
+// never cancelled but keeps the values of the caller's context, see
+// context.WithoutCancel. Useful for cleanups that must run to completion.
+func (f CtxFunc1Error[P0]) Detached() CtxFunc1Error[P0] {
+	return func(ctx context.Context, p0 P0) error {
+		ctx = context.WithoutCancel(ctx)
+		return f(ctx, p0)
+	}
+}
+
+// WithTimeoutCause is like WithTimeout, but the context is cancelled with
+// cause when the timeout expires, see context.WithTimeoutCause.
+// When the CtxFunc1Error then fails with the error of the context, the returned
+// error wraps cause as well, to tell which timeout expired.
+func (f CtxFunc1Error[P0]) WithTimeoutCause(timeout time.Duration, cause error) CtxFunc1Error[P0] {
+	return func(ctx context.Context, p0 P0) error {
+		ctx, cancel := context.WithTimeoutCause(ctx, timeout, cause)
+		defer cancel()
+		err := f(ctx, p0)
+		return withCause(ctx, err)
+	}
+}
+
+// WithDeadlineCause is like WithDeadline, but the context is cancelled with
+// cause when the deadline expires, see context.WithDeadlineCause.
+// When the CtxFunc1Error then fails with the error of the context, the returned
+// error wraps cause as well, to tell which deadline expired.
+func (f CtxFunc1Error[P0]) WithDeadlineCause(deadline time.Time, cause error) CtxFunc1Error[P0] {
+	return func(ctx context.Context, p0 P0) error {
+		ctx, cancel := context.WithDeadlineCause(ctx, deadline, cause)
+		defer cancel()
+		err := f(ctx, p0)
+		return withCause(ctx, err)
+	}
+}
+
+// WithBudget returns a CtxFunc1Error giving the CtxFunc1Error a fraction of the
+// remaining deadline of the caller, capped at max when max is positive,
+// instead of a fixed timeout. Without a deadline, the CtxFunc1Error gets max, or
+// no timeout if max is not positive.
+// When the share is below min, the CtxFunc1Error is not called and a
+// *BudgetError, matching ErrBudgetExhausted, is returned.
+func (f CtxFunc1Error[P0]) WithBudget(fraction float64, min, max time.Duration) CtxFunc1Error[P0] {
+	return func(ctx context.Context, p0 P0) error {
+		timeout, bounded, err := budget(ctx, fraction, min, max)
+		if err != nil {
+			return err
 		}
-		return err
+		if bounded {
+			var cancel context.CancelFunc
+			ctx, cancel = context.WithTimeout(ctx, timeout)
+			defer cancel()
+		}
+		return f(ctx, p0)
 	}
 }
 
-// Must returns a Func1Value that will panic if the CtxFunc1Result returns an error.
+// Retry returns a CtxFunc1Error that will retry the CtxFunc1Error until it returns
+// a nil error or the tryAgain function returns false, in which case it
+// returns a *RetryError holding the errors of all the attempts.
+// The options set the backoff between attempts and hooks, e.g. OnRetry.
+// RetryAttemptTimeout gives every attempt its own deadline within the one of
+// the caller.
+func (f CtxFunc1Error[P0]) Retry(tryAgain func(attempts int, err error) bool, opts ...RetryOption) CtxFunc1Error[P0] {
+	return func(ctx context.Context, p0 P0) error {
+		return retry(ctx, tryAgain, opts, func(ctx context.Context) error {
+			return f(ctx, p0)
+		})
+	}
+}
+
+// Must returns a CtxFunc1 that will panic if the CtxFunc1Error returns an error.
 func (f CtxFunc1Error[P0]) Must() CtxFunc1[P0] {
 	return func(ctx context.Context, p0 P0) {
 		err := f(ctx, p0)
@@ -80,7 +161,7 @@ func (f CtxFunc1Error[P0]) Must() CtxFunc1[P0] {
 	}
 }
 
-// OnErr returns a CtxFunc1Result that will wrap the error returned by the CtxFunc1Result
+// OnErr returns a CtxFunc1Error that will wrap the error returned by the CtxFunc1Error
 // with the provided message.
 func (f CtxFunc1Error[P0]) OnErr(msg string) CtxFunc1Error[P0] {
 	return func(ctx context.Context, p0 P0) error {
@@ -92,10 +173,67 @@ func (f CtxFunc1Error[P0]) OnErr(msg string) CtxFunc1Error[P0] {
 	}
 }
 
+// Once returns a CtxFunc1Error that only executes the first time it is called
+// and returns the cached error afterwards, along with the Once controlling it.
+// Use OnceRetryOnError or OnceBackoff to only cache a success.
+// The shared execution does not inherit the cancellation of the caller's
+// context: a caller whose context is done stops waiting and gets ctx.Err(),
+// while the execution carries on for the other callers.
+func (f CtxFunc1Error[P0]) Once(opts ...OnceOption) (CtxFunc1Error[P0], *Once) {
+	o := newOnce(opts...)
+	return func(ctx context.Context, p0 P0) error {
+		_, err := o.do(ctx, true, func() (any, error) {
+			ctx := context.WithoutCancel(ctx)
+			return nil, f(ctx, p0)
+		})
+		return err
+	}, o
+}
+
+// Lazy returns a CtxFunc1Error that executes until it succeeds once, along
+// with the Once controlling it.
+// Failures are not cached, unless a backoff is provided with OnceBackoff.
+func (f CtxFunc1Error[P0]) Lazy(opts ...OnceOption) (CtxFunc1Error[P0], *Once) {
+	o := newLazy(opts...)
+	return func(ctx context.Context, p0 P0) error {
+		_, err := o.do(ctx, true, func() (any, error) {
+			ctx := context.WithoutCancel(ctx)
+			return nil, f(ctx, p0)
+		})
+		return err
+	}, o
+}
+
+// Every returns a runner that calls the CtxFunc1Error every interval until
+// its context is done, in which case the runner returns nil.
+// The runner only returns an error when EveryMaxConsecutiveErrors is
+// provided and reached.
+// Every panics if interval is not positive, like time.NewTicker.
+func (f CtxFunc1Error[P0]) Every(interval time.Duration, opts ...EveryOption) CtxFunc1Error[P0] {
+	checkEveryInterval(interval)
+	return func(ctx context.Context, p0 P0) error {
+		return every(ctx, interval, opts, func() error {
+			return f(ctx, p0)
+		})
+	}
+}
+
+// InjectFaults returns a CtxFunc1Error injecting the errors, panics and latency
+// configured by cfg in its calls, to test how the callers cope with them.
+// See FaultConfig, and DisableFaults for the global kill-switch.
+func (f CtxFunc1Error[P0]) InjectFaults(cfg FaultConfig) CtxFunc1Error[P0] {
+	fi := newFaultInjector(cfg)
+	return func(ctx context.Context, p0 P0) error {
+		if err := fi.inject(ctx, p0); err != nil {
+			return err
+		}
+		return f(ctx, p0)
+	}
+}
 
+// Curry1 returns a CtxFuncError with the first argument of the CtxFunc1Error bound.
 func (f CtxFunc1Error[P0]) Curry1(p0 P0) CtxFuncError {
 	return func(ctx context.Context) error {
 		return f(ctx, p0)
 	}
 }
-	
\ No newline at end of file
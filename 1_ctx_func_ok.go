@@ -0,0 +1,218 @@
+// Code generated by powerfunc/generator from ctx_func_ok.go.tmpl. DO NOT EDIT.
+
+package powerfunc
+
+import (
+	"context"
+	"fmt"
+	"time"
+)
+
+type CtxFunc1Ok[R, P0 any] func(ctx context.Context, p0 P0) (R, bool)
+
+func (f CtxFunc1Ok[R, P0]) Exec(ctx context.Context, p0 P0) (R, bool) {
+	return f(ctx, p0)
+}
+
+func (f CtxFunc1Ok[R, P0]) Timing(loggers ...func(d time.Duration)) CtxFunc1Ok[R, P0] {
+	return f.TimingWithClock(RealClock(), loggers...)
+}
+
+// TimingWithClock is like Timing, but measures the execution time with clock.
+func (f CtxFunc1Ok[R, P0]) TimingWithClock(clock Clock, loggers ...func(d time.Duration)) CtxFunc1Ok[R, P0] {
+	return func(ctx context.Context, p0 P0) (R, bool) {
+		start := clock.Now()
+		defer func() {
+			dur := clock.Since(start)
+			for _, logger := range loggers {
+				logger(dur)
+			}
+			if len(loggers) == 0 {
+				// Default logger
+				fmt.Println(dur)
+			}
+		}()
+		return f(ctx, p0)
+	}
+}
+
+func (f CtxFunc1Ok[R, P0]) WithTimeout(timeout time.Duration) CtxFunc1Ok[R, P0] {
+	return func(ctx context.Context, p0 P0) (R, bool) {
+		ctx, cancel := context.WithTimeout(ctx, timeout)
+		defer cancel()
+		return f(ctx, p0)
+	}
+}
+
+func (f CtxFunc1Ok[R, P0]) WithDeadline(deadline time.Time) CtxFunc1Ok[R, P0] {
+	return func(ctx context.Context, p0 P0) (R, bool) {
+		ctx, cancel := context.WithDeadline(ctx, deadline)
+		defer cancel()
+		return f(ctx, p0)
+	}
+}
+
+func (f CtxFunc1Ok[R, P0]) WithCancel() CtxFunc1Ok[R, P0] {
+	return func(ctx context.Context, p0 P0) (R, bool) {
+		ctx, cancel := context.WithCancel(ctx)
+		defer cancel()
+		return f(ctx, p0)
+	}
+}
+
+// WithValue returns a CtxFunc1Ok calling the CtxFunc1Ok with a context
+// carrying val for key, see context.WithValue.
+func (f CtxFunc1Ok[R, P0]) WithValue(key, val any) CtxFunc1Ok[R, P0] {
+	return func(ctx context.Context, p0 P0) (R, bool) {
+		ctx = context.WithValue(ctx, key, val)
+		return f(ctx, p0)
+	}
+}
+
+// WithValueFrom returns a CtxFunc1Ok calling the CtxFunc1Ok with a context
+// carrying the value returned by value for its key. value gets the arguments
+// of every call.
+func (f CtxFunc1Ok[R, P0]) WithValueFrom(value func(ctx context.Context, p0 P0) (key, val any)) CtxFunc1Ok[R, P0] {
+	return func(ctx context.Context, p0 P0) (R, bool) {
+		key, val := value(ctx, p0)
+		ctx = context.WithValue(ctx, key, val)
+		return f(ctx, p0)
+	}
+}
+
+// Detached returns a CtxFunc1Ok calling the CtxFunc1Ok with a context that is
+// never cancelled but keeps the values of the caller's context, see
+// context.WithoutCancel. Useful for cleanups that must run to completion.
+func (f CtxFunc1Ok[R, P0]) Detached() CtxFunc1Ok[R, P0] {
+	return func(ctx context.Context, p0 P0) (R, bool) {
+		ctx = context.WithoutCancel(ctx)
+		return f(ctx, p0)
+	}
+}
+
+// WithTimeoutCause is like WithTimeout, but the context is cancelled with
+// cause when the timeout expires, see context.WithTimeoutCause.
+func (f CtxFunc1Ok[R, P0]) WithTimeoutCause(timeout time.Duration, cause error) CtxFunc1Ok[R, P0] {
+	return func(ctx context.Context, p0 P0) (R, bool) {
+		ctx, cancel := context.WithTimeoutCause(ctx, timeout, cause)
+		defer cancel()
+		return f(ctx, p0)
+	}
+}
+
+// WithDeadlineCause is like WithDeadline, but the context is cancelled with
+// cause when the deadline expires, see context.WithDeadlineCause.
+func (f CtxFunc1Ok[R, P0]) WithDeadlineCause(deadline time.Time, cause error) CtxFunc1Ok[R, P0] {
+	return func(ctx context.Context, p0 P0) (R, bool) {
+		ctx, cancel := context.WithDeadlineCause(ctx, deadline, cause)
+		defer cancel()
+		return f(ctx, p0)
+	}
+}
+
+// Retry returns a CtxFunc1Ok that will retry the CtxFunc1Ok until it returns
+// true or the tryAgain function returns false.
+// The attempts that returned false fail with a placeholder error, given to
+// tryAgain and to the hooks of the options, e.g. OnRetry, so that any
+// RetryPolicy can be used.
+func (f CtxFunc1Ok[R, P0]) Retry(tryAgain RetryPolicy, opts ...RetryOption) CtxFunc1Ok[R, P0] {
+	return func(ctx context.Context, p0 P0) (R, bool) {
+		var v R
+		var ok bool
+		err := retry(ctx, func(attempts int, err error) bool {
+			return ctx.Err() == nil && tryAgain(attempts, err)
+		}, opts, func(ctx context.Context) error {
+			v, ok = f(ctx, p0)
+			if !ok {
+				return errNotOk
+			}
+			return nil
+		})
+		return v, err == nil
+	}
+}
+
+// Must returns a CtxFunc1Value that will panic if the CtxFunc1Ok returns false.
+func (f CtxFunc1Ok[R, P0]) Must() CtxFunc1Value[R, P0] {
+	return func(ctx context.Context, p0 P0) R {
+		v, ok := f(ctx, p0)
+		if !ok {
+			panic("powerfunc: CtxFunc1Ok returned false")
+		}
+		return v
+	}
+}
+
+// Fallback returns a CtxFunc1Value that will return the provided value if the
+// CtxFunc1Ok returns false.
+func (f CtxFunc1Ok[R, P0]) Fallback(val R) CtxFunc1Value[R, P0] {
+	return func(ctx context.Context, p0 P0) R {
+		v, ok := f(ctx, p0)
+		if !ok {
+			return val
+		}
+		return v
+	}
+}
+
+// OrElse returns a CtxFunc1Value that will return the provided value if the
+// CtxFunc1Ok returns false. It is an alias of Fallback.
+func (f CtxFunc1Ok[R, P0]) OrElse(val R) CtxFunc1Value[R, P0] {
+	return f.Fallback(val)
+}
+
+// OrElseGet returns a CtxFunc1Value that will call elsef if the CtxFunc1Ok
+// returns false.
+func (f CtxFunc1Ok[R, P0]) OrElseGet(elsef CtxFunc1Value[R, P0]) CtxFunc1Value[R, P0] {
+	return func(ctx context.Context, p0 P0) R {
+		v, ok := f(ctx, p0)
+		if !ok {
+			return elsef(ctx, p0)
+		}
+		return v
+	}
+}
+
+// ToResult transforms a CtxFunc1Ok into a CtxFunc1Result returning the
+// provided error when the CtxFunc1Ok returns false.
+func (f CtxFunc1Ok[R, P0]) ToResult(err error) CtxFunc1Result[R, P0] {
+	return func(ctx context.Context, p0 P0) (R, error) {
+		v, ok := f(ctx, p0)
+		if !ok {
+			return v, err
+		}
+		return v, nil
+	}
+}
+
+// Filter returns a CtxFunc1Ok that will return false if the value returned by
+// the CtxFunc1Ok does not satisfy keep.
+func (f CtxFunc1Ok[R, P0]) Filter(keep func(R) bool) CtxFunc1Ok[R, P0] {
+	return func(ctx context.Context, p0 P0) (R, bool) {
+		v, ok := f(ctx, p0)
+		if !ok || !keep(v) {
+			var zero R
+			return zero, false
+		}
+		return v, true
+	}
+}
+
+// Map applies the provided function to the value returned by the CtxFunc1Ok,
+// if it returns true.
+func (f CtxFunc1Ok[R, P0]) Map(fn func(R) R) CtxFunc1Ok[R, P0] {
+	return func(ctx context.Context, p0 P0) (R, bool) {
+		v, ok := f(ctx, p0)
+		if !ok {
+			return v, false
+		}
+		return fn(v), true
+	}
+}
+
+// Curry1 returns a CtxFuncOk[R] with the first argument of the CtxFunc1Ok bound.
+func (f CtxFunc1Ok[R, P0]) Curry1(p0 P0) CtxFuncOk[R] {
+	return func(ctx context.Context) (R, bool) {
+		return f(ctx, p0)
+	}
+}
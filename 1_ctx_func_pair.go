@@ -0,0 +1,125 @@
+// Code generated by powerfunc/generator from ctx_func_pair.go.tmpl. DO NOT EDIT.
+
+package powerfunc
+
+import (
+	"context"
+	"fmt"
+	"time"
+)
+
+type CtxFunc1Pair[R1, R2, P0 any] func(ctx context.Context, p0 P0) (R1, R2)
+
+func (f CtxFunc1Pair[R1, R2, P0]) Exec(ctx context.Context, p0 P0) (R1, R2) {
+	return f(ctx, p0)
+}
+
+func (f CtxFunc1Pair[R1, R2, P0]) Timing(loggers ...func(d time.Duration)) CtxFunc1Pair[R1, R2, P0] {
+	return f.TimingWithClock(RealClock(), loggers...)
+}
+
+// TimingWithClock is like Timing, but measures the execution time with clock.
+func (f CtxFunc1Pair[R1, R2, P0]) TimingWithClock(clock Clock, loggers ...func(d time.Duration)) CtxFunc1Pair[R1, R2, P0] {
+	return func(ctx context.Context, p0 P0) (R1, R2) {
+		start := clock.Now()
+		defer func() {
+			dur := clock.Since(start)
+			for _, logger := range loggers {
+				logger(dur)
+			}
+			if len(loggers) == 0 {
+				// Default logger
+				fmt.Println(dur)
+			}
+		}()
+		return f(ctx, p0)
+	}
+}
+
+func (f CtxFunc1Pair[R1, R2, P0]) Fallible() CtxFunc1PairResult[R1, R2, P0] {
+	return func(ctx context.Context, p0 P0) (R1, R2, error) {
+		v1, v2 := f(ctx, p0)
+		return v1, v2, nil
+	}
+}
+
+func (f CtxFunc1Pair[R1, R2, P0]) WithTimeout(timeout time.Duration) CtxFunc1Pair[R1, R2, P0] {
+	return func(ctx context.Context, p0 P0) (R1, R2) {
+		ctx, cancel := context.WithTimeout(ctx, timeout)
+		defer cancel()
+		return f(ctx, p0)
+	}
+}
+
+func (f CtxFunc1Pair[R1, R2, P0]) WithDeadline(deadline time.Time) CtxFunc1Pair[R1, R2, P0] {
+	return func(ctx context.Context, p0 P0) (R1, R2) {
+		ctx, cancel := context.WithDeadline(ctx, deadline)
+		defer cancel()
+		return f(ctx, p0)
+	}
+}
+
+func (f CtxFunc1Pair[R1, R2, P0]) WithCancel() CtxFunc1Pair[R1, R2, P0] {
+	return func(ctx context.Context, p0 P0) (R1, R2) {
+		ctx, cancel := context.WithCancel(ctx)
+		defer cancel()
+		return f(ctx, p0)
+	}
+}
+
+// WithValue returns a CtxFunc1Pair calling the CtxFunc1Pair with a context
+// carrying val for key, see context.WithValue.
+func (f CtxFunc1Pair[R1, R2, P0]) WithValue(key, val any) CtxFunc1Pair[R1, R2, P0] {
+	return func(ctx context.Context, p0 P0) (R1, R2) {
+		ctx = context.WithValue(ctx, key, val)
+		return f(ctx, p0)
+	}
+}
+
+// WithValueFrom returns a CtxFunc1Pair calling the CtxFunc1Pair with a context
+// carrying the value returned by value for its key. value gets the arguments
+// of every call.
+func (f CtxFunc1Pair[R1, R2, P0]) WithValueFrom(value func(ctx context.Context, p0 P0) (key, val any)) CtxFunc1Pair[R1, R2, P0] {
+	return func(ctx context.Context, p0 P0) (R1, R2) {
+		key, val := value(ctx, p0)
+		ctx = context.WithValue(ctx, key, val)
+		return f(ctx, p0)
+	}
+}
+
+// Detached returns a CtxFunc1Pair calling the CtxFunc1Pair with a context that is
+// never cancelled but keeps the values of the caller's context, see
+// context.WithoutCancel. Useful for cleanups that must run to completion.
+func (f CtxFunc1Pair[R1, R2, P0]) Detached() CtxFunc1Pair[R1, R2, P0] {
+	return func(ctx context.Context, p0 P0) (R1, R2) {
+		ctx = context.WithoutCancel(ctx)
+		return f(ctx, p0)
+	}
+}
+
+// WithTimeoutCause is like WithTimeout, but the context is cancelled with
+// cause when the timeout expires, see context.WithTimeoutCause.
+func (f CtxFunc1Pair[R1, R2, P0]) WithTimeoutCause(timeout time.Duration, cause error) CtxFunc1Pair[R1, R2, P0] {
+	return func(ctx context.Context, p0 P0) (R1, R2) {
+		ctx, cancel := context.WithTimeoutCause(ctx, timeout, cause)
+		defer cancel()
+		return f(ctx, p0)
+	}
+}
+
+// WithDeadlineCause is like WithDeadline, but the context is cancelled with
+// cause when the deadline expires, see context.WithDeadlineCause.
+func (f CtxFunc1Pair[R1, R2, P0]) WithDeadlineCause(deadline time.Time, cause error) CtxFunc1Pair[R1, R2, P0] {
+	return func(ctx context.Context, p0 P0) (R1, R2) {
+		ctx, cancel := context.WithDeadlineCause(ctx, deadline, cause)
+		defer cancel()
+		return f(ctx, p0)
+	}
+}
+
+// Curry1 returns a CtxFuncPair[R1, R2] with the first argument of the CtxFunc1Pair bound.
+func (f CtxFunc1Pair[R1, R2, P0]) Curry1(p0 P0) CtxFuncPair[R1, R2] {
+	return func(ctx context.Context) (R1, R2) {
+		return f(ctx, p0)
+	}
+}
@@ -0,0 +1,228 @@
+// Code generated by powerfunc/generator from ctx_func_pair_result.go.tmpl. DO NOT EDIT.
+
+package powerfunc
+
+import (
+	"context"
+	"fmt"
+	"time"
+)
+
+type CtxFunc1PairResult[R1, R2, P0 any] func(ctx context.Context, p0 P0) (R1, R2, error)
+
+func (f CtxFunc1PairResult[R1, R2, P0]) Exec(ctx context.Context, p0 P0) (R1, R2, error) {
+	return f(ctx, p0)
+}
+
+func (f CtxFunc1PairResult[R1, R2, P0]) Timing(loggers ...func(d time.Duration)) CtxFunc1PairResult[R1, R2, P0] {
+	return f.TimingWithClock(RealClock(), loggers...)
+}
+
+// TimingWithClock is like Timing, but measures the execution time with clock.
+func (f CtxFunc1PairResult[R1, R2, P0]) TimingWithClock(clock Clock, loggers ...func(d time.Duration)) CtxFunc1PairResult[R1, R2, P0] {
+	return func(ctx context.Context, p0 P0) (R1, R2, error) {
+		start := clock.Now()
+		defer func() {
+			dur := clock.Since(start)
+			for _, logger := range loggers {
+				logger(dur)
+			}
+			if len(loggers) == 0 {
+				// Default logger
+				fmt.Println(dur)
+			}
+		}()
+		return f(ctx, p0)
+	}
+}
+
+func (f CtxFunc1PairResult[R1, R2, P0]) WithTimeout(timeout time.Duration) CtxFunc1PairResult[R1, R2, P0] {
+	return func(ctx context.Context, p0 P0) (R1, R2, error) {
+		ctx, cancel := context.WithTimeout(ctx, timeout)
+		defer cancel()
+		return f(ctx, p0)
+	}
+}
+
+func (f CtxFunc1PairResult[R1, R2, P0]) WithDeadline(deadline time.Time) CtxFunc1PairResult[R1, R2, P0] {
+	return func(ctx context.Context, p0 P0) (R1, R2, error) {
+		ctx, cancel := context.WithDeadline(ctx, deadline)
+		defer cancel()
+		return f(ctx, p0)
+	}
+}
+
+func (f CtxFunc1PairResult[R1, R2, P0]) WithCancel() CtxFunc1PairResult[R1, R2, P0] {
+	return func(ctx context.Context, p0 P0) (R1, R2, error) {
+		ctx, cancel := context.WithCancel(ctx)
+		defer cancel()
+		return f(ctx, p0)
+	}
+}
+
+// WithValue returns a CtxFunc1PairResult calling the CtxFunc1PairResult with a context
+// carrying val for key, see context.WithValue.
+func (f CtxFunc1PairResult[R1, R2, P0]) WithValue(key, val any) CtxFunc1PairResult[R1, R2, P0] {
+	return func(ctx context.Context, p0 P0) (R1, R2, error) {
+		ctx = context.WithValue(ctx, key, val)
+		return f(ctx, p0)
+	}
+}
+
+// WithValueFrom returns a CtxFunc1PairResult calling the CtxFunc1PairResult with a context
+// carrying the value returned by value for its key. value gets the arguments
+// of every call.
+func (f CtxFunc1PairResult[R1, R2, P0]) WithValueFrom(value func(ctx context.Context, p0 P0) (key, val any)) CtxFunc1PairResult[R1, R2, P0] {
+	return func(ctx context.Context, p0 P0) (R1, R2, error) {
+		key, val := value(ctx, p0)
+		ctx = context.WithValue(ctx, key, val)
+		return f(ctx, p0)
+	}
+}
+
+// Detached returns a CtxFunc1PairResult calling the CtxFunc1PairResult with a context that is
+// never cancelled but keeps the values of the caller's context, see
+// context.WithoutCancel. Useful for cleanups that must run to completion.
+func (f CtxFunc1PairResult[R1, R2, P0]) Detached() CtxFunc1PairResult[R1, R2, P0] {
+	return func(ctx context.Context, p0 P0) (R1, R2, error) {
+		ctx = context.WithoutCancel(ctx)
+		return f(ctx, p0)
+	}
+}
+
+// WithTimeoutCause is like WithTimeout, but the context is cancelled with
+// cause when the timeout expires, see context.WithTimeoutCause.
+// When the CtxFunc1PairResult then fails with the error of the context, the returned
+// error wraps cause as well, to tell which timeout expired.
+func (f CtxFunc1PairResult[R1, R2, P0]) WithTimeoutCause(timeout time.Duration, cause error) CtxFunc1PairResult[R1, R2, P0] {
+	return func(ctx context.Context, p0 P0) (R1, R2, error) {
+		ctx, cancel := context.WithTimeoutCause(ctx, timeout, cause)
+		defer cancel()
+		r0, r1, err := f(ctx, p0)
+		return r0, r1, withCause(ctx, err)
+	}
+}
+
+// WithDeadlineCause is like WithDeadline, but the context is cancelled with
+// cause when the deadline expires, see context.WithDeadlineCause.
+// When the CtxFunc1PairResult then fails with the error of the context, the returned
+// error wraps cause as well, to tell which deadline expired.
+func (f CtxFunc1PairResult[R1, R2, P0]) WithDeadlineCause(deadline time.Time, cause error) CtxFunc1PairResult[R1, R2, P0] {
+	return func(ctx context.Context, p0 P0) (R1, R2, error) {
+		ctx, cancel := context.WithDeadlineCause(ctx, deadline, cause)
+		defer cancel()
+		r0, r1, err := f(ctx, p0)
+		return r0, r1, withCause(ctx, err)
+	}
+}
+
+// WithBudget returns a CtxFunc1PairResult giving the CtxFunc1PairResult a fraction of the
+// remaining deadline of the caller, capped at max when max is positive,
+// instead of a fixed timeout. Without a deadline, the CtxFunc1PairResult gets max, or
+// no timeout if max is not positive.
+// When the share is below min, the CtxFunc1PairResult is not called and a
+// *BudgetError, matching ErrBudgetExhausted, is returned.
+func (f CtxFunc1PairResult[R1, R2, P0]) WithBudget(fraction float64, min, max time.Duration) CtxFunc1PairResult[R1, R2, P0] {
+	return func(ctx context.Context, p0 P0) (R1, R2, error) {
+		timeout, bounded, err := budget(ctx, fraction, min, max)
+		if err != nil {
+			var r0 R1
+			var r1 R2
+			return r0, r1, err
+		}
+		if bounded {
+			var cancel context.CancelFunc
+			ctx, cancel = context.WithTimeout(ctx, timeout)
+			defer cancel()
+		}
+		return f(ctx, p0)
+	}
+}
+
+// Retry returns a CtxFunc1PairResult that will retry the CtxFunc1PairResult until it returns
+// a nil error or the tryAgain function returns false, in which case it
+// returns a *RetryError holding the errors of all the attempts.
+// The options set the backoff between attempts and hooks, e.g. OnRetry.
+// RetryAttemptTimeout gives every attempt its own deadline within the one of
+// the caller.
+func (f CtxFunc1PairResult[R1, R2, P0]) Retry(tryAgain func(attempts int, err error) bool, opts ...RetryOption) CtxFunc1PairResult[R1, R2, P0] {
+	return func(ctx context.Context, p0 P0) (R1, R2, error) {
+		var r0 R1
+		var r1 R2
+		err := retry(ctx, tryAgain, opts, func(ctx context.Context) error {
+			var err error
+			r0, r1, err = f(ctx, p0)
+			return err
+		})
+		return r0, r1, err
+	}
+}
+
+// Must returns a CtxFunc1Pair that will panic if the CtxFunc1PairResult returns an error.
+func (f CtxFunc1PairResult[R1, R2, P0]) Must() CtxFunc1Pair[R1, R2, P0] {
+	return func(ctx context.Context, p0 P0) (R1, R2) {
+		v1, v2, err := f(ctx, p0)
+		if err != nil {
+			panic(err)
+		}
+		return v1, v2
+	}
+}
+
+// OnErr returns a CtxFunc1PairResult that will wrap the error returned by the
+// CtxFunc1PairResult with the provided message.
+func (f CtxFunc1PairResult[R1, R2, P0]) OnErr(msg string) CtxFunc1PairResult[R1, R2, P0] {
+	return func(ctx context.Context, p0 P0) (R1, R2, error) {
+		v1, v2, err := f(ctx, p0)
+		if err != nil {
+			return v1, v2, fmt.Errorf("%s: %w", msg, err)
+		}
+		return v1, v2, nil
+	}
+}
+
+// MapErr applies the provided function to the error returned by the
+// CtxFunc1PairResult, if there is an error.
+func (f CtxFunc1PairResult[R1, R2, P0]) MapErr(fn func(error) error) CtxFunc1PairResult[R1, R2, P0] {
+	return func(ctx context.Context, p0 P0) (R1, R2, error) {
+		v1, v2, err := f(ctx, p0)
+		if err != nil {
+			return v1, v2, fn(err)
+		}
+		return v1, v2, nil
+	}
+}
+
+// Fallback returns a CtxFunc1Pair that will return the provided values if the
+// CtxFunc1PairResult returns an error.
+func (f CtxFunc1PairResult[R1, R2, P0]) Fallback(val1 R1, val2 R2) CtxFunc1Pair[R1, R2, P0] {
+	return func(ctx context.Context, p0 P0) (R1, R2) {
+		v1, v2, err := f(ctx, p0)
+		if err != nil {
+			return val1, val2
+		}
+		return v1, v2
+	}
+}
+
+// InjectFaults returns a CtxFunc1PairResult injecting the errors, panics and latency
+// configured by cfg in its calls, to test how the callers cope with them.
+// See FaultConfig, and DisableFaults for the global kill-switch.
+func (f CtxFunc1PairResult[R1, R2, P0]) InjectFaults(cfg FaultConfig) CtxFunc1PairResult[R1, R2, P0] {
+	fi := newFaultInjector(cfg)
+	return func(ctx context.Context, p0 P0) (R1, R2, error) {
+		if err := fi.inject(ctx, p0); err != nil {
+			var r0 R1
+			var r1 R2
+			return r0, r1, err
+		}
+		return f(ctx, p0)
+	}
+}
+
+// Curry1 returns a CtxFuncPairResult[R1, R2] with the first argument of the CtxFunc1PairResult bound.
+func (f CtxFunc1PairResult[R1, R2, P0]) Curry1(p0 P0) CtxFuncPairResult[R1, R2] {
+	return func(ctx context.Context) (R1, R2, error) {
+		return f(ctx, p0)
+	}
+}
@@ -1,3 +1,5 @@
+// Code generated by powerfunc/generator from ctx_func_value.go.tmpl. DO NOT EDIT.
+
 package powerfunc
 
 import (
@@ -13,10 +15,15 @@ func (f CtxFunc1Value[R, P0]) Exec(ctx context.Context, p0 P0) R {
 }
 
 func (f CtxFunc1Value[R, P0]) Timing(loggers ...func(d time.Duration)) CtxFunc1Value[R, P0] {
+	return f.TimingWithClock(RealClock(), loggers...)
+}
+
+// TimingWithClock is like Timing, but measures the execution time with clock.
+func (f CtxFunc1Value[R, P0]) TimingWithClock(clock Clock, loggers ...func(d time.Duration)) CtxFunc1Value[R, P0] {
 	return func(ctx context.Context, p0 P0) R {
-		start := time.Now()
+		start := clock.Now()
 		defer func() {
-			dur := time.Since(start)
+			dur := clock.Since(start)
 			for _, logger := range loggers {
 				logger(dur)
 			}
@@ -60,10 +67,108 @@ func (f CtxFunc1Value[R, P0]) WithCancel() CtxFunc1Value[R, P0] {
 	}
 }
 
+// WithValue returns a CtxFunc1Value calling the CtxFunc1Value with a context
+// carrying val for key, see context.WithValue.
+func (f CtxFunc1Value[R, P0]) WithValue(key, val any) CtxFunc1Value[R, P0] {
+	return func(ctx context.Context, p0 P0) R {
+		ctx = context.WithValue(ctx, key, val)
+		return f(ctx, p0)
+	}
+}
+
+// WithValueFrom returns a CtxFunc1Value calling the CtxFunc1Value with a context
+// carrying the value returned by value for its key. value gets the arguments
+// of every call.
+func (f CtxFunc1Value[R, P0]) WithValueFrom(value func(ctx context.Context, p0 P0) (key, val any)) CtxFunc1Value[R, P0] {
+	return func(ctx context.Context, p0 P0) R {
+		key, val := value(ctx, p0)
+		ctx = context.WithValue(ctx, key, val)
+		return f(ctx, p0)
+	}
+}
+
+// Detached returns a CtxFunc1Value calling the CtxFunc1Value with a context that is
+// never cancelled but keeps the values of the caller's context, see
+// context.WithoutCancel. Useful for cleanups that must run to completion.
+func (f CtxFunc1Value[R, P0]) Detached() CtxFunc1Value[R, P0] {
+	return func(ctx context.Context, p0 P0) R {
+		ctx = context.WithoutCancel(ctx)
+		return f(ctx, p0)
+	}
+}
+
+// WithTimeoutCause is like WithTimeout, but the context is cancelled with
+// cause when the timeout expires, see context.WithTimeoutCause.
+func (f CtxFunc1Value[R, P0]) WithTimeoutCause(timeout time.Duration, cause error) CtxFunc1Value[R, P0] {
+	return func(ctx context.Context, p0 P0) R {
+		ctx, cancel := context.WithTimeoutCause(ctx, timeout, cause)
+		defer cancel()
+		return f(ctx, p0)
+	}
+}
+
+// WithDeadlineCause is like WithDeadline, but the context is cancelled with
+// cause when the deadline expires, see context.WithDeadlineCause.
+func (f CtxFunc1Value[R, P0]) WithDeadlineCause(deadline time.Time, cause error) CtxFunc1Value[R, P0] {
+	return func(ctx context.Context, p0 P0) R {
+		ctx, cancel := context.WithDeadlineCause(ctx, deadline, cause)
+		defer cancel()
+		return f(ctx, p0)
+	}
+}
+
+// Once returns a CtxFunc1Value that only executes the first time it is called
+// and returns the cached value afterwards, along with the Once controlling it.
+// The shared execution does not inherit the cancellation of the caller's
+// context. As a CtxFunc1Value cannot report ctx.Err(), every caller waits for
+// the execution to return, even once its own context is done.
+func (f CtxFunc1Value[R, P0]) Once(opts ...OnceOption) (CtxFunc1Value[R, P0], *Once) {
+	o := newOnce(opts...)
+	return func(ctx context.Context, p0 P0) R {
+		v, _ := o.do(context.WithoutCancel(ctx), false, func() (any, error) {
+			ctx := context.WithoutCancel(ctx)
+			return f(ctx, p0), nil
+		})
+		val, _ := v.(R)
+		return val
+	}, o
+}
+
+// Lazy returns a CtxFunc1Value that computes its value the first time it is
+// called and returns the cached value afterwards, along with the Once
+// controlling it.
+// Like Once, every caller waits for the execution to return, even once its
+// own context is done.
+func (f CtxFunc1Value[R, P0]) Lazy(opts ...OnceOption) (CtxFunc1Value[R, P0], *Once) {
+	o := newLazy(opts...)
+	return func(ctx context.Context, p0 P0) R {
+		v, _ := o.do(context.WithoutCancel(ctx), false, func() (any, error) {
+			ctx := context.WithoutCancel(ctx)
+			return f(ctx, p0), nil
+		})
+		val, _ := v.(R)
+		return val
+	}, o
+}
+
+// PollUntil returns a CtxFunc1Result calling the CtxFunc1Value every interval
+// until the returned value satisfies cond.
+// When the context is done or the maximum number of attempts is reached
+// first, it returns the last value and a *PollTimeoutError.
+func (f CtxFunc1Value[R, P0]) PollUntil(cond func(R) bool, interval time.Duration, opts ...PollOption) CtxFunc1Result[R, P0] {
+	return func(ctx context.Context, p0 P0) (R, error) {
+		var v R
+		err := poll(ctx, interval, opts, func() (bool, error) {
+			v = f(ctx, p0)
+			return cond(v), nil
+		})
+		return v, err
+	}
+}
 
+// Curry1 returns a CtxFuncValue[R] with the first argument of the CtxFunc1Value bound.
 func (f CtxFunc1Value[R, P0]) Curry1(p0 P0) CtxFuncValue[R] {
 	return func(ctx context.Context) R {
 		return f(ctx, p0)
 	}
 }
-	
\ No newline at end of file
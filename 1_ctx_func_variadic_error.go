@@ -0,0 +1,194 @@
+// Code generated by powerfunc/generator from ctx_func_variadic_error.go.tmpl. DO NOT EDIT.
+
+package powerfunc
+
+import (
+	"context"
+	"fmt"
+	"time"
+)
+
+type CtxFunc1VariadicError[V, P0 any] func(ctx context.Context, p0 P0, xs ...V) error
+
+func (f CtxFunc1VariadicError[V, P0]) Exec(ctx context.Context, p0 P0, xs ...V) error {
+	return f(ctx, p0, xs...)
+}
+
+func (f CtxFunc1VariadicError[V, P0]) Timing(loggers ...func(d time.Duration)) CtxFunc1VariadicError[V, P0] {
+	return f.TimingWithClock(RealClock(), loggers...)
+}
+
+// TimingWithClock is like Timing, but measures the execution time with clock.
+func (f CtxFunc1VariadicError[V, P0]) TimingWithClock(clock Clock, loggers ...func(d time.Duration)) CtxFunc1VariadicError[V, P0] {
+	return func(ctx context.Context, p0 P0, xs ...V) error {
+		start := clock.Now()
+		defer func() {
+			dur := clock.Since(start)
+			for _, logger := range loggers {
+				logger(dur)
+			}
+			if len(loggers) == 0 {
+				// Default logger
+				fmt.Println(dur)
+			}
+		}()
+		return f(ctx, p0, xs...)
+	}
+}
+
+func (f CtxFunc1VariadicError[V, P0]) WithTimeout(timeout time.Duration) CtxFunc1VariadicError[V, P0] {
+	return func(ctx context.Context, p0 P0, xs ...V) error {
+		ctx, cancel := context.WithTimeout(ctx, timeout)
+		defer cancel()
+		return f(ctx, p0, xs...)
+	}
+}
+
+func (f CtxFunc1VariadicError[V, P0]) WithDeadline(deadline time.Time) CtxFunc1VariadicError[V, P0] {
+	return func(ctx context.Context, p0 P0, xs ...V) error {
+		ctx, cancel := context.WithDeadline(ctx, deadline)
+		defer cancel()
+		return f(ctx, p0, xs...)
+	}
+}
+
+func (f CtxFunc1VariadicError[V, P0]) WithCancel() CtxFunc1VariadicError[V, P0] {
+	return func(ctx context.Context, p0 P0, xs ...V) error {
+		ctx, cancel := context.WithCancel(ctx)
+		defer cancel()
+		return f(ctx, p0, xs...)
+	}
+}
+
+// WithValue returns a CtxFunc1VariadicError calling the CtxFunc1VariadicError with a context
+// carrying val for key, see context.WithValue.
+func (f CtxFunc1VariadicError[V, P0]) WithValue(key, val any) CtxFunc1VariadicError[V, P0] {
+	return func(ctx context.Context, p0 P0, xs ...V) error {
+		ctx = context.WithValue(ctx, key, val)
+		return f(ctx, p0, xs...)
+	}
+}
+
+// WithValueFrom returns a CtxFunc1VariadicError calling the CtxFunc1VariadicError with a context
+// carrying the value returned by value for its key. value gets the arguments
+// of every call.
+func (f CtxFunc1VariadicError[V, P0]) WithValueFrom(value func(ctx context.Context, p0 P0, xs ...V) (key, val any)) CtxFunc1VariadicError[V, P0] {
+	return func(ctx context.Context, p0 P0, xs ...V) error {
+		key, val := value(ctx, p0, xs...)
+		ctx = context.WithValue(ctx, key, val)
+		return f(ctx, p0, xs...)
+	}
+}
+
+// Detached returns a CtxFunc1VariadicError calling the CtxFunc1VariadicError with a context that is
+// never cancelled but keeps the values of the caller's context, see
+// context.WithoutCancel. Useful for cleanups that must run to completion.
+func (f CtxFunc1VariadicError[V, P0]) Detached() CtxFunc1VariadicError[V, P0] {
+	return func(ctx context.Context, p0 P0, xs ...V) error {
+		ctx = context.WithoutCancel(ctx)
+		return f(ctx, p0, xs...)
+	}
+}
+
+// WithTimeoutCause is like WithTimeout, but the context is cancelled with
+// cause when the timeout expires, see context.WithTimeoutCause.
+// When the CtxFunc1VariadicError then fails with the error of the context, the returned
+// error wraps cause as well, to tell which timeout expired.
+func (f CtxFunc1VariadicError[V, P0]) WithTimeoutCause(timeout time.Duration, cause error) CtxFunc1VariadicError[V, P0] {
+	return func(ctx context.Context, p0 P0, xs ...V) error {
+		ctx, cancel := context.WithTimeoutCause(ctx, timeout, cause)
+		defer cancel()
+		err := f(ctx, p0, xs...)
+		return withCause(ctx, err)
+	}
+}
+
+// WithDeadlineCause is like WithDeadline, but the context is cancelled with
+// cause when the deadline expires, see context.WithDeadlineCause.
+// When the CtxFunc1VariadicError then fails with the error of the context, the returned
+// error wraps cause as well, to tell which deadline expired.
+func (f CtxFunc1VariadicError[V, P0]) WithDeadlineCause(deadline time.Time, cause error) CtxFunc1VariadicError[V, P0] {
+	return func(ctx context.Context, p0 P0, xs ...V) error {
+		ctx, cancel := context.WithDeadlineCause(ctx, deadline, cause)
+		defer cancel()
+		err := f(ctx, p0, xs...)
+		return withCause(ctx, err)
+	}
+}
+
+// WithBudget returns a CtxFunc1VariadicError giving the CtxFunc1VariadicError a fraction of the
+// remaining deadline of the caller, capped at max when max is positive,
+// instead of a fixed timeout. Without a deadline, the CtxFunc1VariadicError gets max, or
+// no timeout if max is not positive.
+// When the share is below min, the CtxFunc1VariadicError is not called and a
+// *BudgetError, matching ErrBudgetExhausted, is returned.
+func (f CtxFunc1VariadicError[V, P0]) WithBudget(fraction float64, min, max time.Duration) CtxFunc1VariadicError[V, P0] {
+	return func(ctx context.Context, p0 P0, xs ...V) error {
+		timeout, bounded, err := budget(ctx, fraction, min, max)
+		if err != nil {
+			return err
+		}
+		if bounded {
+			var cancel context.CancelFunc
+			ctx, cancel = context.WithTimeout(ctx, timeout)
+			defer cancel()
+		}
+		return f(ctx, p0, xs...)
+	}
+}
+
+// Retry returns a CtxFunc1VariadicError that will retry the CtxFunc1VariadicError until it returns
+// a nil error or the tryAgain function returns false, in which case it
+// returns a *RetryError holding the errors of all the attempts.
+// The options set the backoff between attempts and hooks, e.g. OnRetry.
+// RetryAttemptTimeout gives every attempt its own deadline within the one of
+// the caller.
+func (f CtxFunc1VariadicError[V, P0]) Retry(tryAgain func(attempts int, err error) bool, opts ...RetryOption) CtxFunc1VariadicError[V, P0] {
+	return func(ctx context.Context, p0 P0, xs ...V) error {
+		return retry(ctx, tryAgain, opts, func(ctx context.Context) error {
+			return f(ctx, p0, xs...)
+		})
+	}
+}
+
+// OnErr returns a CtxFunc1VariadicError that will wrap the error with the
+// provided message.
+func (f CtxFunc1VariadicError[V, P0]) OnErr(msg string) CtxFunc1VariadicError[V, P0] {
+	return func(ctx context.Context, p0 P0, xs ...V) error {
+		err := f(ctx, p0, xs...)
+		if err != nil {
+			return fmt.Errorf("%s: %w", msg, err)
+		}
+		return nil
+	}
+}
+
+// CurryVariadic returns a CtxFunc1VariadicError with some of its variadic
+// arguments bound. The bound arguments come before the ones passed to the
+// returned function.
+func (f CtxFunc1VariadicError[V, P0]) CurryVariadic(bound ...V) CtxFunc1VariadicError[V, P0] {
+	return func(ctx context.Context, p0 P0, xs ...V) error {
+		xs = append(append(make([]V, 0, len(bound)+len(xs)), bound...), xs...)
+		return f(ctx, p0, xs...)
+	}
+}
+
+// InjectFaults returns a CtxFunc1VariadicError injecting the errors, panics and latency
+// configured by cfg in its calls, to test how the callers cope with them.
+// See FaultConfig, and DisableFaults for the global kill-switch.
+func (f CtxFunc1VariadicError[V, P0]) InjectFaults(cfg FaultConfig) CtxFunc1VariadicError[V, P0] {
+	fi := newFaultInjector(cfg)
+	return func(ctx context.Context, p0 P0, xs ...V) error {
+		if err := fi.inject(ctx, p0, xs); err != nil {
+			return err
+		}
+		return f(ctx, p0, xs...)
+	}
+}
+
+// Curry1 returns a CtxFuncVariadicError[V] with the first argument of the CtxFunc1VariadicError bound.
+func (f CtxFunc1VariadicError[V, P0]) Curry1(p0 P0) CtxFuncVariadicError[V] {
+	return func(ctx context.Context, xs ...V) error {
+		return f(ctx, p0, xs...)
+	}
+}
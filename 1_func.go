@@ -1,13 +1,14 @@
-//go:generate go run ./generator -arity 10
+// Code generated by powerfunc/generator from func.go.tmpl. DO NOT EDIT.
 
 package powerfunc
 
 import (
+	"context"
 	"fmt"
 	"time"
 )
 
-// Func1 is a function that takes 0 arguments and returns no values.
+// Func1 is a function that takes 1 arguments and returns no values.
 type Func1[P0 any] func(p0 P0)
 
 // Exec executes the Function.
@@ -20,10 +21,15 @@ func (f Func1[P0]) Exec(p0 P0) {
 // Timing returns a Func1 that will log the execution time of the Func1.
 // If no loggers are provided, the default logger (fmt.Println) will be used.
 func (f Func1[P0]) Timing(loggers ...func(d time.Duration)) Func1[P0] {
+	return f.TimingWithClock(RealClock(), loggers...)
+}
+
+// TimingWithClock is like Timing, but measures the execution time with clock.
+func (f Func1[P0]) TimingWithClock(clock Clock, loggers ...func(d time.Duration)) Func1[P0] {
 	return func(p0 P0) {
-		start := time.Now()
+		start := clock.Now()
 		defer func() {
-			dur := time.Since(start)
+			dur := clock.Since(start)
 			for _, logger := range loggers {
 				logger(dur)
 			}
@@ -46,10 +52,61 @@ func (f Func1[P0]) Fallible() Func1Error[P0] {
 	}
 }
 
+// Debounce returns a Func1 that delays its execution until wait has elapsed
+// since the last call, along with the Debouncer controlling it.
+// Only the most recent call is kept and eventually invoked.
+func (f Func1[P0]) Debounce(wait time.Duration, opts ...DebounceOption) (Func1[P0], *Debouncer) {
+	d := newDebouncer(wait, opts...)
+	return func(p0 P0) {
+		d.call(func() {
+			f(p0)
+		})
+	}, d
+}
+
+// Throttle returns a Func1 that executes at most once per interval, along
+// with the Debouncer controlling it.
+// The first call is invoked immediately, and the most recent call made
+// during the interval is invoked at the end of it.
+// The options, e.g. DebounceClock, apply to the underlying Debouncer.
+func (f Func1[P0]) Throttle(interval time.Duration, opts ...DebounceOption) (Func1[P0], *Debouncer) {
+	d := newThrottler(interval, opts...)
+	return func(p0 P0) {
+		d.call(func() {
+			f(p0)
+		})
+	}, d
+}
+
+// Once returns a Func1 that only executes the first time it is called,
+// along with the Once controlling it.
+func (f Func1[P0]) Once(opts ...OnceOption) (Func1[P0], *Once) {
+	o := newOnce(opts...)
+	return func(p0 P0) {
+		_, _ = o.do(context.Background(), false, func() (any, error) {
+			f(p0)
+			return nil, nil
+		})
+	}, o
+}
+
+// Lazy returns a Func1 that executes the first time it is called,
+// along with the Once controlling it.
+// A Func1 cannot fail, so Lazy behaves like Once unless it panics, in which
+// case the next call executes it again.
+func (f Func1[P0]) Lazy(opts ...OnceOption) (Func1[P0], *Once) {
+	o := newLazy(opts...)
+	return func(p0 P0) {
+		_, _ = o.do(context.Background(), false, func() (any, error) {
+			f(p0)
+			return nil, nil
+		})
+	}, o
+}
 
+// Curry1 returns a Func with the first argument of the Func1 bound.
 func (f Func1[P0]) Curry1(p0 P0) Func {
-	return func()  {
+	return func() {
 		f(p0)
 	}
 }
-	
\ No newline at end of file
@@ -1,11 +1,14 @@
+// Code generated by powerfunc/generator from func_error.go.tmpl. DO NOT EDIT.
+
 package powerfunc
 
 import (
+	"context"
 	"fmt"
 	"time"
 )
 
-// Func1Error is a function that takes 0 arguments and returns an error.
+// Func1Error is a function that takes 1 arguments and returns an error.
 type Func1Error[P0 any] func(p0 P0) error
 
 // Exec executes the function and returns the error.
@@ -15,13 +18,18 @@ func (f Func1Error[P0]) Exec(p0 P0) error {
 	return f(p0)
 }
 
-// Timing returns a Func1 that will log the execution time of the Func1.
+// Timing returns a Func1Error that will log the execution time of the Func1Error.
 // If no loggers are provided, the default logger (fmt.Println) will be used.
 func (f Func1Error[P0]) Timing(loggers ...func(d time.Duration)) Func1Error[P0] {
+	return f.TimingWithClock(RealClock(), loggers...)
+}
+
+// TimingWithClock is like Timing, but measures the execution time with clock.
+func (f Func1Error[P0]) TimingWithClock(clock Clock, loggers ...func(d time.Duration)) Func1Error[P0] {
 	return func(p0 P0) error {
-		start := time.Now()
+		start := clock.Now()
 		defer func() {
-			dur := time.Since(start)
+			dur := clock.Since(start)
 			for _, logger := range loggers {
 				logger(dur)
 			}
@@ -34,20 +42,15 @@ func (f Func1Error[P0]) Timing(loggers ...func(d time.Duration)) Func1Error[P0]
 	}
 }
 
-// Retry returns a Function that will retry the Function until it returns
-// a nil error or the tryAgain function returns false.
-func (f Func1Error[P0]) Retry(tryAgain func(attempts int, err error) bool) Func1Error[P0] {
+// Retry returns a Func1Error that will retry the Func1Error until it returns
+// a nil error or the tryAgain function returns false, in which case it
+// returns a *RetryError holding the errors of all the attempts.
+// The options set the backoff between attempts and hooks, e.g. OnRetry.
+func (f Func1Error[P0]) Retry(tryAgain func(attempts int, err error) bool, opts ...RetryOption) Func1Error[P0] {
 	return func(p0 P0) error {
-		var err error
-		attempts := 1
-		for {
-			err = f(p0)
-			if err == nil || !tryAgain(attempts, err) {
-				break
-			}
-			attempts++
-		}
-		return err
+		return retryNoContext(tryAgain, opts, func() error {
+			return f(p0)
+		})
 	}
 }
 
@@ -72,10 +75,68 @@ func (f Func1Error[P0]) OnErr(msg string) Func1Error[P0] {
 	}
 }
 
+// Once returns a Func1Error that only executes the first time it is called
+// and returns the cached error afterwards, along with the Once controlling it.
+// Use OnceRetryOnError or OnceBackoff to only cache a success.
+func (f Func1Error[P0]) Once(opts ...OnceOption) (Func1Error[P0], *Once) {
+	o := newOnce(opts...)
+	return func(p0 P0) error {
+		_, err := o.do(context.Background(), false, func() (any, error) {
+			return nil, f(p0)
+		})
+		return err
+	}, o
+}
+
+// Lazy returns a Func1Error that executes until it succeeds once, along
+// with the Once controlling it.
+// Failures are not cached, unless a backoff is provided with OnceBackoff.
+func (f Func1Error[P0]) Lazy(opts ...OnceOption) (Func1Error[P0], *Once) {
+	o := newLazy(opts...)
+	return func(p0 P0) error {
+		_, err := o.do(context.Background(), false, func() (any, error) {
+			return nil, f(p0)
+		})
+		return err
+	}, o
+}
+
+// InjectFaults returns a Func1Error injecting the errors, panics and latency
+// configured by cfg in its calls, to test how the callers cope with them.
+// See FaultConfig, and DisableFaults for the global kill-switch.
+// The faults cannot be enabled per request with OptIn, as there is no
+// context.
+func (f Func1Error[P0]) InjectFaults(cfg FaultConfig) Func1Error[P0] {
+	fi := newFaultInjector(cfg)
+	return func(p0 P0) error {
+		if err := fi.inject(context.Background(), p0); err != nil {
+			return err
+		}
+		return f(p0)
+	}
+}
+
+// WithTimeout returns a Func1Error that runs the Func1Error in its own
+// goroutine and returns a *TimeoutError, matching ErrTimeout, if it does not
+// return within timeout. The goroutine is then abandoned: it keeps running
+// until the Func1Error returns, and is counted by Abandoned.
+// Prefer the WithTimeout method of a function taking a context, which can
+// actually be cancelled.
+func (f Func1Error[P0]) WithTimeout(timeout time.Duration, opts ...TimeoutOption) Func1Error[P0] {
+	return func(p0 P0) error {
+		var err error
+		if terr := callWithTimeout(timeout, opts, func() {
+			err = f(p0)
+		}); terr != nil {
+			return terr
+		}
+		return err
+	}
+}
 
+// Curry1 returns a FuncError with the first argument of the Func1Error bound.
 func (f Func1Error[P0]) Curry1(p0 P0) FuncError {
 	return func() error {
 		return f(p0)
 	}
 }
-	
\ No newline at end of file
@@ -0,0 +1,148 @@
+// Code generated by powerfunc/generator from func_ok.go.tmpl. DO NOT EDIT.
+
+package powerfunc
+
+import (
+	"fmt"
+	"time"
+)
+
+// Func1Ok is a function that takes 1 arguments and returns a value and a
+// boolean reporting whether the value is valid, like a map lookup.
+type Func1Ok[R, P0 any] func(p0 P0) (R, bool)
+
+// Exec executes the function.
+// Convenience method to remove some of the confusion that
+// `f.SomeMethod()()` can bring.
+func (f Func1Ok[R, P0]) Exec(p0 P0) (R, bool) {
+	return f(p0)
+}
+
+// Timing returns a Func1Ok that will log the execution time of the Func1Ok.
+// If no loggers are provided, the default logger (fmt.Println) will be used.
+func (f Func1Ok[R, P0]) Timing(loggers ...func(d time.Duration)) Func1Ok[R, P0] {
+	return f.TimingWithClock(RealClock(), loggers...)
+}
+
+// TimingWithClock is like Timing, but measures the execution time with clock.
+func (f Func1Ok[R, P0]) TimingWithClock(clock Clock, loggers ...func(d time.Duration)) Func1Ok[R, P0] {
+	return func(p0 P0) (R, bool) {
+		start := clock.Now()
+		defer func() {
+			dur := clock.Since(start)
+			for _, logger := range loggers {
+				logger(dur)
+			}
+			if len(loggers) == 0 {
+				// Default logger
+				fmt.Println(dur)
+			}
+		}()
+		return f(p0)
+	}
+}
+
+// Retry returns a Func1Ok that will retry the Func1Ok until it returns
+// true or the tryAgain function returns false.
+// The attempts that returned false fail with a placeholder error, given to
+// tryAgain and to the hooks of the options, e.g. OnRetry, so that any
+// RetryPolicy can be used.
+func (f Func1Ok[R, P0]) Retry(tryAgain RetryPolicy, opts ...RetryOption) Func1Ok[R, P0] {
+	return func(p0 P0) (R, bool) {
+		var v R
+		var ok bool
+		err := retryNoContext(tryAgain, opts, func() error {
+			v, ok = f(p0)
+			if !ok {
+				return errNotOk
+			}
+			return nil
+		})
+		return v, err == nil
+	}
+}
+
+// Must returns a Func1Value that will panic if the Func1Ok returns false.
+func (f Func1Ok[R, P0]) Must() Func1Value[R, P0] {
+	return func(p0 P0) R {
+		v, ok := f(p0)
+		if !ok {
+			panic("powerfunc: Func1Ok returned false")
+		}
+		return v
+	}
+}
+
+// Fallback returns a Func1Value that will return the provided value if the
+// Func1Ok returns false.
+func (f Func1Ok[R, P0]) Fallback(val R) Func1Value[R, P0] {
+	return func(p0 P0) R {
+		v, ok := f(p0)
+		if !ok {
+			return val
+		}
+		return v
+	}
+}
+
+// OrElse returns a Func1Value that will return the provided value if the
+// Func1Ok returns false. It is an alias of Fallback.
+func (f Func1Ok[R, P0]) OrElse(val R) Func1Value[R, P0] {
+	return f.Fallback(val)
+}
+
+// OrElseGet returns a Func1Value that will call elsef if the Func1Ok
+// returns false.
+func (f Func1Ok[R, P0]) OrElseGet(elsef Func1Value[R, P0]) Func1Value[R, P0] {
+	return func(p0 P0) R {
+		v, ok := f(p0)
+		if !ok {
+			return elsef(p0)
+		}
+		return v
+	}
+}
+
+// ToResult transforms a Func1Ok into a Func1Result returning the provided
+// error when the Func1Ok returns false.
+func (f Func1Ok[R, P0]) ToResult(err error) Func1Result[R, P0] {
+	return func(p0 P0) (R, error) {
+		v, ok := f(p0)
+		if !ok {
+			return v, err
+		}
+		return v, nil
+	}
+}
+
+// Filter returns a Func1Ok that will return false if the value returned by
+// the Func1Ok does not satisfy keep.
+func (f Func1Ok[R, P0]) Filter(keep func(R) bool) Func1Ok[R, P0] {
+	return func(p0 P0) (R, bool) {
+		v, ok := f(p0)
+		if !ok || !keep(v) {
+			var zero R
+			return zero, false
+		}
+		return v, true
+	}
+}
+
+// Map applies the provided function to the value returned by the Func1Ok,
+// if it returns true.
+func (f Func1Ok[R, P0]) Map(fn func(R) R) Func1Ok[R, P0] {
+	return func(p0 P0) (R, bool) {
+		v, ok := f(p0)
+		if !ok {
+			return v, false
+		}
+		return fn(v), true
+	}
+}
+
+// Curry1 returns a FuncOk[R] with the first argument of the Func1Ok bound.
+func (f Func1Ok[R, P0]) Curry1(p0 P0) FuncOk[R] {
+	return func() (R, bool) {
+		return f(p0)
+	}
+}
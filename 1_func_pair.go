@@ -0,0 +1,59 @@
+// Code generated by powerfunc/generator from func_pair.go.tmpl. DO NOT EDIT.
+
+package powerfunc
+
+import (
+	"fmt"
+	"time"
+)
+
+// Func1Pair is a function that takes 1 arguments and returns two values.
+type Func1Pair[R1, R2, P0 any] func(p0 P0) (R1, R2)
+
+// Exec executes the function.
+// Convenience method to remove some of the confusion that
+// `f.SomeMethod()()` can bring.
+func (f Func1Pair[R1, R2, P0]) Exec(p0 P0) (R1, R2) {
+	return f(p0)
+}
+
+// Timing returns a Func1Pair that will log the execution time of the Func1Pair.
+// If no loggers are provided, the default logger (fmt.Println) will be used.
+func (f Func1Pair[R1, R2, P0]) Timing(loggers ...func(d time.Duration)) Func1Pair[R1, R2, P0] {
+	return f.TimingWithClock(RealClock(), loggers...)
+}
+
+// TimingWithClock is like Timing, but measures the execution time with clock.
+func (f Func1Pair[R1, R2, P0]) TimingWithClock(clock Clock, loggers ...func(d time.Duration)) Func1Pair[R1, R2, P0] {
+	return func(p0 P0) (R1, R2) {
+		start := clock.Now()
+		defer func() {
+			dur := clock.Since(start)
+			for _, logger := range loggers {
+				logger(dur)
+			}
+			if len(loggers) == 0 {
+				// Default logger
+				fmt.Println(dur)
+			}
+		}()
+		return f(p0)
+	}
+}
+
+// Fallible transforms a Func1Pair into a Func1PairResult.
+// The returned Func1PairResult will never return an error.
+// Useful when passing a Func1Pair to a function that expects a Func1PairResult.
+func (f Func1Pair[R1, R2, P0]) Fallible() Func1PairResult[R1, R2, P0] {
+	return func(p0 P0) (R1, R2, error) {
+		v1, v2 := f(p0)
+		return v1, v2, nil
+	}
+}
+
+// Curry1 returns a FuncPair[R1, R2] with the first argument of the Func1Pair bound.
+func (f Func1Pair[R1, R2, P0]) Curry1(p0 P0) FuncPair[R1, R2] {
+	return func() (R1, R2) {
+		return f(p0)
+	}
+}
@@ -0,0 +1,132 @@
+// Code generated by powerfunc/generator from func_pair_result.go.tmpl. DO NOT EDIT.
+
+package powerfunc
+
+import (
+	"context"
+	"fmt"
+	"time"
+)
+
+// Func1PairResult is a function that takes 1 arguments and returns two
+// values and an error.
+type Func1PairResult[R1, R2, P0 any] func(p0 P0) (R1, R2, error)
+
+// Exec executes the function.
+// Convenience method to remove some of the confusion that
+// `f.SomeMethod()()` can bring.
+func (f Func1PairResult[R1, R2, P0]) Exec(p0 P0) (R1, R2, error) {
+	return f(p0)
+}
+
+// Timing returns a Func1PairResult that will log the execution time of the Func1PairResult.
+// If no loggers are provided, the default logger (fmt.Println) will be used.
+func (f Func1PairResult[R1, R2, P0]) Timing(loggers ...func(d time.Duration)) Func1PairResult[R1, R2, P0] {
+	return f.TimingWithClock(RealClock(), loggers...)
+}
+
+// TimingWithClock is like Timing, but measures the execution time with clock.
+func (f Func1PairResult[R1, R2, P0]) TimingWithClock(clock Clock, loggers ...func(d time.Duration)) Func1PairResult[R1, R2, P0] {
+	return func(p0 P0) (R1, R2, error) {
+		start := clock.Now()
+		defer func() {
+			dur := clock.Since(start)
+			for _, logger := range loggers {
+				logger(dur)
+			}
+			if len(loggers) == 0 {
+				// Default logger
+				fmt.Println(dur)
+			}
+		}()
+		return f(p0)
+	}
+}
+
+// Retry returns a Func1PairResult that will retry the Func1PairResult until it returns
+// a nil error or the tryAgain function returns false, in which case it
+// returns a *RetryError holding the errors of all the attempts.
+// The options set the backoff between attempts and hooks, e.g. OnRetry.
+func (f Func1PairResult[R1, R2, P0]) Retry(tryAgain func(attempts int, err error) bool, opts ...RetryOption) Func1PairResult[R1, R2, P0] {
+	return func(p0 P0) (R1, R2, error) {
+		var r0 R1
+		var r1 R2
+		err := retryNoContext(tryAgain, opts, func() error {
+			var err error
+			r0, r1, err = f(p0)
+			return err
+		})
+		return r0, r1, err
+	}
+}
+
+// Must returns a Func1Pair that will panic if the Func1PairResult returns an error.
+func (f Func1PairResult[R1, R2, P0]) Must() Func1Pair[R1, R2, P0] {
+	return func(p0 P0) (R1, R2) {
+		v1, v2, err := f(p0)
+		if err != nil {
+			panic(err)
+		}
+		return v1, v2
+	}
+}
+
+// OnErr returns a Func1PairResult that will wrap the error returned by the
+// Func1PairResult with the provided message.
+func (f Func1PairResult[R1, R2, P0]) OnErr(msg string) Func1PairResult[R1, R2, P0] {
+	return func(p0 P0) (R1, R2, error) {
+		v1, v2, err := f(p0)
+		if err != nil {
+			return v1, v2, fmt.Errorf("%s: %w", msg, err)
+		}
+		return v1, v2, nil
+	}
+}
+
+// MapErr applies the provided function to the error returned by the
+// Func1PairResult, if there is an error.
+func (f Func1PairResult[R1, R2, P0]) MapErr(fn func(error) error) Func1PairResult[R1, R2, P0] {
+	return func(p0 P0) (R1, R2, error) {
+		v1, v2, err := f(p0)
+		if err != nil {
+			return v1, v2, fn(err)
+		}
+		return v1, v2, nil
+	}
+}
+
+// Fallback returns a Func1Pair that will return the provided values if the
+// Func1PairResult returns an error.
+func (f Func1PairResult[R1, R2, P0]) Fallback(val1 R1, val2 R2) Func1Pair[R1, R2, P0] {
+	return func(p0 P0) (R1, R2) {
+		v1, v2, err := f(p0)
+		if err != nil {
+			return val1, val2
+		}
+		return v1, v2
+	}
+}
+
+// InjectFaults returns a Func1PairResult injecting the errors, panics and latency
+// configured by cfg in its calls, to test how the callers cope with them.
+// See FaultConfig, and DisableFaults for the global kill-switch.
+// The faults cannot be enabled per request with OptIn, as there is no
+// context.
+func (f Func1PairResult[R1, R2, P0]) InjectFaults(cfg FaultConfig) Func1PairResult[R1, R2, P0] {
+	fi := newFaultInjector(cfg)
+	return func(p0 P0) (R1, R2, error) {
+		if err := fi.inject(context.Background(), p0); err != nil {
+			var r0 R1
+			var r1 R2
+			return r0, r1, err
+		}
+		return f(p0)
+	}
+}
+
+// Curry1 returns a FuncPairResult[R1, R2] with the first argument of the Func1PairResult bound.
+func (f Func1PairResult[R1, R2, P0]) Curry1(p0 P0) FuncPairResult[R1, R2] {
+	return func() (R1, R2, error) {
+		return f(p0)
+	}
+}
@@ -1,28 +1,36 @@
+// Code generated by powerfunc/generator from func_result.go.tmpl. DO NOT EDIT.
+
 package powerfunc
 
 import (
+	"context"
 	"fmt"
 	"time"
 )
 
-// Func1Result is a function that takes 0 arguments and returns a
+// Func1Result is a function that takes 1 arguments and returns a
 // value and an error.
-type Func1Result[T, P0 any] func(p0 P0) (T, error)
+type Func1Result[R, P0 any] func(p0 P0) (R, error)
 
 // Exec executes the function.
 // Convenience method to remove some of the confusion that
 // `f.SomeMethod()()` can bring.
-func (f Func1Result[T, P0]) Exec(p0 P0) (T, error) {
+func (f Func1Result[R, P0]) Exec(p0 P0) (R, error) {
 	return f(p0)
 }
 
-// Timing returns a Func1 that will log the execution time of the Func1.
+// Timing returns a Func1Result that will log the execution time of the Func1Result.
 // If no loggers are provided, the default logger (fmt.Println) will be used.
-func (f Func1Result[T, P0]) Timing(loggers ...func(d time.Duration)) Func1Result[T, P0] {
-	return func(p0 P0) (T, error) {
-		start := time.Now()
+func (f Func1Result[R, P0]) Timing(loggers ...func(d time.Duration)) Func1Result[R, P0] {
+	return f.TimingWithClock(RealClock(), loggers...)
+}
+
+// TimingWithClock is like Timing, but measures the execution time with clock.
+func (f Func1Result[R, P0]) TimingWithClock(clock Clock, loggers ...func(d time.Duration)) Func1Result[R, P0] {
+	return func(p0 P0) (R, error) {
+		start := clock.Now()
 		defer func() {
-			dur := time.Since(start)
+			dur := clock.Since(start)
 			for _, logger := range loggers {
 				logger(dur)
 			}
@@ -35,27 +43,25 @@ func (f Func1Result[T, P0]) Timing(loggers ...func(d time.Duration)) Func1Result
 	}
 }
 
-// Retry returns a Function that will retry the Function until it returns
-// a nil error or the tryAgain function returns false.
-func (f Func1Result[T, P0]) Retry(tryAgain func(attempts int, err error) bool) Func1Result[T, P0] {
-	return func(p0 P0) (T, error) {
-		var v T
-		var err error
-		attempts := 1
-		for {
-			v, err = f(p0)
-			if err == nil || !tryAgain(attempts, err) {
-				break
-			}
-			attempts++
-		}
-		return v, err
+// Retry returns a Func1Result that will retry the Func1Result until it returns
+// a nil error or the tryAgain function returns false, in which case it
+// returns a *RetryError holding the errors of all the attempts.
+// The options set the backoff between attempts and hooks, e.g. OnRetry.
+func (f Func1Result[R, P0]) Retry(tryAgain func(attempts int, err error) bool, opts ...RetryOption) Func1Result[R, P0] {
+	return func(p0 P0) (R, error) {
+		var r0 R
+		err := retryNoContext(tryAgain, opts, func() error {
+			var err error
+			r0, err = f(p0)
+			return err
+		})
+		return r0, err
 	}
 }
 
 // Must returns a Func1Value that will panic if the Func1Result returns an error.
-func (f Func1Result[T, P0]) Must() Func1Value[T, P0] {
-	return func(p0 P0) T {
+func (f Func1Result[R, P0]) Must() Func1Value[R, P0] {
+	return func(p0 P0) R {
 		v, err := f(p0)
 		if err != nil {
 			panic(err)
@@ -66,8 +72,8 @@ func (f Func1Result[T, P0]) Must() Func1Value[T, P0] {
 
 // OnErr returns a Func1Result that will wrap the error returned by the Func1Result
 // with the provided message.
-func (f Func1Result[T, P0]) OnErr(msg string) Func1Result[T, P0] {
-	return func(p0 P0) (T, error) {
+func (f Func1Result[R, P0]) OnErr(msg string) Func1Result[R, P0] {
+	return func(p0 P0) (R, error) {
 		v, err := f(p0)
 		if err != nil {
 			return v, fmt.Errorf("%s: %w", msg, err)
@@ -78,8 +84,8 @@ func (f Func1Result[T, P0]) OnErr(msg string) Func1Result[T, P0] {
 
 // Map applies the provided function to the value returned by the Func1Result,
 // if there is no error.
-func (f Func1Result[T, P0]) Map(fn func(T) T) Func1Result[T, P0] {
-	return func(p0 P0) (T, error) {
+func (f Func1Result[R, P0]) Map(fn func(R) R) Func1Result[R, P0] {
+	return func(p0 P0) (R, error) {
 		v, err := f(p0)
 		if err != nil {
 			return v, err
@@ -90,8 +96,8 @@ func (f Func1Result[T, P0]) Map(fn func(T) T) Func1Result[T, P0] {
 
 // MapErr applies the provided function to the error returned by the Func1Result,
 // if there is an error.
-func (f Func1Result[T, P0]) MapErr(fn func(error) error) Func1Result[T, P0] {
-	return func(p0 P0) (T, error) {
+func (f Func1Result[R, P0]) MapErr(fn func(error) error) Func1Result[R, P0] {
+	return func(p0 P0) (R, error) {
 		v, err := f(p0)
 		if err != nil {
 			return v, fn(err)
@@ -102,8 +108,8 @@ func (f Func1Result[T, P0]) MapErr(fn func(error) error) Func1Result[T, P0] {
 
 // Fallback returns a Func1Value that will return the provided value if the
 // Func1Result returns an error.
-func (f Func1Result[T, P0]) Fallback(val T) Func1Value[T, P0] {
-	return func(p0 P0) T {
+func (f Func1Result[R, P0]) Fallback(val R) Func1Value[R, P0] {
+	return func(p0 P0) R {
 		v, err := f(p0)
 		if err != nil {
 			return val
@@ -112,10 +118,73 @@ func (f Func1Result[T, P0]) Fallback(val T) Func1Value[T, P0] {
 	}
 }
 
+// Once returns a Func1Result that only executes the first time it is called
+// and returns the cached result afterwards, along with the Once controlling it.
+// Use OnceRetryOnError or OnceBackoff to only cache a success.
+func (f Func1Result[R, P0]) Once(opts ...OnceOption) (Func1Result[R, P0], *Once) {
+	o := newOnce(opts...)
+	return func(p0 P0) (R, error) {
+		v, err := o.do(context.Background(), false, func() (any, error) {
+			return f(p0)
+		})
+		val, _ := v.(R)
+		return val, err
+	}, o
+}
+
+// Lazy returns a Func1Result that executes until it succeeds once and
+// returns the cached value afterwards, along with the Once controlling it.
+// Failures are not cached, unless a backoff is provided with OnceBackoff.
+func (f Func1Result[R, P0]) Lazy(opts ...OnceOption) (Func1Result[R, P0], *Once) {
+	o := newLazy(opts...)
+	return func(p0 P0) (R, error) {
+		v, err := o.do(context.Background(), false, func() (any, error) {
+			return f(p0)
+		})
+		val, _ := v.(R)
+		return val, err
+	}, o
+}
+
+// InjectFaults returns a Func1Result injecting the errors, panics and latency
+// configured by cfg in its calls, to test how the callers cope with them.
+// See FaultConfig, and DisableFaults for the global kill-switch.
+// The faults cannot be enabled per request with OptIn, as there is no
+// context.
+func (f Func1Result[R, P0]) InjectFaults(cfg FaultConfig) Func1Result[R, P0] {
+	fi := newFaultInjector(cfg)
+	return func(p0 P0) (R, error) {
+		if err := fi.inject(context.Background(), p0); err != nil {
+			var r0 R
+			return r0, err
+		}
+		return f(p0)
+	}
+}
+
+// WithTimeout returns a Func1Result that runs the Func1Result in its own
+// goroutine and returns a *TimeoutError, matching ErrTimeout, if it does not
+// return within timeout. The goroutine is then abandoned: it keeps running
+// until the Func1Result returns, and is counted by Abandoned.
+// Prefer the WithTimeout method of a function taking a context, which can
+// actually be cancelled.
+func (f Func1Result[R, P0]) WithTimeout(timeout time.Duration, opts ...TimeoutOption) Func1Result[R, P0] {
+	return func(p0 P0) (R, error) {
+		var r0 R
+		var err error
+		if terr := callWithTimeout(timeout, opts, func() {
+			r0, err = f(p0)
+		}); terr != nil {
+			var zero R
+			return zero, terr
+		}
+		return r0, err
+	}
+}
 
+// Curry1 returns a FuncResult[R] with the first argument of the Func1Result bound.
 func (f Func1Result[R, P0]) Curry1(p0 P0) FuncResult[R] {
 	return func() (R, error) {
 		return f(p0)
 	}
 }
-	
\ No newline at end of file
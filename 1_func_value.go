@@ -1,27 +1,35 @@
+// Code generated by powerfunc/generator from func_value.go.tmpl. DO NOT EDIT.
+
 package powerfunc
 
 import (
+	"context"
 	"fmt"
 	"time"
 )
 
-// Func1 is a function that takes 0 arguments and returns a value.
-type Func1Value[T, P0 any] func(p0 P0) T
+// Func1Value is a function that takes 1 arguments and returns a value.
+type Func1Value[R, P0 any] func(p0 P0) R
 
 // Exec executes the function.
 // Convenience method to remove some of the confusion that
 // `f.SomeMethod()()` can bring.
-func (f Func1Value[T, P0]) Exec(p0 P0) T {
+func (f Func1Value[R, P0]) Exec(p0 P0) R {
 	return f(p0)
 }
 
-// Timing returns a Func1 that will log the execution time of the Func1.
+// Timing returns a Func1Value that will log the execution time of the Func1Value.
 // If no loggers are provided, the default logger (fmt.Println) will be used.
-func (f Func1Value[T, P0]) Timing(loggers ...func(d time.Duration)) Func1Value[T, P0] {
-	return func(p0 P0) T {
-		start := time.Now()
+func (f Func1Value[R, P0]) Timing(loggers ...func(d time.Duration)) Func1Value[R, P0] {
+	return f.TimingWithClock(RealClock(), loggers...)
+}
+
+// TimingWithClock is like Timing, but measures the execution time with clock.
+func (f Func1Value[R, P0]) TimingWithClock(clock Clock, loggers ...func(d time.Duration)) Func1Value[R, P0] {
+	return func(p0 P0) R {
+		start := clock.Now()
 		defer func() {
-			dur := time.Since(start)
+			dur := clock.Since(start)
 			for _, logger := range loggers {
 				logger(dur)
 			}
@@ -37,16 +45,61 @@ func (f Func1Value[T, P0]) Timing(loggers ...func(d time.Duration)) Func1Value[T
 // Fallible transforms a Func1Value into a Func1Result.
 // The returned Func1Result will never return an error.
 // Useful when passing a Func1Value to a function that expects a Func1Result.
-func (f Func1Value[T, P0]) Fallible() Func1Result[T, P0] {
-	return func(p0 P0) (T, error) {
+func (f Func1Value[R, P0]) Fallible() Func1Result[R, P0] {
+	return func(p0 P0) (R, error) {
 		return f(p0), nil
 	}
 }
 
+// Once returns a Func1Value that only executes the first time it is called
+// and returns the cached value afterwards, along with the Once controlling it.
+func (f Func1Value[R, P0]) Once(opts ...OnceOption) (Func1Value[R, P0], *Once) {
+	o := newOnce(opts...)
+	return func(p0 P0) R {
+		v, _ := o.do(context.Background(), false, func() (any, error) {
+			return f(p0), nil
+		})
+		val, _ := v.(R)
+		return val
+	}, o
+}
+
+// Lazy returns a Func1Value that computes its value the first time it is
+// called and returns the cached value afterwards, along with the Once
+// controlling it.
+func (f Func1Value[R, P0]) Lazy(opts ...OnceOption) (Func1Value[R, P0], *Once) {
+	o := newLazy(opts...)
+	return func(p0 P0) R {
+		v, _ := o.do(context.Background(), false, func() (any, error) {
+			return f(p0), nil
+		})
+		val, _ := v.(R)
+		return val
+	}, o
+}
+
+// WithTimeout returns a Func1Result that runs the Func1Value in its own
+// goroutine and returns a *TimeoutError, matching ErrTimeout, if it does not
+// return within timeout. The goroutine is then abandoned: it keeps running
+// until the Func1Value returns, and is counted by Abandoned.
+// Prefer the WithTimeout method of a function taking a context, which can
+// actually be cancelled.
+func (f Func1Value[R, P0]) WithTimeout(timeout time.Duration, opts ...TimeoutOption) Func1Result[R, P0] {
+	return func(p0 P0) (R, error) {
+		var r0 R
+		if terr := callWithTimeout(timeout, opts, func() {
+			r0 = f(p0)
+		}); terr != nil {
+			var zero R
+			return zero, terr
+		}
+		return r0, nil
+	}
+}
 
+// Curry1 returns a FuncValue[R] with the first argument of the Func1Value bound.
 func (f Func1Value[R, P0]) Curry1(p0 P0) FuncValue[R] {
 	return func() R {
 		return f(p0)
 	}
 }
-	
\ No newline at end of file
@@ -0,0 +1,225 @@
+// Code generated by powerfunc/generator from ctx_func_ok.go.tmpl. DO NOT EDIT.
+
+package powerfunc
+
+import (
+	"context"
+	"fmt"
+	"time"
+)
+
+type CtxFunc2Ok[R, P0, P1 any] func(ctx context.Context, p0 P0, p1 P1) (R, bool)
+
+func (f CtxFunc2Ok[R, P0, P1]) Exec(ctx context.Context, p0 P0, p1 P1) (R, bool) {
+	return f(ctx, p0, p1)
+}
+
+func (f CtxFunc2Ok[R, P0, P1]) Timing(loggers ...func(d time.Duration)) CtxFunc2Ok[R, P0, P1] {
+	return f.TimingWithClock(RealClock(), loggers...)
+}
+
+// TimingWithClock is like Timing, but measures the execution time with clock.
+func (f CtxFunc2Ok[R, P0, P1]) TimingWithClock(clock Clock, loggers ...func(d time.Duration)) CtxFunc2Ok[R, P0, P1] {
+	return func(ctx context.Context, p0 P0, p1 P1) (R, bool) {
+		start := clock.Now()
+		defer func() {
+			dur := clock.Since(start)
+			for _, logger := range loggers {
+				logger(dur)
+			}
+			if len(loggers) == 0 {
+				// Default logger
+				fmt.Println(dur)
+			}
+		}()
+		return f(ctx, p0, p1)
+	}
+}
+
+func (f CtxFunc2Ok[R, P0, P1]) WithTimeout(timeout time.Duration) CtxFunc2Ok[R, P0, P1] {
+	return func(ctx context.Context, p0 P0, p1 P1) (R, bool) {
+		ctx, cancel := context.WithTimeout(ctx, timeout)
+		defer cancel()
+		return f(ctx, p0, p1)
+	}
+}
+
+func (f CtxFunc2Ok[R, P0, P1]) WithDeadline(deadline time.Time) CtxFunc2Ok[R, P0, P1] {
+	return func(ctx context.Context, p0 P0, p1 P1) (R, bool) {
+		ctx, cancel := context.WithDeadline(ctx, deadline)
+		defer cancel()
+		return f(ctx, p0, p1)
+	}
+}
+
+func (f CtxFunc2Ok[R, P0, P1]) WithCancel() CtxFunc2Ok[R, P0, P1] {
+	return func(ctx context.Context, p0 P0, p1 P1) (R, bool) {
+		ctx, cancel := context.WithCancel(ctx)
+		defer cancel()
+		return f(ctx, p0, p1)
+	}
+}
+
+// WithValue returns a CtxFunc2Ok calling the CtxFunc2Ok with a context
+// carrying val for key, see context.WithValue.
+func (f CtxFunc2Ok[R, P0, P1]) WithValue(key, val any) CtxFunc2Ok[R, P0, P1] {
+	return func(ctx context.Context, p0 P0, p1 P1) (R, bool) {
+		ctx = context.WithValue(ctx, key, val)
+		return f(ctx, p0, p1)
+	}
+}
+
+// WithValueFrom returns a CtxFunc2Ok calling the CtxFunc2Ok with a context
+// carrying the value returned by value for its key. value gets the arguments
+// of every call.
+func (f CtxFunc2Ok[R, P0, P1]) WithValueFrom(value func(ctx context.Context, p0 P0, p1 P1) (key, val any)) CtxFunc2Ok[R, P0, P1] {
+	return func(ctx context.Context, p0 P0, p1 P1) (R, bool) {
+		key, val := value(ctx, p0, p1)
+		ctx = context.WithValue(ctx, key, val)
+		return f(ctx, p0, p1)
+	}
+}
+
+// Detached returns a CtxFunc2Ok calling the CtxFunc2Ok with a context that is
+// never cancelled but keeps the values of the caller's context, see
+// context.WithoutCancel. Useful for cleanups that must run to completion.
+func (f CtxFunc2Ok[R, P0, P1]) Detached() CtxFunc2Ok[R, P0, P1] {
+	return func(ctx context.Context, p0 P0, p1 P1) (R, bool) {
+		ctx = context.WithoutCancel(ctx)
+		return f(ctx, p0, p1)
+	}
+}
+
+// WithTimeoutCause is like WithTimeout, but the context is cancelled with
+// cause when the timeout expires, see context.WithTimeoutCause.
+func (f CtxFunc2Ok[R, P0, P1]) WithTimeoutCause(timeout time.Duration, cause error) CtxFunc2Ok[R, P0, P1] {
+	return func(ctx context.Context, p0 P0, p1 P1) (R, bool) {
+		ctx, cancel := context.WithTimeoutCause(ctx, timeout, cause)
+		defer cancel()
+		return f(ctx, p0, p1)
+	}
+}
+
+// WithDeadlineCause is like WithDeadline, but the context is cancelled with
+// cause when the deadline expires, see context.WithDeadlineCause.
+func (f CtxFunc2Ok[R, P0, P1]) WithDeadlineCause(deadline time.Time, cause error) CtxFunc2Ok[R, P0, P1] {
+	return func(ctx context.Context, p0 P0, p1 P1) (R, bool) {
+		ctx, cancel := context.WithDeadlineCause(ctx, deadline, cause)
+		defer cancel()
+		return f(ctx, p0, p1)
+	}
+}
+
+// Retry returns a CtxFunc2Ok that will retry the CtxFunc2Ok until it returns
+// true or the tryAgain function returns false.
+// The attempts that returned false fail with a placeholder error, given to
+// tryAgain and to the hooks of the options, e.g. OnRetry, so that any
+// RetryPolicy can be used.
+func (f CtxFunc2Ok[R, P0, P1]) Retry(tryAgain RetryPolicy, opts ...RetryOption) CtxFunc2Ok[R, P0, P1] {
+	return func(ctx context.Context, p0 P0, p1 P1) (R, bool) {
+		var v R
+		var ok bool
+		err := retry(ctx, func(attempts int, err error) bool {
+			return ctx.Err() == nil && tryAgain(attempts, err)
+		}, opts, func(ctx context.Context) error {
+			v, ok = f(ctx, p0, p1)
+			if !ok {
+				return errNotOk
+			}
+			return nil
+		})
+		return v, err == nil
+	}
+}
+
+// Must returns a CtxFunc2Value that will panic if the CtxFunc2Ok returns false.
+func (f CtxFunc2Ok[R, P0, P1]) Must() CtxFunc2Value[R, P0, P1] {
+	return func(ctx context.Context, p0 P0, p1 P1) R {
+		v, ok := f(ctx, p0, p1)
+		if !ok {
+			panic("powerfunc: CtxFunc2Ok returned false")
+		}
+		return v
+	}
+}
+
+// Fallback returns a CtxFunc2Value that will return the provided value if the
+// CtxFunc2Ok returns false.
+func (f CtxFunc2Ok[R, P0, P1]) Fallback(val R) CtxFunc2Value[R, P0, P1] {
+	return func(ctx context.Context, p0 P0, p1 P1) R {
+		v, ok := f(ctx, p0, p1)
+		if !ok {
+			return val
+		}
+		return v
+	}
+}
+
+// OrElse returns a CtxFunc2Value that will return the provided value if the
+// CtxFunc2Ok returns false. It is an alias of Fallback.
+func (f CtxFunc2Ok[R, P0, P1]) OrElse(val R) CtxFunc2Value[R, P0, P1] {
+	return f.Fallback(val)
+}
+
+// OrElseGet returns a CtxFunc2Value that will call elsef if the CtxFunc2Ok
+// returns false.
+func (f CtxFunc2Ok[R, P0, P1]) OrElseGet(elsef CtxFunc2Value[R, P0, P1]) CtxFunc2Value[R, P0, P1] {
+	return func(ctx context.Context, p0 P0, p1 P1) R {
+		v, ok := f(ctx, p0, p1)
+		if !ok {
+			return elsef(ctx, p0, p1)
+		}
+		return v
+	}
+}
+
+// ToResult transforms a CtxFunc2Ok into a CtxFunc2Result returning the
+// provided error when the CtxFunc2Ok returns false.
+func (f CtxFunc2Ok[R, P0, P1]) ToResult(err error) CtxFunc2Result[R, P0, P1] {
+	return func(ctx context.Context, p0 P0, p1 P1) (R, error) {
+		v, ok := f(ctx, p0, p1)
+		if !ok {
+			return v, err
+		}
+		return v, nil
+	}
+}
+
+// Filter returns a CtxFunc2Ok that will return false if the value returned by
+// the CtxFunc2Ok does not satisfy keep.
+func (f CtxFunc2Ok[R, P0, P1]) Filter(keep func(R) bool) CtxFunc2Ok[R, P0, P1] {
+	return func(ctx context.Context, p0 P0, p1 P1) (R, bool) {
+		v, ok := f(ctx, p0, p1)
+		if !ok || !keep(v) {
+			var zero R
+			return zero, false
+		}
+		return v, true
+	}
+}
+
+// Map applies the provided function to the value returned by the CtxFunc2Ok,
+// if it returns true.
+func (f CtxFunc2Ok[R, P0, P1]) Map(fn func(R) R) CtxFunc2Ok[R, P0, P1] {
+	return func(ctx context.Context, p0 P0, p1 P1) (R, bool) {
+		v, ok := f(ctx, p0, p1)
+		if !ok {
+			return v, false
+		}
+		return fn(v), true
+	}
+}
+
+// Curry2 returns a CtxFuncOk[R] with the first 2 arguments of the CtxFunc2Ok bound.
+func (f CtxFunc2Ok[R, P0, P1]) Curry2(p0 P0, p1 P1) CtxFuncOk[R] {
+	return func(ctx context.Context) (R, bool) {
+		return f(ctx, p0, p1)
+	}
+}
+
+// Curry1 returns a CtxFunc1Ok[R, P1] with the first argument of the CtxFunc2Ok bound.
+func (f CtxFunc2Ok[R, P0, P1]) Curry1(p0 P0) CtxFunc1Ok[R, P1] {
+	return func(ctx context.Context, p1 P1) (R, bool) {
+		return f(ctx, p0, p1)
+	}
+}
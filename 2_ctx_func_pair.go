@@ -0,0 +1,132 @@
+// Code generated by powerfunc/generator from ctx_func_pair.go.tmpl. DO NOT EDIT.
+
+package powerfunc
+
+import (
+	"context"
+	"fmt"
+	"time"
+)
+
+type CtxFunc2Pair[R1, R2, P0, P1 any] func(ctx context.Context, p0 P0, p1 P1) (R1, R2)
+
+func (f CtxFunc2Pair[R1, R2, P0, P1]) Exec(ctx context.Context, p0 P0, p1 P1) (R1, R2) {
+	return f(ctx, p0, p1)
+}
+
+func (f CtxFunc2Pair[R1, R2, P0, P1]) Timing(loggers ...func(d time.Duration)) CtxFunc2Pair[R1, R2, P0, P1] {
+	return f.TimingWithClock(RealClock(), loggers...)
+}
+
+// TimingWithClock is like Timing, but measures the execution time with clock.
+func (f CtxFunc2Pair[R1, R2, P0, P1]) TimingWithClock(clock Clock, loggers ...func(d time.Duration)) CtxFunc2Pair[R1, R2, P0, P1] {
+	return func(ctx context.Context, p0 P0, p1 P1) (R1, R2) {
+		start := clock.Now()
+		defer func() {
+			dur := clock.Since(start)
+			for _, logger := range loggers {
+				logger(dur)
+			}
+			if len(loggers) == 0 {
+				// Default logger
+				fmt.Println(dur)
+			}
+		}()
+		return f(ctx, p0, p1)
+	}
+}
+
+func (f CtxFunc2Pair[R1, R2, P0, P1]) Fallible() CtxFunc2PairResult[R1, R2, P0, P1] {
+	return func(ctx context.Context, p0 P0, p1 P1) (R1, R2, error) {
+		v1, v2 := f(ctx, p0, p1)
+		return v1, v2, nil
+	}
+}
+
+func (f CtxFunc2Pair[R1, R2, P0, P1]) WithTimeout(timeout time.Duration) CtxFunc2Pair[R1, R2, P0, P1] {
+	return func(ctx context.Context, p0 P0, p1 P1) (R1, R2) {
+		ctx, cancel := context.WithTimeout(ctx, timeout)
+		defer cancel()
+		return f(ctx, p0, p1)
+	}
+}
+
+func (f CtxFunc2Pair[R1, R2, P0, P1]) WithDeadline(deadline time.Time) CtxFunc2Pair[R1, R2, P0, P1] {
+	return func(ctx context.Context, p0 P0, p1 P1) (R1, R2) {
+		ctx, cancel := context.WithDeadline(ctx, deadline)
+		defer cancel()
+		return f(ctx, p0, p1)
+	}
+}
+
+func (f CtxFunc2Pair[R1, R2, P0, P1]) WithCancel() CtxFunc2Pair[R1, R2, P0, P1] {
+	return func(ctx context.Context, p0 P0, p1 P1) (R1, R2) {
+		ctx, cancel := context.WithCancel(ctx)
+		defer cancel()
+		return f(ctx, p0, p1)
+	}
+}
+
+// WithValue returns a CtxFunc2Pair calling the CtxFunc2Pair with a context
+// carrying val for key, see context.WithValue.
+func (f CtxFunc2Pair[R1, R2, P0, P1]) WithValue(key, val any) CtxFunc2Pair[R1, R2, P0, P1] {
+	return func(ctx context.Context, p0 P0, p1 P1) (R1, R2) {
+		ctx = context.WithValue(ctx, key, val)
+		return f(ctx, p0, p1)
+	}
+}
+
+// WithValueFrom returns a CtxFunc2Pair calling the CtxFunc2Pair with a context
+// carrying the value returned by value for its key. value gets the arguments
+// of every call.
+func (f CtxFunc2Pair[R1, R2, P0, P1]) WithValueFrom(value func(ctx context.Context, p0 P0, p1 P1) (key, val any)) CtxFunc2Pair[R1, R2, P0, P1] {
+	return func(ctx context.Context, p0 P0, p1 P1) (R1, R2) {
+		key, val := value(ctx, p0, p1)
+		ctx = context.WithValue(ctx, key, val)
+		return f(ctx, p0, p1)
+	}
+}
+
+// Detached returns a CtxFunc2Pair calling the CtxFunc2Pair with a context that is
+// never cancelled but keeps the values of the caller's context, see
+// context.WithoutCancel. Useful for cleanups that must run to completion.
+func (f CtxFunc2Pair[R1, R2, P0, P1]) Detached() CtxFunc2Pair[R1, R2, P0, P1] {
+	return func(ctx context.Context, p0 P0, p1 P1) (R1, R2) {
+		ctx = context.WithoutCancel(ctx)
+		return f(ctx, p0, p1)
+	}
+}
+
+// WithTimeoutCause is like WithTimeout, but the context is cancelled with
+// cause when the timeout expires, see context.WithTimeoutCause.
+func (f CtxFunc2Pair[R1, R2, P0, P1]) WithTimeoutCause(timeout time.Duration, cause error) CtxFunc2Pair[R1, R2, P0, P1] {
+	return func(ctx context.Context, p0 P0, p1 P1) (R1, R2) {
+		ctx, cancel := context.WithTimeoutCause(ctx, timeout, cause)
+		defer cancel()
+		return f(ctx, p0, p1)
+	}
+}
+
+// WithDeadlineCause is like WithDeadline, but the context is cancelled with
+// cause when the deadline expires, see context.WithDeadlineCause.
+func (f CtxFunc2Pair[R1, R2, P0, P1]) WithDeadlineCause(deadline time.Time, cause error) CtxFunc2Pair[R1, R2, P0, P1] {
+	return func(ctx context.Context, p0 P0, p1 P1) (R1, R2) {
+		ctx, cancel := context.WithDeadlineCause(ctx, deadline, cause)
+		defer cancel()
+		return f(ctx, p0, p1)
+	}
+}
+
+// Curry2 returns a CtxFuncPair[R1, R2] with the first 2 arguments of the CtxFunc2Pair bound.
+func (f CtxFunc2Pair[R1, R2, P0, P1]) Curry2(p0 P0, p1 P1) CtxFuncPair[R1, R2] {
+	return func(ctx context.Context) (R1, R2) {
+		return f(ctx, p0, p1)
+	}
+}
+
+// Curry1 returns a CtxFunc1Pair[R1, R2, P1] with the first argument of the CtxFunc2Pair bound.
+func (f CtxFunc2Pair[R1, R2, P0, P1]) Curry1(p0 P0) CtxFunc1Pair[R1, R2, P1] {
+	return func(ctx context.Context, p1 P1) (R1, R2) {
+		return f(ctx, p0, p1)
+	}
+}
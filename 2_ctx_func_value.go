@@ -1,3 +1,5 @@
+// Code generated by powerfunc/generator from ctx_func_value.go.tmpl. DO NOT EDIT.
+
 package powerfunc
 
 import (
@@ -13,10 +15,15 @@ func (f CtxFunc2Value[R, P0, P1]) Exec(ctx context.Context, p0 P0, p1 P1) R {
 }
 
 func (f CtxFunc2Value[R, P0, P1]) Timing(loggers ...func(d time.Duration)) CtxFunc2Value[R, P0, P1] {
+	return f.TimingWithClock(RealClock(), loggers...)
+}
+
+// TimingWithClock is like Timing, but measures the execution time with clock.
+func (f CtxFunc2Value[R, P0, P1]) TimingWithClock(clock Clock, loggers ...func(d time.Duration)) CtxFunc2Value[R, P0, P1] {
 	return func(ctx context.Context, p0 P0, p1 P1) R {
-		start := time.Now()
+		start := clock.Now()
 		defer func() {
-			dur := time.Since(start)
+			dur := clock.Since(start)
 			for _, logger := range loggers {
 				logger(dur)
 			}
@@ -60,17 +67,115 @@ func (f CtxFunc2Value[R, P0, P1]) WithCancel() CtxFunc2Value[R, P0, P1] {
 	}
 }
 
+// WithValue returns a CtxFunc2Value calling the CtxFunc2Value with a context
+// carrying val for key, see context.WithValue.
+func (f CtxFunc2Value[R, P0, P1]) WithValue(key, val any) CtxFunc2Value[R, P0, P1] {
+	return func(ctx context.Context, p0 P0, p1 P1) R {
+		ctx = context.WithValue(ctx, key, val)
+		return f(ctx, p0, p1)
+	}
+}
+
+// WithValueFrom returns a CtxFunc2Value calling the CtxFunc2Value with a context
+// carrying the value returned by value for its key. value gets the arguments
+// of every call.
+func (f CtxFunc2Value[R, P0, P1]) WithValueFrom(value func(ctx context.Context, p0 P0, p1 P1) (key, val any)) CtxFunc2Value[R, P0, P1] {
+	return func(ctx context.Context, p0 P0, p1 P1) R {
+		key, val := value(ctx, p0, p1)
+		ctx = context.WithValue(ctx, key, val)
+		return f(ctx, p0, p1)
+	}
+}
+
+// Detached returns a CtxFunc2Value calling the CtxFunc2Value with a context that is
+// never cancelled but keeps the values of the caller's context, see
+// context.WithoutCancel. Useful for cleanups that must run to completion.
+func (f CtxFunc2Value[R, P0, P1]) Detached() CtxFunc2Value[R, P0, P1] {
+	return func(ctx context.Context, p0 P0, p1 P1) R {
+		ctx = context.WithoutCancel(ctx)
+		return f(ctx, p0, p1)
+	}
+}
+
+// WithTimeoutCause is like WithTimeout, but the context is cancelled with
+// cause when the timeout expires, see context.WithTimeoutCause.
+func (f CtxFunc2Value[R, P0, P1]) WithTimeoutCause(timeout time.Duration, cause error) CtxFunc2Value[R, P0, P1] {
+	return func(ctx context.Context, p0 P0, p1 P1) R {
+		ctx, cancel := context.WithTimeoutCause(ctx, timeout, cause)
+		defer cancel()
+		return f(ctx, p0, p1)
+	}
+}
+
+// WithDeadlineCause is like WithDeadline, but the context is cancelled with
+// cause when the deadline expires, see context.WithDeadlineCause.
+func (f CtxFunc2Value[R, P0, P1]) WithDeadlineCause(deadline time.Time, cause error) CtxFunc2Value[R, P0, P1] {
+	return func(ctx context.Context, p0 P0, p1 P1) R {
+		ctx, cancel := context.WithDeadlineCause(ctx, deadline, cause)
+		defer cancel()
+		return f(ctx, p0, p1)
+	}
+}
+
+// Once returns a CtxFunc2Value that only executes the first time it is called
+// and returns the cached value afterwards, along with the Once controlling it.
+// The shared execution does not inherit the cancellation of the caller's
+// context. As a CtxFunc2Value cannot report ctx.Err(), every caller waits for
+// the execution to return, even once its own context is done.
+func (f CtxFunc2Value[R, P0, P1]) Once(opts ...OnceOption) (CtxFunc2Value[R, P0, P1], *Once) {
+	o := newOnce(opts...)
+	return func(ctx context.Context, p0 P0, p1 P1) R {
+		v, _ := o.do(context.WithoutCancel(ctx), false, func() (any, error) {
+			ctx := context.WithoutCancel(ctx)
+			return f(ctx, p0, p1), nil
+		})
+		val, _ := v.(R)
+		return val
+	}, o
+}
+
+// Lazy returns a CtxFunc2Value that computes its value the first time it is
+// called and returns the cached value afterwards, along with the Once
+// controlling it.
+// Like Once, every caller waits for the execution to return, even once its
+// own context is done.
+func (f CtxFunc2Value[R, P0, P1]) Lazy(opts ...OnceOption) (CtxFunc2Value[R, P0, P1], *Once) {
+	o := newLazy(opts...)
+	return func(ctx context.Context, p0 P0, p1 P1) R {
+		v, _ := o.do(context.WithoutCancel(ctx), false, func() (any, error) {
+			ctx := context.WithoutCancel(ctx)
+			return f(ctx, p0, p1), nil
+		})
+		val, _ := v.(R)
+		return val
+	}, o
+}
+
+// PollUntil returns a CtxFunc2Result calling the CtxFunc2Value every interval
+// until the returned value satisfies cond.
+// When the context is done or the maximum number of attempts is reached
+// first, it returns the last value and a *PollTimeoutError.
+func (f CtxFunc2Value[R, P0, P1]) PollUntil(cond func(R) bool, interval time.Duration, opts ...PollOption) CtxFunc2Result[R, P0, P1] {
+	return func(ctx context.Context, p0 P0, p1 P1) (R, error) {
+		var v R
+		err := poll(ctx, interval, opts, func() (bool, error) {
+			v = f(ctx, p0, p1)
+			return cond(v), nil
+		})
+		return v, err
+	}
+}
 
+// Curry2 returns a CtxFuncValue[R] with the first 2 arguments of the CtxFunc2Value bound.
 func (f CtxFunc2Value[R, P0, P1]) Curry2(p0 P0, p1 P1) CtxFuncValue[R] {
 	return func(ctx context.Context) R {
 		return f(ctx, p0, p1)
 	}
 }
-	
 
+// Curry1 returns a CtxFunc1Value[R, P1] with the first argument of the CtxFunc2Value bound.
 func (f CtxFunc2Value[R, P0, P1]) Curry1(p0 P0) CtxFunc1Value[R, P1] {
 	return func(ctx context.Context, p1 P1) R {
 		return f(ctx, p0, p1)
 	}
 }
-	
\ No newline at end of file
@@ -1,13 +1,14 @@
-//go:generate go run ./generator -arity 10
+// Code generated by powerfunc/generator from func.go.tmpl. DO NOT EDIT.
 
 package powerfunc
 
 import (
+	"context"
 	"fmt"
 	"time"
 )
 
-// Func2 is a function that takes 0 arguments and returns no values.
+// Func2 is a function that takes 2 arguments and returns no values.
 type Func2[P0, P1 any] func(p0 P0, p1 P1)
 
 // Exec executes the Function.
@@ -20,10 +21,15 @@ func (f Func2[P0, P1]) Exec(p0 P0, p1 P1) {
 // Timing returns a Func2 that will log the execution time of the Func2.
 // If no loggers are provided, the default logger (fmt.Println) will be used.
 func (f Func2[P0, P1]) Timing(loggers ...func(d time.Duration)) Func2[P0, P1] {
+	return f.TimingWithClock(RealClock(), loggers...)
+}
+
+// TimingWithClock is like Timing, but measures the execution time with clock.
+func (f Func2[P0, P1]) TimingWithClock(clock Clock, loggers ...func(d time.Duration)) Func2[P0, P1] {
 	return func(p0 P0, p1 P1) {
-		start := time.Now()
+		start := clock.Now()
 		defer func() {
-			dur := time.Since(start)
+			dur := clock.Since(start)
 			for _, logger := range loggers {
 				logger(dur)
 			}
@@ -46,17 +52,68 @@ func (f Func2[P0, P1]) Fallible() Func2Error[P0, P1] {
 	}
 }
 
+// Debounce returns a Func2 that delays its execution until wait has elapsed
+// since the last call, along with the Debouncer controlling it.
+// Only the most recent call is kept and eventually invoked.
+func (f Func2[P0, P1]) Debounce(wait time.Duration, opts ...DebounceOption) (Func2[P0, P1], *Debouncer) {
+	d := newDebouncer(wait, opts...)
+	return func(p0 P0, p1 P1) {
+		d.call(func() {
+			f(p0, p1)
+		})
+	}, d
+}
+
+// Throttle returns a Func2 that executes at most once per interval, along
+// with the Debouncer controlling it.
+// The first call is invoked immediately, and the most recent call made
+// during the interval is invoked at the end of it.
+// The options, e.g. DebounceClock, apply to the underlying Debouncer.
+func (f Func2[P0, P1]) Throttle(interval time.Duration, opts ...DebounceOption) (Func2[P0, P1], *Debouncer) {
+	d := newThrottler(interval, opts...)
+	return func(p0 P0, p1 P1) {
+		d.call(func() {
+			f(p0, p1)
+		})
+	}, d
+}
+
+// Once returns a Func2 that only executes the first time it is called,
+// along with the Once controlling it.
+func (f Func2[P0, P1]) Once(opts ...OnceOption) (Func2[P0, P1], *Once) {
+	o := newOnce(opts...)
+	return func(p0 P0, p1 P1) {
+		_, _ = o.do(context.Background(), false, func() (any, error) {
+			f(p0, p1)
+			return nil, nil
+		})
+	}, o
+}
+
+// Lazy returns a Func2 that executes the first time it is called,
+// along with the Once controlling it.
+// A Func2 cannot fail, so Lazy behaves like Once unless it panics, in which
+// case the next call executes it again.
+func (f Func2[P0, P1]) Lazy(opts ...OnceOption) (Func2[P0, P1], *Once) {
+	o := newLazy(opts...)
+	return func(p0 P0, p1 P1) {
+		_, _ = o.do(context.Background(), false, func() (any, error) {
+			f(p0, p1)
+			return nil, nil
+		})
+	}, o
+}
 
+// Curry2 returns a Func with the first 2 arguments of the Func2 bound.
 func (f Func2[P0, P1]) Curry2(p0 P0, p1 P1) Func {
-	return func()  {
+	return func() {
 		f(p0, p1)
 	}
 }
-	
 
+// Curry1 returns a Func1[P1] with the first argument of the Func2 bound.
 func (f Func2[P0, P1]) Curry1(p0 P0) Func1[P1] {
-	return func(p1 P1)  {
+	return func(p1 P1) {
 		f(p0, p1)
 	}
 }
-	
\ No newline at end of file
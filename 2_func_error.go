@@ -1,11 +1,14 @@
+// Code generated by powerfunc/generator from func_error.go.tmpl. DO NOT EDIT.
+
 package powerfunc
 
 import (
+	"context"
 	"fmt"
 	"time"
 )
 
-// Func2Error is a function that takes 0 arguments and returns an error.
+// Func2Error is a function that takes 2 arguments and returns an error.
 type Func2Error[P0, P1 any] func(p0 P0, p1 P1) error
 
 // Exec executes the function and returns the error.
@@ -15,13 +18,18 @@ func (f Func2Error[P0, P1]) Exec(p0 P0, p1 P1) error {
 	return f(p0, p1)
 }
 
-// Timing returns a Func2 that will log the execution time of the Func2.
+// Timing returns a Func2Error that will log the execution time of the Func2Error.
 // If no loggers are provided, the default logger (fmt.Println) will be used.
 func (f Func2Error[P0, P1]) Timing(loggers ...func(d time.Duration)) Func2Error[P0, P1] {
+	return f.TimingWithClock(RealClock(), loggers...)
+}
+
+// TimingWithClock is like Timing, but measures the execution time with clock.
+func (f Func2Error[P0, P1]) TimingWithClock(clock Clock, loggers ...func(d time.Duration)) Func2Error[P0, P1] {
 	return func(p0 P0, p1 P1) error {
-		start := time.Now()
+		start := clock.Now()
 		defer func() {
-			dur := time.Since(start)
+			dur := clock.Since(start)
 			for _, logger := range loggers {
 				logger(dur)
 			}
@@ -34,20 +42,15 @@ func (f Func2Error[P0, P1]) Timing(loggers ...func(d time.Duration)) Func2Error[
 	}
 }
 
-// Retry returns a Function that will retry the Function until it returns
-// a nil error or the tryAgain function returns false.
-func (f Func2Error[P0, P1]) Retry(tryAgain func(attempts int, err error) bool) Func2Error[P0, P1] {
+// Retry returns a Func2Error that will retry the Func2Error until it returns
+// a nil error or the tryAgain function returns false, in which case it
+// returns a *RetryError holding the errors of all the attempts.
+// The options set the backoff between attempts and hooks, e.g. OnRetry.
+func (f Func2Error[P0, P1]) Retry(tryAgain func(attempts int, err error) bool, opts ...RetryOption) Func2Error[P0, P1] {
 	return func(p0 P0, p1 P1) error {
-		var err error
-		attempts := 1
-		for {
-			err = f(p0, p1)
-			if err == nil || !tryAgain(attempts, err) {
-				break
-			}
-			attempts++
-		}
-		return err
+		return retryNoContext(tryAgain, opts, func() error {
+			return f(p0, p1)
+		})
 	}
 }
 
@@ -72,17 +75,75 @@ func (f Func2Error[P0, P1]) OnErr(msg string) Func2Error[P0, P1] {
 	}
 }
 
+// Once returns a Func2Error that only executes the first time it is called
+// and returns the cached error afterwards, along with the Once controlling it.
+// Use OnceRetryOnError or OnceBackoff to only cache a success.
+func (f Func2Error[P0, P1]) Once(opts ...OnceOption) (Func2Error[P0, P1], *Once) {
+	o := newOnce(opts...)
+	return func(p0 P0, p1 P1) error {
+		_, err := o.do(context.Background(), false, func() (any, error) {
+			return nil, f(p0, p1)
+		})
+		return err
+	}, o
+}
+
+// Lazy returns a Func2Error that executes until it succeeds once, along
+// with the Once controlling it.
+// Failures are not cached, unless a backoff is provided with OnceBackoff.
+func (f Func2Error[P0, P1]) Lazy(opts ...OnceOption) (Func2Error[P0, P1], *Once) {
+	o := newLazy(opts...)
+	return func(p0 P0, p1 P1) error {
+		_, err := o.do(context.Background(), false, func() (any, error) {
+			return nil, f(p0, p1)
+		})
+		return err
+	}, o
+}
+
+// InjectFaults returns a Func2Error injecting the errors, panics and latency
+// configured by cfg in its calls, to test how the callers cope with them.
+// See FaultConfig, and DisableFaults for the global kill-switch.
+// The faults cannot be enabled per request with OptIn, as there is no
+// context.
+func (f Func2Error[P0, P1]) InjectFaults(cfg FaultConfig) Func2Error[P0, P1] {
+	fi := newFaultInjector(cfg)
+	return func(p0 P0, p1 P1) error {
+		if err := fi.inject(context.Background(), p0, p1); err != nil {
+			return err
+		}
+		return f(p0, p1)
+	}
+}
+
+// WithTimeout returns a Func2Error that runs the Func2Error in its own
+// goroutine and returns a *TimeoutError, matching ErrTimeout, if it does not
+// return within timeout. The goroutine is then abandoned: it keeps running
+// until the Func2Error returns, and is counted by Abandoned.
+// Prefer the WithTimeout method of a function taking a context, which can
+// actually be cancelled.
+func (f Func2Error[P0, P1]) WithTimeout(timeout time.Duration, opts ...TimeoutOption) Func2Error[P0, P1] {
+	return func(p0 P0, p1 P1) error {
+		var err error
+		if terr := callWithTimeout(timeout, opts, func() {
+			err = f(p0, p1)
+		}); terr != nil {
+			return terr
+		}
+		return err
+	}
+}
 
+// Curry2 returns a FuncError with the first 2 arguments of the Func2Error bound.
 func (f Func2Error[P0, P1]) Curry2(p0 P0, p1 P1) FuncError {
 	return func() error {
 		return f(p0, p1)
 	}
 }
-	
 
+// Curry1 returns a Func1Error[P1] with the first argument of the Func2Error bound.
 func (f Func2Error[P0, P1]) Curry1(p0 P0) Func1Error[P1] {
 	return func(p1 P1) error {
 		return f(p0, p1)
 	}
 }
-	
\ No newline at end of file
@@ -0,0 +1,155 @@
+// Code generated by powerfunc/generator from func_ok.go.tmpl. DO NOT EDIT.
+
+package powerfunc
+
+import (
+	"fmt"
+	"time"
+)
+
+// Func2Ok is a function that takes 2 arguments and returns a value and a
+// boolean reporting whether the value is valid, like a map lookup.
+type Func2Ok[R, P0, P1 any] func(p0 P0, p1 P1) (R, bool)
+
+// Exec executes the function.
+// Convenience method to remove some of the confusion that
+// `f.SomeMethod()()` can bring.
+func (f Func2Ok[R, P0, P1]) Exec(p0 P0, p1 P1) (R, bool) {
+	return f(p0, p1)
+}
+
+// Timing returns a Func2Ok that will log the execution time of the Func2Ok.
+// If no loggers are provided, the default logger (fmt.Println) will be used.
+func (f Func2Ok[R, P0, P1]) Timing(loggers ...func(d time.Duration)) Func2Ok[R, P0, P1] {
+	return f.TimingWithClock(RealClock(), loggers...)
+}
+
+// TimingWithClock is like Timing, but measures the execution time with clock.
+func (f Func2Ok[R, P0, P1]) TimingWithClock(clock Clock, loggers ...func(d time.Duration)) Func2Ok[R, P0, P1] {
+	return func(p0 P0, p1 P1) (R, bool) {
+		start := clock.Now()
+		defer func() {
+			dur := clock.Since(start)
+			for _, logger := range loggers {
+				logger(dur)
+			}
+			if len(loggers) == 0 {
+				// Default logger
+				fmt.Println(dur)
+			}
+		}()
+		return f(p0, p1)
+	}
+}
+
+// Retry returns a Func2Ok that will retry the Func2Ok until it returns
+// true or the tryAgain function returns false.
+// The attempts that returned false fail with a placeholder error, given to
+// tryAgain and to the hooks of the options, e.g. OnRetry, so that any
+// RetryPolicy can be used.
+func (f Func2Ok[R, P0, P1]) Retry(tryAgain RetryPolicy, opts ...RetryOption) Func2Ok[R, P0, P1] {
+	return func(p0 P0, p1 P1) (R, bool) {
+		var v R
+		var ok bool
+		err := retryNoContext(tryAgain, opts, func() error {
+			v, ok = f(p0, p1)
+			if !ok {
+				return errNotOk
+			}
+			return nil
+		})
+		return v, err == nil
+	}
+}
+
+// Must returns a Func2Value that will panic if the Func2Ok returns false.
+func (f Func2Ok[R, P0, P1]) Must() Func2Value[R, P0, P1] {
+	return func(p0 P0, p1 P1) R {
+		v, ok := f(p0, p1)
+		if !ok {
+			panic("powerfunc: Func2Ok returned false")
+		}
+		return v
+	}
+}
+
+// Fallback returns a Func2Value that will return the provided value if the
+// Func2Ok returns false.
+func (f Func2Ok[R, P0, P1]) Fallback(val R) Func2Value[R, P0, P1] {
+	return func(p0 P0, p1 P1) R {
+		v, ok := f(p0, p1)
+		if !ok {
+			return val
+		}
+		return v
+	}
+}
+
+// OrElse returns a Func2Value that will return the provided value if the
+// Func2Ok returns false. It is an alias of Fallback.
+func (f Func2Ok[R, P0, P1]) OrElse(val R) Func2Value[R, P0, P1] {
+	return f.Fallback(val)
+}
+
+// OrElseGet returns a Func2Value that will call elsef if the Func2Ok
+// returns false.
+func (f Func2Ok[R, P0, P1]) OrElseGet(elsef Func2Value[R, P0, P1]) Func2Value[R, P0, P1] {
+	return func(p0 P0, p1 P1) R {
+		v, ok := f(p0, p1)
+		if !ok {
+			return elsef(p0, p1)
+		}
+		return v
+	}
+}
+
+// ToResult transforms a Func2Ok into a Func2Result returning the provided
+// error when the Func2Ok returns false.
+func (f Func2Ok[R, P0, P1]) ToResult(err error) Func2Result[R, P0, P1] {
+	return func(p0 P0, p1 P1) (R, error) {
+		v, ok := f(p0, p1)
+		if !ok {
+			return v, err
+		}
+		return v, nil
+	}
+}
+
+// Filter returns a Func2Ok that will return false if the value returned by
+// the Func2Ok does not satisfy keep.
+func (f Func2Ok[R, P0, P1]) Filter(keep func(R) bool) Func2Ok[R, P0, P1] {
+	return func(p0 P0, p1 P1) (R, bool) {
+		v, ok := f(p0, p1)
+		if !ok || !keep(v) {
+			var zero R
+			return zero, false
+		}
+		return v, true
+	}
+}
+
+// Map applies the provided function to the value returned by the Func2Ok,
+// if it returns true.
+func (f Func2Ok[R, P0, P1]) Map(fn func(R) R) Func2Ok[R, P0, P1] {
+	return func(p0 P0, p1 P1) (R, bool) {
+		v, ok := f(p0, p1)
+		if !ok {
+			return v, false
+		}
+		return fn(v), true
+	}
+}
+
+// Curry2 returns a FuncOk[R] with the first 2 arguments of the Func2Ok bound.
+func (f Func2Ok[R, P0, P1]) Curry2(p0 P0, p1 P1) FuncOk[R] {
+	return func() (R, bool) {
+		return f(p0, p1)
+	}
+}
+
+// Curry1 returns a Func1Ok[R, P1] with the first argument of the Func2Ok bound.
+func (f Func2Ok[R, P0, P1]) Curry1(p0 P0) Func1Ok[R, P1] {
+	return func(p1 P1) (R, bool) {
+		return f(p0, p1)
+	}
+}
@@ -0,0 +1,66 @@
+// Code generated by powerfunc/generator from func_pair.go.tmpl. DO NOT EDIT.
+
+package powerfunc
+
+import (
+	"fmt"
+	"time"
+)
+
+// Func2Pair is a function that takes 2 arguments and returns two values.
+type Func2Pair[R1, R2, P0, P1 any] func(p0 P0, p1 P1) (R1, R2)
+
+// Exec executes the function.
+// Convenience method to remove some of the confusion that
+// `f.SomeMethod()()` can bring.
+func (f Func2Pair[R1, R2, P0, P1]) Exec(p0 P0, p1 P1) (R1, R2) {
+	return f(p0, p1)
+}
+
+// Timing returns a Func2Pair that will log the execution time of the Func2Pair.
+// If no loggers are provided, the default logger (fmt.Println) will be used.
+func (f Func2Pair[R1, R2, P0, P1]) Timing(loggers ...func(d time.Duration)) Func2Pair[R1, R2, P0, P1] {
+	return f.TimingWithClock(RealClock(), loggers...)
+}
+
+// TimingWithClock is like Timing, but measures the execution time with clock.
+func (f Func2Pair[R1, R2, P0, P1]) TimingWithClock(clock Clock, loggers ...func(d time.Duration)) Func2Pair[R1, R2, P0, P1] {
+	return func(p0 P0, p1 P1) (R1, R2) {
+		start := clock.Now()
+		defer func() {
+			dur := clock.Since(start)
+			for _, logger := range loggers {
+				logger(dur)
+			}
+			if len(loggers) == 0 {
+				// Default logger
+				fmt.Println(dur)
+			}
+		}()
+		return f(p0, p1)
+	}
+}
+
+// Fallible transforms a Func2Pair into a Func2PairResult.
+// The returned Func2PairResult will never return an error.
+// Useful when passing a Func2Pair to a function that expects a Func2PairResult.
+func (f Func2Pair[R1, R2, P0, P1]) Fallible() Func2PairResult[R1, R2, P0, P1] {
+	return func(p0 P0, p1 P1) (R1, R2, error) {
+		v1, v2 := f(p0, p1)
+		return v1, v2, nil
+	}
+}
+
+// Curry2 returns a FuncPair[R1, R2] with the first 2 arguments of the Func2Pair bound.
+func (f Func2Pair[R1, R2, P0, P1]) Curry2(p0 P0, p1 P1) FuncPair[R1, R2] {
+	return func() (R1, R2) {
+		return f(p0, p1)
+	}
+}
+
+// Curry1 returns a Func1Pair[R1, R2, P1] with the first argument of the Func2Pair bound.
+func (f Func2Pair[R1, R2, P0, P1]) Curry1(p0 P0) Func1Pair[R1, R2, P1] {
+	return func(p1 P1) (R1, R2) {
+		return f(p0, p1)
+	}
+}
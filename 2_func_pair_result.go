@@ -0,0 +1,139 @@
+// Code generated by powerfunc/generator from func_pair_result.go.tmpl. DO NOT EDIT.
+
+package powerfunc
+
+import (
+	"context"
+	"fmt"
+	"time"
+)
+
+// Func2PairResult is a function that takes 2 arguments and returns two
+// values and an error.
+type Func2PairResult[R1, R2, P0, P1 any] func(p0 P0, p1 P1) (R1, R2, error)
+
+// Exec executes the function.
+// Convenience method to remove some of the confusion that
+// `f.SomeMethod()()` can bring.
+func (f Func2PairResult[R1, R2, P0, P1]) Exec(p0 P0, p1 P1) (R1, R2, error) {
+	return f(p0, p1)
+}
+
+// Timing returns a Func2PairResult that will log the execution time of the Func2PairResult.
+// If no loggers are provided, the default logger (fmt.Println) will be used.
+func (f Func2PairResult[R1, R2, P0, P1]) Timing(loggers ...func(d time.Duration)) Func2PairResult[R1, R2, P0, P1] {
+	return f.TimingWithClock(RealClock(), loggers...)
+}
+
+// TimingWithClock is like Timing, but measures the execution time with clock.
+func (f Func2PairResult[R1, R2, P0, P1]) TimingWithClock(clock Clock, loggers ...func(d time.Duration)) Func2PairResult[R1, R2, P0, P1] {
+	return func(p0 P0, p1 P1) (R1, R2, error) {
+		start := clock.Now()
+		defer func() {
+			dur := clock.Since(start)
+			for _, logger := range loggers {
+				logger(dur)
+			}
+			if len(loggers) == 0 {
+				// Default logger
+				fmt.Println(dur)
+			}
+		}()
+		return f(p0, p1)
+	}
+}
+
+// Retry returns a Func2PairResult that will retry the Func2PairResult until it returns
+// a nil error or the tryAgain function returns false, in which case it
+// returns a *RetryError holding the errors of all the attempts.
+// The options set the backoff between attempts and hooks, e.g. OnRetry.
+func (f Func2PairResult[R1, R2, P0, P1]) Retry(tryAgain func(attempts int, err error) bool, opts ...RetryOption) Func2PairResult[R1, R2, P0, P1] {
+	return func(p0 P0, p1 P1) (R1, R2, error) {
+		var r0 R1
+		var r1 R2
+		err := retryNoContext(tryAgain, opts, func() error {
+			var err error
+			r0, r1, err = f(p0, p1)
+			return err
+		})
+		return r0, r1, err
+	}
+}
+
+// Must returns a Func2Pair that will panic if the Func2PairResult returns an error.
+func (f Func2PairResult[R1, R2, P0, P1]) Must() Func2Pair[R1, R2, P0, P1] {
+	return func(p0 P0, p1 P1) (R1, R2) {
+		v1, v2, err := f(p0, p1)
+		if err != nil {
+			panic(err)
+		}
+		return v1, v2
+	}
+}
+
+// OnErr returns a Func2PairResult that will wrap the error returned by the
+// Func2PairResult with the provided message.
+func (f Func2PairResult[R1, R2, P0, P1]) OnErr(msg string) Func2PairResult[R1, R2, P0, P1] {
+	return func(p0 P0, p1 P1) (R1, R2, error) {
+		v1, v2, err := f(p0, p1)
+		if err != nil {
+			return v1, v2, fmt.Errorf("%s: %w", msg, err)
+		}
+		return v1, v2, nil
+	}
+}
+
+// MapErr applies the provided function to the error returned by the
+// Func2PairResult, if there is an error.
+func (f Func2PairResult[R1, R2, P0, P1]) MapErr(fn func(error) error) Func2PairResult[R1, R2, P0, P1] {
+	return func(p0 P0, p1 P1) (R1, R2, error) {
+		v1, v2, err := f(p0, p1)
+		if err != nil {
+			return v1, v2, fn(err)
+		}
+		return v1, v2, nil
+	}
+}
+
+// Fallback returns a Func2Pair that will return the provided values if the
+// Func2PairResult returns an error.
+func (f Func2PairResult[R1, R2, P0, P1]) Fallback(val1 R1, val2 R2) Func2Pair[R1, R2, P0, P1] {
+	return func(p0 P0, p1 P1) (R1, R2) {
+		v1, v2, err := f(p0, p1)
+		if err != nil {
+			return val1, val2
+		}
+		return v1, v2
+	}
+}
+
+// InjectFaults returns a Func2PairResult injecting the errors, panics and latency
+// configured by cfg in its calls, to test how the callers cope with them.
+// See FaultConfig, and DisableFaults for the global kill-switch.
+// The faults cannot be enabled per request with OptIn, as there is no
+// context.
+func (f Func2PairResult[R1, R2, P0, P1]) InjectFaults(cfg FaultConfig) Func2PairResult[R1, R2, P0, P1] {
+	fi := newFaultInjector(cfg)
+	return func(p0 P0, p1 P1) (R1, R2, error) {
+		if err := fi.inject(context.Background(), p0, p1); err != nil {
+			var r0 R1
+			var r1 R2
+			return r0, r1, err
+		}
+		return f(p0, p1)
+	}
+}
+
+// Curry2 returns a FuncPairResult[R1, R2] with the first 2 arguments of the Func2PairResult bound.
+func (f Func2PairResult[R1, R2, P0, P1]) Curry2(p0 P0, p1 P1) FuncPairResult[R1, R2] {
+	return func() (R1, R2, error) {
+		return f(p0, p1)
+	}
+}
+
+// Curry1 returns a Func1PairResult[R1, R2, P1] with the first argument of the Func2PairResult bound.
+func (f Func2PairResult[R1, R2, P0, P1]) Curry1(p0 P0) Func1PairResult[R1, R2, P1] {
+	return func(p1 P1) (R1, R2, error) {
+		return f(p0, p1)
+	}
+}
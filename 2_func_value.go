@@ -1,27 +1,35 @@
+// Code generated by powerfunc/generator from func_value.go.tmpl. DO NOT EDIT.
+
 package powerfunc
 
 import (
+	"context"
 	"fmt"
 	"time"
 )
 
-// Func2 is a function that takes 0 arguments and returns a value.
-type Func2Value[T, P0, P1 any] func(p0 P0, p1 P1) T
+// Func2Value is a function that takes 2 arguments and returns a value.
+type Func2Value[R, P0, P1 any] func(p0 P0, p1 P1) R
 
 // Exec executes the function.
 // Convenience method to remove some of the confusion that
 // `f.SomeMethod()()` can bring.
-func (f Func2Value[T, P0, P1]) Exec(p0 P0, p1 P1) T {
+func (f Func2Value[R, P0, P1]) Exec(p0 P0, p1 P1) R {
 	return f(p0, p1)
 }
 
-// Timing returns a Func2 that will log the execution time of the Func2.
+// Timing returns a Func2Value that will log the execution time of the Func2Value.
 // If no loggers are provided, the default logger (fmt.Println) will be used.
-func (f Func2Value[T, P0, P1]) Timing(loggers ...func(d time.Duration)) Func2Value[T, P0, P1] {
-	return func(p0 P0, p1 P1) T {
-		start := time.Now()
+func (f Func2Value[R, P0, P1]) Timing(loggers ...func(d time.Duration)) Func2Value[R, P0, P1] {
+	return f.TimingWithClock(RealClock(), loggers...)
+}
+
+// TimingWithClock is like Timing, but measures the execution time with clock.
+func (f Func2Value[R, P0, P1]) TimingWithClock(clock Clock, loggers ...func(d time.Duration)) Func2Value[R, P0, P1] {
+	return func(p0 P0, p1 P1) R {
+		start := clock.Now()
 		defer func() {
-			dur := time.Since(start)
+			dur := clock.Since(start)
 			for _, logger := range loggers {
 				logger(dur)
 			}
@@ -37,23 +45,68 @@ func (f Func2Value[T, P0, P1]) Timing(loggers ...func(d time.Duration)) Func2Val
 // Fallible transforms a Func2Value into a Func2Result.
 // The returned Func2Result will never return an error.
 // Useful when passing a Func2Value to a function that expects a Func2Result.
-func (f Func2Value[T, P0, P1]) Fallible() Func2Result[T, P0, P1] {
-	return func(p0 P0, p1 P1) (T, error) {
+func (f Func2Value[R, P0, P1]) Fallible() Func2Result[R, P0, P1] {
+	return func(p0 P0, p1 P1) (R, error) {
 		return f(p0, p1), nil
 	}
 }
 
+// Once returns a Func2Value that only executes the first time it is called
+// and returns the cached value afterwards, along with the Once controlling it.
+func (f Func2Value[R, P0, P1]) Once(opts ...OnceOption) (Func2Value[R, P0, P1], *Once) {
+	o := newOnce(opts...)
+	return func(p0 P0, p1 P1) R {
+		v, _ := o.do(context.Background(), false, func() (any, error) {
+			return f(p0, p1), nil
+		})
+		val, _ := v.(R)
+		return val
+	}, o
+}
+
+// Lazy returns a Func2Value that computes its value the first time it is
+// called and returns the cached value afterwards, along with the Once
+// controlling it.
+func (f Func2Value[R, P0, P1]) Lazy(opts ...OnceOption) (Func2Value[R, P0, P1], *Once) {
+	o := newLazy(opts...)
+	return func(p0 P0, p1 P1) R {
+		v, _ := o.do(context.Background(), false, func() (any, error) {
+			return f(p0, p1), nil
+		})
+		val, _ := v.(R)
+		return val
+	}, o
+}
+
+// WithTimeout returns a Func2Result that runs the Func2Value in its own
+// goroutine and returns a *TimeoutError, matching ErrTimeout, if it does not
+// return within timeout. The goroutine is then abandoned: it keeps running
+// until the Func2Value returns, and is counted by Abandoned.
+// Prefer the WithTimeout method of a function taking a context, which can
+// actually be cancelled.
+func (f Func2Value[R, P0, P1]) WithTimeout(timeout time.Duration, opts ...TimeoutOption) Func2Result[R, P0, P1] {
+	return func(p0 P0, p1 P1) (R, error) {
+		var r0 R
+		if terr := callWithTimeout(timeout, opts, func() {
+			r0 = f(p0, p1)
+		}); terr != nil {
+			var zero R
+			return zero, terr
+		}
+		return r0, nil
+	}
+}
 
+// Curry2 returns a FuncValue[R] with the first 2 arguments of the Func2Value bound.
 func (f Func2Value[R, P0, P1]) Curry2(p0 P0, p1 P1) FuncValue[R] {
 	return func() R {
 		return f(p0, p1)
 	}
 }
-	
 
+// Curry1 returns a Func1Value[R, P1] with the first argument of the Func2Value bound.
 func (f Func2Value[R, P0, P1]) Curry1(p0 P0) Func1Value[R, P1] {
 	return func(p1 P1) R {
 		return f(p0, p1)
 	}
 }
-	
\ No newline at end of file
@@ -0,0 +1,125 @@
+// Code generated by powerfunc/generator from func_variadic_error.go.tmpl. DO NOT EDIT.
+
+package powerfunc
+
+import (
+	"context"
+	"fmt"
+	"time"
+)
+
+// Func2VariadicError is a function that takes a variable number of
+// arguments and returns an error.
+type Func2VariadicError[V, P0, P1 any] func(p0 P0, p1 P1, xs ...V) error
+
+// Exec executes the function and returns the error.
+// Convenience method to remove some of the confusion that
+// `f.SomeMethod()()` can bring.
+func (f Func2VariadicError[V, P0, P1]) Exec(p0 P0, p1 P1, xs ...V) error {
+	return f(p0, p1, xs...)
+}
+
+// Timing returns a Func2VariadicError that will log the execution time of the Func2VariadicError.
+// If no loggers are provided, the default logger (fmt.Println) will be used.
+func (f Func2VariadicError[V, P0, P1]) Timing(loggers ...func(d time.Duration)) Func2VariadicError[V, P0, P1] {
+	return f.TimingWithClock(RealClock(), loggers...)
+}
+
+// TimingWithClock is like Timing, but measures the execution time with clock.
+func (f Func2VariadicError[V, P0, P1]) TimingWithClock(clock Clock, loggers ...func(d time.Duration)) Func2VariadicError[V, P0, P1] {
+	return func(p0 P0, p1 P1, xs ...V) error {
+		start := clock.Now()
+		defer func() {
+			dur := clock.Since(start)
+			for _, logger := range loggers {
+				logger(dur)
+			}
+			if len(loggers) == 0 {
+				// Default logger
+				fmt.Println(dur)
+			}
+		}()
+		return f(p0, p1, xs...)
+	}
+}
+
+// Retry returns a Func2VariadicError that will retry the Func2VariadicError until it returns
+// a nil error or the tryAgain function returns false, in which case it
+// returns a *RetryError holding the errors of all the attempts.
+// The options set the backoff between attempts and hooks, e.g. OnRetry.
+func (f Func2VariadicError[V, P0, P1]) Retry(tryAgain func(attempts int, err error) bool, opts ...RetryOption) Func2VariadicError[V, P0, P1] {
+	return func(p0 P0, p1 P1, xs ...V) error {
+		return retryNoContext(tryAgain, opts, func() error {
+			return f(p0, p1, xs...)
+		})
+	}
+}
+
+// OnErr returns a Func2VariadicError that will wrap the error with the
+// provided message.
+func (f Func2VariadicError[V, P0, P1]) OnErr(msg string) Func2VariadicError[V, P0, P1] {
+	return func(p0 P0, p1 P1, xs ...V) error {
+		err := f(p0, p1, xs...)
+		if err != nil {
+			return fmt.Errorf("%s: %w", msg, err)
+		}
+		return nil
+	}
+}
+
+// WithTimeout returns a Func2VariadicError that runs the Func2VariadicError in its own
+// goroutine and returns a *TimeoutError, matching ErrTimeout, if it does not
+// return within timeout. The goroutine is then abandoned: it keeps running
+// until the Func2VariadicError returns, and is counted by Abandoned.
+// Prefer the WithTimeout method of a function taking a context, which can
+// actually be cancelled.
+func (f Func2VariadicError[V, P0, P1]) WithTimeout(timeout time.Duration, opts ...TimeoutOption) Func2VariadicError[V, P0, P1] {
+	return func(p0 P0, p1 P1, xs ...V) error {
+		var err error
+		if terr := callWithTimeout(timeout, opts, func() {
+			err = f(p0, p1, xs...)
+		}); terr != nil {
+			return terr
+		}
+		return err
+	}
+}
+
+// CurryVariadic returns a Func2VariadicError with some of its variadic
+// arguments bound. The bound arguments come before the ones passed to the
+// returned function.
+func (f Func2VariadicError[V, P0, P1]) CurryVariadic(bound ...V) Func2VariadicError[V, P0, P1] {
+	return func(p0 P0, p1 P1, xs ...V) error {
+		xs = append(append(make([]V, 0, len(bound)+len(xs)), bound...), xs...)
+		return f(p0, p1, xs...)
+	}
+}
+
+// InjectFaults returns a Func2VariadicError injecting the errors, panics and latency
+// configured by cfg in its calls, to test how the callers cope with them.
+// See FaultConfig, and DisableFaults for the global kill-switch.
+// The faults cannot be enabled per request with OptIn, as there is no
+// context.
+func (f Func2VariadicError[V, P0, P1]) InjectFaults(cfg FaultConfig) Func2VariadicError[V, P0, P1] {
+	fi := newFaultInjector(cfg)
+	return func(p0 P0, p1 P1, xs ...V) error {
+		if err := fi.inject(context.Background(), p0, p1, xs); err != nil {
+			return err
+		}
+		return f(p0, p1, xs...)
+	}
+}
+
+// Curry2 returns a FuncVariadicError[V] with the first 2 arguments of the Func2VariadicError bound.
+func (f Func2VariadicError[V, P0, P1]) Curry2(p0 P0, p1 P1) FuncVariadicError[V] {
+	return func(xs ...V) error {
+		return f(p0, p1, xs...)
+	}
+}
+
+// Curry1 returns a Func1VariadicError[V, P1] with the first argument of the Func2VariadicError bound.
+func (f Func2VariadicError[V, P0, P1]) Curry1(p0 P0) Func1VariadicError[V, P1] {
+	return func(p1 P1, xs ...V) error {
+		return f(p0, p1, xs...)
+	}
+}
@@ -1,3 +1,5 @@
+// Code generated by powerfunc/generator from ctx_func_error.go.tmpl. DO NOT EDIT.
+
 package powerfunc
 
 import (
@@ -13,10 +15,15 @@ func (f CtxFunc3Error[P0, P1, P2]) Exec(ctx context.Context, p0 P0, p1 P1, p2 P2
 }
 
 func (f CtxFunc3Error[P0, P1, P2]) Timing(loggers ...func(d time.Duration)) CtxFunc3Error[P0, P1, P2] {
+	return f.TimingWithClock(RealClock(), loggers...)
+}
+
+// TimingWithClock is like Timing, but measures the execution time with clock.
+func (f CtxFunc3Error[P0, P1, P2]) TimingWithClock(clock Clock, loggers ...func(d time.Duration)) CtxFunc3Error[P0, P1, P2] {
 	return func(ctx context.Context, p0 P0, p1 P1, p2 P2) error {
-		start := time.Now()
+		start := clock.Now()
 		defer func() {
-			dur := time.Since(start)
+			dur := clock.Since(start)
 			for _, logger := range loggers {
 				logger(dur)
 			}
@@ -53,24 +60,98 @@ func (f CtxFunc3Error[P0, P1, P2]) WithCancel() CtxFunc3Error[P0, P1, P2] {
 	}
 }
 
-// Retry returns a Function that will retry the Function until it returns
-// a nil error or the tryAgain function returns false.
-func (f CtxFunc3Error[P0, P1, P2]) Retry(tryAgain func(attempts int, err error) bool) CtxFunc3Error[P0, P1, P2] {
+// WithValue returns a CtxFunc3Error calling the CtxFunc3Error with a context
+// carrying val for key, see context.WithValue.
+func (f CtxFunc3Error[P0, P1, P2]) WithValue(key, val any) CtxFunc3Error[P0, P1, P2] {
 	return func(ctx context.Context, p0 P0, p1 P1, p2 P2) error {
-		var err error
-		attempts := 1
-		for {
-			err = f(ctx, p0, p1, p2)
-			if err != nil && tryAgain(attempts, err) {
-				break
-			}
-			attempts++
+		ctx = context.WithValue(ctx, key, val)
+		return f(ctx, p0, p1, p2)
+	}
+}
+
+// WithValueFrom returns a CtxFunc3Error calling the CtxFunc3Error with a context
+// carrying the value returned by value for its key. value gets the arguments
+// of every call.
+func (f CtxFunc3Error[P0, P1, P2]) WithValueFrom(value func(ctx context.Context, p0 P0, p1 P1, p2 P2) (key, val any)) CtxFunc3Error[P0, P1, P2] {
+	return func(ctx context.Context, p0 P0, p1 P1, p2 P2) error {
+		key, val := value(ctx, p0, p1, p2)
+		ctx = context.WithValue(ctx, key, val)
+		return f(ctx, p0, p1, p2)
+	}
+}
+
+// Detached returns a CtxFunc3Error calling the CtxFunc3Error with a context that is
+// never cancelled but keeps the values of the caller's context, see
+// context.WithoutCancel. Useful for cleanups that must run to completion.
+func (f CtxFunc3Error[P0, P1, P2]) Detached() CtxFunc3Error[P0, P1, P2] {
+	return func(ctx context.Context, p0 P0, p1 P1, p2 P2) error {
+		ctx = context.WithoutCancel(ctx)
+		return f(ctx, p0, p1, p2)
+	}
+}
+
+// WithTimeoutCause is like WithTimeout, but the context is cancelled with
+// cause when the timeout expires, see context.WithTimeoutCause.
+// When the CtxFunc3Error then fails with the error of the context, the returned
+// error wraps cause as well, to tell which timeout expired.
+func (f CtxFunc3Error[P0, P1, P2]) WithTimeoutCause(timeout time.Duration, cause error) CtxFunc3Error[P0, P1, P2] {
+	return func(ctx context.Context, p0 P0, p1 P1, p2 P2) error {
+		ctx, cancel := context.WithTimeoutCause(ctx, timeout, cause)
+		defer cancel()
+		err := f(ctx, p0, p1, p2)
+		return withCause(ctx, err)
+	}
+}
+
+// WithDeadlineCause is like WithDeadline, but the context is cancelled with
+// cause when the deadline expires, see context.WithDeadlineCause.
+// When the CtxFunc3Error then fails with the error of the context, the returned
+// error wraps cause as well, to tell which deadline expired.
+func (f CtxFunc3Error[P0, P1, P2]) WithDeadlineCause(deadline time.Time, cause error) CtxFunc3Error[P0, P1, P2] {
+	return func(ctx context.Context, p0 P0, p1 P1, p2 P2) error {
+		ctx, cancel := context.WithDeadlineCause(ctx, deadline, cause)
+		defer cancel()
+		err := f(ctx, p0, p1, p2)
+		return withCause(ctx, err)
+	}
+}
+
+// WithBudget returns a CtxFunc3Error giving the CtxFunc3Error a fraction of the
+// remaining deadline of the caller, capped at max when max is positive,
+// instead of a fixed timeout. Without a deadline, the CtxFunc3Error gets max, or
+// no timeout if max is not positive.
+// When the share is below min, the CtxFunc3Error is not called and a
+// *BudgetError, matching ErrBudgetExhausted, is returned.
+func (f CtxFunc3Error[P0, P1, P2]) WithBudget(fraction float64, min, max time.Duration) CtxFunc3Error[P0, P1, P2] {
+	return func(ctx context.Context, p0 P0, p1 P1, p2 P2) error {
+		timeout, bounded, err := budget(ctx, fraction, min, max)
+		if err != nil {
+			return err
 		}
-		return err
+		if bounded {
+			var cancel context.CancelFunc
+			ctx, cancel = context.WithTimeout(ctx, timeout)
+			defer cancel()
+		}
+		return f(ctx, p0, p1, p2)
 	}
 }
 
-// Must returns a Func3Value that will panic if the CtxFunc3Result returns an error.
+// Retry returns a CtxFunc3Error that will retry the CtxFunc3Error until it returns
+// a nil error or the tryAgain function returns false, in which case it
+// returns a *RetryError holding the errors of all the attempts.
+// The options set the backoff between attempts and hooks, e.g. OnRetry.
+// RetryAttemptTimeout gives every attempt its own deadline within the one of
+// the caller.
+func (f CtxFunc3Error[P0, P1, P2]) Retry(tryAgain func(attempts int, err error) bool, opts ...RetryOption) CtxFunc3Error[P0, P1, P2] {
+	return func(ctx context.Context, p0 P0, p1 P1, p2 P2) error {
+		return retry(ctx, tryAgain, opts, func(ctx context.Context) error {
+			return f(ctx, p0, p1, p2)
+		})
+	}
+}
+
+// Must returns a CtxFunc3 that will panic if the CtxFunc3Error returns an error.
 func (f CtxFunc3Error[P0, P1, P2]) Must() CtxFunc3[P0, P1, P2] {
 	return func(ctx context.Context, p0 P0, p1 P1, p2 P2) {
 		err := f(ctx, p0, p1, p2)
@@ -80,7 +161,7 @@ func (f CtxFunc3Error[P0, P1, P2]) Must() CtxFunc3[P0, P1, P2] {
 	}
 }
 
-// OnErr returns a CtxFunc3Result that will wrap the error returned by the CtxFunc3Result
+// OnErr returns a CtxFunc3Error that will wrap the error returned by the CtxFunc3Error
 // with the provided message.
 func (f CtxFunc3Error[P0, P1, P2]) OnErr(msg string) CtxFunc3Error[P0, P1, P2] {
 	return func(ctx context.Context, p0 P0, p1 P1, p2 P2) error {
@@ -92,24 +173,81 @@ func (f CtxFunc3Error[P0, P1, P2]) OnErr(msg string) CtxFunc3Error[P0, P1, P2] {
 	}
 }
 
+// Once returns a CtxFunc3Error that only executes the first time it is called
+// and returns the cached error afterwards, along with the Once controlling it.
+// Use OnceRetryOnError or OnceBackoff to only cache a success.
+// The shared execution does not inherit the cancellation of the caller's
+// context: a caller whose context is done stops waiting and gets ctx.Err(),
+// while the execution carries on for the other callers.
+func (f CtxFunc3Error[P0, P1, P2]) Once(opts ...OnceOption) (CtxFunc3Error[P0, P1, P2], *Once) {
+	o := newOnce(opts...)
+	return func(ctx context.Context, p0 P0, p1 P1, p2 P2) error {
+		_, err := o.do(ctx, true, func() (any, error) {
+			ctx := context.WithoutCancel(ctx)
+			return nil, f(ctx, p0, p1, p2)
+		})
+		return err
+	}, o
+}
+
+// Lazy returns a CtxFunc3Error that executes until it succeeds once, along
+// with the Once controlling it.
+// Failures are not cached, unless a backoff is provided with OnceBackoff.
+func (f CtxFunc3Error[P0, P1, P2]) Lazy(opts ...OnceOption) (CtxFunc3Error[P0, P1, P2], *Once) {
+	o := newLazy(opts...)
+	return func(ctx context.Context, p0 P0, p1 P1, p2 P2) error {
+		_, err := o.do(ctx, true, func() (any, error) {
+			ctx := context.WithoutCancel(ctx)
+			return nil, f(ctx, p0, p1, p2)
+		})
+		return err
+	}, o
+}
+
+// Every returns a runner that calls the CtxFunc3Error every interval until
+// its context is done, in which case the runner returns nil.
+// The runner only returns an error when EveryMaxConsecutiveErrors is
+// provided and reached.
+// Every panics if interval is not positive, like time.NewTicker.
+func (f CtxFunc3Error[P0, P1, P2]) Every(interval time.Duration, opts ...EveryOption) CtxFunc3Error[P0, P1, P2] {
+	checkEveryInterval(interval)
+	return func(ctx context.Context, p0 P0, p1 P1, p2 P2) error {
+		return every(ctx, interval, opts, func() error {
+			return f(ctx, p0, p1, p2)
+		})
+	}
+}
+
+// InjectFaults returns a CtxFunc3Error injecting the errors, panics and latency
+// configured by cfg in its calls, to test how the callers cope with them.
+// See FaultConfig, and DisableFaults for the global kill-switch.
+func (f CtxFunc3Error[P0, P1, P2]) InjectFaults(cfg FaultConfig) CtxFunc3Error[P0, P1, P2] {
+	fi := newFaultInjector(cfg)
+	return func(ctx context.Context, p0 P0, p1 P1, p2 P2) error {
+		if err := fi.inject(ctx, p0, p1, p2); err != nil {
+			return err
+		}
+		return f(ctx, p0, p1, p2)
+	}
+}
 
+// Curry3 returns a CtxFuncError with the first 3 arguments of the CtxFunc3Error bound.
 func (f CtxFunc3Error[P0, P1, P2]) Curry3(p0 P0, p1 P1, p2 P2) CtxFuncError {
 	return func(ctx context.Context) error {
 		return f(ctx, p0, p1, p2)
 	}
 }
-	
 
+// Curry2 returns a CtxFunc1Error[P2] with the first 2 arguments of the CtxFunc3Error bound.
 func (f CtxFunc3Error[P0, P1, P2]) Curry2(p0 P0, p1 P1) CtxFunc1Error[P2] {
 	return func(ctx context.Context, p2 P2) error {
 		return f(ctx, p0, p1, p2)
 	}
 }
-	
 
+// Curry1 returns a CtxFunc2Error[P1, P2] with the first argument of the CtxFunc3Error bound.
 func (f CtxFunc3Error[P0, P1, P2]) Curry1(p0 P0) CtxFunc2Error[P1, P2] {
 	return func(ctx context.Context, p1 P1, p2 P2) error {
 		return f(ctx, p0, p1, p2)
 	}
 }
-	
\ No newline at end of file
@@ -0,0 +1,232 @@
+// Code generated by powerfunc/generator from ctx_func_ok.go.tmpl. DO NOT EDIT.
+
+package powerfunc
+
+import (
+	"context"
+	"fmt"
+	"time"
+)
+
+type CtxFunc3Ok[R, P0, P1, P2 any] func(ctx context.Context, p0 P0, p1 P1, p2 P2) (R, bool)
+
+func (f CtxFunc3Ok[R, P0, P1, P2]) Exec(ctx context.Context, p0 P0, p1 P1, p2 P2) (R, bool) {
+	return f(ctx, p0, p1, p2)
+}
+
+func (f CtxFunc3Ok[R, P0, P1, P2]) Timing(loggers ...func(d time.Duration)) CtxFunc3Ok[R, P0, P1, P2] {
+	return f.TimingWithClock(RealClock(), loggers...)
+}
+
+// TimingWithClock is like Timing, but measures the execution time with clock.
+func (f CtxFunc3Ok[R, P0, P1, P2]) TimingWithClock(clock Clock, loggers ...func(d time.Duration)) CtxFunc3Ok[R, P0, P1, P2] {
+	return func(ctx context.Context, p0 P0, p1 P1, p2 P2) (R, bool) {
+		start := clock.Now()
+		defer func() {
+			dur := clock.Since(start)
+			for _, logger := range loggers {
+				logger(dur)
+			}
+			if len(loggers) == 0 {
+				// Default logger
+				fmt.Println(dur)
+			}
+		}()
+		return f(ctx, p0, p1, p2)
+	}
+}
+
+func (f CtxFunc3Ok[R, P0, P1, P2]) WithTimeout(timeout time.Duration) CtxFunc3Ok[R, P0, P1, P2] {
+	return func(ctx context.Context, p0 P0, p1 P1, p2 P2) (R, bool) {
+		ctx, cancel := context.WithTimeout(ctx, timeout)
+		defer cancel()
+		return f(ctx, p0, p1, p2)
+	}
+}
+
+func (f CtxFunc3Ok[R, P0, P1, P2]) WithDeadline(deadline time.Time) CtxFunc3Ok[R, P0, P1, P2] {
+	return func(ctx context.Context, p0 P0, p1 P1, p2 P2) (R, bool) {
+		ctx, cancel := context.WithDeadline(ctx, deadline)
+		defer cancel()
+		return f(ctx, p0, p1, p2)
+	}
+}
+
+func (f CtxFunc3Ok[R, P0, P1, P2]) WithCancel() CtxFunc3Ok[R, P0, P1, P2] {
+	return func(ctx context.Context, p0 P0, p1 P1, p2 P2) (R, bool) {
+		ctx, cancel := context.WithCancel(ctx)
+		defer cancel()
+		return f(ctx, p0, p1, p2)
+	}
+}
+
+// WithValue returns a CtxFunc3Ok calling the CtxFunc3Ok with a context
+// carrying val for key, see context.WithValue.
+func (f CtxFunc3Ok[R, P0, P1, P2]) WithValue(key, val any) CtxFunc3Ok[R, P0, P1, P2] {
+	return func(ctx context.Context, p0 P0, p1 P1, p2 P2) (R, bool) {
+		ctx = context.WithValue(ctx, key, val)
+		return f(ctx, p0, p1, p2)
+	}
+}
+
+// WithValueFrom returns a CtxFunc3Ok calling the CtxFunc3Ok with a context
+// carrying the value returned by value for its key. value gets the arguments
+// of every call.
+func (f CtxFunc3Ok[R, P0, P1, P2]) WithValueFrom(value func(ctx context.Context, p0 P0, p1 P1, p2 P2) (key, val any)) CtxFunc3Ok[R, P0, P1, P2] {
+	return func(ctx context.Context, p0 P0, p1 P1, p2 P2) (R, bool) {
+		key, val := value(ctx, p0, p1, p2)
+		ctx = context.WithValue(ctx, key, val)
+		return f(ctx, p0, p1, p2)
+	}
+}
+
+// Detached returns a CtxFunc3Ok calling the CtxFunc3Ok with a context that is
+// never cancelled but keeps the values of the caller's context, see
+// context.WithoutCancel. Useful for cleanups that must run to completion.
+func (f CtxFunc3Ok[R, P0, P1, P2]) Detached() CtxFunc3Ok[R, P0, P1, P2] {
+	return func(ctx context.Context, p0 P0, p1 P1, p2 P2) (R, bool) {
+		ctx = context.WithoutCancel(ctx)
+		return f(ctx, p0, p1, p2)
+	}
+}
+
+// WithTimeoutCause is like WithTimeout, but the context is cancelled with
+// cause when the timeout expires, see context.WithTimeoutCause.
+func (f CtxFunc3Ok[R, P0, P1, P2]) WithTimeoutCause(timeout time.Duration, cause error) CtxFunc3Ok[R, P0, P1, P2] {
+	return func(ctx context.Context, p0 P0, p1 P1, p2 P2) (R, bool) {
+		ctx, cancel := context.WithTimeoutCause(ctx, timeout, cause)
+		defer cancel()
+		return f(ctx, p0, p1, p2)
+	}
+}
+
+// WithDeadlineCause is like WithDeadline, but the context is cancelled with
+// cause when the deadline expires, see context.WithDeadlineCause.
+func (f CtxFunc3Ok[R, P0, P1, P2]) WithDeadlineCause(deadline time.Time, cause error) CtxFunc3Ok[R, P0, P1, P2] {
+	return func(ctx context.Context, p0 P0, p1 P1, p2 P2) (R, bool) {
+		ctx, cancel := context.WithDeadlineCause(ctx, deadline, cause)
+		defer cancel()
+		return f(ctx, p0, p1, p2)
+	}
+}
+
+// Retry returns a CtxFunc3Ok that will retry the CtxFunc3Ok until it returns
+// true or the tryAgain function returns false.
+// The attempts that returned false fail with a placeholder error, given to
+// tryAgain and to the hooks of the options, e.g. OnRetry, so that any
+// RetryPolicy can be used.
+func (f CtxFunc3Ok[R, P0, P1, P2]) Retry(tryAgain RetryPolicy, opts ...RetryOption) CtxFunc3Ok[R, P0, P1, P2] {
+	return func(ctx context.Context, p0 P0, p1 P1, p2 P2) (R, bool) {
+		var v R
+		var ok bool
+		err := retry(ctx, func(attempts int, err error) bool {
+			return ctx.Err() == nil && tryAgain(attempts, err)
+		}, opts, func(ctx context.Context) error {
+			v, ok = f(ctx, p0, p1, p2)
+			if !ok {
+				return errNotOk
+			}
+			return nil
+		})
+		return v, err == nil
+	}
+}
+
+// Must returns a CtxFunc3Value that will panic if the CtxFunc3Ok returns false.
+func (f CtxFunc3Ok[R, P0, P1, P2]) Must() CtxFunc3Value[R, P0, P1, P2] {
+	return func(ctx context.Context, p0 P0, p1 P1, p2 P2) R {
+		v, ok := f(ctx, p0, p1, p2)
+		if !ok {
+			panic("powerfunc: CtxFunc3Ok returned false")
+		}
+		return v
+	}
+}
+
+// Fallback returns a CtxFunc3Value that will return the provided value if the
+// CtxFunc3Ok returns false.
+func (f CtxFunc3Ok[R, P0, P1, P2]) Fallback(val R) CtxFunc3Value[R, P0, P1, P2] {
+	return func(ctx context.Context, p0 P0, p1 P1, p2 P2) R {
+		v, ok := f(ctx, p0, p1, p2)
+		if !ok {
+			return val
+		}
+		return v
+	}
+}
+
+// OrElse returns a CtxFunc3Value that will return the provided value if the
+// CtxFunc3Ok returns false. It is an alias of Fallback.
+func (f CtxFunc3Ok[R, P0, P1, P2]) OrElse(val R) CtxFunc3Value[R, P0, P1, P2] {
+	return f.Fallback(val)
+}
+
+// OrElseGet returns a CtxFunc3Value that will call elsef if the CtxFunc3Ok
+// returns false.
+func (f CtxFunc3Ok[R, P0, P1, P2]) OrElseGet(elsef CtxFunc3Value[R, P0, P1, P2]) CtxFunc3Value[R, P0, P1, P2] {
+	return func(ctx context.Context, p0 P0, p1 P1, p2 P2) R {
+		v, ok := f(ctx, p0, p1, p2)
+		if !ok {
+			return elsef(ctx, p0, p1, p2)
+		}
+		return v
+	}
+}
+
+// ToResult transforms a CtxFunc3Ok into a CtxFunc3Result returning the
+// provided error when the CtxFunc3Ok returns false.
+func (f CtxFunc3Ok[R, P0, P1, P2]) ToResult(err error) CtxFunc3Result[R, P0, P1, P2] {
+	return func(ctx context.Context, p0 P0, p1 P1, p2 P2) (R, error) {
+		v, ok := f(ctx, p0, p1, p2)
+		if !ok {
+			return v, err
+		}
+		return v, nil
+	}
+}
+
+// Filter returns a CtxFunc3Ok that will return false if the value returned by
+// the CtxFunc3Ok does not satisfy keep.
+func (f CtxFunc3Ok[R, P0, P1, P2]) Filter(keep func(R) bool) CtxFunc3Ok[R, P0, P1, P2] {
+	return func(ctx context.Context, p0 P0, p1 P1, p2 P2) (R, bool) {
+		v, ok := f(ctx, p0, p1, p2)
+		if !ok || !keep(v) {
+			var zero R
+			return zero, false
+		}
+		return v, true
+	}
+}
+
+// Map applies the provided function to the value returned by the CtxFunc3Ok,
+// if it returns true.
+func (f CtxFunc3Ok[R, P0, P1, P2]) Map(fn func(R) R) CtxFunc3Ok[R, P0, P1, P2] {
+	return func(ctx context.Context, p0 P0, p1 P1, p2 P2) (R, bool) {
+		v, ok := f(ctx, p0, p1, p2)
+		if !ok {
+			return v, false
+		}
+		return fn(v), true
+	}
+}
+
+// Curry3 returns a CtxFuncOk[R] with the first 3 arguments of the CtxFunc3Ok bound.
+func (f CtxFunc3Ok[R, P0, P1, P2]) Curry3(p0 P0, p1 P1, p2 P2) CtxFuncOk[R] {
+	return func(ctx context.Context) (R, bool) {
+		return f(ctx, p0, p1, p2)
+	}
+}
+
+// Curry2 returns a CtxFunc1Ok[R, P2] with the first 2 arguments of the CtxFunc3Ok bound.
+func (f CtxFunc3Ok[R, P0, P1, P2]) Curry2(p0 P0, p1 P1) CtxFunc1Ok[R, P2] {
+	return func(ctx context.Context, p2 P2) (R, bool) {
+		return f(ctx, p0, p1, p2)
+	}
+}
+
+// Curry1 returns a CtxFunc2Ok[R, P1, P2] with the first argument of the CtxFunc3Ok bound.
+func (f CtxFunc3Ok[R, P0, P1, P2]) Curry1(p0 P0) CtxFunc2Ok[R, P1, P2] {
+	return func(ctx context.Context, p1 P1, p2 P2) (R, bool) {
+		return f(ctx, p0, p1, p2)
+	}
+}
@@ -0,0 +1,139 @@
+// Code generated by powerfunc/generator from ctx_func_pair.go.tmpl. DO NOT EDIT.
+
+package powerfunc
+
+import (
+	"context"
+	"fmt"
+	"time"
+)
+
+type CtxFunc3Pair[R1, R2, P0, P1, P2 any] func(ctx context.Context, p0 P0, p1 P1, p2 P2) (R1, R2)
+
+func (f CtxFunc3Pair[R1, R2, P0, P1, P2]) Exec(ctx context.Context, p0 P0, p1 P1, p2 P2) (R1, R2) {
+	return f(ctx, p0, p1, p2)
+}
+
+func (f CtxFunc3Pair[R1, R2, P0, P1, P2]) Timing(loggers ...func(d time.Duration)) CtxFunc3Pair[R1, R2, P0, P1, P2] {
+	return f.TimingWithClock(RealClock(), loggers...)
+}
+
+// TimingWithClock is like Timing, but measures the execution time with clock.
+func (f CtxFunc3Pair[R1, R2, P0, P1, P2]) TimingWithClock(clock Clock, loggers ...func(d time.Duration)) CtxFunc3Pair[R1, R2, P0, P1, P2] {
+	return func(ctx context.Context, p0 P0, p1 P1, p2 P2) (R1, R2) {
+		start := clock.Now()
+		defer func() {
+			dur := clock.Since(start)
+			for _, logger := range loggers {
+				logger(dur)
+			}
+			if len(loggers) == 0 {
+				// Default logger
+				fmt.Println(dur)
+			}
+		}()
+		return f(ctx, p0, p1, p2)
+	}
+}
+
+func (f CtxFunc3Pair[R1, R2, P0, P1, P2]) Fallible() CtxFunc3PairResult[R1, R2, P0, P1, P2] {
+	return func(ctx context.Context, p0 P0, p1 P1, p2 P2) (R1, R2, error) {
+		v1, v2 := f(ctx, p0, p1, p2)
+		return v1, v2, nil
+	}
+}
+
+func (f CtxFunc3Pair[R1, R2, P0, P1, P2]) WithTimeout(timeout time.Duration) CtxFunc3Pair[R1, R2, P0, P1, P2] {
+	return func(ctx context.Context, p0 P0, p1 P1, p2 P2) (R1, R2) {
+		ctx, cancel := context.WithTimeout(ctx, timeout)
+		defer cancel()
+		return f(ctx, p0, p1, p2)
+	}
+}
+
+func (f CtxFunc3Pair[R1, R2, P0, P1, P2]) WithDeadline(deadline time.Time) CtxFunc3Pair[R1, R2, P0, P1, P2] {
+	return func(ctx context.Context, p0 P0, p1 P1, p2 P2) (R1, R2) {
+		ctx, cancel := context.WithDeadline(ctx, deadline)
+		defer cancel()
+		return f(ctx, p0, p1, p2)
+	}
+}
+
+func (f CtxFunc3Pair[R1, R2, P0, P1, P2]) WithCancel() CtxFunc3Pair[R1, R2, P0, P1, P2] {
+	return func(ctx context.Context, p0 P0, p1 P1, p2 P2) (R1, R2) {
+		ctx, cancel := context.WithCancel(ctx)
+		defer cancel()
+		return f(ctx, p0, p1, p2)
+	}
+}
+
+// WithValue returns a CtxFunc3Pair calling the CtxFunc3Pair with a context
+// carrying val for key, see context.WithValue.
+func (f CtxFunc3Pair[R1, R2, P0, P1, P2]) WithValue(key, val any) CtxFunc3Pair[R1, R2, P0, P1, P2] {
+	return func(ctx context.Context, p0 P0, p1 P1, p2 P2) (R1, R2) {
+		ctx = context.WithValue(ctx, key, val)
+		return f(ctx, p0, p1, p2)
+	}
+}
+
+// WithValueFrom returns a CtxFunc3Pair calling the CtxFunc3Pair with a context
+// carrying the value returned by value for its key. value gets the arguments
+// of every call.
+func (f CtxFunc3Pair[R1, R2, P0, P1, P2]) WithValueFrom(value func(ctx context.Context, p0 P0, p1 P1, p2 P2) (key, val any)) CtxFunc3Pair[R1, R2, P0, P1, P2] {
+	return func(ctx context.Context, p0 P0, p1 P1, p2 P2) (R1, R2) {
+		key, val := value(ctx, p0, p1, p2)
+		ctx = context.WithValue(ctx, key, val)
+		return f(ctx, p0, p1, p2)
+	}
+}
+
+// Detached returns a CtxFunc3Pair calling the CtxFunc3Pair with a context that is
+// never cancelled but keeps the values of the caller's context, see
+// context.WithoutCancel. Useful for cleanups that must run to completion.
+func (f CtxFunc3Pair[R1, R2, P0, P1, P2]) Detached() CtxFunc3Pair[R1, R2, P0, P1, P2] {
+	return func(ctx context.Context, p0 P0, p1 P1, p2 P2) (R1, R2) {
+		ctx = context.WithoutCancel(ctx)
+		return f(ctx, p0, p1, p2)
+	}
+}
+
+// WithTimeoutCause is like WithTimeout, but the context is cancelled with
+// cause when the timeout expires, see context.WithTimeoutCause.
+func (f CtxFunc3Pair[R1, R2, P0, P1, P2]) WithTimeoutCause(timeout time.Duration, cause error) CtxFunc3Pair[R1, R2, P0, P1, P2] {
+	return func(ctx context.Context, p0 P0, p1 P1, p2 P2) (R1, R2) {
+		ctx, cancel := context.WithTimeoutCause(ctx, timeout, cause)
+		defer cancel()
+		return f(ctx, p0, p1, p2)
+	}
+}
+
+// WithDeadlineCause is like WithDeadline, but the context is cancelled with
+// cause when the deadline expires, see context.WithDeadlineCause.
+func (f CtxFunc3Pair[R1, R2, P0, P1, P2]) WithDeadlineCause(deadline time.Time, cause error) CtxFunc3Pair[R1, R2, P0, P1, P2] {
+	return func(ctx context.Context, p0 P0, p1 P1, p2 P2) (R1, R2) {
+		ctx, cancel := context.WithDeadlineCause(ctx, deadline, cause)
+		defer cancel()
+		return f(ctx, p0, p1, p2)
+	}
+}
+
+// Curry3 returns a CtxFuncPair[R1, R2] with the first 3 arguments of the CtxFunc3Pair bound.
+func (f CtxFunc3Pair[R1, R2, P0, P1, P2]) Curry3(p0 P0, p1 P1, p2 P2) CtxFuncPair[R1, R2] {
+	return func(ctx context.Context) (R1, R2) {
+		return f(ctx, p0, p1, p2)
+	}
+}
+
+// Curry2 returns a CtxFunc1Pair[R1, R2, P2] with the first 2 arguments of the CtxFunc3Pair bound.
+func (f CtxFunc3Pair[R1, R2, P0, P1, P2]) Curry2(p0 P0, p1 P1) CtxFunc1Pair[R1, R2, P2] {
+	return func(ctx context.Context, p2 P2) (R1, R2) {
+		return f(ctx, p0, p1, p2)
+	}
+}
+
+// Curry1 returns a CtxFunc2Pair[R1, R2, P1, P2] with the first argument of the CtxFunc3Pair bound.
+func (f CtxFunc3Pair[R1, R2, P0, P1, P2]) Curry1(p0 P0) CtxFunc2Pair[R1, R2, P1, P2] {
+	return func(ctx context.Context, p1 P1, p2 P2) (R1, R2) {
+		return f(ctx, p0, p1, p2)
+	}
+}
@@ -1,3 +1,5 @@
+// Code generated by powerfunc/generator from ctx_func_result.go.tmpl. DO NOT EDIT.
+
 package powerfunc
 
 import (
@@ -13,10 +15,15 @@ func (f CtxFunc3Result[R, P0, P1, P2]) Exec(ctx context.Context, p0 P0, p1 P1, p
 }
 
 func (f CtxFunc3Result[R, P0, P1, P2]) Timing(loggers ...func(d time.Duration)) CtxFunc3Result[R, P0, P1, P2] {
+	return f.TimingWithClock(RealClock(), loggers...)
+}
+
+// TimingWithClock is like Timing, but measures the execution time with clock.
+func (f CtxFunc3Result[R, P0, P1, P2]) TimingWithClock(clock Clock, loggers ...func(d time.Duration)) CtxFunc3Result[R, P0, P1, P2] {
 	return func(ctx context.Context, p0 P0, p1 P1, p2 P2) (R, error) {
-		start := time.Now()
+		start := clock.Now()
 		defer func() {
-			dur := time.Since(start)
+			dur := clock.Since(start)
 			for _, logger := range loggers {
 				logger(dur)
 			}
@@ -53,25 +60,103 @@ func (f CtxFunc3Result[R, P0, P1, P2]) WithCancel() CtxFunc3Result[R, P0, P1, P2
 	}
 }
 
-// Retry returns a Function that will retry the Function until it returns
-// a nil error or the tryAgain function returns false.
-func (f CtxFunc3Result[R, P0, P1, P2]) Retry(tryAgain func(attempts int, err error) bool) CtxFunc3Result[R, P0, P1, P2] {
+// WithValue returns a CtxFunc3Result calling the CtxFunc3Result with a context
+// carrying val for key, see context.WithValue.
+func (f CtxFunc3Result[R, P0, P1, P2]) WithValue(key, val any) CtxFunc3Result[R, P0, P1, P2] {
 	return func(ctx context.Context, p0 P0, p1 P1, p2 P2) (R, error) {
-		var v R
-		var err error
-		attempts := 1
-		for {
-			v, err = f(ctx, p0, p1, p2)
-			if err != nil && tryAgain(attempts, err) {
-				break
-			}
-			attempts++
+		ctx = context.WithValue(ctx, key, val)
+		return f(ctx, p0, p1, p2)
+	}
+}
+
+// WithValueFrom returns a CtxFunc3Result calling the CtxFunc3Result with a context
+// carrying the value returned by value for its key. value gets the arguments
+// of every call.
+func (f CtxFunc3Result[R, P0, P1, P2]) WithValueFrom(value func(ctx context.Context, p0 P0, p1 P1, p2 P2) (key, val any)) CtxFunc3Result[R, P0, P1, P2] {
+	return func(ctx context.Context, p0 P0, p1 P1, p2 P2) (R, error) {
+		key, val := value(ctx, p0, p1, p2)
+		ctx = context.WithValue(ctx, key, val)
+		return f(ctx, p0, p1, p2)
+	}
+}
+
+// Detached returns a CtxFunc3Result calling the CtxFunc3Result with a context that is
+// never cancelled but keeps the values of the caller's context, see
+// context.WithoutCancel. Useful for cleanups that must run to completion.
+func (f CtxFunc3Result[R, P0, P1, P2]) Detached() CtxFunc3Result[R, P0, P1, P2] {
+	return func(ctx context.Context, p0 P0, p1 P1, p2 P2) (R, error) {
+		ctx = context.WithoutCancel(ctx)
+		return f(ctx, p0, p1, p2)
+	}
+}
+
+// WithTimeoutCause is like WithTimeout, but the context is cancelled with
+// cause when the timeout expires, see context.WithTimeoutCause.
+// When the CtxFunc3Result then fails with the error of the context, the returned
+// error wraps cause as well, to tell which timeout expired.
+func (f CtxFunc3Result[R, P0, P1, P2]) WithTimeoutCause(timeout time.Duration, cause error) CtxFunc3Result[R, P0, P1, P2] {
+	return func(ctx context.Context, p0 P0, p1 P1, p2 P2) (R, error) {
+		ctx, cancel := context.WithTimeoutCause(ctx, timeout, cause)
+		defer cancel()
+		r0, err := f(ctx, p0, p1, p2)
+		return r0, withCause(ctx, err)
+	}
+}
+
+// WithDeadlineCause is like WithDeadline, but the context is cancelled with
+// cause when the deadline expires, see context.WithDeadlineCause.
+// When the CtxFunc3Result then fails with the error of the context, the returned
+// error wraps cause as well, to tell which deadline expired.
+func (f CtxFunc3Result[R, P0, P1, P2]) WithDeadlineCause(deadline time.Time, cause error) CtxFunc3Result[R, P0, P1, P2] {
+	return func(ctx context.Context, p0 P0, p1 P1, p2 P2) (R, error) {
+		ctx, cancel := context.WithDeadlineCause(ctx, deadline, cause)
+		defer cancel()
+		r0, err := f(ctx, p0, p1, p2)
+		return r0, withCause(ctx, err)
+	}
+}
+
+// WithBudget returns a CtxFunc3Result giving the CtxFunc3Result a fraction of the
+// remaining deadline of the caller, capped at max when max is positive,
+// instead of a fixed timeout. Without a deadline, the CtxFunc3Result gets max, or
+// no timeout if max is not positive.
+// When the share is below min, the CtxFunc3Result is not called and a
+// *BudgetError, matching ErrBudgetExhausted, is returned.
+func (f CtxFunc3Result[R, P0, P1, P2]) WithBudget(fraction float64, min, max time.Duration) CtxFunc3Result[R, P0, P1, P2] {
+	return func(ctx context.Context, p0 P0, p1 P1, p2 P2) (R, error) {
+		timeout, bounded, err := budget(ctx, fraction, min, max)
+		if err != nil {
+			var r0 R
+			return r0, err
 		}
-		return v, err
+		if bounded {
+			var cancel context.CancelFunc
+			ctx, cancel = context.WithTimeout(ctx, timeout)
+			defer cancel()
+		}
+		return f(ctx, p0, p1, p2)
 	}
 }
 
-// Must returns a Func3Value that will panic if the CtxFunc3Result returns an error.
+// Retry returns a CtxFunc3Result that will retry the CtxFunc3Result until it returns
+// a nil error or the tryAgain function returns false, in which case it
+// returns a *RetryError holding the errors of all the attempts.
+// The options set the backoff between attempts and hooks, e.g. OnRetry.
+// RetryAttemptTimeout gives every attempt its own deadline within the one of
+// the caller.
+func (f CtxFunc3Result[R, P0, P1, P2]) Retry(tryAgain func(attempts int, err error) bool, opts ...RetryOption) CtxFunc3Result[R, P0, P1, P2] {
+	return func(ctx context.Context, p0 P0, p1 P1, p2 P2) (R, error) {
+		var r0 R
+		err := retry(ctx, tryAgain, opts, func(ctx context.Context) error {
+			var err error
+			r0, err = f(ctx, p0, p1, p2)
+			return err
+		})
+		return r0, err
+	}
+}
+
+// Must returns a CtxFunc3Value that will panic if the CtxFunc3Result returns an error.
 func (f CtxFunc3Result[R, P0, P1, P2]) Must() CtxFunc3Value[R, P0, P1, P2] {
 	return func(ctx context.Context, p0 P0, p1 P1, p2 P2) R {
 		v, err := f(ctx, p0, p1, p2)
@@ -118,7 +203,7 @@ func (f CtxFunc3Result[R, P0, P1, P2]) MapErr(fn func(error) error) CtxFunc3Resu
 	}
 }
 
-// Fallback returns a Func3Value that will return the provided value if the
+// Fallback returns a CtxFunc3Value that will return the provided value if the
 // CtxFunc3Result returns an error.
 func (f CtxFunc3Result[R, P0, P1, P2]) Fallback(val R) CtxFunc3Value[R, P0, P1, P2] {
 	return func(ctx context.Context, p0 P0, p1 P1, p2 P2) R {
@@ -130,24 +215,89 @@ func (f CtxFunc3Result[R, P0, P1, P2]) Fallback(val R) CtxFunc3Value[R, P0, P1,
 	}
 }
 
+// Once returns a CtxFunc3Result that only executes the first time it is called
+// and returns the cached result afterwards, along with the Once controlling it.
+// Use OnceRetryOnError or OnceBackoff to only cache a success.
+// The shared execution does not inherit the cancellation of the caller's
+// context: a caller whose context is done stops waiting and gets ctx.Err(),
+// while the execution carries on for the other callers.
+func (f CtxFunc3Result[R, P0, P1, P2]) Once(opts ...OnceOption) (CtxFunc3Result[R, P0, P1, P2], *Once) {
+	o := newOnce(opts...)
+	return func(ctx context.Context, p0 P0, p1 P1, p2 P2) (R, error) {
+		v, err := o.do(ctx, true, func() (any, error) {
+			ctx := context.WithoutCancel(ctx)
+			return f(ctx, p0, p1, p2)
+		})
+		val, _ := v.(R)
+		return val, err
+	}, o
+}
+
+// Lazy returns a CtxFunc3Result that executes until it succeeds once and
+// returns the cached value afterwards, along with the Once controlling it.
+// Failures are not cached, unless a backoff is provided with OnceBackoff.
+func (f CtxFunc3Result[R, P0, P1, P2]) Lazy(opts ...OnceOption) (CtxFunc3Result[R, P0, P1, P2], *Once) {
+	o := newLazy(opts...)
+	return func(ctx context.Context, p0 P0, p1 P1, p2 P2) (R, error) {
+		v, err := o.do(ctx, true, func() (any, error) {
+			ctx := context.WithoutCancel(ctx)
+			return f(ctx, p0, p1, p2)
+		})
+		val, _ := v.(R)
+		return val, err
+	}, o
+}
+
+// PollUntil returns a CtxFunc3Result calling the CtxFunc3Result every interval
+// until the returned value satisfies cond.
+// When the context is done or the maximum number of attempts is reached
+// first, it returns the last value and a *PollTimeoutError.
+func (f CtxFunc3Result[R, P0, P1, P2]) PollUntil(cond func(R) bool, interval time.Duration, opts ...PollOption) CtxFunc3Result[R, P0, P1, P2] {
+	return func(ctx context.Context, p0 P0, p1 P1, p2 P2) (R, error) {
+		var v R
+		err := poll(ctx, interval, opts, func() (bool, error) {
+			var err error
+			v, err = f(ctx, p0, p1, p2)
+			if err != nil {
+				return false, err
+			}
+			return cond(v), nil
+		})
+		return v, err
+	}
+}
+
+// InjectFaults returns a CtxFunc3Result injecting the errors, panics and latency
+// configured by cfg in its calls, to test how the callers cope with them.
+// See FaultConfig, and DisableFaults for the global kill-switch.
+func (f CtxFunc3Result[R, P0, P1, P2]) InjectFaults(cfg FaultConfig) CtxFunc3Result[R, P0, P1, P2] {
+	fi := newFaultInjector(cfg)
+	return func(ctx context.Context, p0 P0, p1 P1, p2 P2) (R, error) {
+		if err := fi.inject(ctx, p0, p1, p2); err != nil {
+			var r0 R
+			return r0, err
+		}
+		return f(ctx, p0, p1, p2)
+	}
+}
 
+// Curry3 returns a CtxFuncResult[R] with the first 3 arguments of the CtxFunc3Result bound.
 func (f CtxFunc3Result[R, P0, P1, P2]) Curry3(p0 P0, p1 P1, p2 P2) CtxFuncResult[R] {
 	return func(ctx context.Context) (R, error) {
 		return f(ctx, p0, p1, p2)
 	}
 }
-	
 
+// Curry2 returns a CtxFunc1Result[R, P2] with the first 2 arguments of the CtxFunc3Result bound.
 func (f CtxFunc3Result[R, P0, P1, P2]) Curry2(p0 P0, p1 P1) CtxFunc1Result[R, P2] {
 	return func(ctx context.Context, p2 P2) (R, error) {
 		return f(ctx, p0, p1, p2)
 	}
 }
-	
 
+// Curry1 returns a CtxFunc2Result[R, P1, P2] with the first argument of the CtxFunc3Result bound.
 func (f CtxFunc3Result[R, P0, P1, P2]) Curry1(p0 P0) CtxFunc2Result[R, P1, P2] {
 	return func(ctx context.Context, p1 P1, p2 P2) (R, error) {
 		return f(ctx, p0, p1, p2)
 	}
 }
-	
\ No newline at end of file
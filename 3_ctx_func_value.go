@@ -1,3 +1,5 @@
+// Code generated by powerfunc/generator from ctx_func_value.go.tmpl. DO NOT EDIT.
+
 package powerfunc
 
 import (
@@ -13,10 +15,15 @@ func (f CtxFunc3Value[R, P0, P1, P2]) Exec(ctx context.Context, p0 P0, p1 P1, p2
 }
 
 func (f CtxFunc3Value[R, P0, P1, P2]) Timing(loggers ...func(d time.Duration)) CtxFunc3Value[R, P0, P1, P2] {
+	return f.TimingWithClock(RealClock(), loggers...)
+}
+
+// TimingWithClock is like Timing, but measures the execution time with clock.
+func (f CtxFunc3Value[R, P0, P1, P2]) TimingWithClock(clock Clock, loggers ...func(d time.Duration)) CtxFunc3Value[R, P0, P1, P2] {
 	return func(ctx context.Context, p0 P0, p1 P1, p2 P2) R {
-		start := time.Now()
+		start := clock.Now()
 		defer func() {
-			dur := time.Since(start)
+			dur := clock.Since(start)
 			for _, logger := range loggers {
 				logger(dur)
 			}
@@ -60,24 +67,122 @@ func (f CtxFunc3Value[R, P0, P1, P2]) WithCancel() CtxFunc3Value[R, P0, P1, P2]
 	}
 }
 
+// WithValue returns a CtxFunc3Value calling the CtxFunc3Value with a context
+// carrying val for key, see context.WithValue.
+func (f CtxFunc3Value[R, P0, P1, P2]) WithValue(key, val any) CtxFunc3Value[R, P0, P1, P2] {
+	return func(ctx context.Context, p0 P0, p1 P1, p2 P2) R {
+		ctx = context.WithValue(ctx, key, val)
+		return f(ctx, p0, p1, p2)
+	}
+}
+
+// WithValueFrom returns a CtxFunc3Value calling the CtxFunc3Value with a context
+// carrying the value returned by value for its key. value gets the arguments
+// of every call.
+func (f CtxFunc3Value[R, P0, P1, P2]) WithValueFrom(value func(ctx context.Context, p0 P0, p1 P1, p2 P2) (key, val any)) CtxFunc3Value[R, P0, P1, P2] {
+	return func(ctx context.Context, p0 P0, p1 P1, p2 P2) R {
+		key, val := value(ctx, p0, p1, p2)
+		ctx = context.WithValue(ctx, key, val)
+		return f(ctx, p0, p1, p2)
+	}
+}
+
+// Detached returns a CtxFunc3Value calling the CtxFunc3Value with a context that is
+// never cancelled but keeps the values of the caller's context, see
+// context.WithoutCancel. Useful for cleanups that must run to completion.
+func (f CtxFunc3Value[R, P0, P1, P2]) Detached() CtxFunc3Value[R, P0, P1, P2] {
+	return func(ctx context.Context, p0 P0, p1 P1, p2 P2) R {
+		ctx = context.WithoutCancel(ctx)
+		return f(ctx, p0, p1, p2)
+	}
+}
+
+// WithTimeoutCause is like WithTimeout, but the context is cancelled with
+// cause when the timeout expires, see context.WithTimeoutCause.
+func (f CtxFunc3Value[R, P0, P1, P2]) WithTimeoutCause(timeout time.Duration, cause error) CtxFunc3Value[R, P0, P1, P2] {
+	return func(ctx context.Context, p0 P0, p1 P1, p2 P2) R {
+		ctx, cancel := context.WithTimeoutCause(ctx, timeout, cause)
+		defer cancel()
+		return f(ctx, p0, p1, p2)
+	}
+}
+
+// WithDeadlineCause is like WithDeadline, but the context is cancelled with
+// cause when the deadline expires, see context.WithDeadlineCause.
+func (f CtxFunc3Value[R, P0, P1, P2]) WithDeadlineCause(deadline time.Time, cause error) CtxFunc3Value[R, P0, P1, P2] {
+	return func(ctx context.Context, p0 P0, p1 P1, p2 P2) R {
+		ctx, cancel := context.WithDeadlineCause(ctx, deadline, cause)
+		defer cancel()
+		return f(ctx, p0, p1, p2)
+	}
+}
+
+// Once returns a CtxFunc3Value that only executes the first time it is called
+// and returns the cached value afterwards, along with the Once controlling it.
+// The shared execution does not inherit the cancellation of the caller's
+// context. As a CtxFunc3Value cannot report ctx.Err(), every caller waits for
+// the execution to return, even once its own context is done.
+func (f CtxFunc3Value[R, P0, P1, P2]) Once(opts ...OnceOption) (CtxFunc3Value[R, P0, P1, P2], *Once) {
+	o := newOnce(opts...)
+	return func(ctx context.Context, p0 P0, p1 P1, p2 P2) R {
+		v, _ := o.do(context.WithoutCancel(ctx), false, func() (any, error) {
+			ctx := context.WithoutCancel(ctx)
+			return f(ctx, p0, p1, p2), nil
+		})
+		val, _ := v.(R)
+		return val
+	}, o
+}
+
+// Lazy returns a CtxFunc3Value that computes its value the first time it is
+// called and returns the cached value afterwards, along with the Once
+// controlling it.
+// Like Once, every caller waits for the execution to return, even once its
+// own context is done.
+func (f CtxFunc3Value[R, P0, P1, P2]) Lazy(opts ...OnceOption) (CtxFunc3Value[R, P0, P1, P2], *Once) {
+	o := newLazy(opts...)
+	return func(ctx context.Context, p0 P0, p1 P1, p2 P2) R {
+		v, _ := o.do(context.WithoutCancel(ctx), false, func() (any, error) {
+			ctx := context.WithoutCancel(ctx)
+			return f(ctx, p0, p1, p2), nil
+		})
+		val, _ := v.(R)
+		return val
+	}, o
+}
+
+// PollUntil returns a CtxFunc3Result calling the CtxFunc3Value every interval
+// until the returned value satisfies cond.
+// When the context is done or the maximum number of attempts is reached
+// first, it returns the last value and a *PollTimeoutError.
+func (f CtxFunc3Value[R, P0, P1, P2]) PollUntil(cond func(R) bool, interval time.Duration, opts ...PollOption) CtxFunc3Result[R, P0, P1, P2] {
+	return func(ctx context.Context, p0 P0, p1 P1, p2 P2) (R, error) {
+		var v R
+		err := poll(ctx, interval, opts, func() (bool, error) {
+			v = f(ctx, p0, p1, p2)
+			return cond(v), nil
+		})
+		return v, err
+	}
+}
 
+// Curry3 returns a CtxFuncValue[R] with the first 3 arguments of the CtxFunc3Value bound.
 func (f CtxFunc3Value[R, P0, P1, P2]) Curry3(p0 P0, p1 P1, p2 P2) CtxFuncValue[R] {
 	return func(ctx context.Context) R {
 		return f(ctx, p0, p1, p2)
 	}
 }
-	
 
+// Curry2 returns a CtxFunc1Value[R, P2] with the first 2 arguments of the CtxFunc3Value bound.
 func (f CtxFunc3Value[R, P0, P1, P2]) Curry2(p0 P0, p1 P1) CtxFunc1Value[R, P2] {
 	return func(ctx context.Context, p2 P2) R {
 		return f(ctx, p0, p1, p2)
 	}
 }
-	
 
+// Curry1 returns a CtxFunc2Value[R, P1, P2] with the first argument of the CtxFunc3Value bound.
 func (f CtxFunc3Value[R, P0, P1, P2]) Curry1(p0 P0) CtxFunc2Value[R, P1, P2] {
 	return func(ctx context.Context, p1 P1, p2 P2) R {
 		return f(ctx, p0, p1, p2)
 	}
 }
-	
\ No newline at end of file
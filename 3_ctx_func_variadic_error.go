@@ -0,0 +1,208 @@
+// Code generated by powerfunc/generator from ctx_func_variadic_error.go.tmpl. DO NOT EDIT.
+
+package powerfunc
+
+import (
+	"context"
+	"fmt"
+	"time"
+)
+
+type CtxFunc3VariadicError[V, P0, P1, P2 any] func(ctx context.Context, p0 P0, p1 P1, p2 P2, xs ...V) error
+
+func (f CtxFunc3VariadicError[V, P0, P1, P2]) Exec(ctx context.Context, p0 P0, p1 P1, p2 P2, xs ...V) error {
+	return f(ctx, p0, p1, p2, xs...)
+}
+
+func (f CtxFunc3VariadicError[V, P0, P1, P2]) Timing(loggers ...func(d time.Duration)) CtxFunc3VariadicError[V, P0, P1, P2] {
+	return f.TimingWithClock(RealClock(), loggers...)
+}
+
+// TimingWithClock is like Timing, but measures the execution time with clock.
+func (f CtxFunc3VariadicError[V, P0, P1, P2]) TimingWithClock(clock Clock, loggers ...func(d time.Duration)) CtxFunc3VariadicError[V, P0, P1, P2] {
+	return func(ctx context.Context, p0 P0, p1 P1, p2 P2, xs ...V) error {
+		start := clock.Now()
+		defer func() {
+			dur := clock.Since(start)
+			for _, logger := range loggers {
+				logger(dur)
+			}
+			if len(loggers) == 0 {
+				// Default logger
+				fmt.Println(dur)
+			}
+		}()
+		return f(ctx, p0, p1, p2, xs...)
+	}
+}
+
+func (f CtxFunc3VariadicError[V, P0, P1, P2]) WithTimeout(timeout time.Duration) CtxFunc3VariadicError[V, P0, P1, P2] {
+	return func(ctx context.Context, p0 P0, p1 P1, p2 P2, xs ...V) error {
+		ctx, cancel := context.WithTimeout(ctx, timeout)
+		defer cancel()
+		return f(ctx, p0, p1, p2, xs...)
+	}
+}
+
+func (f CtxFunc3VariadicError[V, P0, P1, P2]) WithDeadline(deadline time.Time) CtxFunc3VariadicError[V, P0, P1, P2] {
+	return func(ctx context.Context, p0 P0, p1 P1, p2 P2, xs ...V) error {
+		ctx, cancel := context.WithDeadline(ctx, deadline)
+		defer cancel()
+		return f(ctx, p0, p1, p2, xs...)
+	}
+}
+
+func (f CtxFunc3VariadicError[V, P0, P1, P2]) WithCancel() CtxFunc3VariadicError[V, P0, P1, P2] {
+	return func(ctx context.Context, p0 P0, p1 P1, p2 P2, xs ...V) error {
+		ctx, cancel := context.WithCancel(ctx)
+		defer cancel()
+		return f(ctx, p0, p1, p2, xs...)
+	}
+}
+
+// WithValue returns a CtxFunc3VariadicError calling the CtxFunc3VariadicError with a context
+// carrying val for key, see context.WithValue.
+func (f CtxFunc3VariadicError[V, P0, P1, P2]) WithValue(key, val any) CtxFunc3VariadicError[V, P0, P1, P2] {
+	return func(ctx context.Context, p0 P0, p1 P1, p2 P2, xs ...V) error {
+		ctx = context.WithValue(ctx, key, val)
+		return f(ctx, p0, p1, p2, xs...)
+	}
+}
+
+// WithValueFrom returns a CtxFunc3VariadicError calling the CtxFunc3VariadicError with a context
+// carrying the value returned by value for its key. value gets the arguments
+// of every call.
+func (f CtxFunc3VariadicError[V, P0, P1, P2]) WithValueFrom(value func(ctx context.Context, p0 P0, p1 P1, p2 P2, xs ...V) (key, val any)) CtxFunc3VariadicError[V, P0, P1, P2] {
+	return func(ctx context.Context, p0 P0, p1 P1, p2 P2, xs ...V) error {
+		key, val := value(ctx, p0, p1, p2, xs...)
+		ctx = context.WithValue(ctx, key, val)
+		return f(ctx, p0, p1, p2, xs...)
+	}
+}
+
+// Detached returns a CtxFunc3VariadicError calling the CtxFunc3VariadicError with a context that is
+// never cancelled but keeps the values of the caller's context, see
+// context.WithoutCancel. Useful for cleanups that must run to completion.
+func (f CtxFunc3VariadicError[V, P0, P1, P2]) Detached() CtxFunc3VariadicError[V, P0, P1, P2] {
+	return func(ctx context.Context, p0 P0, p1 P1, p2 P2, xs ...V) error {
+		ctx = context.WithoutCancel(ctx)
+		return f(ctx, p0, p1, p2, xs...)
+	}
+}
+
+// WithTimeoutCause is like WithTimeout, but the context is cancelled with
+// cause when the timeout expires, see context.WithTimeoutCause.
+// When the CtxFunc3VariadicError then fails with the error of the context, the returned
+// error wraps cause as well, to tell which timeout expired.
+func (f CtxFunc3VariadicError[V, P0, P1, P2]) WithTimeoutCause(timeout time.Duration, cause error) CtxFunc3VariadicError[V, P0, P1, P2] {
+	return func(ctx context.Context, p0 P0, p1 P1, p2 P2, xs ...V) error {
+		ctx, cancel := context.WithTimeoutCause(ctx, timeout, cause)
+		defer cancel()
+		err := f(ctx, p0, p1, p2, xs...)
+		return withCause(ctx, err)
+	}
+}
+
+// WithDeadlineCause is like WithDeadline, but the context is cancelled with
+// cause when the deadline expires, see context.WithDeadlineCause.
+// When the CtxFunc3VariadicError then fails with the error of the context, the returned
+// error wraps cause as well, to tell which deadline expired.
+func (f CtxFunc3VariadicError[V, P0, P1, P2]) WithDeadlineCause(deadline time.Time, cause error) CtxFunc3VariadicError[V, P0, P1, P2] {
+	return func(ctx context.Context, p0 P0, p1 P1, p2 P2, xs ...V) error {
+		ctx, cancel := context.WithDeadlineCause(ctx, deadline, cause)
+		defer cancel()
+		err := f(ctx, p0, p1, p2, xs...)
+		return withCause(ctx, err)
+	}
+}
+
+// WithBudget returns a CtxFunc3VariadicError giving the CtxFunc3VariadicError a fraction of the
+// remaining deadline of the caller, capped at max when max is positive,
+// instead of a fixed timeout. Without a deadline, the CtxFunc3VariadicError gets max, or
+// no timeout if max is not positive.
+// When the share is below min, the CtxFunc3VariadicError is not called and a
+// *BudgetError, matching ErrBudgetExhausted, is returned.
+func (f CtxFunc3VariadicError[V, P0, P1, P2]) WithBudget(fraction float64, min, max time.Duration) CtxFunc3VariadicError[V, P0, P1, P2] {
+	return func(ctx context.Context, p0 P0, p1 P1, p2 P2, xs ...V) error {
+		timeout, bounded, err := budget(ctx, fraction, min, max)
+		if err != nil {
+			return err
+		}
+		if bounded {
+			var cancel context.CancelFunc
+			ctx, cancel = context.WithTimeout(ctx, timeout)
+			defer cancel()
+		}
+		return f(ctx, p0, p1, p2, xs...)
+	}
+}
+
+// Retry returns a CtxFunc3VariadicError that will retry the CtxFunc3VariadicError until it returns
+// a nil error or the tryAgain function returns false, in which case it
+// returns a *RetryError holding the errors of all the attempts.
+// The options set the backoff between attempts and hooks, e.g. OnRetry.
+// RetryAttemptTimeout gives every attempt its own deadline within the one of
+// the caller.
+func (f CtxFunc3VariadicError[V, P0, P1, P2]) Retry(tryAgain func(attempts int, err error) bool, opts ...RetryOption) CtxFunc3VariadicError[V, P0, P1, P2] {
+	return func(ctx context.Context, p0 P0, p1 P1, p2 P2, xs ...V) error {
+		return retry(ctx, tryAgain, opts, func(ctx context.Context) error {
+			return f(ctx, p0, p1, p2, xs...)
+		})
+	}
+}
+
+// OnErr returns a CtxFunc3VariadicError that will wrap the error with the
+// provided message.
+func (f CtxFunc3VariadicError[V, P0, P1, P2]) OnErr(msg string) CtxFunc3VariadicError[V, P0, P1, P2] {
+	return func(ctx context.Context, p0 P0, p1 P1, p2 P2, xs ...V) error {
+		err := f(ctx, p0, p1, p2, xs...)
+		if err != nil {
+			return fmt.Errorf("%s: %w", msg, err)
+		}
+		return nil
+	}
+}
+
+// CurryVariadic returns a CtxFunc3VariadicError with some of its variadic
+// arguments bound. The bound arguments come before the ones passed to the
+// returned function.
+func (f CtxFunc3VariadicError[V, P0, P1, P2]) CurryVariadic(bound ...V) CtxFunc3VariadicError[V, P0, P1, P2] {
+	return func(ctx context.Context, p0 P0, p1 P1, p2 P2, xs ...V) error {
+		xs = append(append(make([]V, 0, len(bound)+len(xs)), bound...), xs...)
+		return f(ctx, p0, p1, p2, xs...)
+	}
+}
+
+// InjectFaults returns a CtxFunc3VariadicError injecting the errors, panics and latency
+// configured by cfg in its calls, to test how the callers cope with them.
+// See FaultConfig, and DisableFaults for the global kill-switch.
+func (f CtxFunc3VariadicError[V, P0, P1, P2]) InjectFaults(cfg FaultConfig) CtxFunc3VariadicError[V, P0, P1, P2] {
+	fi := newFaultInjector(cfg)
+	return func(ctx context.Context, p0 P0, p1 P1, p2 P2, xs ...V) error {
+		if err := fi.inject(ctx, p0, p1, p2, xs); err != nil {
+			return err
+		}
+		return f(ctx, p0, p1, p2, xs...)
+	}
+}
+
+// Curry3 returns a CtxFuncVariadicError[V] with the first 3 arguments of the CtxFunc3VariadicError bound.
+func (f CtxFunc3VariadicError[V, P0, P1, P2]) Curry3(p0 P0, p1 P1, p2 P2) CtxFuncVariadicError[V] {
+	return func(ctx context.Context, xs ...V) error {
+		return f(ctx, p0, p1, p2, xs...)
+	}
+}
+
+// Curry2 returns a CtxFunc1VariadicError[V, P2] with the first 2 arguments of the CtxFunc3VariadicError bound.
+func (f CtxFunc3VariadicError[V, P0, P1, P2]) Curry2(p0 P0, p1 P1) CtxFunc1VariadicError[V, P2] {
+	return func(ctx context.Context, p2 P2, xs ...V) error {
+		return f(ctx, p0, p1, p2, xs...)
+	}
+}
+
+// Curry1 returns a CtxFunc2VariadicError[V, P1, P2] with the first argument of the CtxFunc3VariadicError bound.
+func (f CtxFunc3VariadicError[V, P0, P1, P2]) Curry1(p0 P0) CtxFunc2VariadicError[V, P1, P2] {
+	return func(ctx context.Context, p1 P1, p2 P2, xs ...V) error {
+		return f(ctx, p0, p1, p2, xs...)
+	}
+}
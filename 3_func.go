@@ -1,13 +1,14 @@
-//go:generate go run ./generator -arity 10
+// Code generated by powerfunc/generator from func.go.tmpl. DO NOT EDIT.
 
 package powerfunc
 
 import (
+	"context"
 	"fmt"
 	"time"
 )
 
-// Func3 is a function that takes 0 arguments and returns no values.
+// Func3 is a function that takes 3 arguments and returns no values.
 type Func3[P0, P1, P2 any] func(p0 P0, p1 P1, p2 P2)
 
 // Exec executes the Function.
@@ -20,10 +21,15 @@ func (f Func3[P0, P1, P2]) Exec(p0 P0, p1 P1, p2 P2) {
 // Timing returns a Func3 that will log the execution time of the Func3.
 // If no loggers are provided, the default logger (fmt.Println) will be used.
 func (f Func3[P0, P1, P2]) Timing(loggers ...func(d time.Duration)) Func3[P0, P1, P2] {
+	return f.TimingWithClock(RealClock(), loggers...)
+}
+
+// TimingWithClock is like Timing, but measures the execution time with clock.
+func (f Func3[P0, P1, P2]) TimingWithClock(clock Clock, loggers ...func(d time.Duration)) Func3[P0, P1, P2] {
 	return func(p0 P0, p1 P1, p2 P2) {
-		start := time.Now()
+		start := clock.Now()
 		defer func() {
-			dur := time.Since(start)
+			dur := clock.Since(start)
 			for _, logger := range loggers {
 				logger(dur)
 			}
@@ -46,24 +52,75 @@ func (f Func3[P0, P1, P2]) Fallible() Func3Error[P0, P1, P2] {
 	}
 }
 
+// Debounce returns a Func3 that delays its execution until wait has elapsed
+// since the last call, along with the Debouncer controlling it.
+// Only the most recent call is kept and eventually invoked.
+func (f Func3[P0, P1, P2]) Debounce(wait time.Duration, opts ...DebounceOption) (Func3[P0, P1, P2], *Debouncer) {
+	d := newDebouncer(wait, opts...)
+	return func(p0 P0, p1 P1, p2 P2) {
+		d.call(func() {
+			f(p0, p1, p2)
+		})
+	}, d
+}
+
+// Throttle returns a Func3 that executes at most once per interval, along
+// with the Debouncer controlling it.
+// The first call is invoked immediately, and the most recent call made
+// during the interval is invoked at the end of it.
+// The options, e.g. DebounceClock, apply to the underlying Debouncer.
+func (f Func3[P0, P1, P2]) Throttle(interval time.Duration, opts ...DebounceOption) (Func3[P0, P1, P2], *Debouncer) {
+	d := newThrottler(interval, opts...)
+	return func(p0 P0, p1 P1, p2 P2) {
+		d.call(func() {
+			f(p0, p1, p2)
+		})
+	}, d
+}
+
+// Once returns a Func3 that only executes the first time it is called,
+// along with the Once controlling it.
+func (f Func3[P0, P1, P2]) Once(opts ...OnceOption) (Func3[P0, P1, P2], *Once) {
+	o := newOnce(opts...)
+	return func(p0 P0, p1 P1, p2 P2) {
+		_, _ = o.do(context.Background(), false, func() (any, error) {
+			f(p0, p1, p2)
+			return nil, nil
+		})
+	}, o
+}
+
+// Lazy returns a Func3 that executes the first time it is called,
+// along with the Once controlling it.
+// A Func3 cannot fail, so Lazy behaves like Once unless it panics, in which
+// case the next call executes it again.
+func (f Func3[P0, P1, P2]) Lazy(opts ...OnceOption) (Func3[P0, P1, P2], *Once) {
+	o := newLazy(opts...)
+	return func(p0 P0, p1 P1, p2 P2) {
+		_, _ = o.do(context.Background(), false, func() (any, error) {
+			f(p0, p1, p2)
+			return nil, nil
+		})
+	}, o
+}
 
+// Curry3 returns a Func with the first 3 arguments of the Func3 bound.
 func (f Func3[P0, P1, P2]) Curry3(p0 P0, p1 P1, p2 P2) Func {
-	return func()  {
+	return func() {
 		f(p0, p1, p2)
 	}
 }
-	
 
+// Curry2 returns a Func1[P2] with the first 2 arguments of the Func3 bound.
 func (f Func3[P0, P1, P2]) Curry2(p0 P0, p1 P1) Func1[P2] {
-	return func(p2 P2)  {
+	return func(p2 P2) {
 		f(p0, p1, p2)
 	}
 }
-	
 
+// Curry1 returns a Func2[P1, P2] with the first argument of the Func3 bound.
 func (f Func3[P0, P1, P2]) Curry1(p0 P0) Func2[P1, P2] {
-	return func(p1 P1, p2 P2)  {
+	return func(p1 P1, p2 P2) {
 		f(p0, p1, p2)
 	}
 }
-	
\ No newline at end of file
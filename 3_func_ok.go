@@ -0,0 +1,162 @@
+// Code generated by powerfunc/generator from func_ok.go.tmpl. DO NOT EDIT.
+
+package powerfunc
+
+import (
+	"fmt"
+	"time"
+)
+
+// Func3Ok is a function that takes 3 arguments and returns a value and a
+// boolean reporting whether the value is valid, like a map lookup.
+type Func3Ok[R, P0, P1, P2 any] func(p0 P0, p1 P1, p2 P2) (R, bool)
+
+// Exec executes the function.
+// Convenience method to remove some of the confusion that
+// `f.SomeMethod()()` can bring.
+func (f Func3Ok[R, P0, P1, P2]) Exec(p0 P0, p1 P1, p2 P2) (R, bool) {
+	return f(p0, p1, p2)
+}
+
+// Timing returns a Func3Ok that will log the execution time of the Func3Ok.
+// If no loggers are provided, the default logger (fmt.Println) will be used.
+func (f Func3Ok[R, P0, P1, P2]) Timing(loggers ...func(d time.Duration)) Func3Ok[R, P0, P1, P2] {
+	return f.TimingWithClock(RealClock(), loggers...)
+}
+
+// TimingWithClock is like Timing, but measures the execution time with clock.
+func (f Func3Ok[R, P0, P1, P2]) TimingWithClock(clock Clock, loggers ...func(d time.Duration)) Func3Ok[R, P0, P1, P2] {
+	return func(p0 P0, p1 P1, p2 P2) (R, bool) {
+		start := clock.Now()
+		defer func() {
+			dur := clock.Since(start)
+			for _, logger := range loggers {
+				logger(dur)
+			}
+			if len(loggers) == 0 {
+				// Default logger
+				fmt.Println(dur)
+			}
+		}()
+		return f(p0, p1, p2)
+	}
+}
+
+// Retry returns a Func3Ok that will retry the Func3Ok until it returns
+// true or the tryAgain function returns false.
+// The attempts that returned false fail with a placeholder error, given to
+// tryAgain and to the hooks of the options, e.g. OnRetry, so that any
+// RetryPolicy can be used.
+func (f Func3Ok[R, P0, P1, P2]) Retry(tryAgain RetryPolicy, opts ...RetryOption) Func3Ok[R, P0, P1, P2] {
+	return func(p0 P0, p1 P1, p2 P2) (R, bool) {
+		var v R
+		var ok bool
+		err := retryNoContext(tryAgain, opts, func() error {
+			v, ok = f(p0, p1, p2)
+			if !ok {
+				return errNotOk
+			}
+			return nil
+		})
+		return v, err == nil
+	}
+}
+
+// Must returns a Func3Value that will panic if the Func3Ok returns false.
+func (f Func3Ok[R, P0, P1, P2]) Must() Func3Value[R, P0, P1, P2] {
+	return func(p0 P0, p1 P1, p2 P2) R {
+		v, ok := f(p0, p1, p2)
+		if !ok {
+			panic("powerfunc: Func3Ok returned false")
+		}
+		return v
+	}
+}
+
+// Fallback returns a Func3Value that will return the provided value if the
+// Func3Ok returns false.
+func (f Func3Ok[R, P0, P1, P2]) Fallback(val R) Func3Value[R, P0, P1, P2] {
+	return func(p0 P0, p1 P1, p2 P2) R {
+		v, ok := f(p0, p1, p2)
+		if !ok {
+			return val
+		}
+		return v
+	}
+}
+
+// OrElse returns a Func3Value that will return the provided value if the
+// Func3Ok returns false. It is an alias of Fallback.
+func (f Func3Ok[R, P0, P1, P2]) OrElse(val R) Func3Value[R, P0, P1, P2] {
+	return f.Fallback(val)
+}
+
+// OrElseGet returns a Func3Value that will call elsef if the Func3Ok
+// returns false.
+func (f Func3Ok[R, P0, P1, P2]) OrElseGet(elsef Func3Value[R, P0, P1, P2]) Func3Value[R, P0, P1, P2] {
+	return func(p0 P0, p1 P1, p2 P2) R {
+		v, ok := f(p0, p1, p2)
+		if !ok {
+			return elsef(p0, p1, p2)
+		}
+		return v
+	}
+}
+
+// ToResult transforms a Func3Ok into a Func3Result returning the provided
+// error when the Func3Ok returns false.
+func (f Func3Ok[R, P0, P1, P2]) ToResult(err error) Func3Result[R, P0, P1, P2] {
+	return func(p0 P0, p1 P1, p2 P2) (R, error) {
+		v, ok := f(p0, p1, p2)
+		if !ok {
+			return v, err
+		}
+		return v, nil
+	}
+}
+
+// Filter returns a Func3Ok that will return false if the value returned by
+// the Func3Ok does not satisfy keep.
+func (f Func3Ok[R, P0, P1, P2]) Filter(keep func(R) bool) Func3Ok[R, P0, P1, P2] {
+	return func(p0 P0, p1 P1, p2 P2) (R, bool) {
+		v, ok := f(p0, p1, p2)
+		if !ok || !keep(v) {
+			var zero R
+			return zero, false
+		}
+		return v, true
+	}
+}
+
+// Map applies the provided function to the value returned by the Func3Ok,
+// if it returns true.
+func (f Func3Ok[R, P0, P1, P2]) Map(fn func(R) R) Func3Ok[R, P0, P1, P2] {
+	return func(p0 P0, p1 P1, p2 P2) (R, bool) {
+		v, ok := f(p0, p1, p2)
+		if !ok {
+			return v, false
+		}
+		return fn(v), true
+	}
+}
+
+// Curry3 returns a FuncOk[R] with the first 3 arguments of the Func3Ok bound.
+func (f Func3Ok[R, P0, P1, P2]) Curry3(p0 P0, p1 P1, p2 P2) FuncOk[R] {
+	return func() (R, bool) {
+		return f(p0, p1, p2)
+	}
+}
+
+// Curry2 returns a Func1Ok[R, P2] with the first 2 arguments of the Func3Ok bound.
+func (f Func3Ok[R, P0, P1, P2]) Curry2(p0 P0, p1 P1) Func1Ok[R, P2] {
+	return func(p2 P2) (R, bool) {
+		return f(p0, p1, p2)
+	}
+}
+
+// Curry1 returns a Func2Ok[R, P1, P2] with the first argument of the Func3Ok bound.
+func (f Func3Ok[R, P0, P1, P2]) Curry1(p0 P0) Func2Ok[R, P1, P2] {
+	return func(p1 P1, p2 P2) (R, bool) {
+		return f(p0, p1, p2)
+	}
+}
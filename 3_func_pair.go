@@ -0,0 +1,73 @@
+// Code generated by powerfunc/generator from func_pair.go.tmpl. DO NOT EDIT.
+
+package powerfunc
+
+import (
+	"fmt"
+	"time"
+)
+
+// Func3Pair is a function that takes 3 arguments and returns two values.
+type Func3Pair[R1, R2, P0, P1, P2 any] func(p0 P0, p1 P1, p2 P2) (R1, R2)
+
+// Exec executes the function.
+// Convenience method to remove some of the confusion that
+// `f.SomeMethod()()` can bring.
+func (f Func3Pair[R1, R2, P0, P1, P2]) Exec(p0 P0, p1 P1, p2 P2) (R1, R2) {
+	return f(p0, p1, p2)
+}
+
+// Timing returns a Func3Pair that will log the execution time of the Func3Pair.
+// If no loggers are provided, the default logger (fmt.Println) will be used.
+func (f Func3Pair[R1, R2, P0, P1, P2]) Timing(loggers ...func(d time.Duration)) Func3Pair[R1, R2, P0, P1, P2] {
+	return f.TimingWithClock(RealClock(), loggers...)
+}
+
+// TimingWithClock is like Timing, but measures the execution time with clock.
+func (f Func3Pair[R1, R2, P0, P1, P2]) TimingWithClock(clock Clock, loggers ...func(d time.Duration)) Func3Pair[R1, R2, P0, P1, P2] {
+	return func(p0 P0, p1 P1, p2 P2) (R1, R2) {
+		start := clock.Now()
+		defer func() {
+			dur := clock.Since(start)
+			for _, logger := range loggers {
+				logger(dur)
+			}
+			if len(loggers) == 0 {
+				// Default logger
+				fmt.Println(dur)
+			}
+		}()
+		return f(p0, p1, p2)
+	}
+}
+
+// Fallible transforms a Func3Pair into a Func3PairResult.
+// The returned Func3PairResult will never return an error.
+// Useful when passing a Func3Pair to a function that expects a Func3PairResult.
+func (f Func3Pair[R1, R2, P0, P1, P2]) Fallible() Func3PairResult[R1, R2, P0, P1, P2] {
+	return func(p0 P0, p1 P1, p2 P2) (R1, R2, error) {
+		v1, v2 := f(p0, p1, p2)
+		return v1, v2, nil
+	}
+}
+
+// Curry3 returns a FuncPair[R1, R2] with the first 3 arguments of the Func3Pair bound.
+func (f Func3Pair[R1, R2, P0, P1, P2]) Curry3(p0 P0, p1 P1, p2 P2) FuncPair[R1, R2] {
+	return func() (R1, R2) {
+		return f(p0, p1, p2)
+	}
+}
+
+// Curry2 returns a Func1Pair[R1, R2, P2] with the first 2 arguments of the Func3Pair bound.
+func (f Func3Pair[R1, R2, P0, P1, P2]) Curry2(p0 P0, p1 P1) Func1Pair[R1, R2, P2] {
+	return func(p2 P2) (R1, R2) {
+		return f(p0, p1, p2)
+	}
+}
+
+// Curry1 returns a Func2Pair[R1, R2, P1, P2] with the first argument of the Func3Pair bound.
+func (f Func3Pair[R1, R2, P0, P1, P2]) Curry1(p0 P0) Func2Pair[R1, R2, P1, P2] {
+	return func(p1 P1, p2 P2) (R1, R2) {
+		return f(p0, p1, p2)
+	}
+}
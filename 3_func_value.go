@@ -1,27 +1,35 @@
+// Code generated by powerfunc/generator from func_value.go.tmpl. DO NOT EDIT.
+
 package powerfunc
 
 import (
+	"context"
 	"fmt"
 	"time"
 )
 
-// Func3 is a function that takes 0 arguments and returns a value.
-type Func3Value[T, P0, P1, P2 any] func(p0 P0, p1 P1, p2 P2) T
+// Func3Value is a function that takes 3 arguments and returns a value.
+type Func3Value[R, P0, P1, P2 any] func(p0 P0, p1 P1, p2 P2) R
 
 // Exec executes the function.
 // Convenience method to remove some of the confusion that
 // `f.SomeMethod()()` can bring.
-func (f Func3Value[T, P0, P1, P2]) Exec(p0 P0, p1 P1, p2 P2) T {
+func (f Func3Value[R, P0, P1, P2]) Exec(p0 P0, p1 P1, p2 P2) R {
 	return f(p0, p1, p2)
 }
 
-// Timing returns a Func3 that will log the execution time of the Func3.
+// Timing returns a Func3Value that will log the execution time of the Func3Value.
 // If no loggers are provided, the default logger (fmt.Println) will be used.
-func (f Func3Value[T, P0, P1, P2]) Timing(loggers ...func(d time.Duration)) Func3Value[T, P0, P1, P2] {
-	return func(p0 P0, p1 P1, p2 P2) T {
-		start := time.Now()
+func (f Func3Value[R, P0, P1, P2]) Timing(loggers ...func(d time.Duration)) Func3Value[R, P0, P1, P2] {
+	return f.TimingWithClock(RealClock(), loggers...)
+}
+
+// TimingWithClock is like Timing, but measures the execution time with clock.
+func (f Func3Value[R, P0, P1, P2]) TimingWithClock(clock Clock, loggers ...func(d time.Duration)) Func3Value[R, P0, P1, P2] {
+	return func(p0 P0, p1 P1, p2 P2) R {
+		start := clock.Now()
 		defer func() {
-			dur := time.Since(start)
+			dur := clock.Since(start)
 			for _, logger := range loggers {
 				logger(dur)
 			}
@@ -37,30 +45,75 @@ func (f Func3Value[T, P0, P1, P2]) Timing(loggers ...func(d time.Duration)) Func
 // Fallible transforms a Func3Value into a Func3Result.
 // The returned Func3Result will never return an error.
 // Useful when passing a Func3Value to a function that expects a Func3Result.
-func (f Func3Value[T, P0, P1, P2]) Fallible() Func3Result[T, P0, P1, P2] {
-	return func(p0 P0, p1 P1, p2 P2) (T, error) {
+func (f Func3Value[R, P0, P1, P2]) Fallible() Func3Result[R, P0, P1, P2] {
+	return func(p0 P0, p1 P1, p2 P2) (R, error) {
 		return f(p0, p1, p2), nil
 	}
 }
 
+// Once returns a Func3Value that only executes the first time it is called
+// and returns the cached value afterwards, along with the Once controlling it.
+func (f Func3Value[R, P0, P1, P2]) Once(opts ...OnceOption) (Func3Value[R, P0, P1, P2], *Once) {
+	o := newOnce(opts...)
+	return func(p0 P0, p1 P1, p2 P2) R {
+		v, _ := o.do(context.Background(), false, func() (any, error) {
+			return f(p0, p1, p2), nil
+		})
+		val, _ := v.(R)
+		return val
+	}, o
+}
+
+// Lazy returns a Func3Value that computes its value the first time it is
+// called and returns the cached value afterwards, along with the Once
+// controlling it.
+func (f Func3Value[R, P0, P1, P2]) Lazy(opts ...OnceOption) (Func3Value[R, P0, P1, P2], *Once) {
+	o := newLazy(opts...)
+	return func(p0 P0, p1 P1, p2 P2) R {
+		v, _ := o.do(context.Background(), false, func() (any, error) {
+			return f(p0, p1, p2), nil
+		})
+		val, _ := v.(R)
+		return val
+	}, o
+}
+
+// WithTimeout returns a Func3Result that runs the Func3Value in its own
+// goroutine and returns a *TimeoutError, matching ErrTimeout, if it does not
+// return within timeout. The goroutine is then abandoned: it keeps running
+// until the Func3Value returns, and is counted by Abandoned.
+// Prefer the WithTimeout method of a function taking a context, which can
+// actually be cancelled.
+func (f Func3Value[R, P0, P1, P2]) WithTimeout(timeout time.Duration, opts ...TimeoutOption) Func3Result[R, P0, P1, P2] {
+	return func(p0 P0, p1 P1, p2 P2) (R, error) {
+		var r0 R
+		if terr := callWithTimeout(timeout, opts, func() {
+			r0 = f(p0, p1, p2)
+		}); terr != nil {
+			var zero R
+			return zero, terr
+		}
+		return r0, nil
+	}
+}
 
+// Curry3 returns a FuncValue[R] with the first 3 arguments of the Func3Value bound.
 func (f Func3Value[R, P0, P1, P2]) Curry3(p0 P0, p1 P1, p2 P2) FuncValue[R] {
 	return func() R {
 		return f(p0, p1, p2)
 	}
 }
-	
 
+// Curry2 returns a Func1Value[R, P2] with the first 2 arguments of the Func3Value bound.
 func (f Func3Value[R, P0, P1, P2]) Curry2(p0 P0, p1 P1) Func1Value[R, P2] {
 	return func(p2 P2) R {
 		return f(p0, p1, p2)
 	}
 }
-	
 
+// Curry1 returns a Func2Value[R, P1, P2] with the first argument of the Func3Value bound.
 func (f Func3Value[R, P0, P1, P2]) Curry1(p0 P0) Func2Value[R, P1, P2] {
 	return func(p1 P1, p2 P2) R {
 		return f(p0, p1, p2)
 	}
 }
-	
\ No newline at end of file
@@ -1,3 +1,5 @@
+// Code generated by powerfunc/generator from ctx_func.go.tmpl. DO NOT EDIT.
+
 package powerfunc
 
 import (
@@ -13,10 +15,15 @@ func (f CtxFunc4[P0, P1, P2, P3]) Exec(ctx context.Context, p0 P0, p1 P1, p2 P2,
 }
 
 func (f CtxFunc4[P0, P1, P2, P3]) Timing(loggers ...func(d time.Duration)) CtxFunc4[P0, P1, P2, P3] {
+	return f.TimingWithClock(RealClock(), loggers...)
+}
+
+// TimingWithClock is like Timing, but measures the execution time with clock.
+func (f CtxFunc4[P0, P1, P2, P3]) TimingWithClock(clock Clock, loggers ...func(d time.Duration)) CtxFunc4[P0, P1, P2, P3] {
 	return func(ctx context.Context, p0 P0, p1 P1, p2 P2, p3 P3) {
-		start := time.Now()
+		start := clock.Now()
 		defer func() {
-			dur := time.Since(start)
+			dur := clock.Since(start)
 			for _, logger := range loggers {
 				logger(dur)
 			}
@@ -60,31 +67,143 @@ func (f CtxFunc4[P0, P1, P2, P3]) WithCancel() CtxFunc4[P0, P1, P2, P3] {
 	}
 }
 
+// WithValue returns a CtxFunc4 calling the CtxFunc4 with a context
+// carrying val for key, see context.WithValue.
+func (f CtxFunc4[P0, P1, P2, P3]) WithValue(key, val any) CtxFunc4[P0, P1, P2, P3] {
+	return func(ctx context.Context, p0 P0, p1 P1, p2 P2, p3 P3) {
+		ctx = context.WithValue(ctx, key, val)
+		f(ctx, p0, p1, p2, p3)
+	}
+}
+
+// WithValueFrom returns a CtxFunc4 calling the CtxFunc4 with a context
+// carrying the value returned by value for its key. value gets the arguments
+// of every call.
+func (f CtxFunc4[P0, P1, P2, P3]) WithValueFrom(value func(ctx context.Context, p0 P0, p1 P1, p2 P2, p3 P3) (key, val any)) CtxFunc4[P0, P1, P2, P3] {
+	return func(ctx context.Context, p0 P0, p1 P1, p2 P2, p3 P3) {
+		key, val := value(ctx, p0, p1, p2, p3)
+		ctx = context.WithValue(ctx, key, val)
+		f(ctx, p0, p1, p2, p3)
+	}
+}
+
+// Detached returns a CtxFunc4 calling the CtxFunc4 with a context that is
+// never cancelled but keeps the values of the caller's context, see
+// context.WithoutCancel. Useful for cleanups that must run to completion.
+func (f CtxFunc4[P0, P1, P2, P3]) Detached() CtxFunc4[P0, P1, P2, P3] {
+	return func(ctx context.Context, p0 P0, p1 P1, p2 P2, p3 P3) {
+		ctx = context.WithoutCancel(ctx)
+		f(ctx, p0, p1, p2, p3)
+	}
+}
+
+// WithTimeoutCause is like WithTimeout, but the context is cancelled with
+// cause when the timeout expires, see context.WithTimeoutCause.
+func (f CtxFunc4[P0, P1, P2, P3]) WithTimeoutCause(timeout time.Duration, cause error) CtxFunc4[P0, P1, P2, P3] {
+	return func(ctx context.Context, p0 P0, p1 P1, p2 P2, p3 P3) {
+		ctx, cancel := context.WithTimeoutCause(ctx, timeout, cause)
+		defer cancel()
+		f(ctx, p0, p1, p2, p3)
+	}
+}
+
+// WithDeadlineCause is like WithDeadline, but the context is cancelled with
+// cause when the deadline expires, see context.WithDeadlineCause.
+func (f CtxFunc4[P0, P1, P2, P3]) WithDeadlineCause(deadline time.Time, cause error) CtxFunc4[P0, P1, P2, P3] {
+	return func(ctx context.Context, p0 P0, p1 P1, p2 P2, p3 P3) {
+		ctx, cancel := context.WithDeadlineCause(ctx, deadline, cause)
+		defer cancel()
+		f(ctx, p0, p1, p2, p3)
+	}
+}
+
+// Debounce returns a CtxFunc4 that delays its execution until wait has elapsed
+// since the last call, along with the Debouncer controlling it.
+// Only the most recent call, and its context, is kept and eventually invoked.
+func (f CtxFunc4[P0, P1, P2, P3]) Debounce(wait time.Duration, opts ...DebounceOption) (CtxFunc4[P0, P1, P2, P3], *Debouncer) {
+	d := newDebouncer(wait, opts...)
+	return func(ctx context.Context, p0 P0, p1 P1, p2 P2, p3 P3) {
+		d.call(func() {
+			f(ctx, p0, p1, p2, p3)
+		})
+	}, d
+}
+
+// Throttle returns a CtxFunc4 that executes at most once per interval, along
+// with the Debouncer controlling it.
+// The first call is invoked immediately, and the most recent call made
+// during the interval is invoked at the end of it.
+// The options, e.g. DebounceClock, apply to the underlying Debouncer.
+func (f CtxFunc4[P0, P1, P2, P3]) Throttle(interval time.Duration, opts ...DebounceOption) (CtxFunc4[P0, P1, P2, P3], *Debouncer) {
+	d := newThrottler(interval, opts...)
+	return func(ctx context.Context, p0 P0, p1 P1, p2 P2, p3 P3) {
+		d.call(func() {
+			f(ctx, p0, p1, p2, p3)
+		})
+	}, d
+}
+
+// Once returns a CtxFunc4 that only executes the first time it is called,
+// along with the Once controlling it.
+// The shared execution does not inherit the cancellation of the caller's
+// context: a caller whose context is done returns without waiting for it,
+// while the execution carries on for the other callers.
+func (f CtxFunc4[P0, P1, P2, P3]) Once(opts ...OnceOption) (CtxFunc4[P0, P1, P2, P3], *Once) {
+	o := newOnce(opts...)
+	return func(ctx context.Context, p0 P0, p1 P1, p2 P2, p3 P3) {
+		_, _ = o.do(ctx, true, func() (any, error) {
+			ctx := context.WithoutCancel(ctx)
+			f(ctx, p0, p1, p2, p3)
+			return nil, nil
+		})
+	}, o
+}
+
+// Lazy returns a CtxFunc4 that executes the first time it is called,
+// along with the Once controlling it.
+// A CtxFunc4 cannot fail, so Lazy behaves like Once unless it panics, in which
+// case the next call executes it again.
+func (f CtxFunc4[P0, P1, P2, P3]) Lazy(opts ...OnceOption) (CtxFunc4[P0, P1, P2, P3], *Once) {
+	o := newLazy(opts...)
+	return func(ctx context.Context, p0 P0, p1 P1, p2 P2, p3 P3) {
+		_, _ = o.do(ctx, true, func() (any, error) {
+			ctx := context.WithoutCancel(ctx)
+			f(ctx, p0, p1, p2, p3)
+			return nil, nil
+		})
+	}, o
+}
+
+// Every returns a runner that calls the CtxFunc4 every interval until its
+// context is done. See CtxFunc4Error.Every for the available options.
+func (f CtxFunc4[P0, P1, P2, P3]) Every(interval time.Duration, opts ...EveryOption) CtxFunc4Error[P0, P1, P2, P3] {
+	return f.Fallible().Every(interval, opts...)
+}
 
+// Curry4 returns a CtxFunc with the first 4 arguments of the CtxFunc4 bound.
 func (f CtxFunc4[P0, P1, P2, P3]) Curry4(p0 P0, p1 P1, p2 P2, p3 P3) CtxFunc {
-	return func(ctx context.Context)  {
+	return func(ctx context.Context) {
 		f(ctx, p0, p1, p2, p3)
 	}
 }
-	
 
+// Curry3 returns a CtxFunc1[P3] with the first 3 arguments of the CtxFunc4 bound.
 func (f CtxFunc4[P0, P1, P2, P3]) Curry3(p0 P0, p1 P1, p2 P2) CtxFunc1[P3] {
-	return func(ctx context.Context, p3 P3)  {
+	return func(ctx context.Context, p3 P3) {
 		f(ctx, p0, p1, p2, p3)
 	}
 }
-	
 
+// Curry2 returns a CtxFunc2[P2, P3] with the first 2 arguments of the CtxFunc4 bound.
 func (f CtxFunc4[P0, P1, P2, P3]) Curry2(p0 P0, p1 P1) CtxFunc2[P2, P3] {
-	return func(ctx context.Context, p2 P2, p3 P3)  {
+	return func(ctx context.Context, p2 P2, p3 P3) {
 		f(ctx, p0, p1, p2, p3)
 	}
 }
-	
 
+// Curry1 returns a CtxFunc3[P1, P2, P3] with the first argument of the CtxFunc4 bound.
 func (f CtxFunc4[P0, P1, P2, P3]) Curry1(p0 P0) CtxFunc3[P1, P2, P3] {
-	return func(ctx context.Context, p1 P1, p2 P2, p3 P3)  {
+	return func(ctx context.Context, p1 P1, p2 P2, p3 P3) {
 		f(ctx, p0, p1, p2, p3)
 	}
 }
-	
\ No newline at end of file
@@ -0,0 +1,239 @@
+// Code generated by powerfunc/generator from ctx_func_ok.go.tmpl. DO NOT EDIT.
+
+package powerfunc
+
+import (
+	"context"
+	"fmt"
+	"time"
+)
+
+type CtxFunc4Ok[R, P0, P1, P2, P3 any] func(ctx context.Context, p0 P0, p1 P1, p2 P2, p3 P3) (R, bool)
+
+func (f CtxFunc4Ok[R, P0, P1, P2, P3]) Exec(ctx context.Context, p0 P0, p1 P1, p2 P2, p3 P3) (R, bool) {
+	return f(ctx, p0, p1, p2, p3)
+}
+
+func (f CtxFunc4Ok[R, P0, P1, P2, P3]) Timing(loggers ...func(d time.Duration)) CtxFunc4Ok[R, P0, P1, P2, P3] {
+	return f.TimingWithClock(RealClock(), loggers...)
+}
+
+// TimingWithClock is like Timing, but measures the execution time with clock.
+func (f CtxFunc4Ok[R, P0, P1, P2, P3]) TimingWithClock(clock Clock, loggers ...func(d time.Duration)) CtxFunc4Ok[R, P0, P1, P2, P3] {
+	return func(ctx context.Context, p0 P0, p1 P1, p2 P2, p3 P3) (R, bool) {
+		start := clock.Now()
+		defer func() {
+			dur := clock.Since(start)
+			for _, logger := range loggers {
+				logger(dur)
+			}
+			if len(loggers) == 0 {
+				// Default logger
+				fmt.Println(dur)
+			}
+		}()
+		return f(ctx, p0, p1, p2, p3)
+	}
+}
+
+func (f CtxFunc4Ok[R, P0, P1, P2, P3]) WithTimeout(timeout time.Duration) CtxFunc4Ok[R, P0, P1, P2, P3] {
+	return func(ctx context.Context, p0 P0, p1 P1, p2 P2, p3 P3) (R, bool) {
+		ctx, cancel := context.WithTimeout(ctx, timeout)
+		defer cancel()
+		return f(ctx, p0, p1, p2, p3)
+	}
+}
+
+func (f CtxFunc4Ok[R, P0, P1, P2, P3]) WithDeadline(deadline time.Time) CtxFunc4Ok[R, P0, P1, P2, P3] {
+	return func(ctx context.Context, p0 P0, p1 P1, p2 P2, p3 P3) (R, bool) {
+		ctx, cancel := context.WithDeadline(ctx, deadline)
+		defer cancel()
+		return f(ctx, p0, p1, p2, p3)
+	}
+}
+
+func (f CtxFunc4Ok[R, P0, P1, P2, P3]) WithCancel() CtxFunc4Ok[R, P0, P1, P2, P3] {
+	return func(ctx context.Context, p0 P0, p1 P1, p2 P2, p3 P3) (R, bool) {
+		ctx, cancel := context.WithCancel(ctx)
+		defer cancel()
+		return f(ctx, p0, p1, p2, p3)
+	}
+}
+
+// WithValue returns a CtxFunc4Ok calling the CtxFunc4Ok with a context
+// carrying val for key, see context.WithValue.
+func (f CtxFunc4Ok[R, P0, P1, P2, P3]) WithValue(key, val any) CtxFunc4Ok[R, P0, P1, P2, P3] {
+	return func(ctx context.Context, p0 P0, p1 P1, p2 P2, p3 P3) (R, bool) {
+		ctx = context.WithValue(ctx, key, val)
+		return f(ctx, p0, p1, p2, p3)
+	}
+}
+
+// WithValueFrom returns a CtxFunc4Ok calling the CtxFunc4Ok with a context
+// carrying the value returned by value for its key. value gets the arguments
+// of every call.
+func (f CtxFunc4Ok[R, P0, P1, P2, P3]) WithValueFrom(value func(ctx context.Context, p0 P0, p1 P1, p2 P2, p3 P3) (key, val any)) CtxFunc4Ok[R, P0, P1, P2, P3] {
+	return func(ctx context.Context, p0 P0, p1 P1, p2 P2, p3 P3) (R, bool) {
+		key, val := value(ctx, p0, p1, p2, p3)
+		ctx = context.WithValue(ctx, key, val)
+		return f(ctx, p0, p1, p2, p3)
+	}
+}
+
+// Detached returns a CtxFunc4Ok calling the CtxFunc4Ok with a context that is
+// never cancelled but keeps the values of the caller's context, see
+// context.WithoutCancel. Useful for cleanups that must run to completion.
+func (f CtxFunc4Ok[R, P0, P1, P2, P3]) Detached() CtxFunc4Ok[R, P0, P1, P2, P3] {
+	return func(ctx context.Context, p0 P0, p1 P1, p2 P2, p3 P3) (R, bool) {
+		ctx = context.WithoutCancel(ctx)
+		return f(ctx, p0, p1, p2, p3)
+	}
+}
+
+// WithTimeoutCause is like WithTimeout, but the context is cancelled with
+// cause when the timeout expires, see context.WithTimeoutCause.
+func (f CtxFunc4Ok[R, P0, P1, P2, P3]) WithTimeoutCause(timeout time.Duration, cause error) CtxFunc4Ok[R, P0, P1, P2, P3] {
+	return func(ctx context.Context, p0 P0, p1 P1, p2 P2, p3 P3) (R, bool) {
+		ctx, cancel := context.WithTimeoutCause(ctx, timeout, cause)
+		defer cancel()
+		return f(ctx, p0, p1, p2, p3)
+	}
+}
+
+// WithDeadlineCause is like WithDeadline, but the context is cancelled with
+// cause when the deadline expires, see context.WithDeadlineCause.
+func (f CtxFunc4Ok[R, P0, P1, P2, P3]) WithDeadlineCause(deadline time.Time, cause error) CtxFunc4Ok[R, P0, P1, P2, P3] {
+	return func(ctx context.Context, p0 P0, p1 P1, p2 P2, p3 P3) (R, bool) {
+		ctx, cancel := context.WithDeadlineCause(ctx, deadline, cause)
+		defer cancel()
+		return f(ctx, p0, p1, p2, p3)
+	}
+}
+
+// Retry returns a CtxFunc4Ok that will retry the CtxFunc4Ok until it returns
+// true or the tryAgain function returns false.
+// The attempts that returned false fail with a placeholder error, given to
+// tryAgain and to the hooks of the options, e.g. OnRetry, so that any
+// RetryPolicy can be used.
+func (f CtxFunc4Ok[R, P0, P1, P2, P3]) Retry(tryAgain RetryPolicy, opts ...RetryOption) CtxFunc4Ok[R, P0, P1, P2, P3] {
+	return func(ctx context.Context, p0 P0, p1 P1, p2 P2, p3 P3) (R, bool) {
+		var v R
+		var ok bool
+		err := retry(ctx, func(attempts int, err error) bool {
+			return ctx.Err() == nil && tryAgain(attempts, err)
+		}, opts, func(ctx context.Context) error {
+			v, ok = f(ctx, p0, p1, p2, p3)
+			if !ok {
+				return errNotOk
+			}
+			return nil
+		})
+		return v, err == nil
+	}
+}
+
+// Must returns a CtxFunc4Value that will panic if the CtxFunc4Ok returns false.
+func (f CtxFunc4Ok[R, P0, P1, P2, P3]) Must() CtxFunc4Value[R, P0, P1, P2, P3] {
+	return func(ctx context.Context, p0 P0, p1 P1, p2 P2, p3 P3) R {
+		v, ok := f(ctx, p0, p1, p2, p3)
+		if !ok {
+			panic("powerfunc: CtxFunc4Ok returned false")
+		}
+		return v
+	}
+}
+
+// Fallback returns a CtxFunc4Value that will return the provided value if the
+// CtxFunc4Ok returns false.
+func (f CtxFunc4Ok[R, P0, P1, P2, P3]) Fallback(val R) CtxFunc4Value[R, P0, P1, P2, P3] {
+	return func(ctx context.Context, p0 P0, p1 P1, p2 P2, p3 P3) R {
+		v, ok := f(ctx, p0, p1, p2, p3)
+		if !ok {
+			return val
+		}
+		return v
+	}
+}
+
+// OrElse returns a CtxFunc4Value that will return the provided value if the
+// CtxFunc4Ok returns false. It is an alias of Fallback.
+func (f CtxFunc4Ok[R, P0, P1, P2, P3]) OrElse(val R) CtxFunc4Value[R, P0, P1, P2, P3] {
+	return f.Fallback(val)
+}
+
+// OrElseGet returns a CtxFunc4Value that will call elsef if the CtxFunc4Ok
+// returns false.
+func (f CtxFunc4Ok[R, P0, P1, P2, P3]) OrElseGet(elsef CtxFunc4Value[R, P0, P1, P2, P3]) CtxFunc4Value[R, P0, P1, P2, P3] {
+	return func(ctx context.Context, p0 P0, p1 P1, p2 P2, p3 P3) R {
+		v, ok := f(ctx, p0, p1, p2, p3)
+		if !ok {
+			return elsef(ctx, p0, p1, p2, p3)
+		}
+		return v
+	}
+}
+
+// ToResult transforms a CtxFunc4Ok into a CtxFunc4Result returning the
+// provided error when the CtxFunc4Ok returns false.
+func (f CtxFunc4Ok[R, P0, P1, P2, P3]) ToResult(err error) CtxFunc4Result[R, P0, P1, P2, P3] {
+	return func(ctx context.Context, p0 P0, p1 P1, p2 P2, p3 P3) (R, error) {
+		v, ok := f(ctx, p0, p1, p2, p3)
+		if !ok {
+			return v, err
+		}
+		return v, nil
+	}
+}
+
+// Filter returns a CtxFunc4Ok that will return false if the value returned by
+// the CtxFunc4Ok does not satisfy keep.
+func (f CtxFunc4Ok[R, P0, P1, P2, P3]) Filter(keep func(R) bool) CtxFunc4Ok[R, P0, P1, P2, P3] {
+	return func(ctx context.Context, p0 P0, p1 P1, p2 P2, p3 P3) (R, bool) {
+		v, ok := f(ctx, p0, p1, p2, p3)
+		if !ok || !keep(v) {
+			var zero R
+			return zero, false
+		}
+		return v, true
+	}
+}
+
+// Map applies the provided function to the value returned by the CtxFunc4Ok,
+// if it returns true.
+func (f CtxFunc4Ok[R, P0, P1, P2, P3]) Map(fn func(R) R) CtxFunc4Ok[R, P0, P1, P2, P3] {
+	return func(ctx context.Context, p0 P0, p1 P1, p2 P2, p3 P3) (R, bool) {
+		v, ok := f(ctx, p0, p1, p2, p3)
+		if !ok {
+			return v, false
+		}
+		return fn(v), true
+	}
+}
+
+// Curry4 returns a CtxFuncOk[R] with the first 4 arguments of the CtxFunc4Ok bound.
+func (f CtxFunc4Ok[R, P0, P1, P2, P3]) Curry4(p0 P0, p1 P1, p2 P2, p3 P3) CtxFuncOk[R] {
+	return func(ctx context.Context) (R, bool) {
+		return f(ctx, p0, p1, p2, p3)
+	}
+}
+
+// Curry3 returns a CtxFunc1Ok[R, P3] with the first 3 arguments of the CtxFunc4Ok bound.
+func (f CtxFunc4Ok[R, P0, P1, P2, P3]) Curry3(p0 P0, p1 P1, p2 P2) CtxFunc1Ok[R, P3] {
+	return func(ctx context.Context, p3 P3) (R, bool) {
+		return f(ctx, p0, p1, p2, p3)
+	}
+}
+
+// Curry2 returns a CtxFunc2Ok[R, P2, P3] with the first 2 arguments of the CtxFunc4Ok bound.
+func (f CtxFunc4Ok[R, P0, P1, P2, P3]) Curry2(p0 P0, p1 P1) CtxFunc2Ok[R, P2, P3] {
+	return func(ctx context.Context, p2 P2, p3 P3) (R, bool) {
+		return f(ctx, p0, p1, p2, p3)
+	}
+}
+
+// Curry1 returns a CtxFunc3Ok[R, P1, P2, P3] with the first argument of the CtxFunc4Ok bound.
+func (f CtxFunc4Ok[R, P0, P1, P2, P3]) Curry1(p0 P0) CtxFunc3Ok[R, P1, P2, P3] {
+	return func(ctx context.Context, p1 P1, p2 P2, p3 P3) (R, bool) {
+		return f(ctx, p0, p1, p2, p3)
+	}
+}
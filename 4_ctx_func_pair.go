@@ -0,0 +1,146 @@
+// Code generated by powerfunc/generator from ctx_func_pair.go.tmpl. DO NOT EDIT.
+
+package powerfunc
+
+import (
+	"context"
+	"fmt"
+	"time"
+)
+
+type CtxFunc4Pair[R1, R2, P0, P1, P2, P3 any] func(ctx context.Context, p0 P0, p1 P1, p2 P2, p3 P3) (R1, R2)
+
+func (f CtxFunc4Pair[R1, R2, P0, P1, P2, P3]) Exec(ctx context.Context, p0 P0, p1 P1, p2 P2, p3 P3) (R1, R2) {
+	return f(ctx, p0, p1, p2, p3)
+}
+
+func (f CtxFunc4Pair[R1, R2, P0, P1, P2, P3]) Timing(loggers ...func(d time.Duration)) CtxFunc4Pair[R1, R2, P0, P1, P2, P3] {
+	return f.TimingWithClock(RealClock(), loggers...)
+}
+
+// TimingWithClock is like Timing, but measures the execution time with clock.
+func (f CtxFunc4Pair[R1, R2, P0, P1, P2, P3]) TimingWithClock(clock Clock, loggers ...func(d time.Duration)) CtxFunc4Pair[R1, R2, P0, P1, P2, P3] {
+	return func(ctx context.Context, p0 P0, p1 P1, p2 P2, p3 P3) (R1, R2) {
+		start := clock.Now()
+		defer func() {
+			dur := clock.Since(start)
+			for _, logger := range loggers {
+				logger(dur)
+			}
+			if len(loggers) == 0 {
+				// Default logger
+				fmt.Println(dur)
+			}
+		}()
+		return f(ctx, p0, p1, p2, p3)
+	}
+}
+
+func (f CtxFunc4Pair[R1, R2, P0, P1, P2, P3]) Fallible() CtxFunc4PairResult[R1, R2, P0, P1, P2, P3] {
+	return func(ctx context.Context, p0 P0, p1 P1, p2 P2, p3 P3) (R1, R2, error) {
+		v1, v2 := f(ctx, p0, p1, p2, p3)
+		return v1, v2, nil
+	}
+}
+
+func (f CtxFunc4Pair[R1, R2, P0, P1, P2, P3]) WithTimeout(timeout time.Duration) CtxFunc4Pair[R1, R2, P0, P1, P2, P3] {
+	return func(ctx context.Context, p0 P0, p1 P1, p2 P2, p3 P3) (R1, R2) {
+		ctx, cancel := context.WithTimeout(ctx, timeout)
+		defer cancel()
+		return f(ctx, p0, p1, p2, p3)
+	}
+}
+
+func (f CtxFunc4Pair[R1, R2, P0, P1, P2, P3]) WithDeadline(deadline time.Time) CtxFunc4Pair[R1, R2, P0, P1, P2, P3] {
+	return func(ctx context.Context, p0 P0, p1 P1, p2 P2, p3 P3) (R1, R2) {
+		ctx, cancel := context.WithDeadline(ctx, deadline)
+		defer cancel()
+		return f(ctx, p0, p1, p2, p3)
+	}
+}
+
+func (f CtxFunc4Pair[R1, R2, P0, P1, P2, P3]) WithCancel() CtxFunc4Pair[R1, R2, P0, P1, P2, P3] {
+	return func(ctx context.Context, p0 P0, p1 P1, p2 P2, p3 P3) (R1, R2) {
+		ctx, cancel := context.WithCancel(ctx)
+		defer cancel()
+		return f(ctx, p0, p1, p2, p3)
+	}
+}
+
+// WithValue returns a CtxFunc4Pair calling the CtxFunc4Pair with a context
+// carrying val for key, see context.WithValue.
+func (f CtxFunc4Pair[R1, R2, P0, P1, P2, P3]) WithValue(key, val any) CtxFunc4Pair[R1, R2, P0, P1, P2, P3] {
+	return func(ctx context.Context, p0 P0, p1 P1, p2 P2, p3 P3) (R1, R2) {
+		ctx = context.WithValue(ctx, key, val)
+		return f(ctx, p0, p1, p2, p3)
+	}
+}
+
+// WithValueFrom returns a CtxFunc4Pair calling the CtxFunc4Pair with a context
+// carrying the value returned by value for its key. value gets the arguments
+// of every call.
+func (f CtxFunc4Pair[R1, R2, P0, P1, P2, P3]) WithValueFrom(value func(ctx context.Context, p0 P0, p1 P1, p2 P2, p3 P3) (key, val any)) CtxFunc4Pair[R1, R2, P0, P1, P2, P3] {
+	return func(ctx context.Context, p0 P0, p1 P1, p2 P2, p3 P3) (R1, R2) {
+		key, val := value(ctx, p0, p1, p2, p3)
+		ctx = context.WithValue(ctx, key, val)
+		return f(ctx, p0, p1, p2, p3)
+	}
+}
+
+// Detached returns a CtxFunc4Pair calling the CtxFunc4Pair with a context that is
+// never cancelled but keeps the values of the caller's context, see
+// context.WithoutCancel. Useful for cleanups that must run to completion.
+func (f CtxFunc4Pair[R1, R2, P0, P1, P2, P3]) Detached() CtxFunc4Pair[R1, R2, P0, P1, P2, P3] {
+	return func(ctx context.Context, p0 P0, p1 P1, p2 P2, p3 P3) (R1, R2) {
+		ctx = context.WithoutCancel(ctx)
+		return f(ctx, p0, p1, p2, p3)
+	}
+}
+
+// WithTimeoutCause is like WithTimeout, but the context is cancelled with
+// cause when the timeout expires, see context.WithTimeoutCause.
+func (f CtxFunc4Pair[R1, R2, P0, P1, P2, P3]) WithTimeoutCause(timeout time.Duration, cause error) CtxFunc4Pair[R1, R2, P0, P1, P2, P3] {
+	return func(ctx context.Context, p0 P0, p1 P1, p2 P2, p3 P3) (R1, R2) {
+		ctx, cancel := context.WithTimeoutCause(ctx, timeout, cause)
+		defer cancel()
+		return f(ctx, p0, p1, p2, p3)
+	}
+}
+
+// WithDeadlineCause is like WithDeadline, but the context is cancelled with
+// cause when the deadline expires, see context.WithDeadlineCause.
+func (f CtxFunc4Pair[R1, R2, P0, P1, P2, P3]) WithDeadlineCause(deadline time.Time, cause error) CtxFunc4Pair[R1, R2, P0, P1, P2, P3] {
+	return func(ctx context.Context, p0 P0, p1 P1, p2 P2, p3 P3) (R1, R2) {
+		ctx, cancel := context.WithDeadlineCause(ctx, deadline, cause)
+		defer cancel()
+		return f(ctx, p0, p1, p2, p3)
+	}
+}
+
+// Curry4 returns a CtxFuncPair[R1, R2] with the first 4 arguments of the CtxFunc4Pair bound.
+func (f CtxFunc4Pair[R1, R2, P0, P1, P2, P3]) Curry4(p0 P0, p1 P1, p2 P2, p3 P3) CtxFuncPair[R1, R2] {
+	return func(ctx context.Context) (R1, R2) {
+		return f(ctx, p0, p1, p2, p3)
+	}
+}
+
+// Curry3 returns a CtxFunc1Pair[R1, R2, P3] with the first 3 arguments of the CtxFunc4Pair bound.
+func (f CtxFunc4Pair[R1, R2, P0, P1, P2, P3]) Curry3(p0 P0, p1 P1, p2 P2) CtxFunc1Pair[R1, R2, P3] {
+	return func(ctx context.Context, p3 P3) (R1, R2) {
+		return f(ctx, p0, p1, p2, p3)
+	}
+}
+
+// Curry2 returns a CtxFunc2Pair[R1, R2, P2, P3] with the first 2 arguments of the CtxFunc4Pair bound.
+func (f CtxFunc4Pair[R1, R2, P0, P1, P2, P3]) Curry2(p0 P0, p1 P1) CtxFunc2Pair[R1, R2, P2, P3] {
+	return func(ctx context.Context, p2 P2, p3 P3) (R1, R2) {
+		return f(ctx, p0, p1, p2, p3)
+	}
+}
+
+// Curry1 returns a CtxFunc3Pair[R1, R2, P1, P2, P3] with the first argument of the CtxFunc4Pair bound.
+func (f CtxFunc4Pair[R1, R2, P0, P1, P2, P3]) Curry1(p0 P0) CtxFunc3Pair[R1, R2, P1, P2, P3] {
+	return func(ctx context.Context, p1 P1, p2 P2, p3 P3) (R1, R2) {
+		return f(ctx, p0, p1, p2, p3)
+	}
+}
@@ -1,3 +1,5 @@
+// Code generated by powerfunc/generator from ctx_func_value.go.tmpl. DO NOT EDIT.
+
 package powerfunc
 
 import (
@@ -13,10 +15,15 @@ func (f CtxFunc4Value[R, P0, P1, P2, P3]) Exec(ctx context.Context, p0 P0, p1 P1
 }
 
 func (f CtxFunc4Value[R, P0, P1, P2, P3]) Timing(loggers ...func(d time.Duration)) CtxFunc4Value[R, P0, P1, P2, P3] {
+	return f.TimingWithClock(RealClock(), loggers...)
+}
+
+// TimingWithClock is like Timing, but measures the execution time with clock.
+func (f CtxFunc4Value[R, P0, P1, P2, P3]) TimingWithClock(clock Clock, loggers ...func(d time.Duration)) CtxFunc4Value[R, P0, P1, P2, P3] {
 	return func(ctx context.Context, p0 P0, p1 P1, p2 P2, p3 P3) R {
-		start := time.Now()
+		start := clock.Now()
 		defer func() {
-			dur := time.Since(start)
+			dur := clock.Since(start)
 			for _, logger := range loggers {
 				logger(dur)
 			}
@@ -60,31 +67,129 @@ func (f CtxFunc4Value[R, P0, P1, P2, P3]) WithCancel() CtxFunc4Value[R, P0, P1,
 	}
 }
 
+// WithValue returns a CtxFunc4Value calling the CtxFunc4Value with a context
+// carrying val for key, see context.WithValue.
+func (f CtxFunc4Value[R, P0, P1, P2, P3]) WithValue(key, val any) CtxFunc4Value[R, P0, P1, P2, P3] {
+	return func(ctx context.Context, p0 P0, p1 P1, p2 P2, p3 P3) R {
+		ctx = context.WithValue(ctx, key, val)
+		return f(ctx, p0, p1, p2, p3)
+	}
+}
+
+// WithValueFrom returns a CtxFunc4Value calling the CtxFunc4Value with a context
+// carrying the value returned by value for its key. value gets the arguments
+// of every call.
+func (f CtxFunc4Value[R, P0, P1, P2, P3]) WithValueFrom(value func(ctx context.Context, p0 P0, p1 P1, p2 P2, p3 P3) (key, val any)) CtxFunc4Value[R, P0, P1, P2, P3] {
+	return func(ctx context.Context, p0 P0, p1 P1, p2 P2, p3 P3) R {
+		key, val := value(ctx, p0, p1, p2, p3)
+		ctx = context.WithValue(ctx, key, val)
+		return f(ctx, p0, p1, p2, p3)
+	}
+}
+
+// Detached returns a CtxFunc4Value calling the CtxFunc4Value with a context that is
+// never cancelled but keeps the values of the caller's context, see
+// context.WithoutCancel. Useful for cleanups that must run to completion.
+func (f CtxFunc4Value[R, P0, P1, P2, P3]) Detached() CtxFunc4Value[R, P0, P1, P2, P3] {
+	return func(ctx context.Context, p0 P0, p1 P1, p2 P2, p3 P3) R {
+		ctx = context.WithoutCancel(ctx)
+		return f(ctx, p0, p1, p2, p3)
+	}
+}
+
+// WithTimeoutCause is like WithTimeout, but the context is cancelled with
+// cause when the timeout expires, see context.WithTimeoutCause.
+func (f CtxFunc4Value[R, P0, P1, P2, P3]) WithTimeoutCause(timeout time.Duration, cause error) CtxFunc4Value[R, P0, P1, P2, P3] {
+	return func(ctx context.Context, p0 P0, p1 P1, p2 P2, p3 P3) R {
+		ctx, cancel := context.WithTimeoutCause(ctx, timeout, cause)
+		defer cancel()
+		return f(ctx, p0, p1, p2, p3)
+	}
+}
+
+// WithDeadlineCause is like WithDeadline, but the context is cancelled with
+// cause when the deadline expires, see context.WithDeadlineCause.
+func (f CtxFunc4Value[R, P0, P1, P2, P3]) WithDeadlineCause(deadline time.Time, cause error) CtxFunc4Value[R, P0, P1, P2, P3] {
+	return func(ctx context.Context, p0 P0, p1 P1, p2 P2, p3 P3) R {
+		ctx, cancel := context.WithDeadlineCause(ctx, deadline, cause)
+		defer cancel()
+		return f(ctx, p0, p1, p2, p3)
+	}
+}
+
+// Once returns a CtxFunc4Value that only executes the first time it is called
+// and returns the cached value afterwards, along with the Once controlling it.
+// The shared execution does not inherit the cancellation of the caller's
+// context. As a CtxFunc4Value cannot report ctx.Err(), every caller waits for
+// the execution to return, even once its own context is done.
+func (f CtxFunc4Value[R, P0, P1, P2, P3]) Once(opts ...OnceOption) (CtxFunc4Value[R, P0, P1, P2, P3], *Once) {
+	o := newOnce(opts...)
+	return func(ctx context.Context, p0 P0, p1 P1, p2 P2, p3 P3) R {
+		v, _ := o.do(context.WithoutCancel(ctx), false, func() (any, error) {
+			ctx := context.WithoutCancel(ctx)
+			return f(ctx, p0, p1, p2, p3), nil
+		})
+		val, _ := v.(R)
+		return val
+	}, o
+}
+
+// Lazy returns a CtxFunc4Value that computes its value the first time it is
+// called and returns the cached value afterwards, along with the Once
+// controlling it.
+// Like Once, every caller waits for the execution to return, even once its
+// own context is done.
+func (f CtxFunc4Value[R, P0, P1, P2, P3]) Lazy(opts ...OnceOption) (CtxFunc4Value[R, P0, P1, P2, P3], *Once) {
+	o := newLazy(opts...)
+	return func(ctx context.Context, p0 P0, p1 P1, p2 P2, p3 P3) R {
+		v, _ := o.do(context.WithoutCancel(ctx), false, func() (any, error) {
+			ctx := context.WithoutCancel(ctx)
+			return f(ctx, p0, p1, p2, p3), nil
+		})
+		val, _ := v.(R)
+		return val
+	}, o
+}
+
+// PollUntil returns a CtxFunc4Result calling the CtxFunc4Value every interval
+// until the returned value satisfies cond.
+// When the context is done or the maximum number of attempts is reached
+// first, it returns the last value and a *PollTimeoutError.
+func (f CtxFunc4Value[R, P0, P1, P2, P3]) PollUntil(cond func(R) bool, interval time.Duration, opts ...PollOption) CtxFunc4Result[R, P0, P1, P2, P3] {
+	return func(ctx context.Context, p0 P0, p1 P1, p2 P2, p3 P3) (R, error) {
+		var v R
+		err := poll(ctx, interval, opts, func() (bool, error) {
+			v = f(ctx, p0, p1, p2, p3)
+			return cond(v), nil
+		})
+		return v, err
+	}
+}
 
+// Curry4 returns a CtxFuncValue[R] with the first 4 arguments of the CtxFunc4Value bound.
 func (f CtxFunc4Value[R, P0, P1, P2, P3]) Curry4(p0 P0, p1 P1, p2 P2, p3 P3) CtxFuncValue[R] {
 	return func(ctx context.Context) R {
 		return f(ctx, p0, p1, p2, p3)
 	}
 }
-	
 
+// Curry3 returns a CtxFunc1Value[R, P3] with the first 3 arguments of the CtxFunc4Value bound.
 func (f CtxFunc4Value[R, P0, P1, P2, P3]) Curry3(p0 P0, p1 P1, p2 P2) CtxFunc1Value[R, P3] {
 	return func(ctx context.Context, p3 P3) R {
 		return f(ctx, p0, p1, p2, p3)
 	}
 }
-	
 
+// Curry2 returns a CtxFunc2Value[R, P2, P3] with the first 2 arguments of the CtxFunc4Value bound.
 func (f CtxFunc4Value[R, P0, P1, P2, P3]) Curry2(p0 P0, p1 P1) CtxFunc2Value[R, P2, P3] {
 	return func(ctx context.Context, p2 P2, p3 P3) R {
 		return f(ctx, p0, p1, p2, p3)
 	}
 }
-	
 
+// Curry1 returns a CtxFunc3Value[R, P1, P2, P3] with the first argument of the CtxFunc4Value bound.
 func (f CtxFunc4Value[R, P0, P1, P2, P3]) Curry1(p0 P0) CtxFunc3Value[R, P1, P2, P3] {
 	return func(ctx context.Context, p1 P1, p2 P2, p3 P3) R {
 		return f(ctx, p0, p1, p2, p3)
 	}
 }
-	
\ No newline at end of file
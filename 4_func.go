@@ -1,13 +1,14 @@
-//go:generate go run ./generator -arity 10
+// Code generated by powerfunc/generator from func.go.tmpl. DO NOT EDIT.
 
 package powerfunc
 
 import (
+	"context"
 	"fmt"
 	"time"
 )
 
-// Func4 is a function that takes 0 arguments and returns no values.
+// Func4 is a function that takes 4 arguments and returns no values.
 type Func4[P0, P1, P2, P3 any] func(p0 P0, p1 P1, p2 P2, p3 P3)
 
 // Exec executes the Function.
@@ -20,10 +21,15 @@ func (f Func4[P0, P1, P2, P3]) Exec(p0 P0, p1 P1, p2 P2, p3 P3) {
 // Timing returns a Func4 that will log the execution time of the Func4.
 // If no loggers are provided, the default logger (fmt.Println) will be used.
 func (f Func4[P0, P1, P2, P3]) Timing(loggers ...func(d time.Duration)) Func4[P0, P1, P2, P3] {
+	return f.TimingWithClock(RealClock(), loggers...)
+}
+
+// TimingWithClock is like Timing, but measures the execution time with clock.
+func (f Func4[P0, P1, P2, P3]) TimingWithClock(clock Clock, loggers ...func(d time.Duration)) Func4[P0, P1, P2, P3] {
 	return func(p0 P0, p1 P1, p2 P2, p3 P3) {
-		start := time.Now()
+		start := clock.Now()
 		defer func() {
-			dur := time.Since(start)
+			dur := clock.Since(start)
 			for _, logger := range loggers {
 				logger(dur)
 			}
@@ -46,31 +52,82 @@ func (f Func4[P0, P1, P2, P3]) Fallible() Func4Error[P0, P1, P2, P3] {
 	}
 }
 
+// Debounce returns a Func4 that delays its execution until wait has elapsed
+// since the last call, along with the Debouncer controlling it.
+// Only the most recent call is kept and eventually invoked.
+func (f Func4[P0, P1, P2, P3]) Debounce(wait time.Duration, opts ...DebounceOption) (Func4[P0, P1, P2, P3], *Debouncer) {
+	d := newDebouncer(wait, opts...)
+	return func(p0 P0, p1 P1, p2 P2, p3 P3) {
+		d.call(func() {
+			f(p0, p1, p2, p3)
+		})
+	}, d
+}
+
+// Throttle returns a Func4 that executes at most once per interval, along
+// with the Debouncer controlling it.
+// The first call is invoked immediately, and the most recent call made
+// during the interval is invoked at the end of it.
+// The options, e.g. DebounceClock, apply to the underlying Debouncer.
+func (f Func4[P0, P1, P2, P3]) Throttle(interval time.Duration, opts ...DebounceOption) (Func4[P0, P1, P2, P3], *Debouncer) {
+	d := newThrottler(interval, opts...)
+	return func(p0 P0, p1 P1, p2 P2, p3 P3) {
+		d.call(func() {
+			f(p0, p1, p2, p3)
+		})
+	}, d
+}
+
+// Once returns a Func4 that only executes the first time it is called,
+// along with the Once controlling it.
+func (f Func4[P0, P1, P2, P3]) Once(opts ...OnceOption) (Func4[P0, P1, P2, P3], *Once) {
+	o := newOnce(opts...)
+	return func(p0 P0, p1 P1, p2 P2, p3 P3) {
+		_, _ = o.do(context.Background(), false, func() (any, error) {
+			f(p0, p1, p2, p3)
+			return nil, nil
+		})
+	}, o
+}
+
+// Lazy returns a Func4 that executes the first time it is called,
+// along with the Once controlling it.
+// A Func4 cannot fail, so Lazy behaves like Once unless it panics, in which
+// case the next call executes it again.
+func (f Func4[P0, P1, P2, P3]) Lazy(opts ...OnceOption) (Func4[P0, P1, P2, P3], *Once) {
+	o := newLazy(opts...)
+	return func(p0 P0, p1 P1, p2 P2, p3 P3) {
+		_, _ = o.do(context.Background(), false, func() (any, error) {
+			f(p0, p1, p2, p3)
+			return nil, nil
+		})
+	}, o
+}
 
+// Curry4 returns a Func with the first 4 arguments of the Func4 bound.
 func (f Func4[P0, P1, P2, P3]) Curry4(p0 P0, p1 P1, p2 P2, p3 P3) Func {
-	return func()  {
+	return func() {
 		f(p0, p1, p2, p3)
 	}
 }
-	
 
+// Curry3 returns a Func1[P3] with the first 3 arguments of the Func4 bound.
 func (f Func4[P0, P1, P2, P3]) Curry3(p0 P0, p1 P1, p2 P2) Func1[P3] {
-	return func(p3 P3)  {
+	return func(p3 P3) {
 		f(p0, p1, p2, p3)
 	}
 }
-	
 
+// Curry2 returns a Func2[P2, P3] with the first 2 arguments of the Func4 bound.
 func (f Func4[P0, P1, P2, P3]) Curry2(p0 P0, p1 P1) Func2[P2, P3] {
-	return func(p2 P2, p3 P3)  {
+	return func(p2 P2, p3 P3) {
 		f(p0, p1, p2, p3)
 	}
 }
-	
 
+// Curry1 returns a Func3[P1, P2, P3] with the first argument of the Func4 bound.
 func (f Func4[P0, P1, P2, P3]) Curry1(p0 P0) Func3[P1, P2, P3] {
-	return func(p1 P1, p2 P2, p3 P3)  {
+	return func(p1 P1, p2 P2, p3 P3) {
 		f(p0, p1, p2, p3)
 	}
 }
-	
\ No newline at end of file
@@ -1,11 +1,14 @@
+// Code generated by powerfunc/generator from func_error.go.tmpl. DO NOT EDIT.
+
 package powerfunc
 
 import (
+	"context"
 	"fmt"
 	"time"
 )
 
-// Func4Error is a function that takes 0 arguments and returns an error.
+// Func4Error is a function that takes 4 arguments and returns an error.
 type Func4Error[P0, P1, P2, P3 any] func(p0 P0, p1 P1, p2 P2, p3 P3) error
 
 // Exec executes the function and returns the error.
@@ -15,13 +18,18 @@ func (f Func4Error[P0, P1, P2, P3]) Exec(p0 P0, p1 P1, p2 P2, p3 P3) error {
 	return f(p0, p1, p2, p3)
 }
 
-// Timing returns a Func4 that will log the execution time of the Func4.
+// Timing returns a Func4Error that will log the execution time of the Func4Error.
 // If no loggers are provided, the default logger (fmt.Println) will be used.
 func (f Func4Error[P0, P1, P2, P3]) Timing(loggers ...func(d time.Duration)) Func4Error[P0, P1, P2, P3] {
+	return f.TimingWithClock(RealClock(), loggers...)
+}
+
+// TimingWithClock is like Timing, but measures the execution time with clock.
+func (f Func4Error[P0, P1, P2, P3]) TimingWithClock(clock Clock, loggers ...func(d time.Duration)) Func4Error[P0, P1, P2, P3] {
 	return func(p0 P0, p1 P1, p2 P2, p3 P3) error {
-		start := time.Now()
+		start := clock.Now()
 		defer func() {
-			dur := time.Since(start)
+			dur := clock.Since(start)
 			for _, logger := range loggers {
 				logger(dur)
 			}
@@ -34,20 +42,15 @@ func (f Func4Error[P0, P1, P2, P3]) Timing(loggers ...func(d time.Duration)) Fun
 	}
 }
 
-// Retry returns a Function that will retry the Function until it returns
-// a nil error or the tryAgain function returns false.
-func (f Func4Error[P0, P1, P2, P3]) Retry(tryAgain func(attempts int, err error) bool) Func4Error[P0, P1, P2, P3] {
+// Retry returns a Func4Error that will retry the Func4Error until it returns
+// a nil error or the tryAgain function returns false, in which case it
+// returns a *RetryError holding the errors of all the attempts.
+// The options set the backoff between attempts and hooks, e.g. OnRetry.
+func (f Func4Error[P0, P1, P2, P3]) Retry(tryAgain func(attempts int, err error) bool, opts ...RetryOption) Func4Error[P0, P1, P2, P3] {
 	return func(p0 P0, p1 P1, p2 P2, p3 P3) error {
-		var err error
-		attempts := 1
-		for {
-			err = f(p0, p1, p2, p3)
-			if err == nil || !tryAgain(attempts, err) {
-				break
-			}
-			attempts++
-		}
-		return err
+		return retryNoContext(tryAgain, opts, func() error {
+			return f(p0, p1, p2, p3)
+		})
 	}
 }
 
@@ -72,31 +75,89 @@ func (f Func4Error[P0, P1, P2, P3]) OnErr(msg string) Func4Error[P0, P1, P2, P3]
 	}
 }
 
+// Once returns a Func4Error that only executes the first time it is called
+// and returns the cached error afterwards, along with the Once controlling it.
+// Use OnceRetryOnError or OnceBackoff to only cache a success.
+func (f Func4Error[P0, P1, P2, P3]) Once(opts ...OnceOption) (Func4Error[P0, P1, P2, P3], *Once) {
+	o := newOnce(opts...)
+	return func(p0 P0, p1 P1, p2 P2, p3 P3) error {
+		_, err := o.do(context.Background(), false, func() (any, error) {
+			return nil, f(p0, p1, p2, p3)
+		})
+		return err
+	}, o
+}
+
+// Lazy returns a Func4Error that executes until it succeeds once, along
+// with the Once controlling it.
+// Failures are not cached, unless a backoff is provided with OnceBackoff.
+func (f Func4Error[P0, P1, P2, P3]) Lazy(opts ...OnceOption) (Func4Error[P0, P1, P2, P3], *Once) {
+	o := newLazy(opts...)
+	return func(p0 P0, p1 P1, p2 P2, p3 P3) error {
+		_, err := o.do(context.Background(), false, func() (any, error) {
+			return nil, f(p0, p1, p2, p3)
+		})
+		return err
+	}, o
+}
+
+// InjectFaults returns a Func4Error injecting the errors, panics and latency
+// configured by cfg in its calls, to test how the callers cope with them.
+// See FaultConfig, and DisableFaults for the global kill-switch.
+// The faults cannot be enabled per request with OptIn, as there is no
+// context.
+func (f Func4Error[P0, P1, P2, P3]) InjectFaults(cfg FaultConfig) Func4Error[P0, P1, P2, P3] {
+	fi := newFaultInjector(cfg)
+	return func(p0 P0, p1 P1, p2 P2, p3 P3) error {
+		if err := fi.inject(context.Background(), p0, p1, p2, p3); err != nil {
+			return err
+		}
+		return f(p0, p1, p2, p3)
+	}
+}
+
+// WithTimeout returns a Func4Error that runs the Func4Error in its own
+// goroutine and returns a *TimeoutError, matching ErrTimeout, if it does not
+// return within timeout. The goroutine is then abandoned: it keeps running
+// until the Func4Error returns, and is counted by Abandoned.
+// Prefer the WithTimeout method of a function taking a context, which can
+// actually be cancelled.
+func (f Func4Error[P0, P1, P2, P3]) WithTimeout(timeout time.Duration, opts ...TimeoutOption) Func4Error[P0, P1, P2, P3] {
+	return func(p0 P0, p1 P1, p2 P2, p3 P3) error {
+		var err error
+		if terr := callWithTimeout(timeout, opts, func() {
+			err = f(p0, p1, p2, p3)
+		}); terr != nil {
+			return terr
+		}
+		return err
+	}
+}
 
+// Curry4 returns a FuncError with the first 4 arguments of the Func4Error bound.
 func (f Func4Error[P0, P1, P2, P3]) Curry4(p0 P0, p1 P1, p2 P2, p3 P3) FuncError {
 	return func() error {
 		return f(p0, p1, p2, p3)
 	}
 }
-	
 
+// Curry3 returns a Func1Error[P3] with the first 3 arguments of the Func4Error bound.
 func (f Func4Error[P0, P1, P2, P3]) Curry3(p0 P0, p1 P1, p2 P2) Func1Error[P3] {
 	return func(p3 P3) error {
 		return f(p0, p1, p2, p3)
 	}
 }
-	
 
+// Curry2 returns a Func2Error[P2, P3] with the first 2 arguments of the Func4Error bound.
 func (f Func4Error[P0, P1, P2, P3]) Curry2(p0 P0, p1 P1) Func2Error[P2, P3] {
 	return func(p2 P2, p3 P3) error {
 		return f(p0, p1, p2, p3)
 	}
 }
-	
 
+// Curry1 returns a Func3Error[P1, P2, P3] with the first argument of the Func4Error bound.
 func (f Func4Error[P0, P1, P2, P3]) Curry1(p0 P0) Func3Error[P1, P2, P3] {
 	return func(p1 P1, p2 P2, p3 P3) error {
 		return f(p0, p1, p2, p3)
 	}
 }
-	
\ No newline at end of file
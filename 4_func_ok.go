@@ -0,0 +1,169 @@
+// Code generated by powerfunc/generator from func_ok.go.tmpl. DO NOT EDIT.
+
+package powerfunc
+
+import (
+	"fmt"
+	"time"
+)
+
+// Func4Ok is a function that takes 4 arguments and returns a value and a
+// boolean reporting whether the value is valid, like a map lookup.
+type Func4Ok[R, P0, P1, P2, P3 any] func(p0 P0, p1 P1, p2 P2, p3 P3) (R, bool)
+
+// Exec executes the function.
+// Convenience method to remove some of the confusion that
+// `f.SomeMethod()()` can bring.
+func (f Func4Ok[R, P0, P1, P2, P3]) Exec(p0 P0, p1 P1, p2 P2, p3 P3) (R, bool) {
+	return f(p0, p1, p2, p3)
+}
+
+// Timing returns a Func4Ok that will log the execution time of the Func4Ok.
+// If no loggers are provided, the default logger (fmt.Println) will be used.
+func (f Func4Ok[R, P0, P1, P2, P3]) Timing(loggers ...func(d time.Duration)) Func4Ok[R, P0, P1, P2, P3] {
+	return f.TimingWithClock(RealClock(), loggers...)
+}
+
+// TimingWithClock is like Timing, but measures the execution time with clock.
+func (f Func4Ok[R, P0, P1, P2, P3]) TimingWithClock(clock Clock, loggers ...func(d time.Duration)) Func4Ok[R, P0, P1, P2, P3] {
+	return func(p0 P0, p1 P1, p2 P2, p3 P3) (R, bool) {
+		start := clock.Now()
+		defer func() {
+			dur := clock.Since(start)
+			for _, logger := range loggers {
+				logger(dur)
+			}
+			if len(loggers) == 0 {
+				// Default logger
+				fmt.Println(dur)
+			}
+		}()
+		return f(p0, p1, p2, p3)
+	}
+}
+
+// Retry returns a Func4Ok that will retry the Func4Ok until it returns
+// true or the tryAgain function returns false.
+// The attempts that returned false fail with a placeholder error, given to
+// tryAgain and to the hooks of the options, e.g. OnRetry, so that any
+// RetryPolicy can be used.
+func (f Func4Ok[R, P0, P1, P2, P3]) Retry(tryAgain RetryPolicy, opts ...RetryOption) Func4Ok[R, P0, P1, P2, P3] {
+	return func(p0 P0, p1 P1, p2 P2, p3 P3) (R, bool) {
+		var v R
+		var ok bool
+		err := retryNoContext(tryAgain, opts, func() error {
+			v, ok = f(p0, p1, p2, p3)
+			if !ok {
+				return errNotOk
+			}
+			return nil
+		})
+		return v, err == nil
+	}
+}
+
+// Must returns a Func4Value that will panic if the Func4Ok returns false.
+func (f Func4Ok[R, P0, P1, P2, P3]) Must() Func4Value[R, P0, P1, P2, P3] {
+	return func(p0 P0, p1 P1, p2 P2, p3 P3) R {
+		v, ok := f(p0, p1, p2, p3)
+		if !ok {
+			panic("powerfunc: Func4Ok returned false")
+		}
+		return v
+	}
+}
+
+// Fallback returns a Func4Value that will return the provided value if the
+// Func4Ok returns false.
+func (f Func4Ok[R, P0, P1, P2, P3]) Fallback(val R) Func4Value[R, P0, P1, P2, P3] {
+	return func(p0 P0, p1 P1, p2 P2, p3 P3) R {
+		v, ok := f(p0, p1, p2, p3)
+		if !ok {
+			return val
+		}
+		return v
+	}
+}
+
+// OrElse returns a Func4Value that will return the provided value if the
+// Func4Ok returns false. It is an alias of Fallback.
+func (f Func4Ok[R, P0, P1, P2, P3]) OrElse(val R) Func4Value[R, P0, P1, P2, P3] {
+	return f.Fallback(val)
+}
+
+// OrElseGet returns a Func4Value that will call elsef if the Func4Ok
+// returns false.
+func (f Func4Ok[R, P0, P1, P2, P3]) OrElseGet(elsef Func4Value[R, P0, P1, P2, P3]) Func4Value[R, P0, P1, P2, P3] {
+	return func(p0 P0, p1 P1, p2 P2, p3 P3) R {
+		v, ok := f(p0, p1, p2, p3)
+		if !ok {
+			return elsef(p0, p1, p2, p3)
+		}
+		return v
+	}
+}
+
+// ToResult transforms a Func4Ok into a Func4Result returning the provided
+// error when the Func4Ok returns false.
+func (f Func4Ok[R, P0, P1, P2, P3]) ToResult(err error) Func4Result[R, P0, P1, P2, P3] {
+	return func(p0 P0, p1 P1, p2 P2, p3 P3) (R, error) {
+		v, ok := f(p0, p1, p2, p3)
+		if !ok {
+			return v, err
+		}
+		return v, nil
+	}
+}
+
+// Filter returns a Func4Ok that will return false if the value returned by
+// the Func4Ok does not satisfy keep.
+func (f Func4Ok[R, P0, P1, P2, P3]) Filter(keep func(R) bool) Func4Ok[R, P0, P1, P2, P3] {
+	return func(p0 P0, p1 P1, p2 P2, p3 P3) (R, bool) {
+		v, ok := f(p0, p1, p2, p3)
+		if !ok || !keep(v) {
+			var zero R
+			return zero, false
+		}
+		return v, true
+	}
+}
+
+// Map applies the provided function to the value returned by the Func4Ok,
+// if it returns true.
+func (f Func4Ok[R, P0, P1, P2, P3]) Map(fn func(R) R) Func4Ok[R, P0, P1, P2, P3] {
+	return func(p0 P0, p1 P1, p2 P2, p3 P3) (R, bool) {
+		v, ok := f(p0, p1, p2, p3)
+		if !ok {
+			return v, false
+		}
+		return fn(v), true
+	}
+}
+
+// Curry4 returns a FuncOk[R] with the first 4 arguments of the Func4Ok bound.
+func (f Func4Ok[R, P0, P1, P2, P3]) Curry4(p0 P0, p1 P1, p2 P2, p3 P3) FuncOk[R] {
+	return func() (R, bool) {
+		return f(p0, p1, p2, p3)
+	}
+}
+
+// Curry3 returns a Func1Ok[R, P3] with the first 3 arguments of the Func4Ok bound.
+func (f Func4Ok[R, P0, P1, P2, P3]) Curry3(p0 P0, p1 P1, p2 P2) Func1Ok[R, P3] {
+	return func(p3 P3) (R, bool) {
+		return f(p0, p1, p2, p3)
+	}
+}
+
+// Curry2 returns a Func2Ok[R, P2, P3] with the first 2 arguments of the Func4Ok bound.
+func (f Func4Ok[R, P0, P1, P2, P3]) Curry2(p0 P0, p1 P1) Func2Ok[R, P2, P3] {
+	return func(p2 P2, p3 P3) (R, bool) {
+		return f(p0, p1, p2, p3)
+	}
+}
+
+// Curry1 returns a Func3Ok[R, P1, P2, P3] with the first argument of the Func4Ok bound.
+func (f Func4Ok[R, P0, P1, P2, P3]) Curry1(p0 P0) Func3Ok[R, P1, P2, P3] {
+	return func(p1 P1, p2 P2, p3 P3) (R, bool) {
+		return f(p0, p1, p2, p3)
+	}
+}
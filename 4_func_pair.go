@@ -0,0 +1,80 @@
+// Code generated by powerfunc/generator from func_pair.go.tmpl. DO NOT EDIT.
+
+package powerfunc
+
+import (
+	"fmt"
+	"time"
+)
+
+// Func4Pair is a function that takes 4 arguments and returns two values.
+type Func4Pair[R1, R2, P0, P1, P2, P3 any] func(p0 P0, p1 P1, p2 P2, p3 P3) (R1, R2)
+
+// Exec executes the function.
+// Convenience method to remove some of the confusion that
+// `f.SomeMethod()()` can bring.
+func (f Func4Pair[R1, R2, P0, P1, P2, P3]) Exec(p0 P0, p1 P1, p2 P2, p3 P3) (R1, R2) {
+	return f(p0, p1, p2, p3)
+}
+
+// Timing returns a Func4Pair that will log the execution time of the Func4Pair.
+// If no loggers are provided, the default logger (fmt.Println) will be used.
+func (f Func4Pair[R1, R2, P0, P1, P2, P3]) Timing(loggers ...func(d time.Duration)) Func4Pair[R1, R2, P0, P1, P2, P3] {
+	return f.TimingWithClock(RealClock(), loggers...)
+}
+
+// TimingWithClock is like Timing, but measures the execution time with clock.
+func (f Func4Pair[R1, R2, P0, P1, P2, P3]) TimingWithClock(clock Clock, loggers ...func(d time.Duration)) Func4Pair[R1, R2, P0, P1, P2, P3] {
+	return func(p0 P0, p1 P1, p2 P2, p3 P3) (R1, R2) {
+		start := clock.Now()
+		defer func() {
+			dur := clock.Since(start)
+			for _, logger := range loggers {
+				logger(dur)
+			}
+			if len(loggers) == 0 {
+				// Default logger
+				fmt.Println(dur)
+			}
+		}()
+		return f(p0, p1, p2, p3)
+	}
+}
+
+// Fallible transforms a Func4Pair into a Func4PairResult.
+// The returned Func4PairResult will never return an error.
+// Useful when passing a Func4Pair to a function that expects a Func4PairResult.
+func (f Func4Pair[R1, R2, P0, P1, P2, P3]) Fallible() Func4PairResult[R1, R2, P0, P1, P2, P3] {
+	return func(p0 P0, p1 P1, p2 P2, p3 P3) (R1, R2, error) {
+		v1, v2 := f(p0, p1, p2, p3)
+		return v1, v2, nil
+	}
+}
+
+// Curry4 returns a FuncPair[R1, R2] with the first 4 arguments of the Func4Pair bound.
+func (f Func4Pair[R1, R2, P0, P1, P2, P3]) Curry4(p0 P0, p1 P1, p2 P2, p3 P3) FuncPair[R1, R2] {
+	return func() (R1, R2) {
+		return f(p0, p1, p2, p3)
+	}
+}
+
+// Curry3 returns a Func1Pair[R1, R2, P3] with the first 3 arguments of the Func4Pair bound.
+func (f Func4Pair[R1, R2, P0, P1, P2, P3]) Curry3(p0 P0, p1 P1, p2 P2) Func1Pair[R1, R2, P3] {
+	return func(p3 P3) (R1, R2) {
+		return f(p0, p1, p2, p3)
+	}
+}
+
+// Curry2 returns a Func2Pair[R1, R2, P2, P3] with the first 2 arguments of the Func4Pair bound.
+func (f Func4Pair[R1, R2, P0, P1, P2, P3]) Curry2(p0 P0, p1 P1) Func2Pair[R1, R2, P2, P3] {
+	return func(p2 P2, p3 P3) (R1, R2) {
+		return f(p0, p1, p2, p3)
+	}
+}
+
+// Curry1 returns a Func3Pair[R1, R2, P1, P2, P3] with the first argument of the Func4Pair bound.
+func (f Func4Pair[R1, R2, P0, P1, P2, P3]) Curry1(p0 P0) Func3Pair[R1, R2, P1, P2, P3] {
+	return func(p1 P1, p2 P2, p3 P3) (R1, R2) {
+		return f(p0, p1, p2, p3)
+	}
+}
@@ -1,28 +1,36 @@
+// Code generated by powerfunc/generator from func_result.go.tmpl. DO NOT EDIT.
+
 package powerfunc
 
 import (
+	"context"
 	"fmt"
 	"time"
 )
 
-// Func4Result is a function that takes 0 arguments and returns a
+// Func4Result is a function that takes 4 arguments and returns a
 // value and an error.
-type Func4Result[T, P0, P1, P2, P3 any] func(p0 P0, p1 P1, p2 P2, p3 P3) (T, error)
+type Func4Result[R, P0, P1, P2, P3 any] func(p0 P0, p1 P1, p2 P2, p3 P3) (R, error)
 
 // Exec executes the function.
 // Convenience method to remove some of the confusion that
 // `f.SomeMethod()()` can bring.
-func (f Func4Result[T, P0, P1, P2, P3]) Exec(p0 P0, p1 P1, p2 P2, p3 P3) (T, error) {
+func (f Func4Result[R, P0, P1, P2, P3]) Exec(p0 P0, p1 P1, p2 P2, p3 P3) (R, error) {
 	return f(p0, p1, p2, p3)
 }
 
-// Timing returns a Func4 that will log the execution time of the Func4.
+// Timing returns a Func4Result that will log the execution time of the Func4Result.
 // If no loggers are provided, the default logger (fmt.Println) will be used.
-func (f Func4Result[T, P0, P1, P2, P3]) Timing(loggers ...func(d time.Duration)) Func4Result[T, P0, P1, P2, P3] {
-	return func(p0 P0, p1 P1, p2 P2, p3 P3) (T, error) {
-		start := time.Now()
+func (f Func4Result[R, P0, P1, P2, P3]) Timing(loggers ...func(d time.Duration)) Func4Result[R, P0, P1, P2, P3] {
+	return f.TimingWithClock(RealClock(), loggers...)
+}
+
+// TimingWithClock is like Timing, but measures the execution time with clock.
+func (f Func4Result[R, P0, P1, P2, P3]) TimingWithClock(clock Clock, loggers ...func(d time.Duration)) Func4Result[R, P0, P1, P2, P3] {
+	return func(p0 P0, p1 P1, p2 P2, p3 P3) (R, error) {
+		start := clock.Now()
 		defer func() {
-			dur := time.Since(start)
+			dur := clock.Since(start)
 			for _, logger := range loggers {
 				logger(dur)
 			}
@@ -35,27 +43,25 @@ func (f Func4Result[T, P0, P1, P2, P3]) Timing(loggers ...func(d time.Duration))
 	}
 }
 
-// Retry returns a Function that will retry the Function until it returns
-// a nil error or the tryAgain function returns false.
-func (f Func4Result[T, P0, P1, P2, P3]) Retry(tryAgain func(attempts int, err error) bool) Func4Result[T, P0, P1, P2, P3] {
-	return func(p0 P0, p1 P1, p2 P2, p3 P3) (T, error) {
-		var v T
-		var err error
-		attempts := 1
-		for {
-			v, err = f(p0, p1, p2, p3)
-			if err == nil || !tryAgain(attempts, err) {
-				break
-			}
-			attempts++
-		}
-		return v, err
+// Retry returns a Func4Result that will retry the Func4Result until it returns
+// a nil error or the tryAgain function returns false, in which case it
+// returns a *RetryError holding the errors of all the attempts.
+// The options set the backoff between attempts and hooks, e.g. OnRetry.
+func (f Func4Result[R, P0, P1, P2, P3]) Retry(tryAgain func(attempts int, err error) bool, opts ...RetryOption) Func4Result[R, P0, P1, P2, P3] {
+	return func(p0 P0, p1 P1, p2 P2, p3 P3) (R, error) {
+		var r0 R
+		err := retryNoContext(tryAgain, opts, func() error {
+			var err error
+			r0, err = f(p0, p1, p2, p3)
+			return err
+		})
+		return r0, err
 	}
 }
 
 // Must returns a Func4Value that will panic if the Func4Result returns an error.
-func (f Func4Result[T, P0, P1, P2, P3]) Must() Func4Value[T, P0, P1, P2, P3] {
-	return func(p0 P0, p1 P1, p2 P2, p3 P3) T {
+func (f Func4Result[R, P0, P1, P2, P3]) Must() Func4Value[R, P0, P1, P2, P3] {
+	return func(p0 P0, p1 P1, p2 P2, p3 P3) R {
 		v, err := f(p0, p1, p2, p3)
 		if err != nil {
 			panic(err)
@@ -66,8 +72,8 @@ func (f Func4Result[T, P0, P1, P2, P3]) Must() Func4Value[T, P0, P1, P2, P3] {
 
 // OnErr returns a Func4Result that will wrap the error returned by the Func4Result
 // with the provided message.
-func (f Func4Result[T, P0, P1, P2, P3]) OnErr(msg string) Func4Result[T, P0, P1, P2, P3] {
-	return func(p0 P0, p1 P1, p2 P2, p3 P3) (T, error) {
+func (f Func4Result[R, P0, P1, P2, P3]) OnErr(msg string) Func4Result[R, P0, P1, P2, P3] {
+	return func(p0 P0, p1 P1, p2 P2, p3 P3) (R, error) {
 		v, err := f(p0, p1, p2, p3)
 		if err != nil {
 			return v, fmt.Errorf("%s: %w", msg, err)
@@ -78,8 +84,8 @@ func (f Func4Result[T, P0, P1, P2, P3]) OnErr(msg string) Func4Result[T, P0, P1,
 
 // Map applies the provided function to the value returned by the Func4Result,
 // if there is no error.
-func (f Func4Result[T, P0, P1, P2, P3]) Map(fn func(T) T) Func4Result[T, P0, P1, P2, P3] {
-	return func(p0 P0, p1 P1, p2 P2, p3 P3) (T, error) {
+func (f Func4Result[R, P0, P1, P2, P3]) Map(fn func(R) R) Func4Result[R, P0, P1, P2, P3] {
+	return func(p0 P0, p1 P1, p2 P2, p3 P3) (R, error) {
 		v, err := f(p0, p1, p2, p3)
 		if err != nil {
 			return v, err
@@ -90,8 +96,8 @@ func (f Func4Result[T, P0, P1, P2, P3]) Map(fn func(T) T) Func4Result[T, P0, P1,
 
 // MapErr applies the provided function to the error returned by the Func4Result,
 // if there is an error.
-func (f Func4Result[T, P0, P1, P2, P3]) MapErr(fn func(error) error) Func4Result[T, P0, P1, P2, P3] {
-	return func(p0 P0, p1 P1, p2 P2, p3 P3) (T, error) {
+func (f Func4Result[R, P0, P1, P2, P3]) MapErr(fn func(error) error) Func4Result[R, P0, P1, P2, P3] {
+	return func(p0 P0, p1 P1, p2 P2, p3 P3) (R, error) {
 		v, err := f(p0, p1, p2, p3)
 		if err != nil {
 			return v, fn(err)
@@ -102,8 +108,8 @@ func (f Func4Result[T, P0, P1, P2, P3]) MapErr(fn func(error) error) Func4Result
 
 // Fallback returns a Func4Value that will return the provided value if the
 // Func4Result returns an error.
-func (f Func4Result[T, P0, P1, P2, P3]) Fallback(val T) Func4Value[T, P0, P1, P2, P3] {
-	return func(p0 P0, p1 P1, p2 P2, p3 P3) T {
+func (f Func4Result[R, P0, P1, P2, P3]) Fallback(val R) Func4Value[R, P0, P1, P2, P3] {
+	return func(p0 P0, p1 P1, p2 P2, p3 P3) R {
 		v, err := f(p0, p1, p2, p3)
 		if err != nil {
 			return val
@@ -112,31 +118,94 @@ func (f Func4Result[T, P0, P1, P2, P3]) Fallback(val T) Func4Value[T, P0, P1, P2
 	}
 }
 
+// Once returns a Func4Result that only executes the first time it is called
+// and returns the cached result afterwards, along with the Once controlling it.
+// Use OnceRetryOnError or OnceBackoff to only cache a success.
+func (f Func4Result[R, P0, P1, P2, P3]) Once(opts ...OnceOption) (Func4Result[R, P0, P1, P2, P3], *Once) {
+	o := newOnce(opts...)
+	return func(p0 P0, p1 P1, p2 P2, p3 P3) (R, error) {
+		v, err := o.do(context.Background(), false, func() (any, error) {
+			return f(p0, p1, p2, p3)
+		})
+		val, _ := v.(R)
+		return val, err
+	}, o
+}
+
+// Lazy returns a Func4Result that executes until it succeeds once and
+// returns the cached value afterwards, along with the Once controlling it.
+// Failures are not cached, unless a backoff is provided with OnceBackoff.
+func (f Func4Result[R, P0, P1, P2, P3]) Lazy(opts ...OnceOption) (Func4Result[R, P0, P1, P2, P3], *Once) {
+	o := newLazy(opts...)
+	return func(p0 P0, p1 P1, p2 P2, p3 P3) (R, error) {
+		v, err := o.do(context.Background(), false, func() (any, error) {
+			return f(p0, p1, p2, p3)
+		})
+		val, _ := v.(R)
+		return val, err
+	}, o
+}
+
+// InjectFaults returns a Func4Result injecting the errors, panics and latency
+// configured by cfg in its calls, to test how the callers cope with them.
+// See FaultConfig, and DisableFaults for the global kill-switch.
+// The faults cannot be enabled per request with OptIn, as there is no
+// context.
+func (f Func4Result[R, P0, P1, P2, P3]) InjectFaults(cfg FaultConfig) Func4Result[R, P0, P1, P2, P3] {
+	fi := newFaultInjector(cfg)
+	return func(p0 P0, p1 P1, p2 P2, p3 P3) (R, error) {
+		if err := fi.inject(context.Background(), p0, p1, p2, p3); err != nil {
+			var r0 R
+			return r0, err
+		}
+		return f(p0, p1, p2, p3)
+	}
+}
+
+// WithTimeout returns a Func4Result that runs the Func4Result in its own
+// goroutine and returns a *TimeoutError, matching ErrTimeout, if it does not
+// return within timeout. The goroutine is then abandoned: it keeps running
+// until the Func4Result returns, and is counted by Abandoned.
+// Prefer the WithTimeout method of a function taking a context, which can
+// actually be cancelled.
+func (f Func4Result[R, P0, P1, P2, P3]) WithTimeout(timeout time.Duration, opts ...TimeoutOption) Func4Result[R, P0, P1, P2, P3] {
+	return func(p0 P0, p1 P1, p2 P2, p3 P3) (R, error) {
+		var r0 R
+		var err error
+		if terr := callWithTimeout(timeout, opts, func() {
+			r0, err = f(p0, p1, p2, p3)
+		}); terr != nil {
+			var zero R
+			return zero, terr
+		}
+		return r0, err
+	}
+}
 
+// Curry4 returns a FuncResult[R] with the first 4 arguments of the Func4Result bound.
 func (f Func4Result[R, P0, P1, P2, P3]) Curry4(p0 P0, p1 P1, p2 P2, p3 P3) FuncResult[R] {
 	return func() (R, error) {
 		return f(p0, p1, p2, p3)
 	}
 }
-	
 
+// Curry3 returns a Func1Result[R, P3] with the first 3 arguments of the Func4Result bound.
 func (f Func4Result[R, P0, P1, P2, P3]) Curry3(p0 P0, p1 P1, p2 P2) Func1Result[R, P3] {
 	return func(p3 P3) (R, error) {
 		return f(p0, p1, p2, p3)
 	}
 }
-	
 
+// Curry2 returns a Func2Result[R, P2, P3] with the first 2 arguments of the Func4Result bound.
 func (f Func4Result[R, P0, P1, P2, P3]) Curry2(p0 P0, p1 P1) Func2Result[R, P2, P3] {
 	return func(p2 P2, p3 P3) (R, error) {
 		return f(p0, p1, p2, p3)
 	}
 }
-	
 
+// Curry1 returns a Func3Result[R, P1, P2, P3] with the first argument of the Func4Result bound.
 func (f Func4Result[R, P0, P1, P2, P3]) Curry1(p0 P0) Func3Result[R, P1, P2, P3] {
 	return func(p1 P1, p2 P2, p3 P3) (R, error) {
 		return f(p0, p1, p2, p3)
 	}
 }
-	
\ No newline at end of file
@@ -1,27 +1,35 @@
+// Code generated by powerfunc/generator from func_value.go.tmpl. DO NOT EDIT.
+
 package powerfunc
 
 import (
+	"context"
 	"fmt"
 	"time"
 )
 
-// Func4 is a function that takes 0 arguments and returns a value.
-type Func4Value[T, P0, P1, P2, P3 any] func(p0 P0, p1 P1, p2 P2, p3 P3) T
+// Func4Value is a function that takes 4 arguments and returns a value.
+type Func4Value[R, P0, P1, P2, P3 any] func(p0 P0, p1 P1, p2 P2, p3 P3) R
 
 // Exec executes the function.
 // Convenience method to remove some of the confusion that
 // `f.SomeMethod()()` can bring.
-func (f Func4Value[T, P0, P1, P2, P3]) Exec(p0 P0, p1 P1, p2 P2, p3 P3) T {
+func (f Func4Value[R, P0, P1, P2, P3]) Exec(p0 P0, p1 P1, p2 P2, p3 P3) R {
 	return f(p0, p1, p2, p3)
 }
 
-// Timing returns a Func4 that will log the execution time of the Func4.
+// Timing returns a Func4Value that will log the execution time of the Func4Value.
 // If no loggers are provided, the default logger (fmt.Println) will be used.
-func (f Func4Value[T, P0, P1, P2, P3]) Timing(loggers ...func(d time.Duration)) Func4Value[T, P0, P1, P2, P3] {
-	return func(p0 P0, p1 P1, p2 P2, p3 P3) T {
-		start := time.Now()
+func (f Func4Value[R, P0, P1, P2, P3]) Timing(loggers ...func(d time.Duration)) Func4Value[R, P0, P1, P2, P3] {
+	return f.TimingWithClock(RealClock(), loggers...)
+}
+
+// TimingWithClock is like Timing, but measures the execution time with clock.
+func (f Func4Value[R, P0, P1, P2, P3]) TimingWithClock(clock Clock, loggers ...func(d time.Duration)) Func4Value[R, P0, P1, P2, P3] {
+	return func(p0 P0, p1 P1, p2 P2, p3 P3) R {
+		start := clock.Now()
 		defer func() {
-			dur := time.Since(start)
+			dur := clock.Since(start)
 			for _, logger := range loggers {
 				logger(dur)
 			}
@@ -37,37 +45,82 @@ func (f Func4Value[T, P0, P1, P2, P3]) Timing(loggers ...func(d time.Duration))
 // Fallible transforms a Func4Value into a Func4Result.
 // The returned Func4Result will never return an error.
 // Useful when passing a Func4Value to a function that expects a Func4Result.
-func (f Func4Value[T, P0, P1, P2, P3]) Fallible() Func4Result[T, P0, P1, P2, P3] {
-	return func(p0 P0, p1 P1, p2 P2, p3 P3) (T, error) {
+func (f Func4Value[R, P0, P1, P2, P3]) Fallible() Func4Result[R, P0, P1, P2, P3] {
+	return func(p0 P0, p1 P1, p2 P2, p3 P3) (R, error) {
 		return f(p0, p1, p2, p3), nil
 	}
 }
 
+// Once returns a Func4Value that only executes the first time it is called
+// and returns the cached value afterwards, along with the Once controlling it.
+func (f Func4Value[R, P0, P1, P2, P3]) Once(opts ...OnceOption) (Func4Value[R, P0, P1, P2, P3], *Once) {
+	o := newOnce(opts...)
+	return func(p0 P0, p1 P1, p2 P2, p3 P3) R {
+		v, _ := o.do(context.Background(), false, func() (any, error) {
+			return f(p0, p1, p2, p3), nil
+		})
+		val, _ := v.(R)
+		return val
+	}, o
+}
+
+// Lazy returns a Func4Value that computes its value the first time it is
+// called and returns the cached value afterwards, along with the Once
+// controlling it.
+func (f Func4Value[R, P0, P1, P2, P3]) Lazy(opts ...OnceOption) (Func4Value[R, P0, P1, P2, P3], *Once) {
+	o := newLazy(opts...)
+	return func(p0 P0, p1 P1, p2 P2, p3 P3) R {
+		v, _ := o.do(context.Background(), false, func() (any, error) {
+			return f(p0, p1, p2, p3), nil
+		})
+		val, _ := v.(R)
+		return val
+	}, o
+}
+
+// WithTimeout returns a Func4Result that runs the Func4Value in its own
+// goroutine and returns a *TimeoutError, matching ErrTimeout, if it does not
+// return within timeout. The goroutine is then abandoned: it keeps running
+// until the Func4Value returns, and is counted by Abandoned.
+// Prefer the WithTimeout method of a function taking a context, which can
+// actually be cancelled.
+func (f Func4Value[R, P0, P1, P2, P3]) WithTimeout(timeout time.Duration, opts ...TimeoutOption) Func4Result[R, P0, P1, P2, P3] {
+	return func(p0 P0, p1 P1, p2 P2, p3 P3) (R, error) {
+		var r0 R
+		if terr := callWithTimeout(timeout, opts, func() {
+			r0 = f(p0, p1, p2, p3)
+		}); terr != nil {
+			var zero R
+			return zero, terr
+		}
+		return r0, nil
+	}
+}
 
+// Curry4 returns a FuncValue[R] with the first 4 arguments of the Func4Value bound.
 func (f Func4Value[R, P0, P1, P2, P3]) Curry4(p0 P0, p1 P1, p2 P2, p3 P3) FuncValue[R] {
 	return func() R {
 		return f(p0, p1, p2, p3)
 	}
 }
-	
 
+// Curry3 returns a Func1Value[R, P3] with the first 3 arguments of the Func4Value bound.
 func (f Func4Value[R, P0, P1, P2, P3]) Curry3(p0 P0, p1 P1, p2 P2) Func1Value[R, P3] {
 	return func(p3 P3) R {
 		return f(p0, p1, p2, p3)
 	}
 }
-	
 
+// Curry2 returns a Func2Value[R, P2, P3] with the first 2 arguments of the Func4Value bound.
 func (f Func4Value[R, P0, P1, P2, P3]) Curry2(p0 P0, p1 P1) Func2Value[R, P2, P3] {
 	return func(p2 P2, p3 P3) R {
 		return f(p0, p1, p2, p3)
 	}
 }
-	
 
+// Curry1 returns a Func3Value[R, P1, P2, P3] with the first argument of the Func4Value bound.
 func (f Func4Value[R, P0, P1, P2, P3]) Curry1(p0 P0) Func3Value[R, P1, P2, P3] {
 	return func(p1 P1, p2 P2, p3 P3) R {
 		return f(p0, p1, p2, p3)
 	}
 }
-	
\ No newline at end of file
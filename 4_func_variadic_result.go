@@ -0,0 +1,146 @@
+// Code generated by powerfunc/generator from func_variadic_result.go.tmpl. DO NOT EDIT.
+
+package powerfunc
+
+import (
+	"context"
+	"fmt"
+	"time"
+)
+
+// Func4VariadicResult is a function that takes a variable number of
+// arguments and returns a value and an error.
+type Func4VariadicResult[R, V, P0, P1, P2, P3 any] func(p0 P0, p1 P1, p2 P2, p3 P3, xs ...V) (R, error)
+
+// Exec executes the function.
+// Convenience method to remove some of the confusion that
+// `f.SomeMethod()()` can bring.
+func (f Func4VariadicResult[R, V, P0, P1, P2, P3]) Exec(p0 P0, p1 P1, p2 P2, p3 P3, xs ...V) (R, error) {
+	return f(p0, p1, p2, p3, xs...)
+}
+
+// Timing returns a Func4VariadicResult that will log the execution time of the Func4VariadicResult.
+// If no loggers are provided, the default logger (fmt.Println) will be used.
+func (f Func4VariadicResult[R, V, P0, P1, P2, P3]) Timing(loggers ...func(d time.Duration)) Func4VariadicResult[R, V, P0, P1, P2, P3] {
+	return f.TimingWithClock(RealClock(), loggers...)
+}
+
+// TimingWithClock is like Timing, but measures the execution time with clock.
+func (f Func4VariadicResult[R, V, P0, P1, P2, P3]) TimingWithClock(clock Clock, loggers ...func(d time.Duration)) Func4VariadicResult[R, V, P0, P1, P2, P3] {
+	return func(p0 P0, p1 P1, p2 P2, p3 P3, xs ...V) (R, error) {
+		start := clock.Now()
+		defer func() {
+			dur := clock.Since(start)
+			for _, logger := range loggers {
+				logger(dur)
+			}
+			if len(loggers) == 0 {
+				// Default logger
+				fmt.Println(dur)
+			}
+		}()
+		return f(p0, p1, p2, p3, xs...)
+	}
+}
+
+// Retry returns a Func4VariadicResult that will retry the Func4VariadicResult until it returns
+// a nil error or the tryAgain function returns false, in which case it
+// returns a *RetryError holding the errors of all the attempts.
+// The options set the backoff between attempts and hooks, e.g. OnRetry.
+func (f Func4VariadicResult[R, V, P0, P1, P2, P3]) Retry(tryAgain func(attempts int, err error) bool, opts ...RetryOption) Func4VariadicResult[R, V, P0, P1, P2, P3] {
+	return func(p0 P0, p1 P1, p2 P2, p3 P3, xs ...V) (R, error) {
+		var r0 R
+		err := retryNoContext(tryAgain, opts, func() error {
+			var err error
+			r0, err = f(p0, p1, p2, p3, xs...)
+			return err
+		})
+		return r0, err
+	}
+}
+
+// OnErr returns a Func4VariadicResult that will wrap the error returned by
+// the Func4VariadicResult with the provided message.
+func (f Func4VariadicResult[R, V, P0, P1, P2, P3]) OnErr(msg string) Func4VariadicResult[R, V, P0, P1, P2, P3] {
+	return func(p0 P0, p1 P1, p2 P2, p3 P3, xs ...V) (R, error) {
+		v, err := f(p0, p1, p2, p3, xs...)
+		if err != nil {
+			return v, fmt.Errorf("%s: %w", msg, err)
+		}
+		return v, nil
+	}
+}
+
+// WithTimeout returns a Func4VariadicResult that runs the Func4VariadicResult in its own
+// goroutine and returns a *TimeoutError, matching ErrTimeout, if it does not
+// return within timeout. The goroutine is then abandoned: it keeps running
+// until the Func4VariadicResult returns, and is counted by Abandoned.
+// Prefer the WithTimeout method of a function taking a context, which can
+// actually be cancelled.
+func (f Func4VariadicResult[R, V, P0, P1, P2, P3]) WithTimeout(timeout time.Duration, opts ...TimeoutOption) Func4VariadicResult[R, V, P0, P1, P2, P3] {
+	return func(p0 P0, p1 P1, p2 P2, p3 P3, xs ...V) (R, error) {
+		var r0 R
+		var err error
+		if terr := callWithTimeout(timeout, opts, func() {
+			r0, err = f(p0, p1, p2, p3, xs...)
+		}); terr != nil {
+			var zero R
+			return zero, terr
+		}
+		return r0, err
+	}
+}
+
+// CurryVariadic returns a Func4VariadicResult with some of its variadic
+// arguments bound. The bound arguments come before the ones passed to the
+// returned function.
+func (f Func4VariadicResult[R, V, P0, P1, P2, P3]) CurryVariadic(bound ...V) Func4VariadicResult[R, V, P0, P1, P2, P3] {
+	return func(p0 P0, p1 P1, p2 P2, p3 P3, xs ...V) (R, error) {
+		xs = append(append(make([]V, 0, len(bound)+len(xs)), bound...), xs...)
+		return f(p0, p1, p2, p3, xs...)
+	}
+}
+
+// InjectFaults returns a Func4VariadicResult injecting the errors, panics and latency
+// configured by cfg in its calls, to test how the callers cope with them.
+// See FaultConfig, and DisableFaults for the global kill-switch.
+// The faults cannot be enabled per request with OptIn, as there is no
+// context.
+func (f Func4VariadicResult[R, V, P0, P1, P2, P3]) InjectFaults(cfg FaultConfig) Func4VariadicResult[R, V, P0, P1, P2, P3] {
+	fi := newFaultInjector(cfg)
+	return func(p0 P0, p1 P1, p2 P2, p3 P3, xs ...V) (R, error) {
+		if err := fi.inject(context.Background(), p0, p1, p2, p3, xs); err != nil {
+			var r0 R
+			return r0, err
+		}
+		return f(p0, p1, p2, p3, xs...)
+	}
+}
+
+// Curry4 returns a FuncVariadicResult[R, V] with the first 4 arguments of the Func4VariadicResult bound.
+func (f Func4VariadicResult[R, V, P0, P1, P2, P3]) Curry4(p0 P0, p1 P1, p2 P2, p3 P3) FuncVariadicResult[R, V] {
+	return func(xs ...V) (R, error) {
+		return f(p0, p1, p2, p3, xs...)
+	}
+}
+
+// Curry3 returns a Func1VariadicResult[R, V, P3] with the first 3 arguments of the Func4VariadicResult bound.
+func (f Func4VariadicResult[R, V, P0, P1, P2, P3]) Curry3(p0 P0, p1 P1, p2 P2) Func1VariadicResult[R, V, P3] {
+	return func(p3 P3, xs ...V) (R, error) {
+		return f(p0, p1, p2, p3, xs...)
+	}
+}
+
+// Curry2 returns a Func2VariadicResult[R, V, P2, P3] with the first 2 arguments of the Func4VariadicResult bound.
+func (f Func4VariadicResult[R, V, P0, P1, P2, P3]) Curry2(p0 P0, p1 P1) Func2VariadicResult[R, V, P2, P3] {
+	return func(p2 P2, p3 P3, xs ...V) (R, error) {
+		return f(p0, p1, p2, p3, xs...)
+	}
+}
+
+// Curry1 returns a Func3VariadicResult[R, V, P1, P2, P3] with the first argument of the Func4VariadicResult bound.
+func (f Func4VariadicResult[R, V, P0, P1, P2, P3]) Curry1(p0 P0) Func3VariadicResult[R, V, P1, P2, P3] {
+	return func(p1 P1, p2 P2, p3 P3, xs ...V) (R, error) {
+		return f(p0, p1, p2, p3, xs...)
+	}
+}
@@ -1,3 +1,5 @@
+// Code generated by powerfunc/generator from ctx_func.go.tmpl. DO NOT EDIT.
+
 package powerfunc
 
 import (
@@ -13,10 +15,15 @@ func (f CtxFunc5[P0, P1, P2, P3, P4]) Exec(ctx context.Context, p0 P0, p1 P1, p2
 }
 
 func (f CtxFunc5[P0, P1, P2, P3, P4]) Timing(loggers ...func(d time.Duration)) CtxFunc5[P0, P1, P2, P3, P4] {
+	return f.TimingWithClock(RealClock(), loggers...)
+}
+
+// TimingWithClock is like Timing, but measures the execution time with clock.
+func (f CtxFunc5[P0, P1, P2, P3, P4]) TimingWithClock(clock Clock, loggers ...func(d time.Duration)) CtxFunc5[P0, P1, P2, P3, P4] {
 	return func(ctx context.Context, p0 P0, p1 P1, p2 P2, p3 P3, p4 P4) {
-		start := time.Now()
+		start := clock.Now()
 		defer func() {
-			dur := time.Since(start)
+			dur := clock.Since(start)
 			for _, logger := range loggers {
 				logger(dur)
 			}
@@ -60,38 +67,150 @@ func (f CtxFunc5[P0, P1, P2, P3, P4]) WithCancel() CtxFunc5[P0, P1, P2, P3, P4]
 	}
 }
 
+// WithValue returns a CtxFunc5 calling the CtxFunc5 with a context
+// carrying val for key, see context.WithValue.
+func (f CtxFunc5[P0, P1, P2, P3, P4]) WithValue(key, val any) CtxFunc5[P0, P1, P2, P3, P4] {
+	return func(ctx context.Context, p0 P0, p1 P1, p2 P2, p3 P3, p4 P4) {
+		ctx = context.WithValue(ctx, key, val)
+		f(ctx, p0, p1, p2, p3, p4)
+	}
+}
+
+// WithValueFrom returns a CtxFunc5 calling the CtxFunc5 with a context
+// carrying the value returned by value for its key. value gets the arguments
+// of every call.
+func (f CtxFunc5[P0, P1, P2, P3, P4]) WithValueFrom(value func(ctx context.Context, p0 P0, p1 P1, p2 P2, p3 P3, p4 P4) (key, val any)) CtxFunc5[P0, P1, P2, P3, P4] {
+	return func(ctx context.Context, p0 P0, p1 P1, p2 P2, p3 P3, p4 P4) {
+		key, val := value(ctx, p0, p1, p2, p3, p4)
+		ctx = context.WithValue(ctx, key, val)
+		f(ctx, p0, p1, p2, p3, p4)
+	}
+}
+
+// Detached returns a CtxFunc5 calling the CtxFunc5 with a context that is
+// never cancelled but keeps the values of the caller's context, see
+// context.WithoutCancel. Useful for cleanups that must run to completion.
+func (f CtxFunc5[P0, P1, P2, P3, P4]) Detached() CtxFunc5[P0, P1, P2, P3, P4] {
+	return func(ctx context.Context, p0 P0, p1 P1, p2 P2, p3 P3, p4 P4) {
+		ctx = context.WithoutCancel(ctx)
+		f(ctx, p0, p1, p2, p3, p4)
+	}
+}
+
+// WithTimeoutCause is like WithTimeout, but the context is cancelled with
+// cause when the timeout expires, see context.WithTimeoutCause.
+func (f CtxFunc5[P0, P1, P2, P3, P4]) WithTimeoutCause(timeout time.Duration, cause error) CtxFunc5[P0, P1, P2, P3, P4] {
+	return func(ctx context.Context, p0 P0, p1 P1, p2 P2, p3 P3, p4 P4) {
+		ctx, cancel := context.WithTimeoutCause(ctx, timeout, cause)
+		defer cancel()
+		f(ctx, p0, p1, p2, p3, p4)
+	}
+}
+
+// WithDeadlineCause is like WithDeadline, but the context is cancelled with
+// cause when the deadline expires, see context.WithDeadlineCause.
+func (f CtxFunc5[P0, P1, P2, P3, P4]) WithDeadlineCause(deadline time.Time, cause error) CtxFunc5[P0, P1, P2, P3, P4] {
+	return func(ctx context.Context, p0 P0, p1 P1, p2 P2, p3 P3, p4 P4) {
+		ctx, cancel := context.WithDeadlineCause(ctx, deadline, cause)
+		defer cancel()
+		f(ctx, p0, p1, p2, p3, p4)
+	}
+}
+
+// Debounce returns a CtxFunc5 that delays its execution until wait has elapsed
+// since the last call, along with the Debouncer controlling it.
+// Only the most recent call, and its context, is kept and eventually invoked.
+func (f CtxFunc5[P0, P1, P2, P3, P4]) Debounce(wait time.Duration, opts ...DebounceOption) (CtxFunc5[P0, P1, P2, P3, P4], *Debouncer) {
+	d := newDebouncer(wait, opts...)
+	return func(ctx context.Context, p0 P0, p1 P1, p2 P2, p3 P3, p4 P4) {
+		d.call(func() {
+			f(ctx, p0, p1, p2, p3, p4)
+		})
+	}, d
+}
+
+// Throttle returns a CtxFunc5 that executes at most once per interval, along
+// with the Debouncer controlling it.
+// The first call is invoked immediately, and the most recent call made
+// during the interval is invoked at the end of it.
+// The options, e.g. DebounceClock, apply to the underlying Debouncer.
+func (f CtxFunc5[P0, P1, P2, P3, P4]) Throttle(interval time.Duration, opts ...DebounceOption) (CtxFunc5[P0, P1, P2, P3, P4], *Debouncer) {
+	d := newThrottler(interval, opts...)
+	return func(ctx context.Context, p0 P0, p1 P1, p2 P2, p3 P3, p4 P4) {
+		d.call(func() {
+			f(ctx, p0, p1, p2, p3, p4)
+		})
+	}, d
+}
+
+// Once returns a CtxFunc5 that only executes the first time it is called,
+// along with the Once controlling it.
+// The shared execution does not inherit the cancellation of the caller's
+// context: a caller whose context is done returns without waiting for it,
+// while the execution carries on for the other callers.
+func (f CtxFunc5[P0, P1, P2, P3, P4]) Once(opts ...OnceOption) (CtxFunc5[P0, P1, P2, P3, P4], *Once) {
+	o := newOnce(opts...)
+	return func(ctx context.Context, p0 P0, p1 P1, p2 P2, p3 P3, p4 P4) {
+		_, _ = o.do(ctx, true, func() (any, error) {
+			ctx := context.WithoutCancel(ctx)
+			f(ctx, p0, p1, p2, p3, p4)
+			return nil, nil
+		})
+	}, o
+}
+
+// Lazy returns a CtxFunc5 that executes the first time it is called,
+// along with the Once controlling it.
+// A CtxFunc5 cannot fail, so Lazy behaves like Once unless it panics, in which
+// case the next call executes it again.
+func (f CtxFunc5[P0, P1, P2, P3, P4]) Lazy(opts ...OnceOption) (CtxFunc5[P0, P1, P2, P3, P4], *Once) {
+	o := newLazy(opts...)
+	return func(ctx context.Context, p0 P0, p1 P1, p2 P2, p3 P3, p4 P4) {
+		_, _ = o.do(ctx, true, func() (any, error) {
+			ctx := context.WithoutCancel(ctx)
+			f(ctx, p0, p1, p2, p3, p4)
+			return nil, nil
+		})
+	}, o
+}
+
+// Every returns a runner that calls the CtxFunc5 every interval until its
+// context is done. See CtxFunc5Error.Every for the available options.
+func (f CtxFunc5[P0, P1, P2, P3, P4]) Every(interval time.Duration, opts ...EveryOption) CtxFunc5Error[P0, P1, P2, P3, P4] {
+	return f.Fallible().Every(interval, opts...)
+}
 
+// Curry5 returns a CtxFunc with the first 5 arguments of the CtxFunc5 bound.
 func (f CtxFunc5[P0, P1, P2, P3, P4]) Curry5(p0 P0, p1 P1, p2 P2, p3 P3, p4 P4) CtxFunc {
-	return func(ctx context.Context)  {
+	return func(ctx context.Context) {
 		f(ctx, p0, p1, p2, p3, p4)
 	}
 }
-	
 
+// Curry4 returns a CtxFunc1[P4] with the first 4 arguments of the CtxFunc5 bound.
 func (f CtxFunc5[P0, P1, P2, P3, P4]) Curry4(p0 P0, p1 P1, p2 P2, p3 P3) CtxFunc1[P4] {
-	return func(ctx context.Context, p4 P4)  {
+	return func(ctx context.Context, p4 P4) {
 		f(ctx, p0, p1, p2, p3, p4)
 	}
 }
-	
 
+// Curry3 returns a CtxFunc2[P3, P4] with the first 3 arguments of the CtxFunc5 bound.
 func (f CtxFunc5[P0, P1, P2, P3, P4]) Curry3(p0 P0, p1 P1, p2 P2) CtxFunc2[P3, P4] {
-	return func(ctx context.Context, p3 P3, p4 P4)  {
+	return func(ctx context.Context, p3 P3, p4 P4) {
 		f(ctx, p0, p1, p2, p3, p4)
 	}
 }
-	
 
+// Curry2 returns a CtxFunc3[P2, P3, P4] with the first 2 arguments of the CtxFunc5 bound.
 func (f CtxFunc5[P0, P1, P2, P3, P4]) Curry2(p0 P0, p1 P1) CtxFunc3[P2, P3, P4] {
-	return func(ctx context.Context, p2 P2, p3 P3, p4 P4)  {
+	return func(ctx context.Context, p2 P2, p3 P3, p4 P4) {
 		f(ctx, p0, p1, p2, p3, p4)
 	}
 }
-	
 
+// Curry1 returns a CtxFunc4[P1, P2, P3, P4] with the first argument of the CtxFunc5 bound.
 func (f CtxFunc5[P0, P1, P2, P3, P4]) Curry1(p0 P0) CtxFunc4[P1, P2, P3, P4] {
-	return func(ctx context.Context, p1 P1, p2 P2, p3 P3, p4 P4)  {
+	return func(ctx context.Context, p1 P1, p2 P2, p3 P3, p4 P4) {
 		f(ctx, p0, p1, p2, p3, p4)
 	}
 }
-	
\ No newline at end of file
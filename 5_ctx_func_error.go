@@ -1,3 +1,5 @@
+// Code generated by powerfunc/generator from ctx_func_error.go.tmpl. DO NOT EDIT.
+
 package powerfunc
 
 import (
@@ -13,10 +15,15 @@ func (f CtxFunc5Error[P0, P1, P2, P3, P4]) Exec(ctx context.Context, p0 P0, p1 P
 }
 
 func (f CtxFunc5Error[P0, P1, P2, P3, P4]) Timing(loggers ...func(d time.Duration)) CtxFunc5Error[P0, P1, P2, P3, P4] {
+	return f.TimingWithClock(RealClock(), loggers...)
+}
+
+// TimingWithClock is like Timing, but measures the execution time with clock.
+func (f CtxFunc5Error[P0, P1, P2, P3, P4]) TimingWithClock(clock Clock, loggers ...func(d time.Duration)) CtxFunc5Error[P0, P1, P2, P3, P4] {
 	return func(ctx context.Context, p0 P0, p1 P1, p2 P2, p3 P3, p4 P4) error {
-		start := time.Now()
+		start := clock.Now()
 		defer func() {
-			dur := time.Since(start)
+			dur := clock.Since(start)
 			for _, logger := range loggers {
 				logger(dur)
 			}
@@ -53,24 +60,98 @@ func (f CtxFunc5Error[P0, P1, P2, P3, P4]) WithCancel() CtxFunc5Error[P0, P1, P2
 	}
 }
 
-// Retry returns a Function that will retry the Function until it returns
-// a nil error or the tryAgain function returns false.
-func (f CtxFunc5Error[P0, P1, P2, P3, P4]) Retry(tryAgain func(attempts int, err error) bool) CtxFunc5Error[P0, P1, P2, P3, P4] {
+// WithValue returns a CtxFunc5Error calling the CtxFunc5Error with a context
+// carrying val for key, see context.WithValue.
+func (f CtxFunc5Error[P0, P1, P2, P3, P4]) WithValue(key, val any) CtxFunc5Error[P0, P1, P2, P3, P4] {
 	return func(ctx context.Context, p0 P0, p1 P1, p2 P2, p3 P3, p4 P4) error {
-		var err error
-		attempts := 1
-		for {
-			err = f(ctx, p0, p1, p2, p3, p4)
-			if err != nil && tryAgain(attempts, err) {
-				break
-			}
-			attempts++
+		ctx = context.WithValue(ctx, key, val)
+		return f(ctx, p0, p1, p2, p3, p4)
+	}
+}
+
+// WithValueFrom returns a CtxFunc5Error calling the CtxFunc5Error with a context
+// carrying the value returned by value for its key. value gets the arguments
+// of every call.
+func (f CtxFunc5Error[P0, P1, P2, P3, P4]) WithValueFrom(value func(ctx context.Context, p0 P0, p1 P1, p2 P2, p3 P3, p4 P4) (key, val any)) CtxFunc5Error[P0, P1, P2, P3, P4] {
+	return func(ctx context.Context, p0 P0, p1 P1, p2 P2, p3 P3, p4 P4) error {
+		key, val := value(ctx, p0, p1, p2, p3, p4)
+		ctx = context.WithValue(ctx, key, val)
+		return f(ctx, p0, p1, p2, p3, p4)
+	}
+}
+
+// Detached returns a CtxFunc5Error calling the CtxFunc5Error with a context that is
+// never cancelled but keeps the values of the caller's context, see
+// context.WithoutCancel. Useful for cleanups that must run to completion.
+func (f CtxFunc5Error[P0, P1, P2, P3, P4]) Detached() CtxFunc5Error[P0, P1, P2, P3, P4] {
+	return func(ctx context.Context, p0 P0, p1 P1, p2 P2, p3 P3, p4 P4) error {
+		ctx = context.WithoutCancel(ctx)
+		return f(ctx, p0, p1, p2, p3, p4)
+	}
+}
+
+// WithTimeoutCause is like WithTimeout, but the context is cancelled with
+// cause when the timeout expires, see context.WithTimeoutCause.
+// When the CtxFunc5Error then fails with the error of the context, the returned
+// error wraps cause as well, to tell which timeout expired.
+func (f CtxFunc5Error[P0, P1, P2, P3, P4]) WithTimeoutCause(timeout time.Duration, cause error) CtxFunc5Error[P0, P1, P2, P3, P4] {
+	return func(ctx context.Context, p0 P0, p1 P1, p2 P2, p3 P3, p4 P4) error {
+		ctx, cancel := context.WithTimeoutCause(ctx, timeout, cause)
+		defer cancel()
+		err := f(ctx, p0, p1, p2, p3, p4)
+		return withCause(ctx, err)
+	}
+}
+
+// WithDeadlineCause is like WithDeadline, but the context is cancelled with
+// cause when the deadline expires, see context.WithDeadlineCause.
+// When the CtxFunc5Error then fails with the error of the context, the returned
+// error wraps cause as well, to tell which deadline expired.
+func (f CtxFunc5Error[P0, P1, P2, P3, P4]) WithDeadlineCause(deadline time.Time, cause error) CtxFunc5Error[P0, P1, P2, P3, P4] {
+	return func(ctx context.Context, p0 P0, p1 P1, p2 P2, p3 P3, p4 P4) error {
+		ctx, cancel := context.WithDeadlineCause(ctx, deadline, cause)
+		defer cancel()
+		err := f(ctx, p0, p1, p2, p3, p4)
+		return withCause(ctx, err)
+	}
+}
+
+// WithBudget returns a CtxFunc5Error giving the CtxFunc5Error a fraction of the
+// remaining deadline of the caller, capped at max when max is positive,
+// instead of a fixed timeout. Without a deadline, the CtxFunc5Error gets max, or
+// no timeout if max is not positive.
+// When the share is below min, the CtxFunc5Error is not called and a
+// *BudgetError, matching ErrBudgetExhausted, is returned.
+func (f CtxFunc5Error[P0, P1, P2, P3, P4]) WithBudget(fraction float64, min, max time.Duration) CtxFunc5Error[P0, P1, P2, P3, P4] {
+	return func(ctx context.Context, p0 P0, p1 P1, p2 P2, p3 P3, p4 P4) error {
+		timeout, bounded, err := budget(ctx, fraction, min, max)
+		if err != nil {
+			return err
 		}
-		return err
+		if bounded {
+			var cancel context.CancelFunc
+			ctx, cancel = context.WithTimeout(ctx, timeout)
+			defer cancel()
+		}
+		return f(ctx, p0, p1, p2, p3, p4)
 	}
 }
 
-// Must returns a Func5Value that will panic if the CtxFunc5Result returns an error.
+// Retry returns a CtxFunc5Error that will retry the CtxFunc5Error until it returns
+// a nil error or the tryAgain function returns false, in which case it
+// returns a *RetryError holding the errors of all the attempts.
+// The options set the backoff between attempts and hooks, e.g. OnRetry.
+// RetryAttemptTimeout gives every attempt its own deadline within the one of
+// the caller.
+func (f CtxFunc5Error[P0, P1, P2, P3, P4]) Retry(tryAgain func(attempts int, err error) bool, opts ...RetryOption) CtxFunc5Error[P0, P1, P2, P3, P4] {
+	return func(ctx context.Context, p0 P0, p1 P1, p2 P2, p3 P3, p4 P4) error {
+		return retry(ctx, tryAgain, opts, func(ctx context.Context) error {
+			return f(ctx, p0, p1, p2, p3, p4)
+		})
+	}
+}
+
+// Must returns a CtxFunc5 that will panic if the CtxFunc5Error returns an error.
 func (f CtxFunc5Error[P0, P1, P2, P3, P4]) Must() CtxFunc5[P0, P1, P2, P3, P4] {
 	return func(ctx context.Context, p0 P0, p1 P1, p2 P2, p3 P3, p4 P4) {
 		err := f(ctx, p0, p1, p2, p3, p4)
@@ -80,7 +161,7 @@ func (f CtxFunc5Error[P0, P1, P2, P3, P4]) Must() CtxFunc5[P0, P1, P2, P3, P4] {
 	}
 }
 
-// OnErr returns a CtxFunc5Result that will wrap the error returned by the CtxFunc5Result
+// OnErr returns a CtxFunc5Error that will wrap the error returned by the CtxFunc5Error
 // with the provided message.
 func (f CtxFunc5Error[P0, P1, P2, P3, P4]) OnErr(msg string) CtxFunc5Error[P0, P1, P2, P3, P4] {
 	return func(ctx context.Context, p0 P0, p1 P1, p2 P2, p3 P3, p4 P4) error {
@@ -92,38 +173,95 @@ func (f CtxFunc5Error[P0, P1, P2, P3, P4]) OnErr(msg string) CtxFunc5Error[P0, P
 	}
 }
 
+// Once returns a CtxFunc5Error that only executes the first time it is called
+// and returns the cached error afterwards, along with the Once controlling it.
+// Use OnceRetryOnError or OnceBackoff to only cache a success.
+// The shared execution does not inherit the cancellation of the caller's
+// context: a caller whose context is done stops waiting and gets ctx.Err(),
+// while the execution carries on for the other callers.
+func (f CtxFunc5Error[P0, P1, P2, P3, P4]) Once(opts ...OnceOption) (CtxFunc5Error[P0, P1, P2, P3, P4], *Once) {
+	o := newOnce(opts...)
+	return func(ctx context.Context, p0 P0, p1 P1, p2 P2, p3 P3, p4 P4) error {
+		_, err := o.do(ctx, true, func() (any, error) {
+			ctx := context.WithoutCancel(ctx)
+			return nil, f(ctx, p0, p1, p2, p3, p4)
+		})
+		return err
+	}, o
+}
+
+// Lazy returns a CtxFunc5Error that executes until it succeeds once, along
+// with the Once controlling it.
+// Failures are not cached, unless a backoff is provided with OnceBackoff.
+func (f CtxFunc5Error[P0, P1, P2, P3, P4]) Lazy(opts ...OnceOption) (CtxFunc5Error[P0, P1, P2, P3, P4], *Once) {
+	o := newLazy(opts...)
+	return func(ctx context.Context, p0 P0, p1 P1, p2 P2, p3 P3, p4 P4) error {
+		_, err := o.do(ctx, true, func() (any, error) {
+			ctx := context.WithoutCancel(ctx)
+			return nil, f(ctx, p0, p1, p2, p3, p4)
+		})
+		return err
+	}, o
+}
+
+// Every returns a runner that calls the CtxFunc5Error every interval until
+// its context is done, in which case the runner returns nil.
+// The runner only returns an error when EveryMaxConsecutiveErrors is
+// provided and reached.
+// Every panics if interval is not positive, like time.NewTicker.
+func (f CtxFunc5Error[P0, P1, P2, P3, P4]) Every(interval time.Duration, opts ...EveryOption) CtxFunc5Error[P0, P1, P2, P3, P4] {
+	checkEveryInterval(interval)
+	return func(ctx context.Context, p0 P0, p1 P1, p2 P2, p3 P3, p4 P4) error {
+		return every(ctx, interval, opts, func() error {
+			return f(ctx, p0, p1, p2, p3, p4)
+		})
+	}
+}
+
+// InjectFaults returns a CtxFunc5Error injecting the errors, panics and latency
+// configured by cfg in its calls, to test how the callers cope with them.
+// See FaultConfig, and DisableFaults for the global kill-switch.
+func (f CtxFunc5Error[P0, P1, P2, P3, P4]) InjectFaults(cfg FaultConfig) CtxFunc5Error[P0, P1, P2, P3, P4] {
+	fi := newFaultInjector(cfg)
+	return func(ctx context.Context, p0 P0, p1 P1, p2 P2, p3 P3, p4 P4) error {
+		if err := fi.inject(ctx, p0, p1, p2, p3, p4); err != nil {
+			return err
+		}
+		return f(ctx, p0, p1, p2, p3, p4)
+	}
+}
 
+// Curry5 returns a CtxFuncError with the first 5 arguments of the CtxFunc5Error bound.
 func (f CtxFunc5Error[P0, P1, P2, P3, P4]) Curry5(p0 P0, p1 P1, p2 P2, p3 P3, p4 P4) CtxFuncError {
 	return func(ctx context.Context) error {
 		return f(ctx, p0, p1, p2, p3, p4)
 	}
 }
-	
 
+// Curry4 returns a CtxFunc1Error[P4] with the first 4 arguments of the CtxFunc5Error bound.
 func (f CtxFunc5Error[P0, P1, P2, P3, P4]) Curry4(p0 P0, p1 P1, p2 P2, p3 P3) CtxFunc1Error[P4] {
 	return func(ctx context.Context, p4 P4) error {
 		return f(ctx, p0, p1, p2, p3, p4)
 	}
 }
-	
 
+// Curry3 returns a CtxFunc2Error[P3, P4] with the first 3 arguments of the CtxFunc5Error bound.
 func (f CtxFunc5Error[P0, P1, P2, P3, P4]) Curry3(p0 P0, p1 P1, p2 P2) CtxFunc2Error[P3, P4] {
 	return func(ctx context.Context, p3 P3, p4 P4) error {
 		return f(ctx, p0, p1, p2, p3, p4)
 	}
 }
-	
 
+// Curry2 returns a CtxFunc3Error[P2, P3, P4] with the first 2 arguments of the CtxFunc5Error bound.
 func (f CtxFunc5Error[P0, P1, P2, P3, P4]) Curry2(p0 P0, p1 P1) CtxFunc3Error[P2, P3, P4] {
 	return func(ctx context.Context, p2 P2, p3 P3, p4 P4) error {
 		return f(ctx, p0, p1, p2, p3, p4)
 	}
 }
-	
 
+// Curry1 returns a CtxFunc4Error[P1, P2, P3, P4] with the first argument of the CtxFunc5Error bound.
 func (f CtxFunc5Error[P0, P1, P2, P3, P4]) Curry1(p0 P0) CtxFunc4Error[P1, P2, P3, P4] {
 	return func(ctx context.Context, p1 P1, p2 P2, p3 P3, p4 P4) error {
 		return f(ctx, p0, p1, p2, p3, p4)
 	}
 }
-	
\ No newline at end of file
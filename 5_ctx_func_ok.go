@@ -0,0 +1,246 @@
+// Code generated by powerfunc/generator from ctx_func_ok.go.tmpl. DO NOT EDIT.
+
+package powerfunc
+
+import (
+	"context"
+	"fmt"
+	"time"
+)
+
+type CtxFunc5Ok[R, P0, P1, P2, P3, P4 any] func(ctx context.Context, p0 P0, p1 P1, p2 P2, p3 P3, p4 P4) (R, bool)
+
+func (f CtxFunc5Ok[R, P0, P1, P2, P3, P4]) Exec(ctx context.Context, p0 P0, p1 P1, p2 P2, p3 P3, p4 P4) (R, bool) {
+	return f(ctx, p0, p1, p2, p3, p4)
+}
+
+func (f CtxFunc5Ok[R, P0, P1, P2, P3, P4]) Timing(loggers ...func(d time.Duration)) CtxFunc5Ok[R, P0, P1, P2, P3, P4] {
+	return f.TimingWithClock(RealClock(), loggers...)
+}
+
+// TimingWithClock is like Timing, but measures the execution time with clock.
+func (f CtxFunc5Ok[R, P0, P1, P2, P3, P4]) TimingWithClock(clock Clock, loggers ...func(d time.Duration)) CtxFunc5Ok[R, P0, P1, P2, P3, P4] {
+	return func(ctx context.Context, p0 P0, p1 P1, p2 P2, p3 P3, p4 P4) (R, bool) {
+		start := clock.Now()
+		defer func() {
+			dur := clock.Since(start)
+			for _, logger := range loggers {
+				logger(dur)
+			}
+			if len(loggers) == 0 {
+				// Default logger
+				fmt.Println(dur)
+			}
+		}()
+		return f(ctx, p0, p1, p2, p3, p4)
+	}
+}
+
+func (f CtxFunc5Ok[R, P0, P1, P2, P3, P4]) WithTimeout(timeout time.Duration) CtxFunc5Ok[R, P0, P1, P2, P3, P4] {
+	return func(ctx context.Context, p0 P0, p1 P1, p2 P2, p3 P3, p4 P4) (R, bool) {
+		ctx, cancel := context.WithTimeout(ctx, timeout)
+		defer cancel()
+		return f(ctx, p0, p1, p2, p3, p4)
+	}
+}
+
+func (f CtxFunc5Ok[R, P0, P1, P2, P3, P4]) WithDeadline(deadline time.Time) CtxFunc5Ok[R, P0, P1, P2, P3, P4] {
+	return func(ctx context.Context, p0 P0, p1 P1, p2 P2, p3 P3, p4 P4) (R, bool) {
+		ctx, cancel := context.WithDeadline(ctx, deadline)
+		defer cancel()
+		return f(ctx, p0, p1, p2, p3, p4)
+	}
+}
+
+func (f CtxFunc5Ok[R, P0, P1, P2, P3, P4]) WithCancel() CtxFunc5Ok[R, P0, P1, P2, P3, P4] {
+	return func(ctx context.Context, p0 P0, p1 P1, p2 P2, p3 P3, p4 P4) (R, bool) {
+		ctx, cancel := context.WithCancel(ctx)
+		defer cancel()
+		return f(ctx, p0, p1, p2, p3, p4)
+	}
+}
+
+// WithValue returns a CtxFunc5Ok calling the CtxFunc5Ok with a context
+// carrying val for key, see context.WithValue.
+func (f CtxFunc5Ok[R, P0, P1, P2, P3, P4]) WithValue(key, val any) CtxFunc5Ok[R, P0, P1, P2, P3, P4] {
+	return func(ctx context.Context, p0 P0, p1 P1, p2 P2, p3 P3, p4 P4) (R, bool) {
+		ctx = context.WithValue(ctx, key, val)
+		return f(ctx, p0, p1, p2, p3, p4)
+	}
+}
+
+// WithValueFrom returns a CtxFunc5Ok calling the CtxFunc5Ok with a context
+// carrying the value returned by value for its key. value gets the arguments
+// of every call.
+func (f CtxFunc5Ok[R, P0, P1, P2, P3, P4]) WithValueFrom(value func(ctx context.Context, p0 P0, p1 P1, p2 P2, p3 P3, p4 P4) (key, val any)) CtxFunc5Ok[R, P0, P1, P2, P3, P4] {
+	return func(ctx context.Context, p0 P0, p1 P1, p2 P2, p3 P3, p4 P4) (R, bool) {
+		key, val := value(ctx, p0, p1, p2, p3, p4)
+		ctx = context.WithValue(ctx, key, val)
+		return f(ctx, p0, p1, p2, p3, p4)
+	}
+}
+
+// Detached returns a CtxFunc5Ok calling the CtxFunc5Ok with a context that is
+// never cancelled but keeps the values of the caller's context, see
+// context.WithoutCancel. Useful for cleanups that must run to completion.
+func (f CtxFunc5Ok[R, P0, P1, P2, P3, P4]) Detached() CtxFunc5Ok[R, P0, P1, P2, P3, P4] {
+	return func(ctx context.Context, p0 P0, p1 P1, p2 P2, p3 P3, p4 P4) (R, bool) {
+		ctx = context.WithoutCancel(ctx)
+		return f(ctx, p0, p1, p2, p3, p4)
+	}
+}
+
+// WithTimeoutCause is like WithTimeout, but the context is cancelled with
+// cause when the timeout expires, see context.WithTimeoutCause.
+func (f CtxFunc5Ok[R, P0, P1, P2, P3, P4]) WithTimeoutCause(timeout time.Duration, cause error) CtxFunc5Ok[R, P0, P1, P2, P3, P4] {
+	return func(ctx context.Context, p0 P0, p1 P1, p2 P2, p3 P3, p4 P4) (R, bool) {
+		ctx, cancel := context.WithTimeoutCause(ctx, timeout, cause)
+		defer cancel()
+		return f(ctx, p0, p1, p2, p3, p4)
+	}
+}
+
+// WithDeadlineCause is like WithDeadline, but the context is cancelled with
+// cause when the deadline expires, see context.WithDeadlineCause.
+func (f CtxFunc5Ok[R, P0, P1, P2, P3, P4]) WithDeadlineCause(deadline time.Time, cause error) CtxFunc5Ok[R, P0, P1, P2, P3, P4] {
+	return func(ctx context.Context, p0 P0, p1 P1, p2 P2, p3 P3, p4 P4) (R, bool) {
+		ctx, cancel := context.WithDeadlineCause(ctx, deadline, cause)
+		defer cancel()
+		return f(ctx, p0, p1, p2, p3, p4)
+	}
+}
+
+// Retry returns a CtxFunc5Ok that will retry the CtxFunc5Ok until it returns
+// true or the tryAgain function returns false.
+// The attempts that returned false fail with a placeholder error, given to
+// tryAgain and to the hooks of the options, e.g. OnRetry, so that any
+// RetryPolicy can be used.
+func (f CtxFunc5Ok[R, P0, P1, P2, P3, P4]) Retry(tryAgain RetryPolicy, opts ...RetryOption) CtxFunc5Ok[R, P0, P1, P2, P3, P4] {
+	return func(ctx context.Context, p0 P0, p1 P1, p2 P2, p3 P3, p4 P4) (R, bool) {
+		var v R
+		var ok bool
+		err := retry(ctx, func(attempts int, err error) bool {
+			return ctx.Err() == nil && tryAgain(attempts, err)
+		}, opts, func(ctx context.Context) error {
+			v, ok = f(ctx, p0, p1, p2, p3, p4)
+			if !ok {
+				return errNotOk
+			}
+			return nil
+		})
+		return v, err == nil
+	}
+}
+
+// Must returns a CtxFunc5Value that will panic if the CtxFunc5Ok returns false.
+func (f CtxFunc5Ok[R, P0, P1, P2, P3, P4]) Must() CtxFunc5Value[R, P0, P1, P2, P3, P4] {
+	return func(ctx context.Context, p0 P0, p1 P1, p2 P2, p3 P3, p4 P4) R {
+		v, ok := f(ctx, p0, p1, p2, p3, p4)
+		if !ok {
+			panic("powerfunc: CtxFunc5Ok returned false")
+		}
+		return v
+	}
+}
+
+// Fallback returns a CtxFunc5Value that will return the provided value if the
+// CtxFunc5Ok returns false.
+func (f CtxFunc5Ok[R, P0, P1, P2, P3, P4]) Fallback(val R) CtxFunc5Value[R, P0, P1, P2, P3, P4] {
+	return func(ctx context.Context, p0 P0, p1 P1, p2 P2, p3 P3, p4 P4) R {
+		v, ok := f(ctx, p0, p1, p2, p3, p4)
+		if !ok {
+			return val
+		}
+		return v
+	}
+}
+
+// OrElse returns a CtxFunc5Value that will return the provided value if the
+// CtxFunc5Ok returns false. It is an alias of Fallback.
+func (f CtxFunc5Ok[R, P0, P1, P2, P3, P4]) OrElse(val R) CtxFunc5Value[R, P0, P1, P2, P3, P4] {
+	return f.Fallback(val)
+}
+
+// OrElseGet returns a CtxFunc5Value that will call elsef if the CtxFunc5Ok
+// returns false.
+func (f CtxFunc5Ok[R, P0, P1, P2, P3, P4]) OrElseGet(elsef CtxFunc5Value[R, P0, P1, P2, P3, P4]) CtxFunc5Value[R, P0, P1, P2, P3, P4] {
+	return func(ctx context.Context, p0 P0, p1 P1, p2 P2, p3 P3, p4 P4) R {
+		v, ok := f(ctx, p0, p1, p2, p3, p4)
+		if !ok {
+			return elsef(ctx, p0, p1, p2, p3, p4)
+		}
+		return v
+	}
+}
+
+// ToResult transforms a CtxFunc5Ok into a CtxFunc5Result returning the
+// provided error when the CtxFunc5Ok returns false.
+func (f CtxFunc5Ok[R, P0, P1, P2, P3, P4]) ToResult(err error) CtxFunc5Result[R, P0, P1, P2, P3, P4] {
+	return func(ctx context.Context, p0 P0, p1 P1, p2 P2, p3 P3, p4 P4) (R, error) {
+		v, ok := f(ctx, p0, p1, p2, p3, p4)
+		if !ok {
+			return v, err
+		}
+		return v, nil
+	}
+}
+
+// Filter returns a CtxFunc5Ok that will return false if the value returned by
+// the CtxFunc5Ok does not satisfy keep.
+func (f CtxFunc5Ok[R, P0, P1, P2, P3, P4]) Filter(keep func(R) bool) CtxFunc5Ok[R, P0, P1, P2, P3, P4] {
+	return func(ctx context.Context, p0 P0, p1 P1, p2 P2, p3 P3, p4 P4) (R, bool) {
+		v, ok := f(ctx, p0, p1, p2, p3, p4)
+		if !ok || !keep(v) {
+			var zero R
+			return zero, false
+		}
+		return v, true
+	}
+}
+
+// Map applies the provided function to the value returned by the CtxFunc5Ok,
+// if it returns true.
+func (f CtxFunc5Ok[R, P0, P1, P2, P3, P4]) Map(fn func(R) R) CtxFunc5Ok[R, P0, P1, P2, P3, P4] {
+	return func(ctx context.Context, p0 P0, p1 P1, p2 P2, p3 P3, p4 P4) (R, bool) {
+		v, ok := f(ctx, p0, p1, p2, p3, p4)
+		if !ok {
+			return v, false
+		}
+		return fn(v), true
+	}
+}
+
+// Curry5 returns a CtxFuncOk[R] with the first 5 arguments of the CtxFunc5Ok bound.
+func (f CtxFunc5Ok[R, P0, P1, P2, P3, P4]) Curry5(p0 P0, p1 P1, p2 P2, p3 P3, p4 P4) CtxFuncOk[R] {
+	return func(ctx context.Context) (R, bool) {
+		return f(ctx, p0, p1, p2, p3, p4)
+	}
+}
+
+// Curry4 returns a CtxFunc1Ok[R, P4] with the first 4 arguments of the CtxFunc5Ok bound.
+func (f CtxFunc5Ok[R, P0, P1, P2, P3, P4]) Curry4(p0 P0, p1 P1, p2 P2, p3 P3) CtxFunc1Ok[R, P4] {
+	return func(ctx context.Context, p4 P4) (R, bool) {
+		return f(ctx, p0, p1, p2, p3, p4)
+	}
+}
+
+// Curry3 returns a CtxFunc2Ok[R, P3, P4] with the first 3 arguments of the CtxFunc5Ok bound.
+func (f CtxFunc5Ok[R, P0, P1, P2, P3, P4]) Curry3(p0 P0, p1 P1, p2 P2) CtxFunc2Ok[R, P3, P4] {
+	return func(ctx context.Context, p3 P3, p4 P4) (R, bool) {
+		return f(ctx, p0, p1, p2, p3, p4)
+	}
+}
+
+// Curry2 returns a CtxFunc3Ok[R, P2, P3, P4] with the first 2 arguments of the CtxFunc5Ok bound.
+func (f CtxFunc5Ok[R, P0, P1, P2, P3, P4]) Curry2(p0 P0, p1 P1) CtxFunc3Ok[R, P2, P3, P4] {
+	return func(ctx context.Context, p2 P2, p3 P3, p4 P4) (R, bool) {
+		return f(ctx, p0, p1, p2, p3, p4)
+	}
+}
+
+// Curry1 returns a CtxFunc4Ok[R, P1, P2, P3, P4] with the first argument of the CtxFunc5Ok bound.
+func (f CtxFunc5Ok[R, P0, P1, P2, P3, P4]) Curry1(p0 P0) CtxFunc4Ok[R, P1, P2, P3, P4] {
+	return func(ctx context.Context, p1 P1, p2 P2, p3 P3, p4 P4) (R, bool) {
+		return f(ctx, p0, p1, p2, p3, p4)
+	}
+}
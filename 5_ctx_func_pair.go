@@ -0,0 +1,153 @@
+// Code generated by powerfunc/generator from ctx_func_pair.go.tmpl. DO NOT EDIT.
+
+package powerfunc
+
+import (
+	"context"
+	"fmt"
+	"time"
+)
+
+type CtxFunc5Pair[R1, R2, P0, P1, P2, P3, P4 any] func(ctx context.Context, p0 P0, p1 P1, p2 P2, p3 P3, p4 P4) (R1, R2)
+
+func (f CtxFunc5Pair[R1, R2, P0, P1, P2, P3, P4]) Exec(ctx context.Context, p0 P0, p1 P1, p2 P2, p3 P3, p4 P4) (R1, R2) {
+	return f(ctx, p0, p1, p2, p3, p4)
+}
+
+func (f CtxFunc5Pair[R1, R2, P0, P1, P2, P3, P4]) Timing(loggers ...func(d time.Duration)) CtxFunc5Pair[R1, R2, P0, P1, P2, P3, P4] {
+	return f.TimingWithClock(RealClock(), loggers...)
+}
+
+// TimingWithClock is like Timing, but measures the execution time with clock.
+func (f CtxFunc5Pair[R1, R2, P0, P1, P2, P3, P4]) TimingWithClock(clock Clock, loggers ...func(d time.Duration)) CtxFunc5Pair[R1, R2, P0, P1, P2, P3, P4] {
+	return func(ctx context.Context, p0 P0, p1 P1, p2 P2, p3 P3, p4 P4) (R1, R2) {
+		start := clock.Now()
+		defer func() {
+			dur := clock.Since(start)
+			for _, logger := range loggers {
+				logger(dur)
+			}
+			if len(loggers) == 0 {
+				// Default logger
+				fmt.Println(dur)
+			}
+		}()
+		return f(ctx, p0, p1, p2, p3, p4)
+	}
+}
+
+func (f CtxFunc5Pair[R1, R2, P0, P1, P2, P3, P4]) Fallible() CtxFunc5PairResult[R1, R2, P0, P1, P2, P3, P4] {
+	return func(ctx context.Context, p0 P0, p1 P1, p2 P2, p3 P3, p4 P4) (R1, R2, error) {
+		v1, v2 := f(ctx, p0, p1, p2, p3, p4)
+		return v1, v2, nil
+	}
+}
+
+func (f CtxFunc5Pair[R1, R2, P0, P1, P2, P3, P4]) WithTimeout(timeout time.Duration) CtxFunc5Pair[R1, R2, P0, P1, P2, P3, P4] {
+	return func(ctx context.Context, p0 P0, p1 P1, p2 P2, p3 P3, p4 P4) (R1, R2) {
+		ctx, cancel := context.WithTimeout(ctx, timeout)
+		defer cancel()
+		return f(ctx, p0, p1, p2, p3, p4)
+	}
+}
+
+func (f CtxFunc5Pair[R1, R2, P0, P1, P2, P3, P4]) WithDeadline(deadline time.Time) CtxFunc5Pair[R1, R2, P0, P1, P2, P3, P4] {
+	return func(ctx context.Context, p0 P0, p1 P1, p2 P2, p3 P3, p4 P4) (R1, R2) {
+		ctx, cancel := context.WithDeadline(ctx, deadline)
+		defer cancel()
+		return f(ctx, p0, p1, p2, p3, p4)
+	}
+}
+
+func (f CtxFunc5Pair[R1, R2, P0, P1, P2, P3, P4]) WithCancel() CtxFunc5Pair[R1, R2, P0, P1, P2, P3, P4] {
+	return func(ctx context.Context, p0 P0, p1 P1, p2 P2, p3 P3, p4 P4) (R1, R2) {
+		ctx, cancel := context.WithCancel(ctx)
+		defer cancel()
+		return f(ctx, p0, p1, p2, p3, p4)
+	}
+}
+
+// WithValue returns a CtxFunc5Pair calling the CtxFunc5Pair with a context
+// carrying val for key, see context.WithValue.
+func (f CtxFunc5Pair[R1, R2, P0, P1, P2, P3, P4]) WithValue(key, val any) CtxFunc5Pair[R1, R2, P0, P1, P2, P3, P4] {
+	return func(ctx context.Context, p0 P0, p1 P1, p2 P2, p3 P3, p4 P4) (R1, R2) {
+		ctx = context.WithValue(ctx, key, val)
+		return f(ctx, p0, p1, p2, p3, p4)
+	}
+}
+
+// WithValueFrom returns a CtxFunc5Pair calling the CtxFunc5Pair with a context
+// carrying the value returned by value for its key. value gets the arguments
+// of every call.
+func (f CtxFunc5Pair[R1, R2, P0, P1, P2, P3, P4]) WithValueFrom(value func(ctx context.Context, p0 P0, p1 P1, p2 P2, p3 P3, p4 P4) (key, val any)) CtxFunc5Pair[R1, R2, P0, P1, P2, P3, P4] {
+	return func(ctx context.Context, p0 P0, p1 P1, p2 P2, p3 P3, p4 P4) (R1, R2) {
+		key, val := value(ctx, p0, p1, p2, p3, p4)
+		ctx = context.WithValue(ctx, key, val)
+		return f(ctx, p0, p1, p2, p3, p4)
+	}
+}
+
+// Detached returns a CtxFunc5Pair calling the CtxFunc5Pair with a context that is
+// never cancelled but keeps the values of the caller's context, see
+// context.WithoutCancel. Useful for cleanups that must run to completion.
+func (f CtxFunc5Pair[R1, R2, P0, P1, P2, P3, P4]) Detached() CtxFunc5Pair[R1, R2, P0, P1, P2, P3, P4] {
+	return func(ctx context.Context, p0 P0, p1 P1, p2 P2, p3 P3, p4 P4) (R1, R2) {
+		ctx = context.WithoutCancel(ctx)
+		return f(ctx, p0, p1, p2, p3, p4)
+	}
+}
+
+// WithTimeoutCause is like WithTimeout, but the context is cancelled with
+// cause when the timeout expires, see context.WithTimeoutCause.
+func (f CtxFunc5Pair[R1, R2, P0, P1, P2, P3, P4]) WithTimeoutCause(timeout time.Duration, cause error) CtxFunc5Pair[R1, R2, P0, P1, P2, P3, P4] {
+	return func(ctx context.Context, p0 P0, p1 P1, p2 P2, p3 P3, p4 P4) (R1, R2) {
+		ctx, cancel := context.WithTimeoutCause(ctx, timeout, cause)
+		defer cancel()
+		return f(ctx, p0, p1, p2, p3, p4)
+	}
+}
+
+// WithDeadlineCause is like WithDeadline, but the context is cancelled with
+// cause when the deadline expires, see context.WithDeadlineCause.
+func (f CtxFunc5Pair[R1, R2, P0, P1, P2, P3, P4]) WithDeadlineCause(deadline time.Time, cause error) CtxFunc5Pair[R1, R2, P0, P1, P2, P3, P4] {
+	return func(ctx context.Context, p0 P0, p1 P1, p2 P2, p3 P3, p4 P4) (R1, R2) {
+		ctx, cancel := context.WithDeadlineCause(ctx, deadline, cause)
+		defer cancel()
+		return f(ctx, p0, p1, p2, p3, p4)
+	}
+}
+
+// Curry5 returns a CtxFuncPair[R1, R2] with the first 5 arguments of the CtxFunc5Pair bound.
+func (f CtxFunc5Pair[R1, R2, P0, P1, P2, P3, P4]) Curry5(p0 P0, p1 P1, p2 P2, p3 P3, p4 P4) CtxFuncPair[R1, R2] {
+	return func(ctx context.Context) (R1, R2) {
+		return f(ctx, p0, p1, p2, p3, p4)
+	}
+}
+
+// Curry4 returns a CtxFunc1Pair[R1, R2, P4] with the first 4 arguments of the CtxFunc5Pair bound.
+func (f CtxFunc5Pair[R1, R2, P0, P1, P2, P3, P4]) Curry4(p0 P0, p1 P1, p2 P2, p3 P3) CtxFunc1Pair[R1, R2, P4] {
+	return func(ctx context.Context, p4 P4) (R1, R2) {
+		return f(ctx, p0, p1, p2, p3, p4)
+	}
+}
+
+// Curry3 returns a CtxFunc2Pair[R1, R2, P3, P4] with the first 3 arguments of the CtxFunc5Pair bound.
+func (f CtxFunc5Pair[R1, R2, P0, P1, P2, P3, P4]) Curry3(p0 P0, p1 P1, p2 P2) CtxFunc2Pair[R1, R2, P3, P4] {
+	return func(ctx context.Context, p3 P3, p4 P4) (R1, R2) {
+		return f(ctx, p0, p1, p2, p3, p4)
+	}
+}
+
+// Curry2 returns a CtxFunc3Pair[R1, R2, P2, P3, P4] with the first 2 arguments of the CtxFunc5Pair bound.
+func (f CtxFunc5Pair[R1, R2, P0, P1, P2, P3, P4]) Curry2(p0 P0, p1 P1) CtxFunc3Pair[R1, R2, P2, P3, P4] {
+	return func(ctx context.Context, p2 P2, p3 P3, p4 P4) (R1, R2) {
+		return f(ctx, p0, p1, p2, p3, p4)
+	}
+}
+
+// Curry1 returns a CtxFunc4Pair[R1, R2, P1, P2, P3, P4] with the first argument of the CtxFunc5Pair bound.
+func (f CtxFunc5Pair[R1, R2, P0, P1, P2, P3, P4]) Curry1(p0 P0) CtxFunc4Pair[R1, R2, P1, P2, P3, P4] {
+	return func(ctx context.Context, p1 P1, p2 P2, p3 P3, p4 P4) (R1, R2) {
+		return f(ctx, p0, p1, p2, p3, p4)
+	}
+}
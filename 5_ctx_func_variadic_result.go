@@ -0,0 +1,228 @@
+// Code generated by powerfunc/generator from ctx_func_variadic_result.go.tmpl. DO NOT EDIT.
+
+package powerfunc
+
+import (
+	"context"
+	"fmt"
+	"time"
+)
+
+type CtxFunc5VariadicResult[R, V, P0, P1, P2, P3, P4 any] func(ctx context.Context, p0 P0, p1 P1, p2 P2, p3 P3, p4 P4, xs ...V) (R, error)
+
+func (f CtxFunc5VariadicResult[R, V, P0, P1, P2, P3, P4]) Exec(ctx context.Context, p0 P0, p1 P1, p2 P2, p3 P3, p4 P4, xs ...V) (R, error) {
+	return f(ctx, p0, p1, p2, p3, p4, xs...)
+}
+
+func (f CtxFunc5VariadicResult[R, V, P0, P1, P2, P3, P4]) Timing(loggers ...func(d time.Duration)) CtxFunc5VariadicResult[R, V, P0, P1, P2, P3, P4] {
+	return f.TimingWithClock(RealClock(), loggers...)
+}
+
+// TimingWithClock is like Timing, but measures the execution time with clock.
+func (f CtxFunc5VariadicResult[R, V, P0, P1, P2, P3, P4]) TimingWithClock(clock Clock, loggers ...func(d time.Duration)) CtxFunc5VariadicResult[R, V, P0, P1, P2, P3, P4] {
+	return func(ctx context.Context, p0 P0, p1 P1, p2 P2, p3 P3, p4 P4, xs ...V) (R, error) {
+		start := clock.Now()
+		defer func() {
+			dur := clock.Since(start)
+			for _, logger := range loggers {
+				logger(dur)
+			}
+			if len(loggers) == 0 {
+				// Default logger
+				fmt.Println(dur)
+			}
+		}()
+		return f(ctx, p0, p1, p2, p3, p4, xs...)
+	}
+}
+
+func (f CtxFunc5VariadicResult[R, V, P0, P1, P2, P3, P4]) WithTimeout(timeout time.Duration) CtxFunc5VariadicResult[R, V, P0, P1, P2, P3, P4] {
+	return func(ctx context.Context, p0 P0, p1 P1, p2 P2, p3 P3, p4 P4, xs ...V) (R, error) {
+		ctx, cancel := context.WithTimeout(ctx, timeout)
+		defer cancel()
+		return f(ctx, p0, p1, p2, p3, p4, xs...)
+	}
+}
+
+func (f CtxFunc5VariadicResult[R, V, P0, P1, P2, P3, P4]) WithDeadline(deadline time.Time) CtxFunc5VariadicResult[R, V, P0, P1, P2, P3, P4] {
+	return func(ctx context.Context, p0 P0, p1 P1, p2 P2, p3 P3, p4 P4, xs ...V) (R, error) {
+		ctx, cancel := context.WithDeadline(ctx, deadline)
+		defer cancel()
+		return f(ctx, p0, p1, p2, p3, p4, xs...)
+	}
+}
+
+func (f CtxFunc5VariadicResult[R, V, P0, P1, P2, P3, P4]) WithCancel() CtxFunc5VariadicResult[R, V, P0, P1, P2, P3, P4] {
+	return func(ctx context.Context, p0 P0, p1 P1, p2 P2, p3 P3, p4 P4, xs ...V) (R, error) {
+		ctx, cancel := context.WithCancel(ctx)
+		defer cancel()
+		return f(ctx, p0, p1, p2, p3, p4, xs...)
+	}
+}
+
+// WithValue returns a CtxFunc5VariadicResult calling the CtxFunc5VariadicResult with a context
+// carrying val for key, see context.WithValue.
+func (f CtxFunc5VariadicResult[R, V, P0, P1, P2, P3, P4]) WithValue(key, val any) CtxFunc5VariadicResult[R, V, P0, P1, P2, P3, P4] {
+	return func(ctx context.Context, p0 P0, p1 P1, p2 P2, p3 P3, p4 P4, xs ...V) (R, error) {
+		ctx = context.WithValue(ctx, key, val)
+		return f(ctx, p0, p1, p2, p3, p4, xs...)
+	}
+}
+
+// WithValueFrom returns a CtxFunc5VariadicResult calling the CtxFunc5VariadicResult with a context
+// carrying the value returned by value for its key. value gets the arguments
+// of every call.
+func (f CtxFunc5VariadicResult[R, V, P0, P1, P2, P3, P4]) WithValueFrom(value func(ctx context.Context, p0 P0, p1 P1, p2 P2, p3 P3, p4 P4, xs ...V) (key, val any)) CtxFunc5VariadicResult[R, V, P0, P1, P2, P3, P4] {
+	return func(ctx context.Context, p0 P0, p1 P1, p2 P2, p3 P3, p4 P4, xs ...V) (R, error) {
+		key, val := value(ctx, p0, p1, p2, p3, p4, xs...)
+		ctx = context.WithValue(ctx, key, val)
+		return f(ctx, p0, p1, p2, p3, p4, xs...)
+	}
+}
+
+// Detached returns a CtxFunc5VariadicResult calling the CtxFunc5VariadicResult with a context that is
+// never cancelled but keeps the values of the caller's context, see
+// context.WithoutCancel. Useful for cleanups that must run to completion.
+func (f CtxFunc5VariadicResult[R, V, P0, P1, P2, P3, P4]) Detached() CtxFunc5VariadicResult[R, V, P0, P1, P2, P3, P4] {
+	return func(ctx context.Context, p0 P0, p1 P1, p2 P2, p3 P3, p4 P4, xs ...V) (R, error) {
+		ctx = context.WithoutCancel(ctx)
+		return f(ctx, p0, p1, p2, p3, p4, xs...)
+	}
+}
+
+// WithTimeoutCause is like WithTimeout, but the context is cancelled with
+// cause when the timeout expires, see context.WithTimeoutCause.
+// When the CtxFunc5VariadicResult then fails with the error of the context, the returned
+// error wraps cause as well, to tell which timeout expired.
+func (f CtxFunc5VariadicResult[R, V, P0, P1, P2, P3, P4]) WithTimeoutCause(timeout time.Duration, cause error) CtxFunc5VariadicResult[R, V, P0, P1, P2, P3, P4] {
+	return func(ctx context.Context, p0 P0, p1 P1, p2 P2, p3 P3, p4 P4, xs ...V) (R, error) {
+		ctx, cancel := context.WithTimeoutCause(ctx, timeout, cause)
+		defer cancel()
+		r0, err := f(ctx, p0, p1, p2, p3, p4, xs...)
+		return r0, withCause(ctx, err)
+	}
+}
+
+// WithDeadlineCause is like WithDeadline, but the context is cancelled with
+// cause when the deadline expires, see context.WithDeadlineCause.
+// When the CtxFunc5VariadicResult then fails with the error of the context, the returned
+// error wraps cause as well, to tell which deadline expired.
+func (f CtxFunc5VariadicResult[R, V, P0, P1, P2, P3, P4]) WithDeadlineCause(deadline time.Time, cause error) CtxFunc5VariadicResult[R, V, P0, P1, P2, P3, P4] {
+	return func(ctx context.Context, p0 P0, p1 P1, p2 P2, p3 P3, p4 P4, xs ...V) (R, error) {
+		ctx, cancel := context.WithDeadlineCause(ctx, deadline, cause)
+		defer cancel()
+		r0, err := f(ctx, p0, p1, p2, p3, p4, xs...)
+		return r0, withCause(ctx, err)
+	}
+}
+
+// WithBudget returns a CtxFunc5VariadicResult giving the CtxFunc5VariadicResult a fraction of the
+// remaining deadline of the caller, capped at max when max is positive,
+// instead of a fixed timeout. Without a deadline, the CtxFunc5VariadicResult gets max, or
+// no timeout if max is not positive.
+// When the share is below min, the CtxFunc5VariadicResult is not called and a
+// *BudgetError, matching ErrBudgetExhausted, is returned.
+func (f CtxFunc5VariadicResult[R, V, P0, P1, P2, P3, P4]) WithBudget(fraction float64, min, max time.Duration) CtxFunc5VariadicResult[R, V, P0, P1, P2, P3, P4] {
+	return func(ctx context.Context, p0 P0, p1 P1, p2 P2, p3 P3, p4 P4, xs ...V) (R, error) {
+		timeout, bounded, err := budget(ctx, fraction, min, max)
+		if err != nil {
+			var r0 R
+			return r0, err
+		}
+		if bounded {
+			var cancel context.CancelFunc
+			ctx, cancel = context.WithTimeout(ctx, timeout)
+			defer cancel()
+		}
+		return f(ctx, p0, p1, p2, p3, p4, xs...)
+	}
+}
+
+// Retry returns a CtxFunc5VariadicResult that will retry the CtxFunc5VariadicResult until it returns
+// a nil error or the tryAgain function returns false, in which case it
+// returns a *RetryError holding the errors of all the attempts.
+// The options set the backoff between attempts and hooks, e.g. OnRetry.
+// RetryAttemptTimeout gives every attempt its own deadline within the one of
+// the caller.
+func (f CtxFunc5VariadicResult[R, V, P0, P1, P2, P3, P4]) Retry(tryAgain func(attempts int, err error) bool, opts ...RetryOption) CtxFunc5VariadicResult[R, V, P0, P1, P2, P3, P4] {
+	return func(ctx context.Context, p0 P0, p1 P1, p2 P2, p3 P3, p4 P4, xs ...V) (R, error) {
+		var r0 R
+		err := retry(ctx, tryAgain, opts, func(ctx context.Context) error {
+			var err error
+			r0, err = f(ctx, p0, p1, p2, p3, p4, xs...)
+			return err
+		})
+		return r0, err
+	}
+}
+
+// OnErr returns a CtxFunc5VariadicResult that will wrap the error returned by
+// the CtxFunc5VariadicResult with the provided message.
+func (f CtxFunc5VariadicResult[R, V, P0, P1, P2, P3, P4]) OnErr(msg string) CtxFunc5VariadicResult[R, V, P0, P1, P2, P3, P4] {
+	return func(ctx context.Context, p0 P0, p1 P1, p2 P2, p3 P3, p4 P4, xs ...V) (R, error) {
+		v, err := f(ctx, p0, p1, p2, p3, p4, xs...)
+		if err != nil {
+			return v, fmt.Errorf("%s: %w", msg, err)
+		}
+		return v, nil
+	}
+}
+
+// CurryVariadic returns a CtxFunc5VariadicResult with some of its variadic
+// arguments bound. The bound arguments come before the ones passed to the
+// returned function.
+func (f CtxFunc5VariadicResult[R, V, P0, P1, P2, P3, P4]) CurryVariadic(bound ...V) CtxFunc5VariadicResult[R, V, P0, P1, P2, P3, P4] {
+	return func(ctx context.Context, p0 P0, p1 P1, p2 P2, p3 P3, p4 P4, xs ...V) (R, error) {
+		xs = append(append(make([]V, 0, len(bound)+len(xs)), bound...), xs...)
+		return f(ctx, p0, p1, p2, p3, p4, xs...)
+	}
+}
+
+// InjectFaults returns a CtxFunc5VariadicResult injecting the errors, panics and latency
+// configured by cfg in its calls, to test how the callers cope with them.
+// See FaultConfig, and DisableFaults for the global kill-switch.
+func (f CtxFunc5VariadicResult[R, V, P0, P1, P2, P3, P4]) InjectFaults(cfg FaultConfig) CtxFunc5VariadicResult[R, V, P0, P1, P2, P3, P4] {
+	fi := newFaultInjector(cfg)
+	return func(ctx context.Context, p0 P0, p1 P1, p2 P2, p3 P3, p4 P4, xs ...V) (R, error) {
+		if err := fi.inject(ctx, p0, p1, p2, p3, p4, xs); err != nil {
+			var r0 R
+			return r0, err
+		}
+		return f(ctx, p0, p1, p2, p3, p4, xs...)
+	}
+}
+
+// Curry5 returns a CtxFuncVariadicResult[R, V] with the first 5 arguments of the CtxFunc5VariadicResult bound.
+func (f CtxFunc5VariadicResult[R, V, P0, P1, P2, P3, P4]) Curry5(p0 P0, p1 P1, p2 P2, p3 P3, p4 P4) CtxFuncVariadicResult[R, V] {
+	return func(ctx context.Context, xs ...V) (R, error) {
+		return f(ctx, p0, p1, p2, p3, p4, xs...)
+	}
+}
+
+// Curry4 returns a CtxFunc1VariadicResult[R, V, P4] with the first 4 arguments of the CtxFunc5VariadicResult bound.
+func (f CtxFunc5VariadicResult[R, V, P0, P1, P2, P3, P4]) Curry4(p0 P0, p1 P1, p2 P2, p3 P3) CtxFunc1VariadicResult[R, V, P4] {
+	return func(ctx context.Context, p4 P4, xs ...V) (R, error) {
+		return f(ctx, p0, p1, p2, p3, p4, xs...)
+	}
+}
+
+// Curry3 returns a CtxFunc2VariadicResult[R, V, P3, P4] with the first 3 arguments of the CtxFunc5VariadicResult bound.
+func (f CtxFunc5VariadicResult[R, V, P0, P1, P2, P3, P4]) Curry3(p0 P0, p1 P1, p2 P2) CtxFunc2VariadicResult[R, V, P3, P4] {
+	return func(ctx context.Context, p3 P3, p4 P4, xs ...V) (R, error) {
+		return f(ctx, p0, p1, p2, p3, p4, xs...)
+	}
+}
+
+// Curry2 returns a CtxFunc3VariadicResult[R, V, P2, P3, P4] with the first 2 arguments of the CtxFunc5VariadicResult bound.
+func (f CtxFunc5VariadicResult[R, V, P0, P1, P2, P3, P4]) Curry2(p0 P0, p1 P1) CtxFunc3VariadicResult[R, V, P2, P3, P4] {
+	return func(ctx context.Context, p2 P2, p3 P3, p4 P4, xs ...V) (R, error) {
+		return f(ctx, p0, p1, p2, p3, p4, xs...)
+	}
+}
+
+// Curry1 returns a CtxFunc4VariadicResult[R, V, P1, P2, P3, P4] with the first argument of the CtxFunc5VariadicResult bound.
+func (f CtxFunc5VariadicResult[R, V, P0, P1, P2, P3, P4]) Curry1(p0 P0) CtxFunc4VariadicResult[R, V, P1, P2, P3, P4] {
+	return func(ctx context.Context, p1 P1, p2 P2, p3 P3, p4 P4, xs ...V) (R, error) {
+		return f(ctx, p0, p1, p2, p3, p4, xs...)
+	}
+}
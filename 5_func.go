@@ -1,13 +1,14 @@
-//go:generate go run ./generator -arity 10
+// Code generated by powerfunc/generator from func.go.tmpl. DO NOT EDIT.
 
 package powerfunc
 
 import (
+	"context"
 	"fmt"
 	"time"
 )
 
-// Func5 is a function that takes 0 arguments and returns no values.
+// Func5 is a function that takes 5 arguments and returns no values.
 type Func5[P0, P1, P2, P3, P4 any] func(p0 P0, p1 P1, p2 P2, p3 P3, p4 P4)
 
 // Exec executes the Function.
@@ -20,10 +21,15 @@ func (f Func5[P0, P1, P2, P3, P4]) Exec(p0 P0, p1 P1, p2 P2, p3 P3, p4 P4) {
 // Timing returns a Func5 that will log the execution time of the Func5.
 // If no loggers are provided, the default logger (fmt.Println) will be used.
 func (f Func5[P0, P1, P2, P3, P4]) Timing(loggers ...func(d time.Duration)) Func5[P0, P1, P2, P3, P4] {
+	return f.TimingWithClock(RealClock(), loggers...)
+}
+
+// TimingWithClock is like Timing, but measures the execution time with clock.
+func (f Func5[P0, P1, P2, P3, P4]) TimingWithClock(clock Clock, loggers ...func(d time.Duration)) Func5[P0, P1, P2, P3, P4] {
 	return func(p0 P0, p1 P1, p2 P2, p3 P3, p4 P4) {
-		start := time.Now()
+		start := clock.Now()
 		defer func() {
-			dur := time.Since(start)
+			dur := clock.Since(start)
 			for _, logger := range loggers {
 				logger(dur)
 			}
@@ -46,38 +52,89 @@ func (f Func5[P0, P1, P2, P3, P4]) Fallible() Func5Error[P0, P1, P2, P3, P4] {
 	}
 }
 
+// Debounce returns a Func5 that delays its execution until wait has elapsed
+// since the last call, along with the Debouncer controlling it.
+// Only the most recent call is kept and eventually invoked.
+func (f Func5[P0, P1, P2, P3, P4]) Debounce(wait time.Duration, opts ...DebounceOption) (Func5[P0, P1, P2, P3, P4], *Debouncer) {
+	d := newDebouncer(wait, opts...)
+	return func(p0 P0, p1 P1, p2 P2, p3 P3, p4 P4) {
+		d.call(func() {
+			f(p0, p1, p2, p3, p4)
+		})
+	}, d
+}
+
+// Throttle returns a Func5 that executes at most once per interval, along
+// with the Debouncer controlling it.
+// The first call is invoked immediately, and the most recent call made
+// during the interval is invoked at the end of it.
+// The options, e.g. DebounceClock, apply to the underlying Debouncer.
+func (f Func5[P0, P1, P2, P3, P4]) Throttle(interval time.Duration, opts ...DebounceOption) (Func5[P0, P1, P2, P3, P4], *Debouncer) {
+	d := newThrottler(interval, opts...)
+	return func(p0 P0, p1 P1, p2 P2, p3 P3, p4 P4) {
+		d.call(func() {
+			f(p0, p1, p2, p3, p4)
+		})
+	}, d
+}
+
+// Once returns a Func5 that only executes the first time it is called,
+// along with the Once controlling it.
+func (f Func5[P0, P1, P2, P3, P4]) Once(opts ...OnceOption) (Func5[P0, P1, P2, P3, P4], *Once) {
+	o := newOnce(opts...)
+	return func(p0 P0, p1 P1, p2 P2, p3 P3, p4 P4) {
+		_, _ = o.do(context.Background(), false, func() (any, error) {
+			f(p0, p1, p2, p3, p4)
+			return nil, nil
+		})
+	}, o
+}
+
+// Lazy returns a Func5 that executes the first time it is called,
+// along with the Once controlling it.
+// A Func5 cannot fail, so Lazy behaves like Once unless it panics, in which
+// case the next call executes it again.
+func (f Func5[P0, P1, P2, P3, P4]) Lazy(opts ...OnceOption) (Func5[P0, P1, P2, P3, P4], *Once) {
+	o := newLazy(opts...)
+	return func(p0 P0, p1 P1, p2 P2, p3 P3, p4 P4) {
+		_, _ = o.do(context.Background(), false, func() (any, error) {
+			f(p0, p1, p2, p3, p4)
+			return nil, nil
+		})
+	}, o
+}
 
+// Curry5 returns a Func with the first 5 arguments of the Func5 bound.
 func (f Func5[P0, P1, P2, P3, P4]) Curry5(p0 P0, p1 P1, p2 P2, p3 P3, p4 P4) Func {
-	return func()  {
+	return func() {
 		f(p0, p1, p2, p3, p4)
 	}
 }
-	
 
+// Curry4 returns a Func1[P4] with the first 4 arguments of the Func5 bound.
 func (f Func5[P0, P1, P2, P3, P4]) Curry4(p0 P0, p1 P1, p2 P2, p3 P3) Func1[P4] {
-	return func(p4 P4)  {
+	return func(p4 P4) {
 		f(p0, p1, p2, p3, p4)
 	}
 }
-	
 
+// Curry3 returns a Func2[P3, P4] with the first 3 arguments of the Func5 bound.
 func (f Func5[P0, P1, P2, P3, P4]) Curry3(p0 P0, p1 P1, p2 P2) Func2[P3, P4] {
-	return func(p3 P3, p4 P4)  {
+	return func(p3 P3, p4 P4) {
 		f(p0, p1, p2, p3, p4)
 	}
 }
-	
 
+// Curry2 returns a Func3[P2, P3, P4] with the first 2 arguments of the Func5 bound.
 func (f Func5[P0, P1, P2, P3, P4]) Curry2(p0 P0, p1 P1) Func3[P2, P3, P4] {
-	return func(p2 P2, p3 P3, p4 P4)  {
+	return func(p2 P2, p3 P3, p4 P4) {
 		f(p0, p1, p2, p3, p4)
 	}
 }
-	
 
+// Curry1 returns a Func4[P1, P2, P3, P4] with the first argument of the Func5 bound.
 func (f Func5[P0, P1, P2, P3, P4]) Curry1(p0 P0) Func4[P1, P2, P3, P4] {
-	return func(p1 P1, p2 P2, p3 P3, p4 P4)  {
+	return func(p1 P1, p2 P2, p3 P3, p4 P4) {
 		f(p0, p1, p2, p3, p4)
 	}
 }
-	
\ No newline at end of file
@@ -0,0 +1,176 @@
+// Code generated by powerfunc/generator from func_ok.go.tmpl. DO NOT EDIT.
+
+package powerfunc
+
+import (
+	"fmt"
+	"time"
+)
+
+// Func5Ok is a function that takes 5 arguments and returns a value and a
+// boolean reporting whether the value is valid, like a map lookup.
+type Func5Ok[R, P0, P1, P2, P3, P4 any] func(p0 P0, p1 P1, p2 P2, p3 P3, p4 P4) (R, bool)
+
+// Exec executes the function.
+// Convenience method to remove some of the confusion that
+// `f.SomeMethod()()` can bring.
+func (f Func5Ok[R, P0, P1, P2, P3, P4]) Exec(p0 P0, p1 P1, p2 P2, p3 P3, p4 P4) (R, bool) {
+	return f(p0, p1, p2, p3, p4)
+}
+
+// Timing returns a Func5Ok that will log the execution time of the Func5Ok.
+// If no loggers are provided, the default logger (fmt.Println) will be used.
+func (f Func5Ok[R, P0, P1, P2, P3, P4]) Timing(loggers ...func(d time.Duration)) Func5Ok[R, P0, P1, P2, P3, P4] {
+	return f.TimingWithClock(RealClock(), loggers...)
+}
+
+// TimingWithClock is like Timing, but measures the execution time with clock.
+func (f Func5Ok[R, P0, P1, P2, P3, P4]) TimingWithClock(clock Clock, loggers ...func(d time.Duration)) Func5Ok[R, P0, P1, P2, P3, P4] {
+	return func(p0 P0, p1 P1, p2 P2, p3 P3, p4 P4) (R, bool) {
+		start := clock.Now()
+		defer func() {
+			dur := clock.Since(start)
+			for _, logger := range loggers {
+				logger(dur)
+			}
+			if len(loggers) == 0 {
+				// Default logger
+				fmt.Println(dur)
+			}
+		}()
+		return f(p0, p1, p2, p3, p4)
+	}
+}
+
+// Retry returns a Func5Ok that will retry the Func5Ok until it returns
+// true or the tryAgain function returns false.
+// The attempts that returned false fail with a placeholder error, given to
+// tryAgain and to the hooks of the options, e.g. OnRetry, so that any
+// RetryPolicy can be used.
+func (f Func5Ok[R, P0, P1, P2, P3, P4]) Retry(tryAgain RetryPolicy, opts ...RetryOption) Func5Ok[R, P0, P1, P2, P3, P4] {
+	return func(p0 P0, p1 P1, p2 P2, p3 P3, p4 P4) (R, bool) {
+		var v R
+		var ok bool
+		err := retryNoContext(tryAgain, opts, func() error {
+			v, ok = f(p0, p1, p2, p3, p4)
+			if !ok {
+				return errNotOk
+			}
+			return nil
+		})
+		return v, err == nil
+	}
+}
+
+// Must returns a Func5Value that will panic if the Func5Ok returns false.
+func (f Func5Ok[R, P0, P1, P2, P3, P4]) Must() Func5Value[R, P0, P1, P2, P3, P4] {
+	return func(p0 P0, p1 P1, p2 P2, p3 P3, p4 P4) R {
+		v, ok := f(p0, p1, p2, p3, p4)
+		if !ok {
+			panic("powerfunc: Func5Ok returned false")
+		}
+		return v
+	}
+}
+
+// Fallback returns a Func5Value that will return the provided value if the
+// Func5Ok returns false.
+func (f Func5Ok[R, P0, P1, P2, P3, P4]) Fallback(val R) Func5Value[R, P0, P1, P2, P3, P4] {
+	return func(p0 P0, p1 P1, p2 P2, p3 P3, p4 P4) R {
+		v, ok := f(p0, p1, p2, p3, p4)
+		if !ok {
+			return val
+		}
+		return v
+	}
+}
+
+// OrElse returns a Func5Value that will return the provided value if the
+// Func5Ok returns false. It is an alias of Fallback.
+func (f Func5Ok[R, P0, P1, P2, P3, P4]) OrElse(val R) Func5Value[R, P0, P1, P2, P3, P4] {
+	return f.Fallback(val)
+}
+
+// OrElseGet returns a Func5Value that will call elsef if the Func5Ok
+// returns false.
+func (f Func5Ok[R, P0, P1, P2, P3, P4]) OrElseGet(elsef Func5Value[R, P0, P1, P2, P3, P4]) Func5Value[R, P0, P1, P2, P3, P4] {
+	return func(p0 P0, p1 P1, p2 P2, p3 P3, p4 P4) R {
+		v, ok := f(p0, p1, p2, p3, p4)
+		if !ok {
+			return elsef(p0, p1, p2, p3, p4)
+		}
+		return v
+	}
+}
+
+// ToResult transforms a Func5Ok into a Func5Result returning the provided
+// error when the Func5Ok returns false.
+func (f Func5Ok[R, P0, P1, P2, P3, P4]) ToResult(err error) Func5Result[R, P0, P1, P2, P3, P4] {
+	return func(p0 P0, p1 P1, p2 P2, p3 P3, p4 P4) (R, error) {
+		v, ok := f(p0, p1, p2, p3, p4)
+		if !ok {
+			return v, err
+		}
+		return v, nil
+	}
+}
+
+// Filter returns a Func5Ok that will return false if the value returned by
+// the Func5Ok does not satisfy keep.
+func (f Func5Ok[R, P0, P1, P2, P3, P4]) Filter(keep func(R) bool) Func5Ok[R, P0, P1, P2, P3, P4] {
+	return func(p0 P0, p1 P1, p2 P2, p3 P3, p4 P4) (R, bool) {
+		v, ok := f(p0, p1, p2, p3, p4)
+		if !ok || !keep(v) {
+			var zero R
+			return zero, false
+		}
+		return v, true
+	}
+}
+
+// Map applies the provided function to the value returned by the Func5Ok,
+// if it returns true.
+func (f Func5Ok[R, P0, P1, P2, P3, P4]) Map(fn func(R) R) Func5Ok[R, P0, P1, P2, P3, P4] {
+	return func(p0 P0, p1 P1, p2 P2, p3 P3, p4 P4) (R, bool) {
+		v, ok := f(p0, p1, p2, p3, p4)
+		if !ok {
+			return v, false
+		}
+		return fn(v), true
+	}
+}
+
+// Curry5 returns a FuncOk[R] with the first 5 arguments of the Func5Ok bound.
+func (f Func5Ok[R, P0, P1, P2, P3, P4]) Curry5(p0 P0, p1 P1, p2 P2, p3 P3, p4 P4) FuncOk[R] {
+	return func() (R, bool) {
+		return f(p0, p1, p2, p3, p4)
+	}
+}
+
+// Curry4 returns a Func1Ok[R, P4] with the first 4 arguments of the Func5Ok bound.
+func (f Func5Ok[R, P0, P1, P2, P3, P4]) Curry4(p0 P0, p1 P1, p2 P2, p3 P3) Func1Ok[R, P4] {
+	return func(p4 P4) (R, bool) {
+		return f(p0, p1, p2, p3, p4)
+	}
+}
+
+// Curry3 returns a Func2Ok[R, P3, P4] with the first 3 arguments of the Func5Ok bound.
+func (f Func5Ok[R, P0, P1, P2, P3, P4]) Curry3(p0 P0, p1 P1, p2 P2) Func2Ok[R, P3, P4] {
+	return func(p3 P3, p4 P4) (R, bool) {
+		return f(p0, p1, p2, p3, p4)
+	}
+}
+
+// Curry2 returns a Func3Ok[R, P2, P3, P4] with the first 2 arguments of the Func5Ok bound.
+func (f Func5Ok[R, P0, P1, P2, P3, P4]) Curry2(p0 P0, p1 P1) Func3Ok[R, P2, P3, P4] {
+	return func(p2 P2, p3 P3, p4 P4) (R, bool) {
+		return f(p0, p1, p2, p3, p4)
+	}
+}
+
+// Curry1 returns a Func4Ok[R, P1, P2, P3, P4] with the first argument of the Func5Ok bound.
+func (f Func5Ok[R, P0, P1, P2, P3, P4]) Curry1(p0 P0) Func4Ok[R, P1, P2, P3, P4] {
+	return func(p1 P1, p2 P2, p3 P3, p4 P4) (R, bool) {
+		return f(p0, p1, p2, p3, p4)
+	}
+}
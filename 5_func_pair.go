@@ -0,0 +1,87 @@
+// Code generated by powerfunc/generator from func_pair.go.tmpl. DO NOT EDIT.
+
+package powerfunc
+
+import (
+	"fmt"
+	"time"
+)
+
+// Func5Pair is a function that takes 5 arguments and returns two values.
+type Func5Pair[R1, R2, P0, P1, P2, P3, P4 any] func(p0 P0, p1 P1, p2 P2, p3 P3, p4 P4) (R1, R2)
+
+// Exec executes the function.
+// Convenience method to remove some of the confusion that
+// `f.SomeMethod()()` can bring.
+func (f Func5Pair[R1, R2, P0, P1, P2, P3, P4]) Exec(p0 P0, p1 P1, p2 P2, p3 P3, p4 P4) (R1, R2) {
+	return f(p0, p1, p2, p3, p4)
+}
+
+// Timing returns a Func5Pair that will log the execution time of the Func5Pair.
+// If no loggers are provided, the default logger (fmt.Println) will be used.
+func (f Func5Pair[R1, R2, P0, P1, P2, P3, P4]) Timing(loggers ...func(d time.Duration)) Func5Pair[R1, R2, P0, P1, P2, P3, P4] {
+	return f.TimingWithClock(RealClock(), loggers...)
+}
+
+// TimingWithClock is like Timing, but measures the execution time with clock.
+func (f Func5Pair[R1, R2, P0, P1, P2, P3, P4]) TimingWithClock(clock Clock, loggers ...func(d time.Duration)) Func5Pair[R1, R2, P0, P1, P2, P3, P4] {
+	return func(p0 P0, p1 P1, p2 P2, p3 P3, p4 P4) (R1, R2) {
+		start := clock.Now()
+		defer func() {
+			dur := clock.Since(start)
+			for _, logger := range loggers {
+				logger(dur)
+			}
+			if len(loggers) == 0 {
+				// Default logger
+				fmt.Println(dur)
+			}
+		}()
+		return f(p0, p1, p2, p3, p4)
+	}
+}
+
+// Fallible transforms a Func5Pair into a Func5PairResult.
+// The returned Func5PairResult will never return an error.
+// Useful when passing a Func5Pair to a function that expects a Func5PairResult.
+func (f Func5Pair[R1, R2, P0, P1, P2, P3, P4]) Fallible() Func5PairResult[R1, R2, P0, P1, P2, P3, P4] {
+	return func(p0 P0, p1 P1, p2 P2, p3 P3, p4 P4) (R1, R2, error) {
+		v1, v2 := f(p0, p1, p2, p3, p4)
+		return v1, v2, nil
+	}
+}
+
+// Curry5 returns a FuncPair[R1, R2] with the first 5 arguments of the Func5Pair bound.
+func (f Func5Pair[R1, R2, P0, P1, P2, P3, P4]) Curry5(p0 P0, p1 P1, p2 P2, p3 P3, p4 P4) FuncPair[R1, R2] {
+	return func() (R1, R2) {
+		return f(p0, p1, p2, p3, p4)
+	}
+}
+
+// Curry4 returns a Func1Pair[R1, R2, P4] with the first 4 arguments of the Func5Pair bound.
+func (f Func5Pair[R1, R2, P0, P1, P2, P3, P4]) Curry4(p0 P0, p1 P1, p2 P2, p3 P3) Func1Pair[R1, R2, P4] {
+	return func(p4 P4) (R1, R2) {
+		return f(p0, p1, p2, p3, p4)
+	}
+}
+
+// Curry3 returns a Func2Pair[R1, R2, P3, P4] with the first 3 arguments of the Func5Pair bound.
+func (f Func5Pair[R1, R2, P0, P1, P2, P3, P4]) Curry3(p0 P0, p1 P1, p2 P2) Func2Pair[R1, R2, P3, P4] {
+	return func(p3 P3, p4 P4) (R1, R2) {
+		return f(p0, p1, p2, p3, p4)
+	}
+}
+
+// Curry2 returns a Func3Pair[R1, R2, P2, P3, P4] with the first 2 arguments of the Func5Pair bound.
+func (f Func5Pair[R1, R2, P0, P1, P2, P3, P4]) Curry2(p0 P0, p1 P1) Func3Pair[R1, R2, P2, P3, P4] {
+	return func(p2 P2, p3 P3, p4 P4) (R1, R2) {
+		return f(p0, p1, p2, p3, p4)
+	}
+}
+
+// Curry1 returns a Func4Pair[R1, R2, P1, P2, P3, P4] with the first argument of the Func5Pair bound.
+func (f Func5Pair[R1, R2, P0, P1, P2, P3, P4]) Curry1(p0 P0) Func4Pair[R1, R2, P1, P2, P3, P4] {
+	return func(p1 P1, p2 P2, p3 P3, p4 P4) (R1, R2) {
+		return f(p0, p1, p2, p3, p4)
+	}
+}
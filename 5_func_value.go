@@ -1,27 +1,35 @@
+// Code generated by powerfunc/generator from func_value.go.tmpl. DO NOT EDIT.
+
 package powerfunc
 
 import (
+	"context"
 	"fmt"
 	"time"
 )
 
-// Func5 is a function that takes 0 arguments and returns a value.
-type Func5Value[T, P0, P1, P2, P3, P4 any] func(p0 P0, p1 P1, p2 P2, p3 P3, p4 P4) T
+// Func5Value is a function that takes 5 arguments and returns a value.
+type Func5Value[R, P0, P1, P2, P3, P4 any] func(p0 P0, p1 P1, p2 P2, p3 P3, p4 P4) R
 
 // Exec executes the function.
 // Convenience method to remove some of the confusion that
 // `f.SomeMethod()()` can bring.
-func (f Func5Value[T, P0, P1, P2, P3, P4]) Exec(p0 P0, p1 P1, p2 P2, p3 P3, p4 P4) T {
+func (f Func5Value[R, P0, P1, P2, P3, P4]) Exec(p0 P0, p1 P1, p2 P2, p3 P3, p4 P4) R {
 	return f(p0, p1, p2, p3, p4)
 }
 
-// Timing returns a Func5 that will log the execution time of the Func5.
+// Timing returns a Func5Value that will log the execution time of the Func5Value.
 // If no loggers are provided, the default logger (fmt.Println) will be used.
-func (f Func5Value[T, P0, P1, P2, P3, P4]) Timing(loggers ...func(d time.Duration)) Func5Value[T, P0, P1, P2, P3, P4] {
-	return func(p0 P0, p1 P1, p2 P2, p3 P3, p4 P4) T {
-		start := time.Now()
+func (f Func5Value[R, P0, P1, P2, P3, P4]) Timing(loggers ...func(d time.Duration)) Func5Value[R, P0, P1, P2, P3, P4] {
+	return f.TimingWithClock(RealClock(), loggers...)
+}
+
+// TimingWithClock is like Timing, but measures the execution time with clock.
+func (f Func5Value[R, P0, P1, P2, P3, P4]) TimingWithClock(clock Clock, loggers ...func(d time.Duration)) Func5Value[R, P0, P1, P2, P3, P4] {
+	return func(p0 P0, p1 P1, p2 P2, p3 P3, p4 P4) R {
+		start := clock.Now()
 		defer func() {
-			dur := time.Since(start)
+			dur := clock.Since(start)
 			for _, logger := range loggers {
 				logger(dur)
 			}
@@ -37,44 +45,89 @@ func (f Func5Value[T, P0, P1, P2, P3, P4]) Timing(loggers ...func(d time.Duratio
 // Fallible transforms a Func5Value into a Func5Result.
 // The returned Func5Result will never return an error.
 // Useful when passing a Func5Value to a function that expects a Func5Result.
-func (f Func5Value[T, P0, P1, P2, P3, P4]) Fallible() Func5Result[T, P0, P1, P2, P3, P4] {
-	return func(p0 P0, p1 P1, p2 P2, p3 P3, p4 P4) (T, error) {
+func (f Func5Value[R, P0, P1, P2, P3, P4]) Fallible() Func5Result[R, P0, P1, P2, P3, P4] {
+	return func(p0 P0, p1 P1, p2 P2, p3 P3, p4 P4) (R, error) {
 		return f(p0, p1, p2, p3, p4), nil
 	}
 }
 
+// Once returns a Func5Value that only executes the first time it is called
+// and returns the cached value afterwards, along with the Once controlling it.
+func (f Func5Value[R, P0, P1, P2, P3, P4]) Once(opts ...OnceOption) (Func5Value[R, P0, P1, P2, P3, P4], *Once) {
+	o := newOnce(opts...)
+	return func(p0 P0, p1 P1, p2 P2, p3 P3, p4 P4) R {
+		v, _ := o.do(context.Background(), false, func() (any, error) {
+			return f(p0, p1, p2, p3, p4), nil
+		})
+		val, _ := v.(R)
+		return val
+	}, o
+}
+
+// Lazy returns a Func5Value that computes its value the first time it is
+// called and returns the cached value afterwards, along with the Once
+// controlling it.
+func (f Func5Value[R, P0, P1, P2, P3, P4]) Lazy(opts ...OnceOption) (Func5Value[R, P0, P1, P2, P3, P4], *Once) {
+	o := newLazy(opts...)
+	return func(p0 P0, p1 P1, p2 P2, p3 P3, p4 P4) R {
+		v, _ := o.do(context.Background(), false, func() (any, error) {
+			return f(p0, p1, p2, p3, p4), nil
+		})
+		val, _ := v.(R)
+		return val
+	}, o
+}
+
+// WithTimeout returns a Func5Result that runs the Func5Value in its own
+// goroutine and returns a *TimeoutError, matching ErrTimeout, if it does not
+// return within timeout. The goroutine is then abandoned: it keeps running
+// until the Func5Value returns, and is counted by Abandoned.
+// Prefer the WithTimeout method of a function taking a context, which can
+// actually be cancelled.
+func (f Func5Value[R, P0, P1, P2, P3, P4]) WithTimeout(timeout time.Duration, opts ...TimeoutOption) Func5Result[R, P0, P1, P2, P3, P4] {
+	return func(p0 P0, p1 P1, p2 P2, p3 P3, p4 P4) (R, error) {
+		var r0 R
+		if terr := callWithTimeout(timeout, opts, func() {
+			r0 = f(p0, p1, p2, p3, p4)
+		}); terr != nil {
+			var zero R
+			return zero, terr
+		}
+		return r0, nil
+	}
+}
 
+// Curry5 returns a FuncValue[R] with the first 5 arguments of the Func5Value bound.
 func (f Func5Value[R, P0, P1, P2, P3, P4]) Curry5(p0 P0, p1 P1, p2 P2, p3 P3, p4 P4) FuncValue[R] {
 	return func() R {
 		return f(p0, p1, p2, p3, p4)
 	}
 }
-	
 
+// Curry4 returns a Func1Value[R, P4] with the first 4 arguments of the Func5Value bound.
 func (f Func5Value[R, P0, P1, P2, P3, P4]) Curry4(p0 P0, p1 P1, p2 P2, p3 P3) Func1Value[R, P4] {
 	return func(p4 P4) R {
 		return f(p0, p1, p2, p3, p4)
 	}
 }
-	
 
+// Curry3 returns a Func2Value[R, P3, P4] with the first 3 arguments of the Func5Value bound.
 func (f Func5Value[R, P0, P1, P2, P3, P4]) Curry3(p0 P0, p1 P1, p2 P2) Func2Value[R, P3, P4] {
 	return func(p3 P3, p4 P4) R {
 		return f(p0, p1, p2, p3, p4)
 	}
 }
-	
 
+// Curry2 returns a Func3Value[R, P2, P3, P4] with the first 2 arguments of the Func5Value bound.
 func (f Func5Value[R, P0, P1, P2, P3, P4]) Curry2(p0 P0, p1 P1) Func3Value[R, P2, P3, P4] {
 	return func(p2 P2, p3 P3, p4 P4) R {
 		return f(p0, p1, p2, p3, p4)
 	}
 }
-	
 
+// Curry1 returns a Func4Value[R, P1, P2, P3, P4] with the first argument of the Func5Value bound.
 func (f Func5Value[R, P0, P1, P2, P3, P4]) Curry1(p0 P0) Func4Value[R, P1, P2, P3, P4] {
 	return func(p1 P1, p2 P2, p3 P3, p4 P4) R {
 		return f(p0, p1, p2, p3, p4)
 	}
 }
-	
\ No newline at end of file
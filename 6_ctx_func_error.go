@@ -1,3 +1,5 @@
+// Code generated by powerfunc/generator from ctx_func_error.go.tmpl. DO NOT EDIT.
+
 package powerfunc
 
 import (
@@ -13,10 +15,15 @@ func (f CtxFunc6Error[P0, P1, P2, P3, P4, P5]) Exec(ctx context.Context, p0 P0,
 }
 
 func (f CtxFunc6Error[P0, P1, P2, P3, P4, P5]) Timing(loggers ...func(d time.Duration)) CtxFunc6Error[P0, P1, P2, P3, P4, P5] {
+	return f.TimingWithClock(RealClock(), loggers...)
+}
+
+// TimingWithClock is like Timing, but measures the execution time with clock.
+func (f CtxFunc6Error[P0, P1, P2, P3, P4, P5]) TimingWithClock(clock Clock, loggers ...func(d time.Duration)) CtxFunc6Error[P0, P1, P2, P3, P4, P5] {
 	return func(ctx context.Context, p0 P0, p1 P1, p2 P2, p3 P3, p4 P4, p5 P5) error {
-		start := time.Now()
+		start := clock.Now()
 		defer func() {
-			dur := time.Since(start)
+			dur := clock.Since(start)
 			for _, logger := range loggers {
 				logger(dur)
 			}
@@ -53,24 +60,98 @@ func (f CtxFunc6Error[P0, P1, P2, P3, P4, P5]) WithCancel() CtxFunc6Error[P0, P1
 	}
 }
 
-// Retry returns a Function that will retry the Function until it returns
-// a nil error or the tryAgain function returns false.
-func (f CtxFunc6Error[P0, P1, P2, P3, P4, P5]) Retry(tryAgain func(attempts int, err error) bool) CtxFunc6Error[P0, P1, P2, P3, P4, P5] {
+// WithValue returns a CtxFunc6Error calling the CtxFunc6Error with a context
+// carrying val for key, see context.WithValue.
+func (f CtxFunc6Error[P0, P1, P2, P3, P4, P5]) WithValue(key, val any) CtxFunc6Error[P0, P1, P2, P3, P4, P5] {
 	return func(ctx context.Context, p0 P0, p1 P1, p2 P2, p3 P3, p4 P4, p5 P5) error {
-		var err error
-		attempts := 1
-		for {
-			err = f(ctx, p0, p1, p2, p3, p4, p5)
-			if err != nil && tryAgain(attempts, err) {
-				break
-			}
-			attempts++
+		ctx = context.WithValue(ctx, key, val)
+		return f(ctx, p0, p1, p2, p3, p4, p5)
+	}
+}
+
+// WithValueFrom returns a CtxFunc6Error calling the CtxFunc6Error with a context
+// carrying the value returned by value for its key. value gets the arguments
+// of every call.
+func (f CtxFunc6Error[P0, P1, P2, P3, P4, P5]) WithValueFrom(value func(ctx context.Context, p0 P0, p1 P1, p2 P2, p3 P3, p4 P4, p5 P5) (key, val any)) CtxFunc6Error[P0, P1, P2, P3, P4, P5] {
+	return func(ctx context.Context, p0 P0, p1 P1, p2 P2, p3 P3, p4 P4, p5 P5) error {
+		key, val := value(ctx, p0, p1, p2, p3, p4, p5)
+		ctx = context.WithValue(ctx, key, val)
+		return f(ctx, p0, p1, p2, p3, p4, p5)
+	}
+}
+
+// Detached returns a CtxFunc6Error calling the CtxFunc6Error with a context that is
+// never cancelled but keeps the values of the caller's context, see
+// context.WithoutCancel. Useful for cleanups that must run to completion.
+func (f CtxFunc6Error[P0, P1, P2, P3, P4, P5]) Detached() CtxFunc6Error[P0, P1, P2, P3, P4, P5] {
+	return func(ctx context.Context, p0 P0, p1 P1, p2 P2, p3 P3, p4 P4, p5 P5) error {
+		ctx = context.WithoutCancel(ctx)
+		return f(ctx, p0, p1, p2, p3, p4, p5)
+	}
+}
+
+// WithTimeoutCause is like WithTimeout, but the context is cancelled with
+// cause when the timeout expires, see context.WithTimeoutCause.
+// When the CtxFunc6Error then fails with the error of the context, the returned
+// error wraps cause as well, to tell which timeout expired.
+func (f CtxFunc6Error[P0, P1, P2, P3, P4, P5]) WithTimeoutCause(timeout time.Duration, cause error) CtxFunc6Error[P0, P1, P2, P3, P4, P5] {
+	return func(ctx context.Context, p0 P0, p1 P1, p2 P2, p3 P3, p4 P4, p5 P5) error {
+		ctx, cancel := context.WithTimeoutCause(ctx, timeout, cause)
+		defer cancel()
+		err := f(ctx, p0, p1, p2, p3, p4, p5)
+		return withCause(ctx, err)
+	}
+}
+
+// WithDeadlineCause is like WithDeadline, but the context is cancelled with
+// cause when the deadline expires, see context.WithDeadlineCause.
+// When the CtxFunc6Error then fails with the error of the context, the returned
+// error wraps cause as well, to tell which deadline expired.
+func (f CtxFunc6Error[P0, P1, P2, P3, P4, P5]) WithDeadlineCause(deadline time.Time, cause error) CtxFunc6Error[P0, P1, P2, P3, P4, P5] {
+	return func(ctx context.Context, p0 P0, p1 P1, p2 P2, p3 P3, p4 P4, p5 P5) error {
+		ctx, cancel := context.WithDeadlineCause(ctx, deadline, cause)
+		defer cancel()
+		err := f(ctx, p0, p1, p2, p3, p4, p5)
+		return withCause(ctx, err)
+	}
+}
+
+// WithBudget returns a CtxFunc6Error giving the CtxFunc6Error a fraction of the
+// remaining deadline of the caller, capped at max when max is positive,
+// instead of a fixed timeout. Without a deadline, the CtxFunc6Error gets max, or
+// no timeout if max is not positive.
+// When the share is below min, the CtxFunc6Error is not called and a
+// *BudgetError, matching ErrBudgetExhausted, is returned.
+func (f CtxFunc6Error[P0, P1, P2, P3, P4, P5]) WithBudget(fraction float64, min, max time.Duration) CtxFunc6Error[P0, P1, P2, P3, P4, P5] {
+	return func(ctx context.Context, p0 P0, p1 P1, p2 P2, p3 P3, p4 P4, p5 P5) error {
+		timeout, bounded, err := budget(ctx, fraction, min, max)
+		if err != nil {
+			return err
 		}
-		return err
+		if bounded {
+			var cancel context.CancelFunc
+			ctx, cancel = context.WithTimeout(ctx, timeout)
+			defer cancel()
+		}
+		return f(ctx, p0, p1, p2, p3, p4, p5)
 	}
 }
 
-// Must returns a Func6Value that will panic if the CtxFunc6Result returns an error.
+// Retry returns a CtxFunc6Error that will retry the CtxFunc6Error until it returns
+// a nil error or the tryAgain function returns false, in which case it
+// returns a *RetryError holding the errors of all the attempts.
+// The options set the backoff between attempts and hooks, e.g. OnRetry.
+// RetryAttemptTimeout gives every attempt its own deadline within the one of
+// the caller.
+func (f CtxFunc6Error[P0, P1, P2, P3, P4, P5]) Retry(tryAgain func(attempts int, err error) bool, opts ...RetryOption) CtxFunc6Error[P0, P1, P2, P3, P4, P5] {
+	return func(ctx context.Context, p0 P0, p1 P1, p2 P2, p3 P3, p4 P4, p5 P5) error {
+		return retry(ctx, tryAgain, opts, func(ctx context.Context) error {
+			return f(ctx, p0, p1, p2, p3, p4, p5)
+		})
+	}
+}
+
+// Must returns a CtxFunc6 that will panic if the CtxFunc6Error returns an error.
 func (f CtxFunc6Error[P0, P1, P2, P3, P4, P5]) Must() CtxFunc6[P0, P1, P2, P3, P4, P5] {
 	return func(ctx context.Context, p0 P0, p1 P1, p2 P2, p3 P3, p4 P4, p5 P5) {
 		err := f(ctx, p0, p1, p2, p3, p4, p5)
@@ -80,7 +161,7 @@ func (f CtxFunc6Error[P0, P1, P2, P3, P4, P5]) Must() CtxFunc6[P0, P1, P2, P3, P
 	}
 }
 
-// OnErr returns a CtxFunc6Result that will wrap the error returned by the CtxFunc6Result
+// OnErr returns a CtxFunc6Error that will wrap the error returned by the CtxFunc6Error
 // with the provided message.
 func (f CtxFunc6Error[P0, P1, P2, P3, P4, P5]) OnErr(msg string) CtxFunc6Error[P0, P1, P2, P3, P4, P5] {
 	return func(ctx context.Context, p0 P0, p1 P1, p2 P2, p3 P3, p4 P4, p5 P5) error {
@@ -92,45 +173,102 @@ func (f CtxFunc6Error[P0, P1, P2, P3, P4, P5]) OnErr(msg string) CtxFunc6Error[P
 	}
 }
 
+// Once returns a CtxFunc6Error that only executes the first time it is called
+// and returns the cached error afterwards, along with the Once controlling it.
+// Use OnceRetryOnError or OnceBackoff to only cache a success.
+// The shared execution does not inherit the cancellation of the caller's
+// context: a caller whose context is done stops waiting and gets ctx.Err(),
+// while the execution carries on for the other callers.
+func (f CtxFunc6Error[P0, P1, P2, P3, P4, P5]) Once(opts ...OnceOption) (CtxFunc6Error[P0, P1, P2, P3, P4, P5], *Once) {
+	o := newOnce(opts...)
+	return func(ctx context.Context, p0 P0, p1 P1, p2 P2, p3 P3, p4 P4, p5 P5) error {
+		_, err := o.do(ctx, true, func() (any, error) {
+			ctx := context.WithoutCancel(ctx)
+			return nil, f(ctx, p0, p1, p2, p3, p4, p5)
+		})
+		return err
+	}, o
+}
+
+// Lazy returns a CtxFunc6Error that executes until it succeeds once, along
+// with the Once controlling it.
+// Failures are not cached, unless a backoff is provided with OnceBackoff.
+func (f CtxFunc6Error[P0, P1, P2, P3, P4, P5]) Lazy(opts ...OnceOption) (CtxFunc6Error[P0, P1, P2, P3, P4, P5], *Once) {
+	o := newLazy(opts...)
+	return func(ctx context.Context, p0 P0, p1 P1, p2 P2, p3 P3, p4 P4, p5 P5) error {
+		_, err := o.do(ctx, true, func() (any, error) {
+			ctx := context.WithoutCancel(ctx)
+			return nil, f(ctx, p0, p1, p2, p3, p4, p5)
+		})
+		return err
+	}, o
+}
+
+// Every returns a runner that calls the CtxFunc6Error every interval until
+// its context is done, in which case the runner returns nil.
+// The runner only returns an error when EveryMaxConsecutiveErrors is
+// provided and reached.
+// Every panics if interval is not positive, like time.NewTicker.
+func (f CtxFunc6Error[P0, P1, P2, P3, P4, P5]) Every(interval time.Duration, opts ...EveryOption) CtxFunc6Error[P0, P1, P2, P3, P4, P5] {
+	checkEveryInterval(interval)
+	return func(ctx context.Context, p0 P0, p1 P1, p2 P2, p3 P3, p4 P4, p5 P5) error {
+		return every(ctx, interval, opts, func() error {
+			return f(ctx, p0, p1, p2, p3, p4, p5)
+		})
+	}
+}
+
+// InjectFaults returns a CtxFunc6Error injecting the errors, panics and latency
+// configured by cfg in its calls, to test how the callers cope with them.
+// See FaultConfig, and DisableFaults for the global kill-switch.
+func (f CtxFunc6Error[P0, P1, P2, P3, P4, P5]) InjectFaults(cfg FaultConfig) CtxFunc6Error[P0, P1, P2, P3, P4, P5] {
+	fi := newFaultInjector(cfg)
+	return func(ctx context.Context, p0 P0, p1 P1, p2 P2, p3 P3, p4 P4, p5 P5) error {
+		if err := fi.inject(ctx, p0, p1, p2, p3, p4, p5); err != nil {
+			return err
+		}
+		return f(ctx, p0, p1, p2, p3, p4, p5)
+	}
+}
 
+// Curry6 returns a CtxFuncError with the first 6 arguments of the CtxFunc6Error bound.
 func (f CtxFunc6Error[P0, P1, P2, P3, P4, P5]) Curry6(p0 P0, p1 P1, p2 P2, p3 P3, p4 P4, p5 P5) CtxFuncError {
 	return func(ctx context.Context) error {
 		return f(ctx, p0, p1, p2, p3, p4, p5)
 	}
 }
-	
 
+// Curry5 returns a CtxFunc1Error[P5] with the first 5 arguments of the CtxFunc6Error bound.
 func (f CtxFunc6Error[P0, P1, P2, P3, P4, P5]) Curry5(p0 P0, p1 P1, p2 P2, p3 P3, p4 P4) CtxFunc1Error[P5] {
 	return func(ctx context.Context, p5 P5) error {
 		return f(ctx, p0, p1, p2, p3, p4, p5)
 	}
 }
-	
 
+// Curry4 returns a CtxFunc2Error[P4, P5] with the first 4 arguments of the CtxFunc6Error bound.
 func (f CtxFunc6Error[P0, P1, P2, P3, P4, P5]) Curry4(p0 P0, p1 P1, p2 P2, p3 P3) CtxFunc2Error[P4, P5] {
 	return func(ctx context.Context, p4 P4, p5 P5) error {
 		return f(ctx, p0, p1, p2, p3, p4, p5)
 	}
 }
-	
 
+// Curry3 returns a CtxFunc3Error[P3, P4, P5] with the first 3 arguments of the CtxFunc6Error bound.
 func (f CtxFunc6Error[P0, P1, P2, P3, P4, P5]) Curry3(p0 P0, p1 P1, p2 P2) CtxFunc3Error[P3, P4, P5] {
 	return func(ctx context.Context, p3 P3, p4 P4, p5 P5) error {
 		return f(ctx, p0, p1, p2, p3, p4, p5)
 	}
 }
-	
 
+// Curry2 returns a CtxFunc4Error[P2, P3, P4, P5] with the first 2 arguments of the CtxFunc6Error bound.
 func (f CtxFunc6Error[P0, P1, P2, P3, P4, P5]) Curry2(p0 P0, p1 P1) CtxFunc4Error[P2, P3, P4, P5] {
 	return func(ctx context.Context, p2 P2, p3 P3, p4 P4, p5 P5) error {
 		return f(ctx, p0, p1, p2, p3, p4, p5)
 	}
 }
-	
 
+// Curry1 returns a CtxFunc5Error[P1, P2, P3, P4, P5] with the first argument of the CtxFunc6Error bound.
 func (f CtxFunc6Error[P0, P1, P2, P3, P4, P5]) Curry1(p0 P0) CtxFunc5Error[P1, P2, P3, P4, P5] {
 	return func(ctx context.Context, p1 P1, p2 P2, p3 P3, p4 P4, p5 P5) error {
 		return f(ctx, p0, p1, p2, p3, p4, p5)
 	}
 }
-	
\ No newline at end of file
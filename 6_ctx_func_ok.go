@@ -0,0 +1,253 @@
+// Code generated by powerfunc/generator from ctx_func_ok.go.tmpl. DO NOT EDIT.
+
+package powerfunc
+
+import (
+	"context"
+	"fmt"
+	"time"
+)
+
+type CtxFunc6Ok[R, P0, P1, P2, P3, P4, P5 any] func(ctx context.Context, p0 P0, p1 P1, p2 P2, p3 P3, p4 P4, p5 P5) (R, bool)
+
+func (f CtxFunc6Ok[R, P0, P1, P2, P3, P4, P5]) Exec(ctx context.Context, p0 P0, p1 P1, p2 P2, p3 P3, p4 P4, p5 P5) (R, bool) {
+	return f(ctx, p0, p1, p2, p3, p4, p5)
+}
+
+func (f CtxFunc6Ok[R, P0, P1, P2, P3, P4, P5]) Timing(loggers ...func(d time.Duration)) CtxFunc6Ok[R, P0, P1, P2, P3, P4, P5] {
+	return f.TimingWithClock(RealClock(), loggers...)
+}
+
+// TimingWithClock is like Timing, but measures the execution time with clock.
+func (f CtxFunc6Ok[R, P0, P1, P2, P3, P4, P5]) TimingWithClock(clock Clock, loggers ...func(d time.Duration)) CtxFunc6Ok[R, P0, P1, P2, P3, P4, P5] {
+	return func(ctx context.Context, p0 P0, p1 P1, p2 P2, p3 P3, p4 P4, p5 P5) (R, bool) {
+		start := clock.Now()
+		defer func() {
+			dur := clock.Since(start)
+			for _, logger := range loggers {
+				logger(dur)
+			}
+			if len(loggers) == 0 {
+				// Default logger
+				fmt.Println(dur)
+			}
+		}()
+		return f(ctx, p0, p1, p2, p3, p4, p5)
+	}
+}
+
+func (f CtxFunc6Ok[R, P0, P1, P2, P3, P4, P5]) WithTimeout(timeout time.Duration) CtxFunc6Ok[R, P0, P1, P2, P3, P4, P5] {
+	return func(ctx context.Context, p0 P0, p1 P1, p2 P2, p3 P3, p4 P4, p5 P5) (R, bool) {
+		ctx, cancel := context.WithTimeout(ctx, timeout)
+		defer cancel()
+		return f(ctx, p0, p1, p2, p3, p4, p5)
+	}
+}
+
+func (f CtxFunc6Ok[R, P0, P1, P2, P3, P4, P5]) WithDeadline(deadline time.Time) CtxFunc6Ok[R, P0, P1, P2, P3, P4, P5] {
+	return func(ctx context.Context, p0 P0, p1 P1, p2 P2, p3 P3, p4 P4, p5 P5) (R, bool) {
+		ctx, cancel := context.WithDeadline(ctx, deadline)
+		defer cancel()
+		return f(ctx, p0, p1, p2, p3, p4, p5)
+	}
+}
+
+func (f CtxFunc6Ok[R, P0, P1, P2, P3, P4, P5]) WithCancel() CtxFunc6Ok[R, P0, P1, P2, P3, P4, P5] {
+	return func(ctx context.Context, p0 P0, p1 P1, p2 P2, p3 P3, p4 P4, p5 P5) (R, bool) {
+		ctx, cancel := context.WithCancel(ctx)
+		defer cancel()
+		return f(ctx, p0, p1, p2, p3, p4, p5)
+	}
+}
+
+// WithValue returns a CtxFunc6Ok calling the CtxFunc6Ok with a context
+// carrying val for key, see context.WithValue.
+func (f CtxFunc6Ok[R, P0, P1, P2, P3, P4, P5]) WithValue(key, val any) CtxFunc6Ok[R, P0, P1, P2, P3, P4, P5] {
+	return func(ctx context.Context, p0 P0, p1 P1, p2 P2, p3 P3, p4 P4, p5 P5) (R, bool) {
+		ctx = context.WithValue(ctx, key, val)
+		return f(ctx, p0, p1, p2, p3, p4, p5)
+	}
+}
+
+// WithValueFrom returns a CtxFunc6Ok calling the CtxFunc6Ok with a context
+// carrying the value returned by value for its key. value gets the arguments
+// of every call.
+func (f CtxFunc6Ok[R, P0, P1, P2, P3, P4, P5]) WithValueFrom(value func(ctx context.Context, p0 P0, p1 P1, p2 P2, p3 P3, p4 P4, p5 P5) (key, val any)) CtxFunc6Ok[R, P0, P1, P2, P3, P4, P5] {
+	return func(ctx context.Context, p0 P0, p1 P1, p2 P2, p3 P3, p4 P4, p5 P5) (R, bool) {
+		key, val := value(ctx, p0, p1, p2, p3, p4, p5)
+		ctx = context.WithValue(ctx, key, val)
+		return f(ctx, p0, p1, p2, p3, p4, p5)
+	}
+}
+
+// Detached returns a CtxFunc6Ok calling the CtxFunc6Ok with a context that is
+// never cancelled but keeps the values of the caller's context, see
+// context.WithoutCancel. Useful for cleanups that must run to completion.
+func (f CtxFunc6Ok[R, P0, P1, P2, P3, P4, P5]) Detached() CtxFunc6Ok[R, P0, P1, P2, P3, P4, P5] {
+	return func(ctx context.Context, p0 P0, p1 P1, p2 P2, p3 P3, p4 P4, p5 P5) (R, bool) {
+		ctx = context.WithoutCancel(ctx)
+		return f(ctx, p0, p1, p2, p3, p4, p5)
+	}
+}
+
+// WithTimeoutCause is like WithTimeout, but the context is cancelled with
+// cause when the timeout expires, see context.WithTimeoutCause.
+func (f CtxFunc6Ok[R, P0, P1, P2, P3, P4, P5]) WithTimeoutCause(timeout time.Duration, cause error) CtxFunc6Ok[R, P0, P1, P2, P3, P4, P5] {
+	return func(ctx context.Context, p0 P0, p1 P1, p2 P2, p3 P3, p4 P4, p5 P5) (R, bool) {
+		ctx, cancel := context.WithTimeoutCause(ctx, timeout, cause)
+		defer cancel()
+		return f(ctx, p0, p1, p2, p3, p4, p5)
+	}
+}
+
+// WithDeadlineCause is like WithDeadline, but the context is cancelled with
+// cause when the deadline expires, see context.WithDeadlineCause.
+func (f CtxFunc6Ok[R, P0, P1, P2, P3, P4, P5]) WithDeadlineCause(deadline time.Time, cause error) CtxFunc6Ok[R, P0, P1, P2, P3, P4, P5] {
+	return func(ctx context.Context, p0 P0, p1 P1, p2 P2, p3 P3, p4 P4, p5 P5) (R, bool) {
+		ctx, cancel := context.WithDeadlineCause(ctx, deadline, cause)
+		defer cancel()
+		return f(ctx, p0, p1, p2, p3, p4, p5)
+	}
+}
+
+// Retry returns a CtxFunc6Ok that will retry the CtxFunc6Ok until it returns
+// true or the tryAgain function returns false.
+// The attempts that returned false fail with a placeholder error, given to
+// tryAgain and to the hooks of the options, e.g. OnRetry, so that any
+// RetryPolicy can be used.
+func (f CtxFunc6Ok[R, P0, P1, P2, P3, P4, P5]) Retry(tryAgain RetryPolicy, opts ...RetryOption) CtxFunc6Ok[R, P0, P1, P2, P3, P4, P5] {
+	return func(ctx context.Context, p0 P0, p1 P1, p2 P2, p3 P3, p4 P4, p5 P5) (R, bool) {
+		var v R
+		var ok bool
+		err := retry(ctx, func(attempts int, err error) bool {
+			return ctx.Err() == nil && tryAgain(attempts, err)
+		}, opts, func(ctx context.Context) error {
+			v, ok = f(ctx, p0, p1, p2, p3, p4, p5)
+			if !ok {
+				return errNotOk
+			}
+			return nil
+		})
+		return v, err == nil
+	}
+}
+
+// Must returns a CtxFunc6Value that will panic if the CtxFunc6Ok returns false.
+func (f CtxFunc6Ok[R, P0, P1, P2, P3, P4, P5]) Must() CtxFunc6Value[R, P0, P1, P2, P3, P4, P5] {
+	return func(ctx context.Context, p0 P0, p1 P1, p2 P2, p3 P3, p4 P4, p5 P5) R {
+		v, ok := f(ctx, p0, p1, p2, p3, p4, p5)
+		if !ok {
+			panic("powerfunc: CtxFunc6Ok returned false")
+		}
+		return v
+	}
+}
+
+// Fallback returns a CtxFunc6Value that will return the provided value if the
+// CtxFunc6Ok returns false.
+func (f CtxFunc6Ok[R, P0, P1, P2, P3, P4, P5]) Fallback(val R) CtxFunc6Value[R, P0, P1, P2, P3, P4, P5] {
+	return func(ctx context.Context, p0 P0, p1 P1, p2 P2, p3 P3, p4 P4, p5 P5) R {
+		v, ok := f(ctx, p0, p1, p2, p3, p4, p5)
+		if !ok {
+			return val
+		}
+		return v
+	}
+}
+
+// OrElse returns a CtxFunc6Value that will return the provided value if the
+// CtxFunc6Ok returns false. It is an alias of Fallback.
+func (f CtxFunc6Ok[R, P0, P1, P2, P3, P4, P5]) OrElse(val R) CtxFunc6Value[R, P0, P1, P2, P3, P4, P5] {
+	return f.Fallback(val)
+}
+
+// OrElseGet returns a CtxFunc6Value that will call elsef if the CtxFunc6Ok
+// returns false.
+func (f CtxFunc6Ok[R, P0, P1, P2, P3, P4, P5]) OrElseGet(elsef CtxFunc6Value[R, P0, P1, P2, P3, P4, P5]) CtxFunc6Value[R, P0, P1, P2, P3, P4, P5] {
+	return func(ctx context.Context, p0 P0, p1 P1, p2 P2, p3 P3, p4 P4, p5 P5) R {
+		v, ok := f(ctx, p0, p1, p2, p3, p4, p5)
+		if !ok {
+			return elsef(ctx, p0, p1, p2, p3, p4, p5)
+		}
+		return v
+	}
+}
+
+// ToResult transforms a CtxFunc6Ok into a CtxFunc6Result returning the
+// provided error when the CtxFunc6Ok returns false.
+func (f CtxFunc6Ok[R, P0, P1, P2, P3, P4, P5]) ToResult(err error) CtxFunc6Result[R, P0, P1, P2, P3, P4, P5] {
+	return func(ctx context.Context, p0 P0, p1 P1, p2 P2, p3 P3, p4 P4, p5 P5) (R, error) {
+		v, ok := f(ctx, p0, p1, p2, p3, p4, p5)
+		if !ok {
+			return v, err
+		}
+		return v, nil
+	}
+}
+
+// Filter returns a CtxFunc6Ok that will return false if the value returned by
+// the CtxFunc6Ok does not satisfy keep.
+func (f CtxFunc6Ok[R, P0, P1, P2, P3, P4, P5]) Filter(keep func(R) bool) CtxFunc6Ok[R, P0, P1, P2, P3, P4, P5] {
+	return func(ctx context.Context, p0 P0, p1 P1, p2 P2, p3 P3, p4 P4, p5 P5) (R, bool) {
+		v, ok := f(ctx, p0, p1, p2, p3, p4, p5)
+		if !ok || !keep(v) {
+			var zero R
+			return zero, false
+		}
+		return v, true
+	}
+}
+
+// Map applies the provided function to the value returned by the CtxFunc6Ok,
+// if it returns true.
+func (f CtxFunc6Ok[R, P0, P1, P2, P3, P4, P5]) Map(fn func(R) R) CtxFunc6Ok[R, P0, P1, P2, P3, P4, P5] {
+	return func(ctx context.Context, p0 P0, p1 P1, p2 P2, p3 P3, p4 P4, p5 P5) (R, bool) {
+		v, ok := f(ctx, p0, p1, p2, p3, p4, p5)
+		if !ok {
+			return v, false
+		}
+		return fn(v), true
+	}
+}
+
+// Curry6 returns a CtxFuncOk[R] with the first 6 arguments of the CtxFunc6Ok bound.
+func (f CtxFunc6Ok[R, P0, P1, P2, P3, P4, P5]) Curry6(p0 P0, p1 P1, p2 P2, p3 P3, p4 P4, p5 P5) CtxFuncOk[R] {
+	return func(ctx context.Context) (R, bool) {
+		return f(ctx, p0, p1, p2, p3, p4, p5)
+	}
+}
+
+// Curry5 returns a CtxFunc1Ok[R, P5] with the first 5 arguments of the CtxFunc6Ok bound.
+func (f CtxFunc6Ok[R, P0, P1, P2, P3, P4, P5]) Curry5(p0 P0, p1 P1, p2 P2, p3 P3, p4 P4) CtxFunc1Ok[R, P5] {
+	return func(ctx context.Context, p5 P5) (R, bool) {
+		return f(ctx, p0, p1, p2, p3, p4, p5)
+	}
+}
+
+// Curry4 returns a CtxFunc2Ok[R, P4, P5] with the first 4 arguments of the CtxFunc6Ok bound.
+func (f CtxFunc6Ok[R, P0, P1, P2, P3, P4, P5]) Curry4(p0 P0, p1 P1, p2 P2, p3 P3) CtxFunc2Ok[R, P4, P5] {
+	return func(ctx context.Context, p4 P4, p5 P5) (R, bool) {
+		return f(ctx, p0, p1, p2, p3, p4, p5)
+	}
+}
+
+// Curry3 returns a CtxFunc3Ok[R, P3, P4, P5] with the first 3 arguments of the CtxFunc6Ok bound.
+func (f CtxFunc6Ok[R, P0, P1, P2, P3, P4, P5]) Curry3(p0 P0, p1 P1, p2 P2) CtxFunc3Ok[R, P3, P4, P5] {
+	return func(ctx context.Context, p3 P3, p4 P4, p5 P5) (R, bool) {
+		return f(ctx, p0, p1, p2, p3, p4, p5)
+	}
+}
+
+// Curry2 returns a CtxFunc4Ok[R, P2, P3, P4, P5] with the first 2 arguments of the CtxFunc6Ok bound.
+func (f CtxFunc6Ok[R, P0, P1, P2, P3, P4, P5]) Curry2(p0 P0, p1 P1) CtxFunc4Ok[R, P2, P3, P4, P5] {
+	return func(ctx context.Context, p2 P2, p3 P3, p4 P4, p5 P5) (R, bool) {
+		return f(ctx, p0, p1, p2, p3, p4, p5)
+	}
+}
+
+// Curry1 returns a CtxFunc5Ok[R, P1, P2, P3, P4, P5] with the first argument of the CtxFunc6Ok bound.
+func (f CtxFunc6Ok[R, P0, P1, P2, P3, P4, P5]) Curry1(p0 P0) CtxFunc5Ok[R, P1, P2, P3, P4, P5] {
+	return func(ctx context.Context, p1 P1, p2 P2, p3 P3, p4 P4, p5 P5) (R, bool) {
+		return f(ctx, p0, p1, p2, p3, p4, p5)
+	}
+}
@@ -0,0 +1,160 @@
+// Code generated by powerfunc/generator from ctx_func_pair.go.tmpl. DO NOT EDIT.
+
+package powerfunc
+
+import (
+	"context"
+	"fmt"
+	"time"
+)
+
+type CtxFunc6Pair[R1, R2, P0, P1, P2, P3, P4, P5 any] func(ctx context.Context, p0 P0, p1 P1, p2 P2, p3 P3, p4 P4, p5 P5) (R1, R2)
+
+func (f CtxFunc6Pair[R1, R2, P0, P1, P2, P3, P4, P5]) Exec(ctx context.Context, p0 P0, p1 P1, p2 P2, p3 P3, p4 P4, p5 P5) (R1, R2) {
+	return f(ctx, p0, p1, p2, p3, p4, p5)
+}
+
+func (f CtxFunc6Pair[R1, R2, P0, P1, P2, P3, P4, P5]) Timing(loggers ...func(d time.Duration)) CtxFunc6Pair[R1, R2, P0, P1, P2, P3, P4, P5] {
+	return f.TimingWithClock(RealClock(), loggers...)
+}
+
+// TimingWithClock is like Timing, but measures the execution time with clock.
+func (f CtxFunc6Pair[R1, R2, P0, P1, P2, P3, P4, P5]) TimingWithClock(clock Clock, loggers ...func(d time.Duration)) CtxFunc6Pair[R1, R2, P0, P1, P2, P3, P4, P5] {
+	return func(ctx context.Context, p0 P0, p1 P1, p2 P2, p3 P3, p4 P4, p5 P5) (R1, R2) {
+		start := clock.Now()
+		defer func() {
+			dur := clock.Since(start)
+			for _, logger := range loggers {
+				logger(dur)
+			}
+			if len(loggers) == 0 {
+				// Default logger
+				fmt.Println(dur)
+			}
+		}()
+		return f(ctx, p0, p1, p2, p3, p4, p5)
+	}
+}
+
+func (f CtxFunc6Pair[R1, R2, P0, P1, P2, P3, P4, P5]) Fallible() CtxFunc6PairResult[R1, R2, P0, P1, P2, P3, P4, P5] {
+	return func(ctx context.Context, p0 P0, p1 P1, p2 P2, p3 P3, p4 P4, p5 P5) (R1, R2, error) {
+		v1, v2 := f(ctx, p0, p1, p2, p3, p4, p5)
+		return v1, v2, nil
+	}
+}
+
+func (f CtxFunc6Pair[R1, R2, P0, P1, P2, P3, P4, P5]) WithTimeout(timeout time.Duration) CtxFunc6Pair[R1, R2, P0, P1, P2, P3, P4, P5] {
+	return func(ctx context.Context, p0 P0, p1 P1, p2 P2, p3 P3, p4 P4, p5 P5) (R1, R2) {
+		ctx, cancel := context.WithTimeout(ctx, timeout)
+		defer cancel()
+		return f(ctx, p0, p1, p2, p3, p4, p5)
+	}
+}
+
+func (f CtxFunc6Pair[R1, R2, P0, P1, P2, P3, P4, P5]) WithDeadline(deadline time.Time) CtxFunc6Pair[R1, R2, P0, P1, P2, P3, P4, P5] {
+	return func(ctx context.Context, p0 P0, p1 P1, p2 P2, p3 P3, p4 P4, p5 P5) (R1, R2) {
+		ctx, cancel := context.WithDeadline(ctx, deadline)
+		defer cancel()
+		return f(ctx, p0, p1, p2, p3, p4, p5)
+	}
+}
+
+func (f CtxFunc6Pair[R1, R2, P0, P1, P2, P3, P4, P5]) WithCancel() CtxFunc6Pair[R1, R2, P0, P1, P2, P3, P4, P5] {
+	return func(ctx context.Context, p0 P0, p1 P1, p2 P2, p3 P3, p4 P4, p5 P5) (R1, R2) {
+		ctx, cancel := context.WithCancel(ctx)
+		defer cancel()
+		return f(ctx, p0, p1, p2, p3, p4, p5)
+	}
+}
+
+// WithValue returns a CtxFunc6Pair calling the CtxFunc6Pair with a context
+// carrying val for key, see context.WithValue.
+func (f CtxFunc6Pair[R1, R2, P0, P1, P2, P3, P4, P5]) WithValue(key, val any) CtxFunc6Pair[R1, R2, P0, P1, P2, P3, P4, P5] {
+	return func(ctx context.Context, p0 P0, p1 P1, p2 P2, p3 P3, p4 P4, p5 P5) (R1, R2) {
+		ctx = context.WithValue(ctx, key, val)
+		return f(ctx, p0, p1, p2, p3, p4, p5)
+	}
+}
+
+// WithValueFrom returns a CtxFunc6Pair calling the CtxFunc6Pair with a context
+// carrying the value returned by value for its key. value gets the arguments
+// of every call.
+func (f CtxFunc6Pair[R1, R2, P0, P1, P2, P3, P4, P5]) WithValueFrom(value func(ctx context.Context, p0 P0, p1 P1, p2 P2, p3 P3, p4 P4, p5 P5) (key, val any)) CtxFunc6Pair[R1, R2, P0, P1, P2, P3, P4, P5] {
+	return func(ctx context.Context, p0 P0, p1 P1, p2 P2, p3 P3, p4 P4, p5 P5) (R1, R2) {
+		key, val := value(ctx, p0, p1, p2, p3, p4, p5)
+		ctx = context.WithValue(ctx, key, val)
+		return f(ctx, p0, p1, p2, p3, p4, p5)
+	}
+}
+
+// Detached returns a CtxFunc6Pair calling the CtxFunc6Pair with a context that is
+// never cancelled but keeps the values of the caller's context, see
+// context.WithoutCancel. Useful for cleanups that must run to completion.
+func (f CtxFunc6Pair[R1, R2, P0, P1, P2, P3, P4, P5]) Detached() CtxFunc6Pair[R1, R2, P0, P1, P2, P3, P4, P5] {
+	return func(ctx context.Context, p0 P0, p1 P1, p2 P2, p3 P3, p4 P4, p5 P5) (R1, R2) {
+		ctx = context.WithoutCancel(ctx)
+		return f(ctx, p0, p1, p2, p3, p4, p5)
+	}
+}
+
+// WithTimeoutCause is like WithTimeout, but the context is cancelled with
+// cause when the timeout expires, see context.WithTimeoutCause.
+func (f CtxFunc6Pair[R1, R2, P0, P1, P2, P3, P4, P5]) WithTimeoutCause(timeout time.Duration, cause error) CtxFunc6Pair[R1, R2, P0, P1, P2, P3, P4, P5] {
+	return func(ctx context.Context, p0 P0, p1 P1, p2 P2, p3 P3, p4 P4, p5 P5) (R1, R2) {
+		ctx, cancel := context.WithTimeoutCause(ctx, timeout, cause)
+		defer cancel()
+		return f(ctx, p0, p1, p2, p3, p4, p5)
+	}
+}
+
+// WithDeadlineCause is like WithDeadline, but the context is cancelled with
+// cause when the deadline expires, see context.WithDeadlineCause.
+func (f CtxFunc6Pair[R1, R2, P0, P1, P2, P3, P4, P5]) WithDeadlineCause(deadline time.Time, cause error) CtxFunc6Pair[R1, R2, P0, P1, P2, P3, P4, P5] {
+	return func(ctx context.Context, p0 P0, p1 P1, p2 P2, p3 P3, p4 P4, p5 P5) (R1, R2) {
+		ctx, cancel := context.WithDeadlineCause(ctx, deadline, cause)
+		defer cancel()
+		return f(ctx, p0, p1, p2, p3, p4, p5)
+	}
+}
+
+// Curry6 returns a CtxFuncPair[R1, R2] with the first 6 arguments of the CtxFunc6Pair bound.
+func (f CtxFunc6Pair[R1, R2, P0, P1, P2, P3, P4, P5]) Curry6(p0 P0, p1 P1, p2 P2, p3 P3, p4 P4, p5 P5) CtxFuncPair[R1, R2] {
+	return func(ctx context.Context) (R1, R2) {
+		return f(ctx, p0, p1, p2, p3, p4, p5)
+	}
+}
+
+// Curry5 returns a CtxFunc1Pair[R1, R2, P5] with the first 5 arguments of the CtxFunc6Pair bound.
+func (f CtxFunc6Pair[R1, R2, P0, P1, P2, P3, P4, P5]) Curry5(p0 P0, p1 P1, p2 P2, p3 P3, p4 P4) CtxFunc1Pair[R1, R2, P5] {
+	return func(ctx context.Context, p5 P5) (R1, R2) {
+		return f(ctx, p0, p1, p2, p3, p4, p5)
+	}
+}
+
+// Curry4 returns a CtxFunc2Pair[R1, R2, P4, P5] with the first 4 arguments of the CtxFunc6Pair bound.
+func (f CtxFunc6Pair[R1, R2, P0, P1, P2, P3, P4, P5]) Curry4(p0 P0, p1 P1, p2 P2, p3 P3) CtxFunc2Pair[R1, R2, P4, P5] {
+	return func(ctx context.Context, p4 P4, p5 P5) (R1, R2) {
+		return f(ctx, p0, p1, p2, p3, p4, p5)
+	}
+}
+
+// Curry3 returns a CtxFunc3Pair[R1, R2, P3, P4, P5] with the first 3 arguments of the CtxFunc6Pair bound.
+func (f CtxFunc6Pair[R1, R2, P0, P1, P2, P3, P4, P5]) Curry3(p0 P0, p1 P1, p2 P2) CtxFunc3Pair[R1, R2, P3, P4, P5] {
+	return func(ctx context.Context, p3 P3, p4 P4, p5 P5) (R1, R2) {
+		return f(ctx, p0, p1, p2, p3, p4, p5)
+	}
+}
+
+// Curry2 returns a CtxFunc4Pair[R1, R2, P2, P3, P4, P5] with the first 2 arguments of the CtxFunc6Pair bound.
+func (f CtxFunc6Pair[R1, R2, P0, P1, P2, P3, P4, P5]) Curry2(p0 P0, p1 P1) CtxFunc4Pair[R1, R2, P2, P3, P4, P5] {
+	return func(ctx context.Context, p2 P2, p3 P3, p4 P4, p5 P5) (R1, R2) {
+		return f(ctx, p0, p1, p2, p3, p4, p5)
+	}
+}
+
+// Curry1 returns a CtxFunc5Pair[R1, R2, P1, P2, P3, P4, P5] with the first argument of the CtxFunc6Pair bound.
+func (f CtxFunc6Pair[R1, R2, P0, P1, P2, P3, P4, P5]) Curry1(p0 P0) CtxFunc5Pair[R1, R2, P1, P2, P3, P4, P5] {
+	return func(ctx context.Context, p1 P1, p2 P2, p3 P3, p4 P4, p5 P5) (R1, R2) {
+		return f(ctx, p0, p1, p2, p3, p4, p5)
+	}
+}
@@ -1,13 +1,14 @@
-//go:generate go run ./generator -arity 10
+// Code generated by powerfunc/generator from func.go.tmpl. DO NOT EDIT.
 
 package powerfunc
 
 import (
+	"context"
 	"fmt"
 	"time"
 )
 
-// Func6 is a function that takes 0 arguments and returns no values.
+// Func6 is a function that takes 6 arguments and returns no values.
 type Func6[P0, P1, P2, P3, P4, P5 any] func(p0 P0, p1 P1, p2 P2, p3 P3, p4 P4, p5 P5)
 
 // Exec executes the Function.
@@ -20,10 +21,15 @@ func (f Func6[P0, P1, P2, P3, P4, P5]) Exec(p0 P0, p1 P1, p2 P2, p3 P3, p4 P4, p
 // Timing returns a Func6 that will log the execution time of the Func6.
 // If no loggers are provided, the default logger (fmt.Println) will be used.
 func (f Func6[P0, P1, P2, P3, P4, P5]) Timing(loggers ...func(d time.Duration)) Func6[P0, P1, P2, P3, P4, P5] {
+	return f.TimingWithClock(RealClock(), loggers...)
+}
+
+// TimingWithClock is like Timing, but measures the execution time with clock.
+func (f Func6[P0, P1, P2, P3, P4, P5]) TimingWithClock(clock Clock, loggers ...func(d time.Duration)) Func6[P0, P1, P2, P3, P4, P5] {
 	return func(p0 P0, p1 P1, p2 P2, p3 P3, p4 P4, p5 P5) {
-		start := time.Now()
+		start := clock.Now()
 		defer func() {
-			dur := time.Since(start)
+			dur := clock.Since(start)
 			for _, logger := range loggers {
 				logger(dur)
 			}
@@ -46,45 +52,96 @@ func (f Func6[P0, P1, P2, P3, P4, P5]) Fallible() Func6Error[P0, P1, P2, P3, P4,
 	}
 }
 
+// Debounce returns a Func6 that delays its execution until wait has elapsed
+// since the last call, along with the Debouncer controlling it.
+// Only the most recent call is kept and eventually invoked.
+func (f Func6[P0, P1, P2, P3, P4, P5]) Debounce(wait time.Duration, opts ...DebounceOption) (Func6[P0, P1, P2, P3, P4, P5], *Debouncer) {
+	d := newDebouncer(wait, opts...)
+	return func(p0 P0, p1 P1, p2 P2, p3 P3, p4 P4, p5 P5) {
+		d.call(func() {
+			f(p0, p1, p2, p3, p4, p5)
+		})
+	}, d
+}
+
+// Throttle returns a Func6 that executes at most once per interval, along
+// with the Debouncer controlling it.
+// The first call is invoked immediately, and the most recent call made
+// during the interval is invoked at the end of it.
+// The options, e.g. DebounceClock, apply to the underlying Debouncer.
+func (f Func6[P0, P1, P2, P3, P4, P5]) Throttle(interval time.Duration, opts ...DebounceOption) (Func6[P0, P1, P2, P3, P4, P5], *Debouncer) {
+	d := newThrottler(interval, opts...)
+	return func(p0 P0, p1 P1, p2 P2, p3 P3, p4 P4, p5 P5) {
+		d.call(func() {
+			f(p0, p1, p2, p3, p4, p5)
+		})
+	}, d
+}
+
+// Once returns a Func6 that only executes the first time it is called,
+// along with the Once controlling it.
+func (f Func6[P0, P1, P2, P3, P4, P5]) Once(opts ...OnceOption) (Func6[P0, P1, P2, P3, P4, P5], *Once) {
+	o := newOnce(opts...)
+	return func(p0 P0, p1 P1, p2 P2, p3 P3, p4 P4, p5 P5) {
+		_, _ = o.do(context.Background(), false, func() (any, error) {
+			f(p0, p1, p2, p3, p4, p5)
+			return nil, nil
+		})
+	}, o
+}
+
+// Lazy returns a Func6 that executes the first time it is called,
+// along with the Once controlling it.
+// A Func6 cannot fail, so Lazy behaves like Once unless it panics, in which
+// case the next call executes it again.
+func (f Func6[P0, P1, P2, P3, P4, P5]) Lazy(opts ...OnceOption) (Func6[P0, P1, P2, P3, P4, P5], *Once) {
+	o := newLazy(opts...)
+	return func(p0 P0, p1 P1, p2 P2, p3 P3, p4 P4, p5 P5) {
+		_, _ = o.do(context.Background(), false, func() (any, error) {
+			f(p0, p1, p2, p3, p4, p5)
+			return nil, nil
+		})
+	}, o
+}
 
+// Curry6 returns a Func with the first 6 arguments of the Func6 bound.
 func (f Func6[P0, P1, P2, P3, P4, P5]) Curry6(p0 P0, p1 P1, p2 P2, p3 P3, p4 P4, p5 P5) Func {
-	return func()  {
+	return func() {
 		f(p0, p1, p2, p3, p4, p5)
 	}
 }
-	
 
+// Curry5 returns a Func1[P5] with the first 5 arguments of the Func6 bound.
 func (f Func6[P0, P1, P2, P3, P4, P5]) Curry5(p0 P0, p1 P1, p2 P2, p3 P3, p4 P4) Func1[P5] {
-	return func(p5 P5)  {
+	return func(p5 P5) {
 		f(p0, p1, p2, p3, p4, p5)
 	}
 }
-	
 
+// Curry4 returns a Func2[P4, P5] with the first 4 arguments of the Func6 bound.
 func (f Func6[P0, P1, P2, P3, P4, P5]) Curry4(p0 P0, p1 P1, p2 P2, p3 P3) Func2[P4, P5] {
-	return func(p4 P4, p5 P5)  {
+	return func(p4 P4, p5 P5) {
 		f(p0, p1, p2, p3, p4, p5)
 	}
 }
-	
 
+// Curry3 returns a Func3[P3, P4, P5] with the first 3 arguments of the Func6 bound.
 func (f Func6[P0, P1, P2, P3, P4, P5]) Curry3(p0 P0, p1 P1, p2 P2) Func3[P3, P4, P5] {
-	return func(p3 P3, p4 P4, p5 P5)  {
+	return func(p3 P3, p4 P4, p5 P5) {
 		f(p0, p1, p2, p3, p4, p5)
 	}
 }
-	
 
+// Curry2 returns a Func4[P2, P3, P4, P5] with the first 2 arguments of the Func6 bound.
 func (f Func6[P0, P1, P2, P3, P4, P5]) Curry2(p0 P0, p1 P1) Func4[P2, P3, P4, P5] {
-	return func(p2 P2, p3 P3, p4 P4, p5 P5)  {
+	return func(p2 P2, p3 P3, p4 P4, p5 P5) {
 		f(p0, p1, p2, p3, p4, p5)
 	}
 }
-	
 
+// Curry1 returns a Func5[P1, P2, P3, P4, P5] with the first argument of the Func6 bound.
 func (f Func6[P0, P1, P2, P3, P4, P5]) Curry1(p0 P0) Func5[P1, P2, P3, P4, P5] {
-	return func(p1 P1, p2 P2, p3 P3, p4 P4, p5 P5)  {
+	return func(p1 P1, p2 P2, p3 P3, p4 P4, p5 P5) {
 		f(p0, p1, p2, p3, p4, p5)
 	}
 }
-	
\ No newline at end of file
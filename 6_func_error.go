@@ -1,11 +1,14 @@
+// Code generated by powerfunc/generator from func_error.go.tmpl. DO NOT EDIT.
+
 package powerfunc
 
 import (
+	"context"
 	"fmt"
 	"time"
 )
 
-// Func6Error is a function that takes 0 arguments and returns an error.
+// Func6Error is a function that takes 6 arguments and returns an error.
 type Func6Error[P0, P1, P2, P3, P4, P5 any] func(p0 P0, p1 P1, p2 P2, p3 P3, p4 P4, p5 P5) error
 
 // Exec executes the function and returns the error.
@@ -15,13 +18,18 @@ func (f Func6Error[P0, P1, P2, P3, P4, P5]) Exec(p0 P0, p1 P1, p2 P2, p3 P3, p4
 	return f(p0, p1, p2, p3, p4, p5)
 }
 
-// Timing returns a Func6 that will log the execution time of the Func6.
+// Timing returns a Func6Error that will log the execution time of the Func6Error.
 // If no loggers are provided, the default logger (fmt.Println) will be used.
 func (f Func6Error[P0, P1, P2, P3, P4, P5]) Timing(loggers ...func(d time.Duration)) Func6Error[P0, P1, P2, P3, P4, P5] {
+	return f.TimingWithClock(RealClock(), loggers...)
+}
+
+// TimingWithClock is like Timing, but measures the execution time with clock.
+func (f Func6Error[P0, P1, P2, P3, P4, P5]) TimingWithClock(clock Clock, loggers ...func(d time.Duration)) Func6Error[P0, P1, P2, P3, P4, P5] {
 	return func(p0 P0, p1 P1, p2 P2, p3 P3, p4 P4, p5 P5) error {
-		start := time.Now()
+		start := clock.Now()
 		defer func() {
-			dur := time.Since(start)
+			dur := clock.Since(start)
 			for _, logger := range loggers {
 				logger(dur)
 			}
@@ -34,20 +42,15 @@ func (f Func6Error[P0, P1, P2, P3, P4, P5]) Timing(loggers ...func(d time.Durati
 	}
 }
 
-// Retry returns a Function that will retry the Function until it returns
-// a nil error or the tryAgain function returns false.
-func (f Func6Error[P0, P1, P2, P3, P4, P5]) Retry(tryAgain func(attempts int, err error) bool) Func6Error[P0, P1, P2, P3, P4, P5] {
+// Retry returns a Func6Error that will retry the Func6Error until it returns
+// a nil error or the tryAgain function returns false, in which case it
+// returns a *RetryError holding the errors of all the attempts.
+// The options set the backoff between attempts and hooks, e.g. OnRetry.
+func (f Func6Error[P0, P1, P2, P3, P4, P5]) Retry(tryAgain func(attempts int, err error) bool, opts ...RetryOption) Func6Error[P0, P1, P2, P3, P4, P5] {
 	return func(p0 P0, p1 P1, p2 P2, p3 P3, p4 P4, p5 P5) error {
-		var err error
-		attempts := 1
-		for {
-			err = f(p0, p1, p2, p3, p4, p5)
-			if err == nil || !tryAgain(attempts, err) {
-				break
-			}
-			attempts++
-		}
-		return err
+		return retryNoContext(tryAgain, opts, func() error {
+			return f(p0, p1, p2, p3, p4, p5)
+		})
 	}
 }
 
@@ -72,45 +75,103 @@ func (f Func6Error[P0, P1, P2, P3, P4, P5]) OnErr(msg string) Func6Error[P0, P1,
 	}
 }
 
+// Once returns a Func6Error that only executes the first time it is called
+// and returns the cached error afterwards, along with the Once controlling it.
+// Use OnceRetryOnError or OnceBackoff to only cache a success.
+func (f Func6Error[P0, P1, P2, P3, P4, P5]) Once(opts ...OnceOption) (Func6Error[P0, P1, P2, P3, P4, P5], *Once) {
+	o := newOnce(opts...)
+	return func(p0 P0, p1 P1, p2 P2, p3 P3, p4 P4, p5 P5) error {
+		_, err := o.do(context.Background(), false, func() (any, error) {
+			return nil, f(p0, p1, p2, p3, p4, p5)
+		})
+		return err
+	}, o
+}
+
+// Lazy returns a Func6Error that executes until it succeeds once, along
+// with the Once controlling it.
+// Failures are not cached, unless a backoff is provided with OnceBackoff.
+func (f Func6Error[P0, P1, P2, P3, P4, P5]) Lazy(opts ...OnceOption) (Func6Error[P0, P1, P2, P3, P4, P5], *Once) {
+	o := newLazy(opts...)
+	return func(p0 P0, p1 P1, p2 P2, p3 P3, p4 P4, p5 P5) error {
+		_, err := o.do(context.Background(), false, func() (any, error) {
+			return nil, f(p0, p1, p2, p3, p4, p5)
+		})
+		return err
+	}, o
+}
+
+// InjectFaults returns a Func6Error injecting the errors, panics and latency
+// configured by cfg in its calls, to test how the callers cope with them.
+// See FaultConfig, and DisableFaults for the global kill-switch.
+// The faults cannot be enabled per request with OptIn, as there is no
+// context.
+func (f Func6Error[P0, P1, P2, P3, P4, P5]) InjectFaults(cfg FaultConfig) Func6Error[P0, P1, P2, P3, P4, P5] {
+	fi := newFaultInjector(cfg)
+	return func(p0 P0, p1 P1, p2 P2, p3 P3, p4 P4, p5 P5) error {
+		if err := fi.inject(context.Background(), p0, p1, p2, p3, p4, p5); err != nil {
+			return err
+		}
+		return f(p0, p1, p2, p3, p4, p5)
+	}
+}
+
+// WithTimeout returns a Func6Error that runs the Func6Error in its own
+// goroutine and returns a *TimeoutError, matching ErrTimeout, if it does not
+// return within timeout. The goroutine is then abandoned: it keeps running
+// until the Func6Error returns, and is counted by Abandoned.
+// Prefer the WithTimeout method of a function taking a context, which can
+// actually be cancelled.
+func (f Func6Error[P0, P1, P2, P3, P4, P5]) WithTimeout(timeout time.Duration, opts ...TimeoutOption) Func6Error[P0, P1, P2, P3, P4, P5] {
+	return func(p0 P0, p1 P1, p2 P2, p3 P3, p4 P4, p5 P5) error {
+		var err error
+		if terr := callWithTimeout(timeout, opts, func() {
+			err = f(p0, p1, p2, p3, p4, p5)
+		}); terr != nil {
+			return terr
+		}
+		return err
+	}
+}
 
+// Curry6 returns a FuncError with the first 6 arguments of the Func6Error bound.
 func (f Func6Error[P0, P1, P2, P3, P4, P5]) Curry6(p0 P0, p1 P1, p2 P2, p3 P3, p4 P4, p5 P5) FuncError {
 	return func() error {
 		return f(p0, p1, p2, p3, p4, p5)
 	}
 }
-	
 
+// Curry5 returns a Func1Error[P5] with the first 5 arguments of the Func6Error bound.
 func (f Func6Error[P0, P1, P2, P3, P4, P5]) Curry5(p0 P0, p1 P1, p2 P2, p3 P3, p4 P4) Func1Error[P5] {
 	return func(p5 P5) error {
 		return f(p0, p1, p2, p3, p4, p5)
 	}
 }
-	
 
+// Curry4 returns a Func2Error[P4, P5] with the first 4 arguments of the Func6Error bound.
 func (f Func6Error[P0, P1, P2, P3, P4, P5]) Curry4(p0 P0, p1 P1, p2 P2, p3 P3) Func2Error[P4, P5] {
 	return func(p4 P4, p5 P5) error {
 		return f(p0, p1, p2, p3, p4, p5)
 	}
 }
-	
 
+// Curry3 returns a Func3Error[P3, P4, P5] with the first 3 arguments of the Func6Error bound.
 func (f Func6Error[P0, P1, P2, P3, P4, P5]) Curry3(p0 P0, p1 P1, p2 P2) Func3Error[P3, P4, P5] {
 	return func(p3 P3, p4 P4, p5 P5) error {
 		return f(p0, p1, p2, p3, p4, p5)
 	}
 }
-	
 
+// Curry2 returns a Func4Error[P2, P3, P4, P5] with the first 2 arguments of the Func6Error bound.
 func (f Func6Error[P0, P1, P2, P3, P4, P5]) Curry2(p0 P0, p1 P1) Func4Error[P2, P3, P4, P5] {
 	return func(p2 P2, p3 P3, p4 P4, p5 P5) error {
 		return f(p0, p1, p2, p3, p4, p5)
 	}
 }
-	
 
+// Curry1 returns a Func5Error[P1, P2, P3, P4, P5] with the first argument of the Func6Error bound.
 func (f Func6Error[P0, P1, P2, P3, P4, P5]) Curry1(p0 P0) Func5Error[P1, P2, P3, P4, P5] {
 	return func(p1 P1, p2 P2, p3 P3, p4 P4, p5 P5) error {
 		return f(p0, p1, p2, p3, p4, p5)
 	}
 }
-	
\ No newline at end of file
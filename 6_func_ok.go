@@ -0,0 +1,183 @@
+// Code generated by powerfunc/generator from func_ok.go.tmpl. DO NOT EDIT.
+
+package powerfunc
+
+import (
+	"fmt"
+	"time"
+)
+
+// Func6Ok is a function that takes 6 arguments and returns a value and a
+// boolean reporting whether the value is valid, like a map lookup.
+type Func6Ok[R, P0, P1, P2, P3, P4, P5 any] func(p0 P0, p1 P1, p2 P2, p3 P3, p4 P4, p5 P5) (R, bool)
+
+// Exec executes the function.
+// Convenience method to remove some of the confusion that
+// `f.SomeMethod()()` can bring.
+func (f Func6Ok[R, P0, P1, P2, P3, P4, P5]) Exec(p0 P0, p1 P1, p2 P2, p3 P3, p4 P4, p5 P5) (R, bool) {
+	return f(p0, p1, p2, p3, p4, p5)
+}
+
+// Timing returns a Func6Ok that will log the execution time of the Func6Ok.
+// If no loggers are provided, the default logger (fmt.Println) will be used.
+func (f Func6Ok[R, P0, P1, P2, P3, P4, P5]) Timing(loggers ...func(d time.Duration)) Func6Ok[R, P0, P1, P2, P3, P4, P5] {
+	return f.TimingWithClock(RealClock(), loggers...)
+}
+
+// TimingWithClock is like Timing, but measures the execution time with clock.
+func (f Func6Ok[R, P0, P1, P2, P3, P4, P5]) TimingWithClock(clock Clock, loggers ...func(d time.Duration)) Func6Ok[R, P0, P1, P2, P3, P4, P5] {
+	return func(p0 P0, p1 P1, p2 P2, p3 P3, p4 P4, p5 P5) (R, bool) {
+		start := clock.Now()
+		defer func() {
+			dur := clock.Since(start)
+			for _, logger := range loggers {
+				logger(dur)
+			}
+			if len(loggers) == 0 {
+				// Default logger
+				fmt.Println(dur)
+			}
+		}()
+		return f(p0, p1, p2, p3, p4, p5)
+	}
+}
+
+// Retry returns a Func6Ok that will retry the Func6Ok until it returns
+// true or the tryAgain function returns false.
+// The attempts that returned false fail with a placeholder error, given to
+// tryAgain and to the hooks of the options, e.g. OnRetry, so that any
+// RetryPolicy can be used.
+func (f Func6Ok[R, P0, P1, P2, P3, P4, P5]) Retry(tryAgain RetryPolicy, opts ...RetryOption) Func6Ok[R, P0, P1, P2, P3, P4, P5] {
+	return func(p0 P0, p1 P1, p2 P2, p3 P3, p4 P4, p5 P5) (R, bool) {
+		var v R
+		var ok bool
+		err := retryNoContext(tryAgain, opts, func() error {
+			v, ok = f(p0, p1, p2, p3, p4, p5)
+			if !ok {
+				return errNotOk
+			}
+			return nil
+		})
+		return v, err == nil
+	}
+}
+
+// Must returns a Func6Value that will panic if the Func6Ok returns false.
+func (f Func6Ok[R, P0, P1, P2, P3, P4, P5]) Must() Func6Value[R, P0, P1, P2, P3, P4, P5] {
+	return func(p0 P0, p1 P1, p2 P2, p3 P3, p4 P4, p5 P5) R {
+		v, ok := f(p0, p1, p2, p3, p4, p5)
+		if !ok {
+			panic("powerfunc: Func6Ok returned false")
+		}
+		return v
+	}
+}
+
+// Fallback returns a Func6Value that will return the provided value if the
+// Func6Ok returns false.
+func (f Func6Ok[R, P0, P1, P2, P3, P4, P5]) Fallback(val R) Func6Value[R, P0, P1, P2, P3, P4, P5] {
+	return func(p0 P0, p1 P1, p2 P2, p3 P3, p4 P4, p5 P5) R {
+		v, ok := f(p0, p1, p2, p3, p4, p5)
+		if !ok {
+			return val
+		}
+		return v
+	}
+}
+
+// OrElse returns a Func6Value that will return the provided value if the
+// Func6Ok returns false. It is an alias of Fallback.
+func (f Func6Ok[R, P0, P1, P2, P3, P4, P5]) OrElse(val R) Func6Value[R, P0, P1, P2, P3, P4, P5] {
+	return f.Fallback(val)
+}
+
+// OrElseGet returns a Func6Value that will call elsef if the Func6Ok
+// returns false.
+func (f Func6Ok[R, P0, P1, P2, P3, P4, P5]) OrElseGet(elsef Func6Value[R, P0, P1, P2, P3, P4, P5]) Func6Value[R, P0, P1, P2, P3, P4, P5] {
+	return func(p0 P0, p1 P1, p2 P2, p3 P3, p4 P4, p5 P5) R {
+		v, ok := f(p0, p1, p2, p3, p4, p5)
+		if !ok {
+			return elsef(p0, p1, p2, p3, p4, p5)
+		}
+		return v
+	}
+}
+
+// ToResult transforms a Func6Ok into a Func6Result returning the provided
+// error when the Func6Ok returns false.
+func (f Func6Ok[R, P0, P1, P2, P3, P4, P5]) ToResult(err error) Func6Result[R, P0, P1, P2, P3, P4, P5] {
+	return func(p0 P0, p1 P1, p2 P2, p3 P3, p4 P4, p5 P5) (R, error) {
+		v, ok := f(p0, p1, p2, p3, p4, p5)
+		if !ok {
+			return v, err
+		}
+		return v, nil
+	}
+}
+
+// Filter returns a Func6Ok that will return false if the value returned by
+// the Func6Ok does not satisfy keep.
+func (f Func6Ok[R, P0, P1, P2, P3, P4, P5]) Filter(keep func(R) bool) Func6Ok[R, P0, P1, P2, P3, P4, P5] {
+	return func(p0 P0, p1 P1, p2 P2, p3 P3, p4 P4, p5 P5) (R, bool) {
+		v, ok := f(p0, p1, p2, p3, p4, p5)
+		if !ok || !keep(v) {
+			var zero R
+			return zero, false
+		}
+		return v, true
+	}
+}
+
+// Map applies the provided function to the value returned by the Func6Ok,
+// if it returns true.
+func (f Func6Ok[R, P0, P1, P2, P3, P4, P5]) Map(fn func(R) R) Func6Ok[R, P0, P1, P2, P3, P4, P5] {
+	return func(p0 P0, p1 P1, p2 P2, p3 P3, p4 P4, p5 P5) (R, bool) {
+		v, ok := f(p0, p1, p2, p3, p4, p5)
+		if !ok {
+			return v, false
+		}
+		return fn(v), true
+	}
+}
+
+// Curry6 returns a FuncOk[R] with the first 6 arguments of the Func6Ok bound.
+func (f Func6Ok[R, P0, P1, P2, P3, P4, P5]) Curry6(p0 P0, p1 P1, p2 P2, p3 P3, p4 P4, p5 P5) FuncOk[R] {
+	return func() (R, bool) {
+		return f(p0, p1, p2, p3, p4, p5)
+	}
+}
+
+// Curry5 returns a Func1Ok[R, P5] with the first 5 arguments of the Func6Ok bound.
+func (f Func6Ok[R, P0, P1, P2, P3, P4, P5]) Curry5(p0 P0, p1 P1, p2 P2, p3 P3, p4 P4) Func1Ok[R, P5] {
+	return func(p5 P5) (R, bool) {
+		return f(p0, p1, p2, p3, p4, p5)
+	}
+}
+
+// Curry4 returns a Func2Ok[R, P4, P5] with the first 4 arguments of the Func6Ok bound.
+func (f Func6Ok[R, P0, P1, P2, P3, P4, P5]) Curry4(p0 P0, p1 P1, p2 P2, p3 P3) Func2Ok[R, P4, P5] {
+	return func(p4 P4, p5 P5) (R, bool) {
+		return f(p0, p1, p2, p3, p4, p5)
+	}
+}
+
+// Curry3 returns a Func3Ok[R, P3, P4, P5] with the first 3 arguments of the Func6Ok bound.
+func (f Func6Ok[R, P0, P1, P2, P3, P4, P5]) Curry3(p0 P0, p1 P1, p2 P2) Func3Ok[R, P3, P4, P5] {
+	return func(p3 P3, p4 P4, p5 P5) (R, bool) {
+		return f(p0, p1, p2, p3, p4, p5)
+	}
+}
+
+// Curry2 returns a Func4Ok[R, P2, P3, P4, P5] with the first 2 arguments of the Func6Ok bound.
+func (f Func6Ok[R, P0, P1, P2, P3, P4, P5]) Curry2(p0 P0, p1 P1) Func4Ok[R, P2, P3, P4, P5] {
+	return func(p2 P2, p3 P3, p4 P4, p5 P5) (R, bool) {
+		return f(p0, p1, p2, p3, p4, p5)
+	}
+}
+
+// Curry1 returns a Func5Ok[R, P1, P2, P3, P4, P5] with the first argument of the Func6Ok bound.
+func (f Func6Ok[R, P0, P1, P2, P3, P4, P5]) Curry1(p0 P0) Func5Ok[R, P1, P2, P3, P4, P5] {
+	return func(p1 P1, p2 P2, p3 P3, p4 P4, p5 P5) (R, bool) {
+		return f(p0, p1, p2, p3, p4, p5)
+	}
+}
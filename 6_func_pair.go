@@ -0,0 +1,94 @@
+// Code generated by powerfunc/generator from func_pair.go.tmpl. DO NOT EDIT.
+
+package powerfunc
+
+import (
+	"fmt"
+	"time"
+)
+
+// Func6Pair is a function that takes 6 arguments and returns two values.
+type Func6Pair[R1, R2, P0, P1, P2, P3, P4, P5 any] func(p0 P0, p1 P1, p2 P2, p3 P3, p4 P4, p5 P5) (R1, R2)
+
+// Exec executes the function.
+// Convenience method to remove some of the confusion that
+// `f.SomeMethod()()` can bring.
+func (f Func6Pair[R1, R2, P0, P1, P2, P3, P4, P5]) Exec(p0 P0, p1 P1, p2 P2, p3 P3, p4 P4, p5 P5) (R1, R2) {
+	return f(p0, p1, p2, p3, p4, p5)
+}
+
+// Timing returns a Func6Pair that will log the execution time of the Func6Pair.
+// If no loggers are provided, the default logger (fmt.Println) will be used.
+func (f Func6Pair[R1, R2, P0, P1, P2, P3, P4, P5]) Timing(loggers ...func(d time.Duration)) Func6Pair[R1, R2, P0, P1, P2, P3, P4, P5] {
+	return f.TimingWithClock(RealClock(), loggers...)
+}
+
+// TimingWithClock is like Timing, but measures the execution time with clock.
+func (f Func6Pair[R1, R2, P0, P1, P2, P3, P4, P5]) TimingWithClock(clock Clock, loggers ...func(d time.Duration)) Func6Pair[R1, R2, P0, P1, P2, P3, P4, P5] {
+	return func(p0 P0, p1 P1, p2 P2, p3 P3, p4 P4, p5 P5) (R1, R2) {
+		start := clock.Now()
+		defer func() {
+			dur := clock.Since(start)
+			for _, logger := range loggers {
+				logger(dur)
+			}
+			if len(loggers) == 0 {
+				// Default logger
+				fmt.Println(dur)
+			}
+		}()
+		return f(p0, p1, p2, p3, p4, p5)
+	}
+}
+
+// Fallible transforms a Func6Pair into a Func6PairResult.
+// The returned Func6PairResult will never return an error.
+// Useful when passing a Func6Pair to a function that expects a Func6PairResult.
+func (f Func6Pair[R1, R2, P0, P1, P2, P3, P4, P5]) Fallible() Func6PairResult[R1, R2, P0, P1, P2, P3, P4, P5] {
+	return func(p0 P0, p1 P1, p2 P2, p3 P3, p4 P4, p5 P5) (R1, R2, error) {
+		v1, v2 := f(p0, p1, p2, p3, p4, p5)
+		return v1, v2, nil
+	}
+}
+
+// Curry6 returns a FuncPair[R1, R2] with the first 6 arguments of the Func6Pair bound.
+func (f Func6Pair[R1, R2, P0, P1, P2, P3, P4, P5]) Curry6(p0 P0, p1 P1, p2 P2, p3 P3, p4 P4, p5 P5) FuncPair[R1, R2] {
+	return func() (R1, R2) {
+		return f(p0, p1, p2, p3, p4, p5)
+	}
+}
+
+// Curry5 returns a Func1Pair[R1, R2, P5] with the first 5 arguments of the Func6Pair bound.
+func (f Func6Pair[R1, R2, P0, P1, P2, P3, P4, P5]) Curry5(p0 P0, p1 P1, p2 P2, p3 P3, p4 P4) Func1Pair[R1, R2, P5] {
+	return func(p5 P5) (R1, R2) {
+		return f(p0, p1, p2, p3, p4, p5)
+	}
+}
+
+// Curry4 returns a Func2Pair[R1, R2, P4, P5] with the first 4 arguments of the Func6Pair bound.
+func (f Func6Pair[R1, R2, P0, P1, P2, P3, P4, P5]) Curry4(p0 P0, p1 P1, p2 P2, p3 P3) Func2Pair[R1, R2, P4, P5] {
+	return func(p4 P4, p5 P5) (R1, R2) {
+		return f(p0, p1, p2, p3, p4, p5)
+	}
+}
+
+// Curry3 returns a Func3Pair[R1, R2, P3, P4, P5] with the first 3 arguments of the Func6Pair bound.
+func (f Func6Pair[R1, R2, P0, P1, P2, P3, P4, P5]) Curry3(p0 P0, p1 P1, p2 P2) Func3Pair[R1, R2, P3, P4, P5] {
+	return func(p3 P3, p4 P4, p5 P5) (R1, R2) {
+		return f(p0, p1, p2, p3, p4, p5)
+	}
+}
+
+// Curry2 returns a Func4Pair[R1, R2, P2, P3, P4, P5] with the first 2 arguments of the Func6Pair bound.
+func (f Func6Pair[R1, R2, P0, P1, P2, P3, P4, P5]) Curry2(p0 P0, p1 P1) Func4Pair[R1, R2, P2, P3, P4, P5] {
+	return func(p2 P2, p3 P3, p4 P4, p5 P5) (R1, R2) {
+		return f(p0, p1, p2, p3, p4, p5)
+	}
+}
+
+// Curry1 returns a Func5Pair[R1, R2, P1, P2, P3, P4, P5] with the first argument of the Func6Pair bound.
+func (f Func6Pair[R1, R2, P0, P1, P2, P3, P4, P5]) Curry1(p0 P0) Func5Pair[R1, R2, P1, P2, P3, P4, P5] {
+	return func(p1 P1, p2 P2, p3 P3, p4 P4, p5 P5) (R1, R2) {
+		return f(p0, p1, p2, p3, p4, p5)
+	}
+}
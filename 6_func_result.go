@@ -1,28 +1,36 @@
+// Code generated by powerfunc/generator from func_result.go.tmpl. DO NOT EDIT.
+
 package powerfunc
 
 import (
+	"context"
 	"fmt"
 	"time"
 )
 
-// Func6Result is a function that takes 0 arguments and returns a
+// Func6Result is a function that takes 6 arguments and returns a
 // value and an error.
-type Func6Result[T, P0, P1, P2, P3, P4, P5 any] func(p0 P0, p1 P1, p2 P2, p3 P3, p4 P4, p5 P5) (T, error)
+type Func6Result[R, P0, P1, P2, P3, P4, P5 any] func(p0 P0, p1 P1, p2 P2, p3 P3, p4 P4, p5 P5) (R, error)
 
 // Exec executes the function.
 // Convenience method to remove some of the confusion that
 // `f.SomeMethod()()` can bring.
-func (f Func6Result[T, P0, P1, P2, P3, P4, P5]) Exec(p0 P0, p1 P1, p2 P2, p3 P3, p4 P4, p5 P5) (T, error) {
+func (f Func6Result[R, P0, P1, P2, P3, P4, P5]) Exec(p0 P0, p1 P1, p2 P2, p3 P3, p4 P4, p5 P5) (R, error) {
 	return f(p0, p1, p2, p3, p4, p5)
 }
 
-// Timing returns a Func6 that will log the execution time of the Func6.
+// Timing returns a Func6Result that will log the execution time of the Func6Result.
 // If no loggers are provided, the default logger (fmt.Println) will be used.
-func (f Func6Result[T, P0, P1, P2, P3, P4, P5]) Timing(loggers ...func(d time.Duration)) Func6Result[T, P0, P1, P2, P3, P4, P5] {
-	return func(p0 P0, p1 P1, p2 P2, p3 P3, p4 P4, p5 P5) (T, error) {
-		start := time.Now()
+func (f Func6Result[R, P0, P1, P2, P3, P4, P5]) Timing(loggers ...func(d time.Duration)) Func6Result[R, P0, P1, P2, P3, P4, P5] {
+	return f.TimingWithClock(RealClock(), loggers...)
+}
+
+// TimingWithClock is like Timing, but measures the execution time with clock.
+func (f Func6Result[R, P0, P1, P2, P3, P4, P5]) TimingWithClock(clock Clock, loggers ...func(d time.Duration)) Func6Result[R, P0, P1, P2, P3, P4, P5] {
+	return func(p0 P0, p1 P1, p2 P2, p3 P3, p4 P4, p5 P5) (R, error) {
+		start := clock.Now()
 		defer func() {
-			dur := time.Since(start)
+			dur := clock.Since(start)
 			for _, logger := range loggers {
 				logger(dur)
 			}
@@ -35,27 +43,25 @@ func (f Func6Result[T, P0, P1, P2, P3, P4, P5]) Timing(loggers ...func(d time.Du
 	}
 }
 
-// Retry returns a Function that will retry the Function until it returns
-// a nil error or the tryAgain function returns false.
-func (f Func6Result[T, P0, P1, P2, P3, P4, P5]) Retry(tryAgain func(attempts int, err error) bool) Func6Result[T, P0, P1, P2, P3, P4, P5] {
-	return func(p0 P0, p1 P1, p2 P2, p3 P3, p4 P4, p5 P5) (T, error) {
-		var v T
-		var err error
-		attempts := 1
-		for {
-			v, err = f(p0, p1, p2, p3, p4, p5)
-			if err == nil || !tryAgain(attempts, err) {
-				break
-			}
-			attempts++
-		}
-		return v, err
+// Retry returns a Func6Result that will retry the Func6Result until it returns
+// a nil error or the tryAgain function returns false, in which case it
+// returns a *RetryError holding the errors of all the attempts.
+// The options set the backoff between attempts and hooks, e.g. OnRetry.
+func (f Func6Result[R, P0, P1, P2, P3, P4, P5]) Retry(tryAgain func(attempts int, err error) bool, opts ...RetryOption) Func6Result[R, P0, P1, P2, P3, P4, P5] {
+	return func(p0 P0, p1 P1, p2 P2, p3 P3, p4 P4, p5 P5) (R, error) {
+		var r0 R
+		err := retryNoContext(tryAgain, opts, func() error {
+			var err error
+			r0, err = f(p0, p1, p2, p3, p4, p5)
+			return err
+		})
+		return r0, err
 	}
 }
 
 // Must returns a Func6Value that will panic if the Func6Result returns an error.
-func (f Func6Result[T, P0, P1, P2, P3, P4, P5]) Must() Func6Value[T, P0, P1, P2, P3, P4, P5] {
-	return func(p0 P0, p1 P1, p2 P2, p3 P3, p4 P4, p5 P5) T {
+func (f Func6Result[R, P0, P1, P2, P3, P4, P5]) Must() Func6Value[R, P0, P1, P2, P3, P4, P5] {
+	return func(p0 P0, p1 P1, p2 P2, p3 P3, p4 P4, p5 P5) R {
 		v, err := f(p0, p1, p2, p3, p4, p5)
 		if err != nil {
 			panic(err)
@@ -66,8 +72,8 @@ func (f Func6Result[T, P0, P1, P2, P3, P4, P5]) Must() Func6Value[T, P0, P1, P2,
 
 // OnErr returns a Func6Result that will wrap the error returned by the Func6Result
 // with the provided message.
-func (f Func6Result[T, P0, P1, P2, P3, P4, P5]) OnErr(msg string) Func6Result[T, P0, P1, P2, P3, P4, P5] {
-	return func(p0 P0, p1 P1, p2 P2, p3 P3, p4 P4, p5 P5) (T, error) {
+func (f Func6Result[R, P0, P1, P2, P3, P4, P5]) OnErr(msg string) Func6Result[R, P0, P1, P2, P3, P4, P5] {
+	return func(p0 P0, p1 P1, p2 P2, p3 P3, p4 P4, p5 P5) (R, error) {
 		v, err := f(p0, p1, p2, p3, p4, p5)
 		if err != nil {
 			return v, fmt.Errorf("%s: %w", msg, err)
@@ -78,8 +84,8 @@ func (f Func6Result[T, P0, P1, P2, P3, P4, P5]) OnErr(msg string) Func6Result[T,
 
 // Map applies the provided function to the value returned by the Func6Result,
 // if there is no error.
-func (f Func6Result[T, P0, P1, P2, P3, P4, P5]) Map(fn func(T) T) Func6Result[T, P0, P1, P2, P3, P4, P5] {
-	return func(p0 P0, p1 P1, p2 P2, p3 P3, p4 P4, p5 P5) (T, error) {
+func (f Func6Result[R, P0, P1, P2, P3, P4, P5]) Map(fn func(R) R) Func6Result[R, P0, P1, P2, P3, P4, P5] {
+	return func(p0 P0, p1 P1, p2 P2, p3 P3, p4 P4, p5 P5) (R, error) {
 		v, err := f(p0, p1, p2, p3, p4, p5)
 		if err != nil {
 			return v, err
@@ -90,8 +96,8 @@ func (f Func6Result[T, P0, P1, P2, P3, P4, P5]) Map(fn func(T) T) Func6Result[T,
 
 // MapErr applies the provided function to the error returned by the Func6Result,
 // if there is an error.
-func (f Func6Result[T, P0, P1, P2, P3, P4, P5]) MapErr(fn func(error) error) Func6Result[T, P0, P1, P2, P3, P4, P5] {
-	return func(p0 P0, p1 P1, p2 P2, p3 P3, p4 P4, p5 P5) (T, error) {
+func (f Func6Result[R, P0, P1, P2, P3, P4, P5]) MapErr(fn func(error) error) Func6Result[R, P0, P1, P2, P3, P4, P5] {
+	return func(p0 P0, p1 P1, p2 P2, p3 P3, p4 P4, p5 P5) (R, error) {
 		v, err := f(p0, p1, p2, p3, p4, p5)
 		if err != nil {
 			return v, fn(err)
@@ -102,8 +108,8 @@ func (f Func6Result[T, P0, P1, P2, P3, P4, P5]) MapErr(fn func(error) error) Fun
 
 // Fallback returns a Func6Value that will return the provided value if the
 // Func6Result returns an error.
-func (f Func6Result[T, P0, P1, P2, P3, P4, P5]) Fallback(val T) Func6Value[T, P0, P1, P2, P3, P4, P5] {
-	return func(p0 P0, p1 P1, p2 P2, p3 P3, p4 P4, p5 P5) T {
+func (f Func6Result[R, P0, P1, P2, P3, P4, P5]) Fallback(val R) Func6Value[R, P0, P1, P2, P3, P4, P5] {
+	return func(p0 P0, p1 P1, p2 P2, p3 P3, p4 P4, p5 P5) R {
 		v, err := f(p0, p1, p2, p3, p4, p5)
 		if err != nil {
 			return val
@@ -112,45 +118,108 @@ func (f Func6Result[T, P0, P1, P2, P3, P4, P5]) Fallback(val T) Func6Value[T, P0
 	}
 }
 
+// Once returns a Func6Result that only executes the first time it is called
+// and returns the cached result afterwards, along with the Once controlling it.
+// Use OnceRetryOnError or OnceBackoff to only cache a success.
+func (f Func6Result[R, P0, P1, P2, P3, P4, P5]) Once(opts ...OnceOption) (Func6Result[R, P0, P1, P2, P3, P4, P5], *Once) {
+	o := newOnce(opts...)
+	return func(p0 P0, p1 P1, p2 P2, p3 P3, p4 P4, p5 P5) (R, error) {
+		v, err := o.do(context.Background(), false, func() (any, error) {
+			return f(p0, p1, p2, p3, p4, p5)
+		})
+		val, _ := v.(R)
+		return val, err
+	}, o
+}
+
+// Lazy returns a Func6Result that executes until it succeeds once and
+// returns the cached value afterwards, along with the Once controlling it.
+// Failures are not cached, unless a backoff is provided with OnceBackoff.
+func (f Func6Result[R, P0, P1, P2, P3, P4, P5]) Lazy(opts ...OnceOption) (Func6Result[R, P0, P1, P2, P3, P4, P5], *Once) {
+	o := newLazy(opts...)
+	return func(p0 P0, p1 P1, p2 P2, p3 P3, p4 P4, p5 P5) (R, error) {
+		v, err := o.do(context.Background(), false, func() (any, error) {
+			return f(p0, p1, p2, p3, p4, p5)
+		})
+		val, _ := v.(R)
+		return val, err
+	}, o
+}
+
+// InjectFaults returns a Func6Result injecting the errors, panics and latency
+// configured by cfg in its calls, to test how the callers cope with them.
+// See FaultConfig, and DisableFaults for the global kill-switch.
+// The faults cannot be enabled per request with OptIn, as there is no
+// context.
+func (f Func6Result[R, P0, P1, P2, P3, P4, P5]) InjectFaults(cfg FaultConfig) Func6Result[R, P0, P1, P2, P3, P4, P5] {
+	fi := newFaultInjector(cfg)
+	return func(p0 P0, p1 P1, p2 P2, p3 P3, p4 P4, p5 P5) (R, error) {
+		if err := fi.inject(context.Background(), p0, p1, p2, p3, p4, p5); err != nil {
+			var r0 R
+			return r0, err
+		}
+		return f(p0, p1, p2, p3, p4, p5)
+	}
+}
+
+// WithTimeout returns a Func6Result that runs the Func6Result in its own
+// goroutine and returns a *TimeoutError, matching ErrTimeout, if it does not
+// return within timeout. The goroutine is then abandoned: it keeps running
+// until the Func6Result returns, and is counted by Abandoned.
+// Prefer the WithTimeout method of a function taking a context, which can
+// actually be cancelled.
+func (f Func6Result[R, P0, P1, P2, P3, P4, P5]) WithTimeout(timeout time.Duration, opts ...TimeoutOption) Func6Result[R, P0, P1, P2, P3, P4, P5] {
+	return func(p0 P0, p1 P1, p2 P2, p3 P3, p4 P4, p5 P5) (R, error) {
+		var r0 R
+		var err error
+		if terr := callWithTimeout(timeout, opts, func() {
+			r0, err = f(p0, p1, p2, p3, p4, p5)
+		}); terr != nil {
+			var zero R
+			return zero, terr
+		}
+		return r0, err
+	}
+}
 
+// Curry6 returns a FuncResult[R] with the first 6 arguments of the Func6Result bound.
 func (f Func6Result[R, P0, P1, P2, P3, P4, P5]) Curry6(p0 P0, p1 P1, p2 P2, p3 P3, p4 P4, p5 P5) FuncResult[R] {
 	return func() (R, error) {
 		return f(p0, p1, p2, p3, p4, p5)
 	}
 }
-	
 
+// Curry5 returns a Func1Result[R, P5] with the first 5 arguments of the Func6Result bound.
 func (f Func6Result[R, P0, P1, P2, P3, P4, P5]) Curry5(p0 P0, p1 P1, p2 P2, p3 P3, p4 P4) Func1Result[R, P5] {
 	return func(p5 P5) (R, error) {
 		return f(p0, p1, p2, p3, p4, p5)
 	}
 }
-	
 
+// Curry4 returns a Func2Result[R, P4, P5] with the first 4 arguments of the Func6Result bound.
 func (f Func6Result[R, P0, P1, P2, P3, P4, P5]) Curry4(p0 P0, p1 P1, p2 P2, p3 P3) Func2Result[R, P4, P5] {
 	return func(p4 P4, p5 P5) (R, error) {
 		return f(p0, p1, p2, p3, p4, p5)
 	}
 }
-	
 
+// Curry3 returns a Func3Result[R, P3, P4, P5] with the first 3 arguments of the Func6Result bound.
 func (f Func6Result[R, P0, P1, P2, P3, P4, P5]) Curry3(p0 P0, p1 P1, p2 P2) Func3Result[R, P3, P4, P5] {
 	return func(p3 P3, p4 P4, p5 P5) (R, error) {
 		return f(p0, p1, p2, p3, p4, p5)
 	}
 }
-	
 
+// Curry2 returns a Func4Result[R, P2, P3, P4, P5] with the first 2 arguments of the Func6Result bound.
 func (f Func6Result[R, P0, P1, P2, P3, P4, P5]) Curry2(p0 P0, p1 P1) Func4Result[R, P2, P3, P4, P5] {
 	return func(p2 P2, p3 P3, p4 P4, p5 P5) (R, error) {
 		return f(p0, p1, p2, p3, p4, p5)
 	}
 }
-	
 
+// Curry1 returns a Func5Result[R, P1, P2, P3, P4, P5] with the first argument of the Func6Result bound.
 func (f Func6Result[R, P0, P1, P2, P3, P4, P5]) Curry1(p0 P0) Func5Result[R, P1, P2, P3, P4, P5] {
 	return func(p1 P1, p2 P2, p3 P3, p4 P4, p5 P5) (R, error) {
 		return f(p0, p1, p2, p3, p4, p5)
 	}
 }
-	
\ No newline at end of file
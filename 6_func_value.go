@@ -1,27 +1,35 @@
+// Code generated by powerfunc/generator from func_value.go.tmpl. DO NOT EDIT.
+
 package powerfunc
 
 import (
+	"context"
 	"fmt"
 	"time"
 )
 
-// Func6 is a function that takes 0 arguments and returns a value.
-type Func6Value[T, P0, P1, P2, P3, P4, P5 any] func(p0 P0, p1 P1, p2 P2, p3 P3, p4 P4, p5 P5) T
+// Func6Value is a function that takes 6 arguments and returns a value.
+type Func6Value[R, P0, P1, P2, P3, P4, P5 any] func(p0 P0, p1 P1, p2 P2, p3 P3, p4 P4, p5 P5) R
 
 // Exec executes the function.
 // Convenience method to remove some of the confusion that
 // `f.SomeMethod()()` can bring.
-func (f Func6Value[T, P0, P1, P2, P3, P4, P5]) Exec(p0 P0, p1 P1, p2 P2, p3 P3, p4 P4, p5 P5) T {
+func (f Func6Value[R, P0, P1, P2, P3, P4, P5]) Exec(p0 P0, p1 P1, p2 P2, p3 P3, p4 P4, p5 P5) R {
 	return f(p0, p1, p2, p3, p4, p5)
 }
 
-// Timing returns a Func6 that will log the execution time of the Func6.
+// Timing returns a Func6Value that will log the execution time of the Func6Value.
 // If no loggers are provided, the default logger (fmt.Println) will be used.
-func (f Func6Value[T, P0, P1, P2, P3, P4, P5]) Timing(loggers ...func(d time.Duration)) Func6Value[T, P0, P1, P2, P3, P4, P5] {
-	return func(p0 P0, p1 P1, p2 P2, p3 P3, p4 P4, p5 P5) T {
-		start := time.Now()
+func (f Func6Value[R, P0, P1, P2, P3, P4, P5]) Timing(loggers ...func(d time.Duration)) Func6Value[R, P0, P1, P2, P3, P4, P5] {
+	return f.TimingWithClock(RealClock(), loggers...)
+}
+
+// TimingWithClock is like Timing, but measures the execution time with clock.
+func (f Func6Value[R, P0, P1, P2, P3, P4, P5]) TimingWithClock(clock Clock, loggers ...func(d time.Duration)) Func6Value[R, P0, P1, P2, P3, P4, P5] {
+	return func(p0 P0, p1 P1, p2 P2, p3 P3, p4 P4, p5 P5) R {
+		start := clock.Now()
 		defer func() {
-			dur := time.Since(start)
+			dur := clock.Since(start)
 			for _, logger := range loggers {
 				logger(dur)
 			}
@@ -37,51 +45,96 @@ func (f Func6Value[T, P0, P1, P2, P3, P4, P5]) Timing(loggers ...func(d time.Dur
 // Fallible transforms a Func6Value into a Func6Result.
 // The returned Func6Result will never return an error.
 // Useful when passing a Func6Value to a function that expects a Func6Result.
-func (f Func6Value[T, P0, P1, P2, P3, P4, P5]) Fallible() Func6Result[T, P0, P1, P2, P3, P4, P5] {
-	return func(p0 P0, p1 P1, p2 P2, p3 P3, p4 P4, p5 P5) (T, error) {
+func (f Func6Value[R, P0, P1, P2, P3, P4, P5]) Fallible() Func6Result[R, P0, P1, P2, P3, P4, P5] {
+	return func(p0 P0, p1 P1, p2 P2, p3 P3, p4 P4, p5 P5) (R, error) {
 		return f(p0, p1, p2, p3, p4, p5), nil
 	}
 }
 
+// Once returns a Func6Value that only executes the first time it is called
+// and returns the cached value afterwards, along with the Once controlling it.
+func (f Func6Value[R, P0, P1, P2, P3, P4, P5]) Once(opts ...OnceOption) (Func6Value[R, P0, P1, P2, P3, P4, P5], *Once) {
+	o := newOnce(opts...)
+	return func(p0 P0, p1 P1, p2 P2, p3 P3, p4 P4, p5 P5) R {
+		v, _ := o.do(context.Background(), false, func() (any, error) {
+			return f(p0, p1, p2, p3, p4, p5), nil
+		})
+		val, _ := v.(R)
+		return val
+	}, o
+}
+
+// Lazy returns a Func6Value that computes its value the first time it is
+// called and returns the cached value afterwards, along with the Once
+// controlling it.
+func (f Func6Value[R, P0, P1, P2, P3, P4, P5]) Lazy(opts ...OnceOption) (Func6Value[R, P0, P1, P2, P3, P4, P5], *Once) {
+	o := newLazy(opts...)
+	return func(p0 P0, p1 P1, p2 P2, p3 P3, p4 P4, p5 P5) R {
+		v, _ := o.do(context.Background(), false, func() (any, error) {
+			return f(p0, p1, p2, p3, p4, p5), nil
+		})
+		val, _ := v.(R)
+		return val
+	}, o
+}
+
+// WithTimeout returns a Func6Result that runs the Func6Value in its own
+// goroutine and returns a *TimeoutError, matching ErrTimeout, if it does not
+// return within timeout. The goroutine is then abandoned: it keeps running
+// until the Func6Value returns, and is counted by Abandoned.
+// Prefer the WithTimeout method of a function taking a context, which can
+// actually be cancelled.
+func (f Func6Value[R, P0, P1, P2, P3, P4, P5]) WithTimeout(timeout time.Duration, opts ...TimeoutOption) Func6Result[R, P0, P1, P2, P3, P4, P5] {
+	return func(p0 P0, p1 P1, p2 P2, p3 P3, p4 P4, p5 P5) (R, error) {
+		var r0 R
+		if terr := callWithTimeout(timeout, opts, func() {
+			r0 = f(p0, p1, p2, p3, p4, p5)
+		}); terr != nil {
+			var zero R
+			return zero, terr
+		}
+		return r0, nil
+	}
+}
 
+// Curry6 returns a FuncValue[R] with the first 6 arguments of the Func6Value bound.
 func (f Func6Value[R, P0, P1, P2, P3, P4, P5]) Curry6(p0 P0, p1 P1, p2 P2, p3 P3, p4 P4, p5 P5) FuncValue[R] {
 	return func() R {
 		return f(p0, p1, p2, p3, p4, p5)
 	}
 }
-	
 
+// Curry5 returns a Func1Value[R, P5] with the first 5 arguments of the Func6Value bound.
 func (f Func6Value[R, P0, P1, P2, P3, P4, P5]) Curry5(p0 P0, p1 P1, p2 P2, p3 P3, p4 P4) Func1Value[R, P5] {
 	return func(p5 P5) R {
 		return f(p0, p1, p2, p3, p4, p5)
 	}
 }
-	
 
+// Curry4 returns a Func2Value[R, P4, P5] with the first 4 arguments of the Func6Value bound.
 func (f Func6Value[R, P0, P1, P2, P3, P4, P5]) Curry4(p0 P0, p1 P1, p2 P2, p3 P3) Func2Value[R, P4, P5] {
 	return func(p4 P4, p5 P5) R {
 		return f(p0, p1, p2, p3, p4, p5)
 	}
 }
-	
 
+// Curry3 returns a Func3Value[R, P3, P4, P5] with the first 3 arguments of the Func6Value bound.
 func (f Func6Value[R, P0, P1, P2, P3, P4, P5]) Curry3(p0 P0, p1 P1, p2 P2) Func3Value[R, P3, P4, P5] {
 	return func(p3 P3, p4 P4, p5 P5) R {
 		return f(p0, p1, p2, p3, p4, p5)
 	}
 }
-	
 
+// Curry2 returns a Func4Value[R, P2, P3, P4, P5] with the first 2 arguments of the Func6Value bound.
 func (f Func6Value[R, P0, P1, P2, P3, P4, P5]) Curry2(p0 P0, p1 P1) Func4Value[R, P2, P3, P4, P5] {
 	return func(p2 P2, p3 P3, p4 P4, p5 P5) R {
 		return f(p0, p1, p2, p3, p4, p5)
 	}
 }
-	
 
+// Curry1 returns a Func5Value[R, P1, P2, P3, P4, P5] with the first argument of the Func6Value bound.
 func (f Func6Value[R, P0, P1, P2, P3, P4, P5]) Curry1(p0 P0) Func5Value[R, P1, P2, P3, P4, P5] {
 	return func(p1 P1, p2 P2, p3 P3, p4 P4, p5 P5) R {
 		return f(p0, p1, p2, p3, p4, p5)
 	}
 }
-	
\ No newline at end of file
@@ -0,0 +1,260 @@
+// Code generated by powerfunc/generator from ctx_func_ok.go.tmpl. DO NOT EDIT.
+
+package powerfunc
+
+import (
+	"context"
+	"fmt"
+	"time"
+)
+
+type CtxFunc7Ok[R, P0, P1, P2, P3, P4, P5, P6 any] func(ctx context.Context, p0 P0, p1 P1, p2 P2, p3 P3, p4 P4, p5 P5, p6 P6) (R, bool)
+
+func (f CtxFunc7Ok[R, P0, P1, P2, P3, P4, P5, P6]) Exec(ctx context.Context, p0 P0, p1 P1, p2 P2, p3 P3, p4 P4, p5 P5, p6 P6) (R, bool) {
+	return f(ctx, p0, p1, p2, p3, p4, p5, p6)
+}
+
+func (f CtxFunc7Ok[R, P0, P1, P2, P3, P4, P5, P6]) Timing(loggers ...func(d time.Duration)) CtxFunc7Ok[R, P0, P1, P2, P3, P4, P5, P6] {
+	return f.TimingWithClock(RealClock(), loggers...)
+}
+
+// TimingWithClock is like Timing, but measures the execution time with clock.
+func (f CtxFunc7Ok[R, P0, P1, P2, P3, P4, P5, P6]) TimingWithClock(clock Clock, loggers ...func(d time.Duration)) CtxFunc7Ok[R, P0, P1, P2, P3, P4, P5, P6] {
+	return func(ctx context.Context, p0 P0, p1 P1, p2 P2, p3 P3, p4 P4, p5 P5, p6 P6) (R, bool) {
+		start := clock.Now()
+		defer func() {
+			dur := clock.Since(start)
+			for _, logger := range loggers {
+				logger(dur)
+			}
+			if len(loggers) == 0 {
+				// Default logger
+				fmt.Println(dur)
+			}
+		}()
+		return f(ctx, p0, p1, p2, p3, p4, p5, p6)
+	}
+}
+
+func (f CtxFunc7Ok[R, P0, P1, P2, P3, P4, P5, P6]) WithTimeout(timeout time.Duration) CtxFunc7Ok[R, P0, P1, P2, P3, P4, P5, P6] {
+	return func(ctx context.Context, p0 P0, p1 P1, p2 P2, p3 P3, p4 P4, p5 P5, p6 P6) (R, bool) {
+		ctx, cancel := context.WithTimeout(ctx, timeout)
+		defer cancel()
+		return f(ctx, p0, p1, p2, p3, p4, p5, p6)
+	}
+}
+
+func (f CtxFunc7Ok[R, P0, P1, P2, P3, P4, P5, P6]) WithDeadline(deadline time.Time) CtxFunc7Ok[R, P0, P1, P2, P3, P4, P5, P6] {
+	return func(ctx context.Context, p0 P0, p1 P1, p2 P2, p3 P3, p4 P4, p5 P5, p6 P6) (R, bool) {
+		ctx, cancel := context.WithDeadline(ctx, deadline)
+		defer cancel()
+		return f(ctx, p0, p1, p2, p3, p4, p5, p6)
+	}
+}
+
+func (f CtxFunc7Ok[R, P0, P1, P2, P3, P4, P5, P6]) WithCancel() CtxFunc7Ok[R, P0, P1, P2, P3, P4, P5, P6] {
+	return func(ctx context.Context, p0 P0, p1 P1, p2 P2, p3 P3, p4 P4, p5 P5, p6 P6) (R, bool) {
+		ctx, cancel := context.WithCancel(ctx)
+		defer cancel()
+		return f(ctx, p0, p1, p2, p3, p4, p5, p6)
+	}
+}
+
+// WithValue returns a CtxFunc7Ok calling the CtxFunc7Ok with a context
+// carrying val for key, see context.WithValue.
+func (f CtxFunc7Ok[R, P0, P1, P2, P3, P4, P5, P6]) WithValue(key, val any) CtxFunc7Ok[R, P0, P1, P2, P3, P4, P5, P6] {
+	return func(ctx context.Context, p0 P0, p1 P1, p2 P2, p3 P3, p4 P4, p5 P5, p6 P6) (R, bool) {
+		ctx = context.WithValue(ctx, key, val)
+		return f(ctx, p0, p1, p2, p3, p4, p5, p6)
+	}
+}
+
+// WithValueFrom returns a CtxFunc7Ok calling the CtxFunc7Ok with a context
+// carrying the value returned by value for its key. value gets the arguments
+// of every call.
+func (f CtxFunc7Ok[R, P0, P1, P2, P3, P4, P5, P6]) WithValueFrom(value func(ctx context.Context, p0 P0, p1 P1, p2 P2, p3 P3, p4 P4, p5 P5, p6 P6) (key, val any)) CtxFunc7Ok[R, P0, P1, P2, P3, P4, P5, P6] {
+	return func(ctx context.Context, p0 P0, p1 P1, p2 P2, p3 P3, p4 P4, p5 P5, p6 P6) (R, bool) {
+		key, val := value(ctx, p0, p1, p2, p3, p4, p5, p6)
+		ctx = context.WithValue(ctx, key, val)
+		return f(ctx, p0, p1, p2, p3, p4, p5, p6)
+	}
+}
+
+// Detached returns a CtxFunc7Ok calling the CtxFunc7Ok with a context that is
+// never cancelled but keeps the values of the caller's context, see
+// context.WithoutCancel. Useful for cleanups that must run to completion.
+func (f CtxFunc7Ok[R, P0, P1, P2, P3, P4, P5, P6]) Detached() CtxFunc7Ok[R, P0, P1, P2, P3, P4, P5, P6] {
+	return func(ctx context.Context, p0 P0, p1 P1, p2 P2, p3 P3, p4 P4, p5 P5, p6 P6) (R, bool) {
+		ctx = context.WithoutCancel(ctx)
+		return f(ctx, p0, p1, p2, p3, p4, p5, p6)
+	}
+}
+
+// WithTimeoutCause is like WithTimeout, but the context is cancelled with
+// cause when the timeout expires, see context.WithTimeoutCause.
+func (f CtxFunc7Ok[R, P0, P1, P2, P3, P4, P5, P6]) WithTimeoutCause(timeout time.Duration, cause error) CtxFunc7Ok[R, P0, P1, P2, P3, P4, P5, P6] {
+	return func(ctx context.Context, p0 P0, p1 P1, p2 P2, p3 P3, p4 P4, p5 P5, p6 P6) (R, bool) {
+		ctx, cancel := context.WithTimeoutCause(ctx, timeout, cause)
+		defer cancel()
+		return f(ctx, p0, p1, p2, p3, p4, p5, p6)
+	}
+}
+
+// WithDeadlineCause is like WithDeadline, but the context is cancelled with
+// cause when the deadline expires, see context.WithDeadlineCause.
+func (f CtxFunc7Ok[R, P0, P1, P2, P3, P4, P5, P6]) WithDeadlineCause(deadline time.Time, cause error) CtxFunc7Ok[R, P0, P1, P2, P3, P4, P5, P6] {
+	return func(ctx context.Context, p0 P0, p1 P1, p2 P2, p3 P3, p4 P4, p5 P5, p6 P6) (R, bool) {
+		ctx, cancel := context.WithDeadlineCause(ctx, deadline, cause)
+		defer cancel()
+		return f(ctx, p0, p1, p2, p3, p4, p5, p6)
+	}
+}
+
+// Retry returns a CtxFunc7Ok that will retry the CtxFunc7Ok until it returns
+// true or the tryAgain function returns false.
+// The attempts that returned false fail with a placeholder error, given to
+// tryAgain and to the hooks of the options, e.g. OnRetry, so that any
+// RetryPolicy can be used.
+func (f CtxFunc7Ok[R, P0, P1, P2, P3, P4, P5, P6]) Retry(tryAgain RetryPolicy, opts ...RetryOption) CtxFunc7Ok[R, P0, P1, P2, P3, P4, P5, P6] {
+	return func(ctx context.Context, p0 P0, p1 P1, p2 P2, p3 P3, p4 P4, p5 P5, p6 P6) (R, bool) {
+		var v R
+		var ok bool
+		err := retry(ctx, func(attempts int, err error) bool {
+			return ctx.Err() == nil && tryAgain(attempts, err)
+		}, opts, func(ctx context.Context) error {
+			v, ok = f(ctx, p0, p1, p2, p3, p4, p5, p6)
+			if !ok {
+				return errNotOk
+			}
+			return nil
+		})
+		return v, err == nil
+	}
+}
+
+// Must returns a CtxFunc7Value that will panic if the CtxFunc7Ok returns false.
+func (f CtxFunc7Ok[R, P0, P1, P2, P3, P4, P5, P6]) Must() CtxFunc7Value[R, P0, P1, P2, P3, P4, P5, P6] {
+	return func(ctx context.Context, p0 P0, p1 P1, p2 P2, p3 P3, p4 P4, p5 P5, p6 P6) R {
+		v, ok := f(ctx, p0, p1, p2, p3, p4, p5, p6)
+		if !ok {
+			panic("powerfunc: CtxFunc7Ok returned false")
+		}
+		return v
+	}
+}
+
+// Fallback returns a CtxFunc7Value that will return the provided value if the
+// CtxFunc7Ok returns false.
+func (f CtxFunc7Ok[R, P0, P1, P2, P3, P4, P5, P6]) Fallback(val R) CtxFunc7Value[R, P0, P1, P2, P3, P4, P5, P6] {
+	return func(ctx context.Context, p0 P0, p1 P1, p2 P2, p3 P3, p4 P4, p5 P5, p6 P6) R {
+		v, ok := f(ctx, p0, p1, p2, p3, p4, p5, p6)
+		if !ok {
+			return val
+		}
+		return v
+	}
+}
+
+// OrElse returns a CtxFunc7Value that will return the provided value if the
+// CtxFunc7Ok returns false. It is an alias of Fallback.
+func (f CtxFunc7Ok[R, P0, P1, P2, P3, P4, P5, P6]) OrElse(val R) CtxFunc7Value[R, P0, P1, P2, P3, P4, P5, P6] {
+	return f.Fallback(val)
+}
+
+// OrElseGet returns a CtxFunc7Value that will call elsef if the CtxFunc7Ok
+// returns false.
+func (f CtxFunc7Ok[R, P0, P1, P2, P3, P4, P5, P6]) OrElseGet(elsef CtxFunc7Value[R, P0, P1, P2, P3, P4, P5, P6]) CtxFunc7Value[R, P0, P1, P2, P3, P4, P5, P6] {
+	return func(ctx context.Context, p0 P0, p1 P1, p2 P2, p3 P3, p4 P4, p5 P5, p6 P6) R {
+		v, ok := f(ctx, p0, p1, p2, p3, p4, p5, p6)
+		if !ok {
+			return elsef(ctx, p0, p1, p2, p3, p4, p5, p6)
+		}
+		return v
+	}
+}
+
+// ToResult transforms a CtxFunc7Ok into a CtxFunc7Result returning the
+// provided error when the CtxFunc7Ok returns false.
+func (f CtxFunc7Ok[R, P0, P1, P2, P3, P4, P5, P6]) ToResult(err error) CtxFunc7Result[R, P0, P1, P2, P3, P4, P5, P6] {
+	return func(ctx context.Context, p0 P0, p1 P1, p2 P2, p3 P3, p4 P4, p5 P5, p6 P6) (R, error) {
+		v, ok := f(ctx, p0, p1, p2, p3, p4, p5, p6)
+		if !ok {
+			return v, err
+		}
+		return v, nil
+	}
+}
+
+// Filter returns a CtxFunc7Ok that will return false if the value returned by
+// the CtxFunc7Ok does not satisfy keep.
+func (f CtxFunc7Ok[R, P0, P1, P2, P3, P4, P5, P6]) Filter(keep func(R) bool) CtxFunc7Ok[R, P0, P1, P2, P3, P4, P5, P6] {
+	return func(ctx context.Context, p0 P0, p1 P1, p2 P2, p3 P3, p4 P4, p5 P5, p6 P6) (R, bool) {
+		v, ok := f(ctx, p0, p1, p2, p3, p4, p5, p6)
+		if !ok || !keep(v) {
+			var zero R
+			return zero, false
+		}
+		return v, true
+	}
+}
+
+// Map applies the provided function to the value returned by the CtxFunc7Ok,
+// if it returns true.
+func (f CtxFunc7Ok[R, P0, P1, P2, P3, P4, P5, P6]) Map(fn func(R) R) CtxFunc7Ok[R, P0, P1, P2, P3, P4, P5, P6] {
+	return func(ctx context.Context, p0 P0, p1 P1, p2 P2, p3 P3, p4 P4, p5 P5, p6 P6) (R, bool) {
+		v, ok := f(ctx, p0, p1, p2, p3, p4, p5, p6)
+		if !ok {
+			return v, false
+		}
+		return fn(v), true
+	}
+}
+
+// Curry7 returns a CtxFuncOk[R] with the first 7 arguments of the CtxFunc7Ok bound.
+func (f CtxFunc7Ok[R, P0, P1, P2, P3, P4, P5, P6]) Curry7(p0 P0, p1 P1, p2 P2, p3 P3, p4 P4, p5 P5, p6 P6) CtxFuncOk[R] {
+	return func(ctx context.Context) (R, bool) {
+		return f(ctx, p0, p1, p2, p3, p4, p5, p6)
+	}
+}
+
+// Curry6 returns a CtxFunc1Ok[R, P6] with the first 6 arguments of the CtxFunc7Ok bound.
+func (f CtxFunc7Ok[R, P0, P1, P2, P3, P4, P5, P6]) Curry6(p0 P0, p1 P1, p2 P2, p3 P3, p4 P4, p5 P5) CtxFunc1Ok[R, P6] {
+	return func(ctx context.Context, p6 P6) (R, bool) {
+		return f(ctx, p0, p1, p2, p3, p4, p5, p6)
+	}
+}
+
+// Curry5 returns a CtxFunc2Ok[R, P5, P6] with the first 5 arguments of the CtxFunc7Ok bound.
+func (f CtxFunc7Ok[R, P0, P1, P2, P3, P4, P5, P6]) Curry5(p0 P0, p1 P1, p2 P2, p3 P3, p4 P4) CtxFunc2Ok[R, P5, P6] {
+	return func(ctx context.Context, p5 P5, p6 P6) (R, bool) {
+		return f(ctx, p0, p1, p2, p3, p4, p5, p6)
+	}
+}
+
+// Curry4 returns a CtxFunc3Ok[R, P4, P5, P6] with the first 4 arguments of the CtxFunc7Ok bound.
+func (f CtxFunc7Ok[R, P0, P1, P2, P3, P4, P5, P6]) Curry4(p0 P0, p1 P1, p2 P2, p3 P3) CtxFunc3Ok[R, P4, P5, P6] {
+	return func(ctx context.Context, p4 P4, p5 P5, p6 P6) (R, bool) {
+		return f(ctx, p0, p1, p2, p3, p4, p5, p6)
+	}
+}
+
+// Curry3 returns a CtxFunc4Ok[R, P3, P4, P5, P6] with the first 3 arguments of the CtxFunc7Ok bound.
+func (f CtxFunc7Ok[R, P0, P1, P2, P3, P4, P5, P6]) Curry3(p0 P0, p1 P1, p2 P2) CtxFunc4Ok[R, P3, P4, P5, P6] {
+	return func(ctx context.Context, p3 P3, p4 P4, p5 P5, p6 P6) (R, bool) {
+		return f(ctx, p0, p1, p2, p3, p4, p5, p6)
+	}
+}
+
+// Curry2 returns a CtxFunc5Ok[R, P2, P3, P4, P5, P6] with the first 2 arguments of the CtxFunc7Ok bound.
+func (f CtxFunc7Ok[R, P0, P1, P2, P3, P4, P5, P6]) Curry2(p0 P0, p1 P1) CtxFunc5Ok[R, P2, P3, P4, P5, P6] {
+	return func(ctx context.Context, p2 P2, p3 P3, p4 P4, p5 P5, p6 P6) (R, bool) {
+		return f(ctx, p0, p1, p2, p3, p4, p5, p6)
+	}
+}
+
+// Curry1 returns a CtxFunc6Ok[R, P1, P2, P3, P4, P5, P6] with the first argument of the CtxFunc7Ok bound.
+func (f CtxFunc7Ok[R, P0, P1, P2, P3, P4, P5, P6]) Curry1(p0 P0) CtxFunc6Ok[R, P1, P2, P3, P4, P5, P6] {
+	return func(ctx context.Context, p1 P1, p2 P2, p3 P3, p4 P4, p5 P5, p6 P6) (R, bool) {
+		return f(ctx, p0, p1, p2, p3, p4, p5, p6)
+	}
+}
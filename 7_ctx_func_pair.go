@@ -0,0 +1,167 @@
+// Code generated by powerfunc/generator from ctx_func_pair.go.tmpl. DO NOT EDIT.
+
+package powerfunc
+
+import (
+	"context"
+	"fmt"
+	"time"
+)
+
+type CtxFunc7Pair[R1, R2, P0, P1, P2, P3, P4, P5, P6 any] func(ctx context.Context, p0 P0, p1 P1, p2 P2, p3 P3, p4 P4, p5 P5, p6 P6) (R1, R2)
+
+func (f CtxFunc7Pair[R1, R2, P0, P1, P2, P3, P4, P5, P6]) Exec(ctx context.Context, p0 P0, p1 P1, p2 P2, p3 P3, p4 P4, p5 P5, p6 P6) (R1, R2) {
+	return f(ctx, p0, p1, p2, p3, p4, p5, p6)
+}
+
+func (f CtxFunc7Pair[R1, R2, P0, P1, P2, P3, P4, P5, P6]) Timing(loggers ...func(d time.Duration)) CtxFunc7Pair[R1, R2, P0, P1, P2, P3, P4, P5, P6] {
+	return f.TimingWithClock(RealClock(), loggers...)
+}
+
+// TimingWithClock is like Timing, but measures the execution time with clock.
+func (f CtxFunc7Pair[R1, R2, P0, P1, P2, P3, P4, P5, P6]) TimingWithClock(clock Clock, loggers ...func(d time.Duration)) CtxFunc7Pair[R1, R2, P0, P1, P2, P3, P4, P5, P6] {
+	return func(ctx context.Context, p0 P0, p1 P1, p2 P2, p3 P3, p4 P4, p5 P5, p6 P6) (R1, R2) {
+		start := clock.Now()
+		defer func() {
+			dur := clock.Since(start)
+			for _, logger := range loggers {
+				logger(dur)
+			}
+			if len(loggers) == 0 {
+				// Default logger
+				fmt.Println(dur)
+			}
+		}()
+		return f(ctx, p0, p1, p2, p3, p4, p5, p6)
+	}
+}
+
+func (f CtxFunc7Pair[R1, R2, P0, P1, P2, P3, P4, P5, P6]) Fallible() CtxFunc7PairResult[R1, R2, P0, P1, P2, P3, P4, P5, P6] {
+	return func(ctx context.Context, p0 P0, p1 P1, p2 P2, p3 P3, p4 P4, p5 P5, p6 P6) (R1, R2, error) {
+		v1, v2 := f(ctx, p0, p1, p2, p3, p4, p5, p6)
+		return v1, v2, nil
+	}
+}
+
+func (f CtxFunc7Pair[R1, R2, P0, P1, P2, P3, P4, P5, P6]) WithTimeout(timeout time.Duration) CtxFunc7Pair[R1, R2, P0, P1, P2, P3, P4, P5, P6] {
+	return func(ctx context.Context, p0 P0, p1 P1, p2 P2, p3 P3, p4 P4, p5 P5, p6 P6) (R1, R2) {
+		ctx, cancel := context.WithTimeout(ctx, timeout)
+		defer cancel()
+		return f(ctx, p0, p1, p2, p3, p4, p5, p6)
+	}
+}
+
+func (f CtxFunc7Pair[R1, R2, P0, P1, P2, P3, P4, P5, P6]) WithDeadline(deadline time.Time) CtxFunc7Pair[R1, R2, P0, P1, P2, P3, P4, P5, P6] {
+	return func(ctx context.Context, p0 P0, p1 P1, p2 P2, p3 P3, p4 P4, p5 P5, p6 P6) (R1, R2) {
+		ctx, cancel := context.WithDeadline(ctx, deadline)
+		defer cancel()
+		return f(ctx, p0, p1, p2, p3, p4, p5, p6)
+	}
+}
+
+func (f CtxFunc7Pair[R1, R2, P0, P1, P2, P3, P4, P5, P6]) WithCancel() CtxFunc7Pair[R1, R2, P0, P1, P2, P3, P4, P5, P6] {
+	return func(ctx context.Context, p0 P0, p1 P1, p2 P2, p3 P3, p4 P4, p5 P5, p6 P6) (R1, R2) {
+		ctx, cancel := context.WithCancel(ctx)
+		defer cancel()
+		return f(ctx, p0, p1, p2, p3, p4, p5, p6)
+	}
+}
+
+// WithValue returns a CtxFunc7Pair calling the CtxFunc7Pair with a context
+// carrying val for key, see context.WithValue.
+func (f CtxFunc7Pair[R1, R2, P0, P1, P2, P3, P4, P5, P6]) WithValue(key, val any) CtxFunc7Pair[R1, R2, P0, P1, P2, P3, P4, P5, P6] {
+	return func(ctx context.Context, p0 P0, p1 P1, p2 P2, p3 P3, p4 P4, p5 P5, p6 P6) (R1, R2) {
+		ctx = context.WithValue(ctx, key, val)
+		return f(ctx, p0, p1, p2, p3, p4, p5, p6)
+	}
+}
+
+// WithValueFrom returns a CtxFunc7Pair calling the CtxFunc7Pair with a context
+// carrying the value returned by value for its key. value gets the arguments
+// of every call.
+func (f CtxFunc7Pair[R1, R2, P0, P1, P2, P3, P4, P5, P6]) WithValueFrom(value func(ctx context.Context, p0 P0, p1 P1, p2 P2, p3 P3, p4 P4, p5 P5, p6 P6) (key, val any)) CtxFunc7Pair[R1, R2, P0, P1, P2, P3, P4, P5, P6] {
+	return func(ctx context.Context, p0 P0, p1 P1, p2 P2, p3 P3, p4 P4, p5 P5, p6 P6) (R1, R2) {
+		key, val := value(ctx, p0, p1, p2, p3, p4, p5, p6)
+		ctx = context.WithValue(ctx, key, val)
+		return f(ctx, p0, p1, p2, p3, p4, p5, p6)
+	}
+}
+
+// Detached returns a CtxFunc7Pair calling the CtxFunc7Pair with a context that is
+// never cancelled but keeps the values of the caller's context, see
+// context.WithoutCancel. Useful for cleanups that must run to completion.
+func (f CtxFunc7Pair[R1, R2, P0, P1, P2, P3, P4, P5, P6]) Detached() CtxFunc7Pair[R1, R2, P0, P1, P2, P3, P4, P5, P6] {
+	return func(ctx context.Context, p0 P0, p1 P1, p2 P2, p3 P3, p4 P4, p5 P5, p6 P6) (R1, R2) {
+		ctx = context.WithoutCancel(ctx)
+		return f(ctx, p0, p1, p2, p3, p4, p5, p6)
+	}
+}
+
+// WithTimeoutCause is like WithTimeout, but the context is cancelled with
+// cause when the timeout expires, see context.WithTimeoutCause.
+func (f CtxFunc7Pair[R1, R2, P0, P1, P2, P3, P4, P5, P6]) WithTimeoutCause(timeout time.Duration, cause error) CtxFunc7Pair[R1, R2, P0, P1, P2, P3, P4, P5, P6] {
+	return func(ctx context.Context, p0 P0, p1 P1, p2 P2, p3 P3, p4 P4, p5 P5, p6 P6) (R1, R2) {
+		ctx, cancel := context.WithTimeoutCause(ctx, timeout, cause)
+		defer cancel()
+		return f(ctx, p0, p1, p2, p3, p4, p5, p6)
+	}
+}
+
+// WithDeadlineCause is like WithDeadline, but the context is cancelled with
+// cause when the deadline expires, see context.WithDeadlineCause.
+func (f CtxFunc7Pair[R1, R2, P0, P1, P2, P3, P4, P5, P6]) WithDeadlineCause(deadline time.Time, cause error) CtxFunc7Pair[R1, R2, P0, P1, P2, P3, P4, P5, P6] {
+	return func(ctx context.Context, p0 P0, p1 P1, p2 P2, p3 P3, p4 P4, p5 P5, p6 P6) (R1, R2) {
+		ctx, cancel := context.WithDeadlineCause(ctx, deadline, cause)
+		defer cancel()
+		return f(ctx, p0, p1, p2, p3, p4, p5, p6)
+	}
+}
+
+// Curry7 returns a CtxFuncPair[R1, R2] with the first 7 arguments of the CtxFunc7Pair bound.
+func (f CtxFunc7Pair[R1, R2, P0, P1, P2, P3, P4, P5, P6]) Curry7(p0 P0, p1 P1, p2 P2, p3 P3, p4 P4, p5 P5, p6 P6) CtxFuncPair[R1, R2] {
+	return func(ctx context.Context) (R1, R2) {
+		return f(ctx, p0, p1, p2, p3, p4, p5, p6)
+	}
+}
+
+// Curry6 returns a CtxFunc1Pair[R1, R2, P6] with the first 6 arguments of the CtxFunc7Pair bound.
+func (f CtxFunc7Pair[R1, R2, P0, P1, P2, P3, P4, P5, P6]) Curry6(p0 P0, p1 P1, p2 P2, p3 P3, p4 P4, p5 P5) CtxFunc1Pair[R1, R2, P6] {
+	return func(ctx context.Context, p6 P6) (R1, R2) {
+		return f(ctx, p0, p1, p2, p3, p4, p5, p6)
+	}
+}
+
+// Curry5 returns a CtxFunc2Pair[R1, R2, P5, P6] with the first 5 arguments of the CtxFunc7Pair bound.
+func (f CtxFunc7Pair[R1, R2, P0, P1, P2, P3, P4, P5, P6]) Curry5(p0 P0, p1 P1, p2 P2, p3 P3, p4 P4) CtxFunc2Pair[R1, R2, P5, P6] {
+	return func(ctx context.Context, p5 P5, p6 P6) (R1, R2) {
+		return f(ctx, p0, p1, p2, p3, p4, p5, p6)
+	}
+}
+
+// Curry4 returns a CtxFunc3Pair[R1, R2, P4, P5, P6] with the first 4 arguments of the CtxFunc7Pair bound.
+func (f CtxFunc7Pair[R1, R2, P0, P1, P2, P3, P4, P5, P6]) Curry4(p0 P0, p1 P1, p2 P2, p3 P3) CtxFunc3Pair[R1, R2, P4, P5, P6] {
+	return func(ctx context.Context, p4 P4, p5 P5, p6 P6) (R1, R2) {
+		return f(ctx, p0, p1, p2, p3, p4, p5, p6)
+	}
+}
+
+// Curry3 returns a CtxFunc4Pair[R1, R2, P3, P4, P5, P6] with the first 3 arguments of the CtxFunc7Pair bound.
+func (f CtxFunc7Pair[R1, R2, P0, P1, P2, P3, P4, P5, P6]) Curry3(p0 P0, p1 P1, p2 P2) CtxFunc4Pair[R1, R2, P3, P4, P5, P6] {
+	return func(ctx context.Context, p3 P3, p4 P4, p5 P5, p6 P6) (R1, R2) {
+		return f(ctx, p0, p1, p2, p3, p4, p5, p6)
+	}
+}
+
+// Curry2 returns a CtxFunc5Pair[R1, R2, P2, P3, P4, P5, P6] with the first 2 arguments of the CtxFunc7Pair bound.
+func (f CtxFunc7Pair[R1, R2, P0, P1, P2, P3, P4, P5, P6]) Curry2(p0 P0, p1 P1) CtxFunc5Pair[R1, R2, P2, P3, P4, P5, P6] {
+	return func(ctx context.Context, p2 P2, p3 P3, p4 P4, p5 P5, p6 P6) (R1, R2) {
+		return f(ctx, p0, p1, p2, p3, p4, p5, p6)
+	}
+}
+
+// Curry1 returns a CtxFunc6Pair[R1, R2, P1, P2, P3, P4, P5, P6] with the first argument of the CtxFunc7Pair bound.
+func (f CtxFunc7Pair[R1, R2, P0, P1, P2, P3, P4, P5, P6]) Curry1(p0 P0) CtxFunc6Pair[R1, R2, P1, P2, P3, P4, P5, P6] {
+	return func(ctx context.Context, p1 P1, p2 P2, p3 P3, p4 P4, p5 P5, p6 P6) (R1, R2) {
+		return f(ctx, p0, p1, p2, p3, p4, p5, p6)
+	}
+}
@@ -1,3 +1,5 @@
+// Code generated by powerfunc/generator from ctx_func_value.go.tmpl. DO NOT EDIT.
+
 package powerfunc
 
 import (
@@ -13,10 +15,15 @@ func (f CtxFunc7Value[R, P0, P1, P2, P3, P4, P5, P6]) Exec(ctx context.Context,
 }
 
 func (f CtxFunc7Value[R, P0, P1, P2, P3, P4, P5, P6]) Timing(loggers ...func(d time.Duration)) CtxFunc7Value[R, P0, P1, P2, P3, P4, P5, P6] {
+	return f.TimingWithClock(RealClock(), loggers...)
+}
+
+// TimingWithClock is like Timing, but measures the execution time with clock.
+func (f CtxFunc7Value[R, P0, P1, P2, P3, P4, P5, P6]) TimingWithClock(clock Clock, loggers ...func(d time.Duration)) CtxFunc7Value[R, P0, P1, P2, P3, P4, P5, P6] {
 	return func(ctx context.Context, p0 P0, p1 P1, p2 P2, p3 P3, p4 P4, p5 P5, p6 P6) R {
-		start := time.Now()
+		start := clock.Now()
 		defer func() {
-			dur := time.Since(start)
+			dur := clock.Since(start)
 			for _, logger := range loggers {
 				logger(dur)
 			}
@@ -60,52 +67,150 @@ func (f CtxFunc7Value[R, P0, P1, P2, P3, P4, P5, P6]) WithCancel() CtxFunc7Value
 	}
 }
 
+// WithValue returns a CtxFunc7Value calling the CtxFunc7Value with a context
+// carrying val for key, see context.WithValue.
+func (f CtxFunc7Value[R, P0, P1, P2, P3, P4, P5, P6]) WithValue(key, val any) CtxFunc7Value[R, P0, P1, P2, P3, P4, P5, P6] {
+	return func(ctx context.Context, p0 P0, p1 P1, p2 P2, p3 P3, p4 P4, p5 P5, p6 P6) R {
+		ctx = context.WithValue(ctx, key, val)
+		return f(ctx, p0, p1, p2, p3, p4, p5, p6)
+	}
+}
+
+// WithValueFrom returns a CtxFunc7Value calling the CtxFunc7Value with a context
+// carrying the value returned by value for its key. value gets the arguments
+// of every call.
+func (f CtxFunc7Value[R, P0, P1, P2, P3, P4, P5, P6]) WithValueFrom(value func(ctx context.Context, p0 P0, p1 P1, p2 P2, p3 P3, p4 P4, p5 P5, p6 P6) (key, val any)) CtxFunc7Value[R, P0, P1, P2, P3, P4, P5, P6] {
+	return func(ctx context.Context, p0 P0, p1 P1, p2 P2, p3 P3, p4 P4, p5 P5, p6 P6) R {
+		key, val := value(ctx, p0, p1, p2, p3, p4, p5, p6)
+		ctx = context.WithValue(ctx, key, val)
+		return f(ctx, p0, p1, p2, p3, p4, p5, p6)
+	}
+}
+
+// Detached returns a CtxFunc7Value calling the CtxFunc7Value with a context that is
+// never cancelled but keeps the values of the caller's context, see
+// context.WithoutCancel. Useful for cleanups that must run to completion.
+func (f CtxFunc7Value[R, P0, P1, P2, P3, P4, P5, P6]) Detached() CtxFunc7Value[R, P0, P1, P2, P3, P4, P5, P6] {
+	return func(ctx context.Context, p0 P0, p1 P1, p2 P2, p3 P3, p4 P4, p5 P5, p6 P6) R {
+		ctx = context.WithoutCancel(ctx)
+		return f(ctx, p0, p1, p2, p3, p4, p5, p6)
+	}
+}
+
+// WithTimeoutCause is like WithTimeout, but the context is cancelled with
+// cause when the timeout expires, see context.WithTimeoutCause.
+func (f CtxFunc7Value[R, P0, P1, P2, P3, P4, P5, P6]) WithTimeoutCause(timeout time.Duration, cause error) CtxFunc7Value[R, P0, P1, P2, P3, P4, P5, P6] {
+	return func(ctx context.Context, p0 P0, p1 P1, p2 P2, p3 P3, p4 P4, p5 P5, p6 P6) R {
+		ctx, cancel := context.WithTimeoutCause(ctx, timeout, cause)
+		defer cancel()
+		return f(ctx, p0, p1, p2, p3, p4, p5, p6)
+	}
+}
+
+// WithDeadlineCause is like WithDeadline, but the context is cancelled with
+// cause when the deadline expires, see context.WithDeadlineCause.
+func (f CtxFunc7Value[R, P0, P1, P2, P3, P4, P5, P6]) WithDeadlineCause(deadline time.Time, cause error) CtxFunc7Value[R, P0, P1, P2, P3, P4, P5, P6] {
+	return func(ctx context.Context, p0 P0, p1 P1, p2 P2, p3 P3, p4 P4, p5 P5, p6 P6) R {
+		ctx, cancel := context.WithDeadlineCause(ctx, deadline, cause)
+		defer cancel()
+		return f(ctx, p0, p1, p2, p3, p4, p5, p6)
+	}
+}
+
+// Once returns a CtxFunc7Value that only executes the first time it is called
+// and returns the cached value afterwards, along with the Once controlling it.
+// The shared execution does not inherit the cancellation of the caller's
+// context. As a CtxFunc7Value cannot report ctx.Err(), every caller waits for
+// the execution to return, even once its own context is done.
+func (f CtxFunc7Value[R, P0, P1, P2, P3, P4, P5, P6]) Once(opts ...OnceOption) (CtxFunc7Value[R, P0, P1, P2, P3, P4, P5, P6], *Once) {
+	o := newOnce(opts...)
+	return func(ctx context.Context, p0 P0, p1 P1, p2 P2, p3 P3, p4 P4, p5 P5, p6 P6) R {
+		v, _ := o.do(context.WithoutCancel(ctx), false, func() (any, error) {
+			ctx := context.WithoutCancel(ctx)
+			return f(ctx, p0, p1, p2, p3, p4, p5, p6), nil
+		})
+		val, _ := v.(R)
+		return val
+	}, o
+}
+
+// Lazy returns a CtxFunc7Value that computes its value the first time it is
+// called and returns the cached value afterwards, along with the Once
+// controlling it.
+// Like Once, every caller waits for the execution to return, even once its
+// own context is done.
+func (f CtxFunc7Value[R, P0, P1, P2, P3, P4, P5, P6]) Lazy(opts ...OnceOption) (CtxFunc7Value[R, P0, P1, P2, P3, P4, P5, P6], *Once) {
+	o := newLazy(opts...)
+	return func(ctx context.Context, p0 P0, p1 P1, p2 P2, p3 P3, p4 P4, p5 P5, p6 P6) R {
+		v, _ := o.do(context.WithoutCancel(ctx), false, func() (any, error) {
+			ctx := context.WithoutCancel(ctx)
+			return f(ctx, p0, p1, p2, p3, p4, p5, p6), nil
+		})
+		val, _ := v.(R)
+		return val
+	}, o
+}
+
+// PollUntil returns a CtxFunc7Result calling the CtxFunc7Value every interval
+// until the returned value satisfies cond.
+// When the context is done or the maximum number of attempts is reached
+// first, it returns the last value and a *PollTimeoutError.
+func (f CtxFunc7Value[R, P0, P1, P2, P3, P4, P5, P6]) PollUntil(cond func(R) bool, interval time.Duration, opts ...PollOption) CtxFunc7Result[R, P0, P1, P2, P3, P4, P5, P6] {
+	return func(ctx context.Context, p0 P0, p1 P1, p2 P2, p3 P3, p4 P4, p5 P5, p6 P6) (R, error) {
+		var v R
+		err := poll(ctx, interval, opts, func() (bool, error) {
+			v = f(ctx, p0, p1, p2, p3, p4, p5, p6)
+			return cond(v), nil
+		})
+		return v, err
+	}
+}
 
+// Curry7 returns a CtxFuncValue[R] with the first 7 arguments of the CtxFunc7Value bound.
 func (f CtxFunc7Value[R, P0, P1, P2, P3, P4, P5, P6]) Curry7(p0 P0, p1 P1, p2 P2, p3 P3, p4 P4, p5 P5, p6 P6) CtxFuncValue[R] {
 	return func(ctx context.Context) R {
 		return f(ctx, p0, p1, p2, p3, p4, p5, p6)
 	}
 }
-	
 
+// Curry6 returns a CtxFunc1Value[R, P6] with the first 6 arguments of the CtxFunc7Value bound.
 func (f CtxFunc7Value[R, P0, P1, P2, P3, P4, P5, P6]) Curry6(p0 P0, p1 P1, p2 P2, p3 P3, p4 P4, p5 P5) CtxFunc1Value[R, P6] {
 	return func(ctx context.Context, p6 P6) R {
 		return f(ctx, p0, p1, p2, p3, p4, p5, p6)
 	}
 }
-	
 
+// Curry5 returns a CtxFunc2Value[R, P5, P6] with the first 5 arguments of the CtxFunc7Value bound.
 func (f CtxFunc7Value[R, P0, P1, P2, P3, P4, P5, P6]) Curry5(p0 P0, p1 P1, p2 P2, p3 P3, p4 P4) CtxFunc2Value[R, P5, P6] {
 	return func(ctx context.Context, p5 P5, p6 P6) R {
 		return f(ctx, p0, p1, p2, p3, p4, p5, p6)
 	}
 }
-	
 
+// Curry4 returns a CtxFunc3Value[R, P4, P5, P6] with the first 4 arguments of the CtxFunc7Value bound.
 func (f CtxFunc7Value[R, P0, P1, P2, P3, P4, P5, P6]) Curry4(p0 P0, p1 P1, p2 P2, p3 P3) CtxFunc3Value[R, P4, P5, P6] {
 	return func(ctx context.Context, p4 P4, p5 P5, p6 P6) R {
 		return f(ctx, p0, p1, p2, p3, p4, p5, p6)
 	}
 }
-	
 
+// Curry3 returns a CtxFunc4Value[R, P3, P4, P5, P6] with the first 3 arguments of the CtxFunc7Value bound.
 func (f CtxFunc7Value[R, P0, P1, P2, P3, P4, P5, P6]) Curry3(p0 P0, p1 P1, p2 P2) CtxFunc4Value[R, P3, P4, P5, P6] {
 	return func(ctx context.Context, p3 P3, p4 P4, p5 P5, p6 P6) R {
 		return f(ctx, p0, p1, p2, p3, p4, p5, p6)
 	}
 }
-	
 
+// Curry2 returns a CtxFunc5Value[R, P2, P3, P4, P5, P6] with the first 2 arguments of the CtxFunc7Value bound.
 func (f CtxFunc7Value[R, P0, P1, P2, P3, P4, P5, P6]) Curry2(p0 P0, p1 P1) CtxFunc5Value[R, P2, P3, P4, P5, P6] {
 	return func(ctx context.Context, p2 P2, p3 P3, p4 P4, p5 P5, p6 P6) R {
 		return f(ctx, p0, p1, p2, p3, p4, p5, p6)
 	}
 }
-	
 
+// Curry1 returns a CtxFunc6Value[R, P1, P2, P3, P4, P5, P6] with the first argument of the CtxFunc7Value bound.
 func (f CtxFunc7Value[R, P0, P1, P2, P3, P4, P5, P6]) Curry1(p0 P0) CtxFunc6Value[R, P1, P2, P3, P4, P5, P6] {
 	return func(ctx context.Context, p1 P1, p2 P2, p3 P3, p4 P4, p5 P5, p6 P6) R {
 		return f(ctx, p0, p1, p2, p3, p4, p5, p6)
 	}
 }
-	
\ No newline at end of file
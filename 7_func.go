@@ -1,13 +1,14 @@
-//go:generate go run ./generator -arity 10
+// Code generated by powerfunc/generator from func.go.tmpl. DO NOT EDIT.
 
 package powerfunc
 
 import (
+	"context"
 	"fmt"
 	"time"
 )
 
-// Func7 is a function that takes 0 arguments and returns no values.
+// Func7 is a function that takes 7 arguments and returns no values.
 type Func7[P0, P1, P2, P3, P4, P5, P6 any] func(p0 P0, p1 P1, p2 P2, p3 P3, p4 P4, p5 P5, p6 P6)
 
 // Exec executes the Function.
@@ -20,10 +21,15 @@ func (f Func7[P0, P1, P2, P3, P4, P5, P6]) Exec(p0 P0, p1 P1, p2 P2, p3 P3, p4 P
 // Timing returns a Func7 that will log the execution time of the Func7.
 // If no loggers are provided, the default logger (fmt.Println) will be used.
 func (f Func7[P0, P1, P2, P3, P4, P5, P6]) Timing(loggers ...func(d time.Duration)) Func7[P0, P1, P2, P3, P4, P5, P6] {
+	return f.TimingWithClock(RealClock(), loggers...)
+}
+
+// TimingWithClock is like Timing, but measures the execution time with clock.
+func (f Func7[P0, P1, P2, P3, P4, P5, P6]) TimingWithClock(clock Clock, loggers ...func(d time.Duration)) Func7[P0, P1, P2, P3, P4, P5, P6] {
 	return func(p0 P0, p1 P1, p2 P2, p3 P3, p4 P4, p5 P5, p6 P6) {
-		start := time.Now()
+		start := clock.Now()
 		defer func() {
-			dur := time.Since(start)
+			dur := clock.Since(start)
 			for _, logger := range loggers {
 				logger(dur)
 			}
@@ -46,52 +52,103 @@ func (f Func7[P0, P1, P2, P3, P4, P5, P6]) Fallible() Func7Error[P0, P1, P2, P3,
 	}
 }
 
+// Debounce returns a Func7 that delays its execution until wait has elapsed
+// since the last call, along with the Debouncer controlling it.
+// Only the most recent call is kept and eventually invoked.
+func (f Func7[P0, P1, P2, P3, P4, P5, P6]) Debounce(wait time.Duration, opts ...DebounceOption) (Func7[P0, P1, P2, P3, P4, P5, P6], *Debouncer) {
+	d := newDebouncer(wait, opts...)
+	return func(p0 P0, p1 P1, p2 P2, p3 P3, p4 P4, p5 P5, p6 P6) {
+		d.call(func() {
+			f(p0, p1, p2, p3, p4, p5, p6)
+		})
+	}, d
+}
+
+// Throttle returns a Func7 that executes at most once per interval, along
+// with the Debouncer controlling it.
+// The first call is invoked immediately, and the most recent call made
+// during the interval is invoked at the end of it.
+// The options, e.g. DebounceClock, apply to the underlying Debouncer.
+func (f Func7[P0, P1, P2, P3, P4, P5, P6]) Throttle(interval time.Duration, opts ...DebounceOption) (Func7[P0, P1, P2, P3, P4, P5, P6], *Debouncer) {
+	d := newThrottler(interval, opts...)
+	return func(p0 P0, p1 P1, p2 P2, p3 P3, p4 P4, p5 P5, p6 P6) {
+		d.call(func() {
+			f(p0, p1, p2, p3, p4, p5, p6)
+		})
+	}, d
+}
+
+// Once returns a Func7 that only executes the first time it is called,
+// along with the Once controlling it.
+func (f Func7[P0, P1, P2, P3, P4, P5, P6]) Once(opts ...OnceOption) (Func7[P0, P1, P2, P3, P4, P5, P6], *Once) {
+	o := newOnce(opts...)
+	return func(p0 P0, p1 P1, p2 P2, p3 P3, p4 P4, p5 P5, p6 P6) {
+		_, _ = o.do(context.Background(), false, func() (any, error) {
+			f(p0, p1, p2, p3, p4, p5, p6)
+			return nil, nil
+		})
+	}, o
+}
+
+// Lazy returns a Func7 that executes the first time it is called,
+// along with the Once controlling it.
+// A Func7 cannot fail, so Lazy behaves like Once unless it panics, in which
+// case the next call executes it again.
+func (f Func7[P0, P1, P2, P3, P4, P5, P6]) Lazy(opts ...OnceOption) (Func7[P0, P1, P2, P3, P4, P5, P6], *Once) {
+	o := newLazy(opts...)
+	return func(p0 P0, p1 P1, p2 P2, p3 P3, p4 P4, p5 P5, p6 P6) {
+		_, _ = o.do(context.Background(), false, func() (any, error) {
+			f(p0, p1, p2, p3, p4, p5, p6)
+			return nil, nil
+		})
+	}, o
+}
 
+// Curry7 returns a Func with the first 7 arguments of the Func7 bound.
 func (f Func7[P0, P1, P2, P3, P4, P5, P6]) Curry7(p0 P0, p1 P1, p2 P2, p3 P3, p4 P4, p5 P5, p6 P6) Func {
-	return func()  {
+	return func() {
 		f(p0, p1, p2, p3, p4, p5, p6)
 	}
 }
-	
 
+// Curry6 returns a Func1[P6] with the first 6 arguments of the Func7 bound.
 func (f Func7[P0, P1, P2, P3, P4, P5, P6]) Curry6(p0 P0, p1 P1, p2 P2, p3 P3, p4 P4, p5 P5) Func1[P6] {
-	return func(p6 P6)  {
+	return func(p6 P6) {
 		f(p0, p1, p2, p3, p4, p5, p6)
 	}
 }
-	
 
+// Curry5 returns a Func2[P5, P6] with the first 5 arguments of the Func7 bound.
 func (f Func7[P0, P1, P2, P3, P4, P5, P6]) Curry5(p0 P0, p1 P1, p2 P2, p3 P3, p4 P4) Func2[P5, P6] {
-	return func(p5 P5, p6 P6)  {
+	return func(p5 P5, p6 P6) {
 		f(p0, p1, p2, p3, p4, p5, p6)
 	}
 }
-	
 
+// Curry4 returns a Func3[P4, P5, P6] with the first 4 arguments of the Func7 bound.
 func (f Func7[P0, P1, P2, P3, P4, P5, P6]) Curry4(p0 P0, p1 P1, p2 P2, p3 P3) Func3[P4, P5, P6] {
-	return func(p4 P4, p5 P5, p6 P6)  {
+	return func(p4 P4, p5 P5, p6 P6) {
 		f(p0, p1, p2, p3, p4, p5, p6)
 	}
 }
-	
 
+// Curry3 returns a Func4[P3, P4, P5, P6] with the first 3 arguments of the Func7 bound.
 func (f Func7[P0, P1, P2, P3, P4, P5, P6]) Curry3(p0 P0, p1 P1, p2 P2) Func4[P3, P4, P5, P6] {
-	return func(p3 P3, p4 P4, p5 P5, p6 P6)  {
+	return func(p3 P3, p4 P4, p5 P5, p6 P6) {
 		f(p0, p1, p2, p3, p4, p5, p6)
 	}
 }
-	
 
+// Curry2 returns a Func5[P2, P3, P4, P5, P6] with the first 2 arguments of the Func7 bound.
 func (f Func7[P0, P1, P2, P3, P4, P5, P6]) Curry2(p0 P0, p1 P1) Func5[P2, P3, P4, P5, P6] {
-	return func(p2 P2, p3 P3, p4 P4, p5 P5, p6 P6)  {
+	return func(p2 P2, p3 P3, p4 P4, p5 P5, p6 P6) {
 		f(p0, p1, p2, p3, p4, p5, p6)
 	}
 }
-	
 
+// Curry1 returns a Func6[P1, P2, P3, P4, P5, P6] with the first argument of the Func7 bound.
 func (f Func7[P0, P1, P2, P3, P4, P5, P6]) Curry1(p0 P0) Func6[P1, P2, P3, P4, P5, P6] {
-	return func(p1 P1, p2 P2, p3 P3, p4 P4, p5 P5, p6 P6)  {
+	return func(p1 P1, p2 P2, p3 P3, p4 P4, p5 P5, p6 P6) {
 		f(p0, p1, p2, p3, p4, p5, p6)
 	}
 }
-	
\ No newline at end of file
@@ -0,0 +1,190 @@
+// Code generated by powerfunc/generator from func_ok.go.tmpl. DO NOT EDIT.
+
+package powerfunc
+
+import (
+	"fmt"
+	"time"
+)
+
+// Func7Ok is a function that takes 7 arguments and returns a value and a
+// boolean reporting whether the value is valid, like a map lookup.
+type Func7Ok[R, P0, P1, P2, P3, P4, P5, P6 any] func(p0 P0, p1 P1, p2 P2, p3 P3, p4 P4, p5 P5, p6 P6) (R, bool)
+
+// Exec executes the function.
+// Convenience method to remove some of the confusion that
+// `f.SomeMethod()()` can bring.
+func (f Func7Ok[R, P0, P1, P2, P3, P4, P5, P6]) Exec(p0 P0, p1 P1, p2 P2, p3 P3, p4 P4, p5 P5, p6 P6) (R, bool) {
+	return f(p0, p1, p2, p3, p4, p5, p6)
+}
+
+// Timing returns a Func7Ok that will log the execution time of the Func7Ok.
+// If no loggers are provided, the default logger (fmt.Println) will be used.
+func (f Func7Ok[R, P0, P1, P2, P3, P4, P5, P6]) Timing(loggers ...func(d time.Duration)) Func7Ok[R, P0, P1, P2, P3, P4, P5, P6] {
+	return f.TimingWithClock(RealClock(), loggers...)
+}
+
+// TimingWithClock is like Timing, but measures the execution time with clock.
+func (f Func7Ok[R, P0, P1, P2, P3, P4, P5, P6]) TimingWithClock(clock Clock, loggers ...func(d time.Duration)) Func7Ok[R, P0, P1, P2, P3, P4, P5, P6] {
+	return func(p0 P0, p1 P1, p2 P2, p3 P3, p4 P4, p5 P5, p6 P6) (R, bool) {
+		start := clock.Now()
+		defer func() {
+			dur := clock.Since(start)
+			for _, logger := range loggers {
+				logger(dur)
+			}
+			if len(loggers) == 0 {
+				// Default logger
+				fmt.Println(dur)
+			}
+		}()
+		return f(p0, p1, p2, p3, p4, p5, p6)
+	}
+}
+
+// Retry returns a Func7Ok that will retry the Func7Ok until it returns
+// true or the tryAgain function returns false.
+// The attempts that returned false fail with a placeholder error, given to
+// tryAgain and to the hooks of the options, e.g. OnRetry, so that any
+// RetryPolicy can be used.
+func (f Func7Ok[R, P0, P1, P2, P3, P4, P5, P6]) Retry(tryAgain RetryPolicy, opts ...RetryOption) Func7Ok[R, P0, P1, P2, P3, P4, P5, P6] {
+	return func(p0 P0, p1 P1, p2 P2, p3 P3, p4 P4, p5 P5, p6 P6) (R, bool) {
+		var v R
+		var ok bool
+		err := retryNoContext(tryAgain, opts, func() error {
+			v, ok = f(p0, p1, p2, p3, p4, p5, p6)
+			if !ok {
+				return errNotOk
+			}
+			return nil
+		})
+		return v, err == nil
+	}
+}
+
+// Must returns a Func7Value that will panic if the Func7Ok returns false.
+func (f Func7Ok[R, P0, P1, P2, P3, P4, P5, P6]) Must() Func7Value[R, P0, P1, P2, P3, P4, P5, P6] {
+	return func(p0 P0, p1 P1, p2 P2, p3 P3, p4 P4, p5 P5, p6 P6) R {
+		v, ok := f(p0, p1, p2, p3, p4, p5, p6)
+		if !ok {
+			panic("powerfunc: Func7Ok returned false")
+		}
+		return v
+	}
+}
+
+// Fallback returns a Func7Value that will return the provided value if the
+// Func7Ok returns false.
+func (f Func7Ok[R, P0, P1, P2, P3, P4, P5, P6]) Fallback(val R) Func7Value[R, P0, P1, P2, P3, P4, P5, P6] {
+	return func(p0 P0, p1 P1, p2 P2, p3 P3, p4 P4, p5 P5, p6 P6) R {
+		v, ok := f(p0, p1, p2, p3, p4, p5, p6)
+		if !ok {
+			return val
+		}
+		return v
+	}
+}
+
+// OrElse returns a Func7Value that will return the provided value if the
+// Func7Ok returns false. It is an alias of Fallback.
+func (f Func7Ok[R, P0, P1, P2, P3, P4, P5, P6]) OrElse(val R) Func7Value[R, P0, P1, P2, P3, P4, P5, P6] {
+	return f.Fallback(val)
+}
+
+// OrElseGet returns a Func7Value that will call elsef if the Func7Ok
+// returns false.
+func (f Func7Ok[R, P0, P1, P2, P3, P4, P5, P6]) OrElseGet(elsef Func7Value[R, P0, P1, P2, P3, P4, P5, P6]) Func7Value[R, P0, P1, P2, P3, P4, P5, P6] {
+	return func(p0 P0, p1 P1, p2 P2, p3 P3, p4 P4, p5 P5, p6 P6) R {
+		v, ok := f(p0, p1, p2, p3, p4, p5, p6)
+		if !ok {
+			return elsef(p0, p1, p2, p3, p4, p5, p6)
+		}
+		return v
+	}
+}
+
+// ToResult transforms a Func7Ok into a Func7Result returning the provided
+// error when the Func7Ok returns false.
+func (f Func7Ok[R, P0, P1, P2, P3, P4, P5, P6]) ToResult(err error) Func7Result[R, P0, P1, P2, P3, P4, P5, P6] {
+	return func(p0 P0, p1 P1, p2 P2, p3 P3, p4 P4, p5 P5, p6 P6) (R, error) {
+		v, ok := f(p0, p1, p2, p3, p4, p5, p6)
+		if !ok {
+			return v, err
+		}
+		return v, nil
+	}
+}
+
+// Filter returns a Func7Ok that will return false if the value returned by
+// the Func7Ok does not satisfy keep.
+func (f Func7Ok[R, P0, P1, P2, P3, P4, P5, P6]) Filter(keep func(R) bool) Func7Ok[R, P0, P1, P2, P3, P4, P5, P6] {
+	return func(p0 P0, p1 P1, p2 P2, p3 P3, p4 P4, p5 P5, p6 P6) (R, bool) {
+		v, ok := f(p0, p1, p2, p3, p4, p5, p6)
+		if !ok || !keep(v) {
+			var zero R
+			return zero, false
+		}
+		return v, true
+	}
+}
+
+// Map applies the provided function to the value returned by the Func7Ok,
+// if it returns true.
+func (f Func7Ok[R, P0, P1, P2, P3, P4, P5, P6]) Map(fn func(R) R) Func7Ok[R, P0, P1, P2, P3, P4, P5, P6] {
+	return func(p0 P0, p1 P1, p2 P2, p3 P3, p4 P4, p5 P5, p6 P6) (R, bool) {
+		v, ok := f(p0, p1, p2, p3, p4, p5, p6)
+		if !ok {
+			return v, false
+		}
+		return fn(v), true
+	}
+}
+
+// Curry7 returns a FuncOk[R] with the first 7 arguments of the Func7Ok bound.
+func (f Func7Ok[R, P0, P1, P2, P3, P4, P5, P6]) Curry7(p0 P0, p1 P1, p2 P2, p3 P3, p4 P4, p5 P5, p6 P6) FuncOk[R] {
+	return func() (R, bool) {
+		return f(p0, p1, p2, p3, p4, p5, p6)
+	}
+}
+
+// Curry6 returns a Func1Ok[R, P6] with the first 6 arguments of the Func7Ok bound.
+func (f Func7Ok[R, P0, P1, P2, P3, P4, P5, P6]) Curry6(p0 P0, p1 P1, p2 P2, p3 P3, p4 P4, p5 P5) Func1Ok[R, P6] {
+	return func(p6 P6) (R, bool) {
+		return f(p0, p1, p2, p3, p4, p5, p6)
+	}
+}
+
+// Curry5 returns a Func2Ok[R, P5, P6] with the first 5 arguments of the Func7Ok bound.
+func (f Func7Ok[R, P0, P1, P2, P3, P4, P5, P6]) Curry5(p0 P0, p1 P1, p2 P2, p3 P3, p4 P4) Func2Ok[R, P5, P6] {
+	return func(p5 P5, p6 P6) (R, bool) {
+		return f(p0, p1, p2, p3, p4, p5, p6)
+	}
+}
+
+// Curry4 returns a Func3Ok[R, P4, P5, P6] with the first 4 arguments of the Func7Ok bound.
+func (f Func7Ok[R, P0, P1, P2, P3, P4, P5, P6]) Curry4(p0 P0, p1 P1, p2 P2, p3 P3) Func3Ok[R, P4, P5, P6] {
+	return func(p4 P4, p5 P5, p6 P6) (R, bool) {
+		return f(p0, p1, p2, p3, p4, p5, p6)
+	}
+}
+
+// Curry3 returns a Func4Ok[R, P3, P4, P5, P6] with the first 3 arguments of the Func7Ok bound.
+func (f Func7Ok[R, P0, P1, P2, P3, P4, P5, P6]) Curry3(p0 P0, p1 P1, p2 P2) Func4Ok[R, P3, P4, P5, P6] {
+	return func(p3 P3, p4 P4, p5 P5, p6 P6) (R, bool) {
+		return f(p0, p1, p2, p3, p4, p5, p6)
+	}
+}
+
+// Curry2 returns a Func5Ok[R, P2, P3, P4, P5, P6] with the first 2 arguments of the Func7Ok bound.
+func (f Func7Ok[R, P0, P1, P2, P3, P4, P5, P6]) Curry2(p0 P0, p1 P1) Func5Ok[R, P2, P3, P4, P5, P6] {
+	return func(p2 P2, p3 P3, p4 P4, p5 P5, p6 P6) (R, bool) {
+		return f(p0, p1, p2, p3, p4, p5, p6)
+	}
+}
+
+// Curry1 returns a Func6Ok[R, P1, P2, P3, P4, P5, P6] with the first argument of the Func7Ok bound.
+func (f Func7Ok[R, P0, P1, P2, P3, P4, P5, P6]) Curry1(p0 P0) Func6Ok[R, P1, P2, P3, P4, P5, P6] {
+	return func(p1 P1, p2 P2, p3 P3, p4 P4, p5 P5, p6 P6) (R, bool) {
+		return f(p0, p1, p2, p3, p4, p5, p6)
+	}
+}
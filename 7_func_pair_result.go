@@ -0,0 +1,174 @@
+// Code generated by powerfunc/generator from func_pair_result.go.tmpl. DO NOT EDIT.
+
+package powerfunc
+
+import (
+	"context"
+	"fmt"
+	"time"
+)
+
+// Func7PairResult is a function that takes 7 arguments and returns two
+// values and an error.
+type Func7PairResult[R1, R2, P0, P1, P2, P3, P4, P5, P6 any] func(p0 P0, p1 P1, p2 P2, p3 P3, p4 P4, p5 P5, p6 P6) (R1, R2, error)
+
+// Exec executes the function.
+// Convenience method to remove some of the confusion that
+// `f.SomeMethod()()` can bring.
+func (f Func7PairResult[R1, R2, P0, P1, P2, P3, P4, P5, P6]) Exec(p0 P0, p1 P1, p2 P2, p3 P3, p4 P4, p5 P5, p6 P6) (R1, R2, error) {
+	return f(p0, p1, p2, p3, p4, p5, p6)
+}
+
+// Timing returns a Func7PairResult that will log the execution time of the Func7PairResult.
+// If no loggers are provided, the default logger (fmt.Println) will be used.
+func (f Func7PairResult[R1, R2, P0, P1, P2, P3, P4, P5, P6]) Timing(loggers ...func(d time.Duration)) Func7PairResult[R1, R2, P0, P1, P2, P3, P4, P5, P6] {
+	return f.TimingWithClock(RealClock(), loggers...)
+}
+
+// TimingWithClock is like Timing, but measures the execution time with clock.
+func (f Func7PairResult[R1, R2, P0, P1, P2, P3, P4, P5, P6]) TimingWithClock(clock Clock, loggers ...func(d time.Duration)) Func7PairResult[R1, R2, P0, P1, P2, P3, P4, P5, P6] {
+	return func(p0 P0, p1 P1, p2 P2, p3 P3, p4 P4, p5 P5, p6 P6) (R1, R2, error) {
+		start := clock.Now()
+		defer func() {
+			dur := clock.Since(start)
+			for _, logger := range loggers {
+				logger(dur)
+			}
+			if len(loggers) == 0 {
+				// Default logger
+				fmt.Println(dur)
+			}
+		}()
+		return f(p0, p1, p2, p3, p4, p5, p6)
+	}
+}
+
+// Retry returns a Func7PairResult that will retry the Func7PairResult until it returns
+// a nil error or the tryAgain function returns false, in which case it
+// returns a *RetryError holding the errors of all the attempts.
+// The options set the backoff between attempts and hooks, e.g. OnRetry.
+func (f Func7PairResult[R1, R2, P0, P1, P2, P3, P4, P5, P6]) Retry(tryAgain func(attempts int, err error) bool, opts ...RetryOption) Func7PairResult[R1, R2, P0, P1, P2, P3, P4, P5, P6] {
+	return func(p0 P0, p1 P1, p2 P2, p3 P3, p4 P4, p5 P5, p6 P6) (R1, R2, error) {
+		var r0 R1
+		var r1 R2
+		err := retryNoContext(tryAgain, opts, func() error {
+			var err error
+			r0, r1, err = f(p0, p1, p2, p3, p4, p5, p6)
+			return err
+		})
+		return r0, r1, err
+	}
+}
+
+// Must returns a Func7Pair that will panic if the Func7PairResult returns an error.
+func (f Func7PairResult[R1, R2, P0, P1, P2, P3, P4, P5, P6]) Must() Func7Pair[R1, R2, P0, P1, P2, P3, P4, P5, P6] {
+	return func(p0 P0, p1 P1, p2 P2, p3 P3, p4 P4, p5 P5, p6 P6) (R1, R2) {
+		v1, v2, err := f(p0, p1, p2, p3, p4, p5, p6)
+		if err != nil {
+			panic(err)
+		}
+		return v1, v2
+	}
+}
+
+// OnErr returns a Func7PairResult that will wrap the error returned by the
+// Func7PairResult with the provided message.
+func (f Func7PairResult[R1, R2, P0, P1, P2, P3, P4, P5, P6]) OnErr(msg string) Func7PairResult[R1, R2, P0, P1, P2, P3, P4, P5, P6] {
+	return func(p0 P0, p1 P1, p2 P2, p3 P3, p4 P4, p5 P5, p6 P6) (R1, R2, error) {
+		v1, v2, err := f(p0, p1, p2, p3, p4, p5, p6)
+		if err != nil {
+			return v1, v2, fmt.Errorf("%s: %w", msg, err)
+		}
+		return v1, v2, nil
+	}
+}
+
+// MapErr applies the provided function to the error returned by the
+// Func7PairResult, if there is an error.
+func (f Func7PairResult[R1, R2, P0, P1, P2, P3, P4, P5, P6]) MapErr(fn func(error) error) Func7PairResult[R1, R2, P0, P1, P2, P3, P4, P5, P6] {
+	return func(p0 P0, p1 P1, p2 P2, p3 P3, p4 P4, p5 P5, p6 P6) (R1, R2, error) {
+		v1, v2, err := f(p0, p1, p2, p3, p4, p5, p6)
+		if err != nil {
+			return v1, v2, fn(err)
+		}
+		return v1, v2, nil
+	}
+}
+
+// Fallback returns a Func7Pair that will return the provided values if the
+// Func7PairResult returns an error.
+func (f Func7PairResult[R1, R2, P0, P1, P2, P3, P4, P5, P6]) Fallback(val1 R1, val2 R2) Func7Pair[R1, R2, P0, P1, P2, P3, P4, P5, P6] {
+	return func(p0 P0, p1 P1, p2 P2, p3 P3, p4 P4, p5 P5, p6 P6) (R1, R2) {
+		v1, v2, err := f(p0, p1, p2, p3, p4, p5, p6)
+		if err != nil {
+			return val1, val2
+		}
+		return v1, v2
+	}
+}
+
+// InjectFaults returns a Func7PairResult injecting the errors, panics and latency
+// configured by cfg in its calls, to test how the callers cope with them.
+// See FaultConfig, and DisableFaults for the global kill-switch.
+// The faults cannot be enabled per request with OptIn, as there is no
+// context.
+func (f Func7PairResult[R1, R2, P0, P1, P2, P3, P4, P5, P6]) InjectFaults(cfg FaultConfig) Func7PairResult[R1, R2, P0, P1, P2, P3, P4, P5, P6] {
+	fi := newFaultInjector(cfg)
+	return func(p0 P0, p1 P1, p2 P2, p3 P3, p4 P4, p5 P5, p6 P6) (R1, R2, error) {
+		if err := fi.inject(context.Background(), p0, p1, p2, p3, p4, p5, p6); err != nil {
+			var r0 R1
+			var r1 R2
+			return r0, r1, err
+		}
+		return f(p0, p1, p2, p3, p4, p5, p6)
+	}
+}
+
+// Curry7 returns a FuncPairResult[R1, R2] with the first 7 arguments of the Func7PairResult bound.
+func (f Func7PairResult[R1, R2, P0, P1, P2, P3, P4, P5, P6]) Curry7(p0 P0, p1 P1, p2 P2, p3 P3, p4 P4, p5 P5, p6 P6) FuncPairResult[R1, R2] {
+	return func() (R1, R2, error) {
+		return f(p0, p1, p2, p3, p4, p5, p6)
+	}
+}
+
+// Curry6 returns a Func1PairResult[R1, R2, P6] with the first 6 arguments of the Func7PairResult bound.
+func (f Func7PairResult[R1, R2, P0, P1, P2, P3, P4, P5, P6]) Curry6(p0 P0, p1 P1, p2 P2, p3 P3, p4 P4, p5 P5) Func1PairResult[R1, R2, P6] {
+	return func(p6 P6) (R1, R2, error) {
+		return f(p0, p1, p2, p3, p4, p5, p6)
+	}
+}
+
+// Curry5 returns a Func2PairResult[R1, R2, P5, P6] with the first 5 arguments of the Func7PairResult bound.
+func (f Func7PairResult[R1, R2, P0, P1, P2, P3, P4, P5, P6]) Curry5(p0 P0, p1 P1, p2 P2, p3 P3, p4 P4) Func2PairResult[R1, R2, P5, P6] {
+	return func(p5 P5, p6 P6) (R1, R2, error) {
+		return f(p0, p1, p2, p3, p4, p5, p6)
+	}
+}
+
+// Curry4 returns a Func3PairResult[R1, R2, P4, P5, P6] with the first 4 arguments of the Func7PairResult bound.
+func (f Func7PairResult[R1, R2, P0, P1, P2, P3, P4, P5, P6]) Curry4(p0 P0, p1 P1, p2 P2, p3 P3) Func3PairResult[R1, R2, P4, P5, P6] {
+	return func(p4 P4, p5 P5, p6 P6) (R1, R2, error) {
+		return f(p0, p1, p2, p3, p4, p5, p6)
+	}
+}
+
+// Curry3 returns a Func4PairResult[R1, R2, P3, P4, P5, P6] with the first 3 arguments of the Func7PairResult bound.
+func (f Func7PairResult[R1, R2, P0, P1, P2, P3, P4, P5, P6]) Curry3(p0 P0, p1 P1, p2 P2) Func4PairResult[R1, R2, P3, P4, P5, P6] {
+	return func(p3 P3, p4 P4, p5 P5, p6 P6) (R1, R2, error) {
+		return f(p0, p1, p2, p3, p4, p5, p6)
+	}
+}
+
+// Curry2 returns a Func5PairResult[R1, R2, P2, P3, P4, P5, P6] with the first 2 arguments of the Func7PairResult bound.
+func (f Func7PairResult[R1, R2, P0, P1, P2, P3, P4, P5, P6]) Curry2(p0 P0, p1 P1) Func5PairResult[R1, R2, P2, P3, P4, P5, P6] {
+	return func(p2 P2, p3 P3, p4 P4, p5 P5, p6 P6) (R1, R2, error) {
+		return f(p0, p1, p2, p3, p4, p5, p6)
+	}
+}
+
+// Curry1 returns a Func6PairResult[R1, R2, P1, P2, P3, P4, P5, P6] with the first argument of the Func7PairResult bound.
+func (f Func7PairResult[R1, R2, P0, P1, P2, P3, P4, P5, P6]) Curry1(p0 P0) Func6PairResult[R1, R2, P1, P2, P3, P4, P5, P6] {
+	return func(p1 P1, p2 P2, p3 P3, p4 P4, p5 P5, p6 P6) (R1, R2, error) {
+		return f(p0, p1, p2, p3, p4, p5, p6)
+	}
+}
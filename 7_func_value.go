@@ -1,27 +1,35 @@
+// Code generated by powerfunc/generator from func_value.go.tmpl. DO NOT EDIT.
+
 package powerfunc
 
 import (
+	"context"
 	"fmt"
 	"time"
 )
 
-// Func7 is a function that takes 0 arguments and returns a value.
-type Func7Value[T, P0, P1, P2, P3, P4, P5, P6 any] func(p0 P0, p1 P1, p2 P2, p3 P3, p4 P4, p5 P5, p6 P6) T
+// Func7Value is a function that takes 7 arguments and returns a value.
+type Func7Value[R, P0, P1, P2, P3, P4, P5, P6 any] func(p0 P0, p1 P1, p2 P2, p3 P3, p4 P4, p5 P5, p6 P6) R
 
 // Exec executes the function.
 // Convenience method to remove some of the confusion that
 // `f.SomeMethod()()` can bring.
-func (f Func7Value[T, P0, P1, P2, P3, P4, P5, P6]) Exec(p0 P0, p1 P1, p2 P2, p3 P3, p4 P4, p5 P5, p6 P6) T {
+func (f Func7Value[R, P0, P1, P2, P3, P4, P5, P6]) Exec(p0 P0, p1 P1, p2 P2, p3 P3, p4 P4, p5 P5, p6 P6) R {
 	return f(p0, p1, p2, p3, p4, p5, p6)
 }
 
-// Timing returns a Func7 that will log the execution time of the Func7.
+// Timing returns a Func7Value that will log the execution time of the Func7Value.
 // If no loggers are provided, the default logger (fmt.Println) will be used.
-func (f Func7Value[T, P0, P1, P2, P3, P4, P5, P6]) Timing(loggers ...func(d time.Duration)) Func7Value[T, P0, P1, P2, P3, P4, P5, P6] {
-	return func(p0 P0, p1 P1, p2 P2, p3 P3, p4 P4, p5 P5, p6 P6) T {
-		start := time.Now()
+func (f Func7Value[R, P0, P1, P2, P3, P4, P5, P6]) Timing(loggers ...func(d time.Duration)) Func7Value[R, P0, P1, P2, P3, P4, P5, P6] {
+	return f.TimingWithClock(RealClock(), loggers...)
+}
+
+// TimingWithClock is like Timing, but measures the execution time with clock.
+func (f Func7Value[R, P0, P1, P2, P3, P4, P5, P6]) TimingWithClock(clock Clock, loggers ...func(d time.Duration)) Func7Value[R, P0, P1, P2, P3, P4, P5, P6] {
+	return func(p0 P0, p1 P1, p2 P2, p3 P3, p4 P4, p5 P5, p6 P6) R {
+		start := clock.Now()
 		defer func() {
-			dur := time.Since(start)
+			dur := clock.Since(start)
 			for _, logger := range loggers {
 				logger(dur)
 			}
@@ -37,58 +45,103 @@ func (f Func7Value[T, P0, P1, P2, P3, P4, P5, P6]) Timing(loggers ...func(d time
 // Fallible transforms a Func7Value into a Func7Result.
 // The returned Func7Result will never return an error.
 // Useful when passing a Func7Value to a function that expects a Func7Result.
-func (f Func7Value[T, P0, P1, P2, P3, P4, P5, P6]) Fallible() Func7Result[T, P0, P1, P2, P3, P4, P5, P6] {
-	return func(p0 P0, p1 P1, p2 P2, p3 P3, p4 P4, p5 P5, p6 P6) (T, error) {
+func (f Func7Value[R, P0, P1, P2, P3, P4, P5, P6]) Fallible() Func7Result[R, P0, P1, P2, P3, P4, P5, P6] {
+	return func(p0 P0, p1 P1, p2 P2, p3 P3, p4 P4, p5 P5, p6 P6) (R, error) {
 		return f(p0, p1, p2, p3, p4, p5, p6), nil
 	}
 }
 
+// Once returns a Func7Value that only executes the first time it is called
+// and returns the cached value afterwards, along with the Once controlling it.
+func (f Func7Value[R, P0, P1, P2, P3, P4, P5, P6]) Once(opts ...OnceOption) (Func7Value[R, P0, P1, P2, P3, P4, P5, P6], *Once) {
+	o := newOnce(opts...)
+	return func(p0 P0, p1 P1, p2 P2, p3 P3, p4 P4, p5 P5, p6 P6) R {
+		v, _ := o.do(context.Background(), false, func() (any, error) {
+			return f(p0, p1, p2, p3, p4, p5, p6), nil
+		})
+		val, _ := v.(R)
+		return val
+	}, o
+}
+
+// Lazy returns a Func7Value that computes its value the first time it is
+// called and returns the cached value afterwards, along with the Once
+// controlling it.
+func (f Func7Value[R, P0, P1, P2, P3, P4, P5, P6]) Lazy(opts ...OnceOption) (Func7Value[R, P0, P1, P2, P3, P4, P5, P6], *Once) {
+	o := newLazy(opts...)
+	return func(p0 P0, p1 P1, p2 P2, p3 P3, p4 P4, p5 P5, p6 P6) R {
+		v, _ := o.do(context.Background(), false, func() (any, error) {
+			return f(p0, p1, p2, p3, p4, p5, p6), nil
+		})
+		val, _ := v.(R)
+		return val
+	}, o
+}
+
+// WithTimeout returns a Func7Result that runs the Func7Value in its own
+// goroutine and returns a *TimeoutError, matching ErrTimeout, if it does not
+// return within timeout. The goroutine is then abandoned: it keeps running
+// until the Func7Value returns, and is counted by Abandoned.
+// Prefer the WithTimeout method of a function taking a context, which can
+// actually be cancelled.
+func (f Func7Value[R, P0, P1, P2, P3, P4, P5, P6]) WithTimeout(timeout time.Duration, opts ...TimeoutOption) Func7Result[R, P0, P1, P2, P3, P4, P5, P6] {
+	return func(p0 P0, p1 P1, p2 P2, p3 P3, p4 P4, p5 P5, p6 P6) (R, error) {
+		var r0 R
+		if terr := callWithTimeout(timeout, opts, func() {
+			r0 = f(p0, p1, p2, p3, p4, p5, p6)
+		}); terr != nil {
+			var zero R
+			return zero, terr
+		}
+		return r0, nil
+	}
+}
 
+// Curry7 returns a FuncValue[R] with the first 7 arguments of the Func7Value bound.
 func (f Func7Value[R, P0, P1, P2, P3, P4, P5, P6]) Curry7(p0 P0, p1 P1, p2 P2, p3 P3, p4 P4, p5 P5, p6 P6) FuncValue[R] {
 	return func() R {
 		return f(p0, p1, p2, p3, p4, p5, p6)
 	}
 }
-	
 
+// Curry6 returns a Func1Value[R, P6] with the first 6 arguments of the Func7Value bound.
 func (f Func7Value[R, P0, P1, P2, P3, P4, P5, P6]) Curry6(p0 P0, p1 P1, p2 P2, p3 P3, p4 P4, p5 P5) Func1Value[R, P6] {
 	return func(p6 P6) R {
 		return f(p0, p1, p2, p3, p4, p5, p6)
 	}
 }
-	
 
+// Curry5 returns a Func2Value[R, P5, P6] with the first 5 arguments of the Func7Value bound.
 func (f Func7Value[R, P0, P1, P2, P3, P4, P5, P6]) Curry5(p0 P0, p1 P1, p2 P2, p3 P3, p4 P4) Func2Value[R, P5, P6] {
 	return func(p5 P5, p6 P6) R {
 		return f(p0, p1, p2, p3, p4, p5, p6)
 	}
 }
-	
 
+// Curry4 returns a Func3Value[R, P4, P5, P6] with the first 4 arguments of the Func7Value bound.
 func (f Func7Value[R, P0, P1, P2, P3, P4, P5, P6]) Curry4(p0 P0, p1 P1, p2 P2, p3 P3) Func3Value[R, P4, P5, P6] {
 	return func(p4 P4, p5 P5, p6 P6) R {
 		return f(p0, p1, p2, p3, p4, p5, p6)
 	}
 }
-	
 
+// Curry3 returns a Func4Value[R, P3, P4, P5, P6] with the first 3 arguments of the Func7Value bound.
 func (f Func7Value[R, P0, P1, P2, P3, P4, P5, P6]) Curry3(p0 P0, p1 P1, p2 P2) Func4Value[R, P3, P4, P5, P6] {
 	return func(p3 P3, p4 P4, p5 P5, p6 P6) R {
 		return f(p0, p1, p2, p3, p4, p5, p6)
 	}
 }
-	
 
+// Curry2 returns a Func5Value[R, P2, P3, P4, P5, P6] with the first 2 arguments of the Func7Value bound.
 func (f Func7Value[R, P0, P1, P2, P3, P4, P5, P6]) Curry2(p0 P0, p1 P1) Func5Value[R, P2, P3, P4, P5, P6] {
 	return func(p2 P2, p3 P3, p4 P4, p5 P5, p6 P6) R {
 		return f(p0, p1, p2, p3, p4, p5, p6)
 	}
 }
-	
 
+// Curry1 returns a Func6Value[R, P1, P2, P3, P4, P5, P6] with the first argument of the Func7Value bound.
 func (f Func7Value[R, P0, P1, P2, P3, P4, P5, P6]) Curry1(p0 P0) Func6Value[R, P1, P2, P3, P4, P5, P6] {
 	return func(p1 P1, p2 P2, p3 P3, p4 P4, p5 P5, p6 P6) R {
 		return f(p0, p1, p2, p3, p4, p5, p6)
 	}
 }
-	
\ No newline at end of file
@@ -1,3 +1,5 @@
+// Code generated by powerfunc/generator from ctx_func.go.tmpl. DO NOT EDIT.
+
 package powerfunc
 
 import (
@@ -13,10 +15,15 @@ func (f CtxFunc8[P0, P1, P2, P3, P4, P5, P6, P7]) Exec(ctx context.Context, p0 P
 }
 
 func (f CtxFunc8[P0, P1, P2, P3, P4, P5, P6, P7]) Timing(loggers ...func(d time.Duration)) CtxFunc8[P0, P1, P2, P3, P4, P5, P6, P7] {
+	return f.TimingWithClock(RealClock(), loggers...)
+}
+
+// TimingWithClock is like Timing, but measures the execution time with clock.
+func (f CtxFunc8[P0, P1, P2, P3, P4, P5, P6, P7]) TimingWithClock(clock Clock, loggers ...func(d time.Duration)) CtxFunc8[P0, P1, P2, P3, P4, P5, P6, P7] {
 	return func(ctx context.Context, p0 P0, p1 P1, p2 P2, p3 P3, p4 P4, p5 P5, p6 P6, p7 P7) {
-		start := time.Now()
+		start := clock.Now()
 		defer func() {
-			dur := time.Since(start)
+			dur := clock.Since(start)
 			for _, logger := range loggers {
 				logger(dur)
 			}
@@ -60,59 +67,171 @@ func (f CtxFunc8[P0, P1, P2, P3, P4, P5, P6, P7]) WithCancel() CtxFunc8[P0, P1,
 	}
 }
 
+// WithValue returns a CtxFunc8 calling the CtxFunc8 with a context
+// carrying val for key, see context.WithValue.
+func (f CtxFunc8[P0, P1, P2, P3, P4, P5, P6, P7]) WithValue(key, val any) CtxFunc8[P0, P1, P2, P3, P4, P5, P6, P7] {
+	return func(ctx context.Context, p0 P0, p1 P1, p2 P2, p3 P3, p4 P4, p5 P5, p6 P6, p7 P7) {
+		ctx = context.WithValue(ctx, key, val)
+		f(ctx, p0, p1, p2, p3, p4, p5, p6, p7)
+	}
+}
+
+// WithValueFrom returns a CtxFunc8 calling the CtxFunc8 with a context
+// carrying the value returned by value for its key. value gets the arguments
+// of every call.
+func (f CtxFunc8[P0, P1, P2, P3, P4, P5, P6, P7]) WithValueFrom(value func(ctx context.Context, p0 P0, p1 P1, p2 P2, p3 P3, p4 P4, p5 P5, p6 P6, p7 P7) (key, val any)) CtxFunc8[P0, P1, P2, P3, P4, P5, P6, P7] {
+	return func(ctx context.Context, p0 P0, p1 P1, p2 P2, p3 P3, p4 P4, p5 P5, p6 P6, p7 P7) {
+		key, val := value(ctx, p0, p1, p2, p3, p4, p5, p6, p7)
+		ctx = context.WithValue(ctx, key, val)
+		f(ctx, p0, p1, p2, p3, p4, p5, p6, p7)
+	}
+}
+
+// Detached returns a CtxFunc8 calling the CtxFunc8 with a context that is
+// never cancelled but keeps the values of the caller's context, see
+// context.WithoutCancel. Useful for cleanups that must run to completion.
+func (f CtxFunc8[P0, P1, P2, P3, P4, P5, P6, P7]) Detached() CtxFunc8[P0, P1, P2, P3, P4, P5, P6, P7] {
+	return func(ctx context.Context, p0 P0, p1 P1, p2 P2, p3 P3, p4 P4, p5 P5, p6 P6, p7 P7) {
+		ctx = context.WithoutCancel(ctx)
+		f(ctx, p0, p1, p2, p3, p4, p5, p6, p7)
+	}
+}
+
+// WithTimeoutCause is like WithTimeout, but the context is cancelled with
+// cause when the timeout expires, see context.WithTimeoutCause.
+func (f CtxFunc8[P0, P1, P2, P3, P4, P5, P6, P7]) WithTimeoutCause(timeout time.Duration, cause error) CtxFunc8[P0, P1, P2, P3, P4, P5, P6, P7] {
+	return func(ctx context.Context, p0 P0, p1 P1, p2 P2, p3 P3, p4 P4, p5 P5, p6 P6, p7 P7) {
+		ctx, cancel := context.WithTimeoutCause(ctx, timeout, cause)
+		defer cancel()
+		f(ctx, p0, p1, p2, p3, p4, p5, p6, p7)
+	}
+}
+
+// WithDeadlineCause is like WithDeadline, but the context is cancelled with
+// cause when the deadline expires, see context.WithDeadlineCause.
+func (f CtxFunc8[P0, P1, P2, P3, P4, P5, P6, P7]) WithDeadlineCause(deadline time.Time, cause error) CtxFunc8[P0, P1, P2, P3, P4, P5, P6, P7] {
+	return func(ctx context.Context, p0 P0, p1 P1, p2 P2, p3 P3, p4 P4, p5 P5, p6 P6, p7 P7) {
+		ctx, cancel := context.WithDeadlineCause(ctx, deadline, cause)
+		defer cancel()
+		f(ctx, p0, p1, p2, p3, p4, p5, p6, p7)
+	}
+}
+
+// Debounce returns a CtxFunc8 that delays its execution until wait has elapsed
+// since the last call, along with the Debouncer controlling it.
+// Only the most recent call, and its context, is kept and eventually invoked.
+func (f CtxFunc8[P0, P1, P2, P3, P4, P5, P6, P7]) Debounce(wait time.Duration, opts ...DebounceOption) (CtxFunc8[P0, P1, P2, P3, P4, P5, P6, P7], *Debouncer) {
+	d := newDebouncer(wait, opts...)
+	return func(ctx context.Context, p0 P0, p1 P1, p2 P2, p3 P3, p4 P4, p5 P5, p6 P6, p7 P7) {
+		d.call(func() {
+			f(ctx, p0, p1, p2, p3, p4, p5, p6, p7)
+		})
+	}, d
+}
+
+// Throttle returns a CtxFunc8 that executes at most once per interval, along
+// with the Debouncer controlling it.
+// The first call is invoked immediately, and the most recent call made
+// during the interval is invoked at the end of it.
+// The options, e.g. DebounceClock, apply to the underlying Debouncer.
+func (f CtxFunc8[P0, P1, P2, P3, P4, P5, P6, P7]) Throttle(interval time.Duration, opts ...DebounceOption) (CtxFunc8[P0, P1, P2, P3, P4, P5, P6, P7], *Debouncer) {
+	d := newThrottler(interval, opts...)
+	return func(ctx context.Context, p0 P0, p1 P1, p2 P2, p3 P3, p4 P4, p5 P5, p6 P6, p7 P7) {
+		d.call(func() {
+			f(ctx, p0, p1, p2, p3, p4, p5, p6, p7)
+		})
+	}, d
+}
+
+// Once returns a CtxFunc8 that only executes the first time it is called,
+// along with the Once controlling it.
+// The shared execution does not inherit the cancellation of the caller's
+// context: a caller whose context is done returns without waiting for it,
+// while the execution carries on for the other callers.
+func (f CtxFunc8[P0, P1, P2, P3, P4, P5, P6, P7]) Once(opts ...OnceOption) (CtxFunc8[P0, P1, P2, P3, P4, P5, P6, P7], *Once) {
+	o := newOnce(opts...)
+	return func(ctx context.Context, p0 P0, p1 P1, p2 P2, p3 P3, p4 P4, p5 P5, p6 P6, p7 P7) {
+		_, _ = o.do(ctx, true, func() (any, error) {
+			ctx := context.WithoutCancel(ctx)
+			f(ctx, p0, p1, p2, p3, p4, p5, p6, p7)
+			return nil, nil
+		})
+	}, o
+}
+
+// Lazy returns a CtxFunc8 that executes the first time it is called,
+// along with the Once controlling it.
+// A CtxFunc8 cannot fail, so Lazy behaves like Once unless it panics, in which
+// case the next call executes it again.
+func (f CtxFunc8[P0, P1, P2, P3, P4, P5, P6, P7]) Lazy(opts ...OnceOption) (CtxFunc8[P0, P1, P2, P3, P4, P5, P6, P7], *Once) {
+	o := newLazy(opts...)
+	return func(ctx context.Context, p0 P0, p1 P1, p2 P2, p3 P3, p4 P4, p5 P5, p6 P6, p7 P7) {
+		_, _ = o.do(ctx, true, func() (any, error) {
+			ctx := context.WithoutCancel(ctx)
+			f(ctx, p0, p1, p2, p3, p4, p5, p6, p7)
+			return nil, nil
+		})
+	}, o
+}
+
+// Every returns a runner that calls the CtxFunc8 every interval until its
+// context is done. See CtxFunc8Error.Every for the available options.
+func (f CtxFunc8[P0, P1, P2, P3, P4, P5, P6, P7]) Every(interval time.Duration, opts ...EveryOption) CtxFunc8Error[P0, P1, P2, P3, P4, P5, P6, P7] {
+	return f.Fallible().Every(interval, opts...)
+}
 
+// Curry8 returns a CtxFunc with the first 8 arguments of the CtxFunc8 bound.
 func (f CtxFunc8[P0, P1, P2, P3, P4, P5, P6, P7]) Curry8(p0 P0, p1 P1, p2 P2, p3 P3, p4 P4, p5 P5, p6 P6, p7 P7) CtxFunc {
-	return func(ctx context.Context)  {
+	return func(ctx context.Context) {
 		f(ctx, p0, p1, p2, p3, p4, p5, p6, p7)
 	}
 }
-	
 
+// Curry7 returns a CtxFunc1[P7] with the first 7 arguments of the CtxFunc8 bound.
 func (f CtxFunc8[P0, P1, P2, P3, P4, P5, P6, P7]) Curry7(p0 P0, p1 P1, p2 P2, p3 P3, p4 P4, p5 P5, p6 P6) CtxFunc1[P7] {
-	return func(ctx context.Context, p7 P7)  {
+	return func(ctx context.Context, p7 P7) {
 		f(ctx, p0, p1, p2, p3, p4, p5, p6, p7)
 	}
 }
-	
 
+// Curry6 returns a CtxFunc2[P6, P7] with the first 6 arguments of the CtxFunc8 bound.
 func (f CtxFunc8[P0, P1, P2, P3, P4, P5, P6, P7]) Curry6(p0 P0, p1 P1, p2 P2, p3 P3, p4 P4, p5 P5) CtxFunc2[P6, P7] {
-	return func(ctx context.Context, p6 P6, p7 P7)  {
+	return func(ctx context.Context, p6 P6, p7 P7) {
 		f(ctx, p0, p1, p2, p3, p4, p5, p6, p7)
 	}
 }
-	
 
+// Curry5 returns a CtxFunc3[P5, P6, P7] with the first 5 arguments of the CtxFunc8 bound.
 func (f CtxFunc8[P0, P1, P2, P3, P4, P5, P6, P7]) Curry5(p0 P0, p1 P1, p2 P2, p3 P3, p4 P4) CtxFunc3[P5, P6, P7] {
-	return func(ctx context.Context, p5 P5, p6 P6, p7 P7)  {
+	return func(ctx context.Context, p5 P5, p6 P6, p7 P7) {
 		f(ctx, p0, p1, p2, p3, p4, p5, p6, p7)
 	}
 }
-	
 
+// Curry4 returns a CtxFunc4[P4, P5, P6, P7] with the first 4 arguments of the CtxFunc8 bound.
 func (f CtxFunc8[P0, P1, P2, P3, P4, P5, P6, P7]) Curry4(p0 P0, p1 P1, p2 P2, p3 P3) CtxFunc4[P4, P5, P6, P7] {
-	return func(ctx context.Context, p4 P4, p5 P5, p6 P6, p7 P7)  {
+	return func(ctx context.Context, p4 P4, p5 P5, p6 P6, p7 P7) {
 		f(ctx, p0, p1, p2, p3, p4, p5, p6, p7)
 	}
 }
-	
 
+// Curry3 returns a CtxFunc5[P3, P4, P5, P6, P7] with the first 3 arguments of the CtxFunc8 bound.
 func (f CtxFunc8[P0, P1, P2, P3, P4, P5, P6, P7]) Curry3(p0 P0, p1 P1, p2 P2) CtxFunc5[P3, P4, P5, P6, P7] {
-	return func(ctx context.Context, p3 P3, p4 P4, p5 P5, p6 P6, p7 P7)  {
+	return func(ctx context.Context, p3 P3, p4 P4, p5 P5, p6 P6, p7 P7) {
 		f(ctx, p0, p1, p2, p3, p4, p5, p6, p7)
 	}
 }
-	
 
+// Curry2 returns a CtxFunc6[P2, P3, P4, P5, P6, P7] with the first 2 arguments of the CtxFunc8 bound.
 func (f CtxFunc8[P0, P1, P2, P3, P4, P5, P6, P7]) Curry2(p0 P0, p1 P1) CtxFunc6[P2, P3, P4, P5, P6, P7] {
-	return func(ctx context.Context, p2 P2, p3 P3, p4 P4, p5 P5, p6 P6, p7 P7)  {
+	return func(ctx context.Context, p2 P2, p3 P3, p4 P4, p5 P5, p6 P6, p7 P7) {
 		f(ctx, p0, p1, p2, p3, p4, p5, p6, p7)
 	}
 }
-	
 
+// Curry1 returns a CtxFunc7[P1, P2, P3, P4, P5, P6, P7] with the first argument of the CtxFunc8 bound.
 func (f CtxFunc8[P0, P1, P2, P3, P4, P5, P6, P7]) Curry1(p0 P0) CtxFunc7[P1, P2, P3, P4, P5, P6, P7] {
-	return func(ctx context.Context, p1 P1, p2 P2, p3 P3, p4 P4, p5 P5, p6 P6, p7 P7)  {
+	return func(ctx context.Context, p1 P1, p2 P2, p3 P3, p4 P4, p5 P5, p6 P6, p7 P7) {
 		f(ctx, p0, p1, p2, p3, p4, p5, p6, p7)
 	}
 }
-	
\ No newline at end of file
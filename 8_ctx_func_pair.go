@@ -0,0 +1,174 @@
+// Code generated by powerfunc/generator from ctx_func_pair.go.tmpl. DO NOT EDIT.
+
+package powerfunc
+
+import (
+	"context"
+	"fmt"
+	"time"
+)
+
+type CtxFunc8Pair[R1, R2, P0, P1, P2, P3, P4, P5, P6, P7 any] func(ctx context.Context, p0 P0, p1 P1, p2 P2, p3 P3, p4 P4, p5 P5, p6 P6, p7 P7) (R1, R2)
+
+func (f CtxFunc8Pair[R1, R2, P0, P1, P2, P3, P4, P5, P6, P7]) Exec(ctx context.Context, p0 P0, p1 P1, p2 P2, p3 P3, p4 P4, p5 P5, p6 P6, p7 P7) (R1, R2) {
+	return f(ctx, p0, p1, p2, p3, p4, p5, p6, p7)
+}
+
+func (f CtxFunc8Pair[R1, R2, P0, P1, P2, P3, P4, P5, P6, P7]) Timing(loggers ...func(d time.Duration)) CtxFunc8Pair[R1, R2, P0, P1, P2, P3, P4, P5, P6, P7] {
+	return f.TimingWithClock(RealClock(), loggers...)
+}
+
+// TimingWithClock is like Timing, but measures the execution time with clock.
+func (f CtxFunc8Pair[R1, R2, P0, P1, P2, P3, P4, P5, P6, P7]) TimingWithClock(clock Clock, loggers ...func(d time.Duration)) CtxFunc8Pair[R1, R2, P0, P1, P2, P3, P4, P5, P6, P7] {
+	return func(ctx context.Context, p0 P0, p1 P1, p2 P2, p3 P3, p4 P4, p5 P5, p6 P6, p7 P7) (R1, R2) {
+		start := clock.Now()
+		defer func() {
+			dur := clock.Since(start)
+			for _, logger := range loggers {
+				logger(dur)
+			}
+			if len(loggers) == 0 {
+				// Default logger
+				fmt.Println(dur)
+			}
+		}()
+		return f(ctx, p0, p1, p2, p3, p4, p5, p6, p7)
+	}
+}
+
+func (f CtxFunc8Pair[R1, R2, P0, P1, P2, P3, P4, P5, P6, P7]) Fallible() CtxFunc8PairResult[R1, R2, P0, P1, P2, P3, P4, P5, P6, P7] {
+	return func(ctx context.Context, p0 P0, p1 P1, p2 P2, p3 P3, p4 P4, p5 P5, p6 P6, p7 P7) (R1, R2, error) {
+		v1, v2 := f(ctx, p0, p1, p2, p3, p4, p5, p6, p7)
+		return v1, v2, nil
+	}
+}
+
+func (f CtxFunc8Pair[R1, R2, P0, P1, P2, P3, P4, P5, P6, P7]) WithTimeout(timeout time.Duration) CtxFunc8Pair[R1, R2, P0, P1, P2, P3, P4, P5, P6, P7] {
+	return func(ctx context.Context, p0 P0, p1 P1, p2 P2, p3 P3, p4 P4, p5 P5, p6 P6, p7 P7) (R1, R2) {
+		ctx, cancel := context.WithTimeout(ctx, timeout)
+		defer cancel()
+		return f(ctx, p0, p1, p2, p3, p4, p5, p6, p7)
+	}
+}
+
+func (f CtxFunc8Pair[R1, R2, P0, P1, P2, P3, P4, P5, P6, P7]) WithDeadline(deadline time.Time) CtxFunc8Pair[R1, R2, P0, P1, P2, P3, P4, P5, P6, P7] {
+	return func(ctx context.Context, p0 P0, p1 P1, p2 P2, p3 P3, p4 P4, p5 P5, p6 P6, p7 P7) (R1, R2) {
+		ctx, cancel := context.WithDeadline(ctx, deadline)
+		defer cancel()
+		return f(ctx, p0, p1, p2, p3, p4, p5, p6, p7)
+	}
+}
+
+func (f CtxFunc8Pair[R1, R2, P0, P1, P2, P3, P4, P5, P6, P7]) WithCancel() CtxFunc8Pair[R1, R2, P0, P1, P2, P3, P4, P5, P6, P7] {
+	return func(ctx context.Context, p0 P0, p1 P1, p2 P2, p3 P3, p4 P4, p5 P5, p6 P6, p7 P7) (R1, R2) {
+		ctx, cancel := context.WithCancel(ctx)
+		defer cancel()
+		return f(ctx, p0, p1, p2, p3, p4, p5, p6, p7)
+	}
+}
+
+// WithValue returns a CtxFunc8Pair calling the CtxFunc8Pair with a context
+// carrying val for key, see context.WithValue.
+func (f CtxFunc8Pair[R1, R2, P0, P1, P2, P3, P4, P5, P6, P7]) WithValue(key, val any) CtxFunc8Pair[R1, R2, P0, P1, P2, P3, P4, P5, P6, P7] {
+	return func(ctx context.Context, p0 P0, p1 P1, p2 P2, p3 P3, p4 P4, p5 P5, p6 P6, p7 P7) (R1, R2) {
+		ctx = context.WithValue(ctx, key, val)
+		return f(ctx, p0, p1, p2, p3, p4, p5, p6, p7)
+	}
+}
+
+// WithValueFrom returns a CtxFunc8Pair calling the CtxFunc8Pair with a context
+// carrying the value returned by value for its key. value gets the arguments
+// of every call.
+func (f CtxFunc8Pair[R1, R2, P0, P1, P2, P3, P4, P5, P6, P7]) WithValueFrom(value func(ctx context.Context, p0 P0, p1 P1, p2 P2, p3 P3, p4 P4, p5 P5, p6 P6, p7 P7) (key, val any)) CtxFunc8Pair[R1, R2, P0, P1, P2, P3, P4, P5, P6, P7] {
+	return func(ctx context.Context, p0 P0, p1 P1, p2 P2, p3 P3, p4 P4, p5 P5, p6 P6, p7 P7) (R1, R2) {
+		key, val := value(ctx, p0, p1, p2, p3, p4, p5, p6, p7)
+		ctx = context.WithValue(ctx, key, val)
+		return f(ctx, p0, p1, p2, p3, p4, p5, p6, p7)
+	}
+}
+
+// Detached returns a CtxFunc8Pair calling the CtxFunc8Pair with a context that is
+// never cancelled but keeps the values of the caller's context, see
+// context.WithoutCancel. Useful for cleanups that must run to completion.
+func (f CtxFunc8Pair[R1, R2, P0, P1, P2, P3, P4, P5, P6, P7]) Detached() CtxFunc8Pair[R1, R2, P0, P1, P2, P3, P4, P5, P6, P7] {
+	return func(ctx context.Context, p0 P0, p1 P1, p2 P2, p3 P3, p4 P4, p5 P5, p6 P6, p7 P7) (R1, R2) {
+		ctx = context.WithoutCancel(ctx)
+		return f(ctx, p0, p1, p2, p3, p4, p5, p6, p7)
+	}
+}
+
+// WithTimeoutCause is like WithTimeout, but the context is cancelled with
+// cause when the timeout expires, see context.WithTimeoutCause.
+func (f CtxFunc8Pair[R1, R2, P0, P1, P2, P3, P4, P5, P6, P7]) WithTimeoutCause(timeout time.Duration, cause error) CtxFunc8Pair[R1, R2, P0, P1, P2, P3, P4, P5, P6, P7] {
+	return func(ctx context.Context, p0 P0, p1 P1, p2 P2, p3 P3, p4 P4, p5 P5, p6 P6, p7 P7) (R1, R2) {
+		ctx, cancel := context.WithTimeoutCause(ctx, timeout, cause)
+		defer cancel()
+		return f(ctx, p0, p1, p2, p3, p4, p5, p6, p7)
+	}
+}
+
+// WithDeadlineCause is like WithDeadline, but the context is cancelled with
+// cause when the deadline expires, see context.WithDeadlineCause.
+func (f CtxFunc8Pair[R1, R2, P0, P1, P2, P3, P4, P5, P6, P7]) WithDeadlineCause(deadline time.Time, cause error) CtxFunc8Pair[R1, R2, P0, P1, P2, P3, P4, P5, P6, P7] {
+	return func(ctx context.Context, p0 P0, p1 P1, p2 P2, p3 P3, p4 P4, p5 P5, p6 P6, p7 P7) (R1, R2) {
+		ctx, cancel := context.WithDeadlineCause(ctx, deadline, cause)
+		defer cancel()
+		return f(ctx, p0, p1, p2, p3, p4, p5, p6, p7)
+	}
+}
+
+// Curry8 returns a CtxFuncPair[R1, R2] with the first 8 arguments of the CtxFunc8Pair bound.
+func (f CtxFunc8Pair[R1, R2, P0, P1, P2, P3, P4, P5, P6, P7]) Curry8(p0 P0, p1 P1, p2 P2, p3 P3, p4 P4, p5 P5, p6 P6, p7 P7) CtxFuncPair[R1, R2] {
+	return func(ctx context.Context) (R1, R2) {
+		return f(ctx, p0, p1, p2, p3, p4, p5, p6, p7)
+	}
+}
+
+// Curry7 returns a CtxFunc1Pair[R1, R2, P7] with the first 7 arguments of the CtxFunc8Pair bound.
+func (f CtxFunc8Pair[R1, R2, P0, P1, P2, P3, P4, P5, P6, P7]) Curry7(p0 P0, p1 P1, p2 P2, p3 P3, p4 P4, p5 P5, p6 P6) CtxFunc1Pair[R1, R2, P7] {
+	return func(ctx context.Context, p7 P7) (R1, R2) {
+		return f(ctx, p0, p1, p2, p3, p4, p5, p6, p7)
+	}
+}
+
+// Curry6 returns a CtxFunc2Pair[R1, R2, P6, P7] with the first 6 arguments of the CtxFunc8Pair bound.
+func (f CtxFunc8Pair[R1, R2, P0, P1, P2, P3, P4, P5, P6, P7]) Curry6(p0 P0, p1 P1, p2 P2, p3 P3, p4 P4, p5 P5) CtxFunc2Pair[R1, R2, P6, P7] {
+	return func(ctx context.Context, p6 P6, p7 P7) (R1, R2) {
+		return f(ctx, p0, p1, p2, p3, p4, p5, p6, p7)
+	}
+}
+
+// Curry5 returns a CtxFunc3Pair[R1, R2, P5, P6, P7] with the first 5 arguments of the CtxFunc8Pair bound.
+func (f CtxFunc8Pair[R1, R2, P0, P1, P2, P3, P4, P5, P6, P7]) Curry5(p0 P0, p1 P1, p2 P2, p3 P3, p4 P4) CtxFunc3Pair[R1, R2, P5, P6, P7] {
+	return func(ctx context.Context, p5 P5, p6 P6, p7 P7) (R1, R2) {
+		return f(ctx, p0, p1, p2, p3, p4, p5, p6, p7)
+	}
+}
+
+// Curry4 returns a CtxFunc4Pair[R1, R2, P4, P5, P6, P7] with the first 4 arguments of the CtxFunc8Pair bound.
+func (f CtxFunc8Pair[R1, R2, P0, P1, P2, P3, P4, P5, P6, P7]) Curry4(p0 P0, p1 P1, p2 P2, p3 P3) CtxFunc4Pair[R1, R2, P4, P5, P6, P7] {
+	return func(ctx context.Context, p4 P4, p5 P5, p6 P6, p7 P7) (R1, R2) {
+		return f(ctx, p0, p1, p2, p3, p4, p5, p6, p7)
+	}
+}
+
+// Curry3 returns a CtxFunc5Pair[R1, R2, P3, P4, P5, P6, P7] with the first 3 arguments of the CtxFunc8Pair bound.
+func (f CtxFunc8Pair[R1, R2, P0, P1, P2, P3, P4, P5, P6, P7]) Curry3(p0 P0, p1 P1, p2 P2) CtxFunc5Pair[R1, R2, P3, P4, P5, P6, P7] {
+	return func(ctx context.Context, p3 P3, p4 P4, p5 P5, p6 P6, p7 P7) (R1, R2) {
+		return f(ctx, p0, p1, p2, p3, p4, p5, p6, p7)
+	}
+}
+
+// Curry2 returns a CtxFunc6Pair[R1, R2, P2, P3, P4, P5, P6, P7] with the first 2 arguments of the CtxFunc8Pair bound.
+func (f CtxFunc8Pair[R1, R2, P0, P1, P2, P3, P4, P5, P6, P7]) Curry2(p0 P0, p1 P1) CtxFunc6Pair[R1, R2, P2, P3, P4, P5, P6, P7] {
+	return func(ctx context.Context, p2 P2, p3 P3, p4 P4, p5 P5, p6 P6, p7 P7) (R1, R2) {
+		return f(ctx, p0, p1, p2, p3, p4, p5, p6, p7)
+	}
+}
+
+// Curry1 returns a CtxFunc7Pair[R1, R2, P1, P2, P3, P4, P5, P6, P7] with the first argument of the CtxFunc8Pair bound.
+func (f CtxFunc8Pair[R1, R2, P0, P1, P2, P3, P4, P5, P6, P7]) Curry1(p0 P0) CtxFunc7Pair[R1, R2, P1, P2, P3, P4, P5, P6, P7] {
+	return func(ctx context.Context, p1 P1, p2 P2, p3 P3, p4 P4, p5 P5, p6 P6, p7 P7) (R1, R2) {
+		return f(ctx, p0, p1, p2, p3, p4, p5, p6, p7)
+	}
+}
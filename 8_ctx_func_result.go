@@ -1,3 +1,5 @@
+// Code generated by powerfunc/generator from ctx_func_result.go.tmpl. DO NOT EDIT.
+
 package powerfunc
 
 import (
@@ -13,10 +15,15 @@ func (f CtxFunc8Result[R, P0, P1, P2, P3, P4, P5, P6, P7]) Exec(ctx context.Cont
 }
 
 func (f CtxFunc8Result[R, P0, P1, P2, P3, P4, P5, P6, P7]) Timing(loggers ...func(d time.Duration)) CtxFunc8Result[R, P0, P1, P2, P3, P4, P5, P6, P7] {
+	return f.TimingWithClock(RealClock(), loggers...)
+}
+
+// TimingWithClock is like Timing, but measures the execution time with clock.
+func (f CtxFunc8Result[R, P0, P1, P2, P3, P4, P5, P6, P7]) TimingWithClock(clock Clock, loggers ...func(d time.Duration)) CtxFunc8Result[R, P0, P1, P2, P3, P4, P5, P6, P7] {
 	return func(ctx context.Context, p0 P0, p1 P1, p2 P2, p3 P3, p4 P4, p5 P5, p6 P6, p7 P7) (R, error) {
-		start := time.Now()
+		start := clock.Now()
 		defer func() {
-			dur := time.Since(start)
+			dur := clock.Since(start)
 			for _, logger := range loggers {
 				logger(dur)
 			}
@@ -53,25 +60,103 @@ func (f CtxFunc8Result[R, P0, P1, P2, P3, P4, P5, P6, P7]) WithCancel() CtxFunc8
 	}
 }
 
-// Retry returns a Function that will retry the Function until it returns
-// a nil error or the tryAgain function returns false.
-func (f CtxFunc8Result[R, P0, P1, P2, P3, P4, P5, P6, P7]) Retry(tryAgain func(attempts int, err error) bool) CtxFunc8Result[R, P0, P1, P2, P3, P4, P5, P6, P7] {
+// WithValue returns a CtxFunc8Result calling the CtxFunc8Result with a context
+// carrying val for key, see context.WithValue.
+func (f CtxFunc8Result[R, P0, P1, P2, P3, P4, P5, P6, P7]) WithValue(key, val any) CtxFunc8Result[R, P0, P1, P2, P3, P4, P5, P6, P7] {
 	return func(ctx context.Context, p0 P0, p1 P1, p2 P2, p3 P3, p4 P4, p5 P5, p6 P6, p7 P7) (R, error) {
-		var v R
-		var err error
-		attempts := 1
-		for {
-			v, err = f(ctx, p0, p1, p2, p3, p4, p5, p6, p7)
-			if err != nil && tryAgain(attempts, err) {
-				break
-			}
-			attempts++
+		ctx = context.WithValue(ctx, key, val)
+		return f(ctx, p0, p1, p2, p3, p4, p5, p6, p7)
+	}
+}
+
+// WithValueFrom returns a CtxFunc8Result calling the CtxFunc8Result with a context
+// carrying the value returned by value for its key. value gets the arguments
+// of every call.
+func (f CtxFunc8Result[R, P0, P1, P2, P3, P4, P5, P6, P7]) WithValueFrom(value func(ctx context.Context, p0 P0, p1 P1, p2 P2, p3 P3, p4 P4, p5 P5, p6 P6, p7 P7) (key, val any)) CtxFunc8Result[R, P0, P1, P2, P3, P4, P5, P6, P7] {
+	return func(ctx context.Context, p0 P0, p1 P1, p2 P2, p3 P3, p4 P4, p5 P5, p6 P6, p7 P7) (R, error) {
+		key, val := value(ctx, p0, p1, p2, p3, p4, p5, p6, p7)
+		ctx = context.WithValue(ctx, key, val)
+		return f(ctx, p0, p1, p2, p3, p4, p5, p6, p7)
+	}
+}
+
+// Detached returns a CtxFunc8Result calling the CtxFunc8Result with a context that is
+// never cancelled but keeps the values of the caller's context, see
+// context.WithoutCancel. Useful for cleanups that must run to completion.
+func (f CtxFunc8Result[R, P0, P1, P2, P3, P4, P5, P6, P7]) Detached() CtxFunc8Result[R, P0, P1, P2, P3, P4, P5, P6, P7] {
+	return func(ctx context.Context, p0 P0, p1 P1, p2 P2, p3 P3, p4 P4, p5 P5, p6 P6, p7 P7) (R, error) {
+		ctx = context.WithoutCancel(ctx)
+		return f(ctx, p0, p1, p2, p3, p4, p5, p6, p7)
+	}
+}
+
+// WithTimeoutCause is like WithTimeout, but the context is cancelled with
+// cause when the timeout expires, see context.WithTimeoutCause.
+// When the CtxFunc8Result then fails with the error of the context, the returned
+// error wraps cause as well, to tell which timeout expired.
+func (f CtxFunc8Result[R, P0, P1, P2, P3, P4, P5, P6, P7]) WithTimeoutCause(timeout time.Duration, cause error) CtxFunc8Result[R, P0, P1, P2, P3, P4, P5, P6, P7] {
+	return func(ctx context.Context, p0 P0, p1 P1, p2 P2, p3 P3, p4 P4, p5 P5, p6 P6, p7 P7) (R, error) {
+		ctx, cancel := context.WithTimeoutCause(ctx, timeout, cause)
+		defer cancel()
+		r0, err := f(ctx, p0, p1, p2, p3, p4, p5, p6, p7)
+		return r0, withCause(ctx, err)
+	}
+}
+
+// WithDeadlineCause is like WithDeadline, but the context is cancelled with
+// cause when the deadline expires, see context.WithDeadlineCause.
+// When the CtxFunc8Result then fails with the error of the context, the returned
+// error wraps cause as well, to tell which deadline expired.
+func (f CtxFunc8Result[R, P0, P1, P2, P3, P4, P5, P6, P7]) WithDeadlineCause(deadline time.Time, cause error) CtxFunc8Result[R, P0, P1, P2, P3, P4, P5, P6, P7] {
+	return func(ctx context.Context, p0 P0, p1 P1, p2 P2, p3 P3, p4 P4, p5 P5, p6 P6, p7 P7) (R, error) {
+		ctx, cancel := context.WithDeadlineCause(ctx, deadline, cause)
+		defer cancel()
+		r0, err := f(ctx, p0, p1, p2, p3, p4, p5, p6, p7)
+		return r0, withCause(ctx, err)
+	}
+}
+
+// WithBudget returns a CtxFunc8Result giving the CtxFunc8Result a fraction of the
+// remaining deadline of the caller, capped at max when max is positive,
+// instead of a fixed timeout. Without a deadline, the CtxFunc8Result gets max, or
+// no timeout if max is not positive.
+// When the share is below min, the CtxFunc8Result is not called and a
+// *BudgetError, matching ErrBudgetExhausted, is returned.
+func (f CtxFunc8Result[R, P0, P1, P2, P3, P4, P5, P6, P7]) WithBudget(fraction float64, min, max time.Duration) CtxFunc8Result[R, P0, P1, P2, P3, P4, P5, P6, P7] {
+	return func(ctx context.Context, p0 P0, p1 P1, p2 P2, p3 P3, p4 P4, p5 P5, p6 P6, p7 P7) (R, error) {
+		timeout, bounded, err := budget(ctx, fraction, min, max)
+		if err != nil {
+			var r0 R
+			return r0, err
 		}
-		return v, err
+		if bounded {
+			var cancel context.CancelFunc
+			ctx, cancel = context.WithTimeout(ctx, timeout)
+			defer cancel()
+		}
+		return f(ctx, p0, p1, p2, p3, p4, p5, p6, p7)
 	}
 }
 
-// Must returns a Func8Value that will panic if the CtxFunc8Result returns an error.
+// Retry returns a CtxFunc8Result that will retry the CtxFunc8Result until it returns
+// a nil error or the tryAgain function returns false, in which case it
+// returns a *RetryError holding the errors of all the attempts.
+// The options set the backoff between attempts and hooks, e.g. OnRetry.
+// RetryAttemptTimeout gives every attempt its own deadline within the one of
+// the caller.
+func (f CtxFunc8Result[R, P0, P1, P2, P3, P4, P5, P6, P7]) Retry(tryAgain func(attempts int, err error) bool, opts ...RetryOption) CtxFunc8Result[R, P0, P1, P2, P3, P4, P5, P6, P7] {
+	return func(ctx context.Context, p0 P0, p1 P1, p2 P2, p3 P3, p4 P4, p5 P5, p6 P6, p7 P7) (R, error) {
+		var r0 R
+		err := retry(ctx, tryAgain, opts, func(ctx context.Context) error {
+			var err error
+			r0, err = f(ctx, p0, p1, p2, p3, p4, p5, p6, p7)
+			return err
+		})
+		return r0, err
+	}
+}
+
+// Must returns a CtxFunc8Value that will panic if the CtxFunc8Result returns an error.
 func (f CtxFunc8Result[R, P0, P1, P2, P3, P4, P5, P6, P7]) Must() CtxFunc8Value[R, P0, P1, P2, P3, P4, P5, P6, P7] {
 	return func(ctx context.Context, p0 P0, p1 P1, p2 P2, p3 P3, p4 P4, p5 P5, p6 P6, p7 P7) R {
 		v, err := f(ctx, p0, p1, p2, p3, p4, p5, p6, p7)
@@ -118,7 +203,7 @@ func (f CtxFunc8Result[R, P0, P1, P2, P3, P4, P5, P6, P7]) MapErr(fn func(error)
 	}
 }
 
-// Fallback returns a Func8Value that will return the provided value if the
+// Fallback returns a CtxFunc8Value that will return the provided value if the
 // CtxFunc8Result returns an error.
 func (f CtxFunc8Result[R, P0, P1, P2, P3, P4, P5, P6, P7]) Fallback(val R) CtxFunc8Value[R, P0, P1, P2, P3, P4, P5, P6, P7] {
 	return func(ctx context.Context, p0 P0, p1 P1, p2 P2, p3 P3, p4 P4, p5 P5, p6 P6, p7 P7) R {
@@ -130,59 +215,124 @@ func (f CtxFunc8Result[R, P0, P1, P2, P3, P4, P5, P6, P7]) Fallback(val R) CtxFu
 	}
 }
 
+// Once returns a CtxFunc8Result that only executes the first time it is called
+// and returns the cached result afterwards, along with the Once controlling it.
+// Use OnceRetryOnError or OnceBackoff to only cache a success.
+// The shared execution does not inherit the cancellation of the caller's
+// context: a caller whose context is done stops waiting and gets ctx.Err(),
+// while the execution carries on for the other callers.
+func (f CtxFunc8Result[R, P0, P1, P2, P3, P4, P5, P6, P7]) Once(opts ...OnceOption) (CtxFunc8Result[R, P0, P1, P2, P3, P4, P5, P6, P7], *Once) {
+	o := newOnce(opts...)
+	return func(ctx context.Context, p0 P0, p1 P1, p2 P2, p3 P3, p4 P4, p5 P5, p6 P6, p7 P7) (R, error) {
+		v, err := o.do(ctx, true, func() (any, error) {
+			ctx := context.WithoutCancel(ctx)
+			return f(ctx, p0, p1, p2, p3, p4, p5, p6, p7)
+		})
+		val, _ := v.(R)
+		return val, err
+	}, o
+}
+
+// Lazy returns a CtxFunc8Result that executes until it succeeds once and
+// returns the cached value afterwards, along with the Once controlling it.
+// Failures are not cached, unless a backoff is provided with OnceBackoff.
+func (f CtxFunc8Result[R, P0, P1, P2, P3, P4, P5, P6, P7]) Lazy(opts ...OnceOption) (CtxFunc8Result[R, P0, P1, P2, P3, P4, P5, P6, P7], *Once) {
+	o := newLazy(opts...)
+	return func(ctx context.Context, p0 P0, p1 P1, p2 P2, p3 P3, p4 P4, p5 P5, p6 P6, p7 P7) (R, error) {
+		v, err := o.do(ctx, true, func() (any, error) {
+			ctx := context.WithoutCancel(ctx)
+			return f(ctx, p0, p1, p2, p3, p4, p5, p6, p7)
+		})
+		val, _ := v.(R)
+		return val, err
+	}, o
+}
+
+// PollUntil returns a CtxFunc8Result calling the CtxFunc8Result every interval
+// until the returned value satisfies cond.
+// When the context is done or the maximum number of attempts is reached
+// first, it returns the last value and a *PollTimeoutError.
+func (f CtxFunc8Result[R, P0, P1, P2, P3, P4, P5, P6, P7]) PollUntil(cond func(R) bool, interval time.Duration, opts ...PollOption) CtxFunc8Result[R, P0, P1, P2, P3, P4, P5, P6, P7] {
+	return func(ctx context.Context, p0 P0, p1 P1, p2 P2, p3 P3, p4 P4, p5 P5, p6 P6, p7 P7) (R, error) {
+		var v R
+		err := poll(ctx, interval, opts, func() (bool, error) {
+			var err error
+			v, err = f(ctx, p0, p1, p2, p3, p4, p5, p6, p7)
+			if err != nil {
+				return false, err
+			}
+			return cond(v), nil
+		})
+		return v, err
+	}
+}
+
+// InjectFaults returns a CtxFunc8Result injecting the errors, panics and latency
+// configured by cfg in its calls, to test how the callers cope with them.
+// See FaultConfig, and DisableFaults for the global kill-switch.
+func (f CtxFunc8Result[R, P0, P1, P2, P3, P4, P5, P6, P7]) InjectFaults(cfg FaultConfig) CtxFunc8Result[R, P0, P1, P2, P3, P4, P5, P6, P7] {
+	fi := newFaultInjector(cfg)
+	return func(ctx context.Context, p0 P0, p1 P1, p2 P2, p3 P3, p4 P4, p5 P5, p6 P6, p7 P7) (R, error) {
+		if err := fi.inject(ctx, p0, p1, p2, p3, p4, p5, p6, p7); err != nil {
+			var r0 R
+			return r0, err
+		}
+		return f(ctx, p0, p1, p2, p3, p4, p5, p6, p7)
+	}
+}
 
+// Curry8 returns a CtxFuncResult[R] with the first 8 arguments of the CtxFunc8Result bound.
 func (f CtxFunc8Result[R, P0, P1, P2, P3, P4, P5, P6, P7]) Curry8(p0 P0, p1 P1, p2 P2, p3 P3, p4 P4, p5 P5, p6 P6, p7 P7) CtxFuncResult[R] {
 	return func(ctx context.Context) (R, error) {
 		return f(ctx, p0, p1, p2, p3, p4, p5, p6, p7)
 	}
 }
-	
 
+// Curry7 returns a CtxFunc1Result[R, P7] with the first 7 arguments of the CtxFunc8Result bound.
 func (f CtxFunc8Result[R, P0, P1, P2, P3, P4, P5, P6, P7]) Curry7(p0 P0, p1 P1, p2 P2, p3 P3, p4 P4, p5 P5, p6 P6) CtxFunc1Result[R, P7] {
 	return func(ctx context.Context, p7 P7) (R, error) {
 		return f(ctx, p0, p1, p2, p3, p4, p5, p6, p7)
 	}
 }
-	
 
+// Curry6 returns a CtxFunc2Result[R, P6, P7] with the first 6 arguments of the CtxFunc8Result bound.
 func (f CtxFunc8Result[R, P0, P1, P2, P3, P4, P5, P6, P7]) Curry6(p0 P0, p1 P1, p2 P2, p3 P3, p4 P4, p5 P5) CtxFunc2Result[R, P6, P7] {
 	return func(ctx context.Context, p6 P6, p7 P7) (R, error) {
 		return f(ctx, p0, p1, p2, p3, p4, p5, p6, p7)
 	}
 }
-	
 
+// Curry5 returns a CtxFunc3Result[R, P5, P6, P7] with the first 5 arguments of the CtxFunc8Result bound.
 func (f CtxFunc8Result[R, P0, P1, P2, P3, P4, P5, P6, P7]) Curry5(p0 P0, p1 P1, p2 P2, p3 P3, p4 P4) CtxFunc3Result[R, P5, P6, P7] {
 	return func(ctx context.Context, p5 P5, p6 P6, p7 P7) (R, error) {
 		return f(ctx, p0, p1, p2, p3, p4, p5, p6, p7)
 	}
 }
-	
 
+// Curry4 returns a CtxFunc4Result[R, P4, P5, P6, P7] with the first 4 arguments of the CtxFunc8Result bound.
 func (f CtxFunc8Result[R, P0, P1, P2, P3, P4, P5, P6, P7]) Curry4(p0 P0, p1 P1, p2 P2, p3 P3) CtxFunc4Result[R, P4, P5, P6, P7] {
 	return func(ctx context.Context, p4 P4, p5 P5, p6 P6, p7 P7) (R, error) {
 		return f(ctx, p0, p1, p2, p3, p4, p5, p6, p7)
 	}
 }
-	
 
+// Curry3 returns a CtxFunc5Result[R, P3, P4, P5, P6, P7] with the first 3 arguments of the CtxFunc8Result bound.
 func (f CtxFunc8Result[R, P0, P1, P2, P3, P4, P5, P6, P7]) Curry3(p0 P0, p1 P1, p2 P2) CtxFunc5Result[R, P3, P4, P5, P6, P7] {
 	return func(ctx context.Context, p3 P3, p4 P4, p5 P5, p6 P6, p7 P7) (R, error) {
 		return f(ctx, p0, p1, p2, p3, p4, p5, p6, p7)
 	}
 }
-	
 
+// Curry2 returns a CtxFunc6Result[R, P2, P3, P4, P5, P6, P7] with the first 2 arguments of the CtxFunc8Result bound.
 func (f CtxFunc8Result[R, P0, P1, P2, P3, P4, P5, P6, P7]) Curry2(p0 P0, p1 P1) CtxFunc6Result[R, P2, P3, P4, P5, P6, P7] {
 	return func(ctx context.Context, p2 P2, p3 P3, p4 P4, p5 P5, p6 P6, p7 P7) (R, error) {
 		return f(ctx, p0, p1, p2, p3, p4, p5, p6, p7)
 	}
 }
-	
 
+// Curry1 returns a CtxFunc7Result[R, P1, P2, P3, P4, P5, P6, P7] with the first argument of the CtxFunc8Result bound.
 func (f CtxFunc8Result[R, P0, P1, P2, P3, P4, P5, P6, P7]) Curry1(p0 P0) CtxFunc7Result[R, P1, P2, P3, P4, P5, P6, P7] {
 	return func(ctx context.Context, p1 P1, p2 P2, p3 P3, p4 P4, p5 P5, p6 P6, p7 P7) (R, error) {
 		return f(ctx, p0, p1, p2, p3, p4, p5, p6, p7)
 	}
 }
-	
\ No newline at end of file
@@ -0,0 +1,243 @@
+// Code generated by powerfunc/generator from ctx_func_variadic_error.go.tmpl. DO NOT EDIT.
+
+package powerfunc
+
+import (
+	"context"
+	"fmt"
+	"time"
+)
+
+type CtxFunc8VariadicError[V, P0, P1, P2, P3, P4, P5, P6, P7 any] func(ctx context.Context, p0 P0, p1 P1, p2 P2, p3 P3, p4 P4, p5 P5, p6 P6, p7 P7, xs ...V) error
+
+func (f CtxFunc8VariadicError[V, P0, P1, P2, P3, P4, P5, P6, P7]) Exec(ctx context.Context, p0 P0, p1 P1, p2 P2, p3 P3, p4 P4, p5 P5, p6 P6, p7 P7, xs ...V) error {
+	return f(ctx, p0, p1, p2, p3, p4, p5, p6, p7, xs...)
+}
+
+func (f CtxFunc8VariadicError[V, P0, P1, P2, P3, P4, P5, P6, P7]) Timing(loggers ...func(d time.Duration)) CtxFunc8VariadicError[V, P0, P1, P2, P3, P4, P5, P6, P7] {
+	return f.TimingWithClock(RealClock(), loggers...)
+}
+
+// TimingWithClock is like Timing, but measures the execution time with clock.
+func (f CtxFunc8VariadicError[V, P0, P1, P2, P3, P4, P5, P6, P7]) TimingWithClock(clock Clock, loggers ...func(d time.Duration)) CtxFunc8VariadicError[V, P0, P1, P2, P3, P4, P5, P6, P7] {
+	return func(ctx context.Context, p0 P0, p1 P1, p2 P2, p3 P3, p4 P4, p5 P5, p6 P6, p7 P7, xs ...V) error {
+		start := clock.Now()
+		defer func() {
+			dur := clock.Since(start)
+			for _, logger := range loggers {
+				logger(dur)
+			}
+			if len(loggers) == 0 {
+				// Default logger
+				fmt.Println(dur)
+			}
+		}()
+		return f(ctx, p0, p1, p2, p3, p4, p5, p6, p7, xs...)
+	}
+}
+
+func (f CtxFunc8VariadicError[V, P0, P1, P2, P3, P4, P5, P6, P7]) WithTimeout(timeout time.Duration) CtxFunc8VariadicError[V, P0, P1, P2, P3, P4, P5, P6, P7] {
+	return func(ctx context.Context, p0 P0, p1 P1, p2 P2, p3 P3, p4 P4, p5 P5, p6 P6, p7 P7, xs ...V) error {
+		ctx, cancel := context.WithTimeout(ctx, timeout)
+		defer cancel()
+		return f(ctx, p0, p1, p2, p3, p4, p5, p6, p7, xs...)
+	}
+}
+
+func (f CtxFunc8VariadicError[V, P0, P1, P2, P3, P4, P5, P6, P7]) WithDeadline(deadline time.Time) CtxFunc8VariadicError[V, P0, P1, P2, P3, P4, P5, P6, P7] {
+	return func(ctx context.Context, p0 P0, p1 P1, p2 P2, p3 P3, p4 P4, p5 P5, p6 P6, p7 P7, xs ...V) error {
+		ctx, cancel := context.WithDeadline(ctx, deadline)
+		defer cancel()
+		return f(ctx, p0, p1, p2, p3, p4, p5, p6, p7, xs...)
+	}
+}
+
+func (f CtxFunc8VariadicError[V, P0, P1, P2, P3, P4, P5, P6, P7]) WithCancel() CtxFunc8VariadicError[V, P0, P1, P2, P3, P4, P5, P6, P7] {
+	return func(ctx context.Context, p0 P0, p1 P1, p2 P2, p3 P3, p4 P4, p5 P5, p6 P6, p7 P7, xs ...V) error {
+		ctx, cancel := context.WithCancel(ctx)
+		defer cancel()
+		return f(ctx, p0, p1, p2, p3, p4, p5, p6, p7, xs...)
+	}
+}
+
+// WithValue returns a CtxFunc8VariadicError calling the CtxFunc8VariadicError with a context
+// carrying val for key, see context.WithValue.
+func (f CtxFunc8VariadicError[V, P0, P1, P2, P3, P4, P5, P6, P7]) WithValue(key, val any) CtxFunc8VariadicError[V, P0, P1, P2, P3, P4, P5, P6, P7] {
+	return func(ctx context.Context, p0 P0, p1 P1, p2 P2, p3 P3, p4 P4, p5 P5, p6 P6, p7 P7, xs ...V) error {
+		ctx = context.WithValue(ctx, key, val)
+		return f(ctx, p0, p1, p2, p3, p4, p5, p6, p7, xs...)
+	}
+}
+
+// WithValueFrom returns a CtxFunc8VariadicError calling the CtxFunc8VariadicError with a context
+// carrying the value returned by value for its key. value gets the arguments
+// of every call.
+func (f CtxFunc8VariadicError[V, P0, P1, P2, P3, P4, P5, P6, P7]) WithValueFrom(value func(ctx context.Context, p0 P0, p1 P1, p2 P2, p3 P3, p4 P4, p5 P5, p6 P6, p7 P7, xs ...V) (key, val any)) CtxFunc8VariadicError[V, P0, P1, P2, P3, P4, P5, P6, P7] {
+	return func(ctx context.Context, p0 P0, p1 P1, p2 P2, p3 P3, p4 P4, p5 P5, p6 P6, p7 P7, xs ...V) error {
+		key, val := value(ctx, p0, p1, p2, p3, p4, p5, p6, p7, xs...)
+		ctx = context.WithValue(ctx, key, val)
+		return f(ctx, p0, p1, p2, p3, p4, p5, p6, p7, xs...)
+	}
+}
+
+// Detached returns a CtxFunc8VariadicError calling the CtxFunc8VariadicError with a context that is
+// never cancelled but keeps the values of the caller's context, see
+// context.WithoutCancel. Useful for cleanups that must run to completion.
+func (f CtxFunc8VariadicError[V, P0, P1, P2, P3, P4, P5, P6, P7]) Detached() CtxFunc8VariadicError[V, P0, P1, P2, P3, P4, P5, P6, P7] {
+	return func(ctx context.Context, p0 P0, p1 P1, p2 P2, p3 P3, p4 P4, p5 P5, p6 P6, p7 P7, xs ...V) error {
+		ctx = context.WithoutCancel(ctx)
+		return f(ctx, p0, p1, p2, p3, p4, p5, p6, p7, xs...)
+	}
+}
+
+// WithTimeoutCause is like WithTimeout, but the context is cancelled with
+// cause when the timeout expires, see context.WithTimeoutCause.
+// When the CtxFunc8VariadicError then fails with the error of the context, the returned
+// error wraps cause as well, to tell which timeout expired.
+func (f CtxFunc8VariadicError[V, P0, P1, P2, P3, P4, P5, P6, P7]) WithTimeoutCause(timeout time.Duration, cause error) CtxFunc8VariadicError[V, P0, P1, P2, P3, P4, P5, P6, P7] {
+	return func(ctx context.Context, p0 P0, p1 P1, p2 P2, p3 P3, p4 P4, p5 P5, p6 P6, p7 P7, xs ...V) error {
+		ctx, cancel := context.WithTimeoutCause(ctx, timeout, cause)
+		defer cancel()
+		err := f(ctx, p0, p1, p2, p3, p4, p5, p6, p7, xs...)
+		return withCause(ctx, err)
+	}
+}
+
+// WithDeadlineCause is like WithDeadline, but the context is cancelled with
+// cause when the deadline expires, see context.WithDeadlineCause.
+// When the CtxFunc8VariadicError then fails with the error of the context, the returned
+// error wraps cause as well, to tell which deadline expired.
+func (f CtxFunc8VariadicError[V, P0, P1, P2, P3, P4, P5, P6, P7]) WithDeadlineCause(deadline time.Time, cause error) CtxFunc8VariadicError[V, P0, P1, P2, P3, P4, P5, P6, P7] {
+	return func(ctx context.Context, p0 P0, p1 P1, p2 P2, p3 P3, p4 P4, p5 P5, p6 P6, p7 P7, xs ...V) error {
+		ctx, cancel := context.WithDeadlineCause(ctx, deadline, cause)
+		defer cancel()
+		err := f(ctx, p0, p1, p2, p3, p4, p5, p6, p7, xs...)
+		return withCause(ctx, err)
+	}
+}
+
+// WithBudget returns a CtxFunc8VariadicError giving the CtxFunc8VariadicError a fraction of the
+// remaining deadline of the caller, capped at max when max is positive,
+// instead of a fixed timeout. Without a deadline, the CtxFunc8VariadicError gets max, or
+// no timeout if max is not positive.
+// When the share is below min, the CtxFunc8VariadicError is not called and a
+// *BudgetError, matching ErrBudgetExhausted, is returned.
+func (f CtxFunc8VariadicError[V, P0, P1, P2, P3, P4, P5, P6, P7]) WithBudget(fraction float64, min, max time.Duration) CtxFunc8VariadicError[V, P0, P1, P2, P3, P4, P5, P6, P7] {
+	return func(ctx context.Context, p0 P0, p1 P1, p2 P2, p3 P3, p4 P4, p5 P5, p6 P6, p7 P7, xs ...V) error {
+		timeout, bounded, err := budget(ctx, fraction, min, max)
+		if err != nil {
+			return err
+		}
+		if bounded {
+			var cancel context.CancelFunc
+			ctx, cancel = context.WithTimeout(ctx, timeout)
+			defer cancel()
+		}
+		return f(ctx, p0, p1, p2, p3, p4, p5, p6, p7, xs...)
+	}
+}
+
+// Retry returns a CtxFunc8VariadicError that will retry the CtxFunc8VariadicError until it returns
+// a nil error or the tryAgain function returns false, in which case it
+// returns a *RetryError holding the errors of all the attempts.
+// The options set the backoff between attempts and hooks, e.g. OnRetry.
+// RetryAttemptTimeout gives every attempt its own deadline within the one of
+// the caller.
+func (f CtxFunc8VariadicError[V, P0, P1, P2, P3, P4, P5, P6, P7]) Retry(tryAgain func(attempts int, err error) bool, opts ...RetryOption) CtxFunc8VariadicError[V, P0, P1, P2, P3, P4, P5, P6, P7] {
+	return func(ctx context.Context, p0 P0, p1 P1, p2 P2, p3 P3, p4 P4, p5 P5, p6 P6, p7 P7, xs ...V) error {
+		return retry(ctx, tryAgain, opts, func(ctx context.Context) error {
+			return f(ctx, p0, p1, p2, p3, p4, p5, p6, p7, xs...)
+		})
+	}
+}
+
+// OnErr returns a CtxFunc8VariadicError that will wrap the error with the
+// provided message.
+func (f CtxFunc8VariadicError[V, P0, P1, P2, P3, P4, P5, P6, P7]) OnErr(msg string) CtxFunc8VariadicError[V, P0, P1, P2, P3, P4, P5, P6, P7] {
+	return func(ctx context.Context, p0 P0, p1 P1, p2 P2, p3 P3, p4 P4, p5 P5, p6 P6, p7 P7, xs ...V) error {
+		err := f(ctx, p0, p1, p2, p3, p4, p5, p6, p7, xs...)
+		if err != nil {
+			return fmt.Errorf("%s: %w", msg, err)
+		}
+		return nil
+	}
+}
+
+// CurryVariadic returns a CtxFunc8VariadicError with some of its variadic
+// arguments bound. The bound arguments come before the ones passed to the
+// returned function.
+func (f CtxFunc8VariadicError[V, P0, P1, P2, P3, P4, P5, P6, P7]) CurryVariadic(bound ...V) CtxFunc8VariadicError[V, P0, P1, P2, P3, P4, P5, P6, P7] {
+	return func(ctx context.Context, p0 P0, p1 P1, p2 P2, p3 P3, p4 P4, p5 P5, p6 P6, p7 P7, xs ...V) error {
+		xs = append(append(make([]V, 0, len(bound)+len(xs)), bound...), xs...)
+		return f(ctx, p0, p1, p2, p3, p4, p5, p6, p7, xs...)
+	}
+}
+
+// InjectFaults returns a CtxFunc8VariadicError injecting the errors, panics and latency
+// configured by cfg in its calls, to test how the callers cope with them.
+// See FaultConfig, and DisableFaults for the global kill-switch.
+func (f CtxFunc8VariadicError[V, P0, P1, P2, P3, P4, P5, P6, P7]) InjectFaults(cfg FaultConfig) CtxFunc8VariadicError[V, P0, P1, P2, P3, P4, P5, P6, P7] {
+	fi := newFaultInjector(cfg)
+	return func(ctx context.Context, p0 P0, p1 P1, p2 P2, p3 P3, p4 P4, p5 P5, p6 P6, p7 P7, xs ...V) error {
+		if err := fi.inject(ctx, p0, p1, p2, p3, p4, p5, p6, p7, xs); err != nil {
+			return err
+		}
+		return f(ctx, p0, p1, p2, p3, p4, p5, p6, p7, xs...)
+	}
+}
+
+// Curry8 returns a CtxFuncVariadicError[V] with the first 8 arguments of the CtxFunc8VariadicError bound.
+func (f CtxFunc8VariadicError[V, P0, P1, P2, P3, P4, P5, P6, P7]) Curry8(p0 P0, p1 P1, p2 P2, p3 P3, p4 P4, p5 P5, p6 P6, p7 P7) CtxFuncVariadicError[V] {
+	return func(ctx context.Context, xs ...V) error {
+		return f(ctx, p0, p1, p2, p3, p4, p5, p6, p7, xs...)
+	}
+}
+
+// Curry7 returns a CtxFunc1VariadicError[V, P7] with the first 7 arguments of the CtxFunc8VariadicError bound.
+func (f CtxFunc8VariadicError[V, P0, P1, P2, P3, P4, P5, P6, P7]) Curry7(p0 P0, p1 P1, p2 P2, p3 P3, p4 P4, p5 P5, p6 P6) CtxFunc1VariadicError[V, P7] {
+	return func(ctx context.Context, p7 P7, xs ...V) error {
+		return f(ctx, p0, p1, p2, p3, p4, p5, p6, p7, xs...)
+	}
+}
+
+// Curry6 returns a CtxFunc2VariadicError[V, P6, P7] with the first 6 arguments of the CtxFunc8VariadicError bound.
+func (f CtxFunc8VariadicError[V, P0, P1, P2, P3, P4, P5, P6, P7]) Curry6(p0 P0, p1 P1, p2 P2, p3 P3, p4 P4, p5 P5) CtxFunc2VariadicError[V, P6, P7] {
+	return func(ctx context.Context, p6 P6, p7 P7, xs ...V) error {
+		return f(ctx, p0, p1, p2, p3, p4, p5, p6, p7, xs...)
+	}
+}
+
+// Curry5 returns a CtxFunc3VariadicError[V, P5, P6, P7] with the first 5 arguments of the CtxFunc8VariadicError bound.
+func (f CtxFunc8VariadicError[V, P0, P1, P2, P3, P4, P5, P6, P7]) Curry5(p0 P0, p1 P1, p2 P2, p3 P3, p4 P4) CtxFunc3VariadicError[V, P5, P6, P7] {
+	return func(ctx context.Context, p5 P5, p6 P6, p7 P7, xs ...V) error {
+		return f(ctx, p0, p1, p2, p3, p4, p5, p6, p7, xs...)
+	}
+}
+
+// Curry4 returns a CtxFunc4VariadicError[V, P4, P5, P6, P7] with the first 4 arguments of the CtxFunc8VariadicError bound.
+func (f CtxFunc8VariadicError[V, P0, P1, P2, P3, P4, P5, P6, P7]) Curry4(p0 P0, p1 P1, p2 P2, p3 P3) CtxFunc4VariadicError[V, P4, P5, P6, P7] {
+	return func(ctx context.Context, p4 P4, p5 P5, p6 P6, p7 P7, xs ...V) error {
+		return f(ctx, p0, p1, p2, p3, p4, p5, p6, p7, xs...)
+	}
+}
+
+// Curry3 returns a CtxFunc5VariadicError[V, P3, P4, P5, P6, P7] with the first 3 arguments of the CtxFunc8VariadicError bound.
+func (f CtxFunc8VariadicError[V, P0, P1, P2, P3, P4, P5, P6, P7]) Curry3(p0 P0, p1 P1, p2 P2) CtxFunc5VariadicError[V, P3, P4, P5, P6, P7] {
+	return func(ctx context.Context, p3 P3, p4 P4, p5 P5, p6 P6, p7 P7, xs ...V) error {
+		return f(ctx, p0, p1, p2, p3, p4, p5, p6, p7, xs...)
+	}
+}
+
+// Curry2 returns a CtxFunc6VariadicError[V, P2, P3, P4, P5, P6, P7] with the first 2 arguments of the CtxFunc8VariadicError bound.
+func (f CtxFunc8VariadicError[V, P0, P1, P2, P3, P4, P5, P6, P7]) Curry2(p0 P0, p1 P1) CtxFunc6VariadicError[V, P2, P3, P4, P5, P6, P7] {
+	return func(ctx context.Context, p2 P2, p3 P3, p4 P4, p5 P5, p6 P6, p7 P7, xs ...V) error {
+		return f(ctx, p0, p1, p2, p3, p4, p5, p6, p7, xs...)
+	}
+}
+
+// Curry1 returns a CtxFunc7VariadicError[V, P1, P2, P3, P4, P5, P6, P7] with the first argument of the CtxFunc8VariadicError bound.
+func (f CtxFunc8VariadicError[V, P0, P1, P2, P3, P4, P5, P6, P7]) Curry1(p0 P0) CtxFunc7VariadicError[V, P1, P2, P3, P4, P5, P6, P7] {
+	return func(ctx context.Context, p1 P1, p2 P2, p3 P3, p4 P4, p5 P5, p6 P6, p7 P7, xs ...V) error {
+		return f(ctx, p0, p1, p2, p3, p4, p5, p6, p7, xs...)
+	}
+}
@@ -1,13 +1,14 @@
-//go:generate go run ./generator -arity 10
+// Code generated by powerfunc/generator from func.go.tmpl. DO NOT EDIT.
 
 package powerfunc
 
 import (
+	"context"
 	"fmt"
 	"time"
 )
 
-// Func8 is a function that takes 0 arguments and returns no values.
+// Func8 is a function that takes 8 arguments and returns no values.
 type Func8[P0, P1, P2, P3, P4, P5, P6, P7 any] func(p0 P0, p1 P1, p2 P2, p3 P3, p4 P4, p5 P5, p6 P6, p7 P7)
 
 // Exec executes the Function.
@@ -20,10 +21,15 @@ func (f Func8[P0, P1, P2, P3, P4, P5, P6, P7]) Exec(p0 P0, p1 P1, p2 P2, p3 P3,
 // Timing returns a Func8 that will log the execution time of the Func8.
 // If no loggers are provided, the default logger (fmt.Println) will be used.
 func (f Func8[P0, P1, P2, P3, P4, P5, P6, P7]) Timing(loggers ...func(d time.Duration)) Func8[P0, P1, P2, P3, P4, P5, P6, P7] {
+	return f.TimingWithClock(RealClock(), loggers...)
+}
+
+// TimingWithClock is like Timing, but measures the execution time with clock.
+func (f Func8[P0, P1, P2, P3, P4, P5, P6, P7]) TimingWithClock(clock Clock, loggers ...func(d time.Duration)) Func8[P0, P1, P2, P3, P4, P5, P6, P7] {
 	return func(p0 P0, p1 P1, p2 P2, p3 P3, p4 P4, p5 P5, p6 P6, p7 P7) {
-		start := time.Now()
+		start := clock.Now()
 		defer func() {
-			dur := time.Since(start)
+			dur := clock.Since(start)
 			for _, logger := range loggers {
 				logger(dur)
 			}
@@ -46,59 +52,110 @@ func (f Func8[P0, P1, P2, P3, P4, P5, P6, P7]) Fallible() Func8Error[P0, P1, P2,
 	}
 }
 
+// Debounce returns a Func8 that delays its execution until wait has elapsed
+// since the last call, along with the Debouncer controlling it.
+// Only the most recent call is kept and eventually invoked.
+func (f Func8[P0, P1, P2, P3, P4, P5, P6, P7]) Debounce(wait time.Duration, opts ...DebounceOption) (Func8[P0, P1, P2, P3, P4, P5, P6, P7], *Debouncer) {
+	d := newDebouncer(wait, opts...)
+	return func(p0 P0, p1 P1, p2 P2, p3 P3, p4 P4, p5 P5, p6 P6, p7 P7) {
+		d.call(func() {
+			f(p0, p1, p2, p3, p4, p5, p6, p7)
+		})
+	}, d
+}
+
+// Throttle returns a Func8 that executes at most once per interval, along
+// with the Debouncer controlling it.
+// The first call is invoked immediately, and the most recent call made
+// during the interval is invoked at the end of it.
+// The options, e.g. DebounceClock, apply to the underlying Debouncer.
+func (f Func8[P0, P1, P2, P3, P4, P5, P6, P7]) Throttle(interval time.Duration, opts ...DebounceOption) (Func8[P0, P1, P2, P3, P4, P5, P6, P7], *Debouncer) {
+	d := newThrottler(interval, opts...)
+	return func(p0 P0, p1 P1, p2 P2, p3 P3, p4 P4, p5 P5, p6 P6, p7 P7) {
+		d.call(func() {
+			f(p0, p1, p2, p3, p4, p5, p6, p7)
+		})
+	}, d
+}
+
+// Once returns a Func8 that only executes the first time it is called,
+// along with the Once controlling it.
+func (f Func8[P0, P1, P2, P3, P4, P5, P6, P7]) Once(opts ...OnceOption) (Func8[P0, P1, P2, P3, P4, P5, P6, P7], *Once) {
+	o := newOnce(opts...)
+	return func(p0 P0, p1 P1, p2 P2, p3 P3, p4 P4, p5 P5, p6 P6, p7 P7) {
+		_, _ = o.do(context.Background(), false, func() (any, error) {
+			f(p0, p1, p2, p3, p4, p5, p6, p7)
+			return nil, nil
+		})
+	}, o
+}
+
+// Lazy returns a Func8 that executes the first time it is called,
+// along with the Once controlling it.
+// A Func8 cannot fail, so Lazy behaves like Once unless it panics, in which
+// case the next call executes it again.
+func (f Func8[P0, P1, P2, P3, P4, P5, P6, P7]) Lazy(opts ...OnceOption) (Func8[P0, P1, P2, P3, P4, P5, P6, P7], *Once) {
+	o := newLazy(opts...)
+	return func(p0 P0, p1 P1, p2 P2, p3 P3, p4 P4, p5 P5, p6 P6, p7 P7) {
+		_, _ = o.do(context.Background(), false, func() (any, error) {
+			f(p0, p1, p2, p3, p4, p5, p6, p7)
+			return nil, nil
+		})
+	}, o
+}
 
+// Curry8 returns a Func with the first 8 arguments of the Func8 bound.
 func (f Func8[P0, P1, P2, P3, P4, P5, P6, P7]) Curry8(p0 P0, p1 P1, p2 P2, p3 P3, p4 P4, p5 P5, p6 P6, p7 P7) Func {
-	return func()  {
+	return func() {
 		f(p0, p1, p2, p3, p4, p5, p6, p7)
 	}
 }
-	
 
+// Curry7 returns a Func1[P7] with the first 7 arguments of the Func8 bound.
 func (f Func8[P0, P1, P2, P3, P4, P5, P6, P7]) Curry7(p0 P0, p1 P1, p2 P2, p3 P3, p4 P4, p5 P5, p6 P6) Func1[P7] {
-	return func(p7 P7)  {
+	return func(p7 P7) {
 		f(p0, p1, p2, p3, p4, p5, p6, p7)
 	}
 }
-	
 
+// Curry6 returns a Func2[P6, P7] with the first 6 arguments of the Func8 bound.
 func (f Func8[P0, P1, P2, P3, P4, P5, P6, P7]) Curry6(p0 P0, p1 P1, p2 P2, p3 P3, p4 P4, p5 P5) Func2[P6, P7] {
-	return func(p6 P6, p7 P7)  {
+	return func(p6 P6, p7 P7) {
 		f(p0, p1, p2, p3, p4, p5, p6, p7)
 	}
 }
-	
 
+// Curry5 returns a Func3[P5, P6, P7] with the first 5 arguments of the Func8 bound.
 func (f Func8[P0, P1, P2, P3, P4, P5, P6, P7]) Curry5(p0 P0, p1 P1, p2 P2, p3 P3, p4 P4) Func3[P5, P6, P7] {
-	return func(p5 P5, p6 P6, p7 P7)  {
+	return func(p5 P5, p6 P6, p7 P7) {
 		f(p0, p1, p2, p3, p4, p5, p6, p7)
 	}
 }
-	
 
+// Curry4 returns a Func4[P4, P5, P6, P7] with the first 4 arguments of the Func8 bound.
 func (f Func8[P0, P1, P2, P3, P4, P5, P6, P7]) Curry4(p0 P0, p1 P1, p2 P2, p3 P3) Func4[P4, P5, P6, P7] {
-	return func(p4 P4, p5 P5, p6 P6, p7 P7)  {
+	return func(p4 P4, p5 P5, p6 P6, p7 P7) {
 		f(p0, p1, p2, p3, p4, p5, p6, p7)
 	}
 }
-	
 
+// Curry3 returns a Func5[P3, P4, P5, P6, P7] with the first 3 arguments of the Func8 bound.
 func (f Func8[P0, P1, P2, P3, P4, P5, P6, P7]) Curry3(p0 P0, p1 P1, p2 P2) Func5[P3, P4, P5, P6, P7] {
-	return func(p3 P3, p4 P4, p5 P5, p6 P6, p7 P7)  {
+	return func(p3 P3, p4 P4, p5 P5, p6 P6, p7 P7) {
 		f(p0, p1, p2, p3, p4, p5, p6, p7)
 	}
 }
-	
 
+// Curry2 returns a Func6[P2, P3, P4, P5, P6, P7] with the first 2 arguments of the Func8 bound.
 func (f Func8[P0, P1, P2, P3, P4, P5, P6, P7]) Curry2(p0 P0, p1 P1) Func6[P2, P3, P4, P5, P6, P7] {
-	return func(p2 P2, p3 P3, p4 P4, p5 P5, p6 P6, p7 P7)  {
+	return func(p2 P2, p3 P3, p4 P4, p5 P5, p6 P6, p7 P7) {
 		f(p0, p1, p2, p3, p4, p5, p6, p7)
 	}
 }
-	
 
+// Curry1 returns a Func7[P1, P2, P3, P4, P5, P6, P7] with the first argument of the Func8 bound.
 func (f Func8[P0, P1, P2, P3, P4, P5, P6, P7]) Curry1(p0 P0) Func7[P1, P2, P3, P4, P5, P6, P7] {
-	return func(p1 P1, p2 P2, p3 P3, p4 P4, p5 P5, p6 P6, p7 P7)  {
+	return func(p1 P1, p2 P2, p3 P3, p4 P4, p5 P5, p6 P6, p7 P7) {
 		f(p0, p1, p2, p3, p4, p5, p6, p7)
 	}
 }
-	
\ No newline at end of file
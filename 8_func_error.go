@@ -1,11 +1,14 @@
+// Code generated by powerfunc/generator from func_error.go.tmpl. DO NOT EDIT.
+
 package powerfunc
 
 import (
+	"context"
 	"fmt"
 	"time"
 )
 
-// Func8Error is a function that takes 0 arguments and returns an error.
+// Func8Error is a function that takes 8 arguments and returns an error.
 type Func8Error[P0, P1, P2, P3, P4, P5, P6, P7 any] func(p0 P0, p1 P1, p2 P2, p3 P3, p4 P4, p5 P5, p6 P6, p7 P7) error
 
 // Exec executes the function and returns the error.
@@ -15,13 +18,18 @@ func (f Func8Error[P0, P1, P2, P3, P4, P5, P6, P7]) Exec(p0 P0, p1 P1, p2 P2, p3
 	return f(p0, p1, p2, p3, p4, p5, p6, p7)
 }
 
-// Timing returns a Func8 that will log the execution time of the Func8.
+// Timing returns a Func8Error that will log the execution time of the Func8Error.
 // If no loggers are provided, the default logger (fmt.Println) will be used.
 func (f Func8Error[P0, P1, P2, P3, P4, P5, P6, P7]) Timing(loggers ...func(d time.Duration)) Func8Error[P0, P1, P2, P3, P4, P5, P6, P7] {
+	return f.TimingWithClock(RealClock(), loggers...)
+}
+
+// TimingWithClock is like Timing, but measures the execution time with clock.
+func (f Func8Error[P0, P1, P2, P3, P4, P5, P6, P7]) TimingWithClock(clock Clock, loggers ...func(d time.Duration)) Func8Error[P0, P1, P2, P3, P4, P5, P6, P7] {
 	return func(p0 P0, p1 P1, p2 P2, p3 P3, p4 P4, p5 P5, p6 P6, p7 P7) error {
-		start := time.Now()
+		start := clock.Now()
 		defer func() {
-			dur := time.Since(start)
+			dur := clock.Since(start)
 			for _, logger := range loggers {
 				logger(dur)
 			}
@@ -34,20 +42,15 @@ func (f Func8Error[P0, P1, P2, P3, P4, P5, P6, P7]) Timing(loggers ...func(d tim
 	}
 }
 
-// Retry returns a Function that will retry the Function until it returns
-// a nil error or the tryAgain function returns false.
-func (f Func8Error[P0, P1, P2, P3, P4, P5, P6, P7]) Retry(tryAgain func(attempts int, err error) bool) Func8Error[P0, P1, P2, P3, P4, P5, P6, P7] {
+// Retry returns a Func8Error that will retry the Func8Error until it returns
+// a nil error or the tryAgain function returns false, in which case it
+// returns a *RetryError holding the errors of all the attempts.
+// The options set the backoff between attempts and hooks, e.g. OnRetry.
+func (f Func8Error[P0, P1, P2, P3, P4, P5, P6, P7]) Retry(tryAgain func(attempts int, err error) bool, opts ...RetryOption) Func8Error[P0, P1, P2, P3, P4, P5, P6, P7] {
 	return func(p0 P0, p1 P1, p2 P2, p3 P3, p4 P4, p5 P5, p6 P6, p7 P7) error {
-		var err error
-		attempts := 1
-		for {
-			err = f(p0, p1, p2, p3, p4, p5, p6, p7)
-			if err == nil || !tryAgain(attempts, err) {
-				break
-			}
-			attempts++
-		}
-		return err
+		return retryNoContext(tryAgain, opts, func() error {
+			return f(p0, p1, p2, p3, p4, p5, p6, p7)
+		})
 	}
 }
 
@@ -72,59 +75,117 @@ func (f Func8Error[P0, P1, P2, P3, P4, P5, P6, P7]) OnErr(msg string) Func8Error
 	}
 }
 
+// Once returns a Func8Error that only executes the first time it is called
+// and returns the cached error afterwards, along with the Once controlling it.
+// Use OnceRetryOnError or OnceBackoff to only cache a success.
+func (f Func8Error[P0, P1, P2, P3, P4, P5, P6, P7]) Once(opts ...OnceOption) (Func8Error[P0, P1, P2, P3, P4, P5, P6, P7], *Once) {
+	o := newOnce(opts...)
+	return func(p0 P0, p1 P1, p2 P2, p3 P3, p4 P4, p5 P5, p6 P6, p7 P7) error {
+		_, err := o.do(context.Background(), false, func() (any, error) {
+			return nil, f(p0, p1, p2, p3, p4, p5, p6, p7)
+		})
+		return err
+	}, o
+}
+
+// Lazy returns a Func8Error that executes until it succeeds once, along
+// with the Once controlling it.
+// Failures are not cached, unless a backoff is provided with OnceBackoff.
+func (f Func8Error[P0, P1, P2, P3, P4, P5, P6, P7]) Lazy(opts ...OnceOption) (Func8Error[P0, P1, P2, P3, P4, P5, P6, P7], *Once) {
+	o := newLazy(opts...)
+	return func(p0 P0, p1 P1, p2 P2, p3 P3, p4 P4, p5 P5, p6 P6, p7 P7) error {
+		_, err := o.do(context.Background(), false, func() (any, error) {
+			return nil, f(p0, p1, p2, p3, p4, p5, p6, p7)
+		})
+		return err
+	}, o
+}
+
+// InjectFaults returns a Func8Error injecting the errors, panics and latency
+// configured by cfg in its calls, to test how the callers cope with them.
+// See FaultConfig, and DisableFaults for the global kill-switch.
+// The faults cannot be enabled per request with OptIn, as there is no
+// context.
+func (f Func8Error[P0, P1, P2, P3, P4, P5, P6, P7]) InjectFaults(cfg FaultConfig) Func8Error[P0, P1, P2, P3, P4, P5, P6, P7] {
+	fi := newFaultInjector(cfg)
+	return func(p0 P0, p1 P1, p2 P2, p3 P3, p4 P4, p5 P5, p6 P6, p7 P7) error {
+		if err := fi.inject(context.Background(), p0, p1, p2, p3, p4, p5, p6, p7); err != nil {
+			return err
+		}
+		return f(p0, p1, p2, p3, p4, p5, p6, p7)
+	}
+}
+
+// WithTimeout returns a Func8Error that runs the Func8Error in its own
+// goroutine and returns a *TimeoutError, matching ErrTimeout, if it does not
+// return within timeout. The goroutine is then abandoned: it keeps running
+// until the Func8Error returns, and is counted by Abandoned.
+// Prefer the WithTimeout method of a function taking a context, which can
+// actually be cancelled.
+func (f Func8Error[P0, P1, P2, P3, P4, P5, P6, P7]) WithTimeout(timeout time.Duration, opts ...TimeoutOption) Func8Error[P0, P1, P2, P3, P4, P5, P6, P7] {
+	return func(p0 P0, p1 P1, p2 P2, p3 P3, p4 P4, p5 P5, p6 P6, p7 P7) error {
+		var err error
+		if terr := callWithTimeout(timeout, opts, func() {
+			err = f(p0, p1, p2, p3, p4, p5, p6, p7)
+		}); terr != nil {
+			return terr
+		}
+		return err
+	}
+}
 
+// Curry8 returns a FuncError with the first 8 arguments of the Func8Error bound.
 func (f Func8Error[P0, P1, P2, P3, P4, P5, P6, P7]) Curry8(p0 P0, p1 P1, p2 P2, p3 P3, p4 P4, p5 P5, p6 P6, p7 P7) FuncError {
 	return func() error {
 		return f(p0, p1, p2, p3, p4, p5, p6, p7)
 	}
 }
-	
 
+// Curry7 returns a Func1Error[P7] with the first 7 arguments of the Func8Error bound.
 func (f Func8Error[P0, P1, P2, P3, P4, P5, P6, P7]) Curry7(p0 P0, p1 P1, p2 P2, p3 P3, p4 P4, p5 P5, p6 P6) Func1Error[P7] {
 	return func(p7 P7) error {
 		return f(p0, p1, p2, p3, p4, p5, p6, p7)
 	}
 }
-	
 
+// Curry6 returns a Func2Error[P6, P7] with the first 6 arguments of the Func8Error bound.
 func (f Func8Error[P0, P1, P2, P3, P4, P5, P6, P7]) Curry6(p0 P0, p1 P1, p2 P2, p3 P3, p4 P4, p5 P5) Func2Error[P6, P7] {
 	return func(p6 P6, p7 P7) error {
 		return f(p0, p1, p2, p3, p4, p5, p6, p7)
 	}
 }
-	
 
+// Curry5 returns a Func3Error[P5, P6, P7] with the first 5 arguments of the Func8Error bound.
 func (f Func8Error[P0, P1, P2, P3, P4, P5, P6, P7]) Curry5(p0 P0, p1 P1, p2 P2, p3 P3, p4 P4) Func3Error[P5, P6, P7] {
 	return func(p5 P5, p6 P6, p7 P7) error {
 		return f(p0, p1, p2, p3, p4, p5, p6, p7)
 	}
 }
-	
 
+// Curry4 returns a Func4Error[P4, P5, P6, P7] with the first 4 arguments of the Func8Error bound.
 func (f Func8Error[P0, P1, P2, P3, P4, P5, P6, P7]) Curry4(p0 P0, p1 P1, p2 P2, p3 P3) Func4Error[P4, P5, P6, P7] {
 	return func(p4 P4, p5 P5, p6 P6, p7 P7) error {
 		return f(p0, p1, p2, p3, p4, p5, p6, p7)
 	}
 }
-	
 
+// Curry3 returns a Func5Error[P3, P4, P5, P6, P7] with the first 3 arguments of the Func8Error bound.
 func (f Func8Error[P0, P1, P2, P3, P4, P5, P6, P7]) Curry3(p0 P0, p1 P1, p2 P2) Func5Error[P3, P4, P5, P6, P7] {
 	return func(p3 P3, p4 P4, p5 P5, p6 P6, p7 P7) error {
 		return f(p0, p1, p2, p3, p4, p5, p6, p7)
 	}
 }
-	
 
+// Curry2 returns a Func6Error[P2, P3, P4, P5, P6, P7] with the first 2 arguments of the Func8Error bound.
 func (f Func8Error[P0, P1, P2, P3, P4, P5, P6, P7]) Curry2(p0 P0, p1 P1) Func6Error[P2, P3, P4, P5, P6, P7] {
 	return func(p2 P2, p3 P3, p4 P4, p5 P5, p6 P6, p7 P7) error {
 		return f(p0, p1, p2, p3, p4, p5, p6, p7)
 	}
 }
-	
 
+// Curry1 returns a Func7Error[P1, P2, P3, P4, P5, P6, P7] with the first argument of the Func8Error bound.
 func (f Func8Error[P0, P1, P2, P3, P4, P5, P6, P7]) Curry1(p0 P0) Func7Error[P1, P2, P3, P4, P5, P6, P7] {
 	return func(p1 P1, p2 P2, p3 P3, p4 P4, p5 P5, p6 P6, p7 P7) error {
 		return f(p0, p1, p2, p3, p4, p5, p6, p7)
 	}
 }
-	
\ No newline at end of file
@@ -0,0 +1,197 @@
+// Code generated by powerfunc/generator from func_ok.go.tmpl. DO NOT EDIT.
+
+package powerfunc
+
+import (
+	"fmt"
+	"time"
+)
+
+// Func8Ok is a function that takes 8 arguments and returns a value and a
+// boolean reporting whether the value is valid, like a map lookup.
+type Func8Ok[R, P0, P1, P2, P3, P4, P5, P6, P7 any] func(p0 P0, p1 P1, p2 P2, p3 P3, p4 P4, p5 P5, p6 P6, p7 P7) (R, bool)
+
+// Exec executes the function.
+// Convenience method to remove some of the confusion that
+// `f.SomeMethod()()` can bring.
+func (f Func8Ok[R, P0, P1, P2, P3, P4, P5, P6, P7]) Exec(p0 P0, p1 P1, p2 P2, p3 P3, p4 P4, p5 P5, p6 P6, p7 P7) (R, bool) {
+	return f(p0, p1, p2, p3, p4, p5, p6, p7)
+}
+
+// Timing returns a Func8Ok that will log the execution time of the Func8Ok.
+// If no loggers are provided, the default logger (fmt.Println) will be used.
+func (f Func8Ok[R, P0, P1, P2, P3, P4, P5, P6, P7]) Timing(loggers ...func(d time.Duration)) Func8Ok[R, P0, P1, P2, P3, P4, P5, P6, P7] {
+	return f.TimingWithClock(RealClock(), loggers...)
+}
+
+// TimingWithClock is like Timing, but measures the execution time with clock.
+func (f Func8Ok[R, P0, P1, P2, P3, P4, P5, P6, P7]) TimingWithClock(clock Clock, loggers ...func(d time.Duration)) Func8Ok[R, P0, P1, P2, P3, P4, P5, P6, P7] {
+	return func(p0 P0, p1 P1, p2 P2, p3 P3, p4 P4, p5 P5, p6 P6, p7 P7) (R, bool) {
+		start := clock.Now()
+		defer func() {
+			dur := clock.Since(start)
+			for _, logger := range loggers {
+				logger(dur)
+			}
+			if len(loggers) == 0 {
+				// Default logger
+				fmt.Println(dur)
+			}
+		}()
+		return f(p0, p1, p2, p3, p4, p5, p6, p7)
+	}
+}
+
+// Retry returns a Func8Ok that will retry the Func8Ok until it returns
+// true or the tryAgain function returns false.
+// The attempts that returned false fail with a placeholder error, given to
+// tryAgain and to the hooks of the options, e.g. OnRetry, so that any
+// RetryPolicy can be used.
+func (f Func8Ok[R, P0, P1, P2, P3, P4, P5, P6, P7]) Retry(tryAgain RetryPolicy, opts ...RetryOption) Func8Ok[R, P0, P1, P2, P3, P4, P5, P6, P7] {
+	return func(p0 P0, p1 P1, p2 P2, p3 P3, p4 P4, p5 P5, p6 P6, p7 P7) (R, bool) {
+		var v R
+		var ok bool
+		err := retryNoContext(tryAgain, opts, func() error {
+			v, ok = f(p0, p1, p2, p3, p4, p5, p6, p7)
+			if !ok {
+				return errNotOk
+			}
+			return nil
+		})
+		return v, err == nil
+	}
+}
+
+// Must returns a Func8Value that will panic if the Func8Ok returns false.
+func (f Func8Ok[R, P0, P1, P2, P3, P4, P5, P6, P7]) Must() Func8Value[R, P0, P1, P2, P3, P4, P5, P6, P7] {
+	return func(p0 P0, p1 P1, p2 P2, p3 P3, p4 P4, p5 P5, p6 P6, p7 P7) R {
+		v, ok := f(p0, p1, p2, p3, p4, p5, p6, p7)
+		if !ok {
+			panic("powerfunc: Func8Ok returned false")
+		}
+		return v
+	}
+}
+
+// Fallback returns a Func8Value that will return the provided value if the
+// Func8Ok returns false.
+func (f Func8Ok[R, P0, P1, P2, P3, P4, P5, P6, P7]) Fallback(val R) Func8Value[R, P0, P1, P2, P3, P4, P5, P6, P7] {
+	return func(p0 P0, p1 P1, p2 P2, p3 P3, p4 P4, p5 P5, p6 P6, p7 P7) R {
+		v, ok := f(p0, p1, p2, p3, p4, p5, p6, p7)
+		if !ok {
+			return val
+		}
+		return v
+	}
+}
+
+// OrElse returns a Func8Value that will return the provided value if the
+// Func8Ok returns false. It is an alias of Fallback.
+func (f Func8Ok[R, P0, P1, P2, P3, P4, P5, P6, P7]) OrElse(val R) Func8Value[R, P0, P1, P2, P3, P4, P5, P6, P7] {
+	return f.Fallback(val)
+}
+
+// OrElseGet returns a Func8Value that will call elsef if the Func8Ok
+// returns false.
+func (f Func8Ok[R, P0, P1, P2, P3, P4, P5, P6, P7]) OrElseGet(elsef Func8Value[R, P0, P1, P2, P3, P4, P5, P6, P7]) Func8Value[R, P0, P1, P2, P3, P4, P5, P6, P7] {
+	return func(p0 P0, p1 P1, p2 P2, p3 P3, p4 P4, p5 P5, p6 P6, p7 P7) R {
+		v, ok := f(p0, p1, p2, p3, p4, p5, p6, p7)
+		if !ok {
+			return elsef(p0, p1, p2, p3, p4, p5, p6, p7)
+		}
+		return v
+	}
+}
+
+// ToResult transforms a Func8Ok into a Func8Result returning the provided
+// error when the Func8Ok returns false.
+func (f Func8Ok[R, P0, P1, P2, P3, P4, P5, P6, P7]) ToResult(err error) Func8Result[R, P0, P1, P2, P3, P4, P5, P6, P7] {
+	return func(p0 P0, p1 P1, p2 P2, p3 P3, p4 P4, p5 P5, p6 P6, p7 P7) (R, error) {
+		v, ok := f(p0, p1, p2, p3, p4, p5, p6, p7)
+		if !ok {
+			return v, err
+		}
+		return v, nil
+	}
+}
+
+// Filter returns a Func8Ok that will return false if the value returned by
+// the Func8Ok does not satisfy keep.
+func (f Func8Ok[R, P0, P1, P2, P3, P4, P5, P6, P7]) Filter(keep func(R) bool) Func8Ok[R, P0, P1, P2, P3, P4, P5, P6, P7] {
+	return func(p0 P0, p1 P1, p2 P2, p3 P3, p4 P4, p5 P5, p6 P6, p7 P7) (R, bool) {
+		v, ok := f(p0, p1, p2, p3, p4, p5, p6, p7)
+		if !ok || !keep(v) {
+			var zero R
+			return zero, false
+		}
+		return v, true
+	}
+}
+
+// Map applies the provided function to the value returned by the Func8Ok,
+// if it returns true.
+func (f Func8Ok[R, P0, P1, P2, P3, P4, P5, P6, P7]) Map(fn func(R) R) Func8Ok[R, P0, P1, P2, P3, P4, P5, P6, P7] {
+	return func(p0 P0, p1 P1, p2 P2, p3 P3, p4 P4, p5 P5, p6 P6, p7 P7) (R, bool) {
+		v, ok := f(p0, p1, p2, p3, p4, p5, p6, p7)
+		if !ok {
+			return v, false
+		}
+		return fn(v), true
+	}
+}
+
+// Curry8 returns a FuncOk[R] with the first 8 arguments of the Func8Ok bound.
+func (f Func8Ok[R, P0, P1, P2, P3, P4, P5, P6, P7]) Curry8(p0 P0, p1 P1, p2 P2, p3 P3, p4 P4, p5 P5, p6 P6, p7 P7) FuncOk[R] {
+	return func() (R, bool) {
+		return f(p0, p1, p2, p3, p4, p5, p6, p7)
+	}
+}
+
+// Curry7 returns a Func1Ok[R, P7] with the first 7 arguments of the Func8Ok bound.
+func (f Func8Ok[R, P0, P1, P2, P3, P4, P5, P6, P7]) Curry7(p0 P0, p1 P1, p2 P2, p3 P3, p4 P4, p5 P5, p6 P6) Func1Ok[R, P7] {
+	return func(p7 P7) (R, bool) {
+		return f(p0, p1, p2, p3, p4, p5, p6, p7)
+	}
+}
+
+// Curry6 returns a Func2Ok[R, P6, P7] with the first 6 arguments of the Func8Ok bound.
+func (f Func8Ok[R, P0, P1, P2, P3, P4, P5, P6, P7]) Curry6(p0 P0, p1 P1, p2 P2, p3 P3, p4 P4, p5 P5) Func2Ok[R, P6, P7] {
+	return func(p6 P6, p7 P7) (R, bool) {
+		return f(p0, p1, p2, p3, p4, p5, p6, p7)
+	}
+}
+
+// Curry5 returns a Func3Ok[R, P5, P6, P7] with the first 5 arguments of the Func8Ok bound.
+func (f Func8Ok[R, P0, P1, P2, P3, P4, P5, P6, P7]) Curry5(p0 P0, p1 P1, p2 P2, p3 P3, p4 P4) Func3Ok[R, P5, P6, P7] {
+	return func(p5 P5, p6 P6, p7 P7) (R, bool) {
+		return f(p0, p1, p2, p3, p4, p5, p6, p7)
+	}
+}
+
+// Curry4 returns a Func4Ok[R, P4, P5, P6, P7] with the first 4 arguments of the Func8Ok bound.
+func (f Func8Ok[R, P0, P1, P2, P3, P4, P5, P6, P7]) Curry4(p0 P0, p1 P1, p2 P2, p3 P3) Func4Ok[R, P4, P5, P6, P7] {
+	return func(p4 P4, p5 P5, p6 P6, p7 P7) (R, bool) {
+		return f(p0, p1, p2, p3, p4, p5, p6, p7)
+	}
+}
+
+// Curry3 returns a Func5Ok[R, P3, P4, P5, P6, P7] with the first 3 arguments of the Func8Ok bound.
+func (f Func8Ok[R, P0, P1, P2, P3, P4, P5, P6, P7]) Curry3(p0 P0, p1 P1, p2 P2) Func5Ok[R, P3, P4, P5, P6, P7] {
+	return func(p3 P3, p4 P4, p5 P5, p6 P6, p7 P7) (R, bool) {
+		return f(p0, p1, p2, p3, p4, p5, p6, p7)
+	}
+}
+
+// Curry2 returns a Func6Ok[R, P2, P3, P4, P5, P6, P7] with the first 2 arguments of the Func8Ok bound.
+func (f Func8Ok[R, P0, P1, P2, P3, P4, P5, P6, P7]) Curry2(p0 P0, p1 P1) Func6Ok[R, P2, P3, P4, P5, P6, P7] {
+	return func(p2 P2, p3 P3, p4 P4, p5 P5, p6 P6, p7 P7) (R, bool) {
+		return f(p0, p1, p2, p3, p4, p5, p6, p7)
+	}
+}
+
+// Curry1 returns a Func7Ok[R, P1, P2, P3, P4, P5, P6, P7] with the first argument of the Func8Ok bound.
+func (f Func8Ok[R, P0, P1, P2, P3, P4, P5, P6, P7]) Curry1(p0 P0) Func7Ok[R, P1, P2, P3, P4, P5, P6, P7] {
+	return func(p1 P1, p2 P2, p3 P3, p4 P4, p5 P5, p6 P6, p7 P7) (R, bool) {
+		return f(p0, p1, p2, p3, p4, p5, p6, p7)
+	}
+}
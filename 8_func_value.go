@@ -1,27 +1,35 @@
+// Code generated by powerfunc/generator from func_value.go.tmpl. DO NOT EDIT.
+
 package powerfunc
 
 import (
+	"context"
 	"fmt"
 	"time"
 )
 
-// Func8 is a function that takes 0 arguments and returns a value.
-type Func8Value[T, P0, P1, P2, P3, P4, P5, P6, P7 any] func(p0 P0, p1 P1, p2 P2, p3 P3, p4 P4, p5 P5, p6 P6, p7 P7) T
+// Func8Value is a function that takes 8 arguments and returns a value.
+type Func8Value[R, P0, P1, P2, P3, P4, P5, P6, P7 any] func(p0 P0, p1 P1, p2 P2, p3 P3, p4 P4, p5 P5, p6 P6, p7 P7) R
 
 // Exec executes the function.
 // Convenience method to remove some of the confusion that
 // `f.SomeMethod()()` can bring.
-func (f Func8Value[T, P0, P1, P2, P3, P4, P5, P6, P7]) Exec(p0 P0, p1 P1, p2 P2, p3 P3, p4 P4, p5 P5, p6 P6, p7 P7) T {
+func (f Func8Value[R, P0, P1, P2, P3, P4, P5, P6, P7]) Exec(p0 P0, p1 P1, p2 P2, p3 P3, p4 P4, p5 P5, p6 P6, p7 P7) R {
 	return f(p0, p1, p2, p3, p4, p5, p6, p7)
 }
 
-// Timing returns a Func8 that will log the execution time of the Func8.
+// Timing returns a Func8Value that will log the execution time of the Func8Value.
 // If no loggers are provided, the default logger (fmt.Println) will be used.
-func (f Func8Value[T, P0, P1, P2, P3, P4, P5, P6, P7]) Timing(loggers ...func(d time.Duration)) Func8Value[T, P0, P1, P2, P3, P4, P5, P6, P7] {
-	return func(p0 P0, p1 P1, p2 P2, p3 P3, p4 P4, p5 P5, p6 P6, p7 P7) T {
-		start := time.Now()
+func (f Func8Value[R, P0, P1, P2, P3, P4, P5, P6, P7]) Timing(loggers ...func(d time.Duration)) Func8Value[R, P0, P1, P2, P3, P4, P5, P6, P7] {
+	return f.TimingWithClock(RealClock(), loggers...)
+}
+
+// TimingWithClock is like Timing, but measures the execution time with clock.
+func (f Func8Value[R, P0, P1, P2, P3, P4, P5, P6, P7]) TimingWithClock(clock Clock, loggers ...func(d time.Duration)) Func8Value[R, P0, P1, P2, P3, P4, P5, P6, P7] {
+	return func(p0 P0, p1 P1, p2 P2, p3 P3, p4 P4, p5 P5, p6 P6, p7 P7) R {
+		start := clock.Now()
 		defer func() {
-			dur := time.Since(start)
+			dur := clock.Since(start)
 			for _, logger := range loggers {
 				logger(dur)
 			}
@@ -37,65 +45,110 @@ func (f Func8Value[T, P0, P1, P2, P3, P4, P5, P6, P7]) Timing(loggers ...func(d
 // Fallible transforms a Func8Value into a Func8Result.
 // The returned Func8Result will never return an error.
 // Useful when passing a Func8Value to a function that expects a Func8Result.
-func (f Func8Value[T, P0, P1, P2, P3, P4, P5, P6, P7]) Fallible() Func8Result[T, P0, P1, P2, P3, P4, P5, P6, P7] {
-	return func(p0 P0, p1 P1, p2 P2, p3 P3, p4 P4, p5 P5, p6 P6, p7 P7) (T, error) {
+func (f Func8Value[R, P0, P1, P2, P3, P4, P5, P6, P7]) Fallible() Func8Result[R, P0, P1, P2, P3, P4, P5, P6, P7] {
+	return func(p0 P0, p1 P1, p2 P2, p3 P3, p4 P4, p5 P5, p6 P6, p7 P7) (R, error) {
 		return f(p0, p1, p2, p3, p4, p5, p6, p7), nil
 	}
 }
 
+// Once returns a Func8Value that only executes the first time it is called
+// and returns the cached value afterwards, along with the Once controlling it.
+func (f Func8Value[R, P0, P1, P2, P3, P4, P5, P6, P7]) Once(opts ...OnceOption) (Func8Value[R, P0, P1, P2, P3, P4, P5, P6, P7], *Once) {
+	o := newOnce(opts...)
+	return func(p0 P0, p1 P1, p2 P2, p3 P3, p4 P4, p5 P5, p6 P6, p7 P7) R {
+		v, _ := o.do(context.Background(), false, func() (any, error) {
+			return f(p0, p1, p2, p3, p4, p5, p6, p7), nil
+		})
+		val, _ := v.(R)
+		return val
+	}, o
+}
+
+// Lazy returns a Func8Value that computes its value the first time it is
+// called and returns the cached value afterwards, along with the Once
+// controlling it.
+func (f Func8Value[R, P0, P1, P2, P3, P4, P5, P6, P7]) Lazy(opts ...OnceOption) (Func8Value[R, P0, P1, P2, P3, P4, P5, P6, P7], *Once) {
+	o := newLazy(opts...)
+	return func(p0 P0, p1 P1, p2 P2, p3 P3, p4 P4, p5 P5, p6 P6, p7 P7) R {
+		v, _ := o.do(context.Background(), false, func() (any, error) {
+			return f(p0, p1, p2, p3, p4, p5, p6, p7), nil
+		})
+		val, _ := v.(R)
+		return val
+	}, o
+}
+
+// WithTimeout returns a Func8Result that runs the Func8Value in its own
+// goroutine and returns a *TimeoutError, matching ErrTimeout, if it does not
+// return within timeout. The goroutine is then abandoned: it keeps running
+// until the Func8Value returns, and is counted by Abandoned.
+// Prefer the WithTimeout method of a function taking a context, which can
+// actually be cancelled.
+func (f Func8Value[R, P0, P1, P2, P3, P4, P5, P6, P7]) WithTimeout(timeout time.Duration, opts ...TimeoutOption) Func8Result[R, P0, P1, P2, P3, P4, P5, P6, P7] {
+	return func(p0 P0, p1 P1, p2 P2, p3 P3, p4 P4, p5 P5, p6 P6, p7 P7) (R, error) {
+		var r0 R
+		if terr := callWithTimeout(timeout, opts, func() {
+			r0 = f(p0, p1, p2, p3, p4, p5, p6, p7)
+		}); terr != nil {
+			var zero R
+			return zero, terr
+		}
+		return r0, nil
+	}
+}
 
+// Curry8 returns a FuncValue[R] with the first 8 arguments of the Func8Value bound.
 func (f Func8Value[R, P0, P1, P2, P3, P4, P5, P6, P7]) Curry8(p0 P0, p1 P1, p2 P2, p3 P3, p4 P4, p5 P5, p6 P6, p7 P7) FuncValue[R] {
 	return func() R {
 		return f(p0, p1, p2, p3, p4, p5, p6, p7)
 	}
 }
-	
 
+// Curry7 returns a Func1Value[R, P7] with the first 7 arguments of the Func8Value bound.
 func (f Func8Value[R, P0, P1, P2, P3, P4, P5, P6, P7]) Curry7(p0 P0, p1 P1, p2 P2, p3 P3, p4 P4, p5 P5, p6 P6) Func1Value[R, P7] {
 	return func(p7 P7) R {
 		return f(p0, p1, p2, p3, p4, p5, p6, p7)
 	}
 }
-	
 
+// Curry6 returns a Func2Value[R, P6, P7] with the first 6 arguments of the Func8Value bound.
 func (f Func8Value[R, P0, P1, P2, P3, P4, P5, P6, P7]) Curry6(p0 P0, p1 P1, p2 P2, p3 P3, p4 P4, p5 P5) Func2Value[R, P6, P7] {
 	return func(p6 P6, p7 P7) R {
 		return f(p0, p1, p2, p3, p4, p5, p6, p7)
 	}
 }
-	
 
+// Curry5 returns a Func3Value[R, P5, P6, P7] with the first 5 arguments of the Func8Value bound.
 func (f Func8Value[R, P0, P1, P2, P3, P4, P5, P6, P7]) Curry5(p0 P0, p1 P1, p2 P2, p3 P3, p4 P4) Func3Value[R, P5, P6, P7] {
 	return func(p5 P5, p6 P6, p7 P7) R {
 		return f(p0, p1, p2, p3, p4, p5, p6, p7)
 	}
 }
-	
 
+// Curry4 returns a Func4Value[R, P4, P5, P6, P7] with the first 4 arguments of the Func8Value bound.
 func (f Func8Value[R, P0, P1, P2, P3, P4, P5, P6, P7]) Curry4(p0 P0, p1 P1, p2 P2, p3 P3) Func4Value[R, P4, P5, P6, P7] {
 	return func(p4 P4, p5 P5, p6 P6, p7 P7) R {
 		return f(p0, p1, p2, p3, p4, p5, p6, p7)
 	}
 }
-	
 
+// Curry3 returns a Func5Value[R, P3, P4, P5, P6, P7] with the first 3 arguments of the Func8Value bound.
 func (f Func8Value[R, P0, P1, P2, P3, P4, P5, P6, P7]) Curry3(p0 P0, p1 P1, p2 P2) Func5Value[R, P3, P4, P5, P6, P7] {
 	return func(p3 P3, p4 P4, p5 P5, p6 P6, p7 P7) R {
 		return f(p0, p1, p2, p3, p4, p5, p6, p7)
 	}
 }
-	
 
+// Curry2 returns a Func6Value[R, P2, P3, P4, P5, P6, P7] with the first 2 arguments of the Func8Value bound.
 func (f Func8Value[R, P0, P1, P2, P3, P4, P5, P6, P7]) Curry2(p0 P0, p1 P1) Func6Value[R, P2, P3, P4, P5, P6, P7] {
 	return func(p2 P2, p3 P3, p4 P4, p5 P5, p6 P6, p7 P7) R {
 		return f(p0, p1, p2, p3, p4, p5, p6, p7)
 	}
 }
-	
 
+// Curry1 returns a Func7Value[R, P1, P2, P3, P4, P5, P6, P7] with the first argument of the Func8Value bound.
 func (f Func8Value[R, P0, P1, P2, P3, P4, P5, P6, P7]) Curry1(p0 P0) Func7Value[R, P1, P2, P3, P4, P5, P6, P7] {
 	return func(p1 P1, p2 P2, p3 P3, p4 P4, p5 P5, p6 P6, p7 P7) R {
 		return f(p0, p1, p2, p3, p4, p5, p6, p7)
 	}
 }
-	
\ No newline at end of file
@@ -0,0 +1,174 @@
+// Code generated by powerfunc/generator from func_variadic_result.go.tmpl. DO NOT EDIT.
+
+package powerfunc
+
+import (
+	"context"
+	"fmt"
+	"time"
+)
+
+// Func8VariadicResult is a function that takes a variable number of
+// arguments and returns a value and an error.
+type Func8VariadicResult[R, V, P0, P1, P2, P3, P4, P5, P6, P7 any] func(p0 P0, p1 P1, p2 P2, p3 P3, p4 P4, p5 P5, p6 P6, p7 P7, xs ...V) (R, error)
+
+// Exec executes the function.
+// Convenience method to remove some of the confusion that
+// `f.SomeMethod()()` can bring.
+func (f Func8VariadicResult[R, V, P0, P1, P2, P3, P4, P5, P6, P7]) Exec(p0 P0, p1 P1, p2 P2, p3 P3, p4 P4, p5 P5, p6 P6, p7 P7, xs ...V) (R, error) {
+	return f(p0, p1, p2, p3, p4, p5, p6, p7, xs...)
+}
+
+// Timing returns a Func8VariadicResult that will log the execution time of the Func8VariadicResult.
+// If no loggers are provided, the default logger (fmt.Println) will be used.
+func (f Func8VariadicResult[R, V, P0, P1, P2, P3, P4, P5, P6, P7]) Timing(loggers ...func(d time.Duration)) Func8VariadicResult[R, V, P0, P1, P2, P3, P4, P5, P6, P7] {
+	return f.TimingWithClock(RealClock(), loggers...)
+}
+
+// TimingWithClock is like Timing, but measures the execution time with clock.
+func (f Func8VariadicResult[R, V, P0, P1, P2, P3, P4, P5, P6, P7]) TimingWithClock(clock Clock, loggers ...func(d time.Duration)) Func8VariadicResult[R, V, P0, P1, P2, P3, P4, P5, P6, P7] {
+	return func(p0 P0, p1 P1, p2 P2, p3 P3, p4 P4, p5 P5, p6 P6, p7 P7, xs ...V) (R, error) {
+		start := clock.Now()
+		defer func() {
+			dur := clock.Since(start)
+			for _, logger := range loggers {
+				logger(dur)
+			}
+			if len(loggers) == 0 {
+				// Default logger
+				fmt.Println(dur)
+			}
+		}()
+		return f(p0, p1, p2, p3, p4, p5, p6, p7, xs...)
+	}
+}
+
+// Retry returns a Func8VariadicResult that will retry the Func8VariadicResult until it returns
+// a nil error or the tryAgain function returns false, in which case it
+// returns a *RetryError holding the errors of all the attempts.
+// The options set the backoff between attempts and hooks, e.g. OnRetry.
+func (f Func8VariadicResult[R, V, P0, P1, P2, P3, P4, P5, P6, P7]) Retry(tryAgain func(attempts int, err error) bool, opts ...RetryOption) Func8VariadicResult[R, V, P0, P1, P2, P3, P4, P5, P6, P7] {
+	return func(p0 P0, p1 P1, p2 P2, p3 P3, p4 P4, p5 P5, p6 P6, p7 P7, xs ...V) (R, error) {
+		var r0 R
+		err := retryNoContext(tryAgain, opts, func() error {
+			var err error
+			r0, err = f(p0, p1, p2, p3, p4, p5, p6, p7, xs...)
+			return err
+		})
+		return r0, err
+	}
+}
+
+// OnErr returns a Func8VariadicResult that will wrap the error returned by
+// the Func8VariadicResult with the provided message.
+func (f Func8VariadicResult[R, V, P0, P1, P2, P3, P4, P5, P6, P7]) OnErr(msg string) Func8VariadicResult[R, V, P0, P1, P2, P3, P4, P5, P6, P7] {
+	return func(p0 P0, p1 P1, p2 P2, p3 P3, p4 P4, p5 P5, p6 P6, p7 P7, xs ...V) (R, error) {
+		v, err := f(p0, p1, p2, p3, p4, p5, p6, p7, xs...)
+		if err != nil {
+			return v, fmt.Errorf("%s: %w", msg, err)
+		}
+		return v, nil
+	}
+}
+
+// WithTimeout returns a Func8VariadicResult that runs the Func8VariadicResult in its own
+// goroutine and returns a *TimeoutError, matching ErrTimeout, if it does not
+// return within timeout. The goroutine is then abandoned: it keeps running
+// until the Func8VariadicResult returns, and is counted by Abandoned.
+// Prefer the WithTimeout method of a function taking a context, which can
+// actually be cancelled.
+func (f Func8VariadicResult[R, V, P0, P1, P2, P3, P4, P5, P6, P7]) WithTimeout(timeout time.Duration, opts ...TimeoutOption) Func8VariadicResult[R, V, P0, P1, P2, P3, P4, P5, P6, P7] {
+	return func(p0 P0, p1 P1, p2 P2, p3 P3, p4 P4, p5 P5, p6 P6, p7 P7, xs ...V) (R, error) {
+		var r0 R
+		var err error
+		if terr := callWithTimeout(timeout, opts, func() {
+			r0, err = f(p0, p1, p2, p3, p4, p5, p6, p7, xs...)
+		}); terr != nil {
+			var zero R
+			return zero, terr
+		}
+		return r0, err
+	}
+}
+
+// CurryVariadic returns a Func8VariadicResult with some of its variadic
+// arguments bound. The bound arguments come before the ones passed to the
+// returned function.
+func (f Func8VariadicResult[R, V, P0, P1, P2, P3, P4, P5, P6, P7]) CurryVariadic(bound ...V) Func8VariadicResult[R, V, P0, P1, P2, P3, P4, P5, P6, P7] {
+	return func(p0 P0, p1 P1, p2 P2, p3 P3, p4 P4, p5 P5, p6 P6, p7 P7, xs ...V) (R, error) {
+		xs = append(append(make([]V, 0, len(bound)+len(xs)), bound...), xs...)
+		return f(p0, p1, p2, p3, p4, p5, p6, p7, xs...)
+	}
+}
+
+// InjectFaults returns a Func8VariadicResult injecting the errors, panics and latency
+// configured by cfg in its calls, to test how the callers cope with them.
+// See FaultConfig, and DisableFaults for the global kill-switch.
+// The faults cannot be enabled per request with OptIn, as there is no
+// context.
+func (f Func8VariadicResult[R, V, P0, P1, P2, P3, P4, P5, P6, P7]) InjectFaults(cfg FaultConfig) Func8VariadicResult[R, V, P0, P1, P2, P3, P4, P5, P6, P7] {
+	fi := newFaultInjector(cfg)
+	return func(p0 P0, p1 P1, p2 P2, p3 P3, p4 P4, p5 P5, p6 P6, p7 P7, xs ...V) (R, error) {
+		if err := fi.inject(context.Background(), p0, p1, p2, p3, p4, p5, p6, p7, xs); err != nil {
+			var r0 R
+			return r0, err
+		}
+		return f(p0, p1, p2, p3, p4, p5, p6, p7, xs...)
+	}
+}
+
+// Curry8 returns a FuncVariadicResult[R, V] with the first 8 arguments of the Func8VariadicResult bound.
+func (f Func8VariadicResult[R, V, P0, P1, P2, P3, P4, P5, P6, P7]) Curry8(p0 P0, p1 P1, p2 P2, p3 P3, p4 P4, p5 P5, p6 P6, p7 P7) FuncVariadicResult[R, V] {
+	return func(xs ...V) (R, error) {
+		return f(p0, p1, p2, p3, p4, p5, p6, p7, xs...)
+	}
+}
+
+// Curry7 returns a Func1VariadicResult[R, V, P7] with the first 7 arguments of the Func8VariadicResult bound.
+func (f Func8VariadicResult[R, V, P0, P1, P2, P3, P4, P5, P6, P7]) Curry7(p0 P0, p1 P1, p2 P2, p3 P3, p4 P4, p5 P5, p6 P6) Func1VariadicResult[R, V, P7] {
+	return func(p7 P7, xs ...V) (R, error) {
+		return f(p0, p1, p2, p3, p4, p5, p6, p7, xs...)
+	}
+}
+
+// Curry6 returns a Func2VariadicResult[R, V, P6, P7] with the first 6 arguments of the Func8VariadicResult bound.
+func (f Func8VariadicResult[R, V, P0, P1, P2, P3, P4, P5, P6, P7]) Curry6(p0 P0, p1 P1, p2 P2, p3 P3, p4 P4, p5 P5) Func2VariadicResult[R, V, P6, P7] {
+	return func(p6 P6, p7 P7, xs ...V) (R, error) {
+		return f(p0, p1, p2, p3, p4, p5, p6, p7, xs...)
+	}
+}
+
+// Curry5 returns a Func3VariadicResult[R, V, P5, P6, P7] with the first 5 arguments of the Func8VariadicResult bound.
+func (f Func8VariadicResult[R, V, P0, P1, P2, P3, P4, P5, P6, P7]) Curry5(p0 P0, p1 P1, p2 P2, p3 P3, p4 P4) Func3VariadicResult[R, V, P5, P6, P7] {
+	return func(p5 P5, p6 P6, p7 P7, xs ...V) (R, error) {
+		return f(p0, p1, p2, p3, p4, p5, p6, p7, xs...)
+	}
+}
+
+// Curry4 returns a Func4VariadicResult[R, V, P4, P5, P6, P7] with the first 4 arguments of the Func8VariadicResult bound.
+func (f Func8VariadicResult[R, V, P0, P1, P2, P3, P4, P5, P6, P7]) Curry4(p0 P0, p1 P1, p2 P2, p3 P3) Func4VariadicResult[R, V, P4, P5, P6, P7] {
+	return func(p4 P4, p5 P5, p6 P6, p7 P7, xs ...V) (R, error) {
+		return f(p0, p1, p2, p3, p4, p5, p6, p7, xs...)
+	}
+}
+
+// Curry3 returns a Func5VariadicResult[R, V, P3, P4, P5, P6, P7] with the first 3 arguments of the Func8VariadicResult bound.
+func (f Func8VariadicResult[R, V, P0, P1, P2, P3, P4, P5, P6, P7]) Curry3(p0 P0, p1 P1, p2 P2) Func5VariadicResult[R, V, P3, P4, P5, P6, P7] {
+	return func(p3 P3, p4 P4, p5 P5, p6 P6, p7 P7, xs ...V) (R, error) {
+		return f(p0, p1, p2, p3, p4, p5, p6, p7, xs...)
+	}
+}
+
+// Curry2 returns a Func6VariadicResult[R, V, P2, P3, P4, P5, P6, P7] with the first 2 arguments of the Func8VariadicResult bound.
+func (f Func8VariadicResult[R, V, P0, P1, P2, P3, P4, P5, P6, P7]) Curry2(p0 P0, p1 P1) Func6VariadicResult[R, V, P2, P3, P4, P5, P6, P7] {
+	return func(p2 P2, p3 P3, p4 P4, p5 P5, p6 P6, p7 P7, xs ...V) (R, error) {
+		return f(p0, p1, p2, p3, p4, p5, p6, p7, xs...)
+	}
+}
+
+// Curry1 returns a Func7VariadicResult[R, V, P1, P2, P3, P4, P5, P6, P7] with the first argument of the Func8VariadicResult bound.
+func (f Func8VariadicResult[R, V, P0, P1, P2, P3, P4, P5, P6, P7]) Curry1(p0 P0) Func7VariadicResult[R, V, P1, P2, P3, P4, P5, P6, P7] {
+	return func(p1 P1, p2 P2, p3 P3, p4 P4, p5 P5, p6 P6, p7 P7, xs ...V) (R, error) {
+		return f(p0, p1, p2, p3, p4, p5, p6, p7, xs...)
+	}
+}
@@ -0,0 +1,181 @@
+// Code generated by powerfunc/generator from ctx_func_pair.go.tmpl. DO NOT EDIT.
+
+package powerfunc
+
+import (
+	"context"
+	"fmt"
+	"time"
+)
+
+type CtxFunc9Pair[R1, R2, P0, P1, P2, P3, P4, P5, P6, P7, P8 any] func(ctx context.Context, p0 P0, p1 P1, p2 P2, p3 P3, p4 P4, p5 P5, p6 P6, p7 P7, p8 P8) (R1, R2)
+
+func (f CtxFunc9Pair[R1, R2, P0, P1, P2, P3, P4, P5, P6, P7, P8]) Exec(ctx context.Context, p0 P0, p1 P1, p2 P2, p3 P3, p4 P4, p5 P5, p6 P6, p7 P7, p8 P8) (R1, R2) {
+	return f(ctx, p0, p1, p2, p3, p4, p5, p6, p7, p8)
+}
+
+func (f CtxFunc9Pair[R1, R2, P0, P1, P2, P3, P4, P5, P6, P7, P8]) Timing(loggers ...func(d time.Duration)) CtxFunc9Pair[R1, R2, P0, P1, P2, P3, P4, P5, P6, P7, P8] {
+	return f.TimingWithClock(RealClock(), loggers...)
+}
+
+// TimingWithClock is like Timing, but measures the execution time with clock.
+func (f CtxFunc9Pair[R1, R2, P0, P1, P2, P3, P4, P5, P6, P7, P8]) TimingWithClock(clock Clock, loggers ...func(d time.Duration)) CtxFunc9Pair[R1, R2, P0, P1, P2, P3, P4, P5, P6, P7, P8] {
+	return func(ctx context.Context, p0 P0, p1 P1, p2 P2, p3 P3, p4 P4, p5 P5, p6 P6, p7 P7, p8 P8) (R1, R2) {
+		start := clock.Now()
+		defer func() {
+			dur := clock.Since(start)
+			for _, logger := range loggers {
+				logger(dur)
+			}
+			if len(loggers) == 0 {
+				// Default logger
+				fmt.Println(dur)
+			}
+		}()
+		return f(ctx, p0, p1, p2, p3, p4, p5, p6, p7, p8)
+	}
+}
+
+func (f CtxFunc9Pair[R1, R2, P0, P1, P2, P3, P4, P5, P6, P7, P8]) Fallible() CtxFunc9PairResult[R1, R2, P0, P1, P2, P3, P4, P5, P6, P7, P8] {
+	return func(ctx context.Context, p0 P0, p1 P1, p2 P2, p3 P3, p4 P4, p5 P5, p6 P6, p7 P7, p8 P8) (R1, R2, error) {
+		v1, v2 := f(ctx, p0, p1, p2, p3, p4, p5, p6, p7, p8)
+		return v1, v2, nil
+	}
+}
+
+func (f CtxFunc9Pair[R1, R2, P0, P1, P2, P3, P4, P5, P6, P7, P8]) WithTimeout(timeout time.Duration) CtxFunc9Pair[R1, R2, P0, P1, P2, P3, P4, P5, P6, P7, P8] {
+	return func(ctx context.Context, p0 P0, p1 P1, p2 P2, p3 P3, p4 P4, p5 P5, p6 P6, p7 P7, p8 P8) (R1, R2) {
+		ctx, cancel := context.WithTimeout(ctx, timeout)
+		defer cancel()
+		return f(ctx, p0, p1, p2, p3, p4, p5, p6, p7, p8)
+	}
+}
+
+func (f CtxFunc9Pair[R1, R2, P0, P1, P2, P3, P4, P5, P6, P7, P8]) WithDeadline(deadline time.Time) CtxFunc9Pair[R1, R2, P0, P1, P2, P3, P4, P5, P6, P7, P8] {
+	return func(ctx context.Context, p0 P0, p1 P1, p2 P2, p3 P3, p4 P4, p5 P5, p6 P6, p7 P7, p8 P8) (R1, R2) {
+		ctx, cancel := context.WithDeadline(ctx, deadline)
+		defer cancel()
+		return f(ctx, p0, p1, p2, p3, p4, p5, p6, p7, p8)
+	}
+}
+
+func (f CtxFunc9Pair[R1, R2, P0, P1, P2, P3, P4, P5, P6, P7, P8]) WithCancel() CtxFunc9Pair[R1, R2, P0, P1, P2, P3, P4, P5, P6, P7, P8] {
+	return func(ctx context.Context, p0 P0, p1 P1, p2 P2, p3 P3, p4 P4, p5 P5, p6 P6, p7 P7, p8 P8) (R1, R2) {
+		ctx, cancel := context.WithCancel(ctx)
+		defer cancel()
+		return f(ctx, p0, p1, p2, p3, p4, p5, p6, p7, p8)
+	}
+}
+
+// WithValue returns a CtxFunc9Pair calling the CtxFunc9Pair with a context
+// carrying val for key, see context.WithValue.
+func (f CtxFunc9Pair[R1, R2, P0, P1, P2, P3, P4, P5, P6, P7, P8]) WithValue(key, val any) CtxFunc9Pair[R1, R2, P0, P1, P2, P3, P4, P5, P6, P7, P8] {
+	return func(ctx context.Context, p0 P0, p1 P1, p2 P2, p3 P3, p4 P4, p5 P5, p6 P6, p7 P7, p8 P8) (R1, R2) {
+		ctx = context.WithValue(ctx, key, val)
+		return f(ctx, p0, p1, p2, p3, p4, p5, p6, p7, p8)
+	}
+}
+
+// WithValueFrom returns a CtxFunc9Pair calling the CtxFunc9Pair with a context
+// carrying the value returned by value for its key. value gets the arguments
+// of every call.
+func (f CtxFunc9Pair[R1, R2, P0, P1, P2, P3, P4, P5, P6, P7, P8]) WithValueFrom(value func(ctx context.Context, p0 P0, p1 P1, p2 P2, p3 P3, p4 P4, p5 P5, p6 P6, p7 P7, p8 P8) (key, val any)) CtxFunc9Pair[R1, R2, P0, P1, P2, P3, P4, P5, P6, P7, P8] {
+	return func(ctx context.Context, p0 P0, p1 P1, p2 P2, p3 P3, p4 P4, p5 P5, p6 P6, p7 P7, p8 P8) (R1, R2) {
+		key, val := value(ctx, p0, p1, p2, p3, p4, p5, p6, p7, p8)
+		ctx = context.WithValue(ctx, key, val)
+		return f(ctx, p0, p1, p2, p3, p4, p5, p6, p7, p8)
+	}
+}
+
+// Detached returns a CtxFunc9Pair calling the CtxFunc9Pair with a context that is
+// never cancelled but keeps the values of the caller's context, see
+// context.WithoutCancel. Useful for cleanups that must run to completion.
+func (f CtxFunc9Pair[R1, R2, P0, P1, P2, P3, P4, P5, P6, P7, P8]) Detached() CtxFunc9Pair[R1, R2, P0, P1, P2, P3, P4, P5, P6, P7, P8] {
+	return func(ctx context.Context, p0 P0, p1 P1, p2 P2, p3 P3, p4 P4, p5 P5, p6 P6, p7 P7, p8 P8) (R1, R2) {
+		ctx = context.WithoutCancel(ctx)
+		return f(ctx, p0, p1, p2, p3, p4, p5, p6, p7, p8)
+	}
+}
+
+// WithTimeoutCause is like WithTimeout, but the context is cancelled with
+// cause when the timeout expires, see context.WithTimeoutCause.
+func (f CtxFunc9Pair[R1, R2, P0, P1, P2, P3, P4, P5, P6, P7, P8]) WithTimeoutCause(timeout time.Duration, cause error) CtxFunc9Pair[R1, R2, P0, P1, P2, P3, P4, P5, P6, P7, P8] {
+	return func(ctx context.Context, p0 P0, p1 P1, p2 P2, p3 P3, p4 P4, p5 P5, p6 P6, p7 P7, p8 P8) (R1, R2) {
+		ctx, cancel := context.WithTimeoutCause(ctx, timeout, cause)
+		defer cancel()
+		return f(ctx, p0, p1, p2, p3, p4, p5, p6, p7, p8)
+	}
+}
+
+// WithDeadlineCause is like WithDeadline, but the context is cancelled with
+// cause when the deadline expires, see context.WithDeadlineCause.
+func (f CtxFunc9Pair[R1, R2, P0, P1, P2, P3, P4, P5, P6, P7, P8]) WithDeadlineCause(deadline time.Time, cause error) CtxFunc9Pair[R1, R2, P0, P1, P2, P3, P4, P5, P6, P7, P8] {
+	return func(ctx context.Context, p0 P0, p1 P1, p2 P2, p3 P3, p4 P4, p5 P5, p6 P6, p7 P7, p8 P8) (R1, R2) {
+		ctx, cancel := context.WithDeadlineCause(ctx, deadline, cause)
+		defer cancel()
+		return f(ctx, p0, p1, p2, p3, p4, p5, p6, p7, p8)
+	}
+}
+
+// Curry9 returns a CtxFuncPair[R1, R2] with the first 9 arguments of the CtxFunc9Pair bound.
+func (f CtxFunc9Pair[R1, R2, P0, P1, P2, P3, P4, P5, P6, P7, P8]) Curry9(p0 P0, p1 P1, p2 P2, p3 P3, p4 P4, p5 P5, p6 P6, p7 P7, p8 P8) CtxFuncPair[R1, R2] {
+	return func(ctx context.Context) (R1, R2) {
+		return f(ctx, p0, p1, p2, p3, p4, p5, p6, p7, p8)
+	}
+}
+
+// Curry8 returns a CtxFunc1Pair[R1, R2, P8] with the first 8 arguments of the CtxFunc9Pair bound.
+func (f CtxFunc9Pair[R1, R2, P0, P1, P2, P3, P4, P5, P6, P7, P8]) Curry8(p0 P0, p1 P1, p2 P2, p3 P3, p4 P4, p5 P5, p6 P6, p7 P7) CtxFunc1Pair[R1, R2, P8] {
+	return func(ctx context.Context, p8 P8) (R1, R2) {
+		return f(ctx, p0, p1, p2, p3, p4, p5, p6, p7, p8)
+	}
+}
+
+// Curry7 returns a CtxFunc2Pair[R1, R2, P7, P8] with the first 7 arguments of the CtxFunc9Pair bound.
+func (f CtxFunc9Pair[R1, R2, P0, P1, P2, P3, P4, P5, P6, P7, P8]) Curry7(p0 P0, p1 P1, p2 P2, p3 P3, p4 P4, p5 P5, p6 P6) CtxFunc2Pair[R1, R2, P7, P8] {
+	return func(ctx context.Context, p7 P7, p8 P8) (R1, R2) {
+		return f(ctx, p0, p1, p2, p3, p4, p5, p6, p7, p8)
+	}
+}
+
+// Curry6 returns a CtxFunc3Pair[R1, R2, P6, P7, P8] with the first 6 arguments of the CtxFunc9Pair bound.
+func (f CtxFunc9Pair[R1, R2, P0, P1, P2, P3, P4, P5, P6, P7, P8]) Curry6(p0 P0, p1 P1, p2 P2, p3 P3, p4 P4, p5 P5) CtxFunc3Pair[R1, R2, P6, P7, P8] {
+	return func(ctx context.Context, p6 P6, p7 P7, p8 P8) (R1, R2) {
+		return f(ctx, p0, p1, p2, p3, p4, p5, p6, p7, p8)
+	}
+}
+
+// Curry5 returns a CtxFunc4Pair[R1, R2, P5, P6, P7, P8] with the first 5 arguments of the CtxFunc9Pair bound.
+func (f CtxFunc9Pair[R1, R2, P0, P1, P2, P3, P4, P5, P6, P7, P8]) Curry5(p0 P0, p1 P1, p2 P2, p3 P3, p4 P4) CtxFunc4Pair[R1, R2, P5, P6, P7, P8] {
+	return func(ctx context.Context, p5 P5, p6 P6, p7 P7, p8 P8) (R1, R2) {
+		return f(ctx, p0, p1, p2, p3, p4, p5, p6, p7, p8)
+	}
+}
+
+// Curry4 returns a CtxFunc5Pair[R1, R2, P4, P5, P6, P7, P8] with the first 4 arguments of the CtxFunc9Pair bound.
+func (f CtxFunc9Pair[R1, R2, P0, P1, P2, P3, P4, P5, P6, P7, P8]) Curry4(p0 P0, p1 P1, p2 P2, p3 P3) CtxFunc5Pair[R1, R2, P4, P5, P6, P7, P8] {
+	return func(ctx context.Context, p4 P4, p5 P5, p6 P6, p7 P7, p8 P8) (R1, R2) {
+		return f(ctx, p0, p1, p2, p3, p4, p5, p6, p7, p8)
+	}
+}
+
+// Curry3 returns a CtxFunc6Pair[R1, R2, P3, P4, P5, P6, P7, P8] with the first 3 arguments of the CtxFunc9Pair bound.
+func (f CtxFunc9Pair[R1, R2, P0, P1, P2, P3, P4, P5, P6, P7, P8]) Curry3(p0 P0, p1 P1, p2 P2) CtxFunc6Pair[R1, R2, P3, P4, P5, P6, P7, P8] {
+	return func(ctx context.Context, p3 P3, p4 P4, p5 P5, p6 P6, p7 P7, p8 P8) (R1, R2) {
+		return f(ctx, p0, p1, p2, p3, p4, p5, p6, p7, p8)
+	}
+}
+
+// Curry2 returns a CtxFunc7Pair[R1, R2, P2, P3, P4, P5, P6, P7, P8] with the first 2 arguments of the CtxFunc9Pair bound.
+func (f CtxFunc9Pair[R1, R2, P0, P1, P2, P3, P4, P5, P6, P7, P8]) Curry2(p0 P0, p1 P1) CtxFunc7Pair[R1, R2, P2, P3, P4, P5, P6, P7, P8] {
+	return func(ctx context.Context, p2 P2, p3 P3, p4 P4, p5 P5, p6 P6, p7 P7, p8 P8) (R1, R2) {
+		return f(ctx, p0, p1, p2, p3, p4, p5, p6, p7, p8)
+	}
+}
+
+// Curry1 returns a CtxFunc8Pair[R1, R2, P1, P2, P3, P4, P5, P6, P7, P8] with the first argument of the CtxFunc9Pair bound.
+func (f CtxFunc9Pair[R1, R2, P0, P1, P2, P3, P4, P5, P6, P7, P8]) Curry1(p0 P0) CtxFunc8Pair[R1, R2, P1, P2, P3, P4, P5, P6, P7, P8] {
+	return func(ctx context.Context, p1 P1, p2 P2, p3 P3, p4 P4, p5 P5, p6 P6, p7 P7, p8 P8) (R1, R2) {
+		return f(ctx, p0, p1, p2, p3, p4, p5, p6, p7, p8)
+	}
+}
@@ -0,0 +1,284 @@
+// Code generated by powerfunc/generator from ctx_func_pair_result.go.tmpl. DO NOT EDIT.
+
+package powerfunc
+
+import (
+	"context"
+	"fmt"
+	"time"
+)
+
+type CtxFunc9PairResult[R1, R2, P0, P1, P2, P3, P4, P5, P6, P7, P8 any] func(ctx context.Context, p0 P0, p1 P1, p2 P2, p3 P3, p4 P4, p5 P5, p6 P6, p7 P7, p8 P8) (R1, R2, error)
+
+func (f CtxFunc9PairResult[R1, R2, P0, P1, P2, P3, P4, P5, P6, P7, P8]) Exec(ctx context.Context, p0 P0, p1 P1, p2 P2, p3 P3, p4 P4, p5 P5, p6 P6, p7 P7, p8 P8) (R1, R2, error) {
+	return f(ctx, p0, p1, p2, p3, p4, p5, p6, p7, p8)
+}
+
+func (f CtxFunc9PairResult[R1, R2, P0, P1, P2, P3, P4, P5, P6, P7, P8]) Timing(loggers ...func(d time.Duration)) CtxFunc9PairResult[R1, R2, P0, P1, P2, P3, P4, P5, P6, P7, P8] {
+	return f.TimingWithClock(RealClock(), loggers...)
+}
+
+// TimingWithClock is like Timing, but measures the execution time with clock.
+func (f CtxFunc9PairResult[R1, R2, P0, P1, P2, P3, P4, P5, P6, P7, P8]) TimingWithClock(clock Clock, loggers ...func(d time.Duration)) CtxFunc9PairResult[R1, R2, P0, P1, P2, P3, P4, P5, P6, P7, P8] {
+	return func(ctx context.Context, p0 P0, p1 P1, p2 P2, p3 P3, p4 P4, p5 P5, p6 P6, p7 P7, p8 P8) (R1, R2, error) {
+		start := clock.Now()
+		defer func() {
+			dur := clock.Since(start)
+			for _, logger := range loggers {
+				logger(dur)
+			}
+			if len(loggers) == 0 {
+				// Default logger
+				fmt.Println(dur)
+			}
+		}()
+		return f(ctx, p0, p1, p2, p3, p4, p5, p6, p7, p8)
+	}
+}
+
+func (f CtxFunc9PairResult[R1, R2, P0, P1, P2, P3, P4, P5, P6, P7, P8]) WithTimeout(timeout time.Duration) CtxFunc9PairResult[R1, R2, P0, P1, P2, P3, P4, P5, P6, P7, P8] {
+	return func(ctx context.Context, p0 P0, p1 P1, p2 P2, p3 P3, p4 P4, p5 P5, p6 P6, p7 P7, p8 P8) (R1, R2, error) {
+		ctx, cancel := context.WithTimeout(ctx, timeout)
+		defer cancel()
+		return f(ctx, p0, p1, p2, p3, p4, p5, p6, p7, p8)
+	}
+}
+
+func (f CtxFunc9PairResult[R1, R2, P0, P1, P2, P3, P4, P5, P6, P7, P8]) WithDeadline(deadline time.Time) CtxFunc9PairResult[R1, R2, P0, P1, P2, P3, P4, P5, P6, P7, P8] {
+	return func(ctx context.Context, p0 P0, p1 P1, p2 P2, p3 P3, p4 P4, p5 P5, p6 P6, p7 P7, p8 P8) (R1, R2, error) {
+		ctx, cancel := context.WithDeadline(ctx, deadline)
+		defer cancel()
+		return f(ctx, p0, p1, p2, p3, p4, p5, p6, p7, p8)
+	}
+}
+
+func (f CtxFunc9PairResult[R1, R2, P0, P1, P2, P3, P4, P5, P6, P7, P8]) WithCancel() CtxFunc9PairResult[R1, R2, P0, P1, P2, P3, P4, P5, P6, P7, P8] {
+	return func(ctx context.Context, p0 P0, p1 P1, p2 P2, p3 P3, p4 P4, p5 P5, p6 P6, p7 P7, p8 P8) (R1, R2, error) {
+		ctx, cancel := context.WithCancel(ctx)
+		defer cancel()
+		return f(ctx, p0, p1, p2, p3, p4, p5, p6, p7, p8)
+	}
+}
+
+// WithValue returns a CtxFunc9PairResult calling the CtxFunc9PairResult with a context
+// carrying val for key, see context.WithValue.
+func (f CtxFunc9PairResult[R1, R2, P0, P1, P2, P3, P4, P5, P6, P7, P8]) WithValue(key, val any) CtxFunc9PairResult[R1, R2, P0, P1, P2, P3, P4, P5, P6, P7, P8] {
+	return func(ctx context.Context, p0 P0, p1 P1, p2 P2, p3 P3, p4 P4, p5 P5, p6 P6, p7 P7, p8 P8) (R1, R2, error) {
+		ctx = context.WithValue(ctx, key, val)
+		return f(ctx, p0, p1, p2, p3, p4, p5, p6, p7, p8)
+	}
+}
+
+// WithValueFrom returns a CtxFunc9PairResult calling the CtxFunc9PairResult with a context
+// carrying the value returned by value for its key. value gets the arguments
+// of every call.
+func (f CtxFunc9PairResult[R1, R2, P0, P1, P2, P3, P4, P5, P6, P7, P8]) WithValueFrom(value func(ctx context.Context, p0 P0, p1 P1, p2 P2, p3 P3, p4 P4, p5 P5, p6 P6, p7 P7, p8 P8) (key, val any)) CtxFunc9PairResult[R1, R2, P0, P1, P2, P3, P4, P5, P6, P7, P8] {
+	return func(ctx context.Context, p0 P0, p1 P1, p2 P2, p3 P3, p4 P4, p5 P5, p6 P6, p7 P7, p8 P8) (R1, R2, error) {
+		key, val := value(ctx, p0, p1, p2, p3, p4, p5, p6, p7, p8)
+		ctx = context.WithValue(ctx, key, val)
+		return f(ctx, p0, p1, p2, p3, p4, p5, p6, p7, p8)
+	}
+}
+
+// Detached returns a CtxFunc9PairResult calling the CtxFunc9PairResult with a context that is
+// never cancelled but keeps the values of the caller's context, see
+// context.WithoutCancel. Useful for cleanups that must run to completion.
+func (f CtxFunc9PairResult[R1, R2, P0, P1, P2, P3, P4, P5, P6, P7, P8]) Detached() CtxFunc9PairResult[R1, R2, P0, P1, P2, P3, P4, P5, P6, P7, P8] {
+	return func(ctx context.Context, p0 P0, p1 P1, p2 P2, p3 P3, p4 P4, p5 P5, p6 P6, p7 P7, p8 P8) (R1, R2, error) {
+		ctx = context.WithoutCancel(ctx)
+		return f(ctx, p0, p1, p2, p3, p4, p5, p6, p7, p8)
+	}
+}
+
+// WithTimeoutCause is like WithTimeout, but the context is cancelled with
+// cause when the timeout expires, see context.WithTimeoutCause.
+// When the CtxFunc9PairResult then fails with the error of the context, the returned
+// error wraps cause as well, to tell which timeout expired.
+func (f CtxFunc9PairResult[R1, R2, P0, P1, P2, P3, P4, P5, P6, P7, P8]) WithTimeoutCause(timeout time.Duration, cause error) CtxFunc9PairResult[R1, R2, P0, P1, P2, P3, P4, P5, P6, P7, P8] {
+	return func(ctx context.Context, p0 P0, p1 P1, p2 P2, p3 P3, p4 P4, p5 P5, p6 P6, p7 P7, p8 P8) (R1, R2, error) {
+		ctx, cancel := context.WithTimeoutCause(ctx, timeout, cause)
+		defer cancel()
+		r0, r1, err := f(ctx, p0, p1, p2, p3, p4, p5, p6, p7, p8)
+		return r0, r1, withCause(ctx, err)
+	}
+}
+
+// WithDeadlineCause is like WithDeadline, but the context is cancelled with
+// cause when the deadline expires, see context.WithDeadlineCause.
+// When the CtxFunc9PairResult then fails with the error of the context, the returned
+// error wraps cause as well, to tell which deadline expired.
+func (f CtxFunc9PairResult[R1, R2, P0, P1, P2, P3, P4, P5, P6, P7, P8]) WithDeadlineCause(deadline time.Time, cause error) CtxFunc9PairResult[R1, R2, P0, P1, P2, P3, P4, P5, P6, P7, P8] {
+	return func(ctx context.Context, p0 P0, p1 P1, p2 P2, p3 P3, p4 P4, p5 P5, p6 P6, p7 P7, p8 P8) (R1, R2, error) {
+		ctx, cancel := context.WithDeadlineCause(ctx, deadline, cause)
+		defer cancel()
+		r0, r1, err := f(ctx, p0, p1, p2, p3, p4, p5, p6, p7, p8)
+		return r0, r1, withCause(ctx, err)
+	}
+}
+
+// WithBudget returns a CtxFunc9PairResult giving the CtxFunc9PairResult a fraction of the
+// remaining deadline of the caller, capped at max when max is positive,
+// instead of a fixed timeout. Without a deadline, the CtxFunc9PairResult gets max, or
+// no timeout if max is not positive.
+// When the share is below min, the CtxFunc9PairResult is not called and a
+// *BudgetError, matching ErrBudgetExhausted, is returned.
+func (f CtxFunc9PairResult[R1, R2, P0, P1, P2, P3, P4, P5, P6, P7, P8]) WithBudget(fraction float64, min, max time.Duration) CtxFunc9PairResult[R1, R2, P0, P1, P2, P3, P4, P5, P6, P7, P8] {
+	return func(ctx context.Context, p0 P0, p1 P1, p2 P2, p3 P3, p4 P4, p5 P5, p6 P6, p7 P7, p8 P8) (R1, R2, error) {
+		timeout, bounded, err := budget(ctx, fraction, min, max)
+		if err != nil {
+			var r0 R1
+			var r1 R2
+			return r0, r1, err
+		}
+		if bounded {
+			var cancel context.CancelFunc
+			ctx, cancel = context.WithTimeout(ctx, timeout)
+			defer cancel()
+		}
+		return f(ctx, p0, p1, p2, p3, p4, p5, p6, p7, p8)
+	}
+}
+
+// Retry returns a CtxFunc9PairResult that will retry the CtxFunc9PairResult until it returns
+// a nil error or the tryAgain function returns false, in which case it
+// returns a *RetryError holding the errors of all the attempts.
+// The options set the backoff between attempts and hooks, e.g. OnRetry.
+// RetryAttemptTimeout gives every attempt its own deadline within the one of
+// the caller.
+func (f CtxFunc9PairResult[R1, R2, P0, P1, P2, P3, P4, P5, P6, P7, P8]) Retry(tryAgain func(attempts int, err error) bool, opts ...RetryOption) CtxFunc9PairResult[R1, R2, P0, P1, P2, P3, P4, P5, P6, P7, P8] {
+	return func(ctx context.Context, p0 P0, p1 P1, p2 P2, p3 P3, p4 P4, p5 P5, p6 P6, p7 P7, p8 P8) (R1, R2, error) {
+		var r0 R1
+		var r1 R2
+		err := retry(ctx, tryAgain, opts, func(ctx context.Context) error {
+			var err error
+			r0, r1, err = f(ctx, p0, p1, p2, p3, p4, p5, p6, p7, p8)
+			return err
+		})
+		return r0, r1, err
+	}
+}
+
+// Must returns a CtxFunc9Pair that will panic if the CtxFunc9PairResult returns an error.
+func (f CtxFunc9PairResult[R1, R2, P0, P1, P2, P3, P4, P5, P6, P7, P8]) Must() CtxFunc9Pair[R1, R2, P0, P1, P2, P3, P4, P5, P6, P7, P8] {
+	return func(ctx context.Context, p0 P0, p1 P1, p2 P2, p3 P3, p4 P4, p5 P5, p6 P6, p7 P7, p8 P8) (R1, R2) {
+		v1, v2, err := f(ctx, p0, p1, p2, p3, p4, p5, p6, p7, p8)
+		if err != nil {
+			panic(err)
+		}
+		return v1, v2
+	}
+}
+
+// OnErr returns a CtxFunc9PairResult that will wrap the error returned by the
+// CtxFunc9PairResult with the provided message.
+func (f CtxFunc9PairResult[R1, R2, P0, P1, P2, P3, P4, P5, P6, P7, P8]) OnErr(msg string) CtxFunc9PairResult[R1, R2, P0, P1, P2, P3, P4, P5, P6, P7, P8] {
+	return func(ctx context.Context, p0 P0, p1 P1, p2 P2, p3 P3, p4 P4, p5 P5, p6 P6, p7 P7, p8 P8) (R1, R2, error) {
+		v1, v2, err := f(ctx, p0, p1, p2, p3, p4, p5, p6, p7, p8)
+		if err != nil {
+			return v1, v2, fmt.Errorf("%s: %w", msg, err)
+		}
+		return v1, v2, nil
+	}
+}
+
+// MapErr applies the provided function to the error returned by the
+// CtxFunc9PairResult, if there is an error.
+func (f CtxFunc9PairResult[R1, R2, P0, P1, P2, P3, P4, P5, P6, P7, P8]) MapErr(fn func(error) error) CtxFunc9PairResult[R1, R2, P0, P1, P2, P3, P4, P5, P6, P7, P8] {
+	return func(ctx context.Context, p0 P0, p1 P1, p2 P2, p3 P3, p4 P4, p5 P5, p6 P6, p7 P7, p8 P8) (R1, R2, error) {
+		v1, v2, err := f(ctx, p0, p1, p2, p3, p4, p5, p6, p7, p8)
+		if err != nil {
+			return v1, v2, fn(err)
+		}
+		return v1, v2, nil
+	}
+}
+
+// Fallback returns a CtxFunc9Pair that will return the provided values if the
+// CtxFunc9PairResult returns an error.
+func (f CtxFunc9PairResult[R1, R2, P0, P1, P2, P3, P4, P5, P6, P7, P8]) Fallback(val1 R1, val2 R2) CtxFunc9Pair[R1, R2, P0, P1, P2, P3, P4, P5, P6, P7, P8] {
+	return func(ctx context.Context, p0 P0, p1 P1, p2 P2, p3 P3, p4 P4, p5 P5, p6 P6, p7 P7, p8 P8) (R1, R2) {
+		v1, v2, err := f(ctx, p0, p1, p2, p3, p4, p5, p6, p7, p8)
+		if err != nil {
+			return val1, val2
+		}
+		return v1, v2
+	}
+}
+
+// InjectFaults returns a CtxFunc9PairResult injecting the errors, panics and latency
+// configured by cfg in its calls, to test how the callers cope with them.
+// See FaultConfig, and DisableFaults for the global kill-switch.
+func (f CtxFunc9PairResult[R1, R2, P0, P1, P2, P3, P4, P5, P6, P7, P8]) InjectFaults(cfg FaultConfig) CtxFunc9PairResult[R1, R2, P0, P1, P2, P3, P4, P5, P6, P7, P8] {
+	fi := newFaultInjector(cfg)
+	return func(ctx context.Context, p0 P0, p1 P1, p2 P2, p3 P3, p4 P4, p5 P5, p6 P6, p7 P7, p8 P8) (R1, R2, error) {
+		if err := fi.inject(ctx, p0, p1, p2, p3, p4, p5, p6, p7, p8); err != nil {
+			var r0 R1
+			var r1 R2
+			return r0, r1, err
+		}
+		return f(ctx, p0, p1, p2, p3, p4, p5, p6, p7, p8)
+	}
+}
+
+// Curry9 returns a CtxFuncPairResult[R1, R2] with the first 9 arguments of the CtxFunc9PairResult bound.
+func (f CtxFunc9PairResult[R1, R2, P0, P1, P2, P3, P4, P5, P6, P7, P8]) Curry9(p0 P0, p1 P1, p2 P2, p3 P3, p4 P4, p5 P5, p6 P6, p7 P7, p8 P8) CtxFuncPairResult[R1, R2] {
+	return func(ctx context.Context) (R1, R2, error) {
+		return f(ctx, p0, p1, p2, p3, p4, p5, p6, p7, p8)
+	}
+}
+
+// Curry8 returns a CtxFunc1PairResult[R1, R2, P8] with the first 8 arguments of the CtxFunc9PairResult bound.
+func (f CtxFunc9PairResult[R1, R2, P0, P1, P2, P3, P4, P5, P6, P7, P8]) Curry8(p0 P0, p1 P1, p2 P2, p3 P3, p4 P4, p5 P5, p6 P6, p7 P7) CtxFunc1PairResult[R1, R2, P8] {
+	return func(ctx context.Context, p8 P8) (R1, R2, error) {
+		return f(ctx, p0, p1, p2, p3, p4, p5, p6, p7, p8)
+	}
+}
+
+// Curry7 returns a CtxFunc2PairResult[R1, R2, P7, P8] with the first 7 arguments of the CtxFunc9PairResult bound.
+func (f CtxFunc9PairResult[R1, R2, P0, P1, P2, P3, P4, P5, P6, P7, P8]) Curry7(p0 P0, p1 P1, p2 P2, p3 P3, p4 P4, p5 P5, p6 P6) CtxFunc2PairResult[R1, R2, P7, P8] {
+	return func(ctx context.Context, p7 P7, p8 P8) (R1, R2, error) {
+		return f(ctx, p0, p1, p2, p3, p4, p5, p6, p7, p8)
+	}
+}
+
+// Curry6 returns a CtxFunc3PairResult[R1, R2, P6, P7, P8] with the first 6 arguments of the CtxFunc9PairResult bound.
+func (f CtxFunc9PairResult[R1, R2, P0, P1, P2, P3, P4, P5, P6, P7, P8]) Curry6(p0 P0, p1 P1, p2 P2, p3 P3, p4 P4, p5 P5) CtxFunc3PairResult[R1, R2, P6, P7, P8] {
+	return func(ctx context.Context, p6 P6, p7 P7, p8 P8) (R1, R2, error) {
+		return f(ctx, p0, p1, p2, p3, p4, p5, p6, p7, p8)
+	}
+}
+
+// Curry5 returns a CtxFunc4PairResult[R1, R2, P5, P6, P7, P8] with the first 5 arguments of the CtxFunc9PairResult bound.
+func (f CtxFunc9PairResult[R1, R2, P0, P1, P2, P3, P4, P5, P6, P7, P8]) Curry5(p0 P0, p1 P1, p2 P2, p3 P3, p4 P4) CtxFunc4PairResult[R1, R2, P5, P6, P7, P8] {
+	return func(ctx context.Context, p5 P5, p6 P6, p7 P7, p8 P8) (R1, R2, error) {
+		return f(ctx, p0, p1, p2, p3, p4, p5, p6, p7, p8)
+	}
+}
+
+// Curry4 returns a CtxFunc5PairResult[R1, R2, P4, P5, P6, P7, P8] with the first 4 arguments of the CtxFunc9PairResult bound.
+func (f CtxFunc9PairResult[R1, R2, P0, P1, P2, P3, P4, P5, P6, P7, P8]) Curry4(p0 P0, p1 P1, p2 P2, p3 P3) CtxFunc5PairResult[R1, R2, P4, P5, P6, P7, P8] {
+	return func(ctx context.Context, p4 P4, p5 P5, p6 P6, p7 P7, p8 P8) (R1, R2, error) {
+		return f(ctx, p0, p1, p2, p3, p4, p5, p6, p7, p8)
+	}
+}
+
+// Curry3 returns a CtxFunc6PairResult[R1, R2, P3, P4, P5, P6, P7, P8] with the first 3 arguments of the CtxFunc9PairResult bound.
+func (f CtxFunc9PairResult[R1, R2, P0, P1, P2, P3, P4, P5, P6, P7, P8]) Curry3(p0 P0, p1 P1, p2 P2) CtxFunc6PairResult[R1, R2, P3, P4, P5, P6, P7, P8] {
+	return func(ctx context.Context, p3 P3, p4 P4, p5 P5, p6 P6, p7 P7, p8 P8) (R1, R2, error) {
+		return f(ctx, p0, p1, p2, p3, p4, p5, p6, p7, p8)
+	}
+}
+
+// Curry2 returns a CtxFunc7PairResult[R1, R2, P2, P3, P4, P5, P6, P7, P8] with the first 2 arguments of the CtxFunc9PairResult bound.
+func (f CtxFunc9PairResult[R1, R2, P0, P1, P2, P3, P4, P5, P6, P7, P8]) Curry2(p0 P0, p1 P1) CtxFunc7PairResult[R1, R2, P2, P3, P4, P5, P6, P7, P8] {
+	return func(ctx context.Context, p2 P2, p3 P3, p4 P4, p5 P5, p6 P6, p7 P7, p8 P8) (R1, R2, error) {
+		return f(ctx, p0, p1, p2, p3, p4, p5, p6, p7, p8)
+	}
+}
+
+// Curry1 returns a CtxFunc8PairResult[R1, R2, P1, P2, P3, P4, P5, P6, P7, P8] with the first argument of the CtxFunc9PairResult bound.
+func (f CtxFunc9PairResult[R1, R2, P0, P1, P2, P3, P4, P5, P6, P7, P8]) Curry1(p0 P0) CtxFunc8PairResult[R1, R2, P1, P2, P3, P4, P5, P6, P7, P8] {
+	return func(ctx context.Context, p1 P1, p2 P2, p3 P3, p4 P4, p5 P5, p6 P6, p7 P7, p8 P8) (R1, R2, error) {
+		return f(ctx, p0, p1, p2, p3, p4, p5, p6, p7, p8)
+	}
+}
@@ -0,0 +1,256 @@
+// Code generated by powerfunc/generator from ctx_func_variadic_result.go.tmpl. DO NOT EDIT.
+
+package powerfunc
+
+import (
+	"context"
+	"fmt"
+	"time"
+)
+
+type CtxFunc9VariadicResult[R, V, P0, P1, P2, P3, P4, P5, P6, P7, P8 any] func(ctx context.Context, p0 P0, p1 P1, p2 P2, p3 P3, p4 P4, p5 P5, p6 P6, p7 P7, p8 P8, xs ...V) (R, error)
+
+func (f CtxFunc9VariadicResult[R, V, P0, P1, P2, P3, P4, P5, P6, P7, P8]) Exec(ctx context.Context, p0 P0, p1 P1, p2 P2, p3 P3, p4 P4, p5 P5, p6 P6, p7 P7, p8 P8, xs ...V) (R, error) {
+	return f(ctx, p0, p1, p2, p3, p4, p5, p6, p7, p8, xs...)
+}
+
+func (f CtxFunc9VariadicResult[R, V, P0, P1, P2, P3, P4, P5, P6, P7, P8]) Timing(loggers ...func(d time.Duration)) CtxFunc9VariadicResult[R, V, P0, P1, P2, P3, P4, P5, P6, P7, P8] {
+	return f.TimingWithClock(RealClock(), loggers...)
+}
+
+// TimingWithClock is like Timing, but measures the execution time with clock.
+func (f CtxFunc9VariadicResult[R, V, P0, P1, P2, P3, P4, P5, P6, P7, P8]) TimingWithClock(clock Clock, loggers ...func(d time.Duration)) CtxFunc9VariadicResult[R, V, P0, P1, P2, P3, P4, P5, P6, P7, P8] {
+	return func(ctx context.Context, p0 P0, p1 P1, p2 P2, p3 P3, p4 P4, p5 P5, p6 P6, p7 P7, p8 P8, xs ...V) (R, error) {
+		start := clock.Now()
+		defer func() {
+			dur := clock.Since(start)
+			for _, logger := range loggers {
+				logger(dur)
+			}
+			if len(loggers) == 0 {
+				// Default logger
+				fmt.Println(dur)
+			}
+		}()
+		return f(ctx, p0, p1, p2, p3, p4, p5, p6, p7, p8, xs...)
+	}
+}
+
+func (f CtxFunc9VariadicResult[R, V, P0, P1, P2, P3, P4, P5, P6, P7, P8]) WithTimeout(timeout time.Duration) CtxFunc9VariadicResult[R, V, P0, P1, P2, P3, P4, P5, P6, P7, P8] {
+	return func(ctx context.Context, p0 P0, p1 P1, p2 P2, p3 P3, p4 P4, p5 P5, p6 P6, p7 P7, p8 P8, xs ...V) (R, error) {
+		ctx, cancel := context.WithTimeout(ctx, timeout)
+		defer cancel()
+		return f(ctx, p0, p1, p2, p3, p4, p5, p6, p7, p8, xs...)
+	}
+}
+
+func (f CtxFunc9VariadicResult[R, V, P0, P1, P2, P3, P4, P5, P6, P7, P8]) WithDeadline(deadline time.Time) CtxFunc9VariadicResult[R, V, P0, P1, P2, P3, P4, P5, P6, P7, P8] {
+	return func(ctx context.Context, p0 P0, p1 P1, p2 P2, p3 P3, p4 P4, p5 P5, p6 P6, p7 P7, p8 P8, xs ...V) (R, error) {
+		ctx, cancel := context.WithDeadline(ctx, deadline)
+		defer cancel()
+		return f(ctx, p0, p1, p2, p3, p4, p5, p6, p7, p8, xs...)
+	}
+}
+
+func (f CtxFunc9VariadicResult[R, V, P0, P1, P2, P3, P4, P5, P6, P7, P8]) WithCancel() CtxFunc9VariadicResult[R, V, P0, P1, P2, P3, P4, P5, P6, P7, P8] {
+	return func(ctx context.Context, p0 P0, p1 P1, p2 P2, p3 P3, p4 P4, p5 P5, p6 P6, p7 P7, p8 P8, xs ...V) (R, error) {
+		ctx, cancel := context.WithCancel(ctx)
+		defer cancel()
+		return f(ctx, p0, p1, p2, p3, p4, p5, p6, p7, p8, xs...)
+	}
+}
+
+// WithValue returns a CtxFunc9VariadicResult calling the CtxFunc9VariadicResult with a context
+// carrying val for key, see context.WithValue.
+func (f CtxFunc9VariadicResult[R, V, P0, P1, P2, P3, P4, P5, P6, P7, P8]) WithValue(key, val any) CtxFunc9VariadicResult[R, V, P0, P1, P2, P3, P4, P5, P6, P7, P8] {
+	return func(ctx context.Context, p0 P0, p1 P1, p2 P2, p3 P3, p4 P4, p5 P5, p6 P6, p7 P7, p8 P8, xs ...V) (R, error) {
+		ctx = context.WithValue(ctx, key, val)
+		return f(ctx, p0, p1, p2, p3, p4, p5, p6, p7, p8, xs...)
+	}
+}
+
+// WithValueFrom returns a CtxFunc9VariadicResult calling the CtxFunc9VariadicResult with a context
+// carrying the value returned by value for its key. value gets the arguments
+// of every call.
+func (f CtxFunc9VariadicResult[R, V, P0, P1, P2, P3, P4, P5, P6, P7, P8]) WithValueFrom(value func(ctx context.Context, p0 P0, p1 P1, p2 P2, p3 P3, p4 P4, p5 P5, p6 P6, p7 P7, p8 P8, xs ...V) (key, val any)) CtxFunc9VariadicResult[R, V, P0, P1, P2, P3, P4, P5, P6, P7, P8] {
+	return func(ctx context.Context, p0 P0, p1 P1, p2 P2, p3 P3, p4 P4, p5 P5, p6 P6, p7 P7, p8 P8, xs ...V) (R, error) {
+		key, val := value(ctx, p0, p1, p2, p3, p4, p5, p6, p7, p8, xs...)
+		ctx = context.WithValue(ctx, key, val)
+		return f(ctx, p0, p1, p2, p3, p4, p5, p6, p7, p8, xs...)
+	}
+}
+
+// Detached returns a CtxFunc9VariadicResult calling the CtxFunc9VariadicResult with a context that is
+// never cancelled but keeps the values of the caller's context, see
+// context.WithoutCancel. Useful for cleanups that must run to completion.
+func (f CtxFunc9VariadicResult[R, V, P0, P1, P2, P3, P4, P5, P6, P7, P8]) Detached() CtxFunc9VariadicResult[R, V, P0, P1, P2, P3, P4, P5, P6, P7, P8] {
+	return func(ctx context.Context, p0 P0, p1 P1, p2 P2, p3 P3, p4 P4, p5 P5, p6 P6, p7 P7, p8 P8, xs ...V) (R, error) {
+		ctx = context.WithoutCancel(ctx)
+		return f(ctx, p0, p1, p2, p3, p4, p5, p6, p7, p8, xs...)
+	}
+}
+
+// WithTimeoutCause is like WithTimeout, but the context is cancelled with
+// cause when the timeout expires, see context.WithTimeoutCause.
+// When the CtxFunc9VariadicResult then fails with the error of the context, the returned
+// error wraps cause as well, to tell which timeout expired.
+func (f CtxFunc9VariadicResult[R, V, P0, P1, P2, P3, P4, P5, P6, P7, P8]) WithTimeoutCause(timeout time.Duration, cause error) CtxFunc9VariadicResult[R, V, P0, P1, P2, P3, P4, P5, P6, P7, P8] {
+	return func(ctx context.Context, p0 P0, p1 P1, p2 P2, p3 P3, p4 P4, p5 P5, p6 P6, p7 P7, p8 P8, xs ...V) (R, error) {
+		ctx, cancel := context.WithTimeoutCause(ctx, timeout, cause)
+		defer cancel()
+		r0, err := f(ctx, p0, p1, p2, p3, p4, p5, p6, p7, p8, xs...)
+		return r0, withCause(ctx, err)
+	}
+}
+
+// WithDeadlineCause is like WithDeadline, but the context is cancelled with
+// cause when the deadline expires, see context.WithDeadlineCause.
+// When the CtxFunc9VariadicResult then fails with the error of the context, the returned
+// error wraps cause as well, to tell which deadline expired.
+func (f CtxFunc9VariadicResult[R, V, P0, P1, P2, P3, P4, P5, P6, P7, P8]) WithDeadlineCause(deadline time.Time, cause error) CtxFunc9VariadicResult[R, V, P0, P1, P2, P3, P4, P5, P6, P7, P8] {
+	return func(ctx context.Context, p0 P0, p1 P1, p2 P2, p3 P3, p4 P4, p5 P5, p6 P6, p7 P7, p8 P8, xs ...V) (R, error) {
+		ctx, cancel := context.WithDeadlineCause(ctx, deadline, cause)
+		defer cancel()
+		r0, err := f(ctx, p0, p1, p2, p3, p4, p5, p6, p7, p8, xs...)
+		return r0, withCause(ctx, err)
+	}
+}
+
+// WithBudget returns a CtxFunc9VariadicResult giving the CtxFunc9VariadicResult a fraction of the
+// remaining deadline of the caller, capped at max when max is positive,
+// instead of a fixed timeout. Without a deadline, the CtxFunc9VariadicResult gets max, or
+// no timeout if max is not positive.
+// When the share is below min, the CtxFunc9VariadicResult is not called and a
+// *BudgetError, matching ErrBudgetExhausted, is returned.
+func (f CtxFunc9VariadicResult[R, V, P0, P1, P2, P3, P4, P5, P6, P7, P8]) WithBudget(fraction float64, min, max time.Duration) CtxFunc9VariadicResult[R, V, P0, P1, P2, P3, P4, P5, P6, P7, P8] {
+	return func(ctx context.Context, p0 P0, p1 P1, p2 P2, p3 P3, p4 P4, p5 P5, p6 P6, p7 P7, p8 P8, xs ...V) (R, error) {
+		timeout, bounded, err := budget(ctx, fraction, min, max)
+		if err != nil {
+			var r0 R
+			return r0, err
+		}
+		if bounded {
+			var cancel context.CancelFunc
+			ctx, cancel = context.WithTimeout(ctx, timeout)
+			defer cancel()
+		}
+		return f(ctx, p0, p1, p2, p3, p4, p5, p6, p7, p8, xs...)
+	}
+}
+
+// Retry returns a CtxFunc9VariadicResult that will retry the CtxFunc9VariadicResult until it returns
+// a nil error or the tryAgain function returns false, in which case it
+// returns a *RetryError holding the errors of all the attempts.
+// The options set the backoff between attempts and hooks, e.g. OnRetry.
+// RetryAttemptTimeout gives every attempt its own deadline within the one of
+// the caller.
+func (f CtxFunc9VariadicResult[R, V, P0, P1, P2, P3, P4, P5, P6, P7, P8]) Retry(tryAgain func(attempts int, err error) bool, opts ...RetryOption) CtxFunc9VariadicResult[R, V, P0, P1, P2, P3, P4, P5, P6, P7, P8] {
+	return func(ctx context.Context, p0 P0, p1 P1, p2 P2, p3 P3, p4 P4, p5 P5, p6 P6, p7 P7, p8 P8, xs ...V) (R, error) {
+		var r0 R
+		err := retry(ctx, tryAgain, opts, func(ctx context.Context) error {
+			var err error
+			r0, err = f(ctx, p0, p1, p2, p3, p4, p5, p6, p7, p8, xs...)
+			return err
+		})
+		return r0, err
+	}
+}
+
+// OnErr returns a CtxFunc9VariadicResult that will wrap the error returned by
+// the CtxFunc9VariadicResult with the provided message.
+func (f CtxFunc9VariadicResult[R, V, P0, P1, P2, P3, P4, P5, P6, P7, P8]) OnErr(msg string) CtxFunc9VariadicResult[R, V, P0, P1, P2, P3, P4, P5, P6, P7, P8] {
+	return func(ctx context.Context, p0 P0, p1 P1, p2 P2, p3 P3, p4 P4, p5 P5, p6 P6, p7 P7, p8 P8, xs ...V) (R, error) {
+		v, err := f(ctx, p0, p1, p2, p3, p4, p5, p6, p7, p8, xs...)
+		if err != nil {
+			return v, fmt.Errorf("%s: %w", msg, err)
+		}
+		return v, nil
+	}
+}
+
+// CurryVariadic returns a CtxFunc9VariadicResult with some of its variadic
+// arguments bound. The bound arguments come before the ones passed to the
+// returned function.
+func (f CtxFunc9VariadicResult[R, V, P0, P1, P2, P3, P4, P5, P6, P7, P8]) CurryVariadic(bound ...V) CtxFunc9VariadicResult[R, V, P0, P1, P2, P3, P4, P5, P6, P7, P8] {
+	return func(ctx context.Context, p0 P0, p1 P1, p2 P2, p3 P3, p4 P4, p5 P5, p6 P6, p7 P7, p8 P8, xs ...V) (R, error) {
+		xs = append(append(make([]V, 0, len(bound)+len(xs)), bound...), xs...)
+		return f(ctx, p0, p1, p2, p3, p4, p5, p6, p7, p8, xs...)
+	}
+}
+
+// InjectFaults returns a CtxFunc9VariadicResult injecting the errors, panics and latency
+// configured by cfg in its calls, to test how the callers cope with them.
+// See FaultConfig, and DisableFaults for the global kill-switch.
+func (f CtxFunc9VariadicResult[R, V, P0, P1, P2, P3, P4, P5, P6, P7, P8]) InjectFaults(cfg FaultConfig) CtxFunc9VariadicResult[R, V, P0, P1, P2, P3, P4, P5, P6, P7, P8] {
+	fi := newFaultInjector(cfg)
+	return func(ctx context.Context, p0 P0, p1 P1, p2 P2, p3 P3, p4 P4, p5 P5, p6 P6, p7 P7, p8 P8, xs ...V) (R, error) {
+		if err := fi.inject(ctx, p0, p1, p2, p3, p4, p5, p6, p7, p8, xs); err != nil {
+			var r0 R
+			return r0, err
+		}
+		return f(ctx, p0, p1, p2, p3, p4, p5, p6, p7, p8, xs...)
+	}
+}
+
+// Curry9 returns a CtxFuncVariadicResult[R, V] with the first 9 arguments of the CtxFunc9VariadicResult bound.
+func (f CtxFunc9VariadicResult[R, V, P0, P1, P2, P3, P4, P5, P6, P7, P8]) Curry9(p0 P0, p1 P1, p2 P2, p3 P3, p4 P4, p5 P5, p6 P6, p7 P7, p8 P8) CtxFuncVariadicResult[R, V] {
+	return func(ctx context.Context, xs ...V) (R, error) {
+		return f(ctx, p0, p1, p2, p3, p4, p5, p6, p7, p8, xs...)
+	}
+}
+
+// Curry8 returns a CtxFunc1VariadicResult[R, V, P8] with the first 8 arguments of the CtxFunc9VariadicResult bound.
+func (f CtxFunc9VariadicResult[R, V, P0, P1, P2, P3, P4, P5, P6, P7, P8]) Curry8(p0 P0, p1 P1, p2 P2, p3 P3, p4 P4, p5 P5, p6 P6, p7 P7) CtxFunc1VariadicResult[R, V, P8] {
+	return func(ctx context.Context, p8 P8, xs ...V) (R, error) {
+		return f(ctx, p0, p1, p2, p3, p4, p5, p6, p7, p8, xs...)
+	}
+}
+
+// Curry7 returns a CtxFunc2VariadicResult[R, V, P7, P8] with the first 7 arguments of the CtxFunc9VariadicResult bound.
+func (f CtxFunc9VariadicResult[R, V, P0, P1, P2, P3, P4, P5, P6, P7, P8]) Curry7(p0 P0, p1 P1, p2 P2, p3 P3, p4 P4, p5 P5, p6 P6) CtxFunc2VariadicResult[R, V, P7, P8] {
+	return func(ctx context.Context, p7 P7, p8 P8, xs ...V) (R, error) {
+		return f(ctx, p0, p1, p2, p3, p4, p5, p6, p7, p8, xs...)
+	}
+}
+
+// Curry6 returns a CtxFunc3VariadicResult[R, V, P6, P7, P8] with the first 6 arguments of the CtxFunc9VariadicResult bound.
+func (f CtxFunc9VariadicResult[R, V, P0, P1, P2, P3, P4, P5, P6, P7, P8]) Curry6(p0 P0, p1 P1, p2 P2, p3 P3, p4 P4, p5 P5) CtxFunc3VariadicResult[R, V, P6, P7, P8] {
+	return func(ctx context.Context, p6 P6, p7 P7, p8 P8, xs ...V) (R, error) {
+		return f(ctx, p0, p1, p2, p3, p4, p5, p6, p7, p8, xs...)
+	}
+}
+
+// Curry5 returns a CtxFunc4VariadicResult[R, V, P5, P6, P7, P8] with the first 5 arguments of the CtxFunc9VariadicResult bound.
+func (f CtxFunc9VariadicResult[R, V, P0, P1, P2, P3, P4, P5, P6, P7, P8]) Curry5(p0 P0, p1 P1, p2 P2, p3 P3, p4 P4) CtxFunc4VariadicResult[R, V, P5, P6, P7, P8] {
+	return func(ctx context.Context, p5 P5, p6 P6, p7 P7, p8 P8, xs ...V) (R, error) {
+		return f(ctx, p0, p1, p2, p3, p4, p5, p6, p7, p8, xs...)
+	}
+}
+
+// Curry4 returns a CtxFunc5VariadicResult[R, V, P4, P5, P6, P7, P8] with the first 4 arguments of the CtxFunc9VariadicResult bound.
+func (f CtxFunc9VariadicResult[R, V, P0, P1, P2, P3, P4, P5, P6, P7, P8]) Curry4(p0 P0, p1 P1, p2 P2, p3 P3) CtxFunc5VariadicResult[R, V, P4, P5, P6, P7, P8] {
+	return func(ctx context.Context, p4 P4, p5 P5, p6 P6, p7 P7, p8 P8, xs ...V) (R, error) {
+		return f(ctx, p0, p1, p2, p3, p4, p5, p6, p7, p8, xs...)
+	}
+}
+
+// Curry3 returns a CtxFunc6VariadicResult[R, V, P3, P4, P5, P6, P7, P8] with the first 3 arguments of the CtxFunc9VariadicResult bound.
+func (f CtxFunc9VariadicResult[R, V, P0, P1, P2, P3, P4, P5, P6, P7, P8]) Curry3(p0 P0, p1 P1, p2 P2) CtxFunc6VariadicResult[R, V, P3, P4, P5, P6, P7, P8] {
+	return func(ctx context.Context, p3 P3, p4 P4, p5 P5, p6 P6, p7 P7, p8 P8, xs ...V) (R, error) {
+		return f(ctx, p0, p1, p2, p3, p4, p5, p6, p7, p8, xs...)
+	}
+}
+
+// Curry2 returns a CtxFunc7VariadicResult[R, V, P2, P3, P4, P5, P6, P7, P8] with the first 2 arguments of the CtxFunc9VariadicResult bound.
+func (f CtxFunc9VariadicResult[R, V, P0, P1, P2, P3, P4, P5, P6, P7, P8]) Curry2(p0 P0, p1 P1) CtxFunc7VariadicResult[R, V, P2, P3, P4, P5, P6, P7, P8] {
+	return func(ctx context.Context, p2 P2, p3 P3, p4 P4, p5 P5, p6 P6, p7 P7, p8 P8, xs ...V) (R, error) {
+		return f(ctx, p0, p1, p2, p3, p4, p5, p6, p7, p8, xs...)
+	}
+}
+
+// Curry1 returns a CtxFunc8VariadicResult[R, V, P1, P2, P3, P4, P5, P6, P7, P8] with the first argument of the CtxFunc9VariadicResult bound.
+func (f CtxFunc9VariadicResult[R, V, P0, P1, P2, P3, P4, P5, P6, P7, P8]) Curry1(p0 P0) CtxFunc8VariadicResult[R, V, P1, P2, P3, P4, P5, P6, P7, P8] {
+	return func(ctx context.Context, p1 P1, p2 P2, p3 P3, p4 P4, p5 P5, p6 P6, p7 P7, p8 P8, xs ...V) (R, error) {
+		return f(ctx, p0, p1, p2, p3, p4, p5, p6, p7, p8, xs...)
+	}
+}
@@ -1,13 +1,14 @@
-//go:generate go run ./generator -arity 10
+// Code generated by powerfunc/generator from func.go.tmpl. DO NOT EDIT.
 
 package powerfunc
 
 import (
+	"context"
 	"fmt"
 	"time"
 )
 
-// Func9 is a function that takes 0 arguments and returns no values.
+// Func9 is a function that takes 9 arguments and returns no values.
 type Func9[P0, P1, P2, P3, P4, P5, P6, P7, P8 any] func(p0 P0, p1 P1, p2 P2, p3 P3, p4 P4, p5 P5, p6 P6, p7 P7, p8 P8)
 
 // Exec executes the Function.
@@ -20,10 +21,15 @@ func (f Func9[P0, P1, P2, P3, P4, P5, P6, P7, P8]) Exec(p0 P0, p1 P1, p2 P2, p3
 // Timing returns a Func9 that will log the execution time of the Func9.
 // If no loggers are provided, the default logger (fmt.Println) will be used.
 func (f Func9[P0, P1, P2, P3, P4, P5, P6, P7, P8]) Timing(loggers ...func(d time.Duration)) Func9[P0, P1, P2, P3, P4, P5, P6, P7, P8] {
+	return f.TimingWithClock(RealClock(), loggers...)
+}
+
+// TimingWithClock is like Timing, but measures the execution time with clock.
+func (f Func9[P0, P1, P2, P3, P4, P5, P6, P7, P8]) TimingWithClock(clock Clock, loggers ...func(d time.Duration)) Func9[P0, P1, P2, P3, P4, P5, P6, P7, P8] {
 	return func(p0 P0, p1 P1, p2 P2, p3 P3, p4 P4, p5 P5, p6 P6, p7 P7, p8 P8) {
-		start := time.Now()
+		start := clock.Now()
 		defer func() {
-			dur := time.Since(start)
+			dur := clock.Since(start)
 			for _, logger := range loggers {
 				logger(dur)
 			}
@@ -46,66 +52,117 @@ func (f Func9[P0, P1, P2, P3, P4, P5, P6, P7, P8]) Fallible() Func9Error[P0, P1,
 	}
 }
 
+// Debounce returns a Func9 that delays its execution until wait has elapsed
+// since the last call, along with the Debouncer controlling it.
+// Only the most recent call is kept and eventually invoked.
+func (f Func9[P0, P1, P2, P3, P4, P5, P6, P7, P8]) Debounce(wait time.Duration, opts ...DebounceOption) (Func9[P0, P1, P2, P3, P4, P5, P6, P7, P8], *Debouncer) {
+	d := newDebouncer(wait, opts...)
+	return func(p0 P0, p1 P1, p2 P2, p3 P3, p4 P4, p5 P5, p6 P6, p7 P7, p8 P8) {
+		d.call(func() {
+			f(p0, p1, p2, p3, p4, p5, p6, p7, p8)
+		})
+	}, d
+}
+
+// Throttle returns a Func9 that executes at most once per interval, along
+// with the Debouncer controlling it.
+// The first call is invoked immediately, and the most recent call made
+// during the interval is invoked at the end of it.
+// The options, e.g. DebounceClock, apply to the underlying Debouncer.
+func (f Func9[P0, P1, P2, P3, P4, P5, P6, P7, P8]) Throttle(interval time.Duration, opts ...DebounceOption) (Func9[P0, P1, P2, P3, P4, P5, P6, P7, P8], *Debouncer) {
+	d := newThrottler(interval, opts...)
+	return func(p0 P0, p1 P1, p2 P2, p3 P3, p4 P4, p5 P5, p6 P6, p7 P7, p8 P8) {
+		d.call(func() {
+			f(p0, p1, p2, p3, p4, p5, p6, p7, p8)
+		})
+	}, d
+}
+
+// Once returns a Func9 that only executes the first time it is called,
+// along with the Once controlling it.
+func (f Func9[P0, P1, P2, P3, P4, P5, P6, P7, P8]) Once(opts ...OnceOption) (Func9[P0, P1, P2, P3, P4, P5, P6, P7, P8], *Once) {
+	o := newOnce(opts...)
+	return func(p0 P0, p1 P1, p2 P2, p3 P3, p4 P4, p5 P5, p6 P6, p7 P7, p8 P8) {
+		_, _ = o.do(context.Background(), false, func() (any, error) {
+			f(p0, p1, p2, p3, p4, p5, p6, p7, p8)
+			return nil, nil
+		})
+	}, o
+}
+
+// Lazy returns a Func9 that executes the first time it is called,
+// along with the Once controlling it.
+// A Func9 cannot fail, so Lazy behaves like Once unless it panics, in which
+// case the next call executes it again.
+func (f Func9[P0, P1, P2, P3, P4, P5, P6, P7, P8]) Lazy(opts ...OnceOption) (Func9[P0, P1, P2, P3, P4, P5, P6, P7, P8], *Once) {
+	o := newLazy(opts...)
+	return func(p0 P0, p1 P1, p2 P2, p3 P3, p4 P4, p5 P5, p6 P6, p7 P7, p8 P8) {
+		_, _ = o.do(context.Background(), false, func() (any, error) {
+			f(p0, p1, p2, p3, p4, p5, p6, p7, p8)
+			return nil, nil
+		})
+	}, o
+}
 
+// Curry9 returns a Func with the first 9 arguments of the Func9 bound.
 func (f Func9[P0, P1, P2, P3, P4, P5, P6, P7, P8]) Curry9(p0 P0, p1 P1, p2 P2, p3 P3, p4 P4, p5 P5, p6 P6, p7 P7, p8 P8) Func {
-	return func()  {
+	return func() {
 		f(p0, p1, p2, p3, p4, p5, p6, p7, p8)
 	}
 }
-	
 
+// Curry8 returns a Func1[P8] with the first 8 arguments of the Func9 bound.
 func (f Func9[P0, P1, P2, P3, P4, P5, P6, P7, P8]) Curry8(p0 P0, p1 P1, p2 P2, p3 P3, p4 P4, p5 P5, p6 P6, p7 P7) Func1[P8] {
-	return func(p8 P8)  {
+	return func(p8 P8) {
 		f(p0, p1, p2, p3, p4, p5, p6, p7, p8)
 	}
 }
-	
 
+// Curry7 returns a Func2[P7, P8] with the first 7 arguments of the Func9 bound.
 func (f Func9[P0, P1, P2, P3, P4, P5, P6, P7, P8]) Curry7(p0 P0, p1 P1, p2 P2, p3 P3, p4 P4, p5 P5, p6 P6) Func2[P7, P8] {
-	return func(p7 P7, p8 P8)  {
+	return func(p7 P7, p8 P8) {
 		f(p0, p1, p2, p3, p4, p5, p6, p7, p8)
 	}
 }
-	
 
+// Curry6 returns a Func3[P6, P7, P8] with the first 6 arguments of the Func9 bound.
 func (f Func9[P0, P1, P2, P3, P4, P5, P6, P7, P8]) Curry6(p0 P0, p1 P1, p2 P2, p3 P3, p4 P4, p5 P5) Func3[P6, P7, P8] {
-	return func(p6 P6, p7 P7, p8 P8)  {
+	return func(p6 P6, p7 P7, p8 P8) {
 		f(p0, p1, p2, p3, p4, p5, p6, p7, p8)
 	}
 }
-	
 
+// Curry5 returns a Func4[P5, P6, P7, P8] with the first 5 arguments of the Func9 bound.
 func (f Func9[P0, P1, P2, P3, P4, P5, P6, P7, P8]) Curry5(p0 P0, p1 P1, p2 P2, p3 P3, p4 P4) Func4[P5, P6, P7, P8] {
-	return func(p5 P5, p6 P6, p7 P7, p8 P8)  {
+	return func(p5 P5, p6 P6, p7 P7, p8 P8) {
 		f(p0, p1, p2, p3, p4, p5, p6, p7, p8)
 	}
 }
-	
 
+// Curry4 returns a Func5[P4, P5, P6, P7, P8] with the first 4 arguments of the Func9 bound.
 func (f Func9[P0, P1, P2, P3, P4, P5, P6, P7, P8]) Curry4(p0 P0, p1 P1, p2 P2, p3 P3) Func5[P4, P5, P6, P7, P8] {
-	return func(p4 P4, p5 P5, p6 P6, p7 P7, p8 P8)  {
+	return func(p4 P4, p5 P5, p6 P6, p7 P7, p8 P8) {
 		f(p0, p1, p2, p3, p4, p5, p6, p7, p8)
 	}
 }
-	
 
+// Curry3 returns a Func6[P3, P4, P5, P6, P7, P8] with the first 3 arguments of the Func9 bound.
 func (f Func9[P0, P1, P2, P3, P4, P5, P6, P7, P8]) Curry3(p0 P0, p1 P1, p2 P2) Func6[P3, P4, P5, P6, P7, P8] {
-	return func(p3 P3, p4 P4, p5 P5, p6 P6, p7 P7, p8 P8)  {
+	return func(p3 P3, p4 P4, p5 P5, p6 P6, p7 P7, p8 P8) {
 		f(p0, p1, p2, p3, p4, p5, p6, p7, p8)
 	}
 }
-	
 
+// Curry2 returns a Func7[P2, P3, P4, P5, P6, P7, P8] with the first 2 arguments of the Func9 bound.
 func (f Func9[P0, P1, P2, P3, P4, P5, P6, P7, P8]) Curry2(p0 P0, p1 P1) Func7[P2, P3, P4, P5, P6, P7, P8] {
-	return func(p2 P2, p3 P3, p4 P4, p5 P5, p6 P6, p7 P7, p8 P8)  {
+	return func(p2 P2, p3 P3, p4 P4, p5 P5, p6 P6, p7 P7, p8 P8) {
 		f(p0, p1, p2, p3, p4, p5, p6, p7, p8)
 	}
 }
-	
 
+// Curry1 returns a Func8[P1, P2, P3, P4, P5, P6, P7, P8] with the first argument of the Func9 bound.
 func (f Func9[P0, P1, P2, P3, P4, P5, P6, P7, P8]) Curry1(p0 P0) Func8[P1, P2, P3, P4, P5, P6, P7, P8] {
-	return func(p1 P1, p2 P2, p3 P3, p4 P4, p5 P5, p6 P6, p7 P7, p8 P8)  {
+	return func(p1 P1, p2 P2, p3 P3, p4 P4, p5 P5, p6 P6, p7 P7, p8 P8) {
 		f(p0, p1, p2, p3, p4, p5, p6, p7, p8)
 	}
 }
-	
\ No newline at end of file
@@ -1,11 +1,14 @@
+// Code generated by powerfunc/generator from func_error.go.tmpl. DO NOT EDIT.
+
 package powerfunc
 
 import (
+	"context"
 	"fmt"
 	"time"
 )
 
-// Func9Error is a function that takes 0 arguments and returns an error.
+// Func9Error is a function that takes 9 arguments and returns an error.
 type Func9Error[P0, P1, P2, P3, P4, P5, P6, P7, P8 any] func(p0 P0, p1 P1, p2 P2, p3 P3, p4 P4, p5 P5, p6 P6, p7 P7, p8 P8) error
 
 // Exec executes the function and returns the error.
@@ -15,13 +18,18 @@ func (f Func9Error[P0, P1, P2, P3, P4, P5, P6, P7, P8]) Exec(p0 P0, p1 P1, p2 P2
 	return f(p0, p1, p2, p3, p4, p5, p6, p7, p8)
 }
 
-// Timing returns a Func9 that will log the execution time of the Func9.
+// Timing returns a Func9Error that will log the execution time of the Func9Error.
 // If no loggers are provided, the default logger (fmt.Println) will be used.
 func (f Func9Error[P0, P1, P2, P3, P4, P5, P6, P7, P8]) Timing(loggers ...func(d time.Duration)) Func9Error[P0, P1, P2, P3, P4, P5, P6, P7, P8] {
+	return f.TimingWithClock(RealClock(), loggers...)
+}
+
+// TimingWithClock is like Timing, but measures the execution time with clock.
+func (f Func9Error[P0, P1, P2, P3, P4, P5, P6, P7, P8]) TimingWithClock(clock Clock, loggers ...func(d time.Duration)) Func9Error[P0, P1, P2, P3, P4, P5, P6, P7, P8] {
 	return func(p0 P0, p1 P1, p2 P2, p3 P3, p4 P4, p5 P5, p6 P6, p7 P7, p8 P8) error {
-		start := time.Now()
+		start := clock.Now()
 		defer func() {
-			dur := time.Since(start)
+			dur := clock.Since(start)
 			for _, logger := range loggers {
 				logger(dur)
 			}
@@ -34,20 +42,15 @@ func (f Func9Error[P0, P1, P2, P3, P4, P5, P6, P7, P8]) Timing(loggers ...func(d
 	}
 }
 
-// Retry returns a Function that will retry the Function until it returns
-// a nil error or the tryAgain function returns false.
-func (f Func9Error[P0, P1, P2, P3, P4, P5, P6, P7, P8]) Retry(tryAgain func(attempts int, err error) bool) Func9Error[P0, P1, P2, P3, P4, P5, P6, P7, P8] {
+// Retry returns a Func9Error that will retry the Func9Error until it returns
+// a nil error or the tryAgain function returns false, in which case it
+// returns a *RetryError holding the errors of all the attempts.
+// The options set the backoff between attempts and hooks, e.g. OnRetry.
+func (f Func9Error[P0, P1, P2, P3, P4, P5, P6, P7, P8]) Retry(tryAgain func(attempts int, err error) bool, opts ...RetryOption) Func9Error[P0, P1, P2, P3, P4, P5, P6, P7, P8] {
 	return func(p0 P0, p1 P1, p2 P2, p3 P3, p4 P4, p5 P5, p6 P6, p7 P7, p8 P8) error {
-		var err error
-		attempts := 1
-		for {
-			err = f(p0, p1, p2, p3, p4, p5, p6, p7, p8)
-			if err == nil || !tryAgain(attempts, err) {
-				break
-			}
-			attempts++
-		}
-		return err
+		return retryNoContext(tryAgain, opts, func() error {
+			return f(p0, p1, p2, p3, p4, p5, p6, p7, p8)
+		})
 	}
 }
 
@@ -72,66 +75,124 @@ func (f Func9Error[P0, P1, P2, P3, P4, P5, P6, P7, P8]) OnErr(msg string) Func9E
 	}
 }
 
+// Once returns a Func9Error that only executes the first time it is called
+// and returns the cached error afterwards, along with the Once controlling it.
+// Use OnceRetryOnError or OnceBackoff to only cache a success.
+func (f Func9Error[P0, P1, P2, P3, P4, P5, P6, P7, P8]) Once(opts ...OnceOption) (Func9Error[P0, P1, P2, P3, P4, P5, P6, P7, P8], *Once) {
+	o := newOnce(opts...)
+	return func(p0 P0, p1 P1, p2 P2, p3 P3, p4 P4, p5 P5, p6 P6, p7 P7, p8 P8) error {
+		_, err := o.do(context.Background(), false, func() (any, error) {
+			return nil, f(p0, p1, p2, p3, p4, p5, p6, p7, p8)
+		})
+		return err
+	}, o
+}
+
+// Lazy returns a Func9Error that executes until it succeeds once, along
+// with the Once controlling it.
+// Failures are not cached, unless a backoff is provided with OnceBackoff.
+func (f Func9Error[P0, P1, P2, P3, P4, P5, P6, P7, P8]) Lazy(opts ...OnceOption) (Func9Error[P0, P1, P2, P3, P4, P5, P6, P7, P8], *Once) {
+	o := newLazy(opts...)
+	return func(p0 P0, p1 P1, p2 P2, p3 P3, p4 P4, p5 P5, p6 P6, p7 P7, p8 P8) error {
+		_, err := o.do(context.Background(), false, func() (any, error) {
+			return nil, f(p0, p1, p2, p3, p4, p5, p6, p7, p8)
+		})
+		return err
+	}, o
+}
+
+// InjectFaults returns a Func9Error injecting the errors, panics and latency
+// configured by cfg in its calls, to test how the callers cope with them.
+// See FaultConfig, and DisableFaults for the global kill-switch.
+// The faults cannot be enabled per request with OptIn, as there is no
+// context.
+func (f Func9Error[P0, P1, P2, P3, P4, P5, P6, P7, P8]) InjectFaults(cfg FaultConfig) Func9Error[P0, P1, P2, P3, P4, P5, P6, P7, P8] {
+	fi := newFaultInjector(cfg)
+	return func(p0 P0, p1 P1, p2 P2, p3 P3, p4 P4, p5 P5, p6 P6, p7 P7, p8 P8) error {
+		if err := fi.inject(context.Background(), p0, p1, p2, p3, p4, p5, p6, p7, p8); err != nil {
+			return err
+		}
+		return f(p0, p1, p2, p3, p4, p5, p6, p7, p8)
+	}
+}
+
+// WithTimeout returns a Func9Error that runs the Func9Error in its own
+// goroutine and returns a *TimeoutError, matching ErrTimeout, if it does not
+// return within timeout. The goroutine is then abandoned: it keeps running
+// until the Func9Error returns, and is counted by Abandoned.
+// Prefer the WithTimeout method of a function taking a context, which can
+// actually be cancelled.
+func (f Func9Error[P0, P1, P2, P3, P4, P5, P6, P7, P8]) WithTimeout(timeout time.Duration, opts ...TimeoutOption) Func9Error[P0, P1, P2, P3, P4, P5, P6, P7, P8] {
+	return func(p0 P0, p1 P1, p2 P2, p3 P3, p4 P4, p5 P5, p6 P6, p7 P7, p8 P8) error {
+		var err error
+		if terr := callWithTimeout(timeout, opts, func() {
+			err = f(p0, p1, p2, p3, p4, p5, p6, p7, p8)
+		}); terr != nil {
+			return terr
+		}
+		return err
+	}
+}
 
+// Curry9 returns a FuncError with the first 9 arguments of the Func9Error bound.
 func (f Func9Error[P0, P1, P2, P3, P4, P5, P6, P7, P8]) Curry9(p0 P0, p1 P1, p2 P2, p3 P3, p4 P4, p5 P5, p6 P6, p7 P7, p8 P8) FuncError {
 	return func() error {
 		return f(p0, p1, p2, p3, p4, p5, p6, p7, p8)
 	}
 }
-	
 
+// Curry8 returns a Func1Error[P8] with the first 8 arguments of the Func9Error bound.
 func (f Func9Error[P0, P1, P2, P3, P4, P5, P6, P7, P8]) Curry8(p0 P0, p1 P1, p2 P2, p3 P3, p4 P4, p5 P5, p6 P6, p7 P7) Func1Error[P8] {
 	return func(p8 P8) error {
 		return f(p0, p1, p2, p3, p4, p5, p6, p7, p8)
 	}
 }
-	
 
+// Curry7 returns a Func2Error[P7, P8] with the first 7 arguments of the Func9Error bound.
 func (f Func9Error[P0, P1, P2, P3, P4, P5, P6, P7, P8]) Curry7(p0 P0, p1 P1, p2 P2, p3 P3, p4 P4, p5 P5, p6 P6) Func2Error[P7, P8] {
 	return func(p7 P7, p8 P8) error {
 		return f(p0, p1, p2, p3, p4, p5, p6, p7, p8)
 	}
 }
-	
 
+// Curry6 returns a Func3Error[P6, P7, P8] with the first 6 arguments of the Func9Error bound.
 func (f Func9Error[P0, P1, P2, P3, P4, P5, P6, P7, P8]) Curry6(p0 P0, p1 P1, p2 P2, p3 P3, p4 P4, p5 P5) Func3Error[P6, P7, P8] {
 	return func(p6 P6, p7 P7, p8 P8) error {
 		return f(p0, p1, p2, p3, p4, p5, p6, p7, p8)
 	}
 }
-	
 
+// Curry5 returns a Func4Error[P5, P6, P7, P8] with the first 5 arguments of the Func9Error bound.
 func (f Func9Error[P0, P1, P2, P3, P4, P5, P6, P7, P8]) Curry5(p0 P0, p1 P1, p2 P2, p3 P3, p4 P4) Func4Error[P5, P6, P7, P8] {
 	return func(p5 P5, p6 P6, p7 P7, p8 P8) error {
 		return f(p0, p1, p2, p3, p4, p5, p6, p7, p8)
 	}
 }
-	
 
+// Curry4 returns a Func5Error[P4, P5, P6, P7, P8] with the first 4 arguments of the Func9Error bound.
 func (f Func9Error[P0, P1, P2, P3, P4, P5, P6, P7, P8]) Curry4(p0 P0, p1 P1, p2 P2, p3 P3) Func5Error[P4, P5, P6, P7, P8] {
 	return func(p4 P4, p5 P5, p6 P6, p7 P7, p8 P8) error {
 		return f(p0, p1, p2, p3, p4, p5, p6, p7, p8)
 	}
 }
-	
 
+// Curry3 returns a Func6Error[P3, P4, P5, P6, P7, P8] with the first 3 arguments of the Func9Error bound.
 func (f Func9Error[P0, P1, P2, P3, P4, P5, P6, P7, P8]) Curry3(p0 P0, p1 P1, p2 P2) Func6Error[P3, P4, P5, P6, P7, P8] {
 	return func(p3 P3, p4 P4, p5 P5, p6 P6, p7 P7, p8 P8) error {
 		return f(p0, p1, p2, p3, p4, p5, p6, p7, p8)
 	}
 }
-	
 
+// Curry2 returns a Func7Error[P2, P3, P4, P5, P6, P7, P8] with the first 2 arguments of the Func9Error bound.
 func (f Func9Error[P0, P1, P2, P3, P4, P5, P6, P7, P8]) Curry2(p0 P0, p1 P1) Func7Error[P2, P3, P4, P5, P6, P7, P8] {
 	return func(p2 P2, p3 P3, p4 P4, p5 P5, p6 P6, p7 P7, p8 P8) error {
 		return f(p0, p1, p2, p3, p4, p5, p6, p7, p8)
 	}
 }
-	
 
+// Curry1 returns a Func8Error[P1, P2, P3, P4, P5, P6, P7, P8] with the first argument of the Func9Error bound.
 func (f Func9Error[P0, P1, P2, P3, P4, P5, P6, P7, P8]) Curry1(p0 P0) Func8Error[P1, P2, P3, P4, P5, P6, P7, P8] {
 	return func(p1 P1, p2 P2, p3 P3, p4 P4, p5 P5, p6 P6, p7 P7, p8 P8) error {
 		return f(p0, p1, p2, p3, p4, p5, p6, p7, p8)
 	}
 }
-	
\ No newline at end of file
@@ -0,0 +1,204 @@
+// Code generated by powerfunc/generator from func_ok.go.tmpl. DO NOT EDIT.
+
+package powerfunc
+
+import (
+	"fmt"
+	"time"
+)
+
+// Func9Ok is a function that takes 9 arguments and returns a value and a
+// boolean reporting whether the value is valid, like a map lookup.
+type Func9Ok[R, P0, P1, P2, P3, P4, P5, P6, P7, P8 any] func(p0 P0, p1 P1, p2 P2, p3 P3, p4 P4, p5 P5, p6 P6, p7 P7, p8 P8) (R, bool)
+
+// Exec executes the function.
+// Convenience method to remove some of the confusion that
+// `f.SomeMethod()()` can bring.
+func (f Func9Ok[R, P0, P1, P2, P3, P4, P5, P6, P7, P8]) Exec(p0 P0, p1 P1, p2 P2, p3 P3, p4 P4, p5 P5, p6 P6, p7 P7, p8 P8) (R, bool) {
+	return f(p0, p1, p2, p3, p4, p5, p6, p7, p8)
+}
+
+// Timing returns a Func9Ok that will log the execution time of the Func9Ok.
+// If no loggers are provided, the default logger (fmt.Println) will be used.
+func (f Func9Ok[R, P0, P1, P2, P3, P4, P5, P6, P7, P8]) Timing(loggers ...func(d time.Duration)) Func9Ok[R, P0, P1, P2, P3, P4, P5, P6, P7, P8] {
+	return f.TimingWithClock(RealClock(), loggers...)
+}
+
+// TimingWithClock is like Timing, but measures the execution time with clock.
+func (f Func9Ok[R, P0, P1, P2, P3, P4, P5, P6, P7, P8]) TimingWithClock(clock Clock, loggers ...func(d time.Duration)) Func9Ok[R, P0, P1, P2, P3, P4, P5, P6, P7, P8] {
+	return func(p0 P0, p1 P1, p2 P2, p3 P3, p4 P4, p5 P5, p6 P6, p7 P7, p8 P8) (R, bool) {
+		start := clock.Now()
+		defer func() {
+			dur := clock.Since(start)
+			for _, logger := range loggers {
+				logger(dur)
+			}
+			if len(loggers) == 0 {
+				// Default logger
+				fmt.Println(dur)
+			}
+		}()
+		return f(p0, p1, p2, p3, p4, p5, p6, p7, p8)
+	}
+}
+
+// Retry returns a Func9Ok that will retry the Func9Ok until it returns
+// true or the tryAgain function returns false.
+// The attempts that returned false fail with a placeholder error, given to
+// tryAgain and to the hooks of the options, e.g. OnRetry, so that any
+// RetryPolicy can be used.
+func (f Func9Ok[R, P0, P1, P2, P3, P4, P5, P6, P7, P8]) Retry(tryAgain RetryPolicy, opts ...RetryOption) Func9Ok[R, P0, P1, P2, P3, P4, P5, P6, P7, P8] {
+	return func(p0 P0, p1 P1, p2 P2, p3 P3, p4 P4, p5 P5, p6 P6, p7 P7, p8 P8) (R, bool) {
+		var v R
+		var ok bool
+		err := retryNoContext(tryAgain, opts, func() error {
+			v, ok = f(p0, p1, p2, p3, p4, p5, p6, p7, p8)
+			if !ok {
+				return errNotOk
+			}
+			return nil
+		})
+		return v, err == nil
+	}
+}
+
+// Must returns a Func9Value that will panic if the Func9Ok returns false.
+func (f Func9Ok[R, P0, P1, P2, P3, P4, P5, P6, P7, P8]) Must() Func9Value[R, P0, P1, P2, P3, P4, P5, P6, P7, P8] {
+	return func(p0 P0, p1 P1, p2 P2, p3 P3, p4 P4, p5 P5, p6 P6, p7 P7, p8 P8) R {
+		v, ok := f(p0, p1, p2, p3, p4, p5, p6, p7, p8)
+		if !ok {
+			panic("powerfunc: Func9Ok returned false")
+		}
+		return v
+	}
+}
+
+// Fallback returns a Func9Value that will return the provided value if the
+// Func9Ok returns false.
+func (f Func9Ok[R, P0, P1, P2, P3, P4, P5, P6, P7, P8]) Fallback(val R) Func9Value[R, P0, P1, P2, P3, P4, P5, P6, P7, P8] {
+	return func(p0 P0, p1 P1, p2 P2, p3 P3, p4 P4, p5 P5, p6 P6, p7 P7, p8 P8) R {
+		v, ok := f(p0, p1, p2, p3, p4, p5, p6, p7, p8)
+		if !ok {
+			return val
+		}
+		return v
+	}
+}
+
+// OrElse returns a Func9Value that will return the provided value if the
+// Func9Ok returns false. It is an alias of Fallback.
+func (f Func9Ok[R, P0, P1, P2, P3, P4, P5, P6, P7, P8]) OrElse(val R) Func9Value[R, P0, P1, P2, P3, P4, P5, P6, P7, P8] {
+	return f.Fallback(val)
+}
+
+// OrElseGet returns a Func9Value that will call elsef if the Func9Ok
+// returns false.
+func (f Func9Ok[R, P0, P1, P2, P3, P4, P5, P6, P7, P8]) OrElseGet(elsef Func9Value[R, P0, P1, P2, P3, P4, P5, P6, P7, P8]) Func9Value[R, P0, P1, P2, P3, P4, P5, P6, P7, P8] {
+	return func(p0 P0, p1 P1, p2 P2, p3 P3, p4 P4, p5 P5, p6 P6, p7 P7, p8 P8) R {
+		v, ok := f(p0, p1, p2, p3, p4, p5, p6, p7, p8)
+		if !ok {
+			return elsef(p0, p1, p2, p3, p4, p5, p6, p7, p8)
+		}
+		return v
+	}
+}
+
+// ToResult transforms a Func9Ok into a Func9Result returning the provided
+// error when the Func9Ok returns false.
+func (f Func9Ok[R, P0, P1, P2, P3, P4, P5, P6, P7, P8]) ToResult(err error) Func9Result[R, P0, P1, P2, P3, P4, P5, P6, P7, P8] {
+	return func(p0 P0, p1 P1, p2 P2, p3 P3, p4 P4, p5 P5, p6 P6, p7 P7, p8 P8) (R, error) {
+		v, ok := f(p0, p1, p2, p3, p4, p5, p6, p7, p8)
+		if !ok {
+			return v, err
+		}
+		return v, nil
+	}
+}
+
+// Filter returns a Func9Ok that will return false if the value returned by
+// the Func9Ok does not satisfy keep.
+func (f Func9Ok[R, P0, P1, P2, P3, P4, P5, P6, P7, P8]) Filter(keep func(R) bool) Func9Ok[R, P0, P1, P2, P3, P4, P5, P6, P7, P8] {
+	return func(p0 P0, p1 P1, p2 P2, p3 P3, p4 P4, p5 P5, p6 P6, p7 P7, p8 P8) (R, bool) {
+		v, ok := f(p0, p1, p2, p3, p4, p5, p6, p7, p8)
+		if !ok || !keep(v) {
+			var zero R
+			return zero, false
+		}
+		return v, true
+	}
+}
+
+// Map applies the provided function to the value returned by the Func9Ok,
+// if it returns true.
+func (f Func9Ok[R, P0, P1, P2, P3, P4, P5, P6, P7, P8]) Map(fn func(R) R) Func9Ok[R, P0, P1, P2, P3, P4, P5, P6, P7, P8] {
+	return func(p0 P0, p1 P1, p2 P2, p3 P3, p4 P4, p5 P5, p6 P6, p7 P7, p8 P8) (R, bool) {
+		v, ok := f(p0, p1, p2, p3, p4, p5, p6, p7, p8)
+		if !ok {
+			return v, false
+		}
+		return fn(v), true
+	}
+}
+
+// Curry9 returns a FuncOk[R] with the first 9 arguments of the Func9Ok bound.
+func (f Func9Ok[R, P0, P1, P2, P3, P4, P5, P6, P7, P8]) Curry9(p0 P0, p1 P1, p2 P2, p3 P3, p4 P4, p5 P5, p6 P6, p7 P7, p8 P8) FuncOk[R] {
+	return func() (R, bool) {
+		return f(p0, p1, p2, p3, p4, p5, p6, p7, p8)
+	}
+}
+
+// Curry8 returns a Func1Ok[R, P8] with the first 8 arguments of the Func9Ok bound.
+func (f Func9Ok[R, P0, P1, P2, P3, P4, P5, P6, P7, P8]) Curry8(p0 P0, p1 P1, p2 P2, p3 P3, p4 P4, p5 P5, p6 P6, p7 P7) Func1Ok[R, P8] {
+	return func(p8 P8) (R, bool) {
+		return f(p0, p1, p2, p3, p4, p5, p6, p7, p8)
+	}
+}
+
+// Curry7 returns a Func2Ok[R, P7, P8] with the first 7 arguments of the Func9Ok bound.
+func (f Func9Ok[R, P0, P1, P2, P3, P4, P5, P6, P7, P8]) Curry7(p0 P0, p1 P1, p2 P2, p3 P3, p4 P4, p5 P5, p6 P6) Func2Ok[R, P7, P8] {
+	return func(p7 P7, p8 P8) (R, bool) {
+		return f(p0, p1, p2, p3, p4, p5, p6, p7, p8)
+	}
+}
+
+// Curry6 returns a Func3Ok[R, P6, P7, P8] with the first 6 arguments of the Func9Ok bound.
+func (f Func9Ok[R, P0, P1, P2, P3, P4, P5, P6, P7, P8]) Curry6(p0 P0, p1 P1, p2 P2, p3 P3, p4 P4, p5 P5) Func3Ok[R, P6, P7, P8] {
+	return func(p6 P6, p7 P7, p8 P8) (R, bool) {
+		return f(p0, p1, p2, p3, p4, p5, p6, p7, p8)
+	}
+}
+
+// Curry5 returns a Func4Ok[R, P5, P6, P7, P8] with the first 5 arguments of the Func9Ok bound.
+func (f Func9Ok[R, P0, P1, P2, P3, P4, P5, P6, P7, P8]) Curry5(p0 P0, p1 P1, p2 P2, p3 P3, p4 P4) Func4Ok[R, P5, P6, P7, P8] {
+	return func(p5 P5, p6 P6, p7 P7, p8 P8) (R, bool) {
+		return f(p0, p1, p2, p3, p4, p5, p6, p7, p8)
+	}
+}
+
+// Curry4 returns a Func5Ok[R, P4, P5, P6, P7, P8] with the first 4 arguments of the Func9Ok bound.
+func (f Func9Ok[R, P0, P1, P2, P3, P4, P5, P6, P7, P8]) Curry4(p0 P0, p1 P1, p2 P2, p3 P3) Func5Ok[R, P4, P5, P6, P7, P8] {
+	return func(p4 P4, p5 P5, p6 P6, p7 P7, p8 P8) (R, bool) {
+		return f(p0, p1, p2, p3, p4, p5, p6, p7, p8)
+	}
+}
+
+// Curry3 returns a Func6Ok[R, P3, P4, P5, P6, P7, P8] with the first 3 arguments of the Func9Ok bound.
+func (f Func9Ok[R, P0, P1, P2, P3, P4, P5, P6, P7, P8]) Curry3(p0 P0, p1 P1, p2 P2) Func6Ok[R, P3, P4, P5, P6, P7, P8] {
+	return func(p3 P3, p4 P4, p5 P5, p6 P6, p7 P7, p8 P8) (R, bool) {
+		return f(p0, p1, p2, p3, p4, p5, p6, p7, p8)
+	}
+}
+
+// Curry2 returns a Func7Ok[R, P2, P3, P4, P5, P6, P7, P8] with the first 2 arguments of the Func9Ok bound.
+func (f Func9Ok[R, P0, P1, P2, P3, P4, P5, P6, P7, P8]) Curry2(p0 P0, p1 P1) Func7Ok[R, P2, P3, P4, P5, P6, P7, P8] {
+	return func(p2 P2, p3 P3, p4 P4, p5 P5, p6 P6, p7 P7, p8 P8) (R, bool) {
+		return f(p0, p1, p2, p3, p4, p5, p6, p7, p8)
+	}
+}
+
+// Curry1 returns a Func8Ok[R, P1, P2, P3, P4, P5, P6, P7, P8] with the first argument of the Func9Ok bound.
+func (f Func9Ok[R, P0, P1, P2, P3, P4, P5, P6, P7, P8]) Curry1(p0 P0) Func8Ok[R, P1, P2, P3, P4, P5, P6, P7, P8] {
+	return func(p1 P1, p2 P2, p3 P3, p4 P4, p5 P5, p6 P6, p7 P7, p8 P8) (R, bool) {
+		return f(p0, p1, p2, p3, p4, p5, p6, p7, p8)
+	}
+}
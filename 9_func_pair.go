@@ -0,0 +1,115 @@
+// Code generated by powerfunc/generator from func_pair.go.tmpl. DO NOT EDIT.
+
+package powerfunc
+
+import (
+	"fmt"
+	"time"
+)
+
+// Func9Pair is a function that takes 9 arguments and returns two values.
+type Func9Pair[R1, R2, P0, P1, P2, P3, P4, P5, P6, P7, P8 any] func(p0 P0, p1 P1, p2 P2, p3 P3, p4 P4, p5 P5, p6 P6, p7 P7, p8 P8) (R1, R2)
+
+// Exec executes the function.
+// Convenience method to remove some of the confusion that
+// `f.SomeMethod()()` can bring.
+func (f Func9Pair[R1, R2, P0, P1, P2, P3, P4, P5, P6, P7, P8]) Exec(p0 P0, p1 P1, p2 P2, p3 P3, p4 P4, p5 P5, p6 P6, p7 P7, p8 P8) (R1, R2) {
+	return f(p0, p1, p2, p3, p4, p5, p6, p7, p8)
+}
+
+// Timing returns a Func9Pair that will log the execution time of the Func9Pair.
+// If no loggers are provided, the default logger (fmt.Println) will be used.
+func (f Func9Pair[R1, R2, P0, P1, P2, P3, P4, P5, P6, P7, P8]) Timing(loggers ...func(d time.Duration)) Func9Pair[R1, R2, P0, P1, P2, P3, P4, P5, P6, P7, P8] {
+	return f.TimingWithClock(RealClock(), loggers...)
+}
+
+// TimingWithClock is like Timing, but measures the execution time with clock.
+func (f Func9Pair[R1, R2, P0, P1, P2, P3, P4, P5, P6, P7, P8]) TimingWithClock(clock Clock, loggers ...func(d time.Duration)) Func9Pair[R1, R2, P0, P1, P2, P3, P4, P5, P6, P7, P8] {
+	return func(p0 P0, p1 P1, p2 P2, p3 P3, p4 P4, p5 P5, p6 P6, p7 P7, p8 P8) (R1, R2) {
+		start := clock.Now()
+		defer func() {
+			dur := clock.Since(start)
+			for _, logger := range loggers {
+				logger(dur)
+			}
+			if len(loggers) == 0 {
+				// Default logger
+				fmt.Println(dur)
+			}
+		}()
+		return f(p0, p1, p2, p3, p4, p5, p6, p7, p8)
+	}
+}
+
+// Fallible transforms a Func9Pair into a Func9PairResult.
+// The returned Func9PairResult will never return an error.
+// Useful when passing a Func9Pair to a function that expects a Func9PairResult.
+func (f Func9Pair[R1, R2, P0, P1, P2, P3, P4, P5, P6, P7, P8]) Fallible() Func9PairResult[R1, R2, P0, P1, P2, P3, P4, P5, P6, P7, P8] {
+	return func(p0 P0, p1 P1, p2 P2, p3 P3, p4 P4, p5 P5, p6 P6, p7 P7, p8 P8) (R1, R2, error) {
+		v1, v2 := f(p0, p1, p2, p3, p4, p5, p6, p7, p8)
+		return v1, v2, nil
+	}
+}
+
+// Curry9 returns a FuncPair[R1, R2] with the first 9 arguments of the Func9Pair bound.
+func (f Func9Pair[R1, R2, P0, P1, P2, P3, P4, P5, P6, P7, P8]) Curry9(p0 P0, p1 P1, p2 P2, p3 P3, p4 P4, p5 P5, p6 P6, p7 P7, p8 P8) FuncPair[R1, R2] {
+	return func() (R1, R2) {
+		return f(p0, p1, p2, p3, p4, p5, p6, p7, p8)
+	}
+}
+
+// Curry8 returns a Func1Pair[R1, R2, P8] with the first 8 arguments of the Func9Pair bound.
+func (f Func9Pair[R1, R2, P0, P1, P2, P3, P4, P5, P6, P7, P8]) Curry8(p0 P0, p1 P1, p2 P2, p3 P3, p4 P4, p5 P5, p6 P6, p7 P7) Func1Pair[R1, R2, P8] {
+	return func(p8 P8) (R1, R2) {
+		return f(p0, p1, p2, p3, p4, p5, p6, p7, p8)
+	}
+}
+
+// Curry7 returns a Func2Pair[R1, R2, P7, P8] with the first 7 arguments of the Func9Pair bound.
+func (f Func9Pair[R1, R2, P0, P1, P2, P3, P4, P5, P6, P7, P8]) Curry7(p0 P0, p1 P1, p2 P2, p3 P3, p4 P4, p5 P5, p6 P6) Func2Pair[R1, R2, P7, P8] {
+	return func(p7 P7, p8 P8) (R1, R2) {
+		return f(p0, p1, p2, p3, p4, p5, p6, p7, p8)
+	}
+}
+
+// Curry6 returns a Func3Pair[R1, R2, P6, P7, P8] with the first 6 arguments of the Func9Pair bound.
+func (f Func9Pair[R1, R2, P0, P1, P2, P3, P4, P5, P6, P7, P8]) Curry6(p0 P0, p1 P1, p2 P2, p3 P3, p4 P4, p5 P5) Func3Pair[R1, R2, P6, P7, P8] {
+	return func(p6 P6, p7 P7, p8 P8) (R1, R2) {
+		return f(p0, p1, p2, p3, p4, p5, p6, p7, p8)
+	}
+}
+
+// Curry5 returns a Func4Pair[R1, R2, P5, P6, P7, P8] with the first 5 arguments of the Func9Pair bound.
+func (f Func9Pair[R1, R2, P0, P1, P2, P3, P4, P5, P6, P7, P8]) Curry5(p0 P0, p1 P1, p2 P2, p3 P3, p4 P4) Func4Pair[R1, R2, P5, P6, P7, P8] {
+	return func(p5 P5, p6 P6, p7 P7, p8 P8) (R1, R2) {
+		return f(p0, p1, p2, p3, p4, p5, p6, p7, p8)
+	}
+}
+
+// Curry4 returns a Func5Pair[R1, R2, P4, P5, P6, P7, P8] with the first 4 arguments of the Func9Pair bound.
+func (f Func9Pair[R1, R2, P0, P1, P2, P3, P4, P5, P6, P7, P8]) Curry4(p0 P0, p1 P1, p2 P2, p3 P3) Func5Pair[R1, R2, P4, P5, P6, P7, P8] {
+	return func(p4 P4, p5 P5, p6 P6, p7 P7, p8 P8) (R1, R2) {
+		return f(p0, p1, p2, p3, p4, p5, p6, p7, p8)
+	}
+}
+
+// Curry3 returns a Func6Pair[R1, R2, P3, P4, P5, P6, P7, P8] with the first 3 arguments of the Func9Pair bound.
+func (f Func9Pair[R1, R2, P0, P1, P2, P3, P4, P5, P6, P7, P8]) Curry3(p0 P0, p1 P1, p2 P2) Func6Pair[R1, R2, P3, P4, P5, P6, P7, P8] {
+	return func(p3 P3, p4 P4, p5 P5, p6 P6, p7 P7, p8 P8) (R1, R2) {
+		return f(p0, p1, p2, p3, p4, p5, p6, p7, p8)
+	}
+}
+
+// Curry2 returns a Func7Pair[R1, R2, P2, P3, P4, P5, P6, P7, P8] with the first 2 arguments of the Func9Pair bound.
+func (f Func9Pair[R1, R2, P0, P1, P2, P3, P4, P5, P6, P7, P8]) Curry2(p0 P0, p1 P1) Func7Pair[R1, R2, P2, P3, P4, P5, P6, P7, P8] {
+	return func(p2 P2, p3 P3, p4 P4, p5 P5, p6 P6, p7 P7, p8 P8) (R1, R2) {
+		return f(p0, p1, p2, p3, p4, p5, p6, p7, p8)
+	}
+}
+
+// Curry1 returns a Func8Pair[R1, R2, P1, P2, P3, P4, P5, P6, P7, P8] with the first argument of the Func9Pair bound.
+func (f Func9Pair[R1, R2, P0, P1, P2, P3, P4, P5, P6, P7, P8]) Curry1(p0 P0) Func8Pair[R1, R2, P1, P2, P3, P4, P5, P6, P7, P8] {
+	return func(p1 P1, p2 P2, p3 P3, p4 P4, p5 P5, p6 P6, p7 P7, p8 P8) (R1, R2) {
+		return f(p0, p1, p2, p3, p4, p5, p6, p7, p8)
+	}
+}
@@ -0,0 +1,188 @@
+// Code generated by powerfunc/generator from func_pair_result.go.tmpl. DO NOT EDIT.
+
+package powerfunc
+
+import (
+	"context"
+	"fmt"
+	"time"
+)
+
+// Func9PairResult is a function that takes 9 arguments and returns two
+// values and an error.
+type Func9PairResult[R1, R2, P0, P1, P2, P3, P4, P5, P6, P7, P8 any] func(p0 P0, p1 P1, p2 P2, p3 P3, p4 P4, p5 P5, p6 P6, p7 P7, p8 P8) (R1, R2, error)
+
+// Exec executes the function.
+// Convenience method to remove some of the confusion that
+// `f.SomeMethod()()` can bring.
+func (f Func9PairResult[R1, R2, P0, P1, P2, P3, P4, P5, P6, P7, P8]) Exec(p0 P0, p1 P1, p2 P2, p3 P3, p4 P4, p5 P5, p6 P6, p7 P7, p8 P8) (R1, R2, error) {
+	return f(p0, p1, p2, p3, p4, p5, p6, p7, p8)
+}
+
+// Timing returns a Func9PairResult that will log the execution time of the Func9PairResult.
+// If no loggers are provided, the default logger (fmt.Println) will be used.
+func (f Func9PairResult[R1, R2, P0, P1, P2, P3, P4, P5, P6, P7, P8]) Timing(loggers ...func(d time.Duration)) Func9PairResult[R1, R2, P0, P1, P2, P3, P4, P5, P6, P7, P8] {
+	return f.TimingWithClock(RealClock(), loggers...)
+}
+
+// TimingWithClock is like Timing, but measures the execution time with clock.
+func (f Func9PairResult[R1, R2, P0, P1, P2, P3, P4, P5, P6, P7, P8]) TimingWithClock(clock Clock, loggers ...func(d time.Duration)) Func9PairResult[R1, R2, P0, P1, P2, P3, P4, P5, P6, P7, P8] {
+	return func(p0 P0, p1 P1, p2 P2, p3 P3, p4 P4, p5 P5, p6 P6, p7 P7, p8 P8) (R1, R2, error) {
+		start := clock.Now()
+		defer func() {
+			dur := clock.Since(start)
+			for _, logger := range loggers {
+				logger(dur)
+			}
+			if len(loggers) == 0 {
+				// Default logger
+				fmt.Println(dur)
+			}
+		}()
+		return f(p0, p1, p2, p3, p4, p5, p6, p7, p8)
+	}
+}
+
+// Retry returns a Func9PairResult that will retry the Func9PairResult until it returns
+// a nil error or the tryAgain function returns false, in which case it
+// returns a *RetryError holding the errors of all the attempts.
+// The options set the backoff between attempts and hooks, e.g. OnRetry.
+func (f Func9PairResult[R1, R2, P0, P1, P2, P3, P4, P5, P6, P7, P8]) Retry(tryAgain func(attempts int, err error) bool, opts ...RetryOption) Func9PairResult[R1, R2, P0, P1, P2, P3, P4, P5, P6, P7, P8] {
+	return func(p0 P0, p1 P1, p2 P2, p3 P3, p4 P4, p5 P5, p6 P6, p7 P7, p8 P8) (R1, R2, error) {
+		var r0 R1
+		var r1 R2
+		err := retryNoContext(tryAgain, opts, func() error {
+			var err error
+			r0, r1, err = f(p0, p1, p2, p3, p4, p5, p6, p7, p8)
+			return err
+		})
+		return r0, r1, err
+	}
+}
+
+// Must returns a Func9Pair that will panic if the Func9PairResult returns an error.
+func (f Func9PairResult[R1, R2, P0, P1, P2, P3, P4, P5, P6, P7, P8]) Must() Func9Pair[R1, R2, P0, P1, P2, P3, P4, P5, P6, P7, P8] {
+	return func(p0 P0, p1 P1, p2 P2, p3 P3, p4 P4, p5 P5, p6 P6, p7 P7, p8 P8) (R1, R2) {
+		v1, v2, err := f(p0, p1, p2, p3, p4, p5, p6, p7, p8)
+		if err != nil {
+			panic(err)
+		}
+		return v1, v2
+	}
+}
+
+// OnErr returns a Func9PairResult that will wrap the error returned by the
+// Func9PairResult with the provided message.
+func (f Func9PairResult[R1, R2, P0, P1, P2, P3, P4, P5, P6, P7, P8]) OnErr(msg string) Func9PairResult[R1, R2, P0, P1, P2, P3, P4, P5, P6, P7, P8] {
+	return func(p0 P0, p1 P1, p2 P2, p3 P3, p4 P4, p5 P5, p6 P6, p7 P7, p8 P8) (R1, R2, error) {
+		v1, v2, err := f(p0, p1, p2, p3, p4, p5, p6, p7, p8)
+		if err != nil {
+			return v1, v2, fmt.Errorf("%s: %w", msg, err)
+		}
+		return v1, v2, nil
+	}
+}
+
+// MapErr applies the provided function to the error returned by the
+// Func9PairResult, if there is an error.
+func (f Func9PairResult[R1, R2, P0, P1, P2, P3, P4, P5, P6, P7, P8]) MapErr(fn func(error) error) Func9PairResult[R1, R2, P0, P1, P2, P3, P4, P5, P6, P7, P8] {
+	return func(p0 P0, p1 P1, p2 P2, p3 P3, p4 P4, p5 P5, p6 P6, p7 P7, p8 P8) (R1, R2, error) {
+		v1, v2, err := f(p0, p1, p2, p3, p4, p5, p6, p7, p8)
+		if err != nil {
+			return v1, v2, fn(err)
+		}
+		return v1, v2, nil
+	}
+}
+
+// Fallback returns a Func9Pair that will return the provided values if the
+// Func9PairResult returns an error.
+func (f Func9PairResult[R1, R2, P0, P1, P2, P3, P4, P5, P6, P7, P8]) Fallback(val1 R1, val2 R2) Func9Pair[R1, R2, P0, P1, P2, P3, P4, P5, P6, P7, P8] {
+	return func(p0 P0, p1 P1, p2 P2, p3 P3, p4 P4, p5 P5, p6 P6, p7 P7, p8 P8) (R1, R2) {
+		v1, v2, err := f(p0, p1, p2, p3, p4, p5, p6, p7, p8)
+		if err != nil {
+			return val1, val2
+		}
+		return v1, v2
+	}
+}
+
+// InjectFaults returns a Func9PairResult injecting the errors, panics and latency
+// configured by cfg in its calls, to test how the callers cope with them.
+// See FaultConfig, and DisableFaults for the global kill-switch.
+// The faults cannot be enabled per request with OptIn, as there is no
+// context.
+func (f Func9PairResult[R1, R2, P0, P1, P2, P3, P4, P5, P6, P7, P8]) InjectFaults(cfg FaultConfig) Func9PairResult[R1, R2, P0, P1, P2, P3, P4, P5, P6, P7, P8] {
+	fi := newFaultInjector(cfg)
+	return func(p0 P0, p1 P1, p2 P2, p3 P3, p4 P4, p5 P5, p6 P6, p7 P7, p8 P8) (R1, R2, error) {
+		if err := fi.inject(context.Background(), p0, p1, p2, p3, p4, p5, p6, p7, p8); err != nil {
+			var r0 R1
+			var r1 R2
+			return r0, r1, err
+		}
+		return f(p0, p1, p2, p3, p4, p5, p6, p7, p8)
+	}
+}
+
+// Curry9 returns a FuncPairResult[R1, R2] with the first 9 arguments of the Func9PairResult bound.
+func (f Func9PairResult[R1, R2, P0, P1, P2, P3, P4, P5, P6, P7, P8]) Curry9(p0 P0, p1 P1, p2 P2, p3 P3, p4 P4, p5 P5, p6 P6, p7 P7, p8 P8) FuncPairResult[R1, R2] {
+	return func() (R1, R2, error) {
+		return f(p0, p1, p2, p3, p4, p5, p6, p7, p8)
+	}
+}
+
+// Curry8 returns a Func1PairResult[R1, R2, P8] with the first 8 arguments of the Func9PairResult bound.
+func (f Func9PairResult[R1, R2, P0, P1, P2, P3, P4, P5, P6, P7, P8]) Curry8(p0 P0, p1 P1, p2 P2, p3 P3, p4 P4, p5 P5, p6 P6, p7 P7) Func1PairResult[R1, R2, P8] {
+	return func(p8 P8) (R1, R2, error) {
+		return f(p0, p1, p2, p3, p4, p5, p6, p7, p8)
+	}
+}
+
+// Curry7 returns a Func2PairResult[R1, R2, P7, P8] with the first 7 arguments of the Func9PairResult bound.
+func (f Func9PairResult[R1, R2, P0, P1, P2, P3, P4, P5, P6, P7, P8]) Curry7(p0 P0, p1 P1, p2 P2, p3 P3, p4 P4, p5 P5, p6 P6) Func2PairResult[R1, R2, P7, P8] {
+	return func(p7 P7, p8 P8) (R1, R2, error) {
+		return f(p0, p1, p2, p3, p4, p5, p6, p7, p8)
+	}
+}
+
+// Curry6 returns a Func3PairResult[R1, R2, P6, P7, P8] with the first 6 arguments of the Func9PairResult bound.
+func (f Func9PairResult[R1, R2, P0, P1, P2, P3, P4, P5, P6, P7, P8]) Curry6(p0 P0, p1 P1, p2 P2, p3 P3, p4 P4, p5 P5) Func3PairResult[R1, R2, P6, P7, P8] {
+	return func(p6 P6, p7 P7, p8 P8) (R1, R2, error) {
+		return f(p0, p1, p2, p3, p4, p5, p6, p7, p8)
+	}
+}
+
+// Curry5 returns a Func4PairResult[R1, R2, P5, P6, P7, P8] with the first 5 arguments of the Func9PairResult bound.
+func (f Func9PairResult[R1, R2, P0, P1, P2, P3, P4, P5, P6, P7, P8]) Curry5(p0 P0, p1 P1, p2 P2, p3 P3, p4 P4) Func4PairResult[R1, R2, P5, P6, P7, P8] {
+	return func(p5 P5, p6 P6, p7 P7, p8 P8) (R1, R2, error) {
+		return f(p0, p1, p2, p3, p4, p5, p6, p7, p8)
+	}
+}
+
+// Curry4 returns a Func5PairResult[R1, R2, P4, P5, P6, P7, P8] with the first 4 arguments of the Func9PairResult bound.
+func (f Func9PairResult[R1, R2, P0, P1, P2, P3, P4, P5, P6, P7, P8]) Curry4(p0 P0, p1 P1, p2 P2, p3 P3) Func5PairResult[R1, R2, P4, P5, P6, P7, P8] {
+	return func(p4 P4, p5 P5, p6 P6, p7 P7, p8 P8) (R1, R2, error) {
+		return f(p0, p1, p2, p3, p4, p5, p6, p7, p8)
+	}
+}
+
+// Curry3 returns a Func6PairResult[R1, R2, P3, P4, P5, P6, P7, P8] with the first 3 arguments of the Func9PairResult bound.
+func (f Func9PairResult[R1, R2, P0, P1, P2, P3, P4, P5, P6, P7, P8]) Curry3(p0 P0, p1 P1, p2 P2) Func6PairResult[R1, R2, P3, P4, P5, P6, P7, P8] {
+	return func(p3 P3, p4 P4, p5 P5, p6 P6, p7 P7, p8 P8) (R1, R2, error) {
+		return f(p0, p1, p2, p3, p4, p5, p6, p7, p8)
+	}
+}
+
+// Curry2 returns a Func7PairResult[R1, R2, P2, P3, P4, P5, P6, P7, P8] with the first 2 arguments of the Func9PairResult bound.
+func (f Func9PairResult[R1, R2, P0, P1, P2, P3, P4, P5, P6, P7, P8]) Curry2(p0 P0, p1 P1) Func7PairResult[R1, R2, P2, P3, P4, P5, P6, P7, P8] {
+	return func(p2 P2, p3 P3, p4 P4, p5 P5, p6 P6, p7 P7, p8 P8) (R1, R2, error) {
+		return f(p0, p1, p2, p3, p4, p5, p6, p7, p8)
+	}
+}
+
+// Curry1 returns a Func8PairResult[R1, R2, P1, P2, P3, P4, P5, P6, P7, P8] with the first argument of the Func9PairResult bound.
+func (f Func9PairResult[R1, R2, P0, P1, P2, P3, P4, P5, P6, P7, P8]) Curry1(p0 P0) Func8PairResult[R1, R2, P1, P2, P3, P4, P5, P6, P7, P8] {
+	return func(p1 P1, p2 P2, p3 P3, p4 P4, p5 P5, p6 P6, p7 P7, p8 P8) (R1, R2, error) {
+		return f(p0, p1, p2, p3, p4, p5, p6, p7, p8)
+	}
+}
@@ -1,28 +1,36 @@
+// Code generated by powerfunc/generator from func_result.go.tmpl. DO NOT EDIT.
+
 package powerfunc
 
 import (
+	"context"
 	"fmt"
 	"time"
 )
 
-// Func9Result is a function that takes 0 arguments and returns a
+// Func9Result is a function that takes 9 arguments and returns a
 // value and an error.
-type Func9Result[T, P0, P1, P2, P3, P4, P5, P6, P7, P8 any] func(p0 P0, p1 P1, p2 P2, p3 P3, p4 P4, p5 P5, p6 P6, p7 P7, p8 P8) (T, error)
+type Func9Result[R, P0, P1, P2, P3, P4, P5, P6, P7, P8 any] func(p0 P0, p1 P1, p2 P2, p3 P3, p4 P4, p5 P5, p6 P6, p7 P7, p8 P8) (R, error)
 
 // Exec executes the function.
 // Convenience method to remove some of the confusion that
 // `f.SomeMethod()()` can bring.
-func (f Func9Result[T, P0, P1, P2, P3, P4, P5, P6, P7, P8]) Exec(p0 P0, p1 P1, p2 P2, p3 P3, p4 P4, p5 P5, p6 P6, p7 P7, p8 P8) (T, error) {
+func (f Func9Result[R, P0, P1, P2, P3, P4, P5, P6, P7, P8]) Exec(p0 P0, p1 P1, p2 P2, p3 P3, p4 P4, p5 P5, p6 P6, p7 P7, p8 P8) (R, error) {
 	return f(p0, p1, p2, p3, p4, p5, p6, p7, p8)
 }
 
-// Timing returns a Func9 that will log the execution time of the Func9.
+// Timing returns a Func9Result that will log the execution time of the Func9Result.
 // If no loggers are provided, the default logger (fmt.Println) will be used.
-func (f Func9Result[T, P0, P1, P2, P3, P4, P5, P6, P7, P8]) Timing(loggers ...func(d time.Duration)) Func9Result[T, P0, P1, P2, P3, P4, P5, P6, P7, P8] {
-	return func(p0 P0, p1 P1, p2 P2, p3 P3, p4 P4, p5 P5, p6 P6, p7 P7, p8 P8) (T, error) {
-		start := time.Now()
+func (f Func9Result[R, P0, P1, P2, P3, P4, P5, P6, P7, P8]) Timing(loggers ...func(d time.Duration)) Func9Result[R, P0, P1, P2, P3, P4, P5, P6, P7, P8] {
+	return f.TimingWithClock(RealClock(), loggers...)
+}
+
+// TimingWithClock is like Timing, but measures the execution time with clock.
+func (f Func9Result[R, P0, P1, P2, P3, P4, P5, P6, P7, P8]) TimingWithClock(clock Clock, loggers ...func(d time.Duration)) Func9Result[R, P0, P1, P2, P3, P4, P5, P6, P7, P8] {
+	return func(p0 P0, p1 P1, p2 P2, p3 P3, p4 P4, p5 P5, p6 P6, p7 P7, p8 P8) (R, error) {
+		start := clock.Now()
 		defer func() {
-			dur := time.Since(start)
+			dur := clock.Since(start)
 			for _, logger := range loggers {
 				logger(dur)
 			}
@@ -35,27 +43,25 @@ func (f Func9Result[T, P0, P1, P2, P3, P4, P5, P6, P7, P8]) Timing(loggers ...fu
 	}
 }
 
-// Retry returns a Function that will retry the Function until it returns
-// a nil error or the tryAgain function returns false.
-func (f Func9Result[T, P0, P1, P2, P3, P4, P5, P6, P7, P8]) Retry(tryAgain func(attempts int, err error) bool) Func9Result[T, P0, P1, P2, P3, P4, P5, P6, P7, P8] {
-	return func(p0 P0, p1 P1, p2 P2, p3 P3, p4 P4, p5 P5, p6 P6, p7 P7, p8 P8) (T, error) {
-		var v T
-		var err error
-		attempts := 1
-		for {
-			v, err = f(p0, p1, p2, p3, p4, p5, p6, p7, p8)
-			if err == nil || !tryAgain(attempts, err) {
-				break
-			}
-			attempts++
-		}
-		return v, err
+// Retry returns a Func9Result that will retry the Func9Result until it returns
+// a nil error or the tryAgain function returns false, in which case it
+// returns a *RetryError holding the errors of all the attempts.
+// The options set the backoff between attempts and hooks, e.g. OnRetry.
+func (f Func9Result[R, P0, P1, P2, P3, P4, P5, P6, P7, P8]) Retry(tryAgain func(attempts int, err error) bool, opts ...RetryOption) Func9Result[R, P0, P1, P2, P3, P4, P5, P6, P7, P8] {
+	return func(p0 P0, p1 P1, p2 P2, p3 P3, p4 P4, p5 P5, p6 P6, p7 P7, p8 P8) (R, error) {
+		var r0 R
+		err := retryNoContext(tryAgain, opts, func() error {
+			var err error
+			r0, err = f(p0, p1, p2, p3, p4, p5, p6, p7, p8)
+			return err
+		})
+		return r0, err
 	}
 }
 
 // Must returns a Func9Value that will panic if the Func9Result returns an error.
-func (f Func9Result[T, P0, P1, P2, P3, P4, P5, P6, P7, P8]) Must() Func9Value[T, P0, P1, P2, P3, P4, P5, P6, P7, P8] {
-	return func(p0 P0, p1 P1, p2 P2, p3 P3, p4 P4, p5 P5, p6 P6, p7 P7, p8 P8) T {
+func (f Func9Result[R, P0, P1, P2, P3, P4, P5, P6, P7, P8]) Must() Func9Value[R, P0, P1, P2, P3, P4, P5, P6, P7, P8] {
+	return func(p0 P0, p1 P1, p2 P2, p3 P3, p4 P4, p5 P5, p6 P6, p7 P7, p8 P8) R {
 		v, err := f(p0, p1, p2, p3, p4, p5, p6, p7, p8)
 		if err != nil {
 			panic(err)
@@ -66,8 +72,8 @@ func (f Func9Result[T, P0, P1, P2, P3, P4, P5, P6, P7, P8]) Must() Func9Value[T,
 
 // OnErr returns a Func9Result that will wrap the error returned by the Func9Result
 // with the provided message.
-func (f Func9Result[T, P0, P1, P2, P3, P4, P5, P6, P7, P8]) OnErr(msg string) Func9Result[T, P0, P1, P2, P3, P4, P5, P6, P7, P8] {
-	return func(p0 P0, p1 P1, p2 P2, p3 P3, p4 P4, p5 P5, p6 P6, p7 P7, p8 P8) (T, error) {
+func (f Func9Result[R, P0, P1, P2, P3, P4, P5, P6, P7, P8]) OnErr(msg string) Func9Result[R, P0, P1, P2, P3, P4, P5, P6, P7, P8] {
+	return func(p0 P0, p1 P1, p2 P2, p3 P3, p4 P4, p5 P5, p6 P6, p7 P7, p8 P8) (R, error) {
 		v, err := f(p0, p1, p2, p3, p4, p5, p6, p7, p8)
 		if err != nil {
 			return v, fmt.Errorf("%s: %w", msg, err)
@@ -78,8 +84,8 @@ func (f Func9Result[T, P0, P1, P2, P3, P4, P5, P6, P7, P8]) OnErr(msg string) Fu
 
 // Map applies the provided function to the value returned by the Func9Result,
 // if there is no error.
-func (f Func9Result[T, P0, P1, P2, P3, P4, P5, P6, P7, P8]) Map(fn func(T) T) Func9Result[T, P0, P1, P2, P3, P4, P5, P6, P7, P8] {
-	return func(p0 P0, p1 P1, p2 P2, p3 P3, p4 P4, p5 P5, p6 P6, p7 P7, p8 P8) (T, error) {
+func (f Func9Result[R, P0, P1, P2, P3, P4, P5, P6, P7, P8]) Map(fn func(R) R) Func9Result[R, P0, P1, P2, P3, P4, P5, P6, P7, P8] {
+	return func(p0 P0, p1 P1, p2 P2, p3 P3, p4 P4, p5 P5, p6 P6, p7 P7, p8 P8) (R, error) {
 		v, err := f(p0, p1, p2, p3, p4, p5, p6, p7, p8)
 		if err != nil {
 			return v, err
@@ -90,8 +96,8 @@ func (f Func9Result[T, P0, P1, P2, P3, P4, P5, P6, P7, P8]) Map(fn func(T) T) Fu
 
 // MapErr applies the provided function to the error returned by the Func9Result,
 // if there is an error.
-func (f Func9Result[T, P0, P1, P2, P3, P4, P5, P6, P7, P8]) MapErr(fn func(error) error) Func9Result[T, P0, P1, P2, P3, P4, P5, P6, P7, P8] {
-	return func(p0 P0, p1 P1, p2 P2, p3 P3, p4 P4, p5 P5, p6 P6, p7 P7, p8 P8) (T, error) {
+func (f Func9Result[R, P0, P1, P2, P3, P4, P5, P6, P7, P8]) MapErr(fn func(error) error) Func9Result[R, P0, P1, P2, P3, P4, P5, P6, P7, P8] {
+	return func(p0 P0, p1 P1, p2 P2, p3 P3, p4 P4, p5 P5, p6 P6, p7 P7, p8 P8) (R, error) {
 		v, err := f(p0, p1, p2, p3, p4, p5, p6, p7, p8)
 		if err != nil {
 			return v, fn(err)
@@ -102,8 +108,8 @@ func (f Func9Result[T, P0, P1, P2, P3, P4, P5, P6, P7, P8]) MapErr(fn func(error
 
 // Fallback returns a Func9Value that will return the provided value if the
 // Func9Result returns an error.
-func (f Func9Result[T, P0, P1, P2, P3, P4, P5, P6, P7, P8]) Fallback(val T) Func9Value[T, P0, P1, P2, P3, P4, P5, P6, P7, P8] {
-	return func(p0 P0, p1 P1, p2 P2, p3 P3, p4 P4, p5 P5, p6 P6, p7 P7, p8 P8) T {
+func (f Func9Result[R, P0, P1, P2, P3, P4, P5, P6, P7, P8]) Fallback(val R) Func9Value[R, P0, P1, P2, P3, P4, P5, P6, P7, P8] {
+	return func(p0 P0, p1 P1, p2 P2, p3 P3, p4 P4, p5 P5, p6 P6, p7 P7, p8 P8) R {
 		v, err := f(p0, p1, p2, p3, p4, p5, p6, p7, p8)
 		if err != nil {
 			return val
@@ -112,66 +118,129 @@ func (f Func9Result[T, P0, P1, P2, P3, P4, P5, P6, P7, P8]) Fallback(val T) Func
 	}
 }
 
+// Once returns a Func9Result that only executes the first time it is called
+// and returns the cached result afterwards, along with the Once controlling it.
+// Use OnceRetryOnError or OnceBackoff to only cache a success.
+func (f Func9Result[R, P0, P1, P2, P3, P4, P5, P6, P7, P8]) Once(opts ...OnceOption) (Func9Result[R, P0, P1, P2, P3, P4, P5, P6, P7, P8], *Once) {
+	o := newOnce(opts...)
+	return func(p0 P0, p1 P1, p2 P2, p3 P3, p4 P4, p5 P5, p6 P6, p7 P7, p8 P8) (R, error) {
+		v, err := o.do(context.Background(), false, func() (any, error) {
+			return f(p0, p1, p2, p3, p4, p5, p6, p7, p8)
+		})
+		val, _ := v.(R)
+		return val, err
+	}, o
+}
+
+// Lazy returns a Func9Result that executes until it succeeds once and
+// returns the cached value afterwards, along with the Once controlling it.
+// Failures are not cached, unless a backoff is provided with OnceBackoff.
+func (f Func9Result[R, P0, P1, P2, P3, P4, P5, P6, P7, P8]) Lazy(opts ...OnceOption) (Func9Result[R, P0, P1, P2, P3, P4, P5, P6, P7, P8], *Once) {
+	o := newLazy(opts...)
+	return func(p0 P0, p1 P1, p2 P2, p3 P3, p4 P4, p5 P5, p6 P6, p7 P7, p8 P8) (R, error) {
+		v, err := o.do(context.Background(), false, func() (any, error) {
+			return f(p0, p1, p2, p3, p4, p5, p6, p7, p8)
+		})
+		val, _ := v.(R)
+		return val, err
+	}, o
+}
+
+// InjectFaults returns a Func9Result injecting the errors, panics and latency
+// configured by cfg in its calls, to test how the callers cope with them.
+// See FaultConfig, and DisableFaults for the global kill-switch.
+// The faults cannot be enabled per request with OptIn, as there is no
+// context.
+func (f Func9Result[R, P0, P1, P2, P3, P4, P5, P6, P7, P8]) InjectFaults(cfg FaultConfig) Func9Result[R, P0, P1, P2, P3, P4, P5, P6, P7, P8] {
+	fi := newFaultInjector(cfg)
+	return func(p0 P0, p1 P1, p2 P2, p3 P3, p4 P4, p5 P5, p6 P6, p7 P7, p8 P8) (R, error) {
+		if err := fi.inject(context.Background(), p0, p1, p2, p3, p4, p5, p6, p7, p8); err != nil {
+			var r0 R
+			return r0, err
+		}
+		return f(p0, p1, p2, p3, p4, p5, p6, p7, p8)
+	}
+}
+
+// WithTimeout returns a Func9Result that runs the Func9Result in its own
+// goroutine and returns a *TimeoutError, matching ErrTimeout, if it does not
+// return within timeout. The goroutine is then abandoned: it keeps running
+// until the Func9Result returns, and is counted by Abandoned.
+// Prefer the WithTimeout method of a function taking a context, which can
+// actually be cancelled.
+func (f Func9Result[R, P0, P1, P2, P3, P4, P5, P6, P7, P8]) WithTimeout(timeout time.Duration, opts ...TimeoutOption) Func9Result[R, P0, P1, P2, P3, P4, P5, P6, P7, P8] {
+	return func(p0 P0, p1 P1, p2 P2, p3 P3, p4 P4, p5 P5, p6 P6, p7 P7, p8 P8) (R, error) {
+		var r0 R
+		var err error
+		if terr := callWithTimeout(timeout, opts, func() {
+			r0, err = f(p0, p1, p2, p3, p4, p5, p6, p7, p8)
+		}); terr != nil {
+			var zero R
+			return zero, terr
+		}
+		return r0, err
+	}
+}
 
+// Curry9 returns a FuncResult[R] with the first 9 arguments of the Func9Result bound.
 func (f Func9Result[R, P0, P1, P2, P3, P4, P5, P6, P7, P8]) Curry9(p0 P0, p1 P1, p2 P2, p3 P3, p4 P4, p5 P5, p6 P6, p7 P7, p8 P8) FuncResult[R] {
 	return func() (R, error) {
 		return f(p0, p1, p2, p3, p4, p5, p6, p7, p8)
 	}
 }
-	
 
+// Curry8 returns a Func1Result[R, P8] with the first 8 arguments of the Func9Result bound.
 func (f Func9Result[R, P0, P1, P2, P3, P4, P5, P6, P7, P8]) Curry8(p0 P0, p1 P1, p2 P2, p3 P3, p4 P4, p5 P5, p6 P6, p7 P7) Func1Result[R, P8] {
 	return func(p8 P8) (R, error) {
 		return f(p0, p1, p2, p3, p4, p5, p6, p7, p8)
 	}
 }
-	
 
+// Curry7 returns a Func2Result[R, P7, P8] with the first 7 arguments of the Func9Result bound.
 func (f Func9Result[R, P0, P1, P2, P3, P4, P5, P6, P7, P8]) Curry7(p0 P0, p1 P1, p2 P2, p3 P3, p4 P4, p5 P5, p6 P6) Func2Result[R, P7, P8] {
 	return func(p7 P7, p8 P8) (R, error) {
 		return f(p0, p1, p2, p3, p4, p5, p6, p7, p8)
 	}
 }
-	
 
+// Curry6 returns a Func3Result[R, P6, P7, P8] with the first 6 arguments of the Func9Result bound.
 func (f Func9Result[R, P0, P1, P2, P3, P4, P5, P6, P7, P8]) Curry6(p0 P0, p1 P1, p2 P2, p3 P3, p4 P4, p5 P5) Func3Result[R, P6, P7, P8] {
 	return func(p6 P6, p7 P7, p8 P8) (R, error) {
 		return f(p0, p1, p2, p3, p4, p5, p6, p7, p8)
 	}
 }
-	
 
+// Curry5 returns a Func4Result[R, P5, P6, P7, P8] with the first 5 arguments of the Func9Result bound.
 func (f Func9Result[R, P0, P1, P2, P3, P4, P5, P6, P7, P8]) Curry5(p0 P0, p1 P1, p2 P2, p3 P3, p4 P4) Func4Result[R, P5, P6, P7, P8] {
 	return func(p5 P5, p6 P6, p7 P7, p8 P8) (R, error) {
 		return f(p0, p1, p2, p3, p4, p5, p6, p7, p8)
 	}
 }
-	
 
+// Curry4 returns a Func5Result[R, P4, P5, P6, P7, P8] with the first 4 arguments of the Func9Result bound.
 func (f Func9Result[R, P0, P1, P2, P3, P4, P5, P6, P7, P8]) Curry4(p0 P0, p1 P1, p2 P2, p3 P3) Func5Result[R, P4, P5, P6, P7, P8] {
 	return func(p4 P4, p5 P5, p6 P6, p7 P7, p8 P8) (R, error) {
 		return f(p0, p1, p2, p3, p4, p5, p6, p7, p8)
 	}
 }
-	
 
+// Curry3 returns a Func6Result[R, P3, P4, P5, P6, P7, P8] with the first 3 arguments of the Func9Result bound.
 func (f Func9Result[R, P0, P1, P2, P3, P4, P5, P6, P7, P8]) Curry3(p0 P0, p1 P1, p2 P2) Func6Result[R, P3, P4, P5, P6, P7, P8] {
 	return func(p3 P3, p4 P4, p5 P5, p6 P6, p7 P7, p8 P8) (R, error) {
 		return f(p0, p1, p2, p3, p4, p5, p6, p7, p8)
 	}
 }
-	
 
+// Curry2 returns a Func7Result[R, P2, P3, P4, P5, P6, P7, P8] with the first 2 arguments of the Func9Result bound.
 func (f Func9Result[R, P0, P1, P2, P3, P4, P5, P6, P7, P8]) Curry2(p0 P0, p1 P1) Func7Result[R, P2, P3, P4, P5, P6, P7, P8] {
 	return func(p2 P2, p3 P3, p4 P4, p5 P5, p6 P6, p7 P7, p8 P8) (R, error) {
 		return f(p0, p1, p2, p3, p4, p5, p6, p7, p8)
 	}
 }
-	
 
+// Curry1 returns a Func8Result[R, P1, P2, P3, P4, P5, P6, P7, P8] with the first argument of the Func9Result bound.
 func (f Func9Result[R, P0, P1, P2, P3, P4, P5, P6, P7, P8]) Curry1(p0 P0) Func8Result[R, P1, P2, P3, P4, P5, P6, P7, P8] {
 	return func(p1 P1, p2 P2, p3 P3, p4 P4, p5 P5, p6 P6, p7 P7, p8 P8) (R, error) {
 		return f(p0, p1, p2, p3, p4, p5, p6, p7, p8)
 	}
 }
-	
\ No newline at end of file
@@ -1,27 +1,35 @@
+// Code generated by powerfunc/generator from func_value.go.tmpl. DO NOT EDIT.
+
 package powerfunc
 
 import (
+	"context"
 	"fmt"
 	"time"
 )
 
-// Func9 is a function that takes 0 arguments and returns a value.
-type Func9Value[T, P0, P1, P2, P3, P4, P5, P6, P7, P8 any] func(p0 P0, p1 P1, p2 P2, p3 P3, p4 P4, p5 P5, p6 P6, p7 P7, p8 P8) T
+// Func9Value is a function that takes 9 arguments and returns a value.
+type Func9Value[R, P0, P1, P2, P3, P4, P5, P6, P7, P8 any] func(p0 P0, p1 P1, p2 P2, p3 P3, p4 P4, p5 P5, p6 P6, p7 P7, p8 P8) R
 
 // Exec executes the function.
 // Convenience method to remove some of the confusion that
 // `f.SomeMethod()()` can bring.
-func (f Func9Value[T, P0, P1, P2, P3, P4, P5, P6, P7, P8]) Exec(p0 P0, p1 P1, p2 P2, p3 P3, p4 P4, p5 P5, p6 P6, p7 P7, p8 P8) T {
+func (f Func9Value[R, P0, P1, P2, P3, P4, P5, P6, P7, P8]) Exec(p0 P0, p1 P1, p2 P2, p3 P3, p4 P4, p5 P5, p6 P6, p7 P7, p8 P8) R {
 	return f(p0, p1, p2, p3, p4, p5, p6, p7, p8)
 }
 
-// Timing returns a Func9 that will log the execution time of the Func9.
+// Timing returns a Func9Value that will log the execution time of the Func9Value.
 // If no loggers are provided, the default logger (fmt.Println) will be used.
-func (f Func9Value[T, P0, P1, P2, P3, P4, P5, P6, P7, P8]) Timing(loggers ...func(d time.Duration)) Func9Value[T, P0, P1, P2, P3, P4, P5, P6, P7, P8] {
-	return func(p0 P0, p1 P1, p2 P2, p3 P3, p4 P4, p5 P5, p6 P6, p7 P7, p8 P8) T {
-		start := time.Now()
+func (f Func9Value[R, P0, P1, P2, P3, P4, P5, P6, P7, P8]) Timing(loggers ...func(d time.Duration)) Func9Value[R, P0, P1, P2, P3, P4, P5, P6, P7, P8] {
+	return f.TimingWithClock(RealClock(), loggers...)
+}
+
+// TimingWithClock is like Timing, but measures the execution time with clock.
+func (f Func9Value[R, P0, P1, P2, P3, P4, P5, P6, P7, P8]) TimingWithClock(clock Clock, loggers ...func(d time.Duration)) Func9Value[R, P0, P1, P2, P3, P4, P5, P6, P7, P8] {
+	return func(p0 P0, p1 P1, p2 P2, p3 P3, p4 P4, p5 P5, p6 P6, p7 P7, p8 P8) R {
+		start := clock.Now()
 		defer func() {
-			dur := time.Since(start)
+			dur := clock.Since(start)
 			for _, logger := range loggers {
 				logger(dur)
 			}
@@ -37,72 +45,117 @@ func (f Func9Value[T, P0, P1, P2, P3, P4, P5, P6, P7, P8]) Timing(loggers ...fun
 // Fallible transforms a Func9Value into a Func9Result.
 // The returned Func9Result will never return an error.
 // Useful when passing a Func9Value to a function that expects a Func9Result.
-func (f Func9Value[T, P0, P1, P2, P3, P4, P5, P6, P7, P8]) Fallible() Func9Result[T, P0, P1, P2, P3, P4, P5, P6, P7, P8] {
-	return func(p0 P0, p1 P1, p2 P2, p3 P3, p4 P4, p5 P5, p6 P6, p7 P7, p8 P8) (T, error) {
+func (f Func9Value[R, P0, P1, P2, P3, P4, P5, P6, P7, P8]) Fallible() Func9Result[R, P0, P1, P2, P3, P4, P5, P6, P7, P8] {
+	return func(p0 P0, p1 P1, p2 P2, p3 P3, p4 P4, p5 P5, p6 P6, p7 P7, p8 P8) (R, error) {
 		return f(p0, p1, p2, p3, p4, p5, p6, p7, p8), nil
 	}
 }
 
+// Once returns a Func9Value that only executes the first time it is called
+// and returns the cached value afterwards, along with the Once controlling it.
+func (f Func9Value[R, P0, P1, P2, P3, P4, P5, P6, P7, P8]) Once(opts ...OnceOption) (Func9Value[R, P0, P1, P2, P3, P4, P5, P6, P7, P8], *Once) {
+	o := newOnce(opts...)
+	return func(p0 P0, p1 P1, p2 P2, p3 P3, p4 P4, p5 P5, p6 P6, p7 P7, p8 P8) R {
+		v, _ := o.do(context.Background(), false, func() (any, error) {
+			return f(p0, p1, p2, p3, p4, p5, p6, p7, p8), nil
+		})
+		val, _ := v.(R)
+		return val
+	}, o
+}
+
+// Lazy returns a Func9Value that computes its value the first time it is
+// called and returns the cached value afterwards, along with the Once
+// controlling it.
+func (f Func9Value[R, P0, P1, P2, P3, P4, P5, P6, P7, P8]) Lazy(opts ...OnceOption) (Func9Value[R, P0, P1, P2, P3, P4, P5, P6, P7, P8], *Once) {
+	o := newLazy(opts...)
+	return func(p0 P0, p1 P1, p2 P2, p3 P3, p4 P4, p5 P5, p6 P6, p7 P7, p8 P8) R {
+		v, _ := o.do(context.Background(), false, func() (any, error) {
+			return f(p0, p1, p2, p3, p4, p5, p6, p7, p8), nil
+		})
+		val, _ := v.(R)
+		return val
+	}, o
+}
+
+// WithTimeout returns a Func9Result that runs the Func9Value in its own
+// goroutine and returns a *TimeoutError, matching ErrTimeout, if it does not
+// return within timeout. The goroutine is then abandoned: it keeps running
+// until the Func9Value returns, and is counted by Abandoned.
+// Prefer the WithTimeout method of a function taking a context, which can
+// actually be cancelled.
+func (f Func9Value[R, P0, P1, P2, P3, P4, P5, P6, P7, P8]) WithTimeout(timeout time.Duration, opts ...TimeoutOption) Func9Result[R, P0, P1, P2, P3, P4, P5, P6, P7, P8] {
+	return func(p0 P0, p1 P1, p2 P2, p3 P3, p4 P4, p5 P5, p6 P6, p7 P7, p8 P8) (R, error) {
+		var r0 R
+		if terr := callWithTimeout(timeout, opts, func() {
+			r0 = f(p0, p1, p2, p3, p4, p5, p6, p7, p8)
+		}); terr != nil {
+			var zero R
+			return zero, terr
+		}
+		return r0, nil
+	}
+}
 
+// Curry9 returns a FuncValue[R] with the first 9 arguments of the Func9Value bound.
 func (f Func9Value[R, P0, P1, P2, P3, P4, P5, P6, P7, P8]) Curry9(p0 P0, p1 P1, p2 P2, p3 P3, p4 P4, p5 P5, p6 P6, p7 P7, p8 P8) FuncValue[R] {
 	return func() R {
 		return f(p0, p1, p2, p3, p4, p5, p6, p7, p8)
 	}
 }
-	
 
+// Curry8 returns a Func1Value[R, P8] with the first 8 arguments of the Func9Value bound.
 func (f Func9Value[R, P0, P1, P2, P3, P4, P5, P6, P7, P8]) Curry8(p0 P0, p1 P1, p2 P2, p3 P3, p4 P4, p5 P5, p6 P6, p7 P7) Func1Value[R, P8] {
 	return func(p8 P8) R {
 		return f(p0, p1, p2, p3, p4, p5, p6, p7, p8)
 	}
 }
-	
 
+// Curry7 returns a Func2Value[R, P7, P8] with the first 7 arguments of the Func9Value bound.
 func (f Func9Value[R, P0, P1, P2, P3, P4, P5, P6, P7, P8]) Curry7(p0 P0, p1 P1, p2 P2, p3 P3, p4 P4, p5 P5, p6 P6) Func2Value[R, P7, P8] {
 	return func(p7 P7, p8 P8) R {
 		return f(p0, p1, p2, p3, p4, p5, p6, p7, p8)
 	}
 }
-	
 
+// Curry6 returns a Func3Value[R, P6, P7, P8] with the first 6 arguments of the Func9Value bound.
 func (f Func9Value[R, P0, P1, P2, P3, P4, P5, P6, P7, P8]) Curry6(p0 P0, p1 P1, p2 P2, p3 P3, p4 P4, p5 P5) Func3Value[R, P6, P7, P8] {
 	return func(p6 P6, p7 P7, p8 P8) R {
 		return f(p0, p1, p2, p3, p4, p5, p6, p7, p8)
 	}
 }
-	
 
+// Curry5 returns a Func4Value[R, P5, P6, P7, P8] with the first 5 arguments of the Func9Value bound.
 func (f Func9Value[R, P0, P1, P2, P3, P4, P5, P6, P7, P8]) Curry5(p0 P0, p1 P1, p2 P2, p3 P3, p4 P4) Func4Value[R, P5, P6, P7, P8] {
 	return func(p5 P5, p6 P6, p7 P7, p8 P8) R {
 		return f(p0, p1, p2, p3, p4, p5, p6, p7, p8)
 	}
 }
-	
 
+// Curry4 returns a Func5Value[R, P4, P5, P6, P7, P8] with the first 4 arguments of the Func9Value bound.
 func (f Func9Value[R, P0, P1, P2, P3, P4, P5, P6, P7, P8]) Curry4(p0 P0, p1 P1, p2 P2, p3 P3) Func5Value[R, P4, P5, P6, P7, P8] {
 	return func(p4 P4, p5 P5, p6 P6, p7 P7, p8 P8) R {
 		return f(p0, p1, p2, p3, p4, p5, p6, p7, p8)
 	}
 }
-	
 
+// Curry3 returns a Func6Value[R, P3, P4, P5, P6, P7, P8] with the first 3 arguments of the Func9Value bound.
 func (f Func9Value[R, P0, P1, P2, P3, P4, P5, P6, P7, P8]) Curry3(p0 P0, p1 P1, p2 P2) Func6Value[R, P3, P4, P5, P6, P7, P8] {
 	return func(p3 P3, p4 P4, p5 P5, p6 P6, p7 P7, p8 P8) R {
 		return f(p0, p1, p2, p3, p4, p5, p6, p7, p8)
 	}
 }
-	
 
+// Curry2 returns a Func7Value[R, P2, P3, P4, P5, P6, P7, P8] with the first 2 arguments of the Func9Value bound.
 func (f Func9Value[R, P0, P1, P2, P3, P4, P5, P6, P7, P8]) Curry2(p0 P0, p1 P1) Func7Value[R, P2, P3, P4, P5, P6, P7, P8] {
 	return func(p2 P2, p3 P3, p4 P4, p5 P5, p6 P6, p7 P7, p8 P8) R {
 		return f(p0, p1, p2, p3, p4, p5, p6, p7, p8)
 	}
 }
-	
 
+// Curry1 returns a Func8Value[R, P1, P2, P3, P4, P5, P6, P7, P8] with the first argument of the Func9Value bound.
 func (f Func9Value[R, P0, P1, P2, P3, P4, P5, P6, P7, P8]) Curry1(p0 P0) Func8Value[R, P1, P2, P3, P4, P5, P6, P7, P8] {
 	return func(p1 P1, p2 P2, p3 P3, p4 P4, p5 P5, p6 P6, p7 P7, p8 P8) R {
 		return f(p0, p1, p2, p3, p4, p5, p6, p7, p8)
 	}
 }
-	
\ No newline at end of file
@@ -0,0 +1,29 @@
+package powerfunc
+
+import "time"
+
+// ExponentialBackoff returns a backoff function doubling the delay on every
+// attempt, starting at base and capped at max.
+// attempt starts at 1.
+func ExponentialBackoff(base, max time.Duration) func(attempt int) time.Duration {
+	return func(attempt int) time.Duration {
+		d := base
+		for i := 1; i < attempt; i++ {
+			d *= 2
+			if d >= max || d <= 0 {
+				return max
+			}
+		}
+		if d > max {
+			return max
+		}
+		return d
+	}
+}
+
+// ConstantBackoff returns a backoff function always returning d.
+func ConstantBackoff(d time.Duration) func(attempt int) time.Duration {
+	return func(int) time.Duration {
+		return d
+	}
+}
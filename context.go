@@ -0,0 +1,66 @@
+package powerfunc
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+)
+
+// withCause wraps err with the cause of ctx, if err is the error of ctx and
+// does not carry its cause yet, to tell which deadline fired. Other errors,
+// returned by chance after ctx was done, are left alone.
+func withCause(ctx context.Context, err error) error {
+	if err == nil || ctx.Err() == nil || !errors.Is(err, ctx.Err()) {
+		return err
+	}
+	cause := context.Cause(ctx)
+	if cause == nil || errors.Is(err, cause) {
+		return err
+	}
+	return fmt.Errorf("%w: %w", err, cause)
+}
+
+// ErrBudgetExhausted is matched, with errors.Is, by the *BudgetError returned
+// by a WithBudget function skipping its call.
+var ErrBudgetExhausted = errors.New("deadline budget exhausted")
+
+// BudgetError is returned by a WithBudget function when the share of the
+// remaining deadline it would give to the call is below its minimum.
+type BudgetError struct {
+	// Remaining is the time left before the deadline of the caller.
+	Remaining time.Duration
+	// Budget is the share of Remaining the call would have got.
+	Budget time.Duration
+	Min    time.Duration
+}
+
+func (e *BudgetError) Error() string {
+	return fmt.Sprintf("%v: budget of %s below %s, with %s remaining", ErrBudgetExhausted, e.Budget, e.Min, e.Remaining)
+}
+
+// Is makes a *BudgetError match ErrBudgetExhausted.
+func (e *BudgetError) Is(target error) bool {
+	return target == ErrBudgetExhausted
+}
+
+// budget returns the timeout of a call getting fraction of the remaining
+// deadline of ctx, capped at max when max is positive, and whether the call
+// must be bounded at all: without a deadline, the call gets max, or no
+// timeout if max is not positive.
+func budget(ctx context.Context, fraction float64, min, max time.Duration) (time.Duration, bool, error) {
+	deadline, ok := ctx.Deadline()
+	if !ok {
+		return max, max > 0, nil
+	}
+
+	remaining := time.Until(deadline)
+	timeout := time.Duration(float64(remaining) * fraction)
+	if max > 0 && timeout > max {
+		timeout = max
+	}
+	if timeout < min || timeout <= 0 {
+		return 0, false, &BudgetError{Remaining: remaining, Budget: timeout, Min: min}
+	}
+	return timeout, true, nil
+}
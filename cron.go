@@ -0,0 +1,293 @@
+package powerfunc
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// CronSchedule is a parsed cron expression.
+type CronSchedule struct {
+	second, minute, hour, dom, month, dow uint64
+
+	// domStar and dowStar are set when the field was `*` or `?`.
+	// When both day fields are restricted, a day matches if either matches.
+	domStar, dowStar bool
+
+	loc *time.Location
+}
+
+type cronBounds struct {
+	min, max int
+	names    map[string]int
+}
+
+var (
+	cronSeconds = cronBounds{0, 59, nil}
+	cronMinutes = cronBounds{0, 59, nil}
+	cronHours   = cronBounds{0, 23, nil}
+	cronDom     = cronBounds{1, 31, nil}
+	cronMonths  = cronBounds{1, 12, map[string]int{
+		"jan": 1, "feb": 2, "mar": 3, "apr": 4, "may": 5, "jun": 6,
+		"jul": 7, "aug": 8, "sep": 9, "oct": 10, "nov": 11, "dec": 12,
+	}}
+	// 7 is accepted as an alias for Sunday.
+	cronDow = cronBounds{0, 7, map[string]int{
+		"sun": 0, "mon": 1, "tue": 2, "wed": 3, "thu": 4, "fri": 5, "sat": 6,
+	}}
+)
+
+var cronMacros = map[string]string{
+	"@yearly":   "0 0 1 1 *",
+	"@annually": "0 0 1 1 *",
+	"@monthly":  "0 0 1 * *",
+	"@weekly":   "0 0 * * 0",
+	"@daily":    "0 0 * * *",
+	"@midnight": "0 0 * * *",
+	"@hourly":   "0 * * * *",
+}
+
+// ParseCron parses a cron expression.
+//
+// Both the standard 5 fields form (minute, hour, day of month, month, day of
+// week) and the 6 fields form with a leading seconds field are accepted.
+// Fields support `*`, `?`, lists (`1,15`), ranges (`1-5`), steps (`*/10`,
+// `0-30/5`, `5/15`) and month and day names (`JAN`, `MON-FRI`).
+// The `@yearly`, `@annually`, `@monthly`, `@weekly`, `@daily`, `@midnight`
+// and `@hourly` macros are supported as well.
+//
+// The expression can be prefixed with `CRON_TZ=<zone>` or `TZ=<zone>` to
+// evaluate it in a given time zone. Otherwise, it is evaluated in the
+// location of the time passed to Next.
+func ParseCron(spec string) (*CronSchedule, error) {
+	spec = strings.TrimSpace(spec)
+	s := &CronSchedule{}
+
+	if strings.HasPrefix(spec, "CRON_TZ=") || strings.HasPrefix(spec, "TZ=") {
+		i := strings.IndexAny(spec, " \t")
+		if i == -1 {
+			return nil, fmt.Errorf("cron: missing expression after time zone in %q", spec)
+		}
+		name := spec[strings.Index(spec, "=")+1 : i]
+		loc, err := time.LoadLocation(name)
+		if err != nil {
+			return nil, fmt.Errorf("cron: invalid time zone %q: %w", name, err)
+		}
+		s.loc = loc
+		spec = strings.TrimSpace(spec[i:])
+	}
+
+	if strings.HasPrefix(spec, "@") {
+		expanded, ok := cronMacros[strings.ToLower(spec)]
+		if !ok {
+			return nil, fmt.Errorf("cron: unknown macro %q", spec)
+		}
+		spec = expanded
+	}
+
+	fields := strings.Fields(spec)
+	switch len(fields) {
+	case 5:
+		fields = append([]string{"0"}, fields...)
+	case 6:
+	default:
+		return nil, fmt.Errorf("cron: expected 5 or 6 fields, got %d in %q", len(fields), spec)
+	}
+
+	var err error
+	if s.second, _, err = parseCronField(fields[0], cronSeconds); err != nil {
+		return nil, err
+	}
+	if s.minute, _, err = parseCronField(fields[1], cronMinutes); err != nil {
+		return nil, err
+	}
+	if s.hour, _, err = parseCronField(fields[2], cronHours); err != nil {
+		return nil, err
+	}
+	if s.dom, s.domStar, err = parseCronField(fields[3], cronDom); err != nil {
+		return nil, err
+	}
+	if s.month, _, err = parseCronField(fields[4], cronMonths); err != nil {
+		return nil, err
+	}
+	if s.dow, s.dowStar, err = parseCronField(fields[5], cronDow); err != nil {
+		return nil, err
+	}
+	if s.dow&(1<<7) != 0 {
+		s.dow = s.dow&^(1<<7) | 1
+	}
+
+	return s, nil
+}
+
+// MustParseCron is like ParseCron but panics if the expression is invalid.
+func MustParseCron(spec string) *CronSchedule {
+	s, err := ParseCron(spec)
+	if err != nil {
+		panic(err)
+	}
+	return s
+}
+
+func parseCronField(field string, bounds cronBounds) (bits uint64, star bool, err error) {
+	for _, part := range strings.Split(field, ",") {
+		b, s, err := parseCronRange(part, bounds)
+		if err != nil {
+			return 0, false, fmt.Errorf("cron: invalid field %q: %w", field, err)
+		}
+		bits |= b
+		star = star || s
+	}
+	return bits, star, nil
+}
+
+func parseCronRange(part string, bounds cronBounds) (bits uint64, star bool, err error) {
+	rangePart, stepPart, hasStep := strings.Cut(part, "/")
+
+	var low, high int
+	switch rangePart {
+	case "*", "?":
+		low, high = bounds.min, bounds.max
+		star = !hasStep
+	default:
+		lowPart, highPart, isRange := strings.Cut(rangePart, "-")
+		if low, err = parseCronValue(lowPart, bounds); err != nil {
+			return 0, false, err
+		}
+		switch {
+		case isRange:
+			if high, err = parseCronValue(highPart, bounds); err != nil {
+				return 0, false, err
+			}
+		case hasStep:
+			high = bounds.max
+		default:
+			high = low
+		}
+	}
+	if low > high {
+		return 0, false, fmt.Errorf("range %q is reversed", part)
+	}
+
+	step := 1
+	if hasStep {
+		if step, err = strconv.Atoi(stepPart); err != nil || step <= 0 {
+			return 0, false, fmt.Errorf("invalid step %q", stepPart)
+		}
+	}
+
+	for i := low; i <= high; i += step {
+		bits |= 1 << uint(i)
+	}
+	return bits, star, nil
+}
+
+func parseCronValue(value string, bounds cronBounds) (int, error) {
+	if v, ok := bounds.names[strings.ToLower(value)]; ok {
+		return v, nil
+	}
+	v, err := strconv.Atoi(value)
+	if err != nil {
+		return 0, fmt.Errorf("invalid value %q", value)
+	}
+	if v < bounds.min || v > bounds.max {
+		return 0, fmt.Errorf("value %d out of range [%d, %d]", v, bounds.min, bounds.max)
+	}
+	return v, nil
+}
+
+// Next returns the first time strictly after t matching the schedule, or the
+// zero time if there is none in the next five years.
+func (s *CronSchedule) Next(t time.Time) time.Time {
+	orig := t.Location()
+	loc := s.loc
+	if loc == nil {
+		loc = orig
+	}
+	t = t.In(loc)
+
+	// Start at the next whole second.
+	t = t.Add(time.Second - time.Duration(t.Nanosecond()))
+	added := false
+	yearLimit := t.Year() + 5
+
+wrap:
+	if t.Year() > yearLimit {
+		return time.Time{}
+	}
+
+	for s.month&(1<<uint(t.Month())) == 0 {
+		if !added {
+			added = true
+			t = time.Date(t.Year(), t.Month(), 1, 0, 0, 0, 0, loc)
+		}
+		t = t.AddDate(0, 1, 0)
+		if t.Month() == time.January {
+			goto wrap
+		}
+	}
+
+	for !s.dayMatches(t) {
+		if !added {
+			added = true
+			t = time.Date(t.Year(), t.Month(), t.Day(), 0, 0, 0, 0, loc)
+		}
+		t = t.AddDate(0, 0, 1)
+		// Daylight saving time transitions can shift midnight.
+		if t.Hour() != 0 {
+			if t.Hour() > 12 {
+				t = t.Add(time.Duration(24-t.Hour()) * time.Hour)
+			} else {
+				t = t.Add(-time.Duration(t.Hour()) * time.Hour)
+			}
+		}
+		if t.Day() == 1 {
+			goto wrap
+		}
+	}
+
+	for s.hour&(1<<uint(t.Hour())) == 0 {
+		if !added {
+			added = true
+			t = time.Date(t.Year(), t.Month(), t.Day(), t.Hour(), 0, 0, 0, loc)
+		}
+		t = t.Add(time.Hour)
+		if t.Hour() == 0 {
+			goto wrap
+		}
+	}
+
+	for s.minute&(1<<uint(t.Minute())) == 0 {
+		if !added {
+			added = true
+			t = t.Truncate(time.Minute)
+		}
+		t = t.Add(time.Minute)
+		if t.Minute() == 0 {
+			goto wrap
+		}
+	}
+
+	for s.second&(1<<uint(t.Second())) == 0 {
+		if !added {
+			added = true
+			t = t.Truncate(time.Second)
+		}
+		t = t.Add(time.Second)
+		if t.Second() == 0 {
+			goto wrap
+		}
+	}
+
+	return t.In(orig)
+}
+
+func (s *CronSchedule) dayMatches(t time.Time) bool {
+	domMatch := s.dom&(1<<uint(t.Day())) != 0
+	dowMatch := s.dow&(1<<uint(t.Weekday())) != 0
+	if s.domStar || s.dowStar {
+		return domMatch && dowMatch
+	}
+	return domMatch || dowMatch
+}
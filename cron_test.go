@@ -0,0 +1,108 @@
+package powerfunc_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/jonathanmontane/powerfunc"
+)
+
+func TestCronScheduleNext(t *testing.T) {
+	newYork, err := time.LoadLocation("America/New_York")
+	if err != nil {
+		t.Skipf("time zone database not available: %v", err)
+	}
+	utc := func(s string) time.Time {
+		v, err := time.Parse(time.DateTime, s)
+		if err != nil {
+			t.Fatal(err)
+		}
+		return v
+	}
+	ny := func(s string) time.Time {
+		v, err := time.ParseInLocation(time.DateTime, s, newYork)
+		if err != nil {
+			t.Fatal(err)
+		}
+		return v
+	}
+
+	tests := []struct {
+		name string
+		spec string
+		from time.Time
+		want time.Time
+	}{
+		{"every minute", "* * * * *", utc("2024-01-01 00:00:00"), utc("2024-01-01 00:01:00")},
+		{"strictly after", "0 * * * *", utc("2024-01-01 10:00:00"), utc("2024-01-01 11:00:00")},
+		{"step", "*/15 * * * *", utc("2024-01-01 10:07:30"), utc("2024-01-01 10:15:00")},
+		{"range with step", "0-30/10 * * * *", utc("2024-01-01 10:31:00"), utc("2024-01-01 11:00:00")},
+		{"list", "0 8,20 * * *", utc("2024-01-01 09:00:00"), utc("2024-01-01 20:00:00")},
+		{"seconds field", "*/10 * * * * *", utc("2024-01-01 10:00:05"), utc("2024-01-01 10:00:10")},
+		{"week days by name", "0 9 * * MON-FRI", utc("2024-01-06 10:00:00"), utc("2024-01-08 09:00:00")},
+		{"months by name", "0 0 1 jun,dec *", utc("2024-07-15 00:00:00"), utc("2024-12-01 00:00:00")},
+		{"sunday as 0", "0 0 * * 0", utc("2024-01-01 00:00:00"), utc("2024-01-07 00:00:00")},
+		{"sunday as 7", "0 0 * * 7", utc("2024-01-01 00:00:00"), utc("2024-01-07 00:00:00")},
+		{"sunday as 7 in a range", "0 0 * * 6-7", utc("2024-01-01 00:00:00"), utc("2024-01-06 00:00:00")},
+		{"day of month or day of week, week day first", "0 0 13 * 5", utc("2024-01-01 00:00:00"), utc("2024-01-05 00:00:00")},
+		{"day of month or day of week, month day first", "0 0 13 * 5", utc("2024-01-12 00:00:00"), utc("2024-01-13 00:00:00")},
+		{"day of month with star day of week", "0 0 13 * *", utc("2024-01-01 00:00:00"), utc("2024-01-13 00:00:00")},
+		{"day of month with question mark", "0 0 13 * ?", utc("2024-01-01 00:00:00"), utc("2024-01-13 00:00:00")},
+		{"leap day", "0 0 29 2 *", utc("2024-03-01 00:00:00"), utc("2028-02-29 00:00:00")},
+		{"never", "0 0 31 2 *", utc("2024-01-01 00:00:00"), time.Time{}},
+		{"@yearly", "@yearly", utc("2024-06-01 00:00:00"), utc("2025-01-01 00:00:00")},
+		{"@annually", "@annually", utc("2024-06-01 00:00:00"), utc("2025-01-01 00:00:00")},
+		{"@monthly", "@monthly", utc("2024-01-31 12:00:00"), utc("2024-02-01 00:00:00")},
+		{"@weekly", "@weekly", utc("2024-01-03 00:00:00"), utc("2024-01-07 00:00:00")},
+		{"@daily", "@daily", utc("2024-01-01 12:00:00"), utc("2024-01-02 00:00:00")},
+		{"@midnight", "@midnight", utc("2024-01-01 12:00:00"), utc("2024-01-02 00:00:00")},
+		{"@hourly", "@HOURLY", utc("2024-01-01 10:30:00"), utc("2024-01-01 11:00:00")},
+		{"time zone prefix", "CRON_TZ=America/New_York 0 9 * * *", utc("2024-01-01 00:00:00"), utc("2024-01-01 14:00:00")},
+		{"TZ prefix with a macro", "TZ=America/New_York @daily", utc("2024-01-01 06:00:00"), utc("2024-01-02 05:00:00")},
+		{"location of the time", "0 9 * * *", ny("2024-01-01 00:00:00"), ny("2024-01-01 09:00:00")},
+		{"midnight on the day of the spring transition", "0 0 * * *", ny("2024-03-09 12:00:00"), ny("2024-03-10 00:00:00")},
+		{"after the spring transition", "0 3 * * *", ny("2024-03-10 00:00:00"), ny("2024-03-10 03:00:00")},
+		{"hour skipped by the spring transition", "30 2 * * *", ny("2024-03-09 12:00:00"), ny("2024-03-11 02:30:00")},
+		{"hourly across the spring transition", "0 * * * *", ny("2024-03-10 01:30:00"), ny("2024-03-10 03:00:00")},
+		{"hour repeated by the fall transition", "0 1 * * *", ny("2024-11-03 00:30:00"), ny("2024-11-03 01:00:00")},
+		{"midnight after the fall transition", "0 0 * * *", ny("2024-11-03 00:30:00"), ny("2024-11-04 00:00:00")},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			schedule, err := powerfunc.ParseCron(tt.spec)
+			if err != nil {
+				t.Fatalf("ParseCron(%q): %v", tt.spec, err)
+			}
+			got := schedule.Next(tt.from)
+			if !got.Equal(tt.want) {
+				t.Errorf("Next(%s) = %s, want %s", tt.from, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestParseCronErrors(t *testing.T) {
+	tests := []string{
+		"",
+		"* * * *",
+		"* * * * * * *",
+		"60 * * * *",
+		"* 24 * * *",
+		"* * 0 * *",
+		"* * * 13 *",
+		"* * * * 8",
+		"5-1 * * * *",
+		"*/0 * * * *",
+		"*/x * * * *",
+		"a * * * *",
+		"* * * * MON-",
+		"@fortnightly",
+		"CRON_TZ=Nowhere/Nothing * * * * *",
+		"CRON_TZ=UTC",
+	}
+	for _, spec := range tests {
+		if _, err := powerfunc.ParseCron(spec); err == nil {
+			t.Errorf("ParseCron(%q): expected an error", spec)
+		}
+	}
+}
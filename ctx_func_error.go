@@ -1,3 +1,5 @@
+// Code generated by powerfunc/generator from ctx_func_error.go.tmpl. DO NOT EDIT.
+
 package powerfunc
 
 import (
@@ -13,10 +15,15 @@ func (f CtxFuncError) Exec(ctx context.Context) error {
 }
 
 func (f CtxFuncError) Timing(loggers ...func(d time.Duration)) CtxFuncError {
+	return f.TimingWithClock(RealClock(), loggers...)
+}
+
+// TimingWithClock is like Timing, but measures the execution time with clock.
+func (f CtxFuncError) TimingWithClock(clock Clock, loggers ...func(d time.Duration)) CtxFuncError {
 	return func(ctx context.Context) error {
-		start := time.Now()
+		start := clock.Now()
 		defer func() {
-			dur := time.Since(start)
+			dur := clock.Since(start)
 			for _, logger := range loggers {
 				logger(dur)
 			}
@@ -53,24 +60,98 @@ func (f CtxFuncError) WithCancel() CtxFuncError {
 	}
 }
 
-// Retry returns a Function that will retry the Function until it returns
-// a nil error or the tryAgain function returns false.
-func (f CtxFuncError) Retry(tryAgain func(attempts int, err error) bool) CtxFuncError {
+// WithValue returns a CtxFuncError calling the CtxFuncError with a context
+// carrying val for key, see context.WithValue.
+func (f CtxFuncError) WithValue(key, val any) CtxFuncError {
 	return func(ctx context.Context) error {
-		var err error
-		attempts := 1
-		for {
-			err = f(ctx)
-			if err != nil && tryAgain(attempts, err) {
-				break
-			}
-			attempts++
+		ctx = context.WithValue(ctx, key, val)
+		return f(ctx)
+	}
+}
+
+// WithValueFrom returns a CtxFuncError calling the CtxFuncError with a context
+// carrying the value returned by value for its key. value gets the arguments
+// of every call.
+func (f CtxFuncError) WithValueFrom(value func(ctx context.Context) (key, val any)) CtxFuncError {
+	return func(ctx context.Context) error {
+		key, val := value(ctx)
+		ctx = context.WithValue(ctx, key, val)
+		return f(ctx)
+	}
+}
+
+// Detached returns a CtxFuncError calling the CtxFuncError with a context that is
+// never cancelled but keeps the values of the caller's context, see
+// context.WithoutCancel. Useful for cleanups that must run to completion.
+func (f CtxFuncError) Detached() CtxFuncError {
+	return func(ctx context.Context) error {
+		ctx = context.WithoutCancel(ctx)
+		return f(ctx)
+	}
+}
+
+// WithTimeoutCause is like WithTimeout, but the context is cancelled with
+// cause when the timeout expires, see context.WithTimeoutCause.
+// When the CtxFuncError then fails with the error of the context, the returned
+// error wraps cause as well, to tell which timeout expired.
+func (f CtxFuncError) WithTimeoutCause(timeout time.Duration, cause error) CtxFuncError {
+	return func(ctx context.Context) error {
+		ctx, cancel := context.WithTimeoutCause(ctx, timeout, cause)
+		defer cancel()
+		err := f(ctx)
+		return withCause(ctx, err)
+	}
+}
+
+// WithDeadlineCause is like WithDeadline, but the context is cancelled with
+// cause when the deadline expires, see context.WithDeadlineCause.
+// When the CtxFuncError then fails with the error of the context, the returned
+// error wraps cause as well, to tell which deadline expired.
+func (f CtxFuncError) WithDeadlineCause(deadline time.Time, cause error) CtxFuncError {
+	return func(ctx context.Context) error {
+		ctx, cancel := context.WithDeadlineCause(ctx, deadline, cause)
+		defer cancel()
+		err := f(ctx)
+		return withCause(ctx, err)
+	}
+}
+
+// WithBudget returns a CtxFuncError giving the CtxFuncError a fraction of the
+// remaining deadline of the caller, capped at max when max is positive,
+// instead of a fixed timeout. Without a deadline, the CtxFuncError gets max, or
+// no timeout if max is not positive.
+// When the share is below min, the CtxFuncError is not called and a
+// *BudgetError, matching ErrBudgetExhausted, is returned.
+func (f CtxFuncError) WithBudget(fraction float64, min, max time.Duration) CtxFuncError {
+	return func(ctx context.Context) error {
+		timeout, bounded, err := budget(ctx, fraction, min, max)
+		if err != nil {
+			return err
 		}
-		return err
+		if bounded {
+			var cancel context.CancelFunc
+			ctx, cancel = context.WithTimeout(ctx, timeout)
+			defer cancel()
+		}
+		return f(ctx)
+	}
+}
+
+// Retry returns a CtxFuncError that will retry the CtxFuncError until it returns
+// a nil error or the tryAgain function returns false, in which case it
+// returns a *RetryError holding the errors of all the attempts.
+// The options set the backoff between attempts and hooks, e.g. OnRetry.
+// RetryAttemptTimeout gives every attempt its own deadline within the one of
+// the caller.
+func (f CtxFuncError) Retry(tryAgain func(attempts int, err error) bool, opts ...RetryOption) CtxFuncError {
+	return func(ctx context.Context) error {
+		return retry(ctx, tryAgain, opts, func(ctx context.Context) error {
+			return f(ctx)
+		})
 	}
 }
 
-// Must returns a FuncValue that will panic if the CtxFuncResult returns an error.
+// Must returns a CtxFunc that will panic if the CtxFuncError returns an error.
 func (f CtxFuncError) Must() CtxFunc {
 	return func(ctx context.Context) {
 		err := f(ctx)
@@ -80,7 +161,7 @@ func (f CtxFuncError) Must() CtxFunc {
 	}
 }
 
-// OnErr returns a CtxFuncResult that will wrap the error returned by the CtxFuncResult
+// OnErr returns a CtxFuncError that will wrap the error returned by the CtxFuncError
 // with the provided message.
 func (f CtxFuncError) OnErr(msg string) CtxFuncError {
 	return func(ctx context.Context) error {
@@ -91,3 +172,61 @@ func (f CtxFuncError) OnErr(msg string) CtxFuncError {
 		return nil
 	}
 }
+
+// Once returns a CtxFuncError that only executes the first time it is called
+// and returns the cached error afterwards, along with the Once controlling it.
+// Use OnceRetryOnError or OnceBackoff to only cache a success.
+// The shared execution does not inherit the cancellation of the caller's
+// context: a caller whose context is done stops waiting and gets ctx.Err(),
+// while the execution carries on for the other callers.
+func (f CtxFuncError) Once(opts ...OnceOption) (CtxFuncError, *Once) {
+	o := newOnce(opts...)
+	return func(ctx context.Context) error {
+		_, err := o.do(ctx, true, func() (any, error) {
+			ctx := context.WithoutCancel(ctx)
+			return nil, f(ctx)
+		})
+		return err
+	}, o
+}
+
+// Lazy returns a CtxFuncError that executes until it succeeds once, along
+// with the Once controlling it.
+// Failures are not cached, unless a backoff is provided with OnceBackoff.
+func (f CtxFuncError) Lazy(opts ...OnceOption) (CtxFuncError, *Once) {
+	o := newLazy(opts...)
+	return func(ctx context.Context) error {
+		_, err := o.do(ctx, true, func() (any, error) {
+			ctx := context.WithoutCancel(ctx)
+			return nil, f(ctx)
+		})
+		return err
+	}, o
+}
+
+// Every returns a runner that calls the CtxFuncError every interval until
+// its context is done, in which case the runner returns nil.
+// The runner only returns an error when EveryMaxConsecutiveErrors is
+// provided and reached.
+// Every panics if interval is not positive, like time.NewTicker.
+func (f CtxFuncError) Every(interval time.Duration, opts ...EveryOption) CtxFuncError {
+	checkEveryInterval(interval)
+	return func(ctx context.Context) error {
+		return every(ctx, interval, opts, func() error {
+			return f(ctx)
+		})
+	}
+}
+
+// InjectFaults returns a CtxFuncError injecting the errors, panics and latency
+// configured by cfg in its calls, to test how the callers cope with them.
+// See FaultConfig, and DisableFaults for the global kill-switch.
+func (f CtxFuncError) InjectFaults(cfg FaultConfig) CtxFuncError {
+	fi := newFaultInjector(cfg)
+	return func(ctx context.Context) error {
+		if err := fi.inject(ctx); err != nil {
+			return err
+		}
+		return f(ctx)
+	}
+}
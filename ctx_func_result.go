@@ -1,3 +1,5 @@
+// Code generated by powerfunc/generator from ctx_func_result.go.tmpl. DO NOT EDIT.
+
 package powerfunc
 
 import (
@@ -13,10 +15,15 @@ func (f CtxFuncResult[R]) Exec(ctx context.Context) (R, error) {
 }
 
 func (f CtxFuncResult[R]) Timing(loggers ...func(d time.Duration)) CtxFuncResult[R] {
+	return f.TimingWithClock(RealClock(), loggers...)
+}
+
+// TimingWithClock is like Timing, but measures the execution time with clock.
+func (f CtxFuncResult[R]) TimingWithClock(clock Clock, loggers ...func(d time.Duration)) CtxFuncResult[R] {
 	return func(ctx context.Context) (R, error) {
-		start := time.Now()
+		start := clock.Now()
 		defer func() {
-			dur := time.Since(start)
+			dur := clock.Since(start)
 			for _, logger := range loggers {
 				logger(dur)
 			}
@@ -53,25 +60,103 @@ func (f CtxFuncResult[R]) WithCancel() CtxFuncResult[R] {
 	}
 }
 
-// Retry returns a Function that will retry the Function until it returns
-// a nil error or the tryAgain function returns false.
-func (f CtxFuncResult[R]) Retry(tryAgain func(attempts int, err error) bool) CtxFuncResult[R] {
+// WithValue returns a CtxFuncResult calling the CtxFuncResult with a context
+// carrying val for key, see context.WithValue.
+func (f CtxFuncResult[R]) WithValue(key, val any) CtxFuncResult[R] {
 	return func(ctx context.Context) (R, error) {
-		var v R
-		var err error
-		attempts := 1
-		for {
-			v, err = f(ctx)
-			if err != nil && tryAgain(attempts, err) {
-				break
-			}
-			attempts++
+		ctx = context.WithValue(ctx, key, val)
+		return f(ctx)
+	}
+}
+
+// WithValueFrom returns a CtxFuncResult calling the CtxFuncResult with a context
+// carrying the value returned by value for its key. value gets the arguments
+// of every call.
+func (f CtxFuncResult[R]) WithValueFrom(value func(ctx context.Context) (key, val any)) CtxFuncResult[R] {
+	return func(ctx context.Context) (R, error) {
+		key, val := value(ctx)
+		ctx = context.WithValue(ctx, key, val)
+		return f(ctx)
+	}
+}
+
+// Detached returns a CtxFuncResult calling the CtxFuncResult with a context that is
+// never cancelled but keeps the values of the caller's context, see
+// context.WithoutCancel. Useful for cleanups that must run to completion.
+func (f CtxFuncResult[R]) Detached() CtxFuncResult[R] {
+	return func(ctx context.Context) (R, error) {
+		ctx = context.WithoutCancel(ctx)
+		return f(ctx)
+	}
+}
+
+// WithTimeoutCause is like WithTimeout, but the context is cancelled with
+// cause when the timeout expires, see context.WithTimeoutCause.
+// When the CtxFuncResult then fails with the error of the context, the returned
+// error wraps cause as well, to tell which timeout expired.
+func (f CtxFuncResult[R]) WithTimeoutCause(timeout time.Duration, cause error) CtxFuncResult[R] {
+	return func(ctx context.Context) (R, error) {
+		ctx, cancel := context.WithTimeoutCause(ctx, timeout, cause)
+		defer cancel()
+		r0, err := f(ctx)
+		return r0, withCause(ctx, err)
+	}
+}
+
+// WithDeadlineCause is like WithDeadline, but the context is cancelled with
+// cause when the deadline expires, see context.WithDeadlineCause.
+// When the CtxFuncResult then fails with the error of the context, the returned
+// error wraps cause as well, to tell which deadline expired.
+func (f CtxFuncResult[R]) WithDeadlineCause(deadline time.Time, cause error) CtxFuncResult[R] {
+	return func(ctx context.Context) (R, error) {
+		ctx, cancel := context.WithDeadlineCause(ctx, deadline, cause)
+		defer cancel()
+		r0, err := f(ctx)
+		return r0, withCause(ctx, err)
+	}
+}
+
+// WithBudget returns a CtxFuncResult giving the CtxFuncResult a fraction of the
+// remaining deadline of the caller, capped at max when max is positive,
+// instead of a fixed timeout. Without a deadline, the CtxFuncResult gets max, or
+// no timeout if max is not positive.
+// When the share is below min, the CtxFuncResult is not called and a
+// *BudgetError, matching ErrBudgetExhausted, is returned.
+func (f CtxFuncResult[R]) WithBudget(fraction float64, min, max time.Duration) CtxFuncResult[R] {
+	return func(ctx context.Context) (R, error) {
+		timeout, bounded, err := budget(ctx, fraction, min, max)
+		if err != nil {
+			var r0 R
+			return r0, err
 		}
-		return v, err
+		if bounded {
+			var cancel context.CancelFunc
+			ctx, cancel = context.WithTimeout(ctx, timeout)
+			defer cancel()
+		}
+		return f(ctx)
 	}
 }
 
-// Must returns a FuncValue that will panic if the CtxFuncResult returns an error.
+// Retry returns a CtxFuncResult that will retry the CtxFuncResult until it returns
+// a nil error or the tryAgain function returns false, in which case it
+// returns a *RetryError holding the errors of all the attempts.
+// The options set the backoff between attempts and hooks, e.g. OnRetry.
+// RetryAttemptTimeout gives every attempt its own deadline within the one of
+// the caller.
+func (f CtxFuncResult[R]) Retry(tryAgain func(attempts int, err error) bool, opts ...RetryOption) CtxFuncResult[R] {
+	return func(ctx context.Context) (R, error) {
+		var r0 R
+		err := retry(ctx, tryAgain, opts, func(ctx context.Context) error {
+			var err error
+			r0, err = f(ctx)
+			return err
+		})
+		return r0, err
+	}
+}
+
+// Must returns a CtxFuncValue that will panic if the CtxFuncResult returns an error.
 func (f CtxFuncResult[R]) Must() CtxFuncValue[R] {
 	return func(ctx context.Context) R {
 		v, err := f(ctx)
@@ -118,7 +203,7 @@ func (f CtxFuncResult[R]) MapErr(fn func(error) error) CtxFuncResult[R] {
 	}
 }
 
-// Fallback returns a FuncValue that will return the provided value if the
+// Fallback returns a CtxFuncValue that will return the provided value if the
 // CtxFuncResult returns an error.
 func (f CtxFuncResult[R]) Fallback(val R) CtxFuncValue[R] {
 	return func(ctx context.Context) R {
@@ -129,3 +214,69 @@ func (f CtxFuncResult[R]) Fallback(val R) CtxFuncValue[R] {
 		return v
 	}
 }
+
+// Once returns a CtxFuncResult that only executes the first time it is called
+// and returns the cached result afterwards, along with the Once controlling it.
+// Use OnceRetryOnError or OnceBackoff to only cache a success.
+// The shared execution does not inherit the cancellation of the caller's
+// context: a caller whose context is done stops waiting and gets ctx.Err(),
+// while the execution carries on for the other callers.
+func (f CtxFuncResult[R]) Once(opts ...OnceOption) (CtxFuncResult[R], *Once) {
+	o := newOnce(opts...)
+	return func(ctx context.Context) (R, error) {
+		v, err := o.do(ctx, true, func() (any, error) {
+			ctx := context.WithoutCancel(ctx)
+			return f(ctx)
+		})
+		val, _ := v.(R)
+		return val, err
+	}, o
+}
+
+// Lazy returns a CtxFuncResult that executes until it succeeds once and
+// returns the cached value afterwards, along with the Once controlling it.
+// Failures are not cached, unless a backoff is provided with OnceBackoff.
+func (f CtxFuncResult[R]) Lazy(opts ...OnceOption) (CtxFuncResult[R], *Once) {
+	o := newLazy(opts...)
+	return func(ctx context.Context) (R, error) {
+		v, err := o.do(ctx, true, func() (any, error) {
+			ctx := context.WithoutCancel(ctx)
+			return f(ctx)
+		})
+		val, _ := v.(R)
+		return val, err
+	}, o
+}
+
+// PollUntil returns a CtxFuncResult calling the CtxFuncResult every interval
+// until the returned value satisfies cond.
+// When the context is done or the maximum number of attempts is reached
+// first, it returns the last value and a *PollTimeoutError.
+func (f CtxFuncResult[R]) PollUntil(cond func(R) bool, interval time.Duration, opts ...PollOption) CtxFuncResult[R] {
+	return func(ctx context.Context) (R, error) {
+		var v R
+		err := poll(ctx, interval, opts, func() (bool, error) {
+			var err error
+			v, err = f(ctx)
+			if err != nil {
+				return false, err
+			}
+			return cond(v), nil
+		})
+		return v, err
+	}
+}
+
+// InjectFaults returns a CtxFuncResult injecting the errors, panics and latency
+// configured by cfg in its calls, to test how the callers cope with them.
+// See FaultConfig, and DisableFaults for the global kill-switch.
+func (f CtxFuncResult[R]) InjectFaults(cfg FaultConfig) CtxFuncResult[R] {
+	fi := newFaultInjector(cfg)
+	return func(ctx context.Context) (R, error) {
+		if err := fi.inject(ctx); err != nil {
+			var r0 R
+			return r0, err
+		}
+		return f(ctx)
+	}
+}
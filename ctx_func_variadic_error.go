@@ -0,0 +1,187 @@
+// Code generated by powerfunc/generator from ctx_func_variadic_error.go.tmpl. DO NOT EDIT.
+
+package powerfunc
+
+import (
+	"context"
+	"fmt"
+	"time"
+)
+
+type CtxFuncVariadicError[V any] func(ctx context.Context, xs ...V) error
+
+func (f CtxFuncVariadicError[V]) Exec(ctx context.Context, xs ...V) error {
+	return f(ctx, xs...)
+}
+
+func (f CtxFuncVariadicError[V]) Timing(loggers ...func(d time.Duration)) CtxFuncVariadicError[V] {
+	return f.TimingWithClock(RealClock(), loggers...)
+}
+
+// TimingWithClock is like Timing, but measures the execution time with clock.
+func (f CtxFuncVariadicError[V]) TimingWithClock(clock Clock, loggers ...func(d time.Duration)) CtxFuncVariadicError[V] {
+	return func(ctx context.Context, xs ...V) error {
+		start := clock.Now()
+		defer func() {
+			dur := clock.Since(start)
+			for _, logger := range loggers {
+				logger(dur)
+			}
+			if len(loggers) == 0 {
+				// Default logger
+				fmt.Println(dur)
+			}
+		}()
+		return f(ctx, xs...)
+	}
+}
+
+func (f CtxFuncVariadicError[V]) WithTimeout(timeout time.Duration) CtxFuncVariadicError[V] {
+	return func(ctx context.Context, xs ...V) error {
+		ctx, cancel := context.WithTimeout(ctx, timeout)
+		defer cancel()
+		return f(ctx, xs...)
+	}
+}
+
+func (f CtxFuncVariadicError[V]) WithDeadline(deadline time.Time) CtxFuncVariadicError[V] {
+	return func(ctx context.Context, xs ...V) error {
+		ctx, cancel := context.WithDeadline(ctx, deadline)
+		defer cancel()
+		return f(ctx, xs...)
+	}
+}
+
+func (f CtxFuncVariadicError[V]) WithCancel() CtxFuncVariadicError[V] {
+	return func(ctx context.Context, xs ...V) error {
+		ctx, cancel := context.WithCancel(ctx)
+		defer cancel()
+		return f(ctx, xs...)
+	}
+}
+
+// WithValue returns a CtxFuncVariadicError calling the CtxFuncVariadicError with a context
+// carrying val for key, see context.WithValue.
+func (f CtxFuncVariadicError[V]) WithValue(key, val any) CtxFuncVariadicError[V] {
+	return func(ctx context.Context, xs ...V) error {
+		ctx = context.WithValue(ctx, key, val)
+		return f(ctx, xs...)
+	}
+}
+
+// WithValueFrom returns a CtxFuncVariadicError calling the CtxFuncVariadicError with a context
+// carrying the value returned by value for its key. value gets the arguments
+// of every call.
+func (f CtxFuncVariadicError[V]) WithValueFrom(value func(ctx context.Context, xs ...V) (key, val any)) CtxFuncVariadicError[V] {
+	return func(ctx context.Context, xs ...V) error {
+		key, val := value(ctx, xs...)
+		ctx = context.WithValue(ctx, key, val)
+		return f(ctx, xs...)
+	}
+}
+
+// Detached returns a CtxFuncVariadicError calling the CtxFuncVariadicError with a context that is
+// never cancelled but keeps the values of the caller's context, see
+// context.WithoutCancel. Useful for cleanups that must run to completion.
+func (f CtxFuncVariadicError[V]) Detached() CtxFuncVariadicError[V] {
+	return func(ctx context.Context, xs ...V) error {
+		ctx = context.WithoutCancel(ctx)
+		return f(ctx, xs...)
+	}
+}
+
+// WithTimeoutCause is like WithTimeout, but the context is cancelled with
+// cause when the timeout expires, see context.WithTimeoutCause.
+// When the CtxFuncVariadicError then fails with the error of the context, the returned
+// error wraps cause as well, to tell which timeout expired.
+func (f CtxFuncVariadicError[V]) WithTimeoutCause(timeout time.Duration, cause error) CtxFuncVariadicError[V] {
+	return func(ctx context.Context, xs ...V) error {
+		ctx, cancel := context.WithTimeoutCause(ctx, timeout, cause)
+		defer cancel()
+		err := f(ctx, xs...)
+		return withCause(ctx, err)
+	}
+}
+
+// WithDeadlineCause is like WithDeadline, but the context is cancelled with
+// cause when the deadline expires, see context.WithDeadlineCause.
+// When the CtxFuncVariadicError then fails with the error of the context, the returned
+// error wraps cause as well, to tell which deadline expired.
+func (f CtxFuncVariadicError[V]) WithDeadlineCause(deadline time.Time, cause error) CtxFuncVariadicError[V] {
+	return func(ctx context.Context, xs ...V) error {
+		ctx, cancel := context.WithDeadlineCause(ctx, deadline, cause)
+		defer cancel()
+		err := f(ctx, xs...)
+		return withCause(ctx, err)
+	}
+}
+
+// WithBudget returns a CtxFuncVariadicError giving the CtxFuncVariadicError a fraction of the
+// remaining deadline of the caller, capped at max when max is positive,
+// instead of a fixed timeout. Without a deadline, the CtxFuncVariadicError gets max, or
+// no timeout if max is not positive.
+// When the share is below min, the CtxFuncVariadicError is not called and a
+// *BudgetError, matching ErrBudgetExhausted, is returned.
+func (f CtxFuncVariadicError[V]) WithBudget(fraction float64, min, max time.Duration) CtxFuncVariadicError[V] {
+	return func(ctx context.Context, xs ...V) error {
+		timeout, bounded, err := budget(ctx, fraction, min, max)
+		if err != nil {
+			return err
+		}
+		if bounded {
+			var cancel context.CancelFunc
+			ctx, cancel = context.WithTimeout(ctx, timeout)
+			defer cancel()
+		}
+		return f(ctx, xs...)
+	}
+}
+
+// Retry returns a CtxFuncVariadicError that will retry the CtxFuncVariadicError until it returns
+// a nil error or the tryAgain function returns false, in which case it
+// returns a *RetryError holding the errors of all the attempts.
+// The options set the backoff between attempts and hooks, e.g. OnRetry.
+// RetryAttemptTimeout gives every attempt its own deadline within the one of
+// the caller.
+func (f CtxFuncVariadicError[V]) Retry(tryAgain func(attempts int, err error) bool, opts ...RetryOption) CtxFuncVariadicError[V] {
+	return func(ctx context.Context, xs ...V) error {
+		return retry(ctx, tryAgain, opts, func(ctx context.Context) error {
+			return f(ctx, xs...)
+		})
+	}
+}
+
+// OnErr returns a CtxFuncVariadicError that will wrap the error with the
+// provided message.
+func (f CtxFuncVariadicError[V]) OnErr(msg string) CtxFuncVariadicError[V] {
+	return func(ctx context.Context, xs ...V) error {
+		err := f(ctx, xs...)
+		if err != nil {
+			return fmt.Errorf("%s: %w", msg, err)
+		}
+		return nil
+	}
+}
+
+// CurryVariadic returns a CtxFuncVariadicError with some of its variadic
+// arguments bound. The bound arguments come before the ones passed to the
+// returned function.
+func (f CtxFuncVariadicError[V]) CurryVariadic(bound ...V) CtxFuncVariadicError[V] {
+	return func(ctx context.Context, xs ...V) error {
+		xs = append(append(make([]V, 0, len(bound)+len(xs)), bound...), xs...)
+		return f(ctx, xs...)
+	}
+}
+
+// InjectFaults returns a CtxFuncVariadicError injecting the errors, panics and latency
+// configured by cfg in its calls, to test how the callers cope with them.
+// See FaultConfig, and DisableFaults for the global kill-switch.
+func (f CtxFuncVariadicError[V]) InjectFaults(cfg FaultConfig) CtxFuncVariadicError[V] {
+	fi := newFaultInjector(cfg)
+	return func(ctx context.Context, xs ...V) error {
+		if err := fi.inject(ctx, xs); err != nil {
+			return err
+		}
+		return f(ctx, xs...)
+	}
+}
@@ -0,0 +1,193 @@
+// Code generated by powerfunc/generator from ctx_func_variadic_result.go.tmpl. DO NOT EDIT.
+
+package powerfunc
+
+import (
+	"context"
+	"fmt"
+	"time"
+)
+
+type CtxFuncVariadicResult[R, V any] func(ctx context.Context, xs ...V) (R, error)
+
+func (f CtxFuncVariadicResult[R, V]) Exec(ctx context.Context, xs ...V) (R, error) {
+	return f(ctx, xs...)
+}
+
+func (f CtxFuncVariadicResult[R, V]) Timing(loggers ...func(d time.Duration)) CtxFuncVariadicResult[R, V] {
+	return f.TimingWithClock(RealClock(), loggers...)
+}
+
+// TimingWithClock is like Timing, but measures the execution time with clock.
+func (f CtxFuncVariadicResult[R, V]) TimingWithClock(clock Clock, loggers ...func(d time.Duration)) CtxFuncVariadicResult[R, V] {
+	return func(ctx context.Context, xs ...V) (R, error) {
+		start := clock.Now()
+		defer func() {
+			dur := clock.Since(start)
+			for _, logger := range loggers {
+				logger(dur)
+			}
+			if len(loggers) == 0 {
+				// Default logger
+				fmt.Println(dur)
+			}
+		}()
+		return f(ctx, xs...)
+	}
+}
+
+func (f CtxFuncVariadicResult[R, V]) WithTimeout(timeout time.Duration) CtxFuncVariadicResult[R, V] {
+	return func(ctx context.Context, xs ...V) (R, error) {
+		ctx, cancel := context.WithTimeout(ctx, timeout)
+		defer cancel()
+		return f(ctx, xs...)
+	}
+}
+
+func (f CtxFuncVariadicResult[R, V]) WithDeadline(deadline time.Time) CtxFuncVariadicResult[R, V] {
+	return func(ctx context.Context, xs ...V) (R, error) {
+		ctx, cancel := context.WithDeadline(ctx, deadline)
+		defer cancel()
+		return f(ctx, xs...)
+	}
+}
+
+func (f CtxFuncVariadicResult[R, V]) WithCancel() CtxFuncVariadicResult[R, V] {
+	return func(ctx context.Context, xs ...V) (R, error) {
+		ctx, cancel := context.WithCancel(ctx)
+		defer cancel()
+		return f(ctx, xs...)
+	}
+}
+
+// WithValue returns a CtxFuncVariadicResult calling the CtxFuncVariadicResult with a context
+// carrying val for key, see context.WithValue.
+func (f CtxFuncVariadicResult[R, V]) WithValue(key, val any) CtxFuncVariadicResult[R, V] {
+	return func(ctx context.Context, xs ...V) (R, error) {
+		ctx = context.WithValue(ctx, key, val)
+		return f(ctx, xs...)
+	}
+}
+
+// WithValueFrom returns a CtxFuncVariadicResult calling the CtxFuncVariadicResult with a context
+// carrying the value returned by value for its key. value gets the arguments
+// of every call.
+func (f CtxFuncVariadicResult[R, V]) WithValueFrom(value func(ctx context.Context, xs ...V) (key, val any)) CtxFuncVariadicResult[R, V] {
+	return func(ctx context.Context, xs ...V) (R, error) {
+		key, val := value(ctx, xs...)
+		ctx = context.WithValue(ctx, key, val)
+		return f(ctx, xs...)
+	}
+}
+
+// Detached returns a CtxFuncVariadicResult calling the CtxFuncVariadicResult with a context that is
+// never cancelled but keeps the values of the caller's context, see
+// context.WithoutCancel. Useful for cleanups that must run to completion.
+func (f CtxFuncVariadicResult[R, V]) Detached() CtxFuncVariadicResult[R, V] {
+	return func(ctx context.Context, xs ...V) (R, error) {
+		ctx = context.WithoutCancel(ctx)
+		return f(ctx, xs...)
+	}
+}
+
+// WithTimeoutCause is like WithTimeout, but the context is cancelled with
+// cause when the timeout expires, see context.WithTimeoutCause.
+// When the CtxFuncVariadicResult then fails with the error of the context, the returned
+// error wraps cause as well, to tell which timeout expired.
+func (f CtxFuncVariadicResult[R, V]) WithTimeoutCause(timeout time.Duration, cause error) CtxFuncVariadicResult[R, V] {
+	return func(ctx context.Context, xs ...V) (R, error) {
+		ctx, cancel := context.WithTimeoutCause(ctx, timeout, cause)
+		defer cancel()
+		r0, err := f(ctx, xs...)
+		return r0, withCause(ctx, err)
+	}
+}
+
+// WithDeadlineCause is like WithDeadline, but the context is cancelled with
+// cause when the deadline expires, see context.WithDeadlineCause.
+// When the CtxFuncVariadicResult then fails with the error of the context, the returned
+// error wraps cause as well, to tell which deadline expired.
+func (f CtxFuncVariadicResult[R, V]) WithDeadlineCause(deadline time.Time, cause error) CtxFuncVariadicResult[R, V] {
+	return func(ctx context.Context, xs ...V) (R, error) {
+		ctx, cancel := context.WithDeadlineCause(ctx, deadline, cause)
+		defer cancel()
+		r0, err := f(ctx, xs...)
+		return r0, withCause(ctx, err)
+	}
+}
+
+// WithBudget returns a CtxFuncVariadicResult giving the CtxFuncVariadicResult a fraction of the
+// remaining deadline of the caller, capped at max when max is positive,
+// instead of a fixed timeout. Without a deadline, the CtxFuncVariadicResult gets max, or
+// no timeout if max is not positive.
+// When the share is below min, the CtxFuncVariadicResult is not called and a
+// *BudgetError, matching ErrBudgetExhausted, is returned.
+func (f CtxFuncVariadicResult[R, V]) WithBudget(fraction float64, min, max time.Duration) CtxFuncVariadicResult[R, V] {
+	return func(ctx context.Context, xs ...V) (R, error) {
+		timeout, bounded, err := budget(ctx, fraction, min, max)
+		if err != nil {
+			var r0 R
+			return r0, err
+		}
+		if bounded {
+			var cancel context.CancelFunc
+			ctx, cancel = context.WithTimeout(ctx, timeout)
+			defer cancel()
+		}
+		return f(ctx, xs...)
+	}
+}
+
+// Retry returns a CtxFuncVariadicResult that will retry the CtxFuncVariadicResult until it returns
+// a nil error or the tryAgain function returns false, in which case it
+// returns a *RetryError holding the errors of all the attempts.
+// The options set the backoff between attempts and hooks, e.g. OnRetry.
+// RetryAttemptTimeout gives every attempt its own deadline within the one of
+// the caller.
+func (f CtxFuncVariadicResult[R, V]) Retry(tryAgain func(attempts int, err error) bool, opts ...RetryOption) CtxFuncVariadicResult[R, V] {
+	return func(ctx context.Context, xs ...V) (R, error) {
+		var r0 R
+		err := retry(ctx, tryAgain, opts, func(ctx context.Context) error {
+			var err error
+			r0, err = f(ctx, xs...)
+			return err
+		})
+		return r0, err
+	}
+}
+
+// OnErr returns a CtxFuncVariadicResult that will wrap the error returned by
+// the CtxFuncVariadicResult with the provided message.
+func (f CtxFuncVariadicResult[R, V]) OnErr(msg string) CtxFuncVariadicResult[R, V] {
+	return func(ctx context.Context, xs ...V) (R, error) {
+		v, err := f(ctx, xs...)
+		if err != nil {
+			return v, fmt.Errorf("%s: %w", msg, err)
+		}
+		return v, nil
+	}
+}
+
+// CurryVariadic returns a CtxFuncVariadicResult with some of its variadic
+// arguments bound. The bound arguments come before the ones passed to the
+// returned function.
+func (f CtxFuncVariadicResult[R, V]) CurryVariadic(bound ...V) CtxFuncVariadicResult[R, V] {
+	return func(ctx context.Context, xs ...V) (R, error) {
+		xs = append(append(make([]V, 0, len(bound)+len(xs)), bound...), xs...)
+		return f(ctx, xs...)
+	}
+}
+
+// InjectFaults returns a CtxFuncVariadicResult injecting the errors, panics and latency
+// configured by cfg in its calls, to test how the callers cope with them.
+// See FaultConfig, and DisableFaults for the global kill-switch.
+func (f CtxFuncVariadicResult[R, V]) InjectFaults(cfg FaultConfig) CtxFuncVariadicResult[R, V] {
+	fi := newFaultInjector(cfg)
+	return func(ctx context.Context, xs ...V) (R, error) {
+		if err := fi.inject(ctx, xs); err != nil {
+			var r0 R
+			return r0, err
+		}
+		return f(ctx, xs...)
+	}
+}
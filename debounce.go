@@ -0,0 +1,165 @@
+package powerfunc
+
+import (
+	"sync"
+	"time"
+)
+
+// DebounceOption configures a Debouncer.
+type DebounceOption func(d *Debouncer)
+
+// DebounceLeading makes the Debouncer invoke the function on the leading
+// edge of a burst of calls. Defaults to false.
+func DebounceLeading(leading bool) DebounceOption {
+	return func(d *Debouncer) {
+		d.leading = leading
+	}
+}
+
+// DebounceTrailing makes the Debouncer invoke the function on the trailing
+// edge of a burst of calls, once no call happened for the wait duration.
+// Defaults to true.
+func DebounceTrailing(trailing bool) DebounceOption {
+	return func(d *Debouncer) {
+		d.trailing = trailing
+	}
+}
+
+// DebounceMaxWait sets the maximum amount of time a pending call can be
+// delayed before it is invoked, even if calls keep coming in.
+// A zero duration (the default) means no maximum.
+func DebounceMaxWait(maxWait time.Duration) DebounceOption {
+	return func(d *Debouncer) {
+		d.maxWait = maxWait
+	}
+}
+
+// DebounceClock sets the clock of the Debouncer. Defaults to RealClock.
+func DebounceClock(clock Clock) DebounceOption {
+	return func(d *Debouncer) {
+		d.clock = clock
+	}
+}
+
+// Debouncer controls a debounced or throttled function.
+// It keeps the most recent call until it is invoked, flushed or cancelled.
+type Debouncer struct {
+	wait     time.Duration
+	maxWait  time.Duration
+	leading  bool
+	trailing bool
+	clock    Clock
+
+	mu         sync.Mutex
+	timer      Timer
+	pending    func()
+	lastCall   time.Time
+	lastInvoke time.Time
+}
+
+func newDebouncer(wait time.Duration, opts ...DebounceOption) *Debouncer {
+	d := &Debouncer{
+		wait:     wait,
+		trailing: true,
+		clock:    RealClock(),
+	}
+	for _, opt := range opts {
+		opt(d)
+	}
+	return d
+}
+
+func newThrottler(interval time.Duration, opts ...DebounceOption) *Debouncer {
+	opts = append([]DebounceOption{DebounceLeading(true), DebounceMaxWait(interval)}, opts...)
+	return newDebouncer(interval, opts...)
+}
+
+// Flush immediately invokes the pending call, if any.
+func (d *Debouncer) Flush() {
+	d.mu.Lock()
+	call := d.pending
+	d.pending = nil
+	if call != nil {
+		d.lastInvoke = d.clock.Now()
+	}
+	d.mu.Unlock()
+
+	if call != nil {
+		call()
+	}
+}
+
+// Cancel drops the pending call, if any, and resets the Debouncer.
+func (d *Debouncer) Cancel() {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	if d.timer != nil {
+		d.timer.Stop()
+		d.timer = nil
+	}
+	d.pending = nil
+}
+
+func (d *Debouncer) call(call func()) {
+	d.mu.Lock()
+	now := d.clock.Now()
+	d.pending = call
+	d.lastCall = now
+	if d.timer != nil {
+		d.mu.Unlock()
+		return
+	}
+
+	// First call of a burst.
+	d.lastInvoke = now
+	d.timer = d.clock.AfterFunc(d.wait, d.expire)
+	if !d.leading {
+		d.mu.Unlock()
+		return
+	}
+	d.pending = nil
+	d.mu.Unlock()
+
+	call()
+}
+
+func (d *Debouncer) expire() {
+	d.mu.Lock()
+	if d.timer == nil {
+		// Cancelled while the timer was firing.
+		d.mu.Unlock()
+		return
+	}
+
+	now := d.clock.Now()
+	quiet := now.Sub(d.lastCall)
+	sinceInvoke := now.Sub(d.lastInvoke)
+	maxed := d.maxWait > 0 && sinceInvoke >= d.maxWait
+
+	var call func()
+	switch {
+	case quiet >= d.wait:
+		// End of the burst.
+		if d.trailing {
+			call = d.pending
+		}
+		d.pending = nil
+		d.timer = nil
+	case maxed && d.pending != nil:
+		call = d.pending
+		d.pending = nil
+		d.lastInvoke = now
+		d.timer.Reset(d.wait)
+	default:
+		next := d.wait - quiet
+		if d.maxWait > 0 && d.pending != nil && d.maxWait-sinceInvoke < next {
+			next = d.maxWait - sinceInvoke
+		}
+		d.timer.Reset(next)
+	}
+	d.mu.Unlock()
+
+	if call != nil {
+		call()
+	}
+}
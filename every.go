@@ -0,0 +1,139 @@
+package powerfunc
+
+import (
+	"context"
+	"math/rand"
+	"time"
+)
+
+// EveryOption configures the runner returned by an Every method.
+type EveryOption func(e *everyConfig)
+
+type everyConfig struct {
+	jitter         time.Duration
+	initialDelay   time.Duration
+	queue          bool
+	fixedDelay     bool
+	maxConsecutive int
+	onError        func(err error)
+	clock          Clock
+}
+
+// EveryJitter adds a random duration in [0, jitter) to the wait before each
+// call, to avoid many runners firing in lockstep.
+func EveryJitter(jitter time.Duration) EveryOption {
+	return func(e *everyConfig) {
+		e.jitter = jitter
+	}
+}
+
+// EveryInitialDelay delays the first call. By default, the first call
+// happens as soon as the runner starts.
+func EveryInitialDelay(delay time.Duration) EveryOption {
+	return func(e *everyConfig) {
+		e.initialDelay = delay
+	}
+}
+
+// EveryQueue makes the runner catch up on the calls it missed while a call
+// was still running, by running them back-to-back.
+// By default, missed calls are skipped. Has no effect with EveryFixedDelay.
+func EveryQueue() EveryOption {
+	return func(e *everyConfig) {
+		e.queue = true
+	}
+}
+
+// EveryFixedDelay makes the runner wait for the interval between the end of
+// a call and the start of the next one.
+// By default, calls start at a fixed rate, every interval.
+func EveryFixedDelay() EveryOption {
+	return func(e *everyConfig) {
+		e.fixedDelay = true
+	}
+}
+
+// EveryMaxConsecutiveErrors stops the runner after n consecutive calls
+// returned an error. The runner then returns the last error.
+// By default, the runner never stops on errors.
+func EveryMaxConsecutiveErrors(n int) EveryOption {
+	return func(e *everyConfig) {
+		e.maxConsecutive = n
+	}
+}
+
+// EveryOnError registers a callback invoked with every error returned by
+// the function.
+func EveryOnError(onError func(err error)) EveryOption {
+	return func(e *everyConfig) {
+		e.onError = onError
+	}
+}
+
+// EveryClock sets the clock of the runner. Defaults to RealClock.
+func EveryClock(clock Clock) EveryOption {
+	return func(e *everyConfig) {
+		e.clock = clock
+	}
+}
+
+func checkEveryInterval(interval time.Duration) {
+	if interval <= 0 {
+		panic("powerfunc: non-positive interval for Every")
+	}
+}
+
+// every calls call every interval until ctx is done, in which case it
+// returns nil, or until the maximum number of consecutive errors is reached.
+func every(ctx context.Context, interval time.Duration, opts []EveryOption, call func() error) error {
+	cfg := everyConfig{clock: RealClock()}
+	for _, opt := range opts {
+		opt(&cfg)
+	}
+
+	next := cfg.clock.Now().Add(cfg.initialDelay)
+	consecutive := 0
+	for {
+		if !sleepUntil(ctx, cfg.clock, next.Add(cfg.jitterDuration())) {
+			return nil
+		}
+
+		err := call()
+		if err != nil {
+			consecutive++
+			if cfg.onError != nil {
+				cfg.onError(err)
+			}
+			if cfg.maxConsecutive > 0 && consecutive >= cfg.maxConsecutive {
+				return err
+			}
+		} else {
+			consecutive = 0
+		}
+
+		if ctx.Err() != nil {
+			return nil
+		}
+
+		now := cfg.clock.Now()
+		switch {
+		case cfg.fixedDelay:
+			next = now.Add(interval)
+		case cfg.queue:
+			next = next.Add(interval)
+		default:
+			next = next.Add(interval)
+			if next.Before(now) {
+				missed := now.Sub(next)/interval + 1
+				next = next.Add(missed * interval)
+			}
+		}
+	}
+}
+
+func (e *everyConfig) jitterDuration() time.Duration {
+	if e.jitter <= 0 {
+		return 0
+	}
+	return time.Duration(rand.Int63n(int64(e.jitter)))
+}
@@ -0,0 +1,144 @@
+package powerfunc
+
+import (
+	"context"
+	"errors"
+	"math/rand"
+	"sync"
+	"sync/atomic"
+	"time"
+)
+
+// ErrInjectedFault is the default error returned, and value panicked, by a
+// function decorated with InjectFaults.
+var ErrInjectedFault = errors.New("injected fault")
+
+// FaultConfig configures the faults injected by an InjectFaults method.
+// Every fault is drawn independently on every call: a call can be delayed
+// and then fail.
+type FaultConfig struct {
+	// ErrorRate is the probability, in [0, 1], of returning Err instead of
+	// calling the function.
+	ErrorRate float64
+	// Err is the injected error. Defaults to ErrInjectedFault.
+	Err error
+
+	// PanicRate is the probability, in [0, 1], of panicking with PanicValue
+	// instead of calling the function.
+	PanicRate float64
+	// PanicValue is the injected panic value. Defaults to ErrInjectedFault.
+	PanicValue any
+
+	// LatencyRate is the probability, in [0, 1], of waiting for Latency
+	// before the call. The wait is cut short, and the error of the context
+	// returned, if the context of the call is done first.
+	LatencyRate float64
+	Latency     time.Duration
+
+	// Rand is the source of the probabilities. Use a seeded source, e.g.
+	// rand.New(rand.NewSource(42)), to reproduce a run. The same source can
+	// be shared by several InjectFaults functions; it must not be used
+	// elsewhere while they are called.
+	// Defaults to a randomly seeded source.
+	Rand *rand.Rand
+
+	// Clock is the clock of the latency. Defaults to RealClock.
+	Clock Clock
+
+	// Match restricts the faults to the calls whose arguments, without the
+	// context, it accepts. The variadic arguments are a single slice.
+	// By default, faults are injected in every call.
+	Match func(args []any) bool
+
+	// OptIn restricts the faults to the calls whose context was enabled with
+	// WithFaults, e.g. for requests carrying a chaos header.
+	// Functions without a context never get faults with OptIn.
+	OptIn bool
+}
+
+var faultsDisabled atomic.Bool
+
+// DisableFaults is a global kill-switch turning off the faults of all the
+// InjectFaults functions, until EnableFaults is called.
+func DisableFaults() {
+	faultsDisabled.Store(true)
+}
+
+// EnableFaults turns the faults of the InjectFaults functions back on after
+// DisableFaults.
+func EnableFaults() {
+	faultsDisabled.Store(false)
+}
+
+type faultsKey struct{}
+
+// WithFaults returns a copy of ctx turning the faults on, or off, for the
+// calls of the InjectFaults functions using it.
+// Turning them on only matters for the functions configured with OptIn;
+// turning them off applies to every function.
+func WithFaults(ctx context.Context, enabled bool) context.Context {
+	return context.WithValue(ctx, faultsKey{}, enabled)
+}
+
+type faultInjector struct {
+	cfg FaultConfig
+}
+
+// randMu guards the draws of all the fault injectors, as a *rand.Rand is not
+// safe for concurrent use and the same one can be shared by several of them.
+var randMu sync.Mutex
+
+func newFaultInjector(cfg FaultConfig) *faultInjector {
+	if cfg.Err == nil {
+		cfg.Err = ErrInjectedFault
+	}
+	if cfg.PanicValue == nil {
+		cfg.PanicValue = ErrInjectedFault
+	}
+	if cfg.Clock == nil {
+		cfg.Clock = RealClock()
+	}
+	if cfg.Rand == nil {
+		cfg.Rand = rand.New(rand.NewSource(time.Now().UnixNano()))
+	}
+	return &faultInjector{cfg: cfg}
+}
+
+// inject injects the faults of a call: it waits, panics, or returns the error
+// to return instead of calling the function.
+func (fi *faultInjector) inject(ctx context.Context, args ...any) error {
+	if faultsDisabled.Load() {
+		return nil
+	}
+	enabled, set := ctx.Value(faultsKey{}).(bool)
+	if set && !enabled || !set && fi.cfg.OptIn {
+		return nil
+	}
+	if fi.cfg.Match != nil && !fi.cfg.Match(args) {
+		return nil
+	}
+
+	randMu.Lock()
+	delay := fi.draw(fi.cfg.LatencyRate)
+	panics := fi.draw(fi.cfg.PanicRate)
+	fails := fi.draw(fi.cfg.ErrorRate)
+	randMu.Unlock()
+
+	if delay && !sleepUntil(ctx, fi.cfg.Clock, fi.cfg.Clock.Now().Add(fi.cfg.Latency)) {
+		return ctx.Err()
+	}
+	if panics {
+		panic(fi.cfg.PanicValue)
+	}
+	if fails {
+		return fi.cfg.Err
+	}
+	return nil
+}
+
+// draw reports whether an event of probability rate happens.
+// Every rate is drawn, even zero ones, so the draws of a seeded source do not
+// depend on the configuration.
+func (fi *faultInjector) draw(rate float64) bool {
+	return fi.cfg.Rand.Float64() < rate
+}
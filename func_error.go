@@ -1,6 +1,9 @@
+// Code generated by powerfunc/generator from func_error.go.tmpl. DO NOT EDIT.
+
 package powerfunc
 
 import (
+	"context"
 	"fmt"
 	"time"
 )
@@ -15,13 +18,18 @@ func (f FuncError) Exec() error {
 	return f()
 }
 
-// Timing returns a Func that will log the execution time of the Func.
+// Timing returns a FuncError that will log the execution time of the FuncError.
 // If no loggers are provided, the default logger (fmt.Println) will be used.
 func (f FuncError) Timing(loggers ...func(d time.Duration)) FuncError {
+	return f.TimingWithClock(RealClock(), loggers...)
+}
+
+// TimingWithClock is like Timing, but measures the execution time with clock.
+func (f FuncError) TimingWithClock(clock Clock, loggers ...func(d time.Duration)) FuncError {
 	return func() error {
-		start := time.Now()
+		start := clock.Now()
 		defer func() {
-			dur := time.Since(start)
+			dur := clock.Since(start)
 			for _, logger := range loggers {
 				logger(dur)
 			}
@@ -34,20 +42,15 @@ func (f FuncError) Timing(loggers ...func(d time.Duration)) FuncError {
 	}
 }
 
-// Retry returns a Function that will retry the Function until it returns
-// a nil error or the tryAgain function returns false.
-func (f FuncError) Retry(tryAgain func(attempts int, err error) bool) FuncError {
+// Retry returns a FuncError that will retry the FuncError until it returns
+// a nil error or the tryAgain function returns false, in which case it
+// returns a *RetryError holding the errors of all the attempts.
+// The options set the backoff between attempts and hooks, e.g. OnRetry.
+func (f FuncError) Retry(tryAgain func(attempts int, err error) bool, opts ...RetryOption) FuncError {
 	return func() error {
-		var err error
-		attempts := 1
-		for {
-			err = f()
-			if err == nil || !tryAgain(attempts, err) {
-				break
-			}
-			attempts++
-		}
-		return err
+		return retryNoContext(tryAgain, opts, func() error {
+			return f()
+		})
 	}
 }
 
@@ -71,3 +74,62 @@ func (f FuncError) OnErr(msg string) FuncError {
 		return nil
 	}
 }
+
+// Once returns a FuncError that only executes the first time it is called
+// and returns the cached error afterwards, along with the Once controlling it.
+// Use OnceRetryOnError or OnceBackoff to only cache a success.
+func (f FuncError) Once(opts ...OnceOption) (FuncError, *Once) {
+	o := newOnce(opts...)
+	return func() error {
+		_, err := o.do(context.Background(), false, func() (any, error) {
+			return nil, f()
+		})
+		return err
+	}, o
+}
+
+// Lazy returns a FuncError that executes until it succeeds once, along
+// with the Once controlling it.
+// Failures are not cached, unless a backoff is provided with OnceBackoff.
+func (f FuncError) Lazy(opts ...OnceOption) (FuncError, *Once) {
+	o := newLazy(opts...)
+	return func() error {
+		_, err := o.do(context.Background(), false, func() (any, error) {
+			return nil, f()
+		})
+		return err
+	}, o
+}
+
+// InjectFaults returns a FuncError injecting the errors, panics and latency
+// configured by cfg in its calls, to test how the callers cope with them.
+// See FaultConfig, and DisableFaults for the global kill-switch.
+// The faults cannot be enabled per request with OptIn, as there is no
+// context.
+func (f FuncError) InjectFaults(cfg FaultConfig) FuncError {
+	fi := newFaultInjector(cfg)
+	return func() error {
+		if err := fi.inject(context.Background()); err != nil {
+			return err
+		}
+		return f()
+	}
+}
+
+// WithTimeout returns a FuncError that runs the FuncError in its own
+// goroutine and returns a *TimeoutError, matching ErrTimeout, if it does not
+// return within timeout. The goroutine is then abandoned: it keeps running
+// until the FuncError returns, and is counted by Abandoned.
+// Prefer the WithTimeout method of a function taking a context, which can
+// actually be cancelled.
+func (f FuncError) WithTimeout(timeout time.Duration, opts ...TimeoutOption) FuncError {
+	return func() error {
+		var err error
+		if terr := callWithTimeout(timeout, opts, func() {
+			err = f()
+		}); terr != nil {
+			return terr
+		}
+		return err
+	}
+}
@@ -1,28 +1,36 @@
+// Code generated by powerfunc/generator from func_result.go.tmpl. DO NOT EDIT.
+
 package powerfunc
 
 import (
+	"context"
 	"fmt"
 	"time"
 )
 
 // FuncResult is a function that takes 0 arguments and returns a
 // value and an error.
-type FuncResult[T any] func() (T, error)
+type FuncResult[R any] func() (R, error)
 
 // Exec executes the function.
 // Convenience method to remove some of the confusion that
 // `f.SomeMethod()()` can bring.
-func (f FuncResult[T]) Exec() (T, error) {
+func (f FuncResult[R]) Exec() (R, error) {
 	return f()
 }
 
-// Timing returns a Func that will log the execution time of the Func.
+// Timing returns a FuncResult that will log the execution time of the FuncResult.
 // If no loggers are provided, the default logger (fmt.Println) will be used.
-func (f FuncResult[T]) Timing(loggers ...func(d time.Duration)) FuncResult[T] {
-	return func() (T, error) {
-		start := time.Now()
+func (f FuncResult[R]) Timing(loggers ...func(d time.Duration)) FuncResult[R] {
+	return f.TimingWithClock(RealClock(), loggers...)
+}
+
+// TimingWithClock is like Timing, but measures the execution time with clock.
+func (f FuncResult[R]) TimingWithClock(clock Clock, loggers ...func(d time.Duration)) FuncResult[R] {
+	return func() (R, error) {
+		start := clock.Now()
 		defer func() {
-			dur := time.Since(start)
+			dur := clock.Since(start)
 			for _, logger := range loggers {
 				logger(dur)
 			}
@@ -35,27 +43,25 @@ func (f FuncResult[T]) Timing(loggers ...func(d time.Duration)) FuncResult[T] {
 	}
 }
 
-// Retry returns a Function that will retry the Function until it returns
-// a nil error or the tryAgain function returns false.
-func (f FuncResult[T]) Retry(tryAgain func(attempts int, err error) bool) FuncResult[T] {
-	return func() (T, error) {
-		var v T
-		var err error
-		attempts := 1
-		for {
-			v, err = f()
-			if err == nil || !tryAgain(attempts, err) {
-				break
-			}
-			attempts++
-		}
-		return v, err
+// Retry returns a FuncResult that will retry the FuncResult until it returns
+// a nil error or the tryAgain function returns false, in which case it
+// returns a *RetryError holding the errors of all the attempts.
+// The options set the backoff between attempts and hooks, e.g. OnRetry.
+func (f FuncResult[R]) Retry(tryAgain func(attempts int, err error) bool, opts ...RetryOption) FuncResult[R] {
+	return func() (R, error) {
+		var r0 R
+		err := retryNoContext(tryAgain, opts, func() error {
+			var err error
+			r0, err = f()
+			return err
+		})
+		return r0, err
 	}
 }
 
 // Must returns a FuncValue that will panic if the FuncResult returns an error.
-func (f FuncResult[T]) Must() FuncValue[T] {
-	return func() T {
+func (f FuncResult[R]) Must() FuncValue[R] {
+	return func() R {
 		v, err := f()
 		if err != nil {
 			panic(err)
@@ -66,8 +72,8 @@ func (f FuncResult[T]) Must() FuncValue[T] {
 
 // OnErr returns a FuncResult that will wrap the error returned by the FuncResult
 // with the provided message.
-func (f FuncResult[T]) OnErr(msg string) FuncResult[T] {
-	return func() (T, error) {
+func (f FuncResult[R]) OnErr(msg string) FuncResult[R] {
+	return func() (R, error) {
 		v, err := f()
 		if err != nil {
 			return v, fmt.Errorf("%s: %w", msg, err)
@@ -78,8 +84,8 @@ func (f FuncResult[T]) OnErr(msg string) FuncResult[T] {
 
 // Map applies the provided function to the value returned by the FuncResult,
 // if there is no error.
-func (f FuncResult[T]) Map(fn func(T) T) FuncResult[T] {
-	return func() (T, error) {
+func (f FuncResult[R]) Map(fn func(R) R) FuncResult[R] {
+	return func() (R, error) {
 		v, err := f()
 		if err != nil {
 			return v, err
@@ -90,8 +96,8 @@ func (f FuncResult[T]) Map(fn func(T) T) FuncResult[T] {
 
 // MapErr applies the provided function to the error returned by the FuncResult,
 // if there is an error.
-func (f FuncResult[T]) MapErr(fn func(error) error) FuncResult[T] {
-	return func() (T, error) {
+func (f FuncResult[R]) MapErr(fn func(error) error) FuncResult[R] {
+	return func() (R, error) {
 		v, err := f()
 		if err != nil {
 			return v, fn(err)
@@ -102,8 +108,8 @@ func (f FuncResult[T]) MapErr(fn func(error) error) FuncResult[T] {
 
 // Fallback returns a FuncValue that will return the provided value if the
 // FuncResult returns an error.
-func (f FuncResult[T]) Fallback(val T) FuncValue[T] {
-	return func() T {
+func (f FuncResult[R]) Fallback(val R) FuncValue[R] {
+	return func() R {
 		v, err := f()
 		if err != nil {
 			return val
@@ -111,3 +117,67 @@ func (f FuncResult[T]) Fallback(val T) FuncValue[T] {
 		return v
 	}
 }
+
+// Once returns a FuncResult that only executes the first time it is called
+// and returns the cached result afterwards, along with the Once controlling it.
+// Use OnceRetryOnError or OnceBackoff to only cache a success.
+func (f FuncResult[R]) Once(opts ...OnceOption) (FuncResult[R], *Once) {
+	o := newOnce(opts...)
+	return func() (R, error) {
+		v, err := o.do(context.Background(), false, func() (any, error) {
+			return f()
+		})
+		val, _ := v.(R)
+		return val, err
+	}, o
+}
+
+// Lazy returns a FuncResult that executes until it succeeds once and
+// returns the cached value afterwards, along with the Once controlling it.
+// Failures are not cached, unless a backoff is provided with OnceBackoff.
+func (f FuncResult[R]) Lazy(opts ...OnceOption) (FuncResult[R], *Once) {
+	o := newLazy(opts...)
+	return func() (R, error) {
+		v, err := o.do(context.Background(), false, func() (any, error) {
+			return f()
+		})
+		val, _ := v.(R)
+		return val, err
+	}, o
+}
+
+// InjectFaults returns a FuncResult injecting the errors, panics and latency
+// configured by cfg in its calls, to test how the callers cope with them.
+// See FaultConfig, and DisableFaults for the global kill-switch.
+// The faults cannot be enabled per request with OptIn, as there is no
+// context.
+func (f FuncResult[R]) InjectFaults(cfg FaultConfig) FuncResult[R] {
+	fi := newFaultInjector(cfg)
+	return func() (R, error) {
+		if err := fi.inject(context.Background()); err != nil {
+			var r0 R
+			return r0, err
+		}
+		return f()
+	}
+}
+
+// WithTimeout returns a FuncResult that runs the FuncResult in its own
+// goroutine and returns a *TimeoutError, matching ErrTimeout, if it does not
+// return within timeout. The goroutine is then abandoned: it keeps running
+// until the FuncResult returns, and is counted by Abandoned.
+// Prefer the WithTimeout method of a function taking a context, which can
+// actually be cancelled.
+func (f FuncResult[R]) WithTimeout(timeout time.Duration, opts ...TimeoutOption) FuncResult[R] {
+	return func() (R, error) {
+		var r0 R
+		var err error
+		if terr := callWithTimeout(timeout, opts, func() {
+			r0, err = f()
+		}); terr != nil {
+			var zero R
+			return zero, terr
+		}
+		return r0, err
+	}
+}
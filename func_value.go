@@ -1,27 +1,35 @@
+// Code generated by powerfunc/generator from func_value.go.tmpl. DO NOT EDIT.
+
 package powerfunc
 
 import (
+	"context"
 	"fmt"
 	"time"
 )
 
-// Func is a function that takes 0 arguments and returns a value.
-type FuncValue[T any] func() T
+// FuncValue is a function that takes 0 arguments and returns a value.
+type FuncValue[R any] func() R
 
 // Exec executes the function.
 // Convenience method to remove some of the confusion that
 // `f.SomeMethod()()` can bring.
-func (f FuncValue[T]) Exec() T {
+func (f FuncValue[R]) Exec() R {
 	return f()
 }
 
-// Timing returns a Func that will log the execution time of the Func.
+// Timing returns a FuncValue that will log the execution time of the FuncValue.
 // If no loggers are provided, the default logger (fmt.Println) will be used.
-func (f FuncValue[T]) Timing(loggers ...func(d time.Duration)) FuncValue[T] {
-	return func() T {
-		start := time.Now()
+func (f FuncValue[R]) Timing(loggers ...func(d time.Duration)) FuncValue[R] {
+	return f.TimingWithClock(RealClock(), loggers...)
+}
+
+// TimingWithClock is like Timing, but measures the execution time with clock.
+func (f FuncValue[R]) TimingWithClock(clock Clock, loggers ...func(d time.Duration)) FuncValue[R] {
+	return func() R {
+		start := clock.Now()
 		defer func() {
-			dur := time.Since(start)
+			dur := clock.Since(start)
 			for _, logger := range loggers {
 				logger(dur)
 			}
@@ -37,8 +45,54 @@ func (f FuncValue[T]) Timing(loggers ...func(d time.Duration)) FuncValue[T] {
 // Fallible transforms a FuncValue into a FuncResult.
 // The returned FuncResult will never return an error.
 // Useful when passing a FuncValue to a function that expects a FuncResult.
-func (f FuncValue[T]) Fallible() FuncResult[T] {
-	return func() (T, error) {
+func (f FuncValue[R]) Fallible() FuncResult[R] {
+	return func() (R, error) {
 		return f(), nil
 	}
 }
+
+// Once returns a FuncValue that only executes the first time it is called
+// and returns the cached value afterwards, along with the Once controlling it.
+func (f FuncValue[R]) Once(opts ...OnceOption) (FuncValue[R], *Once) {
+	o := newOnce(opts...)
+	return func() R {
+		v, _ := o.do(context.Background(), false, func() (any, error) {
+			return f(), nil
+		})
+		val, _ := v.(R)
+		return val
+	}, o
+}
+
+// Lazy returns a FuncValue that computes its value the first time it is
+// called and returns the cached value afterwards, along with the Once
+// controlling it.
+func (f FuncValue[R]) Lazy(opts ...OnceOption) (FuncValue[R], *Once) {
+	o := newLazy(opts...)
+	return func() R {
+		v, _ := o.do(context.Background(), false, func() (any, error) {
+			return f(), nil
+		})
+		val, _ := v.(R)
+		return val
+	}, o
+}
+
+// WithTimeout returns a FuncResult that runs the FuncValue in its own
+// goroutine and returns a *TimeoutError, matching ErrTimeout, if it does not
+// return within timeout. The goroutine is then abandoned: it keeps running
+// until the FuncValue returns, and is counted by Abandoned.
+// Prefer the WithTimeout method of a function taking a context, which can
+// actually be cancelled.
+func (f FuncValue[R]) WithTimeout(timeout time.Duration, opts ...TimeoutOption) FuncResult[R] {
+	return func() (R, error) {
+		var r0 R
+		if terr := callWithTimeout(timeout, opts, func() {
+			r0 = f()
+		}); terr != nil {
+			var zero R
+			return zero, terr
+		}
+		return r0, nil
+	}
+}
@@ -0,0 +1,7 @@
+//go:generate go run ./generator -arity 10
+
+package powerfunc
+
+// The function types and their methods are rendered from the templates in
+// generator/templates, for every family and arity: edit the templates and
+// run go generate rather than the generated files.
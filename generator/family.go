@@ -0,0 +1,356 @@
+package main
+
+import (
+	"fmt"
+	"strings"
+)
+
+// kind is a return shape of a function.
+type kind struct {
+	// name is the suffix of the type names, e.g. "Result" in "FuncResult".
+	// The kind of the functions returning nothing is named "None" and has no
+	// suffix.
+	name string
+	// typeParams are the type parameters of the kind, which come before the
+	// type parameters of the arguments.
+	typeParams []string
+	// results are the types of the return values of the functions.
+	results []string
+	// variadic kinds take a trailing `xs ...V` argument.
+	variadic bool
+}
+
+var kinds = []kind{
+	{name: "None"},
+	{name: "Error", results: []string{"error"}},
+	{name: "Value", typeParams: []string{"R"}, results: []string{"R"}},
+	{name: "Result", typeParams: []string{"R"}, results: []string{"R", "error"}},
+	{name: "Pair", typeParams: []string{"R1", "R2"}, results: []string{"R1", "R2"}},
+	{name: "PairResult", typeParams: []string{"R1", "R2"}, results: []string{"R1", "R2", "error"}},
+	{name: "Ok", typeParams: []string{"R"}, results: []string{"R", "bool"}},
+	{name: "VariadicError", typeParams: []string{"V"}, results: []string{"error"}, variadic: true},
+	{name: "VariadicResult", typeParams: []string{"R", "V"}, results: []string{"R", "error"}, variadic: true},
+}
+
+func (k kind) suffix() string {
+	if k.name == "None" {
+		return ""
+	}
+	return k.name
+}
+
+func findKind(name string) (kind, error) {
+	for _, k := range kinds {
+		if k.name == name {
+			return k, nil
+		}
+	}
+	return kind{}, fmt.Errorf("unknown kind %q", name)
+}
+
+// family is a kind of function, with or without a leading context.
+type family struct {
+	kind kind
+	ctx  bool
+}
+
+func allFamilies() []family {
+	var families []family
+	for _, ctx := range []bool{false, true} {
+		for _, k := range kinds {
+			families = append(families, family{kind: k, ctx: ctx})
+		}
+	}
+	return families
+}
+
+// name returns the name of the type of the family with no argument,
+// e.g. "CtxFuncResult".
+func (f family) name() string {
+	return f.prefix() + "Func" + f.kind.suffix()
+}
+
+// file returns the base name of the files of the family, e.g.
+// "ctx_func_result".
+func (f family) file() string {
+	var b strings.Builder
+	if f.ctx {
+		b.WriteString("ctx_")
+	}
+	b.WriteString("func")
+	for _, r := range f.kind.suffix() {
+		if r >= 'A' && r <= 'Z' {
+			b.WriteByte('_')
+			r += 'a' - 'A'
+		}
+		b.WriteRune(r)
+	}
+	return b.String()
+}
+
+func (f family) prefix() string {
+	if f.ctx {
+		return "Ctx"
+	}
+	return ""
+}
+
+// Data is the data passed to the templates: a family at a given arity.
+type Data struct {
+	// Package is the name of the package of the generated files.
+	Package string
+	// Qualifier is the name of the package of the powerfunc types, if the
+	// files are generated outside of it.
+	Qualifier string
+
+	family family
+	arity  int
+}
+
+// Kind returns the name of the kind of the family, e.g. "Result".
+func (d Data) Kind() string {
+	return d.family.kind.name
+}
+
+// Ctx reports whether the functions take a leading context.
+func (d Data) Ctx() bool {
+	return d.family.ctx
+}
+
+// Variadic reports whether the functions take a trailing `xs ...V` argument.
+func (d Data) Variadic() bool {
+	return d.family.kind.variadic
+}
+
+// Arity returns the number of arguments, besides the context and the
+// variadic arguments.
+func (d Data) Arity() int {
+	return d.arity
+}
+
+// Name returns the name of the type, e.g. "CtxFunc2Result".
+func (d Data) Name() string {
+	name := d.family.prefix() + "Func"
+	if d.arity > 0 {
+		name += fmt.Sprint(d.arity)
+	}
+	return name + d.family.kind.suffix()
+}
+
+// TypeParams returns the type parameters of the type, e.g. "[R, P0, P1]",
+// or an empty string if it has none.
+func (d Data) TypeParams() string {
+	params := d.typeParams()
+	if len(params) == 0 {
+		return ""
+	}
+	return "[" + strings.Join(params, ", ") + "]"
+}
+
+// TypeParamsDecl returns the declaration of the type parameters of the
+// type, e.g. "[R, P0, P1 any]", or an empty string if it has none.
+func (d Data) TypeParamsDecl() string {
+	params := d.typeParams()
+	if len(params) == 0 {
+		return ""
+	}
+	return "[" + strings.Join(params, ", ") + " any]"
+}
+
+func (d Data) typeParams() []string {
+	params := append([]string{}, d.family.kind.typeParams...)
+	for i := 0; i < d.arity; i++ {
+		params = append(params, fmt.Sprintf("P%d", i))
+	}
+	return params
+}
+
+// Type returns the instantiated type, e.g. "CtxFunc2Result[R, P0, P1]",
+// qualified by the Qualifier.
+func (d Data) Type() string {
+	return d.qualify(d.Name()) + d.TypeParams()
+}
+
+// TypeDecl returns the type with the declaration of its type parameters,
+// e.g. "CtxFunc2Result[R, P0, P1 any]", to declare it.
+func (d Data) TypeDecl() string {
+	return d.Name() + d.TypeParamsDecl()
+}
+
+func (d Data) qualify(name string) string {
+	if d.Qualifier == "" {
+		return name
+	}
+	return d.Qualifier + "." + name
+}
+
+// Params returns the declaration of the arguments of the functions, e.g.
+// "ctx context.Context, p0 P0, p1 P1".
+func (d Data) Params() string {
+	return strings.Join(d.params(0), ", ")
+}
+
+func (d Data) params(from int) []string {
+	var params []string
+	if d.family.ctx {
+		params = append(params, "ctx context.Context")
+	}
+	for i := from; i < d.arity; i++ {
+		params = append(params, fmt.Sprintf("p%d P%d", i, i))
+	}
+	if d.family.kind.variadic {
+		params = append(params, "xs ...V")
+	}
+	return params
+}
+
+// Args returns the arguments of the functions to call them, e.g.
+// "ctx, p0, p1".
+func (d Data) Args() string {
+	var args []string
+	if d.family.ctx {
+		args = append(args, "ctx")
+	}
+	for i := 0; i < d.arity; i++ {
+		args = append(args, fmt.Sprintf("p%d", i))
+	}
+	if d.family.kind.variadic {
+		args = append(args, "xs...")
+	}
+	return strings.Join(args, ", ")
+}
+
+// Values returns the arguments of the functions without the context, e.g.
+// "p0, p1, xs". The variadic arguments are a single slice.
+func (d Data) Values() string {
+	var values []string
+	for i := 0; i < d.arity; i++ {
+		values = append(values, fmt.Sprintf("p%d", i))
+	}
+	if d.family.kind.variadic {
+		values = append(values, "xs")
+	}
+	return strings.Join(values, ", ")
+}
+
+// Ret returns the return values of the functions, e.g. "(R, error)".
+func (d Data) Ret() string {
+	results := d.family.kind.results
+	if len(results) == 1 {
+		return results[0]
+	}
+	if len(results) == 0 {
+		return ""
+	}
+	return "(" + strings.Join(results, ", ") + ")"
+}
+
+// HasErr reports whether the last return value of the functions is an error.
+func (d Data) HasErr() bool {
+	results := d.family.kind.results
+	return len(results) > 0 && results[len(results)-1] == "error"
+}
+
+// Result is a return value of the functions.
+type Result struct {
+	// Name is the name of the variable holding the value, e.g. "r0", or
+	// "err" for the error.
+	Name string
+	Type string
+}
+
+// Results returns the return values of the functions, e.g. to declare
+// variables holding them.
+func (d Data) Results() []Result {
+	var results []Result
+	for i, typ := range d.family.kind.results {
+		if typ == "error" {
+			results = append(results, Result{Name: "err", Type: typ})
+		} else {
+			results = append(results, Result{Name: fmt.Sprintf("r%d", i), Type: typ})
+		}
+	}
+	return results
+}
+
+// Vars returns the names of the variables of Results, e.g. "r0, err", to
+// assign them.
+func (d Data) Vars() string {
+	var vars []string
+	for _, r := range d.Results() {
+		vars = append(vars, r.Name)
+	}
+	return strings.Join(vars, ", ")
+}
+
+// Zero returns the zero values of the return values of the functions, with
+// err as the error, e.g. `{{.Zero "err"}}` gives "*new(R), err".
+func (d Data) Zero(err string) string {
+	var zero []string
+	for _, typ := range d.family.kind.results {
+		switch typ {
+		case "error":
+			zero = append(zero, err)
+		case "bool":
+			zero = append(zero, "false")
+		default:
+			zero = append(zero, "*new("+typ+")")
+		}
+	}
+	return strings.Join(zero, ", ")
+}
+
+// Family returns the data of another kind with the same arity and context,
+// e.g. `{{(.Family "Value").Type}}` in a Result template.
+func (d Data) Family(kindName string) (Data, error) {
+	k, err := findKind(kindName)
+	if err != nil {
+		return Data{}, err
+	}
+	other := d
+	other.family = family{kind: k, ctx: d.family.ctx}
+	return other, nil
+}
+
+// Curry is a CurryN method, binding the first N arguments of a function.
+type Curry struct {
+	// N is the number of bound arguments.
+	N int
+	// Bound is the declaration of the bound arguments.
+	Bound string
+	// Target is the type of the returned function.
+	Target string
+	// Params is the declaration of the arguments of the returned function.
+	Params string
+}
+
+// Curries returns the CurryN methods of the type, from binding all the
+// arguments to binding only the first one.
+func (d Data) Curries() []Curry {
+	var curries []Curry
+	for n := d.arity; n > 0; n-- {
+		var bound []string
+		for i := 0; i < n; i++ {
+			bound = append(bound, fmt.Sprintf("p%d P%d", i, i))
+		}
+
+		target := d
+		target.arity = d.arity - n
+		targetParams := append([]string{}, d.family.kind.typeParams...)
+		for i := n; i < d.arity; i++ {
+			targetParams = append(targetParams, fmt.Sprintf("P%d", i))
+		}
+		targetType := d.qualify(target.Name())
+		if len(targetParams) > 0 {
+			targetType += "[" + strings.Join(targetParams, ", ") + "]"
+		}
+
+		curries = append(curries, Curry{
+			N:      n,
+			Bound:  strings.Join(bound, ", "),
+			Target: targetType,
+			Params: strings.Join(d.params(n), ", "),
+		})
+	}
+	return curries
+}
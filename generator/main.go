@@ -1,220 +1,165 @@
+// Command generator renders the powerfunc templates for every function
+// family and arity.
+//
+// Every family is a return shape (no value, an error, a value, a value and
+// an error, ...) with or without a leading context. For each selected
+// family, each template is rendered once per arity, from 0 to -arity, and
+// the output is formatted with go/format.
+//
+// A template named after a family file (for instance `ctx_func_result.go.tmpl`)
+// is only rendered for that family, to `ctx_func_result.go` for arity 0 and
+// `<arity>_ctx_func_result.go` otherwise. Any other template (for instance
+// `metrics.go.tmpl`) is rendered for every selected family, to
+// `ctx_func_result_metrics.go` and `<arity>_ctx_func_result_metrics.go`.
+// Templates whose name starts with `_` are partials: they are available to
+// the other templates but not rendered on their own.
+//
+// A downstream module can generate an extension package with its own
+// decorators across all the shapes by pointing -templates to its own
+// templates, and -qualifier to the powerfunc package name:
+//
+//	go run github.com/jonathanmontane/powerfunc/generator \
+//		-templates ./templates -package metrics -qualifier powerfunc \
+//		-families CtxFuncError,CtxFuncResult
 package main
 
 import (
+	"bytes"
+	"embed"
 	"flag"
 	"fmt"
-	"io"
+	"go/format"
+	"io/fs"
 	"os"
-	"regexp"
+	"path/filepath"
 	"strings"
+	"text/template"
 )
 
-var flagArity = flag.Int("arity", 1, "arity")
+//go:embed all:templates
+var embedded embed.FS
+
+var (
+	flagArity     = flag.Int("arity", 1, "maximum arity to generate")
+	flagFamilies  = flag.String("families", "", "comma-separated families to generate, e.g. FuncResult,CtxFuncError (default all)")
+	flagTemplates = flag.String("templates", "", "directory of the templates (default the powerfunc templates)")
+	flagOut       = flag.String("out", ".", "output directory")
+	flagPackage   = flag.String("package", "powerfunc", "package name of the generated files")
+	flagQualifier = flag.String("qualifier", "", "package name qualifying the powerfunc types, when generating outside of powerfunc")
+)
 
 func main() {
 	flag.Parse()
 
-	returnTypes := []string{"None", "Error", "Result", "Value"}
-	maxArity := *flagArity
-
-	for _, ctx := range []bool{false, true} {
-		for _, returnType := range returnTypes {
-			for arity := 1; arity <= maxArity; arity++ {
-				err := GenerateFile(ctx, returnType, arity)
-				if err != nil {
-					panic(err)
-				}
-			}
-		}
-	}
-
-}
-
-func GenerateFile(ctx bool, returnType string, arity int) error {
-	var path string
-	if ctx {
-		path += "ctx_"
-	}
-	path += "func"
-	switch returnType {
-	case "None":
-		path += ".go"
-	case "Error":
-		path += "_error.go"
-	case "Result":
-		path += "_result.go"
-	case "Value":
-		path += "_value.go"
-	default:
-		return fmt.Errorf("invalid return type: %s", returnType)
-	}
-
-	fd, err := os.Open(path)
+	templates, err := loadTemplates()
 	if err != nil {
-		return err
+		fail(err)
 	}
-	defer fd.Close()
 
-	b, err := io.ReadAll(fd)
+	families, err := selectFamilies(*flagFamilies)
 	if err != nil {
-		return err
+		fail(err)
 	}
 
-	var arityCall strings.Builder
-	var arityDecl strings.Builder
-	var arityType strings.Builder
-	for i := 0; i < arity; i++ {
-		if i > 0 {
-			arityCall.WriteString(", ")
-			arityDecl.WriteString(", ")
-			arityType.WriteString(", ")
+	for _, family := range families {
+		for arity := 0; arity <= *flagArity; arity++ {
+			data := Data{
+				Package:   *flagPackage,
+				Qualifier: *flagQualifier,
+				family:    family,
+				arity:     arity,
+			}
+			if err := render(templates, data); err != nil {
+				fail(err)
+			}
 		}
-		arityCall.WriteString(fmt.Sprintf("p%d", i))
-		arityDecl.WriteString(fmt.Sprintf("p%d P%d", i, i))
-		arityType.WriteString(fmt.Sprintf("P%d", i))
-	}
-
-	augmented := regexp.MustCompile("Func([^t])").ReplaceAll(b, []byte(fmt.Sprintf("Func%d$1", arity)))
-	if ctx {
-		augmented = regexp.MustCompile(`f\(ctx\)`).ReplaceAll(augmented, []byte(fmt.Sprintf("f(ctx, %s)", arityCall.String())))
-		augmented = regexp.MustCompile(`\(ctx context.Context\)`).ReplaceAll(augmented, []byte(fmt.Sprintf("(ctx context.Context, %s)", arityDecl.String())))
-	} else {
-		augmented = regexp.MustCompile(`f\(\)`).ReplaceAll(augmented, []byte("f("+arityCall.String()+")"))
-		augmented = regexp.MustCompile(`(return func|Exec)\(\)`).ReplaceAll(augmented, []byte("${1}("+arityDecl.String()+")"))
-		augmented = regexp.MustCompile(`(type.*) func\(\)`).ReplaceAll(augmented, []byte("$1 func("+arityDecl.String()+")"))
-	}
-
-	switch returnType {
-	case "None", "Error":
-		augmented = regexp.MustCompile(`(f|\)) (Ctx|)Func([0-9]+)(Error|)`).ReplaceAll(augmented, []byte("${1} ${2}Func${3}${4}["+arityType.String()+"]"))
-		augmented = regexp.MustCompile(`type (Ctx|)Func([0-9]+)(Error|)`).ReplaceAll(augmented, []byte("type ${1}Func${2}${3}["+arityType.String()+" any]"))
-	case "Value", "Result":
-		augmented = regexp.MustCompile(`\[(R|T)\]`).ReplaceAll(augmented, []byte(fmt.Sprintf("[$1, %s]", arityType.String())))
-		augmented = regexp.MustCompile(`\[(R|T) any\]`).ReplaceAll(augmented, []byte(fmt.Sprintf("[$1, %s any]", arityType.String())))
-	}
-
-	augmented = addCurrying(augmented, ctx, returnType, arity)
-
-	newPath := fmt.Sprintf("%d_%s", arity, path)
-	err = os.WriteFile(newPath, augmented, 0644)
-	if err != nil {
-		panic(err)
 	}
+}
 
-	return nil
+func fail(err error) {
+	fmt.Fprintln(os.Stderr, "generator:", err)
+	os.Exit(1)
 }
 
-func addCurrying(b []byte, ctx bool, returnType string, arity int) []byte {
-	for i := 0; i < arity; i++ {
-		b = append(b, curryFromTo(ctx, returnType, arity, i)...)
+func loadTemplates() (*template.Template, error) {
+	var fsys fs.FS
+	if *flagTemplates != "" {
+		fsys = os.DirFS(*flagTemplates)
+	} else {
+		sub, err := fs.Sub(embedded, "templates")
+		if err != nil {
+			return nil, err
+		}
+		fsys = sub
 	}
-	return b
+	return template.New("").ParseFS(fsys, "*.tmpl")
 }
 
-func curryFromTo(ctx bool, returnType string, from, to int) []byte {
-	var arityCall strings.Builder
-	var arityDeclCurry strings.Builder
-	var arityTypeFrom strings.Builder
-
-	if returnType == "Result" || returnType == "Value" {
-		arityTypeFrom.WriteString("R, ")
+func selectFamilies(names string) ([]family, error) {
+	all := allFamilies()
+	if names == "" {
+		return all, nil
 	}
 
-	if ctx {
-		arityCall.WriteString("ctx, ")
+	byName := map[string]family{}
+	for _, f := range all {
+		byName[f.name()] = f
 	}
-
-	for i := 0; i < from-to; i++ {
-		if i > 0 {
-			arityCall.WriteString(", ")
-			arityDeclCurry.WriteString(", ")
-			arityTypeFrom.WriteString(", ")
+	var selected []family
+	for _, name := range strings.Split(names, ",") {
+		f, ok := byName[strings.TrimSpace(name)]
+		if !ok {
+			return nil, fmt.Errorf("unknown family %q", name)
 		}
-		arityCall.WriteString(fmt.Sprintf("p%d", i))
-		arityDeclCurry.WriteString(fmt.Sprintf("p%d P%d", i, i))
-		arityTypeFrom.WriteString(fmt.Sprintf("P%d", i))
+		selected = append(selected, f)
 	}
+	return selected, nil
+}
 
-	var arityDeclTo strings.Builder
-	var arityTypeTo strings.Builder
-
-	if returnType == "Result" || returnType == "Value" {
-		arityTypeTo.WriteString("R")
-		if to > 0 {
-			arityTypeTo.WriteString(", ")
+func render(templates *template.Template, data Data) error {
+	for _, tmpl := range templates.Templates() {
+		name := tmpl.Name()
+		if !strings.HasSuffix(name, ".go.tmpl") || strings.HasPrefix(name, "_") {
+			continue
 		}
-	}
-
-	if ctx {
-		arityDeclTo.WriteString("ctx context.Context")
-		if to > 0 {
-			arityDeclTo.WriteString(", ")
+		base := strings.TrimSuffix(name, ".go.tmpl")
+
+		var path string
+		switch {
+		case base == data.family.file():
+			path = data.family.file()
+		case isFamilyFile(base):
+			// Template of another family.
+			continue
+		default:
+			path = data.family.file() + "_" + base
 		}
-	}
-
-	for i := from - to; i < from; i++ {
-		arityCall.WriteString(", ")
-		arityTypeFrom.WriteString(", ")
-		if i > from-to {
-			arityDeclTo.WriteString(", ")
-			arityTypeTo.WriteString(", ")
+		if data.arity > 0 {
+			path = fmt.Sprintf("%d_%s", data.arity, path)
 		}
-		arityCall.WriteString(fmt.Sprintf("p%d", i))
-		arityDeclTo.WriteString(fmt.Sprintf("p%d P%d", i, i))
-		arityTypeFrom.WriteString(fmt.Sprintf("P%d", i))
-		arityTypeTo.WriteString(fmt.Sprintf("P%d", i))
-	}
-
-	var ctxPrefix string
-	if ctx {
-		ctxPrefix = "Ctx"
-	}
-	returnPrefix := returnType
-	if returnType == "None" {
-		returnPrefix = ""
-	}
+		path = filepath.Join(*flagOut, path+".go")
 
-	var toArityPrefix string
-	var toArityType string
-	if to > 0 {
-		toArityPrefix = fmt.Sprintf("%d", to)
-		toArityType = fmt.Sprintf("[%s]", arityTypeTo.String())
-	} else if returnType == "Result" || returnType == "Value" {
-		toArityType = fmt.Sprintf("[%s]", arityTypeTo.String())
-	}
-
-	var returnDecl string
-	switch returnType {
-	case "None":
-		returnDecl = ""
-	case "Error":
-		returnDecl = "error"
-	case "Value":
-		returnDecl = "R"
-	case "Result":
-		returnDecl = "(R, error)"
-	}
-
-	var call string
-	switch returnType {
-	case "None":
-		call = fmt.Sprintf("f(%s)", arityCall.String())
-	case "Error", "Value", "Result":
-		call = fmt.Sprintf("return f(%s)", arityCall.String())
-	}
-
-	tmpl := fmt.Sprintf(`
-
-func (f %sFunc%d%s[%s]) Curry%d(%s) %sFunc%s%s%s {
-	return func(%s) %s {
-		%s
+		var buf bytes.Buffer
+		fmt.Fprintf(&buf, "// Code generated by powerfunc/generator from %s. DO NOT EDIT.\n\n", name)
+		if err := tmpl.Execute(&buf, data); err != nil {
+			return err
+		}
+		src, err := format.Source(buf.Bytes())
+		if err != nil {
+			return fmt.Errorf("format %s: %w\n%s", path, err, buf.Bytes())
+		}
+		if err := os.WriteFile(path, src, 0644); err != nil {
+			return err
+		}
 	}
+	return nil
 }
-	`,
-		ctxPrefix, from, returnPrefix, arityTypeFrom.String(), from-to, arityDeclCurry.String(), ctxPrefix, toArityPrefix, returnPrefix, toArityType,
-		arityDeclTo.String(), returnDecl,
-		call,
-	)
 
-	return []byte(tmpl)
+func isFamilyFile(base string) bool {
+	for _, f := range allFamilies() {
+		if f.file() == base {
+			return true
+		}
+	}
+	return false
 }
@@ -0,0 +1,131 @@
+package powerfunc
+
+import (
+	"context"
+	"errors"
+	"sync"
+)
+
+// GroupOption configures a Group.
+type GroupOption func(g *Group)
+
+// GroupLimit limits the number of tasks running at the same time to n.
+// Go blocks until a slot is available. A limit of 0 or less means no limit.
+func GroupLimit(n int) GroupOption {
+	return func(g *Group) {
+		if n > 0 {
+			g.sem = make(chan struct{}, n)
+		}
+	}
+}
+
+// GroupCollectErrors makes Wait return all the errors of the tasks joined
+// with errors.Join, instead of the first one.
+// In this mode, a failing task does not cancel the context of the others.
+func GroupCollectErrors() GroupOption {
+	return func(g *Group) {
+		g.collect = true
+	}
+}
+
+// Group runs CtxFuncError tasks concurrently with a shared context.
+// By default, the first task to fail cancels the context of the others, and
+// its error is returned by Wait.
+// A panicking task is recovered and reported as a *PanicError.
+//
+// N-arity Ctx functions can be run by currying them down to a CtxFuncError:
+//
+//	g.Go(fetch.Curry2(client, id))
+type Group struct {
+	ctx     context.Context
+	cancel  context.CancelFunc
+	sem     chan struct{}
+	collect bool
+
+	wg   sync.WaitGroup
+	mu   sync.Mutex
+	errs []error
+}
+
+// NewGroup returns a Group, and the context passed to its tasks, derived
+// from ctx.
+func NewGroup(ctx context.Context, opts ...GroupOption) (*Group, context.Context) {
+	ctx, cancel := context.WithCancel(ctx)
+	g := &Group{
+		ctx:    ctx,
+		cancel: cancel,
+	}
+	for _, opt := range opts {
+		opt(g)
+	}
+	return g, ctx
+}
+
+// Go runs f in a new goroutine, blocking first until the concurrency limit
+// allows it.
+func (g *Group) Go(f CtxFuncError) {
+	if g.sem != nil {
+		g.sem <- struct{}{}
+	}
+	g.start(f)
+}
+
+// TryGo runs f in a new goroutine only if the concurrency limit allows it
+// without blocking, and reports whether it did.
+func (g *Group) TryGo(f CtxFuncError) bool {
+	if g.sem != nil {
+		select {
+		case g.sem <- struct{}{}:
+		default:
+			return false
+		}
+	}
+	g.start(f)
+	return true
+}
+
+func (g *Group) start(f CtxFuncError) {
+	g.wg.Add(1)
+	go func() {
+		defer g.wg.Done()
+		defer func() {
+			if g.sem != nil {
+				<-g.sem
+			}
+		}()
+
+		err := callProtected(func() error {
+			return f(g.ctx)
+		})
+		if err == nil {
+			return
+		}
+
+		g.mu.Lock()
+		defer g.mu.Unlock()
+		if g.collect || len(g.errs) == 0 {
+			g.errs = append(g.errs, err)
+		}
+		if !g.collect {
+			g.cancel()
+		}
+	}()
+}
+
+// Wait waits for all the tasks to return, cancels the context of the Group,
+// and returns the first error, or all the errors joined with
+// GroupCollectErrors.
+func (g *Group) Wait() error {
+	g.wg.Wait()
+	g.cancel()
+
+	g.mu.Lock()
+	defer g.mu.Unlock()
+	if len(g.errs) == 0 {
+		return nil
+	}
+	if g.collect {
+		return errors.Join(g.errs...)
+	}
+	return g.errs[0]
+}
@@ -0,0 +1,86 @@
+package powerfunc
+
+import (
+	"errors"
+	"iter"
+)
+
+// ErrDone can be returned by a function passed to Generate or Generate2 to
+// signal that there are no more values.
+var ErrDone = errors.New("done")
+
+// Generate returns a sequence of the values returned by repeated calls to f.
+// The sequence ends when f returns an error. Use Generate2 to observe errors
+// other than ErrDone.
+func Generate[T any](f FuncResult[T]) iter.Seq[T] {
+	return func(yield func(T) bool) {
+		for {
+			v, err := f()
+			if err != nil || !yield(v) {
+				return
+			}
+		}
+	}
+}
+
+// Generate2 returns a sequence of the results of repeated calls to f.
+// The sequence ends silently when f returns ErrDone, and after yielding any
+// other error.
+func Generate2[T any](f FuncResult[T]) iter.Seq2[T, error] {
+	return func(yield func(T, error) bool) {
+		for {
+			v, err := f()
+			if errors.Is(err, ErrDone) || !yield(v, err) || err != nil {
+				return
+			}
+		}
+	}
+}
+
+// Map returns a sequence of the values of seq transformed by f.
+func Map[B, A any](seq iter.Seq[A], f Func1Value[B, A]) iter.Seq[B] {
+	return func(yield func(B) bool) {
+		for a := range seq {
+			if !yield(f(a)) {
+				return
+			}
+		}
+	}
+}
+
+// MapResult returns a sequence of the results of f applied to the values of
+// seq. The sequence ends after yielding the first error.
+func MapResult[B, A any](seq iter.Seq[A], f Func1Result[B, A]) iter.Seq2[B, error] {
+	return func(yield func(B, error) bool) {
+		for a := range seq {
+			v, err := f(a)
+			if !yield(v, err) || err != nil {
+				return
+			}
+		}
+	}
+}
+
+// Filter returns a sequence of the values of seq for which keep returns true.
+func Filter[A any](seq iter.Seq[A], keep Func1Value[bool, A]) iter.Seq[A] {
+	return func(yield func(A) bool) {
+		for a := range seq {
+			if keep(a) && !yield(a) {
+				return
+			}
+		}
+	}
+}
+
+// Collect returns the values of seq, stopping at the first error.
+// The values collected before the error are returned along with it.
+func Collect[T any](seq iter.Seq2[T, error]) ([]T, error) {
+	var out []T
+	for v, err := range seq {
+		if err != nil {
+			return out, err
+		}
+		out = append(out, v)
+	}
+	return out, nil
+}
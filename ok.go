@@ -0,0 +1,9 @@
+package powerfunc
+
+// Lookup returns a Func1Ok looking keys up in m.
+func Lookup[K comparable, V any](m map[K]V) Func1Ok[V, K] {
+	return func(k K) (V, bool) {
+		v, ok := m[k]
+		return v, ok
+	}
+}
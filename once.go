@@ -0,0 +1,160 @@
+package powerfunc
+
+import (
+	"context"
+	"sync"
+	"time"
+)
+
+// OnceOption configures a Once.
+type OnceOption func(o *Once)
+
+// OnceRetryOnError makes the Once cache successful calls only.
+// After a failure, the error is returned to the callers and the next call
+// runs the function again.
+func OnceRetryOnError() OnceOption {
+	return func(o *Once) {
+		o.retryOnError = true
+	}
+}
+
+// OnceBackoff makes the Once retry after a failure, but only once the
+// duration returned by backoff has elapsed. Until then, the last error is
+// returned without running the function.
+// failures is the number of consecutive failures so far, starting at 1.
+func OnceBackoff(backoff func(failures int) time.Duration) OnceOption {
+	return func(o *Once) {
+		o.retryOnError = true
+		o.backoff = backoff
+	}
+}
+
+// OnceClock sets the clock of the backoff of OnceBackoff. Defaults to
+// RealClock.
+func OnceClock(clock Clock) OnceOption {
+	return func(o *Once) {
+		o.clock = clock
+	}
+}
+
+// Once controls a function returned by a Once or Lazy method.
+type Once struct {
+	retryOnError bool
+	backoff      func(failures int) time.Duration
+	clock        Clock
+
+	mu       sync.Mutex
+	gen      uint64
+	done     bool
+	val      any
+	err      error
+	failures int
+	retryAt  time.Time
+	inflight *onceCall
+}
+
+type onceCall struct {
+	done     chan struct{}
+	val      any
+	err      error
+	panicked bool
+	panicVal any
+}
+
+func newOnce(opts ...OnceOption) *Once {
+	o := &Once{clock: RealClock()}
+	for _, opt := range opts {
+		opt(o)
+	}
+	return o
+}
+
+func newLazy(opts ...OnceOption) *Once {
+	return newOnce(append([]OnceOption{OnceRetryOnError()}, opts...)...)
+}
+
+// Reset forgets the cached outcome, so that the next call runs the
+// function again. A call in flight is not interrupted, but its outcome
+// will not be cached.
+func (o *Once) Reset() {
+	o.mu.Lock()
+	defer o.mu.Unlock()
+	o.gen++
+	o.done = false
+	o.val = nil
+	o.err = nil
+	o.failures = 0
+	o.retryAt = time.Time{}
+	o.inflight = nil
+}
+
+// do runs call unless its outcome is already cached, and makes concurrent
+// callers share a single execution.
+// When async is true, call runs in its own goroutine, so that a caller
+// whose ctx is done stops waiting without interrupting the shared execution.
+func (o *Once) do(ctx context.Context, async bool, call func() (any, error)) (any, error) {
+	o.mu.Lock()
+	if o.done {
+		defer o.mu.Unlock()
+		return o.val, o.err
+	}
+	if o.err != nil && o.clock.Now().Before(o.retryAt) {
+		defer o.mu.Unlock()
+		return nil, o.err
+	}
+
+	c := o.inflight
+	if c == nil {
+		c = &onceCall{done: make(chan struct{})}
+		o.inflight = c
+		gen := o.gen
+		o.mu.Unlock()
+		if async {
+			go o.run(gen, c, call)
+		} else {
+			o.run(gen, c, call)
+		}
+	} else {
+		o.mu.Unlock()
+	}
+
+	select {
+	case <-c.done:
+	case <-ctx.Done():
+		return nil, ctx.Err()
+	}
+	if c.panicked {
+		panic(c.panicVal)
+	}
+	return c.val, c.err
+}
+
+func (o *Once) run(gen uint64, c *onceCall, call func() (any, error)) {
+	defer func() {
+		if r := recover(); r != nil {
+			c.panicked = true
+			c.panicVal = r
+		}
+
+		o.mu.Lock()
+		if o.inflight == c {
+			o.inflight = nil
+		}
+		if gen == o.gen && !c.panicked {
+			if c.err == nil || !o.retryOnError {
+				o.done = true
+				o.val, o.err = c.val, c.err
+			} else {
+				o.failures++
+				o.err = c.err
+				if o.backoff != nil {
+					o.retryAt = o.clock.Now().Add(o.backoff(o.failures))
+				}
+			}
+		}
+		o.mu.Unlock()
+
+		close(c.done)
+	}()
+	c.val, c.err = call()
+}
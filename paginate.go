@@ -0,0 +1,108 @@
+package powerfunc
+
+import (
+	"context"
+	"iter"
+)
+
+// Page is a page of items returned by a paginated API.
+type Page[T any, Tok comparable] struct {
+	Items []T
+	// Next is the continuation token of the next page.
+	// The zero value means that this page is the last one.
+	Next Tok
+}
+
+// PageOption configures Paginate.
+type PageOption func(p *pageConfig)
+
+type pageConfig struct {
+	maxPages  int
+	prefetch  bool
+	tryAgain  func(attempts int, err error) bool
+	retryOpts []RetryOption
+}
+
+// PageMax stops the pagination after n pages.
+func PageMax(n int) PageOption {
+	return func(p *pageConfig) {
+		p.maxPages = n
+	}
+}
+
+// PagePrefetch fetches the next page while the items of the current one are
+// being consumed.
+func PagePrefetch() PageOption {
+	return func(p *pageConfig) {
+		p.prefetch = true
+	}
+}
+
+// PageRetry retries the fetch of every page with CtxFunc1Result.Retry.
+func PageRetry(tryAgain func(attempts int, err error) bool, opts ...RetryOption) PageOption {
+	return func(p *pageConfig) {
+		p.tryAgain = tryAgain
+		p.retryOpts = opts
+	}
+}
+
+type pageResult[T any, Tok comparable] struct {
+	page Page[T, Tok]
+	err  error
+}
+
+// Paginate returns a sequence of the items of all the pages returned by
+// fetch, following the continuation tokens from the zero token until the
+// last page.
+// The sequence ends after yielding the first error of fetch. Breaking out
+// of the loop stops the pagination, and cancels a prefetch in progress.
+func Paginate[T any, Tok comparable](ctx context.Context, fetch CtxFunc1Result[Page[T, Tok], Tok], opts ...PageOption) iter.Seq2[T, error] {
+	cfg := pageConfig{}
+	for _, opt := range opts {
+		opt(&cfg)
+	}
+	if cfg.tryAgain != nil {
+		fetch = fetch.Retry(cfg.tryAgain, cfg.retryOpts...)
+	}
+
+	return func(yield func(T, error) bool) {
+		ctx, cancel := context.WithCancel(ctx)
+		defer cancel()
+
+		start := func(tok Tok) <-chan pageResult[T, Tok] {
+			c := make(chan pageResult[T, Tok], 1)
+			go func() {
+				page, err := fetch(ctx, tok)
+				c <- pageResult[T, Tok]{page, err}
+			}()
+			return c
+		}
+
+		var zero Tok
+		next := start(zero)
+		for pages := 1; ; pages++ {
+			res := <-next
+			if res.err != nil {
+				var item T
+				yield(item, res.err)
+				return
+			}
+
+			last := res.page.Next == zero || (cfg.maxPages > 0 && pages >= cfg.maxPages)
+			if !last && cfg.prefetch {
+				next = start(res.page.Next)
+			}
+			for _, item := range res.page.Items {
+				if !yield(item, nil) {
+					return
+				}
+			}
+			if last {
+				return
+			}
+			if !cfg.prefetch {
+				next = start(res.page.Next)
+			}
+		}
+	}
+}
@@ -0,0 +1,406 @@
+package powerfunc
+
+import (
+	"context"
+	"errors"
+	"reflect"
+	"sync"
+)
+
+// ErrorPolicy decides what a pipeline stage does when its function fails.
+type ErrorPolicy int
+
+const (
+	// StopOnError stops the stage on the first error, which is returned by
+	// its wait function.
+	StopOnError ErrorPolicy = iota
+	// SkipOnError drops the items whose processing failed and carries on.
+	SkipOnError
+	// CollectErrors drops the items whose processing failed and carries on,
+	// and makes the wait function return all the errors joined.
+	CollectErrors
+)
+
+// StageOption configures a pipeline stage.
+type StageOption func(s *stageConfig)
+
+type stageConfig struct {
+	parallelism int
+	ordered     bool
+	buffer      int
+	policy      ErrorPolicy
+	onError     func(err error)
+}
+
+// StageParallelism sets the number of items processed concurrently.
+// Defaults to 1.
+func StageParallelism(n int) StageOption {
+	return func(s *stageConfig) {
+		if n > 0 {
+			s.parallelism = n
+		}
+	}
+}
+
+// StageOrdered makes the stage write its outputs in the order of its
+// inputs, even when items are processed concurrently.
+func StageOrdered() StageOption {
+	return func(s *stageConfig) {
+		s.ordered = true
+	}
+}
+
+// StageBuffer sets the capacity of the output channel of the stage.
+// Once it is full, the stage stops reading its input. Defaults to 0.
+func StageBuffer(n int) StageOption {
+	return func(s *stageConfig) {
+		s.buffer = n
+	}
+}
+
+// StageErrors sets the ErrorPolicy of the stage. Defaults to StopOnError.
+func StageErrors(policy ErrorPolicy) StageOption {
+	return func(s *stageConfig) {
+		s.policy = policy
+	}
+}
+
+// StageOnError registers a callback invoked with every error of the stage
+// function. Panics are reported as a *PanicError.
+func StageOnError(onError func(err error)) StageOption {
+	return func(s *stageConfig) {
+		s.onError = onError
+	}
+}
+
+// StageFunc is a pipeline stage. It reads from in until it is closed or ctx
+// is done, and closes the returned channel once it is done.
+// The returned wait function blocks until then, and returns the error of
+// the stage according to its ErrorPolicy, or the error of ctx if it was
+// done before the stage returned.
+type StageFunc[A, B any] func(ctx context.Context, in <-chan A) (out <-chan B, wait func() error)
+
+// Stage returns a pipeline stage applying f to every item read from its
+// input.
+//
+// When the stage stops on an error, it keeps draining its input so that the
+// upstream stages are not blocked. Cancel ctx to stop them instead.
+func Stage[B, A any](f CtxFunc1Result[B, A], opts ...StageOption) StageFunc[A, B] {
+	cfg := stageConfig{parallelism: 1}
+	for _, opt := range opts {
+		opt(&cfg)
+	}
+
+	return func(ctx context.Context, in <-chan A) (<-chan B, func() error) {
+		r := &stageRun[A, B]{
+			cfg: cfg,
+			f:   f,
+			in:  in,
+			out: make(chan B, cfg.buffer),
+		}
+		r.parent = ctx
+		r.ctx, r.cancel = context.WithCancel(ctx)
+		r.done = make(chan struct{})
+
+		go func() {
+			if cfg.ordered && cfg.parallelism > 1 {
+				r.runOrdered()
+			} else {
+				r.runUnordered()
+			}
+			r.mu.Lock()
+			r.cut = r.parent.Err()
+			r.mu.Unlock()
+			r.cancel()
+			close(r.out)
+			close(r.done)
+			r.drain()
+		}()
+
+		return r.out, r.wait
+	}
+}
+
+type stageRun[A, B any] struct {
+	cfg    stageConfig
+	f      CtxFunc1Result[B, A]
+	in     <-chan A
+	out    chan B
+	parent context.Context
+	ctx    context.Context
+	cancel context.CancelFunc
+	done   chan struct{}
+
+	mu   sync.Mutex
+	errs []error
+	// cut is the error of the parent context if it was done before the
+	// stage returned, cutting its output short.
+	cut error
+}
+
+type stageResult[B any] struct {
+	v    B
+	err  error
+	done chan struct{}
+}
+
+func (r *stageRun[A, B]) runUnordered() {
+	var wg sync.WaitGroup
+	for i := 0; i < r.cfg.parallelism; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for {
+				a, ok := r.receive()
+				if !ok {
+					return
+				}
+				v, err := r.call(a)
+				if r.keep(err) {
+					r.send(v)
+				}
+			}
+		}()
+	}
+	wg.Wait()
+}
+
+func (r *stageRun[A, B]) runOrdered() {
+	pending := make(chan *stageResult[B], r.cfg.parallelism+r.cfg.buffer)
+	sem := make(chan struct{}, r.cfg.parallelism)
+
+	go func() {
+		defer close(pending)
+		for {
+			a, ok := r.receive()
+			if !ok {
+				return
+			}
+			res := &stageResult[B]{done: make(chan struct{})}
+			select {
+			case pending <- res:
+			case <-r.ctx.Done():
+				return
+			}
+			select {
+			case sem <- struct{}{}:
+			case <-r.ctx.Done():
+				res.err = r.ctx.Err()
+				close(res.done)
+				return
+			}
+			go func(a A) {
+				defer close(res.done)
+				res.v, res.err = r.call(a)
+				<-sem
+			}(a)
+		}
+	}()
+
+	for res := range pending {
+		<-res.done
+		if r.ctx.Err() == nil && r.keep(res.err) {
+			r.send(res.v)
+		}
+	}
+}
+
+func (r *stageRun[A, B]) receive() (A, bool) {
+	select {
+	case a, ok := <-r.in:
+		return a, ok
+	case <-r.ctx.Done():
+		var zero A
+		return zero, false
+	}
+}
+
+func (r *stageRun[A, B]) send(v B) {
+	select {
+	case r.out <- v:
+	case <-r.ctx.Done():
+	}
+}
+
+func (r *stageRun[A, B]) call(a A) (B, error) {
+	var v B
+	err := callProtected(func() error {
+		var err error
+		v, err = r.f(r.ctx, a)
+		return err
+	})
+	return v, err
+}
+
+// keep records err according to the ErrorPolicy, and reports whether the
+// item should be written to the output.
+func (r *stageRun[A, B]) keep(err error) bool {
+	if err == nil {
+		return true
+	}
+	if r.ctx.Err() != nil {
+		// Errors caused by the cancellation of the stage are not reported.
+		return false
+	}
+	if r.cfg.onError != nil {
+		r.cfg.onError(err)
+	}
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	switch r.cfg.policy {
+	case StopOnError:
+		if len(r.errs) == 0 {
+			r.errs = append(r.errs, err)
+		}
+		r.cancel()
+	case CollectErrors:
+		r.errs = append(r.errs, err)
+	}
+	return false
+}
+
+// drain reads the input until it is closed or the parent context is done,
+// so that a stage stopped on an error does not block the upstream stages.
+func (r *stageRun[A, B]) drain() {
+	for {
+		select {
+		case _, ok := <-r.in:
+			if !ok {
+				return
+			}
+		case <-r.parent.Done():
+			return
+		}
+	}
+}
+
+func (r *stageRun[A, B]) wait() error {
+	<-r.done
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	if len(r.errs) == 0 {
+		return r.cut
+	}
+	if r.cfg.policy == CollectErrors {
+		return errors.Join(append(r.errs, r.cut)...)
+	}
+	return r.errs[0]
+}
+
+// Source returns a channel emitting items, and closed once all of them have
+// been emitted or ctx is done.
+func Source[T any](ctx context.Context, items []T) <-chan T {
+	out := make(chan T)
+	go func() {
+		defer close(out)
+		for _, item := range items {
+			select {
+			case out <- item:
+			case <-ctx.Done():
+				return
+			}
+		}
+	}()
+	return out
+}
+
+// Sink calls f with every item read from in, until in is closed, ctx is
+// done or f returns an error.
+// Returns the error of f, or the error of ctx if it is done first.
+func Sink[A any](ctx context.Context, in <-chan A, f CtxFunc1Error[A]) error {
+	for {
+		select {
+		case a, ok := <-in:
+			if !ok {
+				return nil
+			}
+			if err := f(ctx, a); err != nil {
+				return err
+			}
+		case <-ctx.Done():
+			return ctx.Err()
+		}
+	}
+}
+
+// Merge returns a channel emitting the items of all the inputs, in no
+// particular order, and closed once they are all closed or ctx is done.
+func Merge[T any](ctx context.Context, ins ...<-chan T) <-chan T {
+	out := make(chan T)
+	var wg sync.WaitGroup
+	for _, in := range ins {
+		wg.Add(1)
+		go func(in <-chan T) {
+			defer wg.Done()
+			for {
+				select {
+				case item, ok := <-in:
+					if !ok {
+						return
+					}
+					select {
+					case out <- item:
+					case <-ctx.Done():
+						return
+					}
+				case <-ctx.Done():
+					return
+				}
+			}
+		}(in)
+	}
+	go func() {
+		wg.Wait()
+		close(out)
+	}()
+	return out
+}
+
+// Split returns n channels sharing the items of in: every item is emitted
+// by exactly one of them, the first whose consumer is ready to receive it.
+// A slow consumer does not hold back the items the others can take.
+// The channels are closed once in is closed or ctx is done.
+func Split[T any](ctx context.Context, in <-chan T, n int) []<-chan T {
+	outs := make([]<-chan T, n)
+	chans := make([]chan T, n)
+	for i := range outs {
+		chans[i] = make(chan T)
+		outs[i] = chans[i]
+	}
+	if n == 0 {
+		return outs
+	}
+
+	go func() {
+		defer func() {
+			for _, out := range chans {
+				close(out)
+			}
+		}()
+		// One send case per output, and the done channel of ctx last.
+		cases := make([]reflect.SelectCase, n+1)
+		for i, out := range chans {
+			cases[i] = reflect.SelectCase{Dir: reflect.SelectSend, Chan: reflect.ValueOf(out)}
+		}
+		cases[n] = reflect.SelectCase{Dir: reflect.SelectRecv, Chan: reflect.ValueOf(ctx.Done())}
+		for {
+			select {
+			case item, ok := <-in:
+				if !ok {
+					return
+				}
+				send := reflect.ValueOf(&item).Elem()
+				for i := range chans {
+					cases[i].Send = send
+				}
+				if chosen, _, _ := reflect.Select(cases); chosen == n {
+					return
+				}
+			case <-ctx.Done():
+				return
+			}
+		}
+	}()
+	return outs
+}
@@ -0,0 +1,117 @@
+package powerfunc
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math/rand"
+	"time"
+)
+
+// ErrMaxAttempts is the cause of a PollTimeoutError returned when the
+// maximum number of attempts is reached.
+var ErrMaxAttempts = errors.New("max attempts reached")
+
+// PollTimeoutError is returned by a PollUntil function when the condition
+// was not met before the context expired or the maximum number of attempts
+// was reached.
+type PollTimeoutError struct {
+	Attempts int
+	Elapsed  time.Duration
+	// Err is either ErrMaxAttempts or the error of the context.
+	Err error
+}
+
+func (e *PollTimeoutError) Error() string {
+	return fmt.Sprintf("condition not met after %d attempts in %s: %v", e.Attempts, e.Elapsed, e.Err)
+}
+
+func (e *PollTimeoutError) Unwrap() error {
+	return e.Err
+}
+
+// PollOption configures a PollUntil function.
+type PollOption func(p *pollConfig)
+
+type pollConfig struct {
+	maxAttempts     int
+	backoff         func(attempt int) time.Duration
+	jitter          time.Duration
+	continueOnError bool
+	clock           Clock
+}
+
+// PollMaxAttempts stops polling after n calls. By default, polling only
+// stops when the context is done.
+func PollMaxAttempts(n int) PollOption {
+	return func(p *pollConfig) {
+		p.maxAttempts = n
+	}
+}
+
+// PollBackoff sets the delay after each attempt, instead of the fixed
+// interval. attempt starts at 1.
+func PollBackoff(backoff func(attempt int) time.Duration) PollOption {
+	return func(p *pollConfig) {
+		p.backoff = backoff
+	}
+}
+
+// PollJitter adds a random duration in [0, jitter) to every delay.
+func PollJitter(jitter time.Duration) PollOption {
+	return func(p *pollConfig) {
+		p.jitter = jitter
+	}
+}
+
+// PollContinueOnError keeps polling when the function returns an error.
+// By default, the error is returned immediately.
+func PollContinueOnError() PollOption {
+	return func(p *pollConfig) {
+		p.continueOnError = true
+	}
+}
+
+// PollClock sets the clock of the polling. Defaults to RealClock.
+func PollClock(clock Clock) PollOption {
+	return func(p *pollConfig) {
+		p.clock = clock
+	}
+}
+
+// poll calls call until it reports that the condition is met.
+func poll(ctx context.Context, interval time.Duration, opts []PollOption, call func() (bool, error)) error {
+	cfg := pollConfig{backoff: ConstantBackoff(interval), clock: RealClock()}
+	for _, opt := range opts {
+		opt(&cfg)
+	}
+
+	start := cfg.clock.Now()
+	timeout := func(attempts int, err error) error {
+		return &PollTimeoutError{Attempts: attempts, Elapsed: cfg.clock.Since(start), Err: err}
+	}
+
+	for attempt := 1; ; attempt++ {
+		done, err := call()
+		if err == nil && done {
+			return nil
+		}
+		if ctx.Err() != nil {
+			return timeout(attempt, ctx.Err())
+		}
+		if err != nil && !cfg.continueOnError {
+			return err
+		}
+		if cfg.maxAttempts > 0 && attempt >= cfg.maxAttempts {
+			return timeout(attempt, ErrMaxAttempts)
+		}
+
+		delay := cfg.backoff(attempt)
+		if cfg.jitter > 0 {
+			delay += time.Duration(rand.Int63n(int64(cfg.jitter)))
+		}
+		if !sleepUntil(ctx, cfg.clock, cfg.clock.Now().Add(delay)) {
+			return timeout(attempt, ctx.Err())
+		}
+	}
+}
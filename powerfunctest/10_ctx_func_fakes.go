@@ -0,0 +1,45 @@
+// Code generated by powerfunc/generator from fakes.go.tmpl. DO NOT EDIT.
+
+package powerfunctest
+
+import (
+	"context"
+
+	"github.com/jonathanmontane/powerfunc"
+)
+
+// SpyCtxFunc10 returns a CtxFunc10 recording the calls of f, along with the
+// Spy holding them.
+func SpyCtxFunc10[P0, P1, P2, P3, P4, P5, P6, P7, P8, P9 any](f powerfunc.CtxFunc10[P0, P1, P2, P3, P4, P5, P6, P7, P8, P9]) (powerfunc.CtxFunc10[P0, P1, P2, P3, P4, P5, P6, P7, P8, P9], *Spy) {
+	s := &Spy{}
+	return func(ctx context.Context, p0 P0, p1 P1, p2 P2, p3 P3, p4 P4, p5 P5, p6 P6, p7 P7, p8 P8, p9 P9) {
+		call := s.begin(ctx, p0, p1, p2, p3, p4, p5, p6, p7, p8, p9)
+		panicked := true
+		defer func() {
+			s.end(call, panicked)
+		}()
+		f(ctx, p0, p1, p2, p3, p4, p5, p6, p7, p8, p9)
+		panicked = false
+	}, s
+}
+
+// DelayCtxFunc10 returns a CtxFunc10 waiting for latency before calling f.
+// The options set the clock measuring the latency, see DelayClock.
+// f is still called if the context is done first.
+func DelayCtxFunc10[P0, P1, P2, P3, P4, P5, P6, P7, P8, P9 any](latency Latency, f powerfunc.CtxFunc10[P0, P1, P2, P3, P4, P5, P6, P7, P8, P9], opts ...DelayOption) powerfunc.CtxFunc10[P0, P1, P2, P3, P4, P5, P6, P7, P8, P9] {
+	d := newDelay(latency, opts)
+	return func(ctx context.Context, p0 P0, p1 P1, p2 P2, p3 P3, p4 P4, p5 P5, p6 P6, p7 P7, p8 P8, p9 P9) {
+		_ = d.wait(ctx)
+		f(ctx, p0, p1, p2, p3, p4, p5, p6, p7, p8, p9)
+	}
+}
+
+// GateCtxFunc10 returns a CtxFunc10 blocking until g lets the call through
+// before calling f.
+// f is still called if the context is done first.
+func GateCtxFunc10[P0, P1, P2, P3, P4, P5, P6, P7, P8, P9 any](g *Gate, f powerfunc.CtxFunc10[P0, P1, P2, P3, P4, P5, P6, P7, P8, P9]) powerfunc.CtxFunc10[P0, P1, P2, P3, P4, P5, P6, P7, P8, P9] {
+	return func(ctx context.Context, p0 P0, p1 P1, p2 P2, p3 P3, p4 P4, p5 P5, p6 P6, p7 P7, p8 P8, p9 P9) {
+		_ = g.wait(ctx)
+		f(ctx, p0, p1, p2, p3, p4, p5, p6, p7, p8, p9)
+	}
+}
@@ -0,0 +1,77 @@
+// Code generated by powerfunc/generator from fakes.go.tmpl. DO NOT EDIT.
+
+package powerfunctest
+
+import (
+	"context"
+
+	"github.com/jonathanmontane/powerfunc"
+)
+
+// StubCtxFunc10Result returns a CtxFunc10Result returning the outcomes in order, one per
+// call. The last outcome is repeated once they are exhausted, and zero values
+// are returned if there are none.
+func StubCtxFunc10Result[R, P0, P1, P2, P3, P4, P5, P6, P7, P8, P9 any](outcomes ...Result[R]) powerfunc.CtxFunc10Result[R, P0, P1, P2, P3, P4, P5, P6, P7, P8, P9] {
+	s := &script{}
+	return func(ctx context.Context, p0 P0, p1 P1, p2 P2, p3 P3, p4 P4, p5 P5, p6 P6, p7 P7, p8 P8, p9 P9) (R, error) {
+		var out Result[R]
+		if i := s.next(len(outcomes)); i >= 0 {
+			out = outcomes[i]
+		}
+		return out.Value, out.Err
+	}
+}
+
+// SpyCtxFunc10Result returns a CtxFunc10Result recording the calls of f, along with the
+// Spy holding them.
+func SpyCtxFunc10Result[R, P0, P1, P2, P3, P4, P5, P6, P7, P8, P9 any](f powerfunc.CtxFunc10Result[R, P0, P1, P2, P3, P4, P5, P6, P7, P8, P9]) (powerfunc.CtxFunc10Result[R, P0, P1, P2, P3, P4, P5, P6, P7, P8, P9], *Spy) {
+	s := &Spy{}
+	return func(ctx context.Context, p0 P0, p1 P1, p2 P2, p3 P3, p4 P4, p5 P5, p6 P6, p7 P7, p8 P8, p9 P9) (R, error) {
+		call := s.begin(ctx, p0, p1, p2, p3, p4, p5, p6, p7, p8, p9)
+		panicked := true
+		defer func() {
+			s.end(call, panicked)
+		}()
+		r0, err := f(ctx, p0, p1, p2, p3, p4, p5, p6, p7, p8, p9)
+		panicked = false
+		s.fail(call, err)
+		return r0, err
+	}, s
+}
+
+// FailNCtxFunc10Result returns a CtxFunc10Result returning err for the first n calls,
+// then calling f.
+func FailNCtxFunc10Result[R, P0, P1, P2, P3, P4, P5, P6, P7, P8, P9 any](n int, err error, f powerfunc.CtxFunc10Result[R, P0, P1, P2, P3, P4, P5, P6, P7, P8, P9]) powerfunc.CtxFunc10Result[R, P0, P1, P2, P3, P4, P5, P6, P7, P8, P9] {
+	fl := &failer{n: int64(n)}
+	return func(ctx context.Context, p0 P0, p1 P1, p2 P2, p3 P3, p4 P4, p5 P5, p6 P6, p7 P7, p8 P8, p9 P9) (R, error) {
+		if fl.fail() {
+			return *new(R), err
+		}
+		return f(ctx, p0, p1, p2, p3, p4, p5, p6, p7, p8, p9)
+	}
+}
+
+// DelayCtxFunc10Result returns a CtxFunc10Result waiting for latency before calling f.
+// The options set the clock measuring the latency, see DelayClock.
+// The error of the context is returned if it is done first.
+func DelayCtxFunc10Result[R, P0, P1, P2, P3, P4, P5, P6, P7, P8, P9 any](latency Latency, f powerfunc.CtxFunc10Result[R, P0, P1, P2, P3, P4, P5, P6, P7, P8, P9], opts ...DelayOption) powerfunc.CtxFunc10Result[R, P0, P1, P2, P3, P4, P5, P6, P7, P8, P9] {
+	d := newDelay(latency, opts)
+	return func(ctx context.Context, p0 P0, p1 P1, p2 P2, p3 P3, p4 P4, p5 P5, p6 P6, p7 P7, p8 P8, p9 P9) (R, error) {
+		if err := d.wait(ctx); err != nil {
+			return *new(R), err
+		}
+		return f(ctx, p0, p1, p2, p3, p4, p5, p6, p7, p8, p9)
+	}
+}
+
+// GateCtxFunc10Result returns a CtxFunc10Result blocking until g lets the call through
+// before calling f.
+// The error of the context is returned if it is done first.
+func GateCtxFunc10Result[R, P0, P1, P2, P3, P4, P5, P6, P7, P8, P9 any](g *Gate, f powerfunc.CtxFunc10Result[R, P0, P1, P2, P3, P4, P5, P6, P7, P8, P9]) powerfunc.CtxFunc10Result[R, P0, P1, P2, P3, P4, P5, P6, P7, P8, P9] {
+	return func(ctx context.Context, p0 P0, p1 P1, p2 P2, p3 P3, p4 P4, p5 P5, p6 P6, p7 P7, p8 P8, p9 P9) (R, error) {
+		if err := g.wait(ctx); err != nil {
+			return *new(R), err
+		}
+		return f(ctx, p0, p1, p2, p3, p4, p5, p6, p7, p8, p9)
+	}
+}
@@ -0,0 +1,43 @@
+// Code generated by powerfunc/generator from fakes.go.tmpl. DO NOT EDIT.
+
+package powerfunctest
+
+import (
+	"context"
+
+	"github.com/jonathanmontane/powerfunc"
+)
+
+// SpyFunc10 returns a Func10 recording the calls of f, along with the
+// Spy holding them.
+func SpyFunc10[P0, P1, P2, P3, P4, P5, P6, P7, P8, P9 any](f powerfunc.Func10[P0, P1, P2, P3, P4, P5, P6, P7, P8, P9]) (powerfunc.Func10[P0, P1, P2, P3, P4, P5, P6, P7, P8, P9], *Spy) {
+	s := &Spy{}
+	return func(p0 P0, p1 P1, p2 P2, p3 P3, p4 P4, p5 P5, p6 P6, p7 P7, p8 P8, p9 P9) {
+		call := s.begin(context.Background(), p0, p1, p2, p3, p4, p5, p6, p7, p8, p9)
+		panicked := true
+		defer func() {
+			s.end(call, panicked)
+		}()
+		f(p0, p1, p2, p3, p4, p5, p6, p7, p8, p9)
+		panicked = false
+	}, s
+}
+
+// DelayFunc10 returns a Func10 waiting for latency before calling f.
+// The options set the clock measuring the latency, see DelayClock.
+func DelayFunc10[P0, P1, P2, P3, P4, P5, P6, P7, P8, P9 any](latency Latency, f powerfunc.Func10[P0, P1, P2, P3, P4, P5, P6, P7, P8, P9], opts ...DelayOption) powerfunc.Func10[P0, P1, P2, P3, P4, P5, P6, P7, P8, P9] {
+	d := newDelay(latency, opts)
+	return func(p0 P0, p1 P1, p2 P2, p3 P3, p4 P4, p5 P5, p6 P6, p7 P7, p8 P8, p9 P9) {
+		_ = d.wait(context.Background())
+		f(p0, p1, p2, p3, p4, p5, p6, p7, p8, p9)
+	}
+}
+
+// GateFunc10 returns a Func10 blocking until g lets the call through
+// before calling f.
+func GateFunc10[P0, P1, P2, P3, P4, P5, P6, P7, P8, P9 any](g *Gate, f powerfunc.Func10[P0, P1, P2, P3, P4, P5, P6, P7, P8, P9]) powerfunc.Func10[P0, P1, P2, P3, P4, P5, P6, P7, P8, P9] {
+	return func(p0 P0, p1 P1, p2 P2, p3 P3, p4 P4, p5 P5, p6 P6, p7 P7, p8 P8, p9 P9) {
+		_ = g.wait(context.Background())
+		f(p0, p1, p2, p3, p4, p5, p6, p7, p8, p9)
+	}
+}
@@ -0,0 +1,58 @@
+// Code generated by powerfunc/generator from fakes.go.tmpl. DO NOT EDIT.
+
+package powerfunctest
+
+import (
+	"context"
+
+	"github.com/jonathanmontane/powerfunc"
+)
+
+// StubFunc10Ok returns a Func10Ok returning the outcomes in order, one per
+// call. The last outcome is repeated once they are exhausted, and zero values
+// are returned if there are none.
+func StubFunc10Ok[R, P0, P1, P2, P3, P4, P5, P6, P7, P8, P9 any](outcomes ...Option[R]) powerfunc.Func10Ok[R, P0, P1, P2, P3, P4, P5, P6, P7, P8, P9] {
+	s := &script{}
+	return func(p0 P0, p1 P1, p2 P2, p3 P3, p4 P4, p5 P5, p6 P6, p7 P7, p8 P8, p9 P9) (R, bool) {
+		var out Option[R]
+		if i := s.next(len(outcomes)); i >= 0 {
+			out = outcomes[i]
+		}
+		return out.Value, out.Ok
+	}
+}
+
+// SpyFunc10Ok returns a Func10Ok recording the calls of f, along with the
+// Spy holding them.
+func SpyFunc10Ok[R, P0, P1, P2, P3, P4, P5, P6, P7, P8, P9 any](f powerfunc.Func10Ok[R, P0, P1, P2, P3, P4, P5, P6, P7, P8, P9]) (powerfunc.Func10Ok[R, P0, P1, P2, P3, P4, P5, P6, P7, P8, P9], *Spy) {
+	s := &Spy{}
+	return func(p0 P0, p1 P1, p2 P2, p3 P3, p4 P4, p5 P5, p6 P6, p7 P7, p8 P8, p9 P9) (R, bool) {
+		call := s.begin(context.Background(), p0, p1, p2, p3, p4, p5, p6, p7, p8, p9)
+		panicked := true
+		defer func() {
+			s.end(call, panicked)
+		}()
+		r0, r1 := f(p0, p1, p2, p3, p4, p5, p6, p7, p8, p9)
+		panicked = false
+		return r0, r1
+	}, s
+}
+
+// DelayFunc10Ok returns a Func10Ok waiting for latency before calling f.
+// The options set the clock measuring the latency, see DelayClock.
+func DelayFunc10Ok[R, P0, P1, P2, P3, P4, P5, P6, P7, P8, P9 any](latency Latency, f powerfunc.Func10Ok[R, P0, P1, P2, P3, P4, P5, P6, P7, P8, P9], opts ...DelayOption) powerfunc.Func10Ok[R, P0, P1, P2, P3, P4, P5, P6, P7, P8, P9] {
+	d := newDelay(latency, opts)
+	return func(p0 P0, p1 P1, p2 P2, p3 P3, p4 P4, p5 P5, p6 P6, p7 P7, p8 P8, p9 P9) (R, bool) {
+		_ = d.wait(context.Background())
+		return f(p0, p1, p2, p3, p4, p5, p6, p7, p8, p9)
+	}
+}
+
+// GateFunc10Ok returns a Func10Ok blocking until g lets the call through
+// before calling f.
+func GateFunc10Ok[R, P0, P1, P2, P3, P4, P5, P6, P7, P8, P9 any](g *Gate, f powerfunc.Func10Ok[R, P0, P1, P2, P3, P4, P5, P6, P7, P8, P9]) powerfunc.Func10Ok[R, P0, P1, P2, P3, P4, P5, P6, P7, P8, P9] {
+	return func(p0 P0, p1 P1, p2 P2, p3 P3, p4 P4, p5 P5, p6 P6, p7 P7, p8 P8, p9 P9) (R, bool) {
+		_ = g.wait(context.Background())
+		return f(p0, p1, p2, p3, p4, p5, p6, p7, p8, p9)
+	}
+}
@@ -0,0 +1,71 @@
+// Code generated by powerfunc/generator from fakes.go.tmpl. DO NOT EDIT.
+
+package powerfunctest
+
+import (
+	"context"
+
+	"github.com/jonathanmontane/powerfunc"
+)
+
+// StubFunc10VariadicError returns a Func10VariadicError returning the outcomes in order, one per
+// call. The last outcome is repeated once they are exhausted, and zero values
+// are returned if there are none.
+func StubFunc10VariadicError[V, P0, P1, P2, P3, P4, P5, P6, P7, P8, P9 any](outcomes ...error) powerfunc.Func10VariadicError[V, P0, P1, P2, P3, P4, P5, P6, P7, P8, P9] {
+	s := &script{}
+	return func(p0 P0, p1 P1, p2 P2, p3 P3, p4 P4, p5 P5, p6 P6, p7 P7, p8 P8, p9 P9, xs ...V) error {
+		var out error
+		if i := s.next(len(outcomes)); i >= 0 {
+			out = outcomes[i]
+		}
+		return out
+	}
+}
+
+// SpyFunc10VariadicError returns a Func10VariadicError recording the calls of f, along with the
+// Spy holding them.
+func SpyFunc10VariadicError[V, P0, P1, P2, P3, P4, P5, P6, P7, P8, P9 any](f powerfunc.Func10VariadicError[V, P0, P1, P2, P3, P4, P5, P6, P7, P8, P9]) (powerfunc.Func10VariadicError[V, P0, P1, P2, P3, P4, P5, P6, P7, P8, P9], *Spy) {
+	s := &Spy{}
+	return func(p0 P0, p1 P1, p2 P2, p3 P3, p4 P4, p5 P5, p6 P6, p7 P7, p8 P8, p9 P9, xs ...V) error {
+		call := s.begin(context.Background(), p0, p1, p2, p3, p4, p5, p6, p7, p8, p9, xs)
+		panicked := true
+		defer func() {
+			s.end(call, panicked)
+		}()
+		err := f(p0, p1, p2, p3, p4, p5, p6, p7, p8, p9, xs...)
+		panicked = false
+		s.fail(call, err)
+		return err
+	}, s
+}
+
+// FailNFunc10VariadicError returns a Func10VariadicError returning err for the first n calls,
+// then calling f.
+func FailNFunc10VariadicError[V, P0, P1, P2, P3, P4, P5, P6, P7, P8, P9 any](n int, err error, f powerfunc.Func10VariadicError[V, P0, P1, P2, P3, P4, P5, P6, P7, P8, P9]) powerfunc.Func10VariadicError[V, P0, P1, P2, P3, P4, P5, P6, P7, P8, P9] {
+	fl := &failer{n: int64(n)}
+	return func(p0 P0, p1 P1, p2 P2, p3 P3, p4 P4, p5 P5, p6 P6, p7 P7, p8 P8, p9 P9, xs ...V) error {
+		if fl.fail() {
+			return err
+		}
+		return f(p0, p1, p2, p3, p4, p5, p6, p7, p8, p9, xs...)
+	}
+}
+
+// DelayFunc10VariadicError returns a Func10VariadicError waiting for latency before calling f.
+// The options set the clock measuring the latency, see DelayClock.
+func DelayFunc10VariadicError[V, P0, P1, P2, P3, P4, P5, P6, P7, P8, P9 any](latency Latency, f powerfunc.Func10VariadicError[V, P0, P1, P2, P3, P4, P5, P6, P7, P8, P9], opts ...DelayOption) powerfunc.Func10VariadicError[V, P0, P1, P2, P3, P4, P5, P6, P7, P8, P9] {
+	d := newDelay(latency, opts)
+	return func(p0 P0, p1 P1, p2 P2, p3 P3, p4 P4, p5 P5, p6 P6, p7 P7, p8 P8, p9 P9, xs ...V) error {
+		_ = d.wait(context.Background())
+		return f(p0, p1, p2, p3, p4, p5, p6, p7, p8, p9, xs...)
+	}
+}
+
+// GateFunc10VariadicError returns a Func10VariadicError blocking until g lets the call through
+// before calling f.
+func GateFunc10VariadicError[V, P0, P1, P2, P3, P4, P5, P6, P7, P8, P9 any](g *Gate, f powerfunc.Func10VariadicError[V, P0, P1, P2, P3, P4, P5, P6, P7, P8, P9]) powerfunc.Func10VariadicError[V, P0, P1, P2, P3, P4, P5, P6, P7, P8, P9] {
+	return func(p0 P0, p1 P1, p2 P2, p3 P3, p4 P4, p5 P5, p6 P6, p7 P7, p8 P8, p9 P9, xs ...V) error {
+		_ = g.wait(context.Background())
+		return f(p0, p1, p2, p3, p4, p5, p6, p7, p8, p9, xs...)
+	}
+}
@@ -0,0 +1,45 @@
+// Code generated by powerfunc/generator from fakes.go.tmpl. DO NOT EDIT.
+
+package powerfunctest
+
+import (
+	"context"
+
+	"github.com/jonathanmontane/powerfunc"
+)
+
+// SpyCtxFunc1 returns a CtxFunc1 recording the calls of f, along with the
+// Spy holding them.
+func SpyCtxFunc1[P0 any](f powerfunc.CtxFunc1[P0]) (powerfunc.CtxFunc1[P0], *Spy) {
+	s := &Spy{}
+	return func(ctx context.Context, p0 P0) {
+		call := s.begin(ctx, p0)
+		panicked := true
+		defer func() {
+			s.end(call, panicked)
+		}()
+		f(ctx, p0)
+		panicked = false
+	}, s
+}
+
+// DelayCtxFunc1 returns a CtxFunc1 waiting for latency before calling f.
+// The options set the clock measuring the latency, see DelayClock.
+// f is still called if the context is done first.
+func DelayCtxFunc1[P0 any](latency Latency, f powerfunc.CtxFunc1[P0], opts ...DelayOption) powerfunc.CtxFunc1[P0] {
+	d := newDelay(latency, opts)
+	return func(ctx context.Context, p0 P0) {
+		_ = d.wait(ctx)
+		f(ctx, p0)
+	}
+}
+
+// GateCtxFunc1 returns a CtxFunc1 blocking until g lets the call through
+// before calling f.
+// f is still called if the context is done first.
+func GateCtxFunc1[P0 any](g *Gate, f powerfunc.CtxFunc1[P0]) powerfunc.CtxFunc1[P0] {
+	return func(ctx context.Context, p0 P0) {
+		_ = g.wait(ctx)
+		f(ctx, p0)
+	}
+}
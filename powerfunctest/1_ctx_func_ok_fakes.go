@@ -0,0 +1,60 @@
+// Code generated by powerfunc/generator from fakes.go.tmpl. DO NOT EDIT.
+
+package powerfunctest
+
+import (
+	"context"
+
+	"github.com/jonathanmontane/powerfunc"
+)
+
+// StubCtxFunc1Ok returns a CtxFunc1Ok returning the outcomes in order, one per
+// call. The last outcome is repeated once they are exhausted, and zero values
+// are returned if there are none.
+func StubCtxFunc1Ok[R, P0 any](outcomes ...Option[R]) powerfunc.CtxFunc1Ok[R, P0] {
+	s := &script{}
+	return func(ctx context.Context, p0 P0) (R, bool) {
+		var out Option[R]
+		if i := s.next(len(outcomes)); i >= 0 {
+			out = outcomes[i]
+		}
+		return out.Value, out.Ok
+	}
+}
+
+// SpyCtxFunc1Ok returns a CtxFunc1Ok recording the calls of f, along with the
+// Spy holding them.
+func SpyCtxFunc1Ok[R, P0 any](f powerfunc.CtxFunc1Ok[R, P0]) (powerfunc.CtxFunc1Ok[R, P0], *Spy) {
+	s := &Spy{}
+	return func(ctx context.Context, p0 P0) (R, bool) {
+		call := s.begin(ctx, p0)
+		panicked := true
+		defer func() {
+			s.end(call, panicked)
+		}()
+		r0, r1 := f(ctx, p0)
+		panicked = false
+		return r0, r1
+	}, s
+}
+
+// DelayCtxFunc1Ok returns a CtxFunc1Ok waiting for latency before calling f.
+// The options set the clock measuring the latency, see DelayClock.
+// f is still called if the context is done first.
+func DelayCtxFunc1Ok[R, P0 any](latency Latency, f powerfunc.CtxFunc1Ok[R, P0], opts ...DelayOption) powerfunc.CtxFunc1Ok[R, P0] {
+	d := newDelay(latency, opts)
+	return func(ctx context.Context, p0 P0) (R, bool) {
+		_ = d.wait(ctx)
+		return f(ctx, p0)
+	}
+}
+
+// GateCtxFunc1Ok returns a CtxFunc1Ok blocking until g lets the call through
+// before calling f.
+// f is still called if the context is done first.
+func GateCtxFunc1Ok[R, P0 any](g *Gate, f powerfunc.CtxFunc1Ok[R, P0]) powerfunc.CtxFunc1Ok[R, P0] {
+	return func(ctx context.Context, p0 P0) (R, bool) {
+		_ = g.wait(ctx)
+		return f(ctx, p0)
+	}
+}
@@ -0,0 +1,77 @@
+// Code generated by powerfunc/generator from fakes.go.tmpl. DO NOT EDIT.
+
+package powerfunctest
+
+import (
+	"context"
+
+	"github.com/jonathanmontane/powerfunc"
+)
+
+// StubCtxFunc1Result returns a CtxFunc1Result returning the outcomes in order, one per
+// call. The last outcome is repeated once they are exhausted, and zero values
+// are returned if there are none.
+func StubCtxFunc1Result[R, P0 any](outcomes ...Result[R]) powerfunc.CtxFunc1Result[R, P0] {
+	s := &script{}
+	return func(ctx context.Context, p0 P0) (R, error) {
+		var out Result[R]
+		if i := s.next(len(outcomes)); i >= 0 {
+			out = outcomes[i]
+		}
+		return out.Value, out.Err
+	}
+}
+
+// SpyCtxFunc1Result returns a CtxFunc1Result recording the calls of f, along with the
+// Spy holding them.
+func SpyCtxFunc1Result[R, P0 any](f powerfunc.CtxFunc1Result[R, P0]) (powerfunc.CtxFunc1Result[R, P0], *Spy) {
+	s := &Spy{}
+	return func(ctx context.Context, p0 P0) (R, error) {
+		call := s.begin(ctx, p0)
+		panicked := true
+		defer func() {
+			s.end(call, panicked)
+		}()
+		r0, err := f(ctx, p0)
+		panicked = false
+		s.fail(call, err)
+		return r0, err
+	}, s
+}
+
+// FailNCtxFunc1Result returns a CtxFunc1Result returning err for the first n calls,
+// then calling f.
+func FailNCtxFunc1Result[R, P0 any](n int, err error, f powerfunc.CtxFunc1Result[R, P0]) powerfunc.CtxFunc1Result[R, P0] {
+	fl := &failer{n: int64(n)}
+	return func(ctx context.Context, p0 P0) (R, error) {
+		if fl.fail() {
+			return *new(R), err
+		}
+		return f(ctx, p0)
+	}
+}
+
+// DelayCtxFunc1Result returns a CtxFunc1Result waiting for latency before calling f.
+// The options set the clock measuring the latency, see DelayClock.
+// The error of the context is returned if it is done first.
+func DelayCtxFunc1Result[R, P0 any](latency Latency, f powerfunc.CtxFunc1Result[R, P0], opts ...DelayOption) powerfunc.CtxFunc1Result[R, P0] {
+	d := newDelay(latency, opts)
+	return func(ctx context.Context, p0 P0) (R, error) {
+		if err := d.wait(ctx); err != nil {
+			return *new(R), err
+		}
+		return f(ctx, p0)
+	}
+}
+
+// GateCtxFunc1Result returns a CtxFunc1Result blocking until g lets the call through
+// before calling f.
+// The error of the context is returned if it is done first.
+func GateCtxFunc1Result[R, P0 any](g *Gate, f powerfunc.CtxFunc1Result[R, P0]) powerfunc.CtxFunc1Result[R, P0] {
+	return func(ctx context.Context, p0 P0) (R, error) {
+		if err := g.wait(ctx); err != nil {
+			return *new(R), err
+		}
+		return f(ctx, p0)
+	}
+}
@@ -0,0 +1,60 @@
+// Code generated by powerfunc/generator from fakes.go.tmpl. DO NOT EDIT.
+
+package powerfunctest
+
+import (
+	"context"
+
+	"github.com/jonathanmontane/powerfunc"
+)
+
+// StubCtxFunc1Value returns a CtxFunc1Value returning the outcomes in order, one per
+// call. The last outcome is repeated once they are exhausted, and zero values
+// are returned if there are none.
+func StubCtxFunc1Value[R, P0 any](outcomes ...R) powerfunc.CtxFunc1Value[R, P0] {
+	s := &script{}
+	return func(ctx context.Context, p0 P0) R {
+		var out R
+		if i := s.next(len(outcomes)); i >= 0 {
+			out = outcomes[i]
+		}
+		return out
+	}
+}
+
+// SpyCtxFunc1Value returns a CtxFunc1Value recording the calls of f, along with the
+// Spy holding them.
+func SpyCtxFunc1Value[R, P0 any](f powerfunc.CtxFunc1Value[R, P0]) (powerfunc.CtxFunc1Value[R, P0], *Spy) {
+	s := &Spy{}
+	return func(ctx context.Context, p0 P0) R {
+		call := s.begin(ctx, p0)
+		panicked := true
+		defer func() {
+			s.end(call, panicked)
+		}()
+		r0 := f(ctx, p0)
+		panicked = false
+		return r0
+	}, s
+}
+
+// DelayCtxFunc1Value returns a CtxFunc1Value waiting for latency before calling f.
+// The options set the clock measuring the latency, see DelayClock.
+// f is still called if the context is done first.
+func DelayCtxFunc1Value[R, P0 any](latency Latency, f powerfunc.CtxFunc1Value[R, P0], opts ...DelayOption) powerfunc.CtxFunc1Value[R, P0] {
+	d := newDelay(latency, opts)
+	return func(ctx context.Context, p0 P0) R {
+		_ = d.wait(ctx)
+		return f(ctx, p0)
+	}
+}
+
+// GateCtxFunc1Value returns a CtxFunc1Value blocking until g lets the call through
+// before calling f.
+// f is still called if the context is done first.
+func GateCtxFunc1Value[R, P0 any](g *Gate, f powerfunc.CtxFunc1Value[R, P0]) powerfunc.CtxFunc1Value[R, P0] {
+	return func(ctx context.Context, p0 P0) R {
+		_ = g.wait(ctx)
+		return f(ctx, p0)
+	}
+}
@@ -0,0 +1,77 @@
+// Code generated by powerfunc/generator from fakes.go.tmpl. DO NOT EDIT.
+
+package powerfunctest
+
+import (
+	"context"
+
+	"github.com/jonathanmontane/powerfunc"
+)
+
+// StubCtxFunc1VariadicError returns a CtxFunc1VariadicError returning the outcomes in order, one per
+// call. The last outcome is repeated once they are exhausted, and zero values
+// are returned if there are none.
+func StubCtxFunc1VariadicError[V, P0 any](outcomes ...error) powerfunc.CtxFunc1VariadicError[V, P0] {
+	s := &script{}
+	return func(ctx context.Context, p0 P0, xs ...V) error {
+		var out error
+		if i := s.next(len(outcomes)); i >= 0 {
+			out = outcomes[i]
+		}
+		return out
+	}
+}
+
+// SpyCtxFunc1VariadicError returns a CtxFunc1VariadicError recording the calls of f, along with the
+// Spy holding them.
+func SpyCtxFunc1VariadicError[V, P0 any](f powerfunc.CtxFunc1VariadicError[V, P0]) (powerfunc.CtxFunc1VariadicError[V, P0], *Spy) {
+	s := &Spy{}
+	return func(ctx context.Context, p0 P0, xs ...V) error {
+		call := s.begin(ctx, p0, xs)
+		panicked := true
+		defer func() {
+			s.end(call, panicked)
+		}()
+		err := f(ctx, p0, xs...)
+		panicked = false
+		s.fail(call, err)
+		return err
+	}, s
+}
+
+// FailNCtxFunc1VariadicError returns a CtxFunc1VariadicError returning err for the first n calls,
+// then calling f.
+func FailNCtxFunc1VariadicError[V, P0 any](n int, err error, f powerfunc.CtxFunc1VariadicError[V, P0]) powerfunc.CtxFunc1VariadicError[V, P0] {
+	fl := &failer{n: int64(n)}
+	return func(ctx context.Context, p0 P0, xs ...V) error {
+		if fl.fail() {
+			return err
+		}
+		return f(ctx, p0, xs...)
+	}
+}
+
+// DelayCtxFunc1VariadicError returns a CtxFunc1VariadicError waiting for latency before calling f.
+// The options set the clock measuring the latency, see DelayClock.
+// The error of the context is returned if it is done first.
+func DelayCtxFunc1VariadicError[V, P0 any](latency Latency, f powerfunc.CtxFunc1VariadicError[V, P0], opts ...DelayOption) powerfunc.CtxFunc1VariadicError[V, P0] {
+	d := newDelay(latency, opts)
+	return func(ctx context.Context, p0 P0, xs ...V) error {
+		if err := d.wait(ctx); err != nil {
+			return err
+		}
+		return f(ctx, p0, xs...)
+	}
+}
+
+// GateCtxFunc1VariadicError returns a CtxFunc1VariadicError blocking until g lets the call through
+// before calling f.
+// The error of the context is returned if it is done first.
+func GateCtxFunc1VariadicError[V, P0 any](g *Gate, f powerfunc.CtxFunc1VariadicError[V, P0]) powerfunc.CtxFunc1VariadicError[V, P0] {
+	return func(ctx context.Context, p0 P0, xs ...V) error {
+		if err := g.wait(ctx); err != nil {
+			return err
+		}
+		return f(ctx, p0, xs...)
+	}
+}
@@ -0,0 +1,71 @@
+// Code generated by powerfunc/generator from fakes.go.tmpl. DO NOT EDIT.
+
+package powerfunctest
+
+import (
+	"context"
+
+	"github.com/jonathanmontane/powerfunc"
+)
+
+// StubFunc1Error returns a Func1Error returning the outcomes in order, one per
+// call. The last outcome is repeated once they are exhausted, and zero values
+// are returned if there are none.
+func StubFunc1Error[P0 any](outcomes ...error) powerfunc.Func1Error[P0] {
+	s := &script{}
+	return func(p0 P0) error {
+		var out error
+		if i := s.next(len(outcomes)); i >= 0 {
+			out = outcomes[i]
+		}
+		return out
+	}
+}
+
+// SpyFunc1Error returns a Func1Error recording the calls of f, along with the
+// Spy holding them.
+func SpyFunc1Error[P0 any](f powerfunc.Func1Error[P0]) (powerfunc.Func1Error[P0], *Spy) {
+	s := &Spy{}
+	return func(p0 P0) error {
+		call := s.begin(context.Background(), p0)
+		panicked := true
+		defer func() {
+			s.end(call, panicked)
+		}()
+		err := f(p0)
+		panicked = false
+		s.fail(call, err)
+		return err
+	}, s
+}
+
+// FailNFunc1Error returns a Func1Error returning err for the first n calls,
+// then calling f.
+func FailNFunc1Error[P0 any](n int, err error, f powerfunc.Func1Error[P0]) powerfunc.Func1Error[P0] {
+	fl := &failer{n: int64(n)}
+	return func(p0 P0) error {
+		if fl.fail() {
+			return err
+		}
+		return f(p0)
+	}
+}
+
+// DelayFunc1Error returns a Func1Error waiting for latency before calling f.
+// The options set the clock measuring the latency, see DelayClock.
+func DelayFunc1Error[P0 any](latency Latency, f powerfunc.Func1Error[P0], opts ...DelayOption) powerfunc.Func1Error[P0] {
+	d := newDelay(latency, opts)
+	return func(p0 P0) error {
+		_ = d.wait(context.Background())
+		return f(p0)
+	}
+}
+
+// GateFunc1Error returns a Func1Error blocking until g lets the call through
+// before calling f.
+func GateFunc1Error[P0 any](g *Gate, f powerfunc.Func1Error[P0]) powerfunc.Func1Error[P0] {
+	return func(p0 P0) error {
+		_ = g.wait(context.Background())
+		return f(p0)
+	}
+}
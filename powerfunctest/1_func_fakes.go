@@ -0,0 +1,43 @@
+// Code generated by powerfunc/generator from fakes.go.tmpl. DO NOT EDIT.
+
+package powerfunctest
+
+import (
+	"context"
+
+	"github.com/jonathanmontane/powerfunc"
+)
+
+// SpyFunc1 returns a Func1 recording the calls of f, along with the
+// Spy holding them.
+func SpyFunc1[P0 any](f powerfunc.Func1[P0]) (powerfunc.Func1[P0], *Spy) {
+	s := &Spy{}
+	return func(p0 P0) {
+		call := s.begin(context.Background(), p0)
+		panicked := true
+		defer func() {
+			s.end(call, panicked)
+		}()
+		f(p0)
+		panicked = false
+	}, s
+}
+
+// DelayFunc1 returns a Func1 waiting for latency before calling f.
+// The options set the clock measuring the latency, see DelayClock.
+func DelayFunc1[P0 any](latency Latency, f powerfunc.Func1[P0], opts ...DelayOption) powerfunc.Func1[P0] {
+	d := newDelay(latency, opts)
+	return func(p0 P0) {
+		_ = d.wait(context.Background())
+		f(p0)
+	}
+}
+
+// GateFunc1 returns a Func1 blocking until g lets the call through
+// before calling f.
+func GateFunc1[P0 any](g *Gate, f powerfunc.Func1[P0]) powerfunc.Func1[P0] {
+	return func(p0 P0) {
+		_ = g.wait(context.Background())
+		f(p0)
+	}
+}
@@ -0,0 +1,58 @@
+// Code generated by powerfunc/generator from fakes.go.tmpl. DO NOT EDIT.
+
+package powerfunctest
+
+import (
+	"context"
+
+	"github.com/jonathanmontane/powerfunc"
+)
+
+// StubFunc1Ok returns a Func1Ok returning the outcomes in order, one per
+// call. The last outcome is repeated once they are exhausted, and zero values
+// are returned if there are none.
+func StubFunc1Ok[R, P0 any](outcomes ...Option[R]) powerfunc.Func1Ok[R, P0] {
+	s := &script{}
+	return func(p0 P0) (R, bool) {
+		var out Option[R]
+		if i := s.next(len(outcomes)); i >= 0 {
+			out = outcomes[i]
+		}
+		return out.Value, out.Ok
+	}
+}
+
+// SpyFunc1Ok returns a Func1Ok recording the calls of f, along with the
+// Spy holding them.
+func SpyFunc1Ok[R, P0 any](f powerfunc.Func1Ok[R, P0]) (powerfunc.Func1Ok[R, P0], *Spy) {
+	s := &Spy{}
+	return func(p0 P0) (R, bool) {
+		call := s.begin(context.Background(), p0)
+		panicked := true
+		defer func() {
+			s.end(call, panicked)
+		}()
+		r0, r1 := f(p0)
+		panicked = false
+		return r0, r1
+	}, s
+}
+
+// DelayFunc1Ok returns a Func1Ok waiting for latency before calling f.
+// The options set the clock measuring the latency, see DelayClock.
+func DelayFunc1Ok[R, P0 any](latency Latency, f powerfunc.Func1Ok[R, P0], opts ...DelayOption) powerfunc.Func1Ok[R, P0] {
+	d := newDelay(latency, opts)
+	return func(p0 P0) (R, bool) {
+		_ = d.wait(context.Background())
+		return f(p0)
+	}
+}
+
+// GateFunc1Ok returns a Func1Ok blocking until g lets the call through
+// before calling f.
+func GateFunc1Ok[R, P0 any](g *Gate, f powerfunc.Func1Ok[R, P0]) powerfunc.Func1Ok[R, P0] {
+	return func(p0 P0) (R, bool) {
+		_ = g.wait(context.Background())
+		return f(p0)
+	}
+}
@@ -0,0 +1,58 @@
+// Code generated by powerfunc/generator from fakes.go.tmpl. DO NOT EDIT.
+
+package powerfunctest
+
+import (
+	"context"
+
+	"github.com/jonathanmontane/powerfunc"
+)
+
+// StubFunc1Pair returns a Func1Pair returning the outcomes in order, one per
+// call. The last outcome is repeated once they are exhausted, and zero values
+// are returned if there are none.
+func StubFunc1Pair[R1, R2, P0 any](outcomes ...Pair[R1, R2]) powerfunc.Func1Pair[R1, R2, P0] {
+	s := &script{}
+	return func(p0 P0) (R1, R2) {
+		var out Pair[R1, R2]
+		if i := s.next(len(outcomes)); i >= 0 {
+			out = outcomes[i]
+		}
+		return out.First, out.Second
+	}
+}
+
+// SpyFunc1Pair returns a Func1Pair recording the calls of f, along with the
+// Spy holding them.
+func SpyFunc1Pair[R1, R2, P0 any](f powerfunc.Func1Pair[R1, R2, P0]) (powerfunc.Func1Pair[R1, R2, P0], *Spy) {
+	s := &Spy{}
+	return func(p0 P0) (R1, R2) {
+		call := s.begin(context.Background(), p0)
+		panicked := true
+		defer func() {
+			s.end(call, panicked)
+		}()
+		r0, r1 := f(p0)
+		panicked = false
+		return r0, r1
+	}, s
+}
+
+// DelayFunc1Pair returns a Func1Pair waiting for latency before calling f.
+// The options set the clock measuring the latency, see DelayClock.
+func DelayFunc1Pair[R1, R2, P0 any](latency Latency, f powerfunc.Func1Pair[R1, R2, P0], opts ...DelayOption) powerfunc.Func1Pair[R1, R2, P0] {
+	d := newDelay(latency, opts)
+	return func(p0 P0) (R1, R2) {
+		_ = d.wait(context.Background())
+		return f(p0)
+	}
+}
+
+// GateFunc1Pair returns a Func1Pair blocking until g lets the call through
+// before calling f.
+func GateFunc1Pair[R1, R2, P0 any](g *Gate, f powerfunc.Func1Pair[R1, R2, P0]) powerfunc.Func1Pair[R1, R2, P0] {
+	return func(p0 P0) (R1, R2) {
+		_ = g.wait(context.Background())
+		return f(p0)
+	}
+}
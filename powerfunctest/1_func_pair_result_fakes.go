@@ -0,0 +1,71 @@
+// Code generated by powerfunc/generator from fakes.go.tmpl. DO NOT EDIT.
+
+package powerfunctest
+
+import (
+	"context"
+
+	"github.com/jonathanmontane/powerfunc"
+)
+
+// StubFunc1PairResult returns a Func1PairResult returning the outcomes in order, one per
+// call. The last outcome is repeated once they are exhausted, and zero values
+// are returned if there are none.
+func StubFunc1PairResult[R1, R2, P0 any](outcomes ...PairResult[R1, R2]) powerfunc.Func1PairResult[R1, R2, P0] {
+	s := &script{}
+	return func(p0 P0) (R1, R2, error) {
+		var out PairResult[R1, R2]
+		if i := s.next(len(outcomes)); i >= 0 {
+			out = outcomes[i]
+		}
+		return out.First, out.Second, out.Err
+	}
+}
+
+// SpyFunc1PairResult returns a Func1PairResult recording the calls of f, along with the
+// Spy holding them.
+func SpyFunc1PairResult[R1, R2, P0 any](f powerfunc.Func1PairResult[R1, R2, P0]) (powerfunc.Func1PairResult[R1, R2, P0], *Spy) {
+	s := &Spy{}
+	return func(p0 P0) (R1, R2, error) {
+		call := s.begin(context.Background(), p0)
+		panicked := true
+		defer func() {
+			s.end(call, panicked)
+		}()
+		r0, r1, err := f(p0)
+		panicked = false
+		s.fail(call, err)
+		return r0, r1, err
+	}, s
+}
+
+// FailNFunc1PairResult returns a Func1PairResult returning err for the first n calls,
+// then calling f.
+func FailNFunc1PairResult[R1, R2, P0 any](n int, err error, f powerfunc.Func1PairResult[R1, R2, P0]) powerfunc.Func1PairResult[R1, R2, P0] {
+	fl := &failer{n: int64(n)}
+	return func(p0 P0) (R1, R2, error) {
+		if fl.fail() {
+			return *new(R1), *new(R2), err
+		}
+		return f(p0)
+	}
+}
+
+// DelayFunc1PairResult returns a Func1PairResult waiting for latency before calling f.
+// The options set the clock measuring the latency, see DelayClock.
+func DelayFunc1PairResult[R1, R2, P0 any](latency Latency, f powerfunc.Func1PairResult[R1, R2, P0], opts ...DelayOption) powerfunc.Func1PairResult[R1, R2, P0] {
+	d := newDelay(latency, opts)
+	return func(p0 P0) (R1, R2, error) {
+		_ = d.wait(context.Background())
+		return f(p0)
+	}
+}
+
+// GateFunc1PairResult returns a Func1PairResult blocking until g lets the call through
+// before calling f.
+func GateFunc1PairResult[R1, R2, P0 any](g *Gate, f powerfunc.Func1PairResult[R1, R2, P0]) powerfunc.Func1PairResult[R1, R2, P0] {
+	return func(p0 P0) (R1, R2, error) {
+		_ = g.wait(context.Background())
+		return f(p0)
+	}
+}
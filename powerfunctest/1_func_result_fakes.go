@@ -0,0 +1,71 @@
+// Code generated by powerfunc/generator from fakes.go.tmpl. DO NOT EDIT.
+
+package powerfunctest
+
+import (
+	"context"
+
+	"github.com/jonathanmontane/powerfunc"
+)
+
+// StubFunc1Result returns a Func1Result returning the outcomes in order, one per
+// call. The last outcome is repeated once they are exhausted, and zero values
+// are returned if there are none.
+func StubFunc1Result[R, P0 any](outcomes ...Result[R]) powerfunc.Func1Result[R, P0] {
+	s := &script{}
+	return func(p0 P0) (R, error) {
+		var out Result[R]
+		if i := s.next(len(outcomes)); i >= 0 {
+			out = outcomes[i]
+		}
+		return out.Value, out.Err
+	}
+}
+
+// SpyFunc1Result returns a Func1Result recording the calls of f, along with the
+// Spy holding them.
+func SpyFunc1Result[R, P0 any](f powerfunc.Func1Result[R, P0]) (powerfunc.Func1Result[R, P0], *Spy) {
+	s := &Spy{}
+	return func(p0 P0) (R, error) {
+		call := s.begin(context.Background(), p0)
+		panicked := true
+		defer func() {
+			s.end(call, panicked)
+		}()
+		r0, err := f(p0)
+		panicked = false
+		s.fail(call, err)
+		return r0, err
+	}, s
+}
+
+// FailNFunc1Result returns a Func1Result returning err for the first n calls,
+// then calling f.
+func FailNFunc1Result[R, P0 any](n int, err error, f powerfunc.Func1Result[R, P0]) powerfunc.Func1Result[R, P0] {
+	fl := &failer{n: int64(n)}
+	return func(p0 P0) (R, error) {
+		if fl.fail() {
+			return *new(R), err
+		}
+		return f(p0)
+	}
+}
+
+// DelayFunc1Result returns a Func1Result waiting for latency before calling f.
+// The options set the clock measuring the latency, see DelayClock.
+func DelayFunc1Result[R, P0 any](latency Latency, f powerfunc.Func1Result[R, P0], opts ...DelayOption) powerfunc.Func1Result[R, P0] {
+	d := newDelay(latency, opts)
+	return func(p0 P0) (R, error) {
+		_ = d.wait(context.Background())
+		return f(p0)
+	}
+}
+
+// GateFunc1Result returns a Func1Result blocking until g lets the call through
+// before calling f.
+func GateFunc1Result[R, P0 any](g *Gate, f powerfunc.Func1Result[R, P0]) powerfunc.Func1Result[R, P0] {
+	return func(p0 P0) (R, error) {
+		_ = g.wait(context.Background())
+		return f(p0)
+	}
+}
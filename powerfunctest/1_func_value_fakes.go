@@ -0,0 +1,58 @@
+// Code generated by powerfunc/generator from fakes.go.tmpl. DO NOT EDIT.
+
+package powerfunctest
+
+import (
+	"context"
+
+	"github.com/jonathanmontane/powerfunc"
+)
+
+// StubFunc1Value returns a Func1Value returning the outcomes in order, one per
+// call. The last outcome is repeated once they are exhausted, and zero values
+// are returned if there are none.
+func StubFunc1Value[R, P0 any](outcomes ...R) powerfunc.Func1Value[R, P0] {
+	s := &script{}
+	return func(p0 P0) R {
+		var out R
+		if i := s.next(len(outcomes)); i >= 0 {
+			out = outcomes[i]
+		}
+		return out
+	}
+}
+
+// SpyFunc1Value returns a Func1Value recording the calls of f, along with the
+// Spy holding them.
+func SpyFunc1Value[R, P0 any](f powerfunc.Func1Value[R, P0]) (powerfunc.Func1Value[R, P0], *Spy) {
+	s := &Spy{}
+	return func(p0 P0) R {
+		call := s.begin(context.Background(), p0)
+		panicked := true
+		defer func() {
+			s.end(call, panicked)
+		}()
+		r0 := f(p0)
+		panicked = false
+		return r0
+	}, s
+}
+
+// DelayFunc1Value returns a Func1Value waiting for latency before calling f.
+// The options set the clock measuring the latency, see DelayClock.
+func DelayFunc1Value[R, P0 any](latency Latency, f powerfunc.Func1Value[R, P0], opts ...DelayOption) powerfunc.Func1Value[R, P0] {
+	d := newDelay(latency, opts)
+	return func(p0 P0) R {
+		_ = d.wait(context.Background())
+		return f(p0)
+	}
+}
+
+// GateFunc1Value returns a Func1Value blocking until g lets the call through
+// before calling f.
+func GateFunc1Value[R, P0 any](g *Gate, f powerfunc.Func1Value[R, P0]) powerfunc.Func1Value[R, P0] {
+	return func(p0 P0) R {
+		_ = g.wait(context.Background())
+		return f(p0)
+	}
+}
@@ -0,0 +1,71 @@
+// Code generated by powerfunc/generator from fakes.go.tmpl. DO NOT EDIT.
+
+package powerfunctest
+
+import (
+	"context"
+
+	"github.com/jonathanmontane/powerfunc"
+)
+
+// StubFunc1VariadicError returns a Func1VariadicError returning the outcomes in order, one per
+// call. The last outcome is repeated once they are exhausted, and zero values
+// are returned if there are none.
+func StubFunc1VariadicError[V, P0 any](outcomes ...error) powerfunc.Func1VariadicError[V, P0] {
+	s := &script{}
+	return func(p0 P0, xs ...V) error {
+		var out error
+		if i := s.next(len(outcomes)); i >= 0 {
+			out = outcomes[i]
+		}
+		return out
+	}
+}
+
+// SpyFunc1VariadicError returns a Func1VariadicError recording the calls of f, along with the
+// Spy holding them.
+func SpyFunc1VariadicError[V, P0 any](f powerfunc.Func1VariadicError[V, P0]) (powerfunc.Func1VariadicError[V, P0], *Spy) {
+	s := &Spy{}
+	return func(p0 P0, xs ...V) error {
+		call := s.begin(context.Background(), p0, xs)
+		panicked := true
+		defer func() {
+			s.end(call, panicked)
+		}()
+		err := f(p0, xs...)
+		panicked = false
+		s.fail(call, err)
+		return err
+	}, s
+}
+
+// FailNFunc1VariadicError returns a Func1VariadicError returning err for the first n calls,
+// then calling f.
+func FailNFunc1VariadicError[V, P0 any](n int, err error, f powerfunc.Func1VariadicError[V, P0]) powerfunc.Func1VariadicError[V, P0] {
+	fl := &failer{n: int64(n)}
+	return func(p0 P0, xs ...V) error {
+		if fl.fail() {
+			return err
+		}
+		return f(p0, xs...)
+	}
+}
+
+// DelayFunc1VariadicError returns a Func1VariadicError waiting for latency before calling f.
+// The options set the clock measuring the latency, see DelayClock.
+func DelayFunc1VariadicError[V, P0 any](latency Latency, f powerfunc.Func1VariadicError[V, P0], opts ...DelayOption) powerfunc.Func1VariadicError[V, P0] {
+	d := newDelay(latency, opts)
+	return func(p0 P0, xs ...V) error {
+		_ = d.wait(context.Background())
+		return f(p0, xs...)
+	}
+}
+
+// GateFunc1VariadicError returns a Func1VariadicError blocking until g lets the call through
+// before calling f.
+func GateFunc1VariadicError[V, P0 any](g *Gate, f powerfunc.Func1VariadicError[V, P0]) powerfunc.Func1VariadicError[V, P0] {
+	return func(p0 P0, xs ...V) error {
+		_ = g.wait(context.Background())
+		return f(p0, xs...)
+	}
+}
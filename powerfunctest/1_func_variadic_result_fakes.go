@@ -0,0 +1,71 @@
+// Code generated by powerfunc/generator from fakes.go.tmpl. DO NOT EDIT.
+
+package powerfunctest
+
+import (
+	"context"
+
+	"github.com/jonathanmontane/powerfunc"
+)
+
+// StubFunc1VariadicResult returns a Func1VariadicResult returning the outcomes in order, one per
+// call. The last outcome is repeated once they are exhausted, and zero values
+// are returned if there are none.
+func StubFunc1VariadicResult[R, V, P0 any](outcomes ...Result[R]) powerfunc.Func1VariadicResult[R, V, P0] {
+	s := &script{}
+	return func(p0 P0, xs ...V) (R, error) {
+		var out Result[R]
+		if i := s.next(len(outcomes)); i >= 0 {
+			out = outcomes[i]
+		}
+		return out.Value, out.Err
+	}
+}
+
+// SpyFunc1VariadicResult returns a Func1VariadicResult recording the calls of f, along with the
+// Spy holding them.
+func SpyFunc1VariadicResult[R, V, P0 any](f powerfunc.Func1VariadicResult[R, V, P0]) (powerfunc.Func1VariadicResult[R, V, P0], *Spy) {
+	s := &Spy{}
+	return func(p0 P0, xs ...V) (R, error) {
+		call := s.begin(context.Background(), p0, xs)
+		panicked := true
+		defer func() {
+			s.end(call, panicked)
+		}()
+		r0, err := f(p0, xs...)
+		panicked = false
+		s.fail(call, err)
+		return r0, err
+	}, s
+}
+
+// FailNFunc1VariadicResult returns a Func1VariadicResult returning err for the first n calls,
+// then calling f.
+func FailNFunc1VariadicResult[R, V, P0 any](n int, err error, f powerfunc.Func1VariadicResult[R, V, P0]) powerfunc.Func1VariadicResult[R, V, P0] {
+	fl := &failer{n: int64(n)}
+	return func(p0 P0, xs ...V) (R, error) {
+		if fl.fail() {
+			return *new(R), err
+		}
+		return f(p0, xs...)
+	}
+}
+
+// DelayFunc1VariadicResult returns a Func1VariadicResult waiting for latency before calling f.
+// The options set the clock measuring the latency, see DelayClock.
+func DelayFunc1VariadicResult[R, V, P0 any](latency Latency, f powerfunc.Func1VariadicResult[R, V, P0], opts ...DelayOption) powerfunc.Func1VariadicResult[R, V, P0] {
+	d := newDelay(latency, opts)
+	return func(p0 P0, xs ...V) (R, error) {
+		_ = d.wait(context.Background())
+		return f(p0, xs...)
+	}
+}
+
+// GateFunc1VariadicResult returns a Func1VariadicResult blocking until g lets the call through
+// before calling f.
+func GateFunc1VariadicResult[R, V, P0 any](g *Gate, f powerfunc.Func1VariadicResult[R, V, P0]) powerfunc.Func1VariadicResult[R, V, P0] {
+	return func(p0 P0, xs ...V) (R, error) {
+		_ = g.wait(context.Background())
+		return f(p0, xs...)
+	}
+}
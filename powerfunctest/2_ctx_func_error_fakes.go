@@ -0,0 +1,77 @@
+// Code generated by powerfunc/generator from fakes.go.tmpl. DO NOT EDIT.
+
+package powerfunctest
+
+import (
+	"context"
+
+	"github.com/jonathanmontane/powerfunc"
+)
+
+// StubCtxFunc2Error returns a CtxFunc2Error returning the outcomes in order, one per
+// call. The last outcome is repeated once they are exhausted, and zero values
+// are returned if there are none.
+func StubCtxFunc2Error[P0, P1 any](outcomes ...error) powerfunc.CtxFunc2Error[P0, P1] {
+	s := &script{}
+	return func(ctx context.Context, p0 P0, p1 P1) error {
+		var out error
+		if i := s.next(len(outcomes)); i >= 0 {
+			out = outcomes[i]
+		}
+		return out
+	}
+}
+
+// SpyCtxFunc2Error returns a CtxFunc2Error recording the calls of f, along with the
+// Spy holding them.
+func SpyCtxFunc2Error[P0, P1 any](f powerfunc.CtxFunc2Error[P0, P1]) (powerfunc.CtxFunc2Error[P0, P1], *Spy) {
+	s := &Spy{}
+	return func(ctx context.Context, p0 P0, p1 P1) error {
+		call := s.begin(ctx, p0, p1)
+		panicked := true
+		defer func() {
+			s.end(call, panicked)
+		}()
+		err := f(ctx, p0, p1)
+		panicked = false
+		s.fail(call, err)
+		return err
+	}, s
+}
+
+// FailNCtxFunc2Error returns a CtxFunc2Error returning err for the first n calls,
+// then calling f.
+func FailNCtxFunc2Error[P0, P1 any](n int, err error, f powerfunc.CtxFunc2Error[P0, P1]) powerfunc.CtxFunc2Error[P0, P1] {
+	fl := &failer{n: int64(n)}
+	return func(ctx context.Context, p0 P0, p1 P1) error {
+		if fl.fail() {
+			return err
+		}
+		return f(ctx, p0, p1)
+	}
+}
+
+// DelayCtxFunc2Error returns a CtxFunc2Error waiting for latency before calling f.
+// The options set the clock measuring the latency, see DelayClock.
+// The error of the context is returned if it is done first.
+func DelayCtxFunc2Error[P0, P1 any](latency Latency, f powerfunc.CtxFunc2Error[P0, P1], opts ...DelayOption) powerfunc.CtxFunc2Error[P0, P1] {
+	d := newDelay(latency, opts)
+	return func(ctx context.Context, p0 P0, p1 P1) error {
+		if err := d.wait(ctx); err != nil {
+			return err
+		}
+		return f(ctx, p0, p1)
+	}
+}
+
+// GateCtxFunc2Error returns a CtxFunc2Error blocking until g lets the call through
+// before calling f.
+// The error of the context is returned if it is done first.
+func GateCtxFunc2Error[P0, P1 any](g *Gate, f powerfunc.CtxFunc2Error[P0, P1]) powerfunc.CtxFunc2Error[P0, P1] {
+	return func(ctx context.Context, p0 P0, p1 P1) error {
+		if err := g.wait(ctx); err != nil {
+			return err
+		}
+		return f(ctx, p0, p1)
+	}
+}
@@ -0,0 +1,45 @@
+// Code generated by powerfunc/generator from fakes.go.tmpl. DO NOT EDIT.
+
+package powerfunctest
+
+import (
+	"context"
+
+	"github.com/jonathanmontane/powerfunc"
+)
+
+// SpyCtxFunc2 returns a CtxFunc2 recording the calls of f, along with the
+// Spy holding them.
+func SpyCtxFunc2[P0, P1 any](f powerfunc.CtxFunc2[P0, P1]) (powerfunc.CtxFunc2[P0, P1], *Spy) {
+	s := &Spy{}
+	return func(ctx context.Context, p0 P0, p1 P1) {
+		call := s.begin(ctx, p0, p1)
+		panicked := true
+		defer func() {
+			s.end(call, panicked)
+		}()
+		f(ctx, p0, p1)
+		panicked = false
+	}, s
+}
+
+// DelayCtxFunc2 returns a CtxFunc2 waiting for latency before calling f.
+// The options set the clock measuring the latency, see DelayClock.
+// f is still called if the context is done first.
+func DelayCtxFunc2[P0, P1 any](latency Latency, f powerfunc.CtxFunc2[P0, P1], opts ...DelayOption) powerfunc.CtxFunc2[P0, P1] {
+	d := newDelay(latency, opts)
+	return func(ctx context.Context, p0 P0, p1 P1) {
+		_ = d.wait(ctx)
+		f(ctx, p0, p1)
+	}
+}
+
+// GateCtxFunc2 returns a CtxFunc2 blocking until g lets the call through
+// before calling f.
+// f is still called if the context is done first.
+func GateCtxFunc2[P0, P1 any](g *Gate, f powerfunc.CtxFunc2[P0, P1]) powerfunc.CtxFunc2[P0, P1] {
+	return func(ctx context.Context, p0 P0, p1 P1) {
+		_ = g.wait(ctx)
+		f(ctx, p0, p1)
+	}
+}
@@ -0,0 +1,77 @@
+// Code generated by powerfunc/generator from fakes.go.tmpl. DO NOT EDIT.
+
+package powerfunctest
+
+import (
+	"context"
+
+	"github.com/jonathanmontane/powerfunc"
+)
+
+// StubCtxFunc2PairResult returns a CtxFunc2PairResult returning the outcomes in order, one per
+// call. The last outcome is repeated once they are exhausted, and zero values
+// are returned if there are none.
+func StubCtxFunc2PairResult[R1, R2, P0, P1 any](outcomes ...PairResult[R1, R2]) powerfunc.CtxFunc2PairResult[R1, R2, P0, P1] {
+	s := &script{}
+	return func(ctx context.Context, p0 P0, p1 P1) (R1, R2, error) {
+		var out PairResult[R1, R2]
+		if i := s.next(len(outcomes)); i >= 0 {
+			out = outcomes[i]
+		}
+		return out.First, out.Second, out.Err
+	}
+}
+
+// SpyCtxFunc2PairResult returns a CtxFunc2PairResult recording the calls of f, along with the
+// Spy holding them.
+func SpyCtxFunc2PairResult[R1, R2, P0, P1 any](f powerfunc.CtxFunc2PairResult[R1, R2, P0, P1]) (powerfunc.CtxFunc2PairResult[R1, R2, P0, P1], *Spy) {
+	s := &Spy{}
+	return func(ctx context.Context, p0 P0, p1 P1) (R1, R2, error) {
+		call := s.begin(ctx, p0, p1)
+		panicked := true
+		defer func() {
+			s.end(call, panicked)
+		}()
+		r0, r1, err := f(ctx, p0, p1)
+		panicked = false
+		s.fail(call, err)
+		return r0, r1, err
+	}, s
+}
+
+// FailNCtxFunc2PairResult returns a CtxFunc2PairResult returning err for the first n calls,
+// then calling f.
+func FailNCtxFunc2PairResult[R1, R2, P0, P1 any](n int, err error, f powerfunc.CtxFunc2PairResult[R1, R2, P0, P1]) powerfunc.CtxFunc2PairResult[R1, R2, P0, P1] {
+	fl := &failer{n: int64(n)}
+	return func(ctx context.Context, p0 P0, p1 P1) (R1, R2, error) {
+		if fl.fail() {
+			return *new(R1), *new(R2), err
+		}
+		return f(ctx, p0, p1)
+	}
+}
+
+// DelayCtxFunc2PairResult returns a CtxFunc2PairResult waiting for latency before calling f.
+// The options set the clock measuring the latency, see DelayClock.
+// The error of the context is returned if it is done first.
+func DelayCtxFunc2PairResult[R1, R2, P0, P1 any](latency Latency, f powerfunc.CtxFunc2PairResult[R1, R2, P0, P1], opts ...DelayOption) powerfunc.CtxFunc2PairResult[R1, R2, P0, P1] {
+	d := newDelay(latency, opts)
+	return func(ctx context.Context, p0 P0, p1 P1) (R1, R2, error) {
+		if err := d.wait(ctx); err != nil {
+			return *new(R1), *new(R2), err
+		}
+		return f(ctx, p0, p1)
+	}
+}
+
+// GateCtxFunc2PairResult returns a CtxFunc2PairResult blocking until g lets the call through
+// before calling f.
+// The error of the context is returned if it is done first.
+func GateCtxFunc2PairResult[R1, R2, P0, P1 any](g *Gate, f powerfunc.CtxFunc2PairResult[R1, R2, P0, P1]) powerfunc.CtxFunc2PairResult[R1, R2, P0, P1] {
+	return func(ctx context.Context, p0 P0, p1 P1) (R1, R2, error) {
+		if err := g.wait(ctx); err != nil {
+			return *new(R1), *new(R2), err
+		}
+		return f(ctx, p0, p1)
+	}
+}
@@ -0,0 +1,77 @@
+// Code generated by powerfunc/generator from fakes.go.tmpl. DO NOT EDIT.
+
+package powerfunctest
+
+import (
+	"context"
+
+	"github.com/jonathanmontane/powerfunc"
+)
+
+// StubCtxFunc2Result returns a CtxFunc2Result returning the outcomes in order, one per
+// call. The last outcome is repeated once they are exhausted, and zero values
+// are returned if there are none.
+func StubCtxFunc2Result[R, P0, P1 any](outcomes ...Result[R]) powerfunc.CtxFunc2Result[R, P0, P1] {
+	s := &script{}
+	return func(ctx context.Context, p0 P0, p1 P1) (R, error) {
+		var out Result[R]
+		if i := s.next(len(outcomes)); i >= 0 {
+			out = outcomes[i]
+		}
+		return out.Value, out.Err
+	}
+}
+
+// SpyCtxFunc2Result returns a CtxFunc2Result recording the calls of f, along with the
+// Spy holding them.
+func SpyCtxFunc2Result[R, P0, P1 any](f powerfunc.CtxFunc2Result[R, P0, P1]) (powerfunc.CtxFunc2Result[R, P0, P1], *Spy) {
+	s := &Spy{}
+	return func(ctx context.Context, p0 P0, p1 P1) (R, error) {
+		call := s.begin(ctx, p0, p1)
+		panicked := true
+		defer func() {
+			s.end(call, panicked)
+		}()
+		r0, err := f(ctx, p0, p1)
+		panicked = false
+		s.fail(call, err)
+		return r0, err
+	}, s
+}
+
+// FailNCtxFunc2Result returns a CtxFunc2Result returning err for the first n calls,
+// then calling f.
+func FailNCtxFunc2Result[R, P0, P1 any](n int, err error, f powerfunc.CtxFunc2Result[R, P0, P1]) powerfunc.CtxFunc2Result[R, P0, P1] {
+	fl := &failer{n: int64(n)}
+	return func(ctx context.Context, p0 P0, p1 P1) (R, error) {
+		if fl.fail() {
+			return *new(R), err
+		}
+		return f(ctx, p0, p1)
+	}
+}
+
+// DelayCtxFunc2Result returns a CtxFunc2Result waiting for latency before calling f.
+// The options set the clock measuring the latency, see DelayClock.
+// The error of the context is returned if it is done first.
+func DelayCtxFunc2Result[R, P0, P1 any](latency Latency, f powerfunc.CtxFunc2Result[R, P0, P1], opts ...DelayOption) powerfunc.CtxFunc2Result[R, P0, P1] {
+	d := newDelay(latency, opts)
+	return func(ctx context.Context, p0 P0, p1 P1) (R, error) {
+		if err := d.wait(ctx); err != nil {
+			return *new(R), err
+		}
+		return f(ctx, p0, p1)
+	}
+}
+
+// GateCtxFunc2Result returns a CtxFunc2Result blocking until g lets the call through
+// before calling f.
+// The error of the context is returned if it is done first.
+func GateCtxFunc2Result[R, P0, P1 any](g *Gate, f powerfunc.CtxFunc2Result[R, P0, P1]) powerfunc.CtxFunc2Result[R, P0, P1] {
+	return func(ctx context.Context, p0 P0, p1 P1) (R, error) {
+		if err := g.wait(ctx); err != nil {
+			return *new(R), err
+		}
+		return f(ctx, p0, p1)
+	}
+}
@@ -0,0 +1,60 @@
+// Code generated by powerfunc/generator from fakes.go.tmpl. DO NOT EDIT.
+
+package powerfunctest
+
+import (
+	"context"
+
+	"github.com/jonathanmontane/powerfunc"
+)
+
+// StubCtxFunc2Value returns a CtxFunc2Value returning the outcomes in order, one per
+// call. The last outcome is repeated once they are exhausted, and zero values
+// are returned if there are none.
+func StubCtxFunc2Value[R, P0, P1 any](outcomes ...R) powerfunc.CtxFunc2Value[R, P0, P1] {
+	s := &script{}
+	return func(ctx context.Context, p0 P0, p1 P1) R {
+		var out R
+		if i := s.next(len(outcomes)); i >= 0 {
+			out = outcomes[i]
+		}
+		return out
+	}
+}
+
+// SpyCtxFunc2Value returns a CtxFunc2Value recording the calls of f, along with the
+// Spy holding them.
+func SpyCtxFunc2Value[R, P0, P1 any](f powerfunc.CtxFunc2Value[R, P0, P1]) (powerfunc.CtxFunc2Value[R, P0, P1], *Spy) {
+	s := &Spy{}
+	return func(ctx context.Context, p0 P0, p1 P1) R {
+		call := s.begin(ctx, p0, p1)
+		panicked := true
+		defer func() {
+			s.end(call, panicked)
+		}()
+		r0 := f(ctx, p0, p1)
+		panicked = false
+		return r0
+	}, s
+}
+
+// DelayCtxFunc2Value returns a CtxFunc2Value waiting for latency before calling f.
+// The options set the clock measuring the latency, see DelayClock.
+// f is still called if the context is done first.
+func DelayCtxFunc2Value[R, P0, P1 any](latency Latency, f powerfunc.CtxFunc2Value[R, P0, P1], opts ...DelayOption) powerfunc.CtxFunc2Value[R, P0, P1] {
+	d := newDelay(latency, opts)
+	return func(ctx context.Context, p0 P0, p1 P1) R {
+		_ = d.wait(ctx)
+		return f(ctx, p0, p1)
+	}
+}
+
+// GateCtxFunc2Value returns a CtxFunc2Value blocking until g lets the call through
+// before calling f.
+// f is still called if the context is done first.
+func GateCtxFunc2Value[R, P0, P1 any](g *Gate, f powerfunc.CtxFunc2Value[R, P0, P1]) powerfunc.CtxFunc2Value[R, P0, P1] {
+	return func(ctx context.Context, p0 P0, p1 P1) R {
+		_ = g.wait(ctx)
+		return f(ctx, p0, p1)
+	}
+}
@@ -0,0 +1,77 @@
+// Code generated by powerfunc/generator from fakes.go.tmpl. DO NOT EDIT.
+
+package powerfunctest
+
+import (
+	"context"
+
+	"github.com/jonathanmontane/powerfunc"
+)
+
+// StubCtxFunc2VariadicResult returns a CtxFunc2VariadicResult returning the outcomes in order, one per
+// call. The last outcome is repeated once they are exhausted, and zero values
+// are returned if there are none.
+func StubCtxFunc2VariadicResult[R, V, P0, P1 any](outcomes ...Result[R]) powerfunc.CtxFunc2VariadicResult[R, V, P0, P1] {
+	s := &script{}
+	return func(ctx context.Context, p0 P0, p1 P1, xs ...V) (R, error) {
+		var out Result[R]
+		if i := s.next(len(outcomes)); i >= 0 {
+			out = outcomes[i]
+		}
+		return out.Value, out.Err
+	}
+}
+
+// SpyCtxFunc2VariadicResult returns a CtxFunc2VariadicResult recording the calls of f, along with the
+// Spy holding them.
+func SpyCtxFunc2VariadicResult[R, V, P0, P1 any](f powerfunc.CtxFunc2VariadicResult[R, V, P0, P1]) (powerfunc.CtxFunc2VariadicResult[R, V, P0, P1], *Spy) {
+	s := &Spy{}
+	return func(ctx context.Context, p0 P0, p1 P1, xs ...V) (R, error) {
+		call := s.begin(ctx, p0, p1, xs)
+		panicked := true
+		defer func() {
+			s.end(call, panicked)
+		}()
+		r0, err := f(ctx, p0, p1, xs...)
+		panicked = false
+		s.fail(call, err)
+		return r0, err
+	}, s
+}
+
+// FailNCtxFunc2VariadicResult returns a CtxFunc2VariadicResult returning err for the first n calls,
+// then calling f.
+func FailNCtxFunc2VariadicResult[R, V, P0, P1 any](n int, err error, f powerfunc.CtxFunc2VariadicResult[R, V, P0, P1]) powerfunc.CtxFunc2VariadicResult[R, V, P0, P1] {
+	fl := &failer{n: int64(n)}
+	return func(ctx context.Context, p0 P0, p1 P1, xs ...V) (R, error) {
+		if fl.fail() {
+			return *new(R), err
+		}
+		return f(ctx, p0, p1, xs...)
+	}
+}
+
+// DelayCtxFunc2VariadicResult returns a CtxFunc2VariadicResult waiting for latency before calling f.
+// The options set the clock measuring the latency, see DelayClock.
+// The error of the context is returned if it is done first.
+func DelayCtxFunc2VariadicResult[R, V, P0, P1 any](latency Latency, f powerfunc.CtxFunc2VariadicResult[R, V, P0, P1], opts ...DelayOption) powerfunc.CtxFunc2VariadicResult[R, V, P0, P1] {
+	d := newDelay(latency, opts)
+	return func(ctx context.Context, p0 P0, p1 P1, xs ...V) (R, error) {
+		if err := d.wait(ctx); err != nil {
+			return *new(R), err
+		}
+		return f(ctx, p0, p1, xs...)
+	}
+}
+
+// GateCtxFunc2VariadicResult returns a CtxFunc2VariadicResult blocking until g lets the call through
+// before calling f.
+// The error of the context is returned if it is done first.
+func GateCtxFunc2VariadicResult[R, V, P0, P1 any](g *Gate, f powerfunc.CtxFunc2VariadicResult[R, V, P0, P1]) powerfunc.CtxFunc2VariadicResult[R, V, P0, P1] {
+	return func(ctx context.Context, p0 P0, p1 P1, xs ...V) (R, error) {
+		if err := g.wait(ctx); err != nil {
+			return *new(R), err
+		}
+		return f(ctx, p0, p1, xs...)
+	}
+}
@@ -0,0 +1,43 @@
+// Code generated by powerfunc/generator from fakes.go.tmpl. DO NOT EDIT.
+
+package powerfunctest
+
+import (
+	"context"
+
+	"github.com/jonathanmontane/powerfunc"
+)
+
+// SpyFunc2 returns a Func2 recording the calls of f, along with the
+// Spy holding them.
+func SpyFunc2[P0, P1 any](f powerfunc.Func2[P0, P1]) (powerfunc.Func2[P0, P1], *Spy) {
+	s := &Spy{}
+	return func(p0 P0, p1 P1) {
+		call := s.begin(context.Background(), p0, p1)
+		panicked := true
+		defer func() {
+			s.end(call, panicked)
+		}()
+		f(p0, p1)
+		panicked = false
+	}, s
+}
+
+// DelayFunc2 returns a Func2 waiting for latency before calling f.
+// The options set the clock measuring the latency, see DelayClock.
+func DelayFunc2[P0, P1 any](latency Latency, f powerfunc.Func2[P0, P1], opts ...DelayOption) powerfunc.Func2[P0, P1] {
+	d := newDelay(latency, opts)
+	return func(p0 P0, p1 P1) {
+		_ = d.wait(context.Background())
+		f(p0, p1)
+	}
+}
+
+// GateFunc2 returns a Func2 blocking until g lets the call through
+// before calling f.
+func GateFunc2[P0, P1 any](g *Gate, f powerfunc.Func2[P0, P1]) powerfunc.Func2[P0, P1] {
+	return func(p0 P0, p1 P1) {
+		_ = g.wait(context.Background())
+		f(p0, p1)
+	}
+}
@@ -0,0 +1,58 @@
+// Code generated by powerfunc/generator from fakes.go.tmpl. DO NOT EDIT.
+
+package powerfunctest
+
+import (
+	"context"
+
+	"github.com/jonathanmontane/powerfunc"
+)
+
+// StubFunc2Ok returns a Func2Ok returning the outcomes in order, one per
+// call. The last outcome is repeated once they are exhausted, and zero values
+// are returned if there are none.
+func StubFunc2Ok[R, P0, P1 any](outcomes ...Option[R]) powerfunc.Func2Ok[R, P0, P1] {
+	s := &script{}
+	return func(p0 P0, p1 P1) (R, bool) {
+		var out Option[R]
+		if i := s.next(len(outcomes)); i >= 0 {
+			out = outcomes[i]
+		}
+		return out.Value, out.Ok
+	}
+}
+
+// SpyFunc2Ok returns a Func2Ok recording the calls of f, along with the
+// Spy holding them.
+func SpyFunc2Ok[R, P0, P1 any](f powerfunc.Func2Ok[R, P0, P1]) (powerfunc.Func2Ok[R, P0, P1], *Spy) {
+	s := &Spy{}
+	return func(p0 P0, p1 P1) (R, bool) {
+		call := s.begin(context.Background(), p0, p1)
+		panicked := true
+		defer func() {
+			s.end(call, panicked)
+		}()
+		r0, r1 := f(p0, p1)
+		panicked = false
+		return r0, r1
+	}, s
+}
+
+// DelayFunc2Ok returns a Func2Ok waiting for latency before calling f.
+// The options set the clock measuring the latency, see DelayClock.
+func DelayFunc2Ok[R, P0, P1 any](latency Latency, f powerfunc.Func2Ok[R, P0, P1], opts ...DelayOption) powerfunc.Func2Ok[R, P0, P1] {
+	d := newDelay(latency, opts)
+	return func(p0 P0, p1 P1) (R, bool) {
+		_ = d.wait(context.Background())
+		return f(p0, p1)
+	}
+}
+
+// GateFunc2Ok returns a Func2Ok blocking until g lets the call through
+// before calling f.
+func GateFunc2Ok[R, P0, P1 any](g *Gate, f powerfunc.Func2Ok[R, P0, P1]) powerfunc.Func2Ok[R, P0, P1] {
+	return func(p0 P0, p1 P1) (R, bool) {
+		_ = g.wait(context.Background())
+		return f(p0, p1)
+	}
+}
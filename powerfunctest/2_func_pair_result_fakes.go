@@ -0,0 +1,71 @@
+// Code generated by powerfunc/generator from fakes.go.tmpl. DO NOT EDIT.
+
+package powerfunctest
+
+import (
+	"context"
+
+	"github.com/jonathanmontane/powerfunc"
+)
+
+// StubFunc2PairResult returns a Func2PairResult returning the outcomes in order, one per
+// call. The last outcome is repeated once they are exhausted, and zero values
+// are returned if there are none.
+func StubFunc2PairResult[R1, R2, P0, P1 any](outcomes ...PairResult[R1, R2]) powerfunc.Func2PairResult[R1, R2, P0, P1] {
+	s := &script{}
+	return func(p0 P0, p1 P1) (R1, R2, error) {
+		var out PairResult[R1, R2]
+		if i := s.next(len(outcomes)); i >= 0 {
+			out = outcomes[i]
+		}
+		return out.First, out.Second, out.Err
+	}
+}
+
+// SpyFunc2PairResult returns a Func2PairResult recording the calls of f, along with the
+// Spy holding them.
+func SpyFunc2PairResult[R1, R2, P0, P1 any](f powerfunc.Func2PairResult[R1, R2, P0, P1]) (powerfunc.Func2PairResult[R1, R2, P0, P1], *Spy) {
+	s := &Spy{}
+	return func(p0 P0, p1 P1) (R1, R2, error) {
+		call := s.begin(context.Background(), p0, p1)
+		panicked := true
+		defer func() {
+			s.end(call, panicked)
+		}()
+		r0, r1, err := f(p0, p1)
+		panicked = false
+		s.fail(call, err)
+		return r0, r1, err
+	}, s
+}
+
+// FailNFunc2PairResult returns a Func2PairResult returning err for the first n calls,
+// then calling f.
+func FailNFunc2PairResult[R1, R2, P0, P1 any](n int, err error, f powerfunc.Func2PairResult[R1, R2, P0, P1]) powerfunc.Func2PairResult[R1, R2, P0, P1] {
+	fl := &failer{n: int64(n)}
+	return func(p0 P0, p1 P1) (R1, R2, error) {
+		if fl.fail() {
+			return *new(R1), *new(R2), err
+		}
+		return f(p0, p1)
+	}
+}
+
+// DelayFunc2PairResult returns a Func2PairResult waiting for latency before calling f.
+// The options set the clock measuring the latency, see DelayClock.
+func DelayFunc2PairResult[R1, R2, P0, P1 any](latency Latency, f powerfunc.Func2PairResult[R1, R2, P0, P1], opts ...DelayOption) powerfunc.Func2PairResult[R1, R2, P0, P1] {
+	d := newDelay(latency, opts)
+	return func(p0 P0, p1 P1) (R1, R2, error) {
+		_ = d.wait(context.Background())
+		return f(p0, p1)
+	}
+}
+
+// GateFunc2PairResult returns a Func2PairResult blocking until g lets the call through
+// before calling f.
+func GateFunc2PairResult[R1, R2, P0, P1 any](g *Gate, f powerfunc.Func2PairResult[R1, R2, P0, P1]) powerfunc.Func2PairResult[R1, R2, P0, P1] {
+	return func(p0 P0, p1 P1) (R1, R2, error) {
+		_ = g.wait(context.Background())
+		return f(p0, p1)
+	}
+}
@@ -0,0 +1,71 @@
+// Code generated by powerfunc/generator from fakes.go.tmpl. DO NOT EDIT.
+
+package powerfunctest
+
+import (
+	"context"
+
+	"github.com/jonathanmontane/powerfunc"
+)
+
+// StubFunc2Result returns a Func2Result returning the outcomes in order, one per
+// call. The last outcome is repeated once they are exhausted, and zero values
+// are returned if there are none.
+func StubFunc2Result[R, P0, P1 any](outcomes ...Result[R]) powerfunc.Func2Result[R, P0, P1] {
+	s := &script{}
+	return func(p0 P0, p1 P1) (R, error) {
+		var out Result[R]
+		if i := s.next(len(outcomes)); i >= 0 {
+			out = outcomes[i]
+		}
+		return out.Value, out.Err
+	}
+}
+
+// SpyFunc2Result returns a Func2Result recording the calls of f, along with the
+// Spy holding them.
+func SpyFunc2Result[R, P0, P1 any](f powerfunc.Func2Result[R, P0, P1]) (powerfunc.Func2Result[R, P0, P1], *Spy) {
+	s := &Spy{}
+	return func(p0 P0, p1 P1) (R, error) {
+		call := s.begin(context.Background(), p0, p1)
+		panicked := true
+		defer func() {
+			s.end(call, panicked)
+		}()
+		r0, err := f(p0, p1)
+		panicked = false
+		s.fail(call, err)
+		return r0, err
+	}, s
+}
+
+// FailNFunc2Result returns a Func2Result returning err for the first n calls,
+// then calling f.
+func FailNFunc2Result[R, P0, P1 any](n int, err error, f powerfunc.Func2Result[R, P0, P1]) powerfunc.Func2Result[R, P0, P1] {
+	fl := &failer{n: int64(n)}
+	return func(p0 P0, p1 P1) (R, error) {
+		if fl.fail() {
+			return *new(R), err
+		}
+		return f(p0, p1)
+	}
+}
+
+// DelayFunc2Result returns a Func2Result waiting for latency before calling f.
+// The options set the clock measuring the latency, see DelayClock.
+func DelayFunc2Result[R, P0, P1 any](latency Latency, f powerfunc.Func2Result[R, P0, P1], opts ...DelayOption) powerfunc.Func2Result[R, P0, P1] {
+	d := newDelay(latency, opts)
+	return func(p0 P0, p1 P1) (R, error) {
+		_ = d.wait(context.Background())
+		return f(p0, p1)
+	}
+}
+
+// GateFunc2Result returns a Func2Result blocking until g lets the call through
+// before calling f.
+func GateFunc2Result[R, P0, P1 any](g *Gate, f powerfunc.Func2Result[R, P0, P1]) powerfunc.Func2Result[R, P0, P1] {
+	return func(p0 P0, p1 P1) (R, error) {
+		_ = g.wait(context.Background())
+		return f(p0, p1)
+	}
+}
@@ -0,0 +1,58 @@
+// Code generated by powerfunc/generator from fakes.go.tmpl. DO NOT EDIT.
+
+package powerfunctest
+
+import (
+	"context"
+
+	"github.com/jonathanmontane/powerfunc"
+)
+
+// StubFunc2Value returns a Func2Value returning the outcomes in order, one per
+// call. The last outcome is repeated once they are exhausted, and zero values
+// are returned if there are none.
+func StubFunc2Value[R, P0, P1 any](outcomes ...R) powerfunc.Func2Value[R, P0, P1] {
+	s := &script{}
+	return func(p0 P0, p1 P1) R {
+		var out R
+		if i := s.next(len(outcomes)); i >= 0 {
+			out = outcomes[i]
+		}
+		return out
+	}
+}
+
+// SpyFunc2Value returns a Func2Value recording the calls of f, along with the
+// Spy holding them.
+func SpyFunc2Value[R, P0, P1 any](f powerfunc.Func2Value[R, P0, P1]) (powerfunc.Func2Value[R, P0, P1], *Spy) {
+	s := &Spy{}
+	return func(p0 P0, p1 P1) R {
+		call := s.begin(context.Background(), p0, p1)
+		panicked := true
+		defer func() {
+			s.end(call, panicked)
+		}()
+		r0 := f(p0, p1)
+		panicked = false
+		return r0
+	}, s
+}
+
+// DelayFunc2Value returns a Func2Value waiting for latency before calling f.
+// The options set the clock measuring the latency, see DelayClock.
+func DelayFunc2Value[R, P0, P1 any](latency Latency, f powerfunc.Func2Value[R, P0, P1], opts ...DelayOption) powerfunc.Func2Value[R, P0, P1] {
+	d := newDelay(latency, opts)
+	return func(p0 P0, p1 P1) R {
+		_ = d.wait(context.Background())
+		return f(p0, p1)
+	}
+}
+
+// GateFunc2Value returns a Func2Value blocking until g lets the call through
+// before calling f.
+func GateFunc2Value[R, P0, P1 any](g *Gate, f powerfunc.Func2Value[R, P0, P1]) powerfunc.Func2Value[R, P0, P1] {
+	return func(p0 P0, p1 P1) R {
+		_ = g.wait(context.Background())
+		return f(p0, p1)
+	}
+}
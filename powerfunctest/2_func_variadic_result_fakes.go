@@ -0,0 +1,71 @@
+// Code generated by powerfunc/generator from fakes.go.tmpl. DO NOT EDIT.
+
+package powerfunctest
+
+import (
+	"context"
+
+	"github.com/jonathanmontane/powerfunc"
+)
+
+// StubFunc2VariadicResult returns a Func2VariadicResult returning the outcomes in order, one per
+// call. The last outcome is repeated once they are exhausted, and zero values
+// are returned if there are none.
+func StubFunc2VariadicResult[R, V, P0, P1 any](outcomes ...Result[R]) powerfunc.Func2VariadicResult[R, V, P0, P1] {
+	s := &script{}
+	return func(p0 P0, p1 P1, xs ...V) (R, error) {
+		var out Result[R]
+		if i := s.next(len(outcomes)); i >= 0 {
+			out = outcomes[i]
+		}
+		return out.Value, out.Err
+	}
+}
+
+// SpyFunc2VariadicResult returns a Func2VariadicResult recording the calls of f, along with the
+// Spy holding them.
+func SpyFunc2VariadicResult[R, V, P0, P1 any](f powerfunc.Func2VariadicResult[R, V, P0, P1]) (powerfunc.Func2VariadicResult[R, V, P0, P1], *Spy) {
+	s := &Spy{}
+	return func(p0 P0, p1 P1, xs ...V) (R, error) {
+		call := s.begin(context.Background(), p0, p1, xs)
+		panicked := true
+		defer func() {
+			s.end(call, panicked)
+		}()
+		r0, err := f(p0, p1, xs...)
+		panicked = false
+		s.fail(call, err)
+		return r0, err
+	}, s
+}
+
+// FailNFunc2VariadicResult returns a Func2VariadicResult returning err for the first n calls,
+// then calling f.
+func FailNFunc2VariadicResult[R, V, P0, P1 any](n int, err error, f powerfunc.Func2VariadicResult[R, V, P0, P1]) powerfunc.Func2VariadicResult[R, V, P0, P1] {
+	fl := &failer{n: int64(n)}
+	return func(p0 P0, p1 P1, xs ...V) (R, error) {
+		if fl.fail() {
+			return *new(R), err
+		}
+		return f(p0, p1, xs...)
+	}
+}
+
+// DelayFunc2VariadicResult returns a Func2VariadicResult waiting for latency before calling f.
+// The options set the clock measuring the latency, see DelayClock.
+func DelayFunc2VariadicResult[R, V, P0, P1 any](latency Latency, f powerfunc.Func2VariadicResult[R, V, P0, P1], opts ...DelayOption) powerfunc.Func2VariadicResult[R, V, P0, P1] {
+	d := newDelay(latency, opts)
+	return func(p0 P0, p1 P1, xs ...V) (R, error) {
+		_ = d.wait(context.Background())
+		return f(p0, p1, xs...)
+	}
+}
+
+// GateFunc2VariadicResult returns a Func2VariadicResult blocking until g lets the call through
+// before calling f.
+func GateFunc2VariadicResult[R, V, P0, P1 any](g *Gate, f powerfunc.Func2VariadicResult[R, V, P0, P1]) powerfunc.Func2VariadicResult[R, V, P0, P1] {
+	return func(p0 P0, p1 P1, xs ...V) (R, error) {
+		_ = g.wait(context.Background())
+		return f(p0, p1, xs...)
+	}
+}
@@ -0,0 +1,45 @@
+// Code generated by powerfunc/generator from fakes.go.tmpl. DO NOT EDIT.
+
+package powerfunctest
+
+import (
+	"context"
+
+	"github.com/jonathanmontane/powerfunc"
+)
+
+// SpyCtxFunc3 returns a CtxFunc3 recording the calls of f, along with the
+// Spy holding them.
+func SpyCtxFunc3[P0, P1, P2 any](f powerfunc.CtxFunc3[P0, P1, P2]) (powerfunc.CtxFunc3[P0, P1, P2], *Spy) {
+	s := &Spy{}
+	return func(ctx context.Context, p0 P0, p1 P1, p2 P2) {
+		call := s.begin(ctx, p0, p1, p2)
+		panicked := true
+		defer func() {
+			s.end(call, panicked)
+		}()
+		f(ctx, p0, p1, p2)
+		panicked = false
+	}, s
+}
+
+// DelayCtxFunc3 returns a CtxFunc3 waiting for latency before calling f.
+// The options set the clock measuring the latency, see DelayClock.
+// f is still called if the context is done first.
+func DelayCtxFunc3[P0, P1, P2 any](latency Latency, f powerfunc.CtxFunc3[P0, P1, P2], opts ...DelayOption) powerfunc.CtxFunc3[P0, P1, P2] {
+	d := newDelay(latency, opts)
+	return func(ctx context.Context, p0 P0, p1 P1, p2 P2) {
+		_ = d.wait(ctx)
+		f(ctx, p0, p1, p2)
+	}
+}
+
+// GateCtxFunc3 returns a CtxFunc3 blocking until g lets the call through
+// before calling f.
+// f is still called if the context is done first.
+func GateCtxFunc3[P0, P1, P2 any](g *Gate, f powerfunc.CtxFunc3[P0, P1, P2]) powerfunc.CtxFunc3[P0, P1, P2] {
+	return func(ctx context.Context, p0 P0, p1 P1, p2 P2) {
+		_ = g.wait(ctx)
+		f(ctx, p0, p1, p2)
+	}
+}
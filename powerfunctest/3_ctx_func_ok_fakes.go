@@ -0,0 +1,60 @@
+// Code generated by powerfunc/generator from fakes.go.tmpl. DO NOT EDIT.
+
+package powerfunctest
+
+import (
+	"context"
+
+	"github.com/jonathanmontane/powerfunc"
+)
+
+// StubCtxFunc3Ok returns a CtxFunc3Ok returning the outcomes in order, one per
+// call. The last outcome is repeated once they are exhausted, and zero values
+// are returned if there are none.
+func StubCtxFunc3Ok[R, P0, P1, P2 any](outcomes ...Option[R]) powerfunc.CtxFunc3Ok[R, P0, P1, P2] {
+	s := &script{}
+	return func(ctx context.Context, p0 P0, p1 P1, p2 P2) (R, bool) {
+		var out Option[R]
+		if i := s.next(len(outcomes)); i >= 0 {
+			out = outcomes[i]
+		}
+		return out.Value, out.Ok
+	}
+}
+
+// SpyCtxFunc3Ok returns a CtxFunc3Ok recording the calls of f, along with the
+// Spy holding them.
+func SpyCtxFunc3Ok[R, P0, P1, P2 any](f powerfunc.CtxFunc3Ok[R, P0, P1, P2]) (powerfunc.CtxFunc3Ok[R, P0, P1, P2], *Spy) {
+	s := &Spy{}
+	return func(ctx context.Context, p0 P0, p1 P1, p2 P2) (R, bool) {
+		call := s.begin(ctx, p0, p1, p2)
+		panicked := true
+		defer func() {
+			s.end(call, panicked)
+		}()
+		r0, r1 := f(ctx, p0, p1, p2)
+		panicked = false
+		return r0, r1
+	}, s
+}
+
+// DelayCtxFunc3Ok returns a CtxFunc3Ok waiting for latency before calling f.
+// The options set the clock measuring the latency, see DelayClock.
+// f is still called if the context is done first.
+func DelayCtxFunc3Ok[R, P0, P1, P2 any](latency Latency, f powerfunc.CtxFunc3Ok[R, P0, P1, P2], opts ...DelayOption) powerfunc.CtxFunc3Ok[R, P0, P1, P2] {
+	d := newDelay(latency, opts)
+	return func(ctx context.Context, p0 P0, p1 P1, p2 P2) (R, bool) {
+		_ = d.wait(ctx)
+		return f(ctx, p0, p1, p2)
+	}
+}
+
+// GateCtxFunc3Ok returns a CtxFunc3Ok blocking until g lets the call through
+// before calling f.
+// f is still called if the context is done first.
+func GateCtxFunc3Ok[R, P0, P1, P2 any](g *Gate, f powerfunc.CtxFunc3Ok[R, P0, P1, P2]) powerfunc.CtxFunc3Ok[R, P0, P1, P2] {
+	return func(ctx context.Context, p0 P0, p1 P1, p2 P2) (R, bool) {
+		_ = g.wait(ctx)
+		return f(ctx, p0, p1, p2)
+	}
+}
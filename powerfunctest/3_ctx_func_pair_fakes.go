@@ -0,0 +1,60 @@
+// Code generated by powerfunc/generator from fakes.go.tmpl. DO NOT EDIT.
+
+package powerfunctest
+
+import (
+	"context"
+
+	"github.com/jonathanmontane/powerfunc"
+)
+
+// StubCtxFunc3Pair returns a CtxFunc3Pair returning the outcomes in order, one per
+// call. The last outcome is repeated once they are exhausted, and zero values
+// are returned if there are none.
+func StubCtxFunc3Pair[R1, R2, P0, P1, P2 any](outcomes ...Pair[R1, R2]) powerfunc.CtxFunc3Pair[R1, R2, P0, P1, P2] {
+	s := &script{}
+	return func(ctx context.Context, p0 P0, p1 P1, p2 P2) (R1, R2) {
+		var out Pair[R1, R2]
+		if i := s.next(len(outcomes)); i >= 0 {
+			out = outcomes[i]
+		}
+		return out.First, out.Second
+	}
+}
+
+// SpyCtxFunc3Pair returns a CtxFunc3Pair recording the calls of f, along with the
+// Spy holding them.
+func SpyCtxFunc3Pair[R1, R2, P0, P1, P2 any](f powerfunc.CtxFunc3Pair[R1, R2, P0, P1, P2]) (powerfunc.CtxFunc3Pair[R1, R2, P0, P1, P2], *Spy) {
+	s := &Spy{}
+	return func(ctx context.Context, p0 P0, p1 P1, p2 P2) (R1, R2) {
+		call := s.begin(ctx, p0, p1, p2)
+		panicked := true
+		defer func() {
+			s.end(call, panicked)
+		}()
+		r0, r1 := f(ctx, p0, p1, p2)
+		panicked = false
+		return r0, r1
+	}, s
+}
+
+// DelayCtxFunc3Pair returns a CtxFunc3Pair waiting for latency before calling f.
+// The options set the clock measuring the latency, see DelayClock.
+// f is still called if the context is done first.
+func DelayCtxFunc3Pair[R1, R2, P0, P1, P2 any](latency Latency, f powerfunc.CtxFunc3Pair[R1, R2, P0, P1, P2], opts ...DelayOption) powerfunc.CtxFunc3Pair[R1, R2, P0, P1, P2] {
+	d := newDelay(latency, opts)
+	return func(ctx context.Context, p0 P0, p1 P1, p2 P2) (R1, R2) {
+		_ = d.wait(ctx)
+		return f(ctx, p0, p1, p2)
+	}
+}
+
+// GateCtxFunc3Pair returns a CtxFunc3Pair blocking until g lets the call through
+// before calling f.
+// f is still called if the context is done first.
+func GateCtxFunc3Pair[R1, R2, P0, P1, P2 any](g *Gate, f powerfunc.CtxFunc3Pair[R1, R2, P0, P1, P2]) powerfunc.CtxFunc3Pair[R1, R2, P0, P1, P2] {
+	return func(ctx context.Context, p0 P0, p1 P1, p2 P2) (R1, R2) {
+		_ = g.wait(ctx)
+		return f(ctx, p0, p1, p2)
+	}
+}
@@ -0,0 +1,77 @@
+// Code generated by powerfunc/generator from fakes.go.tmpl. DO NOT EDIT.
+
+package powerfunctest
+
+import (
+	"context"
+
+	"github.com/jonathanmontane/powerfunc"
+)
+
+// StubCtxFunc3PairResult returns a CtxFunc3PairResult returning the outcomes in order, one per
+// call. The last outcome is repeated once they are exhausted, and zero values
+// are returned if there are none.
+func StubCtxFunc3PairResult[R1, R2, P0, P1, P2 any](outcomes ...PairResult[R1, R2]) powerfunc.CtxFunc3PairResult[R1, R2, P0, P1, P2] {
+	s := &script{}
+	return func(ctx context.Context, p0 P0, p1 P1, p2 P2) (R1, R2, error) {
+		var out PairResult[R1, R2]
+		if i := s.next(len(outcomes)); i >= 0 {
+			out = outcomes[i]
+		}
+		return out.First, out.Second, out.Err
+	}
+}
+
+// SpyCtxFunc3PairResult returns a CtxFunc3PairResult recording the calls of f, along with the
+// Spy holding them.
+func SpyCtxFunc3PairResult[R1, R2, P0, P1, P2 any](f powerfunc.CtxFunc3PairResult[R1, R2, P0, P1, P2]) (powerfunc.CtxFunc3PairResult[R1, R2, P0, P1, P2], *Spy) {
+	s := &Spy{}
+	return func(ctx context.Context, p0 P0, p1 P1, p2 P2) (R1, R2, error) {
+		call := s.begin(ctx, p0, p1, p2)
+		panicked := true
+		defer func() {
+			s.end(call, panicked)
+		}()
+		r0, r1, err := f(ctx, p0, p1, p2)
+		panicked = false
+		s.fail(call, err)
+		return r0, r1, err
+	}, s
+}
+
+// FailNCtxFunc3PairResult returns a CtxFunc3PairResult returning err for the first n calls,
+// then calling f.
+func FailNCtxFunc3PairResult[R1, R2, P0, P1, P2 any](n int, err error, f powerfunc.CtxFunc3PairResult[R1, R2, P0, P1, P2]) powerfunc.CtxFunc3PairResult[R1, R2, P0, P1, P2] {
+	fl := &failer{n: int64(n)}
+	return func(ctx context.Context, p0 P0, p1 P1, p2 P2) (R1, R2, error) {
+		if fl.fail() {
+			return *new(R1), *new(R2), err
+		}
+		return f(ctx, p0, p1, p2)
+	}
+}
+
+// DelayCtxFunc3PairResult returns a CtxFunc3PairResult waiting for latency before calling f.
+// The options set the clock measuring the latency, see DelayClock.
+// The error of the context is returned if it is done first.
+func DelayCtxFunc3PairResult[R1, R2, P0, P1, P2 any](latency Latency, f powerfunc.CtxFunc3PairResult[R1, R2, P0, P1, P2], opts ...DelayOption) powerfunc.CtxFunc3PairResult[R1, R2, P0, P1, P2] {
+	d := newDelay(latency, opts)
+	return func(ctx context.Context, p0 P0, p1 P1, p2 P2) (R1, R2, error) {
+		if err := d.wait(ctx); err != nil {
+			return *new(R1), *new(R2), err
+		}
+		return f(ctx, p0, p1, p2)
+	}
+}
+
+// GateCtxFunc3PairResult returns a CtxFunc3PairResult blocking until g lets the call through
+// before calling f.
+// The error of the context is returned if it is done first.
+func GateCtxFunc3PairResult[R1, R2, P0, P1, P2 any](g *Gate, f powerfunc.CtxFunc3PairResult[R1, R2, P0, P1, P2]) powerfunc.CtxFunc3PairResult[R1, R2, P0, P1, P2] {
+	return func(ctx context.Context, p0 P0, p1 P1, p2 P2) (R1, R2, error) {
+		if err := g.wait(ctx); err != nil {
+			return *new(R1), *new(R2), err
+		}
+		return f(ctx, p0, p1, p2)
+	}
+}
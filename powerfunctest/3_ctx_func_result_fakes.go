@@ -0,0 +1,77 @@
+// Code generated by powerfunc/generator from fakes.go.tmpl. DO NOT EDIT.
+
+package powerfunctest
+
+import (
+	"context"
+
+	"github.com/jonathanmontane/powerfunc"
+)
+
+// StubCtxFunc3Result returns a CtxFunc3Result returning the outcomes in order, one per
+// call. The last outcome is repeated once they are exhausted, and zero values
+// are returned if there are none.
+func StubCtxFunc3Result[R, P0, P1, P2 any](outcomes ...Result[R]) powerfunc.CtxFunc3Result[R, P0, P1, P2] {
+	s := &script{}
+	return func(ctx context.Context, p0 P0, p1 P1, p2 P2) (R, error) {
+		var out Result[R]
+		if i := s.next(len(outcomes)); i >= 0 {
+			out = outcomes[i]
+		}
+		return out.Value, out.Err
+	}
+}
+
+// SpyCtxFunc3Result returns a CtxFunc3Result recording the calls of f, along with the
+// Spy holding them.
+func SpyCtxFunc3Result[R, P0, P1, P2 any](f powerfunc.CtxFunc3Result[R, P0, P1, P2]) (powerfunc.CtxFunc3Result[R, P0, P1, P2], *Spy) {
+	s := &Spy{}
+	return func(ctx context.Context, p0 P0, p1 P1, p2 P2) (R, error) {
+		call := s.begin(ctx, p0, p1, p2)
+		panicked := true
+		defer func() {
+			s.end(call, panicked)
+		}()
+		r0, err := f(ctx, p0, p1, p2)
+		panicked = false
+		s.fail(call, err)
+		return r0, err
+	}, s
+}
+
+// FailNCtxFunc3Result returns a CtxFunc3Result returning err for the first n calls,
+// then calling f.
+func FailNCtxFunc3Result[R, P0, P1, P2 any](n int, err error, f powerfunc.CtxFunc3Result[R, P0, P1, P2]) powerfunc.CtxFunc3Result[R, P0, P1, P2] {
+	fl := &failer{n: int64(n)}
+	return func(ctx context.Context, p0 P0, p1 P1, p2 P2) (R, error) {
+		if fl.fail() {
+			return *new(R), err
+		}
+		return f(ctx, p0, p1, p2)
+	}
+}
+
+// DelayCtxFunc3Result returns a CtxFunc3Result waiting for latency before calling f.
+// The options set the clock measuring the latency, see DelayClock.
+// The error of the context is returned if it is done first.
+func DelayCtxFunc3Result[R, P0, P1, P2 any](latency Latency, f powerfunc.CtxFunc3Result[R, P0, P1, P2], opts ...DelayOption) powerfunc.CtxFunc3Result[R, P0, P1, P2] {
+	d := newDelay(latency, opts)
+	return func(ctx context.Context, p0 P0, p1 P1, p2 P2) (R, error) {
+		if err := d.wait(ctx); err != nil {
+			return *new(R), err
+		}
+		return f(ctx, p0, p1, p2)
+	}
+}
+
+// GateCtxFunc3Result returns a CtxFunc3Result blocking until g lets the call through
+// before calling f.
+// The error of the context is returned if it is done first.
+func GateCtxFunc3Result[R, P0, P1, P2 any](g *Gate, f powerfunc.CtxFunc3Result[R, P0, P1, P2]) powerfunc.CtxFunc3Result[R, P0, P1, P2] {
+	return func(ctx context.Context, p0 P0, p1 P1, p2 P2) (R, error) {
+		if err := g.wait(ctx); err != nil {
+			return *new(R), err
+		}
+		return f(ctx, p0, p1, p2)
+	}
+}
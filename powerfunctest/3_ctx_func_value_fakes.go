@@ -0,0 +1,60 @@
+// Code generated by powerfunc/generator from fakes.go.tmpl. DO NOT EDIT.
+
+package powerfunctest
+
+import (
+	"context"
+
+	"github.com/jonathanmontane/powerfunc"
+)
+
+// StubCtxFunc3Value returns a CtxFunc3Value returning the outcomes in order, one per
+// call. The last outcome is repeated once they are exhausted, and zero values
+// are returned if there are none.
+func StubCtxFunc3Value[R, P0, P1, P2 any](outcomes ...R) powerfunc.CtxFunc3Value[R, P0, P1, P2] {
+	s := &script{}
+	return func(ctx context.Context, p0 P0, p1 P1, p2 P2) R {
+		var out R
+		if i := s.next(len(outcomes)); i >= 0 {
+			out = outcomes[i]
+		}
+		return out
+	}
+}
+
+// SpyCtxFunc3Value returns a CtxFunc3Value recording the calls of f, along with the
+// Spy holding them.
+func SpyCtxFunc3Value[R, P0, P1, P2 any](f powerfunc.CtxFunc3Value[R, P0, P1, P2]) (powerfunc.CtxFunc3Value[R, P0, P1, P2], *Spy) {
+	s := &Spy{}
+	return func(ctx context.Context, p0 P0, p1 P1, p2 P2) R {
+		call := s.begin(ctx, p0, p1, p2)
+		panicked := true
+		defer func() {
+			s.end(call, panicked)
+		}()
+		r0 := f(ctx, p0, p1, p2)
+		panicked = false
+		return r0
+	}, s
+}
+
+// DelayCtxFunc3Value returns a CtxFunc3Value waiting for latency before calling f.
+// The options set the clock measuring the latency, see DelayClock.
+// f is still called if the context is done first.
+func DelayCtxFunc3Value[R, P0, P1, P2 any](latency Latency, f powerfunc.CtxFunc3Value[R, P0, P1, P2], opts ...DelayOption) powerfunc.CtxFunc3Value[R, P0, P1, P2] {
+	d := newDelay(latency, opts)
+	return func(ctx context.Context, p0 P0, p1 P1, p2 P2) R {
+		_ = d.wait(ctx)
+		return f(ctx, p0, p1, p2)
+	}
+}
+
+// GateCtxFunc3Value returns a CtxFunc3Value blocking until g lets the call through
+// before calling f.
+// f is still called if the context is done first.
+func GateCtxFunc3Value[R, P0, P1, P2 any](g *Gate, f powerfunc.CtxFunc3Value[R, P0, P1, P2]) powerfunc.CtxFunc3Value[R, P0, P1, P2] {
+	return func(ctx context.Context, p0 P0, p1 P1, p2 P2) R {
+		_ = g.wait(ctx)
+		return f(ctx, p0, p1, p2)
+	}
+}
@@ -0,0 +1,71 @@
+// Code generated by powerfunc/generator from fakes.go.tmpl. DO NOT EDIT.
+
+package powerfunctest
+
+import (
+	"context"
+
+	"github.com/jonathanmontane/powerfunc"
+)
+
+// StubFunc3Error returns a Func3Error returning the outcomes in order, one per
+// call. The last outcome is repeated once they are exhausted, and zero values
+// are returned if there are none.
+func StubFunc3Error[P0, P1, P2 any](outcomes ...error) powerfunc.Func3Error[P0, P1, P2] {
+	s := &script{}
+	return func(p0 P0, p1 P1, p2 P2) error {
+		var out error
+		if i := s.next(len(outcomes)); i >= 0 {
+			out = outcomes[i]
+		}
+		return out
+	}
+}
+
+// SpyFunc3Error returns a Func3Error recording the calls of f, along with the
+// Spy holding them.
+func SpyFunc3Error[P0, P1, P2 any](f powerfunc.Func3Error[P0, P1, P2]) (powerfunc.Func3Error[P0, P1, P2], *Spy) {
+	s := &Spy{}
+	return func(p0 P0, p1 P1, p2 P2) error {
+		call := s.begin(context.Background(), p0, p1, p2)
+		panicked := true
+		defer func() {
+			s.end(call, panicked)
+		}()
+		err := f(p0, p1, p2)
+		panicked = false
+		s.fail(call, err)
+		return err
+	}, s
+}
+
+// FailNFunc3Error returns a Func3Error returning err for the first n calls,
+// then calling f.
+func FailNFunc3Error[P0, P1, P2 any](n int, err error, f powerfunc.Func3Error[P0, P1, P2]) powerfunc.Func3Error[P0, P1, P2] {
+	fl := &failer{n: int64(n)}
+	return func(p0 P0, p1 P1, p2 P2) error {
+		if fl.fail() {
+			return err
+		}
+		return f(p0, p1, p2)
+	}
+}
+
+// DelayFunc3Error returns a Func3Error waiting for latency before calling f.
+// The options set the clock measuring the latency, see DelayClock.
+func DelayFunc3Error[P0, P1, P2 any](latency Latency, f powerfunc.Func3Error[P0, P1, P2], opts ...DelayOption) powerfunc.Func3Error[P0, P1, P2] {
+	d := newDelay(latency, opts)
+	return func(p0 P0, p1 P1, p2 P2) error {
+		_ = d.wait(context.Background())
+		return f(p0, p1, p2)
+	}
+}
+
+// GateFunc3Error returns a Func3Error blocking until g lets the call through
+// before calling f.
+func GateFunc3Error[P0, P1, P2 any](g *Gate, f powerfunc.Func3Error[P0, P1, P2]) powerfunc.Func3Error[P0, P1, P2] {
+	return func(p0 P0, p1 P1, p2 P2) error {
+		_ = g.wait(context.Background())
+		return f(p0, p1, p2)
+	}
+}
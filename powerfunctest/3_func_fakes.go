@@ -0,0 +1,43 @@
+// Code generated by powerfunc/generator from fakes.go.tmpl. DO NOT EDIT.
+
+package powerfunctest
+
+import (
+	"context"
+
+	"github.com/jonathanmontane/powerfunc"
+)
+
+// SpyFunc3 returns a Func3 recording the calls of f, along with the
+// Spy holding them.
+func SpyFunc3[P0, P1, P2 any](f powerfunc.Func3[P0, P1, P2]) (powerfunc.Func3[P0, P1, P2], *Spy) {
+	s := &Spy{}
+	return func(p0 P0, p1 P1, p2 P2) {
+		call := s.begin(context.Background(), p0, p1, p2)
+		panicked := true
+		defer func() {
+			s.end(call, panicked)
+		}()
+		f(p0, p1, p2)
+		panicked = false
+	}, s
+}
+
+// DelayFunc3 returns a Func3 waiting for latency before calling f.
+// The options set the clock measuring the latency, see DelayClock.
+func DelayFunc3[P0, P1, P2 any](latency Latency, f powerfunc.Func3[P0, P1, P2], opts ...DelayOption) powerfunc.Func3[P0, P1, P2] {
+	d := newDelay(latency, opts)
+	return func(p0 P0, p1 P1, p2 P2) {
+		_ = d.wait(context.Background())
+		f(p0, p1, p2)
+	}
+}
+
+// GateFunc3 returns a Func3 blocking until g lets the call through
+// before calling f.
+func GateFunc3[P0, P1, P2 any](g *Gate, f powerfunc.Func3[P0, P1, P2]) powerfunc.Func3[P0, P1, P2] {
+	return func(p0 P0, p1 P1, p2 P2) {
+		_ = g.wait(context.Background())
+		f(p0, p1, p2)
+	}
+}
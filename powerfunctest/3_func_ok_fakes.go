@@ -0,0 +1,58 @@
+// Code generated by powerfunc/generator from fakes.go.tmpl. DO NOT EDIT.
+
+package powerfunctest
+
+import (
+	"context"
+
+	"github.com/jonathanmontane/powerfunc"
+)
+
+// StubFunc3Ok returns a Func3Ok returning the outcomes in order, one per
+// call. The last outcome is repeated once they are exhausted, and zero values
+// are returned if there are none.
+func StubFunc3Ok[R, P0, P1, P2 any](outcomes ...Option[R]) powerfunc.Func3Ok[R, P0, P1, P2] {
+	s := &script{}
+	return func(p0 P0, p1 P1, p2 P2) (R, bool) {
+		var out Option[R]
+		if i := s.next(len(outcomes)); i >= 0 {
+			out = outcomes[i]
+		}
+		return out.Value, out.Ok
+	}
+}
+
+// SpyFunc3Ok returns a Func3Ok recording the calls of f, along with the
+// Spy holding them.
+func SpyFunc3Ok[R, P0, P1, P2 any](f powerfunc.Func3Ok[R, P0, P1, P2]) (powerfunc.Func3Ok[R, P0, P1, P2], *Spy) {
+	s := &Spy{}
+	return func(p0 P0, p1 P1, p2 P2) (R, bool) {
+		call := s.begin(context.Background(), p0, p1, p2)
+		panicked := true
+		defer func() {
+			s.end(call, panicked)
+		}()
+		r0, r1 := f(p0, p1, p2)
+		panicked = false
+		return r0, r1
+	}, s
+}
+
+// DelayFunc3Ok returns a Func3Ok waiting for latency before calling f.
+// The options set the clock measuring the latency, see DelayClock.
+func DelayFunc3Ok[R, P0, P1, P2 any](latency Latency, f powerfunc.Func3Ok[R, P0, P1, P2], opts ...DelayOption) powerfunc.Func3Ok[R, P0, P1, P2] {
+	d := newDelay(latency, opts)
+	return func(p0 P0, p1 P1, p2 P2) (R, bool) {
+		_ = d.wait(context.Background())
+		return f(p0, p1, p2)
+	}
+}
+
+// GateFunc3Ok returns a Func3Ok blocking until g lets the call through
+// before calling f.
+func GateFunc3Ok[R, P0, P1, P2 any](g *Gate, f powerfunc.Func3Ok[R, P0, P1, P2]) powerfunc.Func3Ok[R, P0, P1, P2] {
+	return func(p0 P0, p1 P1, p2 P2) (R, bool) {
+		_ = g.wait(context.Background())
+		return f(p0, p1, p2)
+	}
+}
@@ -0,0 +1,71 @@
+// Code generated by powerfunc/generator from fakes.go.tmpl. DO NOT EDIT.
+
+package powerfunctest
+
+import (
+	"context"
+
+	"github.com/jonathanmontane/powerfunc"
+)
+
+// StubFunc3Result returns a Func3Result returning the outcomes in order, one per
+// call. The last outcome is repeated once they are exhausted, and zero values
+// are returned if there are none.
+func StubFunc3Result[R, P0, P1, P2 any](outcomes ...Result[R]) powerfunc.Func3Result[R, P0, P1, P2] {
+	s := &script{}
+	return func(p0 P0, p1 P1, p2 P2) (R, error) {
+		var out Result[R]
+		if i := s.next(len(outcomes)); i >= 0 {
+			out = outcomes[i]
+		}
+		return out.Value, out.Err
+	}
+}
+
+// SpyFunc3Result returns a Func3Result recording the calls of f, along with the
+// Spy holding them.
+func SpyFunc3Result[R, P0, P1, P2 any](f powerfunc.Func3Result[R, P0, P1, P2]) (powerfunc.Func3Result[R, P0, P1, P2], *Spy) {
+	s := &Spy{}
+	return func(p0 P0, p1 P1, p2 P2) (R, error) {
+		call := s.begin(context.Background(), p0, p1, p2)
+		panicked := true
+		defer func() {
+			s.end(call, panicked)
+		}()
+		r0, err := f(p0, p1, p2)
+		panicked = false
+		s.fail(call, err)
+		return r0, err
+	}, s
+}
+
+// FailNFunc3Result returns a Func3Result returning err for the first n calls,
+// then calling f.
+func FailNFunc3Result[R, P0, P1, P2 any](n int, err error, f powerfunc.Func3Result[R, P0, P1, P2]) powerfunc.Func3Result[R, P0, P1, P2] {
+	fl := &failer{n: int64(n)}
+	return func(p0 P0, p1 P1, p2 P2) (R, error) {
+		if fl.fail() {
+			return *new(R), err
+		}
+		return f(p0, p1, p2)
+	}
+}
+
+// DelayFunc3Result returns a Func3Result waiting for latency before calling f.
+// The options set the clock measuring the latency, see DelayClock.
+func DelayFunc3Result[R, P0, P1, P2 any](latency Latency, f powerfunc.Func3Result[R, P0, P1, P2], opts ...DelayOption) powerfunc.Func3Result[R, P0, P1, P2] {
+	d := newDelay(latency, opts)
+	return func(p0 P0, p1 P1, p2 P2) (R, error) {
+		_ = d.wait(context.Background())
+		return f(p0, p1, p2)
+	}
+}
+
+// GateFunc3Result returns a Func3Result blocking until g lets the call through
+// before calling f.
+func GateFunc3Result[R, P0, P1, P2 any](g *Gate, f powerfunc.Func3Result[R, P0, P1, P2]) powerfunc.Func3Result[R, P0, P1, P2] {
+	return func(p0 P0, p1 P1, p2 P2) (R, error) {
+		_ = g.wait(context.Background())
+		return f(p0, p1, p2)
+	}
+}
@@ -0,0 +1,58 @@
+// Code generated by powerfunc/generator from fakes.go.tmpl. DO NOT EDIT.
+
+package powerfunctest
+
+import (
+	"context"
+
+	"github.com/jonathanmontane/powerfunc"
+)
+
+// StubFunc3Value returns a Func3Value returning the outcomes in order, one per
+// call. The last outcome is repeated once they are exhausted, and zero values
+// are returned if there are none.
+func StubFunc3Value[R, P0, P1, P2 any](outcomes ...R) powerfunc.Func3Value[R, P0, P1, P2] {
+	s := &script{}
+	return func(p0 P0, p1 P1, p2 P2) R {
+		var out R
+		if i := s.next(len(outcomes)); i >= 0 {
+			out = outcomes[i]
+		}
+		return out
+	}
+}
+
+// SpyFunc3Value returns a Func3Value recording the calls of f, along with the
+// Spy holding them.
+func SpyFunc3Value[R, P0, P1, P2 any](f powerfunc.Func3Value[R, P0, P1, P2]) (powerfunc.Func3Value[R, P0, P1, P2], *Spy) {
+	s := &Spy{}
+	return func(p0 P0, p1 P1, p2 P2) R {
+		call := s.begin(context.Background(), p0, p1, p2)
+		panicked := true
+		defer func() {
+			s.end(call, panicked)
+		}()
+		r0 := f(p0, p1, p2)
+		panicked = false
+		return r0
+	}, s
+}
+
+// DelayFunc3Value returns a Func3Value waiting for latency before calling f.
+// The options set the clock measuring the latency, see DelayClock.
+func DelayFunc3Value[R, P0, P1, P2 any](latency Latency, f powerfunc.Func3Value[R, P0, P1, P2], opts ...DelayOption) powerfunc.Func3Value[R, P0, P1, P2] {
+	d := newDelay(latency, opts)
+	return func(p0 P0, p1 P1, p2 P2) R {
+		_ = d.wait(context.Background())
+		return f(p0, p1, p2)
+	}
+}
+
+// GateFunc3Value returns a Func3Value blocking until g lets the call through
+// before calling f.
+func GateFunc3Value[R, P0, P1, P2 any](g *Gate, f powerfunc.Func3Value[R, P0, P1, P2]) powerfunc.Func3Value[R, P0, P1, P2] {
+	return func(p0 P0, p1 P1, p2 P2) R {
+		_ = g.wait(context.Background())
+		return f(p0, p1, p2)
+	}
+}
@@ -0,0 +1,77 @@
+// Code generated by powerfunc/generator from fakes.go.tmpl. DO NOT EDIT.
+
+package powerfunctest
+
+import (
+	"context"
+
+	"github.com/jonathanmontane/powerfunc"
+)
+
+// StubCtxFunc4Error returns a CtxFunc4Error returning the outcomes in order, one per
+// call. The last outcome is repeated once they are exhausted, and zero values
+// are returned if there are none.
+func StubCtxFunc4Error[P0, P1, P2, P3 any](outcomes ...error) powerfunc.CtxFunc4Error[P0, P1, P2, P3] {
+	s := &script{}
+	return func(ctx context.Context, p0 P0, p1 P1, p2 P2, p3 P3) error {
+		var out error
+		if i := s.next(len(outcomes)); i >= 0 {
+			out = outcomes[i]
+		}
+		return out
+	}
+}
+
+// SpyCtxFunc4Error returns a CtxFunc4Error recording the calls of f, along with the
+// Spy holding them.
+func SpyCtxFunc4Error[P0, P1, P2, P3 any](f powerfunc.CtxFunc4Error[P0, P1, P2, P3]) (powerfunc.CtxFunc4Error[P0, P1, P2, P3], *Spy) {
+	s := &Spy{}
+	return func(ctx context.Context, p0 P0, p1 P1, p2 P2, p3 P3) error {
+		call := s.begin(ctx, p0, p1, p2, p3)
+		panicked := true
+		defer func() {
+			s.end(call, panicked)
+		}()
+		err := f(ctx, p0, p1, p2, p3)
+		panicked = false
+		s.fail(call, err)
+		return err
+	}, s
+}
+
+// FailNCtxFunc4Error returns a CtxFunc4Error returning err for the first n calls,
+// then calling f.
+func FailNCtxFunc4Error[P0, P1, P2, P3 any](n int, err error, f powerfunc.CtxFunc4Error[P0, P1, P2, P3]) powerfunc.CtxFunc4Error[P0, P1, P2, P3] {
+	fl := &failer{n: int64(n)}
+	return func(ctx context.Context, p0 P0, p1 P1, p2 P2, p3 P3) error {
+		if fl.fail() {
+			return err
+		}
+		return f(ctx, p0, p1, p2, p3)
+	}
+}
+
+// DelayCtxFunc4Error returns a CtxFunc4Error waiting for latency before calling f.
+// The options set the clock measuring the latency, see DelayClock.
+// The error of the context is returned if it is done first.
+func DelayCtxFunc4Error[P0, P1, P2, P3 any](latency Latency, f powerfunc.CtxFunc4Error[P0, P1, P2, P3], opts ...DelayOption) powerfunc.CtxFunc4Error[P0, P1, P2, P3] {
+	d := newDelay(latency, opts)
+	return func(ctx context.Context, p0 P0, p1 P1, p2 P2, p3 P3) error {
+		if err := d.wait(ctx); err != nil {
+			return err
+		}
+		return f(ctx, p0, p1, p2, p3)
+	}
+}
+
+// GateCtxFunc4Error returns a CtxFunc4Error blocking until g lets the call through
+// before calling f.
+// The error of the context is returned if it is done first.
+func GateCtxFunc4Error[P0, P1, P2, P3 any](g *Gate, f powerfunc.CtxFunc4Error[P0, P1, P2, P3]) powerfunc.CtxFunc4Error[P0, P1, P2, P3] {
+	return func(ctx context.Context, p0 P0, p1 P1, p2 P2, p3 P3) error {
+		if err := g.wait(ctx); err != nil {
+			return err
+		}
+		return f(ctx, p0, p1, p2, p3)
+	}
+}
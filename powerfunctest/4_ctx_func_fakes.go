@@ -0,0 +1,45 @@
+// Code generated by powerfunc/generator from fakes.go.tmpl. DO NOT EDIT.
+
+package powerfunctest
+
+import (
+	"context"
+
+	"github.com/jonathanmontane/powerfunc"
+)
+
+// SpyCtxFunc4 returns a CtxFunc4 recording the calls of f, along with the
+// Spy holding them.
+func SpyCtxFunc4[P0, P1, P2, P3 any](f powerfunc.CtxFunc4[P0, P1, P2, P3]) (powerfunc.CtxFunc4[P0, P1, P2, P3], *Spy) {
+	s := &Spy{}
+	return func(ctx context.Context, p0 P0, p1 P1, p2 P2, p3 P3) {
+		call := s.begin(ctx, p0, p1, p2, p3)
+		panicked := true
+		defer func() {
+			s.end(call, panicked)
+		}()
+		f(ctx, p0, p1, p2, p3)
+		panicked = false
+	}, s
+}
+
+// DelayCtxFunc4 returns a CtxFunc4 waiting for latency before calling f.
+// The options set the clock measuring the latency, see DelayClock.
+// f is still called if the context is done first.
+func DelayCtxFunc4[P0, P1, P2, P3 any](latency Latency, f powerfunc.CtxFunc4[P0, P1, P2, P3], opts ...DelayOption) powerfunc.CtxFunc4[P0, P1, P2, P3] {
+	d := newDelay(latency, opts)
+	return func(ctx context.Context, p0 P0, p1 P1, p2 P2, p3 P3) {
+		_ = d.wait(ctx)
+		f(ctx, p0, p1, p2, p3)
+	}
+}
+
+// GateCtxFunc4 returns a CtxFunc4 blocking until g lets the call through
+// before calling f.
+// f is still called if the context is done first.
+func GateCtxFunc4[P0, P1, P2, P3 any](g *Gate, f powerfunc.CtxFunc4[P0, P1, P2, P3]) powerfunc.CtxFunc4[P0, P1, P2, P3] {
+	return func(ctx context.Context, p0 P0, p1 P1, p2 P2, p3 P3) {
+		_ = g.wait(ctx)
+		f(ctx, p0, p1, p2, p3)
+	}
+}
@@ -0,0 +1,71 @@
+// Code generated by powerfunc/generator from fakes.go.tmpl. DO NOT EDIT.
+
+package powerfunctest
+
+import (
+	"context"
+
+	"github.com/jonathanmontane/powerfunc"
+)
+
+// StubFunc4Error returns a Func4Error returning the outcomes in order, one per
+// call. The last outcome is repeated once they are exhausted, and zero values
+// are returned if there are none.
+func StubFunc4Error[P0, P1, P2, P3 any](outcomes ...error) powerfunc.Func4Error[P0, P1, P2, P3] {
+	s := &script{}
+	return func(p0 P0, p1 P1, p2 P2, p3 P3) error {
+		var out error
+		if i := s.next(len(outcomes)); i >= 0 {
+			out = outcomes[i]
+		}
+		return out
+	}
+}
+
+// SpyFunc4Error returns a Func4Error recording the calls of f, along with the
+// Spy holding them.
+func SpyFunc4Error[P0, P1, P2, P3 any](f powerfunc.Func4Error[P0, P1, P2, P3]) (powerfunc.Func4Error[P0, P1, P2, P3], *Spy) {
+	s := &Spy{}
+	return func(p0 P0, p1 P1, p2 P2, p3 P3) error {
+		call := s.begin(context.Background(), p0, p1, p2, p3)
+		panicked := true
+		defer func() {
+			s.end(call, panicked)
+		}()
+		err := f(p0, p1, p2, p3)
+		panicked = false
+		s.fail(call, err)
+		return err
+	}, s
+}
+
+// FailNFunc4Error returns a Func4Error returning err for the first n calls,
+// then calling f.
+func FailNFunc4Error[P0, P1, P2, P3 any](n int, err error, f powerfunc.Func4Error[P0, P1, P2, P3]) powerfunc.Func4Error[P0, P1, P2, P3] {
+	fl := &failer{n: int64(n)}
+	return func(p0 P0, p1 P1, p2 P2, p3 P3) error {
+		if fl.fail() {
+			return err
+		}
+		return f(p0, p1, p2, p3)
+	}
+}
+
+// DelayFunc4Error returns a Func4Error waiting for latency before calling f.
+// The options set the clock measuring the latency, see DelayClock.
+func DelayFunc4Error[P0, P1, P2, P3 any](latency Latency, f powerfunc.Func4Error[P0, P1, P2, P3], opts ...DelayOption) powerfunc.Func4Error[P0, P1, P2, P3] {
+	d := newDelay(latency, opts)
+	return func(p0 P0, p1 P1, p2 P2, p3 P3) error {
+		_ = d.wait(context.Background())
+		return f(p0, p1, p2, p3)
+	}
+}
+
+// GateFunc4Error returns a Func4Error blocking until g lets the call through
+// before calling f.
+func GateFunc4Error[P0, P1, P2, P3 any](g *Gate, f powerfunc.Func4Error[P0, P1, P2, P3]) powerfunc.Func4Error[P0, P1, P2, P3] {
+	return func(p0 P0, p1 P1, p2 P2, p3 P3) error {
+		_ = g.wait(context.Background())
+		return f(p0, p1, p2, p3)
+	}
+}
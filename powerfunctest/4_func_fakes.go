@@ -0,0 +1,43 @@
+// Code generated by powerfunc/generator from fakes.go.tmpl. DO NOT EDIT.
+
+package powerfunctest
+
+import (
+	"context"
+
+	"github.com/jonathanmontane/powerfunc"
+)
+
+// SpyFunc4 returns a Func4 recording the calls of f, along with the
+// Spy holding them.
+func SpyFunc4[P0, P1, P2, P3 any](f powerfunc.Func4[P0, P1, P2, P3]) (powerfunc.Func4[P0, P1, P2, P3], *Spy) {
+	s := &Spy{}
+	return func(p0 P0, p1 P1, p2 P2, p3 P3) {
+		call := s.begin(context.Background(), p0, p1, p2, p3)
+		panicked := true
+		defer func() {
+			s.end(call, panicked)
+		}()
+		f(p0, p1, p2, p3)
+		panicked = false
+	}, s
+}
+
+// DelayFunc4 returns a Func4 waiting for latency before calling f.
+// The options set the clock measuring the latency, see DelayClock.
+func DelayFunc4[P0, P1, P2, P3 any](latency Latency, f powerfunc.Func4[P0, P1, P2, P3], opts ...DelayOption) powerfunc.Func4[P0, P1, P2, P3] {
+	d := newDelay(latency, opts)
+	return func(p0 P0, p1 P1, p2 P2, p3 P3) {
+		_ = d.wait(context.Background())
+		f(p0, p1, p2, p3)
+	}
+}
+
+// GateFunc4 returns a Func4 blocking until g lets the call through
+// before calling f.
+func GateFunc4[P0, P1, P2, P3 any](g *Gate, f powerfunc.Func4[P0, P1, P2, P3]) powerfunc.Func4[P0, P1, P2, P3] {
+	return func(p0 P0, p1 P1, p2 P2, p3 P3) {
+		_ = g.wait(context.Background())
+		f(p0, p1, p2, p3)
+	}
+}
@@ -0,0 +1,58 @@
+// Code generated by powerfunc/generator from fakes.go.tmpl. DO NOT EDIT.
+
+package powerfunctest
+
+import (
+	"context"
+
+	"github.com/jonathanmontane/powerfunc"
+)
+
+// StubFunc4Ok returns a Func4Ok returning the outcomes in order, one per
+// call. The last outcome is repeated once they are exhausted, and zero values
+// are returned if there are none.
+func StubFunc4Ok[R, P0, P1, P2, P3 any](outcomes ...Option[R]) powerfunc.Func4Ok[R, P0, P1, P2, P3] {
+	s := &script{}
+	return func(p0 P0, p1 P1, p2 P2, p3 P3) (R, bool) {
+		var out Option[R]
+		if i := s.next(len(outcomes)); i >= 0 {
+			out = outcomes[i]
+		}
+		return out.Value, out.Ok
+	}
+}
+
+// SpyFunc4Ok returns a Func4Ok recording the calls of f, along with the
+// Spy holding them.
+func SpyFunc4Ok[R, P0, P1, P2, P3 any](f powerfunc.Func4Ok[R, P0, P1, P2, P3]) (powerfunc.Func4Ok[R, P0, P1, P2, P3], *Spy) {
+	s := &Spy{}
+	return func(p0 P0, p1 P1, p2 P2, p3 P3) (R, bool) {
+		call := s.begin(context.Background(), p0, p1, p2, p3)
+		panicked := true
+		defer func() {
+			s.end(call, panicked)
+		}()
+		r0, r1 := f(p0, p1, p2, p3)
+		panicked = false
+		return r0, r1
+	}, s
+}
+
+// DelayFunc4Ok returns a Func4Ok waiting for latency before calling f.
+// The options set the clock measuring the latency, see DelayClock.
+func DelayFunc4Ok[R, P0, P1, P2, P3 any](latency Latency, f powerfunc.Func4Ok[R, P0, P1, P2, P3], opts ...DelayOption) powerfunc.Func4Ok[R, P0, P1, P2, P3] {
+	d := newDelay(latency, opts)
+	return func(p0 P0, p1 P1, p2 P2, p3 P3) (R, bool) {
+		_ = d.wait(context.Background())
+		return f(p0, p1, p2, p3)
+	}
+}
+
+// GateFunc4Ok returns a Func4Ok blocking until g lets the call through
+// before calling f.
+func GateFunc4Ok[R, P0, P1, P2, P3 any](g *Gate, f powerfunc.Func4Ok[R, P0, P1, P2, P3]) powerfunc.Func4Ok[R, P0, P1, P2, P3] {
+	return func(p0 P0, p1 P1, p2 P2, p3 P3) (R, bool) {
+		_ = g.wait(context.Background())
+		return f(p0, p1, p2, p3)
+	}
+}
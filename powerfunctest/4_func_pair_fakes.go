@@ -0,0 +1,58 @@
+// Code generated by powerfunc/generator from fakes.go.tmpl. DO NOT EDIT.
+
+package powerfunctest
+
+import (
+	"context"
+
+	"github.com/jonathanmontane/powerfunc"
+)
+
+// StubFunc4Pair returns a Func4Pair returning the outcomes in order, one per
+// call. The last outcome is repeated once they are exhausted, and zero values
+// are returned if there are none.
+func StubFunc4Pair[R1, R2, P0, P1, P2, P3 any](outcomes ...Pair[R1, R2]) powerfunc.Func4Pair[R1, R2, P0, P1, P2, P3] {
+	s := &script{}
+	return func(p0 P0, p1 P1, p2 P2, p3 P3) (R1, R2) {
+		var out Pair[R1, R2]
+		if i := s.next(len(outcomes)); i >= 0 {
+			out = outcomes[i]
+		}
+		return out.First, out.Second
+	}
+}
+
+// SpyFunc4Pair returns a Func4Pair recording the calls of f, along with the
+// Spy holding them.
+func SpyFunc4Pair[R1, R2, P0, P1, P2, P3 any](f powerfunc.Func4Pair[R1, R2, P0, P1, P2, P3]) (powerfunc.Func4Pair[R1, R2, P0, P1, P2, P3], *Spy) {
+	s := &Spy{}
+	return func(p0 P0, p1 P1, p2 P2, p3 P3) (R1, R2) {
+		call := s.begin(context.Background(), p0, p1, p2, p3)
+		panicked := true
+		defer func() {
+			s.end(call, panicked)
+		}()
+		r0, r1 := f(p0, p1, p2, p3)
+		panicked = false
+		return r0, r1
+	}, s
+}
+
+// DelayFunc4Pair returns a Func4Pair waiting for latency before calling f.
+// The options set the clock measuring the latency, see DelayClock.
+func DelayFunc4Pair[R1, R2, P0, P1, P2, P3 any](latency Latency, f powerfunc.Func4Pair[R1, R2, P0, P1, P2, P3], opts ...DelayOption) powerfunc.Func4Pair[R1, R2, P0, P1, P2, P3] {
+	d := newDelay(latency, opts)
+	return func(p0 P0, p1 P1, p2 P2, p3 P3) (R1, R2) {
+		_ = d.wait(context.Background())
+		return f(p0, p1, p2, p3)
+	}
+}
+
+// GateFunc4Pair returns a Func4Pair blocking until g lets the call through
+// before calling f.
+func GateFunc4Pair[R1, R2, P0, P1, P2, P3 any](g *Gate, f powerfunc.Func4Pair[R1, R2, P0, P1, P2, P3]) powerfunc.Func4Pair[R1, R2, P0, P1, P2, P3] {
+	return func(p0 P0, p1 P1, p2 P2, p3 P3) (R1, R2) {
+		_ = g.wait(context.Background())
+		return f(p0, p1, p2, p3)
+	}
+}
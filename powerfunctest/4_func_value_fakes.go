@@ -0,0 +1,58 @@
+// Code generated by powerfunc/generator from fakes.go.tmpl. DO NOT EDIT.
+
+package powerfunctest
+
+import (
+	"context"
+
+	"github.com/jonathanmontane/powerfunc"
+)
+
+// StubFunc4Value returns a Func4Value returning the outcomes in order, one per
+// call. The last outcome is repeated once they are exhausted, and zero values
+// are returned if there are none.
+func StubFunc4Value[R, P0, P1, P2, P3 any](outcomes ...R) powerfunc.Func4Value[R, P0, P1, P2, P3] {
+	s := &script{}
+	return func(p0 P0, p1 P1, p2 P2, p3 P3) R {
+		var out R
+		if i := s.next(len(outcomes)); i >= 0 {
+			out = outcomes[i]
+		}
+		return out
+	}
+}
+
+// SpyFunc4Value returns a Func4Value recording the calls of f, along with the
+// Spy holding them.
+func SpyFunc4Value[R, P0, P1, P2, P3 any](f powerfunc.Func4Value[R, P0, P1, P2, P3]) (powerfunc.Func4Value[R, P0, P1, P2, P3], *Spy) {
+	s := &Spy{}
+	return func(p0 P0, p1 P1, p2 P2, p3 P3) R {
+		call := s.begin(context.Background(), p0, p1, p2, p3)
+		panicked := true
+		defer func() {
+			s.end(call, panicked)
+		}()
+		r0 := f(p0, p1, p2, p3)
+		panicked = false
+		return r0
+	}, s
+}
+
+// DelayFunc4Value returns a Func4Value waiting for latency before calling f.
+// The options set the clock measuring the latency, see DelayClock.
+func DelayFunc4Value[R, P0, P1, P2, P3 any](latency Latency, f powerfunc.Func4Value[R, P0, P1, P2, P3], opts ...DelayOption) powerfunc.Func4Value[R, P0, P1, P2, P3] {
+	d := newDelay(latency, opts)
+	return func(p0 P0, p1 P1, p2 P2, p3 P3) R {
+		_ = d.wait(context.Background())
+		return f(p0, p1, p2, p3)
+	}
+}
+
+// GateFunc4Value returns a Func4Value blocking until g lets the call through
+// before calling f.
+func GateFunc4Value[R, P0, P1, P2, P3 any](g *Gate, f powerfunc.Func4Value[R, P0, P1, P2, P3]) powerfunc.Func4Value[R, P0, P1, P2, P3] {
+	return func(p0 P0, p1 P1, p2 P2, p3 P3) R {
+		_ = g.wait(context.Background())
+		return f(p0, p1, p2, p3)
+	}
+}
@@ -0,0 +1,71 @@
+// Code generated by powerfunc/generator from fakes.go.tmpl. DO NOT EDIT.
+
+package powerfunctest
+
+import (
+	"context"
+
+	"github.com/jonathanmontane/powerfunc"
+)
+
+// StubFunc4VariadicError returns a Func4VariadicError returning the outcomes in order, one per
+// call. The last outcome is repeated once they are exhausted, and zero values
+// are returned if there are none.
+func StubFunc4VariadicError[V, P0, P1, P2, P3 any](outcomes ...error) powerfunc.Func4VariadicError[V, P0, P1, P2, P3] {
+	s := &script{}
+	return func(p0 P0, p1 P1, p2 P2, p3 P3, xs ...V) error {
+		var out error
+		if i := s.next(len(outcomes)); i >= 0 {
+			out = outcomes[i]
+		}
+		return out
+	}
+}
+
+// SpyFunc4VariadicError returns a Func4VariadicError recording the calls of f, along with the
+// Spy holding them.
+func SpyFunc4VariadicError[V, P0, P1, P2, P3 any](f powerfunc.Func4VariadicError[V, P0, P1, P2, P3]) (powerfunc.Func4VariadicError[V, P0, P1, P2, P3], *Spy) {
+	s := &Spy{}
+	return func(p0 P0, p1 P1, p2 P2, p3 P3, xs ...V) error {
+		call := s.begin(context.Background(), p0, p1, p2, p3, xs)
+		panicked := true
+		defer func() {
+			s.end(call, panicked)
+		}()
+		err := f(p0, p1, p2, p3, xs...)
+		panicked = false
+		s.fail(call, err)
+		return err
+	}, s
+}
+
+// FailNFunc4VariadicError returns a Func4VariadicError returning err for the first n calls,
+// then calling f.
+func FailNFunc4VariadicError[V, P0, P1, P2, P3 any](n int, err error, f powerfunc.Func4VariadicError[V, P0, P1, P2, P3]) powerfunc.Func4VariadicError[V, P0, P1, P2, P3] {
+	fl := &failer{n: int64(n)}
+	return func(p0 P0, p1 P1, p2 P2, p3 P3, xs ...V) error {
+		if fl.fail() {
+			return err
+		}
+		return f(p0, p1, p2, p3, xs...)
+	}
+}
+
+// DelayFunc4VariadicError returns a Func4VariadicError waiting for latency before calling f.
+// The options set the clock measuring the latency, see DelayClock.
+func DelayFunc4VariadicError[V, P0, P1, P2, P3 any](latency Latency, f powerfunc.Func4VariadicError[V, P0, P1, P2, P3], opts ...DelayOption) powerfunc.Func4VariadicError[V, P0, P1, P2, P3] {
+	d := newDelay(latency, opts)
+	return func(p0 P0, p1 P1, p2 P2, p3 P3, xs ...V) error {
+		_ = d.wait(context.Background())
+		return f(p0, p1, p2, p3, xs...)
+	}
+}
+
+// GateFunc4VariadicError returns a Func4VariadicError blocking until g lets the call through
+// before calling f.
+func GateFunc4VariadicError[V, P0, P1, P2, P3 any](g *Gate, f powerfunc.Func4VariadicError[V, P0, P1, P2, P3]) powerfunc.Func4VariadicError[V, P0, P1, P2, P3] {
+	return func(p0 P0, p1 P1, p2 P2, p3 P3, xs ...V) error {
+		_ = g.wait(context.Background())
+		return f(p0, p1, p2, p3, xs...)
+	}
+}
@@ -0,0 +1,71 @@
+// Code generated by powerfunc/generator from fakes.go.tmpl. DO NOT EDIT.
+
+package powerfunctest
+
+import (
+	"context"
+
+	"github.com/jonathanmontane/powerfunc"
+)
+
+// StubFunc4VariadicResult returns a Func4VariadicResult returning the outcomes in order, one per
+// call. The last outcome is repeated once they are exhausted, and zero values
+// are returned if there are none.
+func StubFunc4VariadicResult[R, V, P0, P1, P2, P3 any](outcomes ...Result[R]) powerfunc.Func4VariadicResult[R, V, P0, P1, P2, P3] {
+	s := &script{}
+	return func(p0 P0, p1 P1, p2 P2, p3 P3, xs ...V) (R, error) {
+		var out Result[R]
+		if i := s.next(len(outcomes)); i >= 0 {
+			out = outcomes[i]
+		}
+		return out.Value, out.Err
+	}
+}
+
+// SpyFunc4VariadicResult returns a Func4VariadicResult recording the calls of f, along with the
+// Spy holding them.
+func SpyFunc4VariadicResult[R, V, P0, P1, P2, P3 any](f powerfunc.Func4VariadicResult[R, V, P0, P1, P2, P3]) (powerfunc.Func4VariadicResult[R, V, P0, P1, P2, P3], *Spy) {
+	s := &Spy{}
+	return func(p0 P0, p1 P1, p2 P2, p3 P3, xs ...V) (R, error) {
+		call := s.begin(context.Background(), p0, p1, p2, p3, xs)
+		panicked := true
+		defer func() {
+			s.end(call, panicked)
+		}()
+		r0, err := f(p0, p1, p2, p3, xs...)
+		panicked = false
+		s.fail(call, err)
+		return r0, err
+	}, s
+}
+
+// FailNFunc4VariadicResult returns a Func4VariadicResult returning err for the first n calls,
+// then calling f.
+func FailNFunc4VariadicResult[R, V, P0, P1, P2, P3 any](n int, err error, f powerfunc.Func4VariadicResult[R, V, P0, P1, P2, P3]) powerfunc.Func4VariadicResult[R, V, P0, P1, P2, P3] {
+	fl := &failer{n: int64(n)}
+	return func(p0 P0, p1 P1, p2 P2, p3 P3, xs ...V) (R, error) {
+		if fl.fail() {
+			return *new(R), err
+		}
+		return f(p0, p1, p2, p3, xs...)
+	}
+}
+
+// DelayFunc4VariadicResult returns a Func4VariadicResult waiting for latency before calling f.
+// The options set the clock measuring the latency, see DelayClock.
+func DelayFunc4VariadicResult[R, V, P0, P1, P2, P3 any](latency Latency, f powerfunc.Func4VariadicResult[R, V, P0, P1, P2, P3], opts ...DelayOption) powerfunc.Func4VariadicResult[R, V, P0, P1, P2, P3] {
+	d := newDelay(latency, opts)
+	return func(p0 P0, p1 P1, p2 P2, p3 P3, xs ...V) (R, error) {
+		_ = d.wait(context.Background())
+		return f(p0, p1, p2, p3, xs...)
+	}
+}
+
+// GateFunc4VariadicResult returns a Func4VariadicResult blocking until g lets the call through
+// before calling f.
+func GateFunc4VariadicResult[R, V, P0, P1, P2, P3 any](g *Gate, f powerfunc.Func4VariadicResult[R, V, P0, P1, P2, P3]) powerfunc.Func4VariadicResult[R, V, P0, P1, P2, P3] {
+	return func(p0 P0, p1 P1, p2 P2, p3 P3, xs ...V) (R, error) {
+		_ = g.wait(context.Background())
+		return f(p0, p1, p2, p3, xs...)
+	}
+}
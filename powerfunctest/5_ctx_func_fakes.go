@@ -0,0 +1,45 @@
+// Code generated by powerfunc/generator from fakes.go.tmpl. DO NOT EDIT.
+
+package powerfunctest
+
+import (
+	"context"
+
+	"github.com/jonathanmontane/powerfunc"
+)
+
+// SpyCtxFunc5 returns a CtxFunc5 recording the calls of f, along with the
+// Spy holding them.
+func SpyCtxFunc5[P0, P1, P2, P3, P4 any](f powerfunc.CtxFunc5[P0, P1, P2, P3, P4]) (powerfunc.CtxFunc5[P0, P1, P2, P3, P4], *Spy) {
+	s := &Spy{}
+	return func(ctx context.Context, p0 P0, p1 P1, p2 P2, p3 P3, p4 P4) {
+		call := s.begin(ctx, p0, p1, p2, p3, p4)
+		panicked := true
+		defer func() {
+			s.end(call, panicked)
+		}()
+		f(ctx, p0, p1, p2, p3, p4)
+		panicked = false
+	}, s
+}
+
+// DelayCtxFunc5 returns a CtxFunc5 waiting for latency before calling f.
+// The options set the clock measuring the latency, see DelayClock.
+// f is still called if the context is done first.
+func DelayCtxFunc5[P0, P1, P2, P3, P4 any](latency Latency, f powerfunc.CtxFunc5[P0, P1, P2, P3, P4], opts ...DelayOption) powerfunc.CtxFunc5[P0, P1, P2, P3, P4] {
+	d := newDelay(latency, opts)
+	return func(ctx context.Context, p0 P0, p1 P1, p2 P2, p3 P3, p4 P4) {
+		_ = d.wait(ctx)
+		f(ctx, p0, p1, p2, p3, p4)
+	}
+}
+
+// GateCtxFunc5 returns a CtxFunc5 blocking until g lets the call through
+// before calling f.
+// f is still called if the context is done first.
+func GateCtxFunc5[P0, P1, P2, P3, P4 any](g *Gate, f powerfunc.CtxFunc5[P0, P1, P2, P3, P4]) powerfunc.CtxFunc5[P0, P1, P2, P3, P4] {
+	return func(ctx context.Context, p0 P0, p1 P1, p2 P2, p3 P3, p4 P4) {
+		_ = g.wait(ctx)
+		f(ctx, p0, p1, p2, p3, p4)
+	}
+}
@@ -0,0 +1,60 @@
+// Code generated by powerfunc/generator from fakes.go.tmpl. DO NOT EDIT.
+
+package powerfunctest
+
+import (
+	"context"
+
+	"github.com/jonathanmontane/powerfunc"
+)
+
+// StubCtxFunc5Pair returns a CtxFunc5Pair returning the outcomes in order, one per
+// call. The last outcome is repeated once they are exhausted, and zero values
+// are returned if there are none.
+func StubCtxFunc5Pair[R1, R2, P0, P1, P2, P3, P4 any](outcomes ...Pair[R1, R2]) powerfunc.CtxFunc5Pair[R1, R2, P0, P1, P2, P3, P4] {
+	s := &script{}
+	return func(ctx context.Context, p0 P0, p1 P1, p2 P2, p3 P3, p4 P4) (R1, R2) {
+		var out Pair[R1, R2]
+		if i := s.next(len(outcomes)); i >= 0 {
+			out = outcomes[i]
+		}
+		return out.First, out.Second
+	}
+}
+
+// SpyCtxFunc5Pair returns a CtxFunc5Pair recording the calls of f, along with the
+// Spy holding them.
+func SpyCtxFunc5Pair[R1, R2, P0, P1, P2, P3, P4 any](f powerfunc.CtxFunc5Pair[R1, R2, P0, P1, P2, P3, P4]) (powerfunc.CtxFunc5Pair[R1, R2, P0, P1, P2, P3, P4], *Spy) {
+	s := &Spy{}
+	return func(ctx context.Context, p0 P0, p1 P1, p2 P2, p3 P3, p4 P4) (R1, R2) {
+		call := s.begin(ctx, p0, p1, p2, p3, p4)
+		panicked := true
+		defer func() {
+			s.end(call, panicked)
+		}()
+		r0, r1 := f(ctx, p0, p1, p2, p3, p4)
+		panicked = false
+		return r0, r1
+	}, s
+}
+
+// DelayCtxFunc5Pair returns a CtxFunc5Pair waiting for latency before calling f.
+// The options set the clock measuring the latency, see DelayClock.
+// f is still called if the context is done first.
+func DelayCtxFunc5Pair[R1, R2, P0, P1, P2, P3, P4 any](latency Latency, f powerfunc.CtxFunc5Pair[R1, R2, P0, P1, P2, P3, P4], opts ...DelayOption) powerfunc.CtxFunc5Pair[R1, R2, P0, P1, P2, P3, P4] {
+	d := newDelay(latency, opts)
+	return func(ctx context.Context, p0 P0, p1 P1, p2 P2, p3 P3, p4 P4) (R1, R2) {
+		_ = d.wait(ctx)
+		return f(ctx, p0, p1, p2, p3, p4)
+	}
+}
+
+// GateCtxFunc5Pair returns a CtxFunc5Pair blocking until g lets the call through
+// before calling f.
+// f is still called if the context is done first.
+func GateCtxFunc5Pair[R1, R2, P0, P1, P2, P3, P4 any](g *Gate, f powerfunc.CtxFunc5Pair[R1, R2, P0, P1, P2, P3, P4]) powerfunc.CtxFunc5Pair[R1, R2, P0, P1, P2, P3, P4] {
+	return func(ctx context.Context, p0 P0, p1 P1, p2 P2, p3 P3, p4 P4) (R1, R2) {
+		_ = g.wait(ctx)
+		return f(ctx, p0, p1, p2, p3, p4)
+	}
+}
@@ -0,0 +1,43 @@
+// Code generated by powerfunc/generator from fakes.go.tmpl. DO NOT EDIT.
+
+package powerfunctest
+
+import (
+	"context"
+
+	"github.com/jonathanmontane/powerfunc"
+)
+
+// SpyFunc5 returns a Func5 recording the calls of f, along with the
+// Spy holding them.
+func SpyFunc5[P0, P1, P2, P3, P4 any](f powerfunc.Func5[P0, P1, P2, P3, P4]) (powerfunc.Func5[P0, P1, P2, P3, P4], *Spy) {
+	s := &Spy{}
+	return func(p0 P0, p1 P1, p2 P2, p3 P3, p4 P4) {
+		call := s.begin(context.Background(), p0, p1, p2, p3, p4)
+		panicked := true
+		defer func() {
+			s.end(call, panicked)
+		}()
+		f(p0, p1, p2, p3, p4)
+		panicked = false
+	}, s
+}
+
+// DelayFunc5 returns a Func5 waiting for latency before calling f.
+// The options set the clock measuring the latency, see DelayClock.
+func DelayFunc5[P0, P1, P2, P3, P4 any](latency Latency, f powerfunc.Func5[P0, P1, P2, P3, P4], opts ...DelayOption) powerfunc.Func5[P0, P1, P2, P3, P4] {
+	d := newDelay(latency, opts)
+	return func(p0 P0, p1 P1, p2 P2, p3 P3, p4 P4) {
+		_ = d.wait(context.Background())
+		f(p0, p1, p2, p3, p4)
+	}
+}
+
+// GateFunc5 returns a Func5 blocking until g lets the call through
+// before calling f.
+func GateFunc5[P0, P1, P2, P3, P4 any](g *Gate, f powerfunc.Func5[P0, P1, P2, P3, P4]) powerfunc.Func5[P0, P1, P2, P3, P4] {
+	return func(p0 P0, p1 P1, p2 P2, p3 P3, p4 P4) {
+		_ = g.wait(context.Background())
+		f(p0, p1, p2, p3, p4)
+	}
+}
@@ -0,0 +1,58 @@
+// Code generated by powerfunc/generator from fakes.go.tmpl. DO NOT EDIT.
+
+package powerfunctest
+
+import (
+	"context"
+
+	"github.com/jonathanmontane/powerfunc"
+)
+
+// StubFunc5Ok returns a Func5Ok returning the outcomes in order, one per
+// call. The last outcome is repeated once they are exhausted, and zero values
+// are returned if there are none.
+func StubFunc5Ok[R, P0, P1, P2, P3, P4 any](outcomes ...Option[R]) powerfunc.Func5Ok[R, P0, P1, P2, P3, P4] {
+	s := &script{}
+	return func(p0 P0, p1 P1, p2 P2, p3 P3, p4 P4) (R, bool) {
+		var out Option[R]
+		if i := s.next(len(outcomes)); i >= 0 {
+			out = outcomes[i]
+		}
+		return out.Value, out.Ok
+	}
+}
+
+// SpyFunc5Ok returns a Func5Ok recording the calls of f, along with the
+// Spy holding them.
+func SpyFunc5Ok[R, P0, P1, P2, P3, P4 any](f powerfunc.Func5Ok[R, P0, P1, P2, P3, P4]) (powerfunc.Func5Ok[R, P0, P1, P2, P3, P4], *Spy) {
+	s := &Spy{}
+	return func(p0 P0, p1 P1, p2 P2, p3 P3, p4 P4) (R, bool) {
+		call := s.begin(context.Background(), p0, p1, p2, p3, p4)
+		panicked := true
+		defer func() {
+			s.end(call, panicked)
+		}()
+		r0, r1 := f(p0, p1, p2, p3, p4)
+		panicked = false
+		return r0, r1
+	}, s
+}
+
+// DelayFunc5Ok returns a Func5Ok waiting for latency before calling f.
+// The options set the clock measuring the latency, see DelayClock.
+func DelayFunc5Ok[R, P0, P1, P2, P3, P4 any](latency Latency, f powerfunc.Func5Ok[R, P0, P1, P2, P3, P4], opts ...DelayOption) powerfunc.Func5Ok[R, P0, P1, P2, P3, P4] {
+	d := newDelay(latency, opts)
+	return func(p0 P0, p1 P1, p2 P2, p3 P3, p4 P4) (R, bool) {
+		_ = d.wait(context.Background())
+		return f(p0, p1, p2, p3, p4)
+	}
+}
+
+// GateFunc5Ok returns a Func5Ok blocking until g lets the call through
+// before calling f.
+func GateFunc5Ok[R, P0, P1, P2, P3, P4 any](g *Gate, f powerfunc.Func5Ok[R, P0, P1, P2, P3, P4]) powerfunc.Func5Ok[R, P0, P1, P2, P3, P4] {
+	return func(p0 P0, p1 P1, p2 P2, p3 P3, p4 P4) (R, bool) {
+		_ = g.wait(context.Background())
+		return f(p0, p1, p2, p3, p4)
+	}
+}
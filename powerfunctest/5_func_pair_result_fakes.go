@@ -0,0 +1,71 @@
+// Code generated by powerfunc/generator from fakes.go.tmpl. DO NOT EDIT.
+
+package powerfunctest
+
+import (
+	"context"
+
+	"github.com/jonathanmontane/powerfunc"
+)
+
+// StubFunc5PairResult returns a Func5PairResult returning the outcomes in order, one per
+// call. The last outcome is repeated once they are exhausted, and zero values
+// are returned if there are none.
+func StubFunc5PairResult[R1, R2, P0, P1, P2, P3, P4 any](outcomes ...PairResult[R1, R2]) powerfunc.Func5PairResult[R1, R2, P0, P1, P2, P3, P4] {
+	s := &script{}
+	return func(p0 P0, p1 P1, p2 P2, p3 P3, p4 P4) (R1, R2, error) {
+		var out PairResult[R1, R2]
+		if i := s.next(len(outcomes)); i >= 0 {
+			out = outcomes[i]
+		}
+		return out.First, out.Second, out.Err
+	}
+}
+
+// SpyFunc5PairResult returns a Func5PairResult recording the calls of f, along with the
+// Spy holding them.
+func SpyFunc5PairResult[R1, R2, P0, P1, P2, P3, P4 any](f powerfunc.Func5PairResult[R1, R2, P0, P1, P2, P3, P4]) (powerfunc.Func5PairResult[R1, R2, P0, P1, P2, P3, P4], *Spy) {
+	s := &Spy{}
+	return func(p0 P0, p1 P1, p2 P2, p3 P3, p4 P4) (R1, R2, error) {
+		call := s.begin(context.Background(), p0, p1, p2, p3, p4)
+		panicked := true
+		defer func() {
+			s.end(call, panicked)
+		}()
+		r0, r1, err := f(p0, p1, p2, p3, p4)
+		panicked = false
+		s.fail(call, err)
+		return r0, r1, err
+	}, s
+}
+
+// FailNFunc5PairResult returns a Func5PairResult returning err for the first n calls,
+// then calling f.
+func FailNFunc5PairResult[R1, R2, P0, P1, P2, P3, P4 any](n int, err error, f powerfunc.Func5PairResult[R1, R2, P0, P1, P2, P3, P4]) powerfunc.Func5PairResult[R1, R2, P0, P1, P2, P3, P4] {
+	fl := &failer{n: int64(n)}
+	return func(p0 P0, p1 P1, p2 P2, p3 P3, p4 P4) (R1, R2, error) {
+		if fl.fail() {
+			return *new(R1), *new(R2), err
+		}
+		return f(p0, p1, p2, p3, p4)
+	}
+}
+
+// DelayFunc5PairResult returns a Func5PairResult waiting for latency before calling f.
+// The options set the clock measuring the latency, see DelayClock.
+func DelayFunc5PairResult[R1, R2, P0, P1, P2, P3, P4 any](latency Latency, f powerfunc.Func5PairResult[R1, R2, P0, P1, P2, P3, P4], opts ...DelayOption) powerfunc.Func5PairResult[R1, R2, P0, P1, P2, P3, P4] {
+	d := newDelay(latency, opts)
+	return func(p0 P0, p1 P1, p2 P2, p3 P3, p4 P4) (R1, R2, error) {
+		_ = d.wait(context.Background())
+		return f(p0, p1, p2, p3, p4)
+	}
+}
+
+// GateFunc5PairResult returns a Func5PairResult blocking until g lets the call through
+// before calling f.
+func GateFunc5PairResult[R1, R2, P0, P1, P2, P3, P4 any](g *Gate, f powerfunc.Func5PairResult[R1, R2, P0, P1, P2, P3, P4]) powerfunc.Func5PairResult[R1, R2, P0, P1, P2, P3, P4] {
+	return func(p0 P0, p1 P1, p2 P2, p3 P3, p4 P4) (R1, R2, error) {
+		_ = g.wait(context.Background())
+		return f(p0, p1, p2, p3, p4)
+	}
+}
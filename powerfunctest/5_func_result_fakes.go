@@ -0,0 +1,71 @@
+// Code generated by powerfunc/generator from fakes.go.tmpl. DO NOT EDIT.
+
+package powerfunctest
+
+import (
+	"context"
+
+	"github.com/jonathanmontane/powerfunc"
+)
+
+// StubFunc5Result returns a Func5Result returning the outcomes in order, one per
+// call. The last outcome is repeated once they are exhausted, and zero values
+// are returned if there are none.
+func StubFunc5Result[R, P0, P1, P2, P3, P4 any](outcomes ...Result[R]) powerfunc.Func5Result[R, P0, P1, P2, P3, P4] {
+	s := &script{}
+	return func(p0 P0, p1 P1, p2 P2, p3 P3, p4 P4) (R, error) {
+		var out Result[R]
+		if i := s.next(len(outcomes)); i >= 0 {
+			out = outcomes[i]
+		}
+		return out.Value, out.Err
+	}
+}
+
+// SpyFunc5Result returns a Func5Result recording the calls of f, along with the
+// Spy holding them.
+func SpyFunc5Result[R, P0, P1, P2, P3, P4 any](f powerfunc.Func5Result[R, P0, P1, P2, P3, P4]) (powerfunc.Func5Result[R, P0, P1, P2, P3, P4], *Spy) {
+	s := &Spy{}
+	return func(p0 P0, p1 P1, p2 P2, p3 P3, p4 P4) (R, error) {
+		call := s.begin(context.Background(), p0, p1, p2, p3, p4)
+		panicked := true
+		defer func() {
+			s.end(call, panicked)
+		}()
+		r0, err := f(p0, p1, p2, p3, p4)
+		panicked = false
+		s.fail(call, err)
+		return r0, err
+	}, s
+}
+
+// FailNFunc5Result returns a Func5Result returning err for the first n calls,
+// then calling f.
+func FailNFunc5Result[R, P0, P1, P2, P3, P4 any](n int, err error, f powerfunc.Func5Result[R, P0, P1, P2, P3, P4]) powerfunc.Func5Result[R, P0, P1, P2, P3, P4] {
+	fl := &failer{n: int64(n)}
+	return func(p0 P0, p1 P1, p2 P2, p3 P3, p4 P4) (R, error) {
+		if fl.fail() {
+			return *new(R), err
+		}
+		return f(p0, p1, p2, p3, p4)
+	}
+}
+
+// DelayFunc5Result returns a Func5Result waiting for latency before calling f.
+// The options set the clock measuring the latency, see DelayClock.
+func DelayFunc5Result[R, P0, P1, P2, P3, P4 any](latency Latency, f powerfunc.Func5Result[R, P0, P1, P2, P3, P4], opts ...DelayOption) powerfunc.Func5Result[R, P0, P1, P2, P3, P4] {
+	d := newDelay(latency, opts)
+	return func(p0 P0, p1 P1, p2 P2, p3 P3, p4 P4) (R, error) {
+		_ = d.wait(context.Background())
+		return f(p0, p1, p2, p3, p4)
+	}
+}
+
+// GateFunc5Result returns a Func5Result blocking until g lets the call through
+// before calling f.
+func GateFunc5Result[R, P0, P1, P2, P3, P4 any](g *Gate, f powerfunc.Func5Result[R, P0, P1, P2, P3, P4]) powerfunc.Func5Result[R, P0, P1, P2, P3, P4] {
+	return func(p0 P0, p1 P1, p2 P2, p3 P3, p4 P4) (R, error) {
+		_ = g.wait(context.Background())
+		return f(p0, p1, p2, p3, p4)
+	}
+}
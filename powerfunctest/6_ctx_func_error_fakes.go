@@ -0,0 +1,77 @@
+// Code generated by powerfunc/generator from fakes.go.tmpl. DO NOT EDIT.
+
+package powerfunctest
+
+import (
+	"context"
+
+	"github.com/jonathanmontane/powerfunc"
+)
+
+// StubCtxFunc6Error returns a CtxFunc6Error returning the outcomes in order, one per
+// call. The last outcome is repeated once they are exhausted, and zero values
+// are returned if there are none.
+func StubCtxFunc6Error[P0, P1, P2, P3, P4, P5 any](outcomes ...error) powerfunc.CtxFunc6Error[P0, P1, P2, P3, P4, P5] {
+	s := &script{}
+	return func(ctx context.Context, p0 P0, p1 P1, p2 P2, p3 P3, p4 P4, p5 P5) error {
+		var out error
+		if i := s.next(len(outcomes)); i >= 0 {
+			out = outcomes[i]
+		}
+		return out
+	}
+}
+
+// SpyCtxFunc6Error returns a CtxFunc6Error recording the calls of f, along with the
+// Spy holding them.
+func SpyCtxFunc6Error[P0, P1, P2, P3, P4, P5 any](f powerfunc.CtxFunc6Error[P0, P1, P2, P3, P4, P5]) (powerfunc.CtxFunc6Error[P0, P1, P2, P3, P4, P5], *Spy) {
+	s := &Spy{}
+	return func(ctx context.Context, p0 P0, p1 P1, p2 P2, p3 P3, p4 P4, p5 P5) error {
+		call := s.begin(ctx, p0, p1, p2, p3, p4, p5)
+		panicked := true
+		defer func() {
+			s.end(call, panicked)
+		}()
+		err := f(ctx, p0, p1, p2, p3, p4, p5)
+		panicked = false
+		s.fail(call, err)
+		return err
+	}, s
+}
+
+// FailNCtxFunc6Error returns a CtxFunc6Error returning err for the first n calls,
+// then calling f.
+func FailNCtxFunc6Error[P0, P1, P2, P3, P4, P5 any](n int, err error, f powerfunc.CtxFunc6Error[P0, P1, P2, P3, P4, P5]) powerfunc.CtxFunc6Error[P0, P1, P2, P3, P4, P5] {
+	fl := &failer{n: int64(n)}
+	return func(ctx context.Context, p0 P0, p1 P1, p2 P2, p3 P3, p4 P4, p5 P5) error {
+		if fl.fail() {
+			return err
+		}
+		return f(ctx, p0, p1, p2, p3, p4, p5)
+	}
+}
+
+// DelayCtxFunc6Error returns a CtxFunc6Error waiting for latency before calling f.
+// The options set the clock measuring the latency, see DelayClock.
+// The error of the context is returned if it is done first.
+func DelayCtxFunc6Error[P0, P1, P2, P3, P4, P5 any](latency Latency, f powerfunc.CtxFunc6Error[P0, P1, P2, P3, P4, P5], opts ...DelayOption) powerfunc.CtxFunc6Error[P0, P1, P2, P3, P4, P5] {
+	d := newDelay(latency, opts)
+	return func(ctx context.Context, p0 P0, p1 P1, p2 P2, p3 P3, p4 P4, p5 P5) error {
+		if err := d.wait(ctx); err != nil {
+			return err
+		}
+		return f(ctx, p0, p1, p2, p3, p4, p5)
+	}
+}
+
+// GateCtxFunc6Error returns a CtxFunc6Error blocking until g lets the call through
+// before calling f.
+// The error of the context is returned if it is done first.
+func GateCtxFunc6Error[P0, P1, P2, P3, P4, P5 any](g *Gate, f powerfunc.CtxFunc6Error[P0, P1, P2, P3, P4, P5]) powerfunc.CtxFunc6Error[P0, P1, P2, P3, P4, P5] {
+	return func(ctx context.Context, p0 P0, p1 P1, p2 P2, p3 P3, p4 P4, p5 P5) error {
+		if err := g.wait(ctx); err != nil {
+			return err
+		}
+		return f(ctx, p0, p1, p2, p3, p4, p5)
+	}
+}
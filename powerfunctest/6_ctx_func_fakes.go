@@ -0,0 +1,45 @@
+// Code generated by powerfunc/generator from fakes.go.tmpl. DO NOT EDIT.
+
+package powerfunctest
+
+import (
+	"context"
+
+	"github.com/jonathanmontane/powerfunc"
+)
+
+// SpyCtxFunc6 returns a CtxFunc6 recording the calls of f, along with the
+// Spy holding them.
+func SpyCtxFunc6[P0, P1, P2, P3, P4, P5 any](f powerfunc.CtxFunc6[P0, P1, P2, P3, P4, P5]) (powerfunc.CtxFunc6[P0, P1, P2, P3, P4, P5], *Spy) {
+	s := &Spy{}
+	return func(ctx context.Context, p0 P0, p1 P1, p2 P2, p3 P3, p4 P4, p5 P5) {
+		call := s.begin(ctx, p0, p1, p2, p3, p4, p5)
+		panicked := true
+		defer func() {
+			s.end(call, panicked)
+		}()
+		f(ctx, p0, p1, p2, p3, p4, p5)
+		panicked = false
+	}, s
+}
+
+// DelayCtxFunc6 returns a CtxFunc6 waiting for latency before calling f.
+// The options set the clock measuring the latency, see DelayClock.
+// f is still called if the context is done first.
+func DelayCtxFunc6[P0, P1, P2, P3, P4, P5 any](latency Latency, f powerfunc.CtxFunc6[P0, P1, P2, P3, P4, P5], opts ...DelayOption) powerfunc.CtxFunc6[P0, P1, P2, P3, P4, P5] {
+	d := newDelay(latency, opts)
+	return func(ctx context.Context, p0 P0, p1 P1, p2 P2, p3 P3, p4 P4, p5 P5) {
+		_ = d.wait(ctx)
+		f(ctx, p0, p1, p2, p3, p4, p5)
+	}
+}
+
+// GateCtxFunc6 returns a CtxFunc6 blocking until g lets the call through
+// before calling f.
+// f is still called if the context is done first.
+func GateCtxFunc6[P0, P1, P2, P3, P4, P5 any](g *Gate, f powerfunc.CtxFunc6[P0, P1, P2, P3, P4, P5]) powerfunc.CtxFunc6[P0, P1, P2, P3, P4, P5] {
+	return func(ctx context.Context, p0 P0, p1 P1, p2 P2, p3 P3, p4 P4, p5 P5) {
+		_ = g.wait(ctx)
+		f(ctx, p0, p1, p2, p3, p4, p5)
+	}
+}
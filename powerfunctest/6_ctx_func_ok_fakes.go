@@ -0,0 +1,60 @@
+// Code generated by powerfunc/generator from fakes.go.tmpl. DO NOT EDIT.
+
+package powerfunctest
+
+import (
+	"context"
+
+	"github.com/jonathanmontane/powerfunc"
+)
+
+// StubCtxFunc6Ok returns a CtxFunc6Ok returning the outcomes in order, one per
+// call. The last outcome is repeated once they are exhausted, and zero values
+// are returned if there are none.
+func StubCtxFunc6Ok[R, P0, P1, P2, P3, P4, P5 any](outcomes ...Option[R]) powerfunc.CtxFunc6Ok[R, P0, P1, P2, P3, P4, P5] {
+	s := &script{}
+	return func(ctx context.Context, p0 P0, p1 P1, p2 P2, p3 P3, p4 P4, p5 P5) (R, bool) {
+		var out Option[R]
+		if i := s.next(len(outcomes)); i >= 0 {
+			out = outcomes[i]
+		}
+		return out.Value, out.Ok
+	}
+}
+
+// SpyCtxFunc6Ok returns a CtxFunc6Ok recording the calls of f, along with the
+// Spy holding them.
+func SpyCtxFunc6Ok[R, P0, P1, P2, P3, P4, P5 any](f powerfunc.CtxFunc6Ok[R, P0, P1, P2, P3, P4, P5]) (powerfunc.CtxFunc6Ok[R, P0, P1, P2, P3, P4, P5], *Spy) {
+	s := &Spy{}
+	return func(ctx context.Context, p0 P0, p1 P1, p2 P2, p3 P3, p4 P4, p5 P5) (R, bool) {
+		call := s.begin(ctx, p0, p1, p2, p3, p4, p5)
+		panicked := true
+		defer func() {
+			s.end(call, panicked)
+		}()
+		r0, r1 := f(ctx, p0, p1, p2, p3, p4, p5)
+		panicked = false
+		return r0, r1
+	}, s
+}
+
+// DelayCtxFunc6Ok returns a CtxFunc6Ok waiting for latency before calling f.
+// The options set the clock measuring the latency, see DelayClock.
+// f is still called if the context is done first.
+func DelayCtxFunc6Ok[R, P0, P1, P2, P3, P4, P5 any](latency Latency, f powerfunc.CtxFunc6Ok[R, P0, P1, P2, P3, P4, P5], opts ...DelayOption) powerfunc.CtxFunc6Ok[R, P0, P1, P2, P3, P4, P5] {
+	d := newDelay(latency, opts)
+	return func(ctx context.Context, p0 P0, p1 P1, p2 P2, p3 P3, p4 P4, p5 P5) (R, bool) {
+		_ = d.wait(ctx)
+		return f(ctx, p0, p1, p2, p3, p4, p5)
+	}
+}
+
+// GateCtxFunc6Ok returns a CtxFunc6Ok blocking until g lets the call through
+// before calling f.
+// f is still called if the context is done first.
+func GateCtxFunc6Ok[R, P0, P1, P2, P3, P4, P5 any](g *Gate, f powerfunc.CtxFunc6Ok[R, P0, P1, P2, P3, P4, P5]) powerfunc.CtxFunc6Ok[R, P0, P1, P2, P3, P4, P5] {
+	return func(ctx context.Context, p0 P0, p1 P1, p2 P2, p3 P3, p4 P4, p5 P5) (R, bool) {
+		_ = g.wait(ctx)
+		return f(ctx, p0, p1, p2, p3, p4, p5)
+	}
+}
@@ -0,0 +1,60 @@
+// Code generated by powerfunc/generator from fakes.go.tmpl. DO NOT EDIT.
+
+package powerfunctest
+
+import (
+	"context"
+
+	"github.com/jonathanmontane/powerfunc"
+)
+
+// StubCtxFunc6Value returns a CtxFunc6Value returning the outcomes in order, one per
+// call. The last outcome is repeated once they are exhausted, and zero values
+// are returned if there are none.
+func StubCtxFunc6Value[R, P0, P1, P2, P3, P4, P5 any](outcomes ...R) powerfunc.CtxFunc6Value[R, P0, P1, P2, P3, P4, P5] {
+	s := &script{}
+	return func(ctx context.Context, p0 P0, p1 P1, p2 P2, p3 P3, p4 P4, p5 P5) R {
+		var out R
+		if i := s.next(len(outcomes)); i >= 0 {
+			out = outcomes[i]
+		}
+		return out
+	}
+}
+
+// SpyCtxFunc6Value returns a CtxFunc6Value recording the calls of f, along with the
+// Spy holding them.
+func SpyCtxFunc6Value[R, P0, P1, P2, P3, P4, P5 any](f powerfunc.CtxFunc6Value[R, P0, P1, P2, P3, P4, P5]) (powerfunc.CtxFunc6Value[R, P0, P1, P2, P3, P4, P5], *Spy) {
+	s := &Spy{}
+	return func(ctx context.Context, p0 P0, p1 P1, p2 P2, p3 P3, p4 P4, p5 P5) R {
+		call := s.begin(ctx, p0, p1, p2, p3, p4, p5)
+		panicked := true
+		defer func() {
+			s.end(call, panicked)
+		}()
+		r0 := f(ctx, p0, p1, p2, p3, p4, p5)
+		panicked = false
+		return r0
+	}, s
+}
+
+// DelayCtxFunc6Value returns a CtxFunc6Value waiting for latency before calling f.
+// The options set the clock measuring the latency, see DelayClock.
+// f is still called if the context is done first.
+func DelayCtxFunc6Value[R, P0, P1, P2, P3, P4, P5 any](latency Latency, f powerfunc.CtxFunc6Value[R, P0, P1, P2, P3, P4, P5], opts ...DelayOption) powerfunc.CtxFunc6Value[R, P0, P1, P2, P3, P4, P5] {
+	d := newDelay(latency, opts)
+	return func(ctx context.Context, p0 P0, p1 P1, p2 P2, p3 P3, p4 P4, p5 P5) R {
+		_ = d.wait(ctx)
+		return f(ctx, p0, p1, p2, p3, p4, p5)
+	}
+}
+
+// GateCtxFunc6Value returns a CtxFunc6Value blocking until g lets the call through
+// before calling f.
+// f is still called if the context is done first.
+func GateCtxFunc6Value[R, P0, P1, P2, P3, P4, P5 any](g *Gate, f powerfunc.CtxFunc6Value[R, P0, P1, P2, P3, P4, P5]) powerfunc.CtxFunc6Value[R, P0, P1, P2, P3, P4, P5] {
+	return func(ctx context.Context, p0 P0, p1 P1, p2 P2, p3 P3, p4 P4, p5 P5) R {
+		_ = g.wait(ctx)
+		return f(ctx, p0, p1, p2, p3, p4, p5)
+	}
+}
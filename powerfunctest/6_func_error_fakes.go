@@ -0,0 +1,71 @@
+// Code generated by powerfunc/generator from fakes.go.tmpl. DO NOT EDIT.
+
+package powerfunctest
+
+import (
+	"context"
+
+	"github.com/jonathanmontane/powerfunc"
+)
+
+// StubFunc6Error returns a Func6Error returning the outcomes in order, one per
+// call. The last outcome is repeated once they are exhausted, and zero values
+// are returned if there are none.
+func StubFunc6Error[P0, P1, P2, P3, P4, P5 any](outcomes ...error) powerfunc.Func6Error[P0, P1, P2, P3, P4, P5] {
+	s := &script{}
+	return func(p0 P0, p1 P1, p2 P2, p3 P3, p4 P4, p5 P5) error {
+		var out error
+		if i := s.next(len(outcomes)); i >= 0 {
+			out = outcomes[i]
+		}
+		return out
+	}
+}
+
+// SpyFunc6Error returns a Func6Error recording the calls of f, along with the
+// Spy holding them.
+func SpyFunc6Error[P0, P1, P2, P3, P4, P5 any](f powerfunc.Func6Error[P0, P1, P2, P3, P4, P5]) (powerfunc.Func6Error[P0, P1, P2, P3, P4, P5], *Spy) {
+	s := &Spy{}
+	return func(p0 P0, p1 P1, p2 P2, p3 P3, p4 P4, p5 P5) error {
+		call := s.begin(context.Background(), p0, p1, p2, p3, p4, p5)
+		panicked := true
+		defer func() {
+			s.end(call, panicked)
+		}()
+		err := f(p0, p1, p2, p3, p4, p5)
+		panicked = false
+		s.fail(call, err)
+		return err
+	}, s
+}
+
+// FailNFunc6Error returns a Func6Error returning err for the first n calls,
+// then calling f.
+func FailNFunc6Error[P0, P1, P2, P3, P4, P5 any](n int, err error, f powerfunc.Func6Error[P0, P1, P2, P3, P4, P5]) powerfunc.Func6Error[P0, P1, P2, P3, P4, P5] {
+	fl := &failer{n: int64(n)}
+	return func(p0 P0, p1 P1, p2 P2, p3 P3, p4 P4, p5 P5) error {
+		if fl.fail() {
+			return err
+		}
+		return f(p0, p1, p2, p3, p4, p5)
+	}
+}
+
+// DelayFunc6Error returns a Func6Error waiting for latency before calling f.
+// The options set the clock measuring the latency, see DelayClock.
+func DelayFunc6Error[P0, P1, P2, P3, P4, P5 any](latency Latency, f powerfunc.Func6Error[P0, P1, P2, P3, P4, P5], opts ...DelayOption) powerfunc.Func6Error[P0, P1, P2, P3, P4, P5] {
+	d := newDelay(latency, opts)
+	return func(p0 P0, p1 P1, p2 P2, p3 P3, p4 P4, p5 P5) error {
+		_ = d.wait(context.Background())
+		return f(p0, p1, p2, p3, p4, p5)
+	}
+}
+
+// GateFunc6Error returns a Func6Error blocking until g lets the call through
+// before calling f.
+func GateFunc6Error[P0, P1, P2, P3, P4, P5 any](g *Gate, f powerfunc.Func6Error[P0, P1, P2, P3, P4, P5]) powerfunc.Func6Error[P0, P1, P2, P3, P4, P5] {
+	return func(p0 P0, p1 P1, p2 P2, p3 P3, p4 P4, p5 P5) error {
+		_ = g.wait(context.Background())
+		return f(p0, p1, p2, p3, p4, p5)
+	}
+}
@@ -0,0 +1,43 @@
+// Code generated by powerfunc/generator from fakes.go.tmpl. DO NOT EDIT.
+
+package powerfunctest
+
+import (
+	"context"
+
+	"github.com/jonathanmontane/powerfunc"
+)
+
+// SpyFunc6 returns a Func6 recording the calls of f, along with the
+// Spy holding them.
+func SpyFunc6[P0, P1, P2, P3, P4, P5 any](f powerfunc.Func6[P0, P1, P2, P3, P4, P5]) (powerfunc.Func6[P0, P1, P2, P3, P4, P5], *Spy) {
+	s := &Spy{}
+	return func(p0 P0, p1 P1, p2 P2, p3 P3, p4 P4, p5 P5) {
+		call := s.begin(context.Background(), p0, p1, p2, p3, p4, p5)
+		panicked := true
+		defer func() {
+			s.end(call, panicked)
+		}()
+		f(p0, p1, p2, p3, p4, p5)
+		panicked = false
+	}, s
+}
+
+// DelayFunc6 returns a Func6 waiting for latency before calling f.
+// The options set the clock measuring the latency, see DelayClock.
+func DelayFunc6[P0, P1, P2, P3, P4, P5 any](latency Latency, f powerfunc.Func6[P0, P1, P2, P3, P4, P5], opts ...DelayOption) powerfunc.Func6[P0, P1, P2, P3, P4, P5] {
+	d := newDelay(latency, opts)
+	return func(p0 P0, p1 P1, p2 P2, p3 P3, p4 P4, p5 P5) {
+		_ = d.wait(context.Background())
+		f(p0, p1, p2, p3, p4, p5)
+	}
+}
+
+// GateFunc6 returns a Func6 blocking until g lets the call through
+// before calling f.
+func GateFunc6[P0, P1, P2, P3, P4, P5 any](g *Gate, f powerfunc.Func6[P0, P1, P2, P3, P4, P5]) powerfunc.Func6[P0, P1, P2, P3, P4, P5] {
+	return func(p0 P0, p1 P1, p2 P2, p3 P3, p4 P4, p5 P5) {
+		_ = g.wait(context.Background())
+		f(p0, p1, p2, p3, p4, p5)
+	}
+}
@@ -0,0 +1,58 @@
+// Code generated by powerfunc/generator from fakes.go.tmpl. DO NOT EDIT.
+
+package powerfunctest
+
+import (
+	"context"
+
+	"github.com/jonathanmontane/powerfunc"
+)
+
+// StubFunc6Value returns a Func6Value returning the outcomes in order, one per
+// call. The last outcome is repeated once they are exhausted, and zero values
+// are returned if there are none.
+func StubFunc6Value[R, P0, P1, P2, P3, P4, P5 any](outcomes ...R) powerfunc.Func6Value[R, P0, P1, P2, P3, P4, P5] {
+	s := &script{}
+	return func(p0 P0, p1 P1, p2 P2, p3 P3, p4 P4, p5 P5) R {
+		var out R
+		if i := s.next(len(outcomes)); i >= 0 {
+			out = outcomes[i]
+		}
+		return out
+	}
+}
+
+// SpyFunc6Value returns a Func6Value recording the calls of f, along with the
+// Spy holding them.
+func SpyFunc6Value[R, P0, P1, P2, P3, P4, P5 any](f powerfunc.Func6Value[R, P0, P1, P2, P3, P4, P5]) (powerfunc.Func6Value[R, P0, P1, P2, P3, P4, P5], *Spy) {
+	s := &Spy{}
+	return func(p0 P0, p1 P1, p2 P2, p3 P3, p4 P4, p5 P5) R {
+		call := s.begin(context.Background(), p0, p1, p2, p3, p4, p5)
+		panicked := true
+		defer func() {
+			s.end(call, panicked)
+		}()
+		r0 := f(p0, p1, p2, p3, p4, p5)
+		panicked = false
+		return r0
+	}, s
+}
+
+// DelayFunc6Value returns a Func6Value waiting for latency before calling f.
+// The options set the clock measuring the latency, see DelayClock.
+func DelayFunc6Value[R, P0, P1, P2, P3, P4, P5 any](latency Latency, f powerfunc.Func6Value[R, P0, P1, P2, P3, P4, P5], opts ...DelayOption) powerfunc.Func6Value[R, P0, P1, P2, P3, P4, P5] {
+	d := newDelay(latency, opts)
+	return func(p0 P0, p1 P1, p2 P2, p3 P3, p4 P4, p5 P5) R {
+		_ = d.wait(context.Background())
+		return f(p0, p1, p2, p3, p4, p5)
+	}
+}
+
+// GateFunc6Value returns a Func6Value blocking until g lets the call through
+// before calling f.
+func GateFunc6Value[R, P0, P1, P2, P3, P4, P5 any](g *Gate, f powerfunc.Func6Value[R, P0, P1, P2, P3, P4, P5]) powerfunc.Func6Value[R, P0, P1, P2, P3, P4, P5] {
+	return func(p0 P0, p1 P1, p2 P2, p3 P3, p4 P4, p5 P5) R {
+		_ = g.wait(context.Background())
+		return f(p0, p1, p2, p3, p4, p5)
+	}
+}
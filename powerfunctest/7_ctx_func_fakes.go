@@ -0,0 +1,45 @@
+// Code generated by powerfunc/generator from fakes.go.tmpl. DO NOT EDIT.
+
+package powerfunctest
+
+import (
+	"context"
+
+	"github.com/jonathanmontane/powerfunc"
+)
+
+// SpyCtxFunc7 returns a CtxFunc7 recording the calls of f, along with the
+// Spy holding them.
+func SpyCtxFunc7[P0, P1, P2, P3, P4, P5, P6 any](f powerfunc.CtxFunc7[P0, P1, P2, P3, P4, P5, P6]) (powerfunc.CtxFunc7[P0, P1, P2, P3, P4, P5, P6], *Spy) {
+	s := &Spy{}
+	return func(ctx context.Context, p0 P0, p1 P1, p2 P2, p3 P3, p4 P4, p5 P5, p6 P6) {
+		call := s.begin(ctx, p0, p1, p2, p3, p4, p5, p6)
+		panicked := true
+		defer func() {
+			s.end(call, panicked)
+		}()
+		f(ctx, p0, p1, p2, p3, p4, p5, p6)
+		panicked = false
+	}, s
+}
+
+// DelayCtxFunc7 returns a CtxFunc7 waiting for latency before calling f.
+// The options set the clock measuring the latency, see DelayClock.
+// f is still called if the context is done first.
+func DelayCtxFunc7[P0, P1, P2, P3, P4, P5, P6 any](latency Latency, f powerfunc.CtxFunc7[P0, P1, P2, P3, P4, P5, P6], opts ...DelayOption) powerfunc.CtxFunc7[P0, P1, P2, P3, P4, P5, P6] {
+	d := newDelay(latency, opts)
+	return func(ctx context.Context, p0 P0, p1 P1, p2 P2, p3 P3, p4 P4, p5 P5, p6 P6) {
+		_ = d.wait(ctx)
+		f(ctx, p0, p1, p2, p3, p4, p5, p6)
+	}
+}
+
+// GateCtxFunc7 returns a CtxFunc7 blocking until g lets the call through
+// before calling f.
+// f is still called if the context is done first.
+func GateCtxFunc7[P0, P1, P2, P3, P4, P5, P6 any](g *Gate, f powerfunc.CtxFunc7[P0, P1, P2, P3, P4, P5, P6]) powerfunc.CtxFunc7[P0, P1, P2, P3, P4, P5, P6] {
+	return func(ctx context.Context, p0 P0, p1 P1, p2 P2, p3 P3, p4 P4, p5 P5, p6 P6) {
+		_ = g.wait(ctx)
+		f(ctx, p0, p1, p2, p3, p4, p5, p6)
+	}
+}
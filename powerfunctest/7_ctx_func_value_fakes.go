@@ -0,0 +1,60 @@
+// Code generated by powerfunc/generator from fakes.go.tmpl. DO NOT EDIT.
+
+package powerfunctest
+
+import (
+	"context"
+
+	"github.com/jonathanmontane/powerfunc"
+)
+
+// StubCtxFunc7Value returns a CtxFunc7Value returning the outcomes in order, one per
+// call. The last outcome is repeated once they are exhausted, and zero values
+// are returned if there are none.
+func StubCtxFunc7Value[R, P0, P1, P2, P3, P4, P5, P6 any](outcomes ...R) powerfunc.CtxFunc7Value[R, P0, P1, P2, P3, P4, P5, P6] {
+	s := &script{}
+	return func(ctx context.Context, p0 P0, p1 P1, p2 P2, p3 P3, p4 P4, p5 P5, p6 P6) R {
+		var out R
+		if i := s.next(len(outcomes)); i >= 0 {
+			out = outcomes[i]
+		}
+		return out
+	}
+}
+
+// SpyCtxFunc7Value returns a CtxFunc7Value recording the calls of f, along with the
+// Spy holding them.
+func SpyCtxFunc7Value[R, P0, P1, P2, P3, P4, P5, P6 any](f powerfunc.CtxFunc7Value[R, P0, P1, P2, P3, P4, P5, P6]) (powerfunc.CtxFunc7Value[R, P0, P1, P2, P3, P4, P5, P6], *Spy) {
+	s := &Spy{}
+	return func(ctx context.Context, p0 P0, p1 P1, p2 P2, p3 P3, p4 P4, p5 P5, p6 P6) R {
+		call := s.begin(ctx, p0, p1, p2, p3, p4, p5, p6)
+		panicked := true
+		defer func() {
+			s.end(call, panicked)
+		}()
+		r0 := f(ctx, p0, p1, p2, p3, p4, p5, p6)
+		panicked = false
+		return r0
+	}, s
+}
+
+// DelayCtxFunc7Value returns a CtxFunc7Value waiting for latency before calling f.
+// The options set the clock measuring the latency, see DelayClock.
+// f is still called if the context is done first.
+func DelayCtxFunc7Value[R, P0, P1, P2, P3, P4, P5, P6 any](latency Latency, f powerfunc.CtxFunc7Value[R, P0, P1, P2, P3, P4, P5, P6], opts ...DelayOption) powerfunc.CtxFunc7Value[R, P0, P1, P2, P3, P4, P5, P6] {
+	d := newDelay(latency, opts)
+	return func(ctx context.Context, p0 P0, p1 P1, p2 P2, p3 P3, p4 P4, p5 P5, p6 P6) R {
+		_ = d.wait(ctx)
+		return f(ctx, p0, p1, p2, p3, p4, p5, p6)
+	}
+}
+
+// GateCtxFunc7Value returns a CtxFunc7Value blocking until g lets the call through
+// before calling f.
+// f is still called if the context is done first.
+func GateCtxFunc7Value[R, P0, P1, P2, P3, P4, P5, P6 any](g *Gate, f powerfunc.CtxFunc7Value[R, P0, P1, P2, P3, P4, P5, P6]) powerfunc.CtxFunc7Value[R, P0, P1, P2, P3, P4, P5, P6] {
+	return func(ctx context.Context, p0 P0, p1 P1, p2 P2, p3 P3, p4 P4, p5 P5, p6 P6) R {
+		_ = g.wait(ctx)
+		return f(ctx, p0, p1, p2, p3, p4, p5, p6)
+	}
+}
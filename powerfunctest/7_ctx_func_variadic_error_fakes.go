@@ -0,0 +1,77 @@
+// Code generated by powerfunc/generator from fakes.go.tmpl. DO NOT EDIT.
+
+package powerfunctest
+
+import (
+	"context"
+
+	"github.com/jonathanmontane/powerfunc"
+)
+
+// StubCtxFunc7VariadicError returns a CtxFunc7VariadicError returning the outcomes in order, one per
+// call. The last outcome is repeated once they are exhausted, and zero values
+// are returned if there are none.
+func StubCtxFunc7VariadicError[V, P0, P1, P2, P3, P4, P5, P6 any](outcomes ...error) powerfunc.CtxFunc7VariadicError[V, P0, P1, P2, P3, P4, P5, P6] {
+	s := &script{}
+	return func(ctx context.Context, p0 P0, p1 P1, p2 P2, p3 P3, p4 P4, p5 P5, p6 P6, xs ...V) error {
+		var out error
+		if i := s.next(len(outcomes)); i >= 0 {
+			out = outcomes[i]
+		}
+		return out
+	}
+}
+
+// SpyCtxFunc7VariadicError returns a CtxFunc7VariadicError recording the calls of f, along with the
+// Spy holding them.
+func SpyCtxFunc7VariadicError[V, P0, P1, P2, P3, P4, P5, P6 any](f powerfunc.CtxFunc7VariadicError[V, P0, P1, P2, P3, P4, P5, P6]) (powerfunc.CtxFunc7VariadicError[V, P0, P1, P2, P3, P4, P5, P6], *Spy) {
+	s := &Spy{}
+	return func(ctx context.Context, p0 P0, p1 P1, p2 P2, p3 P3, p4 P4, p5 P5, p6 P6, xs ...V) error {
+		call := s.begin(ctx, p0, p1, p2, p3, p4, p5, p6, xs)
+		panicked := true
+		defer func() {
+			s.end(call, panicked)
+		}()
+		err := f(ctx, p0, p1, p2, p3, p4, p5, p6, xs...)
+		panicked = false
+		s.fail(call, err)
+		return err
+	}, s
+}
+
+// FailNCtxFunc7VariadicError returns a CtxFunc7VariadicError returning err for the first n calls,
+// then calling f.
+func FailNCtxFunc7VariadicError[V, P0, P1, P2, P3, P4, P5, P6 any](n int, err error, f powerfunc.CtxFunc7VariadicError[V, P0, P1, P2, P3, P4, P5, P6]) powerfunc.CtxFunc7VariadicError[V, P0, P1, P2, P3, P4, P5, P6] {
+	fl := &failer{n: int64(n)}
+	return func(ctx context.Context, p0 P0, p1 P1, p2 P2, p3 P3, p4 P4, p5 P5, p6 P6, xs ...V) error {
+		if fl.fail() {
+			return err
+		}
+		return f(ctx, p0, p1, p2, p3, p4, p5, p6, xs...)
+	}
+}
+
+// DelayCtxFunc7VariadicError returns a CtxFunc7VariadicError waiting for latency before calling f.
+// The options set the clock measuring the latency, see DelayClock.
+// The error of the context is returned if it is done first.
+func DelayCtxFunc7VariadicError[V, P0, P1, P2, P3, P4, P5, P6 any](latency Latency, f powerfunc.CtxFunc7VariadicError[V, P0, P1, P2, P3, P4, P5, P6], opts ...DelayOption) powerfunc.CtxFunc7VariadicError[V, P0, P1, P2, P3, P4, P5, P6] {
+	d := newDelay(latency, opts)
+	return func(ctx context.Context, p0 P0, p1 P1, p2 P2, p3 P3, p4 P4, p5 P5, p6 P6, xs ...V) error {
+		if err := d.wait(ctx); err != nil {
+			return err
+		}
+		return f(ctx, p0, p1, p2, p3, p4, p5, p6, xs...)
+	}
+}
+
+// GateCtxFunc7VariadicError returns a CtxFunc7VariadicError blocking until g lets the call through
+// before calling f.
+// The error of the context is returned if it is done first.
+func GateCtxFunc7VariadicError[V, P0, P1, P2, P3, P4, P5, P6 any](g *Gate, f powerfunc.CtxFunc7VariadicError[V, P0, P1, P2, P3, P4, P5, P6]) powerfunc.CtxFunc7VariadicError[V, P0, P1, P2, P3, P4, P5, P6] {
+	return func(ctx context.Context, p0 P0, p1 P1, p2 P2, p3 P3, p4 P4, p5 P5, p6 P6, xs ...V) error {
+		if err := g.wait(ctx); err != nil {
+			return err
+		}
+		return f(ctx, p0, p1, p2, p3, p4, p5, p6, xs...)
+	}
+}
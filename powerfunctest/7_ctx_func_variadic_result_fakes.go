@@ -0,0 +1,77 @@
+// Code generated by powerfunc/generator from fakes.go.tmpl. DO NOT EDIT.
+
+package powerfunctest
+
+import (
+	"context"
+
+	"github.com/jonathanmontane/powerfunc"
+)
+
+// StubCtxFunc7VariadicResult returns a CtxFunc7VariadicResult returning the outcomes in order, one per
+// call. The last outcome is repeated once they are exhausted, and zero values
+// are returned if there are none.
+func StubCtxFunc7VariadicResult[R, V, P0, P1, P2, P3, P4, P5, P6 any](outcomes ...Result[R]) powerfunc.CtxFunc7VariadicResult[R, V, P0, P1, P2, P3, P4, P5, P6] {
+	s := &script{}
+	return func(ctx context.Context, p0 P0, p1 P1, p2 P2, p3 P3, p4 P4, p5 P5, p6 P6, xs ...V) (R, error) {
+		var out Result[R]
+		if i := s.next(len(outcomes)); i >= 0 {
+			out = outcomes[i]
+		}
+		return out.Value, out.Err
+	}
+}
+
+// SpyCtxFunc7VariadicResult returns a CtxFunc7VariadicResult recording the calls of f, along with the
+// Spy holding them.
+func SpyCtxFunc7VariadicResult[R, V, P0, P1, P2, P3, P4, P5, P6 any](f powerfunc.CtxFunc7VariadicResult[R, V, P0, P1, P2, P3, P4, P5, P6]) (powerfunc.CtxFunc7VariadicResult[R, V, P0, P1, P2, P3, P4, P5, P6], *Spy) {
+	s := &Spy{}
+	return func(ctx context.Context, p0 P0, p1 P1, p2 P2, p3 P3, p4 P4, p5 P5, p6 P6, xs ...V) (R, error) {
+		call := s.begin(ctx, p0, p1, p2, p3, p4, p5, p6, xs)
+		panicked := true
+		defer func() {
+			s.end(call, panicked)
+		}()
+		r0, err := f(ctx, p0, p1, p2, p3, p4, p5, p6, xs...)
+		panicked = false
+		s.fail(call, err)
+		return r0, err
+	}, s
+}
+
+// FailNCtxFunc7VariadicResult returns a CtxFunc7VariadicResult returning err for the first n calls,
+// then calling f.
+func FailNCtxFunc7VariadicResult[R, V, P0, P1, P2, P3, P4, P5, P6 any](n int, err error, f powerfunc.CtxFunc7VariadicResult[R, V, P0, P1, P2, P3, P4, P5, P6]) powerfunc.CtxFunc7VariadicResult[R, V, P0, P1, P2, P3, P4, P5, P6] {
+	fl := &failer{n: int64(n)}
+	return func(ctx context.Context, p0 P0, p1 P1, p2 P2, p3 P3, p4 P4, p5 P5, p6 P6, xs ...V) (R, error) {
+		if fl.fail() {
+			return *new(R), err
+		}
+		return f(ctx, p0, p1, p2, p3, p4, p5, p6, xs...)
+	}
+}
+
+// DelayCtxFunc7VariadicResult returns a CtxFunc7VariadicResult waiting for latency before calling f.
+// The options set the clock measuring the latency, see DelayClock.
+// The error of the context is returned if it is done first.
+func DelayCtxFunc7VariadicResult[R, V, P0, P1, P2, P3, P4, P5, P6 any](latency Latency, f powerfunc.CtxFunc7VariadicResult[R, V, P0, P1, P2, P3, P4, P5, P6], opts ...DelayOption) powerfunc.CtxFunc7VariadicResult[R, V, P0, P1, P2, P3, P4, P5, P6] {
+	d := newDelay(latency, opts)
+	return func(ctx context.Context, p0 P0, p1 P1, p2 P2, p3 P3, p4 P4, p5 P5, p6 P6, xs ...V) (R, error) {
+		if err := d.wait(ctx); err != nil {
+			return *new(R), err
+		}
+		return f(ctx, p0, p1, p2, p3, p4, p5, p6, xs...)
+	}
+}
+
+// GateCtxFunc7VariadicResult returns a CtxFunc7VariadicResult blocking until g lets the call through
+// before calling f.
+// The error of the context is returned if it is done first.
+func GateCtxFunc7VariadicResult[R, V, P0, P1, P2, P3, P4, P5, P6 any](g *Gate, f powerfunc.CtxFunc7VariadicResult[R, V, P0, P1, P2, P3, P4, P5, P6]) powerfunc.CtxFunc7VariadicResult[R, V, P0, P1, P2, P3, P4, P5, P6] {
+	return func(ctx context.Context, p0 P0, p1 P1, p2 P2, p3 P3, p4 P4, p5 P5, p6 P6, xs ...V) (R, error) {
+		if err := g.wait(ctx); err != nil {
+			return *new(R), err
+		}
+		return f(ctx, p0, p1, p2, p3, p4, p5, p6, xs...)
+	}
+}
@@ -0,0 +1,43 @@
+// Code generated by powerfunc/generator from fakes.go.tmpl. DO NOT EDIT.
+
+package powerfunctest
+
+import (
+	"context"
+
+	"github.com/jonathanmontane/powerfunc"
+)
+
+// SpyFunc7 returns a Func7 recording the calls of f, along with the
+// Spy holding them.
+func SpyFunc7[P0, P1, P2, P3, P4, P5, P6 any](f powerfunc.Func7[P0, P1, P2, P3, P4, P5, P6]) (powerfunc.Func7[P0, P1, P2, P3, P4, P5, P6], *Spy) {
+	s := &Spy{}
+	return func(p0 P0, p1 P1, p2 P2, p3 P3, p4 P4, p5 P5, p6 P6) {
+		call := s.begin(context.Background(), p0, p1, p2, p3, p4, p5, p6)
+		panicked := true
+		defer func() {
+			s.end(call, panicked)
+		}()
+		f(p0, p1, p2, p3, p4, p5, p6)
+		panicked = false
+	}, s
+}
+
+// DelayFunc7 returns a Func7 waiting for latency before calling f.
+// The options set the clock measuring the latency, see DelayClock.
+func DelayFunc7[P0, P1, P2, P3, P4, P5, P6 any](latency Latency, f powerfunc.Func7[P0, P1, P2, P3, P4, P5, P6], opts ...DelayOption) powerfunc.Func7[P0, P1, P2, P3, P4, P5, P6] {
+	d := newDelay(latency, opts)
+	return func(p0 P0, p1 P1, p2 P2, p3 P3, p4 P4, p5 P5, p6 P6) {
+		_ = d.wait(context.Background())
+		f(p0, p1, p2, p3, p4, p5, p6)
+	}
+}
+
+// GateFunc7 returns a Func7 blocking until g lets the call through
+// before calling f.
+func GateFunc7[P0, P1, P2, P3, P4, P5, P6 any](g *Gate, f powerfunc.Func7[P0, P1, P2, P3, P4, P5, P6]) powerfunc.Func7[P0, P1, P2, P3, P4, P5, P6] {
+	return func(p0 P0, p1 P1, p2 P2, p3 P3, p4 P4, p5 P5, p6 P6) {
+		_ = g.wait(context.Background())
+		f(p0, p1, p2, p3, p4, p5, p6)
+	}
+}
@@ -0,0 +1,58 @@
+// Code generated by powerfunc/generator from fakes.go.tmpl. DO NOT EDIT.
+
+package powerfunctest
+
+import (
+	"context"
+
+	"github.com/jonathanmontane/powerfunc"
+)
+
+// StubFunc7Pair returns a Func7Pair returning the outcomes in order, one per
+// call. The last outcome is repeated once they are exhausted, and zero values
+// are returned if there are none.
+func StubFunc7Pair[R1, R2, P0, P1, P2, P3, P4, P5, P6 any](outcomes ...Pair[R1, R2]) powerfunc.Func7Pair[R1, R2, P0, P1, P2, P3, P4, P5, P6] {
+	s := &script{}
+	return func(p0 P0, p1 P1, p2 P2, p3 P3, p4 P4, p5 P5, p6 P6) (R1, R2) {
+		var out Pair[R1, R2]
+		if i := s.next(len(outcomes)); i >= 0 {
+			out = outcomes[i]
+		}
+		return out.First, out.Second
+	}
+}
+
+// SpyFunc7Pair returns a Func7Pair recording the calls of f, along with the
+// Spy holding them.
+func SpyFunc7Pair[R1, R2, P0, P1, P2, P3, P4, P5, P6 any](f powerfunc.Func7Pair[R1, R2, P0, P1, P2, P3, P4, P5, P6]) (powerfunc.Func7Pair[R1, R2, P0, P1, P2, P3, P4, P5, P6], *Spy) {
+	s := &Spy{}
+	return func(p0 P0, p1 P1, p2 P2, p3 P3, p4 P4, p5 P5, p6 P6) (R1, R2) {
+		call := s.begin(context.Background(), p0, p1, p2, p3, p4, p5, p6)
+		panicked := true
+		defer func() {
+			s.end(call, panicked)
+		}()
+		r0, r1 := f(p0, p1, p2, p3, p4, p5, p6)
+		panicked = false
+		return r0, r1
+	}, s
+}
+
+// DelayFunc7Pair returns a Func7Pair waiting for latency before calling f.
+// The options set the clock measuring the latency, see DelayClock.
+func DelayFunc7Pair[R1, R2, P0, P1, P2, P3, P4, P5, P6 any](latency Latency, f powerfunc.Func7Pair[R1, R2, P0, P1, P2, P3, P4, P5, P6], opts ...DelayOption) powerfunc.Func7Pair[R1, R2, P0, P1, P2, P3, P4, P5, P6] {
+	d := newDelay(latency, opts)
+	return func(p0 P0, p1 P1, p2 P2, p3 P3, p4 P4, p5 P5, p6 P6) (R1, R2) {
+		_ = d.wait(context.Background())
+		return f(p0, p1, p2, p3, p4, p5, p6)
+	}
+}
+
+// GateFunc7Pair returns a Func7Pair blocking until g lets the call through
+// before calling f.
+func GateFunc7Pair[R1, R2, P0, P1, P2, P3, P4, P5, P6 any](g *Gate, f powerfunc.Func7Pair[R1, R2, P0, P1, P2, P3, P4, P5, P6]) powerfunc.Func7Pair[R1, R2, P0, P1, P2, P3, P4, P5, P6] {
+	return func(p0 P0, p1 P1, p2 P2, p3 P3, p4 P4, p5 P5, p6 P6) (R1, R2) {
+		_ = g.wait(context.Background())
+		return f(p0, p1, p2, p3, p4, p5, p6)
+	}
+}
@@ -0,0 +1,58 @@
+// Code generated by powerfunc/generator from fakes.go.tmpl. DO NOT EDIT.
+
+package powerfunctest
+
+import (
+	"context"
+
+	"github.com/jonathanmontane/powerfunc"
+)
+
+// StubFunc7Value returns a Func7Value returning the outcomes in order, one per
+// call. The last outcome is repeated once they are exhausted, and zero values
+// are returned if there are none.
+func StubFunc7Value[R, P0, P1, P2, P3, P4, P5, P6 any](outcomes ...R) powerfunc.Func7Value[R, P0, P1, P2, P3, P4, P5, P6] {
+	s := &script{}
+	return func(p0 P0, p1 P1, p2 P2, p3 P3, p4 P4, p5 P5, p6 P6) R {
+		var out R
+		if i := s.next(len(outcomes)); i >= 0 {
+			out = outcomes[i]
+		}
+		return out
+	}
+}
+
+// SpyFunc7Value returns a Func7Value recording the calls of f, along with the
+// Spy holding them.
+func SpyFunc7Value[R, P0, P1, P2, P3, P4, P5, P6 any](f powerfunc.Func7Value[R, P0, P1, P2, P3, P4, P5, P6]) (powerfunc.Func7Value[R, P0, P1, P2, P3, P4, P5, P6], *Spy) {
+	s := &Spy{}
+	return func(p0 P0, p1 P1, p2 P2, p3 P3, p4 P4, p5 P5, p6 P6) R {
+		call := s.begin(context.Background(), p0, p1, p2, p3, p4, p5, p6)
+		panicked := true
+		defer func() {
+			s.end(call, panicked)
+		}()
+		r0 := f(p0, p1, p2, p3, p4, p5, p6)
+		panicked = false
+		return r0
+	}, s
+}
+
+// DelayFunc7Value returns a Func7Value waiting for latency before calling f.
+// The options set the clock measuring the latency, see DelayClock.
+func DelayFunc7Value[R, P0, P1, P2, P3, P4, P5, P6 any](latency Latency, f powerfunc.Func7Value[R, P0, P1, P2, P3, P4, P5, P6], opts ...DelayOption) powerfunc.Func7Value[R, P0, P1, P2, P3, P4, P5, P6] {
+	d := newDelay(latency, opts)
+	return func(p0 P0, p1 P1, p2 P2, p3 P3, p4 P4, p5 P5, p6 P6) R {
+		_ = d.wait(context.Background())
+		return f(p0, p1, p2, p3, p4, p5, p6)
+	}
+}
+
+// GateFunc7Value returns a Func7Value blocking until g lets the call through
+// before calling f.
+func GateFunc7Value[R, P0, P1, P2, P3, P4, P5, P6 any](g *Gate, f powerfunc.Func7Value[R, P0, P1, P2, P3, P4, P5, P6]) powerfunc.Func7Value[R, P0, P1, P2, P3, P4, P5, P6] {
+	return func(p0 P0, p1 P1, p2 P2, p3 P3, p4 P4, p5 P5, p6 P6) R {
+		_ = g.wait(context.Background())
+		return f(p0, p1, p2, p3, p4, p5, p6)
+	}
+}
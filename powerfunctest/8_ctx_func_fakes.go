@@ -0,0 +1,45 @@
+// Code generated by powerfunc/generator from fakes.go.tmpl. DO NOT EDIT.
+
+package powerfunctest
+
+import (
+	"context"
+
+	"github.com/jonathanmontane/powerfunc"
+)
+
+// SpyCtxFunc8 returns a CtxFunc8 recording the calls of f, along with the
+// Spy holding them.
+func SpyCtxFunc8[P0, P1, P2, P3, P4, P5, P6, P7 any](f powerfunc.CtxFunc8[P0, P1, P2, P3, P4, P5, P6, P7]) (powerfunc.CtxFunc8[P0, P1, P2, P3, P4, P5, P6, P7], *Spy) {
+	s := &Spy{}
+	return func(ctx context.Context, p0 P0, p1 P1, p2 P2, p3 P3, p4 P4, p5 P5, p6 P6, p7 P7) {
+		call := s.begin(ctx, p0, p1, p2, p3, p4, p5, p6, p7)
+		panicked := true
+		defer func() {
+			s.end(call, panicked)
+		}()
+		f(ctx, p0, p1, p2, p3, p4, p5, p6, p7)
+		panicked = false
+	}, s
+}
+
+// DelayCtxFunc8 returns a CtxFunc8 waiting for latency before calling f.
+// The options set the clock measuring the latency, see DelayClock.
+// f is still called if the context is done first.
+func DelayCtxFunc8[P0, P1, P2, P3, P4, P5, P6, P7 any](latency Latency, f powerfunc.CtxFunc8[P0, P1, P2, P3, P4, P5, P6, P7], opts ...DelayOption) powerfunc.CtxFunc8[P0, P1, P2, P3, P4, P5, P6, P7] {
+	d := newDelay(latency, opts)
+	return func(ctx context.Context, p0 P0, p1 P1, p2 P2, p3 P3, p4 P4, p5 P5, p6 P6, p7 P7) {
+		_ = d.wait(ctx)
+		f(ctx, p0, p1, p2, p3, p4, p5, p6, p7)
+	}
+}
+
+// GateCtxFunc8 returns a CtxFunc8 blocking until g lets the call through
+// before calling f.
+// f is still called if the context is done first.
+func GateCtxFunc8[P0, P1, P2, P3, P4, P5, P6, P7 any](g *Gate, f powerfunc.CtxFunc8[P0, P1, P2, P3, P4, P5, P6, P7]) powerfunc.CtxFunc8[P0, P1, P2, P3, P4, P5, P6, P7] {
+	return func(ctx context.Context, p0 P0, p1 P1, p2 P2, p3 P3, p4 P4, p5 P5, p6 P6, p7 P7) {
+		_ = g.wait(ctx)
+		f(ctx, p0, p1, p2, p3, p4, p5, p6, p7)
+	}
+}
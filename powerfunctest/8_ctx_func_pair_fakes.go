@@ -0,0 +1,60 @@
+// Code generated by powerfunc/generator from fakes.go.tmpl. DO NOT EDIT.
+
+package powerfunctest
+
+import (
+	"context"
+
+	"github.com/jonathanmontane/powerfunc"
+)
+
+// StubCtxFunc8Pair returns a CtxFunc8Pair returning the outcomes in order, one per
+// call. The last outcome is repeated once they are exhausted, and zero values
+// are returned if there are none.
+func StubCtxFunc8Pair[R1, R2, P0, P1, P2, P3, P4, P5, P6, P7 any](outcomes ...Pair[R1, R2]) powerfunc.CtxFunc8Pair[R1, R2, P0, P1, P2, P3, P4, P5, P6, P7] {
+	s := &script{}
+	return func(ctx context.Context, p0 P0, p1 P1, p2 P2, p3 P3, p4 P4, p5 P5, p6 P6, p7 P7) (R1, R2) {
+		var out Pair[R1, R2]
+		if i := s.next(len(outcomes)); i >= 0 {
+			out = outcomes[i]
+		}
+		return out.First, out.Second
+	}
+}
+
+// SpyCtxFunc8Pair returns a CtxFunc8Pair recording the calls of f, along with the
+// Spy holding them.
+func SpyCtxFunc8Pair[R1, R2, P0, P1, P2, P3, P4, P5, P6, P7 any](f powerfunc.CtxFunc8Pair[R1, R2, P0, P1, P2, P3, P4, P5, P6, P7]) (powerfunc.CtxFunc8Pair[R1, R2, P0, P1, P2, P3, P4, P5, P6, P7], *Spy) {
+	s := &Spy{}
+	return func(ctx context.Context, p0 P0, p1 P1, p2 P2, p3 P3, p4 P4, p5 P5, p6 P6, p7 P7) (R1, R2) {
+		call := s.begin(ctx, p0, p1, p2, p3, p4, p5, p6, p7)
+		panicked := true
+		defer func() {
+			s.end(call, panicked)
+		}()
+		r0, r1 := f(ctx, p0, p1, p2, p3, p4, p5, p6, p7)
+		panicked = false
+		return r0, r1
+	}, s
+}
+
+// DelayCtxFunc8Pair returns a CtxFunc8Pair waiting for latency before calling f.
+// The options set the clock measuring the latency, see DelayClock.
+// f is still called if the context is done first.
+func DelayCtxFunc8Pair[R1, R2, P0, P1, P2, P3, P4, P5, P6, P7 any](latency Latency, f powerfunc.CtxFunc8Pair[R1, R2, P0, P1, P2, P3, P4, P5, P6, P7], opts ...DelayOption) powerfunc.CtxFunc8Pair[R1, R2, P0, P1, P2, P3, P4, P5, P6, P7] {
+	d := newDelay(latency, opts)
+	return func(ctx context.Context, p0 P0, p1 P1, p2 P2, p3 P3, p4 P4, p5 P5, p6 P6, p7 P7) (R1, R2) {
+		_ = d.wait(ctx)
+		return f(ctx, p0, p1, p2, p3, p4, p5, p6, p7)
+	}
+}
+
+// GateCtxFunc8Pair returns a CtxFunc8Pair blocking until g lets the call through
+// before calling f.
+// f is still called if the context is done first.
+func GateCtxFunc8Pair[R1, R2, P0, P1, P2, P3, P4, P5, P6, P7 any](g *Gate, f powerfunc.CtxFunc8Pair[R1, R2, P0, P1, P2, P3, P4, P5, P6, P7]) powerfunc.CtxFunc8Pair[R1, R2, P0, P1, P2, P3, P4, P5, P6, P7] {
+	return func(ctx context.Context, p0 P0, p1 P1, p2 P2, p3 P3, p4 P4, p5 P5, p6 P6, p7 P7) (R1, R2) {
+		_ = g.wait(ctx)
+		return f(ctx, p0, p1, p2, p3, p4, p5, p6, p7)
+	}
+}
@@ -0,0 +1,77 @@
+// Code generated by powerfunc/generator from fakes.go.tmpl. DO NOT EDIT.
+
+package powerfunctest
+
+import (
+	"context"
+
+	"github.com/jonathanmontane/powerfunc"
+)
+
+// StubCtxFunc8VariadicError returns a CtxFunc8VariadicError returning the outcomes in order, one per
+// call. The last outcome is repeated once they are exhausted, and zero values
+// are returned if there are none.
+func StubCtxFunc8VariadicError[V, P0, P1, P2, P3, P4, P5, P6, P7 any](outcomes ...error) powerfunc.CtxFunc8VariadicError[V, P0, P1, P2, P3, P4, P5, P6, P7] {
+	s := &script{}
+	return func(ctx context.Context, p0 P0, p1 P1, p2 P2, p3 P3, p4 P4, p5 P5, p6 P6, p7 P7, xs ...V) error {
+		var out error
+		if i := s.next(len(outcomes)); i >= 0 {
+			out = outcomes[i]
+		}
+		return out
+	}
+}
+
+// SpyCtxFunc8VariadicError returns a CtxFunc8VariadicError recording the calls of f, along with the
+// Spy holding them.
+func SpyCtxFunc8VariadicError[V, P0, P1, P2, P3, P4, P5, P6, P7 any](f powerfunc.CtxFunc8VariadicError[V, P0, P1, P2, P3, P4, P5, P6, P7]) (powerfunc.CtxFunc8VariadicError[V, P0, P1, P2, P3, P4, P5, P6, P7], *Spy) {
+	s := &Spy{}
+	return func(ctx context.Context, p0 P0, p1 P1, p2 P2, p3 P3, p4 P4, p5 P5, p6 P6, p7 P7, xs ...V) error {
+		call := s.begin(ctx, p0, p1, p2, p3, p4, p5, p6, p7, xs)
+		panicked := true
+		defer func() {
+			s.end(call, panicked)
+		}()
+		err := f(ctx, p0, p1, p2, p3, p4, p5, p6, p7, xs...)
+		panicked = false
+		s.fail(call, err)
+		return err
+	}, s
+}
+
+// FailNCtxFunc8VariadicError returns a CtxFunc8VariadicError returning err for the first n calls,
+// then calling f.
+func FailNCtxFunc8VariadicError[V, P0, P1, P2, P3, P4, P5, P6, P7 any](n int, err error, f powerfunc.CtxFunc8VariadicError[V, P0, P1, P2, P3, P4, P5, P6, P7]) powerfunc.CtxFunc8VariadicError[V, P0, P1, P2, P3, P4, P5, P6, P7] {
+	fl := &failer{n: int64(n)}
+	return func(ctx context.Context, p0 P0, p1 P1, p2 P2, p3 P3, p4 P4, p5 P5, p6 P6, p7 P7, xs ...V) error {
+		if fl.fail() {
+			return err
+		}
+		return f(ctx, p0, p1, p2, p3, p4, p5, p6, p7, xs...)
+	}
+}
+
+// DelayCtxFunc8VariadicError returns a CtxFunc8VariadicError waiting for latency before calling f.
+// The options set the clock measuring the latency, see DelayClock.
+// The error of the context is returned if it is done first.
+func DelayCtxFunc8VariadicError[V, P0, P1, P2, P3, P4, P5, P6, P7 any](latency Latency, f powerfunc.CtxFunc8VariadicError[V, P0, P1, P2, P3, P4, P5, P6, P7], opts ...DelayOption) powerfunc.CtxFunc8VariadicError[V, P0, P1, P2, P3, P4, P5, P6, P7] {
+	d := newDelay(latency, opts)
+	return func(ctx context.Context, p0 P0, p1 P1, p2 P2, p3 P3, p4 P4, p5 P5, p6 P6, p7 P7, xs ...V) error {
+		if err := d.wait(ctx); err != nil {
+			return err
+		}
+		return f(ctx, p0, p1, p2, p3, p4, p5, p6, p7, xs...)
+	}
+}
+
+// GateCtxFunc8VariadicError returns a CtxFunc8VariadicError blocking until g lets the call through
+// before calling f.
+// The error of the context is returned if it is done first.
+func GateCtxFunc8VariadicError[V, P0, P1, P2, P3, P4, P5, P6, P7 any](g *Gate, f powerfunc.CtxFunc8VariadicError[V, P0, P1, P2, P3, P4, P5, P6, P7]) powerfunc.CtxFunc8VariadicError[V, P0, P1, P2, P3, P4, P5, P6, P7] {
+	return func(ctx context.Context, p0 P0, p1 P1, p2 P2, p3 P3, p4 P4, p5 P5, p6 P6, p7 P7, xs ...V) error {
+		if err := g.wait(ctx); err != nil {
+			return err
+		}
+		return f(ctx, p0, p1, p2, p3, p4, p5, p6, p7, xs...)
+	}
+}
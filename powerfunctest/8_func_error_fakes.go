@@ -0,0 +1,71 @@
+// Code generated by powerfunc/generator from fakes.go.tmpl. DO NOT EDIT.
+
+package powerfunctest
+
+import (
+	"context"
+
+	"github.com/jonathanmontane/powerfunc"
+)
+
+// StubFunc8Error returns a Func8Error returning the outcomes in order, one per
+// call. The last outcome is repeated once they are exhausted, and zero values
+// are returned if there are none.
+func StubFunc8Error[P0, P1, P2, P3, P4, P5, P6, P7 any](outcomes ...error) powerfunc.Func8Error[P0, P1, P2, P3, P4, P5, P6, P7] {
+	s := &script{}
+	return func(p0 P0, p1 P1, p2 P2, p3 P3, p4 P4, p5 P5, p6 P6, p7 P7) error {
+		var out error
+		if i := s.next(len(outcomes)); i >= 0 {
+			out = outcomes[i]
+		}
+		return out
+	}
+}
+
+// SpyFunc8Error returns a Func8Error recording the calls of f, along with the
+// Spy holding them.
+func SpyFunc8Error[P0, P1, P2, P3, P4, P5, P6, P7 any](f powerfunc.Func8Error[P0, P1, P2, P3, P4, P5, P6, P7]) (powerfunc.Func8Error[P0, P1, P2, P3, P4, P5, P6, P7], *Spy) {
+	s := &Spy{}
+	return func(p0 P0, p1 P1, p2 P2, p3 P3, p4 P4, p5 P5, p6 P6, p7 P7) error {
+		call := s.begin(context.Background(), p0, p1, p2, p3, p4, p5, p6, p7)
+		panicked := true
+		defer func() {
+			s.end(call, panicked)
+		}()
+		err := f(p0, p1, p2, p3, p4, p5, p6, p7)
+		panicked = false
+		s.fail(call, err)
+		return err
+	}, s
+}
+
+// FailNFunc8Error returns a Func8Error returning err for the first n calls,
+// then calling f.
+func FailNFunc8Error[P0, P1, P2, P3, P4, P5, P6, P7 any](n int, err error, f powerfunc.Func8Error[P0, P1, P2, P3, P4, P5, P6, P7]) powerfunc.Func8Error[P0, P1, P2, P3, P4, P5, P6, P7] {
+	fl := &failer{n: int64(n)}
+	return func(p0 P0, p1 P1, p2 P2, p3 P3, p4 P4, p5 P5, p6 P6, p7 P7) error {
+		if fl.fail() {
+			return err
+		}
+		return f(p0, p1, p2, p3, p4, p5, p6, p7)
+	}
+}
+
+// DelayFunc8Error returns a Func8Error waiting for latency before calling f.
+// The options set the clock measuring the latency, see DelayClock.
+func DelayFunc8Error[P0, P1, P2, P3, P4, P5, P6, P7 any](latency Latency, f powerfunc.Func8Error[P0, P1, P2, P3, P4, P5, P6, P7], opts ...DelayOption) powerfunc.Func8Error[P0, P1, P2, P3, P4, P5, P6, P7] {
+	d := newDelay(latency, opts)
+	return func(p0 P0, p1 P1, p2 P2, p3 P3, p4 P4, p5 P5, p6 P6, p7 P7) error {
+		_ = d.wait(context.Background())
+		return f(p0, p1, p2, p3, p4, p5, p6, p7)
+	}
+}
+
+// GateFunc8Error returns a Func8Error blocking until g lets the call through
+// before calling f.
+func GateFunc8Error[P0, P1, P2, P3, P4, P5, P6, P7 any](g *Gate, f powerfunc.Func8Error[P0, P1, P2, P3, P4, P5, P6, P7]) powerfunc.Func8Error[P0, P1, P2, P3, P4, P5, P6, P7] {
+	return func(p0 P0, p1 P1, p2 P2, p3 P3, p4 P4, p5 P5, p6 P6, p7 P7) error {
+		_ = g.wait(context.Background())
+		return f(p0, p1, p2, p3, p4, p5, p6, p7)
+	}
+}
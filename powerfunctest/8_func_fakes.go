@@ -0,0 +1,43 @@
+// Code generated by powerfunc/generator from fakes.go.tmpl. DO NOT EDIT.
+
+package powerfunctest
+
+import (
+	"context"
+
+	"github.com/jonathanmontane/powerfunc"
+)
+
+// SpyFunc8 returns a Func8 recording the calls of f, along with the
+// Spy holding them.
+func SpyFunc8[P0, P1, P2, P3, P4, P5, P6, P7 any](f powerfunc.Func8[P0, P1, P2, P3, P4, P5, P6, P7]) (powerfunc.Func8[P0, P1, P2, P3, P4, P5, P6, P7], *Spy) {
+	s := &Spy{}
+	return func(p0 P0, p1 P1, p2 P2, p3 P3, p4 P4, p5 P5, p6 P6, p7 P7) {
+		call := s.begin(context.Background(), p0, p1, p2, p3, p4, p5, p6, p7)
+		panicked := true
+		defer func() {
+			s.end(call, panicked)
+		}()
+		f(p0, p1, p2, p3, p4, p5, p6, p7)
+		panicked = false
+	}, s
+}
+
+// DelayFunc8 returns a Func8 waiting for latency before calling f.
+// The options set the clock measuring the latency, see DelayClock.
+func DelayFunc8[P0, P1, P2, P3, P4, P5, P6, P7 any](latency Latency, f powerfunc.Func8[P0, P1, P2, P3, P4, P5, P6, P7], opts ...DelayOption) powerfunc.Func8[P0, P1, P2, P3, P4, P5, P6, P7] {
+	d := newDelay(latency, opts)
+	return func(p0 P0, p1 P1, p2 P2, p3 P3, p4 P4, p5 P5, p6 P6, p7 P7) {
+		_ = d.wait(context.Background())
+		f(p0, p1, p2, p3, p4, p5, p6, p7)
+	}
+}
+
+// GateFunc8 returns a Func8 blocking until g lets the call through
+// before calling f.
+func GateFunc8[P0, P1, P2, P3, P4, P5, P6, P7 any](g *Gate, f powerfunc.Func8[P0, P1, P2, P3, P4, P5, P6, P7]) powerfunc.Func8[P0, P1, P2, P3, P4, P5, P6, P7] {
+	return func(p0 P0, p1 P1, p2 P2, p3 P3, p4 P4, p5 P5, p6 P6, p7 P7) {
+		_ = g.wait(context.Background())
+		f(p0, p1, p2, p3, p4, p5, p6, p7)
+	}
+}
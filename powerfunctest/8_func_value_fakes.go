@@ -0,0 +1,58 @@
+// Code generated by powerfunc/generator from fakes.go.tmpl. DO NOT EDIT.
+
+package powerfunctest
+
+import (
+	"context"
+
+	"github.com/jonathanmontane/powerfunc"
+)
+
+// StubFunc8Value returns a Func8Value returning the outcomes in order, one per
+// call. The last outcome is repeated once they are exhausted, and zero values
+// are returned if there are none.
+func StubFunc8Value[R, P0, P1, P2, P3, P4, P5, P6, P7 any](outcomes ...R) powerfunc.Func8Value[R, P0, P1, P2, P3, P4, P5, P6, P7] {
+	s := &script{}
+	return func(p0 P0, p1 P1, p2 P2, p3 P3, p4 P4, p5 P5, p6 P6, p7 P7) R {
+		var out R
+		if i := s.next(len(outcomes)); i >= 0 {
+			out = outcomes[i]
+		}
+		return out
+	}
+}
+
+// SpyFunc8Value returns a Func8Value recording the calls of f, along with the
+// Spy holding them.
+func SpyFunc8Value[R, P0, P1, P2, P3, P4, P5, P6, P7 any](f powerfunc.Func8Value[R, P0, P1, P2, P3, P4, P5, P6, P7]) (powerfunc.Func8Value[R, P0, P1, P2, P3, P4, P5, P6, P7], *Spy) {
+	s := &Spy{}
+	return func(p0 P0, p1 P1, p2 P2, p3 P3, p4 P4, p5 P5, p6 P6, p7 P7) R {
+		call := s.begin(context.Background(), p0, p1, p2, p3, p4, p5, p6, p7)
+		panicked := true
+		defer func() {
+			s.end(call, panicked)
+		}()
+		r0 := f(p0, p1, p2, p3, p4, p5, p6, p7)
+		panicked = false
+		return r0
+	}, s
+}
+
+// DelayFunc8Value returns a Func8Value waiting for latency before calling f.
+// The options set the clock measuring the latency, see DelayClock.
+func DelayFunc8Value[R, P0, P1, P2, P3, P4, P5, P6, P7 any](latency Latency, f powerfunc.Func8Value[R, P0, P1, P2, P3, P4, P5, P6, P7], opts ...DelayOption) powerfunc.Func8Value[R, P0, P1, P2, P3, P4, P5, P6, P7] {
+	d := newDelay(latency, opts)
+	return func(p0 P0, p1 P1, p2 P2, p3 P3, p4 P4, p5 P5, p6 P6, p7 P7) R {
+		_ = d.wait(context.Background())
+		return f(p0, p1, p2, p3, p4, p5, p6, p7)
+	}
+}
+
+// GateFunc8Value returns a Func8Value blocking until g lets the call through
+// before calling f.
+func GateFunc8Value[R, P0, P1, P2, P3, P4, P5, P6, P7 any](g *Gate, f powerfunc.Func8Value[R, P0, P1, P2, P3, P4, P5, P6, P7]) powerfunc.Func8Value[R, P0, P1, P2, P3, P4, P5, P6, P7] {
+	return func(p0 P0, p1 P1, p2 P2, p3 P3, p4 P4, p5 P5, p6 P6, p7 P7) R {
+		_ = g.wait(context.Background())
+		return f(p0, p1, p2, p3, p4, p5, p6, p7)
+	}
+}
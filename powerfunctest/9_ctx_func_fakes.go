@@ -0,0 +1,45 @@
+// Code generated by powerfunc/generator from fakes.go.tmpl. DO NOT EDIT.
+
+package powerfunctest
+
+import (
+	"context"
+
+	"github.com/jonathanmontane/powerfunc"
+)
+
+// SpyCtxFunc9 returns a CtxFunc9 recording the calls of f, along with the
+// Spy holding them.
+func SpyCtxFunc9[P0, P1, P2, P3, P4, P5, P6, P7, P8 any](f powerfunc.CtxFunc9[P0, P1, P2, P3, P4, P5, P6, P7, P8]) (powerfunc.CtxFunc9[P0, P1, P2, P3, P4, P5, P6, P7, P8], *Spy) {
+	s := &Spy{}
+	return func(ctx context.Context, p0 P0, p1 P1, p2 P2, p3 P3, p4 P4, p5 P5, p6 P6, p7 P7, p8 P8) {
+		call := s.begin(ctx, p0, p1, p2, p3, p4, p5, p6, p7, p8)
+		panicked := true
+		defer func() {
+			s.end(call, panicked)
+		}()
+		f(ctx, p0, p1, p2, p3, p4, p5, p6, p7, p8)
+		panicked = false
+	}, s
+}
+
+// DelayCtxFunc9 returns a CtxFunc9 waiting for latency before calling f.
+// The options set the clock measuring the latency, see DelayClock.
+// f is still called if the context is done first.
+func DelayCtxFunc9[P0, P1, P2, P3, P4, P5, P6, P7, P8 any](latency Latency, f powerfunc.CtxFunc9[P0, P1, P2, P3, P4, P5, P6, P7, P8], opts ...DelayOption) powerfunc.CtxFunc9[P0, P1, P2, P3, P4, P5, P6, P7, P8] {
+	d := newDelay(latency, opts)
+	return func(ctx context.Context, p0 P0, p1 P1, p2 P2, p3 P3, p4 P4, p5 P5, p6 P6, p7 P7, p8 P8) {
+		_ = d.wait(ctx)
+		f(ctx, p0, p1, p2, p3, p4, p5, p6, p7, p8)
+	}
+}
+
+// GateCtxFunc9 returns a CtxFunc9 blocking until g lets the call through
+// before calling f.
+// f is still called if the context is done first.
+func GateCtxFunc9[P0, P1, P2, P3, P4, P5, P6, P7, P8 any](g *Gate, f powerfunc.CtxFunc9[P0, P1, P2, P3, P4, P5, P6, P7, P8]) powerfunc.CtxFunc9[P0, P1, P2, P3, P4, P5, P6, P7, P8] {
+	return func(ctx context.Context, p0 P0, p1 P1, p2 P2, p3 P3, p4 P4, p5 P5, p6 P6, p7 P7, p8 P8) {
+		_ = g.wait(ctx)
+		f(ctx, p0, p1, p2, p3, p4, p5, p6, p7, p8)
+	}
+}
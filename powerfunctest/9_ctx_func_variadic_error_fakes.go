@@ -0,0 +1,77 @@
+// Code generated by powerfunc/generator from fakes.go.tmpl. DO NOT EDIT.
+
+package powerfunctest
+
+import (
+	"context"
+
+	"github.com/jonathanmontane/powerfunc"
+)
+
+// StubCtxFunc9VariadicError returns a CtxFunc9VariadicError returning the outcomes in order, one per
+// call. The last outcome is repeated once they are exhausted, and zero values
+// are returned if there are none.
+func StubCtxFunc9VariadicError[V, P0, P1, P2, P3, P4, P5, P6, P7, P8 any](outcomes ...error) powerfunc.CtxFunc9VariadicError[V, P0, P1, P2, P3, P4, P5, P6, P7, P8] {
+	s := &script{}
+	return func(ctx context.Context, p0 P0, p1 P1, p2 P2, p3 P3, p4 P4, p5 P5, p6 P6, p7 P7, p8 P8, xs ...V) error {
+		var out error
+		if i := s.next(len(outcomes)); i >= 0 {
+			out = outcomes[i]
+		}
+		return out
+	}
+}
+
+// SpyCtxFunc9VariadicError returns a CtxFunc9VariadicError recording the calls of f, along with the
+// Spy holding them.
+func SpyCtxFunc9VariadicError[V, P0, P1, P2, P3, P4, P5, P6, P7, P8 any](f powerfunc.CtxFunc9VariadicError[V, P0, P1, P2, P3, P4, P5, P6, P7, P8]) (powerfunc.CtxFunc9VariadicError[V, P0, P1, P2, P3, P4, P5, P6, P7, P8], *Spy) {
+	s := &Spy{}
+	return func(ctx context.Context, p0 P0, p1 P1, p2 P2, p3 P3, p4 P4, p5 P5, p6 P6, p7 P7, p8 P8, xs ...V) error {
+		call := s.begin(ctx, p0, p1, p2, p3, p4, p5, p6, p7, p8, xs)
+		panicked := true
+		defer func() {
+			s.end(call, panicked)
+		}()
+		err := f(ctx, p0, p1, p2, p3, p4, p5, p6, p7, p8, xs...)
+		panicked = false
+		s.fail(call, err)
+		return err
+	}, s
+}
+
+// FailNCtxFunc9VariadicError returns a CtxFunc9VariadicError returning err for the first n calls,
+// then calling f.
+func FailNCtxFunc9VariadicError[V, P0, P1, P2, P3, P4, P5, P6, P7, P8 any](n int, err error, f powerfunc.CtxFunc9VariadicError[V, P0, P1, P2, P3, P4, P5, P6, P7, P8]) powerfunc.CtxFunc9VariadicError[V, P0, P1, P2, P3, P4, P5, P6, P7, P8] {
+	fl := &failer{n: int64(n)}
+	return func(ctx context.Context, p0 P0, p1 P1, p2 P2, p3 P3, p4 P4, p5 P5, p6 P6, p7 P7, p8 P8, xs ...V) error {
+		if fl.fail() {
+			return err
+		}
+		return f(ctx, p0, p1, p2, p3, p4, p5, p6, p7, p8, xs...)
+	}
+}
+
+// DelayCtxFunc9VariadicError returns a CtxFunc9VariadicError waiting for latency before calling f.
+// The options set the clock measuring the latency, see DelayClock.
+// The error of the context is returned if it is done first.
+func DelayCtxFunc9VariadicError[V, P0, P1, P2, P3, P4, P5, P6, P7, P8 any](latency Latency, f powerfunc.CtxFunc9VariadicError[V, P0, P1, P2, P3, P4, P5, P6, P7, P8], opts ...DelayOption) powerfunc.CtxFunc9VariadicError[V, P0, P1, P2, P3, P4, P5, P6, P7, P8] {
+	d := newDelay(latency, opts)
+	return func(ctx context.Context, p0 P0, p1 P1, p2 P2, p3 P3, p4 P4, p5 P5, p6 P6, p7 P7, p8 P8, xs ...V) error {
+		if err := d.wait(ctx); err != nil {
+			return err
+		}
+		return f(ctx, p0, p1, p2, p3, p4, p5, p6, p7, p8, xs...)
+	}
+}
+
+// GateCtxFunc9VariadicError returns a CtxFunc9VariadicError blocking until g lets the call through
+// before calling f.
+// The error of the context is returned if it is done first.
+func GateCtxFunc9VariadicError[V, P0, P1, P2, P3, P4, P5, P6, P7, P8 any](g *Gate, f powerfunc.CtxFunc9VariadicError[V, P0, P1, P2, P3, P4, P5, P6, P7, P8]) powerfunc.CtxFunc9VariadicError[V, P0, P1, P2, P3, P4, P5, P6, P7, P8] {
+	return func(ctx context.Context, p0 P0, p1 P1, p2 P2, p3 P3, p4 P4, p5 P5, p6 P6, p7 P7, p8 P8, xs ...V) error {
+		if err := g.wait(ctx); err != nil {
+			return err
+		}
+		return f(ctx, p0, p1, p2, p3, p4, p5, p6, p7, p8, xs...)
+	}
+}
@@ -0,0 +1,43 @@
+// Code generated by powerfunc/generator from fakes.go.tmpl. DO NOT EDIT.
+
+package powerfunctest
+
+import (
+	"context"
+
+	"github.com/jonathanmontane/powerfunc"
+)
+
+// SpyFunc9 returns a Func9 recording the calls of f, along with the
+// Spy holding them.
+func SpyFunc9[P0, P1, P2, P3, P4, P5, P6, P7, P8 any](f powerfunc.Func9[P0, P1, P2, P3, P4, P5, P6, P7, P8]) (powerfunc.Func9[P0, P1, P2, P3, P4, P5, P6, P7, P8], *Spy) {
+	s := &Spy{}
+	return func(p0 P0, p1 P1, p2 P2, p3 P3, p4 P4, p5 P5, p6 P6, p7 P7, p8 P8) {
+		call := s.begin(context.Background(), p0, p1, p2, p3, p4, p5, p6, p7, p8)
+		panicked := true
+		defer func() {
+			s.end(call, panicked)
+		}()
+		f(p0, p1, p2, p3, p4, p5, p6, p7, p8)
+		panicked = false
+	}, s
+}
+
+// DelayFunc9 returns a Func9 waiting for latency before calling f.
+// The options set the clock measuring the latency, see DelayClock.
+func DelayFunc9[P0, P1, P2, P3, P4, P5, P6, P7, P8 any](latency Latency, f powerfunc.Func9[P0, P1, P2, P3, P4, P5, P6, P7, P8], opts ...DelayOption) powerfunc.Func9[P0, P1, P2, P3, P4, P5, P6, P7, P8] {
+	d := newDelay(latency, opts)
+	return func(p0 P0, p1 P1, p2 P2, p3 P3, p4 P4, p5 P5, p6 P6, p7 P7, p8 P8) {
+		_ = d.wait(context.Background())
+		f(p0, p1, p2, p3, p4, p5, p6, p7, p8)
+	}
+}
+
+// GateFunc9 returns a Func9 blocking until g lets the call through
+// before calling f.
+func GateFunc9[P0, P1, P2, P3, P4, P5, P6, P7, P8 any](g *Gate, f powerfunc.Func9[P0, P1, P2, P3, P4, P5, P6, P7, P8]) powerfunc.Func9[P0, P1, P2, P3, P4, P5, P6, P7, P8] {
+	return func(p0 P0, p1 P1, p2 P2, p3 P3, p4 P4, p5 P5, p6 P6, p7 P7, p8 P8) {
+		_ = g.wait(context.Background())
+		f(p0, p1, p2, p3, p4, p5, p6, p7, p8)
+	}
+}
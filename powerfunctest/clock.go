@@ -0,0 +1,241 @@
+package powerfunctest
+
+import (
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/jonathanmontane/powerfunc"
+)
+
+// FakeClock is a powerfunc.Clock that only moves forward when Advance or Set
+// is called, for deterministic tests of time-dependent decorators.
+//
+// Advance fires the timers that are due in the order of their deadlines,
+// with the clock set to the deadline of each one. The functions of AfterFunc
+// run synchronously in Advance, so that their effects are visible when it
+// returns. Use BlockUntil to wait for the code under test to be sleeping
+// before advancing the clock.
+type FakeClock struct {
+	mu     sync.Mutex
+	now    time.Time
+	timers []*fakeTimer
+	// changed is closed and replaced every time the timers change.
+	changed chan struct{}
+}
+
+var _ powerfunc.Clock = (*FakeClock)(nil)
+
+// NewFakeClock returns a FakeClock set to now.
+func NewFakeClock(now time.Time) *FakeClock {
+	return &FakeClock{now: now, changed: make(chan struct{})}
+}
+
+// Now returns the current time of the clock.
+func (c *FakeClock) Now() time.Time {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return c.now
+}
+
+// Since returns the time elapsed on the clock since t.
+func (c *FakeClock) Since(t time.Time) time.Duration {
+	return c.Now().Sub(t)
+}
+
+// Sleep blocks until the clock has been advanced by d.
+func (c *FakeClock) Sleep(d time.Duration) {
+	<-c.NewTimer(d).C()
+}
+
+// After returns a channel that receives the time of the clock once it has
+// been advanced by d.
+func (c *FakeClock) After(d time.Duration) <-chan time.Time {
+	return c.NewTimer(d).C()
+}
+
+// NewTimer returns a Timer firing once the clock has been advanced by d.
+func (c *FakeClock) NewTimer(d time.Duration) powerfunc.Timer {
+	t := &fakeTimer{clock: c, c: make(chan time.Time, 1)}
+	t.Reset(d)
+	return t
+}
+
+// AfterFunc returns a Timer calling f once the clock has been advanced by
+// d. f runs in its own goroutine if d is not positive, and synchronously in
+// Advance otherwise.
+func (c *FakeClock) AfterFunc(d time.Duration, f func()) powerfunc.Timer {
+	t := &fakeTimer{clock: c, f: f}
+	t.Reset(d)
+	return t
+}
+
+// NewTicker returns a Ticker ticking every time the clock has been advanced
+// by d. Like a time.Ticker, it drops the ticks its reader is not ready for.
+func (c *FakeClock) NewTicker(d time.Duration) powerfunc.Ticker {
+	if d <= 0 {
+		panic("powerfunctest: non-positive interval for NewTicker")
+	}
+	t := &fakeTimer{clock: c, c: make(chan time.Time, 1)}
+	t.reset(d, d)
+	return fakeTicker{t}
+}
+
+// Advance moves the clock forward by d, firing the timers that are due.
+func (c *FakeClock) Advance(d time.Duration) {
+	c.Set(c.Now().Add(d))
+}
+
+// Set moves the clock to t, firing the timers that are due.
+// The clock never moves backward: Set is a no-op if t is before Now.
+func (c *FakeClock) Set(t time.Time) {
+	for {
+		c.mu.Lock()
+		timer := c.due(t)
+		if timer == nil {
+			if t.After(c.now) {
+				c.now = t
+			}
+			c.mu.Unlock()
+			return
+		}
+		if timer.at.After(c.now) {
+			c.now = timer.at
+		}
+		f := timer.fire()
+		c.mu.Unlock()
+
+		if f != nil {
+			f()
+		}
+	}
+}
+
+// Timers returns the number of timers, tickers and sleepers waiting for the
+// clock to advance.
+func (c *FakeClock) Timers() int {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return len(c.timers)
+}
+
+// BlockUntil blocks until at least n timers, tickers and sleepers are
+// waiting for the clock to advance.
+func (c *FakeClock) BlockUntil(n int) {
+	c.mu.Lock()
+	for len(c.timers) < n {
+		changed := c.changed
+		c.mu.Unlock()
+		<-changed
+		c.mu.Lock()
+	}
+	c.mu.Unlock()
+}
+
+// due returns the timer with the earliest deadline no later than t, if any.
+func (c *FakeClock) due(t time.Time) *fakeTimer {
+	sort.SliceStable(c.timers, func(i, j int) bool {
+		return c.timers[i].at.Before(c.timers[j].at)
+	})
+	if len(c.timers) == 0 || c.timers[0].at.After(t) {
+		return nil
+	}
+	return c.timers[0]
+}
+
+func (c *FakeClock) add(t *fakeTimer) {
+	c.timers = append(c.timers, t)
+	c.notify()
+}
+
+func (c *FakeClock) remove(t *fakeTimer) bool {
+	for i, other := range c.timers {
+		if other == t {
+			c.timers = append(c.timers[:i], c.timers[i+1:]...)
+			c.notify()
+			return true
+		}
+	}
+	return false
+}
+
+func (c *FakeClock) notify() {
+	close(c.changed)
+	c.changed = make(chan struct{})
+}
+
+type fakeTimer struct {
+	clock *FakeClock
+	c     chan time.Time
+	f     func()
+	at    time.Time
+	// period is the interval of a ticker, zero for a timer.
+	period time.Duration
+}
+
+func (t *fakeTimer) C() <-chan time.Time {
+	return t.c
+}
+
+func (t *fakeTimer) Stop() bool {
+	t.clock.mu.Lock()
+	defer t.clock.mu.Unlock()
+	return t.clock.remove(t)
+}
+
+func (t *fakeTimer) Reset(d time.Duration) bool {
+	return t.reset(d, 0)
+}
+
+func (t *fakeTimer) reset(d, period time.Duration) bool {
+	t.clock.mu.Lock()
+	active := t.clock.remove(t)
+	t.at = t.clock.now.Add(d)
+	t.period = period
+	if d > 0 {
+		t.clock.add(t)
+		t.clock.mu.Unlock()
+		return active
+	}
+	f := t.fire()
+	t.clock.mu.Unlock()
+
+	if f != nil {
+		go f()
+	}
+	return active
+}
+
+// fire sends the current time on the channel of the timer, or returns the
+// function to call, and schedules the next tick of a ticker. The clock must
+// be locked.
+func (t *fakeTimer) fire() func() {
+	t.clock.remove(t)
+	if t.period > 0 {
+		t.at = t.at.Add(t.period)
+		t.clock.add(t)
+	}
+	if t.f != nil {
+		return t.f
+	}
+	select {
+	case t.c <- t.clock.now:
+	default:
+	}
+	return nil
+}
+
+type fakeTicker struct {
+	*fakeTimer
+}
+
+func (t fakeTicker) Stop() {
+	t.fakeTimer.Stop()
+}
+
+func (t fakeTicker) Reset(d time.Duration) {
+	if d <= 0 {
+		panic("powerfunctest: non-positive interval for Ticker.Reset")
+	}
+	t.reset(d, d)
+}
@@ -0,0 +1,215 @@
+package powerfunctest_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/jonathanmontane/powerfunc/powerfunctest"
+)
+
+var epoch = time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+
+func received(c <-chan time.Time) (time.Time, bool) {
+	select {
+	case t := <-c:
+		return t, true
+	default:
+		return time.Time{}, false
+	}
+}
+
+func TestFakeClockNeverMovesBackward(t *testing.T) {
+	clock := powerfunctest.NewFakeClock(epoch)
+	clock.Advance(time.Hour)
+	clock.Set(epoch)
+	if got := clock.Now(); !got.Equal(epoch.Add(time.Hour)) {
+		t.Errorf("Now() = %s, want %s", got, epoch.Add(time.Hour))
+	}
+	if got := clock.Since(epoch); got != time.Hour {
+		t.Errorf("Since(epoch) = %s, want 1h", got)
+	}
+}
+
+func TestFakeClockTimer(t *testing.T) {
+	clock := powerfunctest.NewFakeClock(epoch)
+	timer := clock.NewTimer(time.Second)
+
+	clock.Advance(999 * time.Millisecond)
+	if _, ok := received(timer.C()); ok {
+		t.Fatal("timer fired early")
+	}
+	clock.Advance(time.Millisecond)
+	if got, ok := received(timer.C()); !ok || !got.Equal(epoch.Add(time.Second)) {
+		t.Fatalf("timer fired at %s, %t", got, ok)
+	}
+	if timer.Stop() {
+		t.Error("Stop() of a fired timer = true")
+	}
+
+	if timer.Reset(time.Second) {
+		t.Error("Reset() of a fired timer = true")
+	}
+	if !timer.Stop() {
+		t.Error("Stop() of an active timer = false")
+	}
+	clock.Advance(time.Hour)
+	if _, ok := received(timer.C()); ok {
+		t.Error("stopped timer fired")
+	}
+	if got := clock.Timers(); got != 0 {
+		t.Errorf("Timers() = %d, want 0", got)
+	}
+}
+
+func TestFakeClockFiresInOrderOfDeadlines(t *testing.T) {
+	clock := powerfunctest.NewFakeClock(epoch)
+	var fired []time.Duration
+	for _, d := range []time.Duration{3 * time.Second, time.Second, 2 * time.Second} {
+		clock.AfterFunc(d, func() {
+			fired = append(fired, clock.Since(epoch))
+		})
+	}
+
+	// The functions run synchronously: their effects are visible as soon as
+	// Advance returns.
+	clock.Advance(time.Minute)
+	want := []time.Duration{time.Second, 2 * time.Second, 3 * time.Second}
+	if len(fired) != len(want) {
+		t.Fatalf("fired %v, want %v", fired, want)
+	}
+	for i := range want {
+		if fired[i] != want[i] {
+			t.Errorf("fired %v, want %v", fired, want)
+			break
+		}
+	}
+	if got := clock.Now(); !got.Equal(epoch.Add(time.Minute)) {
+		t.Errorf("Now() = %s, want %s", got, epoch.Add(time.Minute))
+	}
+}
+
+func TestFakeClockAfterFuncFiringTimers(t *testing.T) {
+	clock := powerfunctest.NewFakeClock(epoch)
+	var fired []time.Duration
+	clock.AfterFunc(time.Second, func() {
+		fired = append(fired, clock.Since(epoch))
+		// Due within the same Advance: fired by it as well.
+		clock.AfterFunc(time.Second, func() {
+			fired = append(fired, clock.Since(epoch))
+		})
+	})
+
+	clock.Advance(5 * time.Second)
+	if len(fired) != 2 || fired[0] != time.Second || fired[1] != 2*time.Second {
+		t.Errorf("fired %v, want [1s 2s]", fired)
+	}
+}
+
+func TestFakeClockAfterFuncWithoutDelay(t *testing.T) {
+	clock := powerfunctest.NewFakeClock(epoch)
+	done := make(chan struct{})
+	clock.AfterFunc(0, func() {
+		close(done)
+	})
+	select {
+	case <-done:
+	case <-time.After(time.Second):
+		t.Fatal("AfterFunc(0) did not run without advancing the clock")
+	}
+}
+
+func TestFakeClockBlockUntil(t *testing.T) {
+	clock := powerfunctest.NewFakeClock(epoch)
+	woke := make(chan time.Time)
+	for i := 0; i < 2; i++ {
+		go func() {
+			clock.Sleep(time.Second)
+			woke <- clock.Now()
+		}()
+	}
+
+	clock.BlockUntil(2)
+	clock.Advance(time.Second)
+	for i := 0; i < 2; i++ {
+		if got := <-woke; got.Before(epoch.Add(time.Second)) {
+			t.Errorf("woke at %s", got)
+		}
+	}
+}
+
+func TestFakeClockTicker(t *testing.T) {
+	clock := powerfunctest.NewFakeClock(epoch)
+	ticker := clock.NewTicker(time.Second)
+
+	clock.Advance(time.Second)
+	if got, ok := received(ticker.C()); !ok || !got.Equal(epoch.Add(time.Second)) {
+		t.Fatalf("first tick at %s, %t", got, ok)
+	}
+
+	// The ticker re-arms itself, and drops the ticks that are not read.
+	clock.Advance(3 * time.Second)
+	if got, ok := received(ticker.C()); !ok || !got.Equal(epoch.Add(2*time.Second)) {
+		t.Errorf("buffered tick at %s, %t, want the first missed one", got, ok)
+	}
+	if _, ok := received(ticker.C()); ok {
+		t.Error("dropped ticks were delivered")
+	}
+	if got := clock.Timers(); got != 1 {
+		t.Errorf("Timers() = %d, want 1", got)
+	}
+
+	ticker.Reset(10 * time.Second)
+	clock.Advance(9 * time.Second)
+	if _, ok := received(ticker.C()); ok {
+		t.Error("tick before the new interval")
+	}
+	clock.Advance(time.Second)
+	if _, ok := received(ticker.C()); !ok {
+		t.Error("no tick after the new interval")
+	}
+
+	ticker.Stop()
+	clock.Advance(time.Minute)
+	if _, ok := received(ticker.C()); ok {
+		t.Error("stopped ticker ticked")
+	}
+}
+
+func TestFakeClockTickerPanicsOnNonPositiveInterval(t *testing.T) {
+	defer func() {
+		if recover() == nil {
+			t.Error("NewTicker(0) did not panic")
+		}
+	}()
+	powerfunctest.NewFakeClock(epoch).NewTicker(0)
+}
+
+func TestDelayClock(t *testing.T) {
+	clock := powerfunctest.NewFakeClock(epoch)
+	f := powerfunctest.DelayCtxFuncError(powerfunctest.Fixed(time.Second), func(ctx context.Context) error {
+		return nil
+	}, powerfunctest.DelayClock(clock))
+
+	done := make(chan error)
+	go func() {
+		done <- f(context.Background())
+	}()
+	clock.BlockUntil(1)
+	select {
+	case <-done:
+		t.Fatal("returned before the clock was advanced")
+	default:
+	}
+	clock.Advance(time.Second)
+	if err := <-done; err != nil {
+		t.Fatal(err)
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	if err := f(ctx); !errors.Is(err, context.Canceled) {
+		t.Errorf("f(cancelled ctx) = %v, want context.Canceled", err)
+	}
+}
@@ -0,0 +1,77 @@
+// Code generated by powerfunc/generator from fakes.go.tmpl. DO NOT EDIT.
+
+package powerfunctest
+
+import (
+	"context"
+
+	"github.com/jonathanmontane/powerfunc"
+)
+
+// StubCtxFuncError returns a CtxFuncError returning the outcomes in order, one per
+// call. The last outcome is repeated once they are exhausted, and zero values
+// are returned if there are none.
+func StubCtxFuncError(outcomes ...error) powerfunc.CtxFuncError {
+	s := &script{}
+	return func(ctx context.Context) error {
+		var out error
+		if i := s.next(len(outcomes)); i >= 0 {
+			out = outcomes[i]
+		}
+		return out
+	}
+}
+
+// SpyCtxFuncError returns a CtxFuncError recording the calls of f, along with the
+// Spy holding them.
+func SpyCtxFuncError(f powerfunc.CtxFuncError) (powerfunc.CtxFuncError, *Spy) {
+	s := &Spy{}
+	return func(ctx context.Context) error {
+		call := s.begin(ctx)
+		panicked := true
+		defer func() {
+			s.end(call, panicked)
+		}()
+		err := f(ctx)
+		panicked = false
+		s.fail(call, err)
+		return err
+	}, s
+}
+
+// FailNCtxFuncError returns a CtxFuncError returning err for the first n calls,
+// then calling f.
+func FailNCtxFuncError(n int, err error, f powerfunc.CtxFuncError) powerfunc.CtxFuncError {
+	fl := &failer{n: int64(n)}
+	return func(ctx context.Context) error {
+		if fl.fail() {
+			return err
+		}
+		return f(ctx)
+	}
+}
+
+// DelayCtxFuncError returns a CtxFuncError waiting for latency before calling f.
+// The options set the clock measuring the latency, see DelayClock.
+// The error of the context is returned if it is done first.
+func DelayCtxFuncError(latency Latency, f powerfunc.CtxFuncError, opts ...DelayOption) powerfunc.CtxFuncError {
+	d := newDelay(latency, opts)
+	return func(ctx context.Context) error {
+		if err := d.wait(ctx); err != nil {
+			return err
+		}
+		return f(ctx)
+	}
+}
+
+// GateCtxFuncError returns a CtxFuncError blocking until g lets the call through
+// before calling f.
+// The error of the context is returned if it is done first.
+func GateCtxFuncError(g *Gate, f powerfunc.CtxFuncError) powerfunc.CtxFuncError {
+	return func(ctx context.Context) error {
+		if err := g.wait(ctx); err != nil {
+			return err
+		}
+		return f(ctx)
+	}
+}
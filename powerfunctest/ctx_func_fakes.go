@@ -0,0 +1,45 @@
+// Code generated by powerfunc/generator from fakes.go.tmpl. DO NOT EDIT.
+
+package powerfunctest
+
+import (
+	"context"
+
+	"github.com/jonathanmontane/powerfunc"
+)
+
+// SpyCtxFunc returns a CtxFunc recording the calls of f, along with the
+// Spy holding them.
+func SpyCtxFunc(f powerfunc.CtxFunc) (powerfunc.CtxFunc, *Spy) {
+	s := &Spy{}
+	return func(ctx context.Context) {
+		call := s.begin(ctx)
+		panicked := true
+		defer func() {
+			s.end(call, panicked)
+		}()
+		f(ctx)
+		panicked = false
+	}, s
+}
+
+// DelayCtxFunc returns a CtxFunc waiting for latency before calling f.
+// The options set the clock measuring the latency, see DelayClock.
+// f is still called if the context is done first.
+func DelayCtxFunc(latency Latency, f powerfunc.CtxFunc, opts ...DelayOption) powerfunc.CtxFunc {
+	d := newDelay(latency, opts)
+	return func(ctx context.Context) {
+		_ = d.wait(ctx)
+		f(ctx)
+	}
+}
+
+// GateCtxFunc returns a CtxFunc blocking until g lets the call through
+// before calling f.
+// f is still called if the context is done first.
+func GateCtxFunc(g *Gate, f powerfunc.CtxFunc) powerfunc.CtxFunc {
+	return func(ctx context.Context) {
+		_ = g.wait(ctx)
+		f(ctx)
+	}
+}
@@ -0,0 +1,60 @@
+// Code generated by powerfunc/generator from fakes.go.tmpl. DO NOT EDIT.
+
+package powerfunctest
+
+import (
+	"context"
+
+	"github.com/jonathanmontane/powerfunc"
+)
+
+// StubCtxFuncOk returns a CtxFuncOk returning the outcomes in order, one per
+// call. The last outcome is repeated once they are exhausted, and zero values
+// are returned if there are none.
+func StubCtxFuncOk[R any](outcomes ...Option[R]) powerfunc.CtxFuncOk[R] {
+	s := &script{}
+	return func(ctx context.Context) (R, bool) {
+		var out Option[R]
+		if i := s.next(len(outcomes)); i >= 0 {
+			out = outcomes[i]
+		}
+		return out.Value, out.Ok
+	}
+}
+
+// SpyCtxFuncOk returns a CtxFuncOk recording the calls of f, along with the
+// Spy holding them.
+func SpyCtxFuncOk[R any](f powerfunc.CtxFuncOk[R]) (powerfunc.CtxFuncOk[R], *Spy) {
+	s := &Spy{}
+	return func(ctx context.Context) (R, bool) {
+		call := s.begin(ctx)
+		panicked := true
+		defer func() {
+			s.end(call, panicked)
+		}()
+		r0, r1 := f(ctx)
+		panicked = false
+		return r0, r1
+	}, s
+}
+
+// DelayCtxFuncOk returns a CtxFuncOk waiting for latency before calling f.
+// The options set the clock measuring the latency, see DelayClock.
+// f is still called if the context is done first.
+func DelayCtxFuncOk[R any](latency Latency, f powerfunc.CtxFuncOk[R], opts ...DelayOption) powerfunc.CtxFuncOk[R] {
+	d := newDelay(latency, opts)
+	return func(ctx context.Context) (R, bool) {
+		_ = d.wait(ctx)
+		return f(ctx)
+	}
+}
+
+// GateCtxFuncOk returns a CtxFuncOk blocking until g lets the call through
+// before calling f.
+// f is still called if the context is done first.
+func GateCtxFuncOk[R any](g *Gate, f powerfunc.CtxFuncOk[R]) powerfunc.CtxFuncOk[R] {
+	return func(ctx context.Context) (R, bool) {
+		_ = g.wait(ctx)
+		return f(ctx)
+	}
+}
@@ -0,0 +1,60 @@
+// Code generated by powerfunc/generator from fakes.go.tmpl. DO NOT EDIT.
+
+package powerfunctest
+
+import (
+	"context"
+
+	"github.com/jonathanmontane/powerfunc"
+)
+
+// StubCtxFuncPair returns a CtxFuncPair returning the outcomes in order, one per
+// call. The last outcome is repeated once they are exhausted, and zero values
+// are returned if there are none.
+func StubCtxFuncPair[R1, R2 any](outcomes ...Pair[R1, R2]) powerfunc.CtxFuncPair[R1, R2] {
+	s := &script{}
+	return func(ctx context.Context) (R1, R2) {
+		var out Pair[R1, R2]
+		if i := s.next(len(outcomes)); i >= 0 {
+			out = outcomes[i]
+		}
+		return out.First, out.Second
+	}
+}
+
+// SpyCtxFuncPair returns a CtxFuncPair recording the calls of f, along with the
+// Spy holding them.
+func SpyCtxFuncPair[R1, R2 any](f powerfunc.CtxFuncPair[R1, R2]) (powerfunc.CtxFuncPair[R1, R2], *Spy) {
+	s := &Spy{}
+	return func(ctx context.Context) (R1, R2) {
+		call := s.begin(ctx)
+		panicked := true
+		defer func() {
+			s.end(call, panicked)
+		}()
+		r0, r1 := f(ctx)
+		panicked = false
+		return r0, r1
+	}, s
+}
+
+// DelayCtxFuncPair returns a CtxFuncPair waiting for latency before calling f.
+// The options set the clock measuring the latency, see DelayClock.
+// f is still called if the context is done first.
+func DelayCtxFuncPair[R1, R2 any](latency Latency, f powerfunc.CtxFuncPair[R1, R2], opts ...DelayOption) powerfunc.CtxFuncPair[R1, R2] {
+	d := newDelay(latency, opts)
+	return func(ctx context.Context) (R1, R2) {
+		_ = d.wait(ctx)
+		return f(ctx)
+	}
+}
+
+// GateCtxFuncPair returns a CtxFuncPair blocking until g lets the call through
+// before calling f.
+// f is still called if the context is done first.
+func GateCtxFuncPair[R1, R2 any](g *Gate, f powerfunc.CtxFuncPair[R1, R2]) powerfunc.CtxFuncPair[R1, R2] {
+	return func(ctx context.Context) (R1, R2) {
+		_ = g.wait(ctx)
+		return f(ctx)
+	}
+}
@@ -0,0 +1,77 @@
+// Code generated by powerfunc/generator from fakes.go.tmpl. DO NOT EDIT.
+
+package powerfunctest
+
+import (
+	"context"
+
+	"github.com/jonathanmontane/powerfunc"
+)
+
+// StubCtxFuncPairResult returns a CtxFuncPairResult returning the outcomes in order, one per
+// call. The last outcome is repeated once they are exhausted, and zero values
+// are returned if there are none.
+func StubCtxFuncPairResult[R1, R2 any](outcomes ...PairResult[R1, R2]) powerfunc.CtxFuncPairResult[R1, R2] {
+	s := &script{}
+	return func(ctx context.Context) (R1, R2, error) {
+		var out PairResult[R1, R2]
+		if i := s.next(len(outcomes)); i >= 0 {
+			out = outcomes[i]
+		}
+		return out.First, out.Second, out.Err
+	}
+}
+
+// SpyCtxFuncPairResult returns a CtxFuncPairResult recording the calls of f, along with the
+// Spy holding them.
+func SpyCtxFuncPairResult[R1, R2 any](f powerfunc.CtxFuncPairResult[R1, R2]) (powerfunc.CtxFuncPairResult[R1, R2], *Spy) {
+	s := &Spy{}
+	return func(ctx context.Context) (R1, R2, error) {
+		call := s.begin(ctx)
+		panicked := true
+		defer func() {
+			s.end(call, panicked)
+		}()
+		r0, r1, err := f(ctx)
+		panicked = false
+		s.fail(call, err)
+		return r0, r1, err
+	}, s
+}
+
+// FailNCtxFuncPairResult returns a CtxFuncPairResult returning err for the first n calls,
+// then calling f.
+func FailNCtxFuncPairResult[R1, R2 any](n int, err error, f powerfunc.CtxFuncPairResult[R1, R2]) powerfunc.CtxFuncPairResult[R1, R2] {
+	fl := &failer{n: int64(n)}
+	return func(ctx context.Context) (R1, R2, error) {
+		if fl.fail() {
+			return *new(R1), *new(R2), err
+		}
+		return f(ctx)
+	}
+}
+
+// DelayCtxFuncPairResult returns a CtxFuncPairResult waiting for latency before calling f.
+// The options set the clock measuring the latency, see DelayClock.
+// The error of the context is returned if it is done first.
+func DelayCtxFuncPairResult[R1, R2 any](latency Latency, f powerfunc.CtxFuncPairResult[R1, R2], opts ...DelayOption) powerfunc.CtxFuncPairResult[R1, R2] {
+	d := newDelay(latency, opts)
+	return func(ctx context.Context) (R1, R2, error) {
+		if err := d.wait(ctx); err != nil {
+			return *new(R1), *new(R2), err
+		}
+		return f(ctx)
+	}
+}
+
+// GateCtxFuncPairResult returns a CtxFuncPairResult blocking until g lets the call through
+// before calling f.
+// The error of the context is returned if it is done first.
+func GateCtxFuncPairResult[R1, R2 any](g *Gate, f powerfunc.CtxFuncPairResult[R1, R2]) powerfunc.CtxFuncPairResult[R1, R2] {
+	return func(ctx context.Context) (R1, R2, error) {
+		if err := g.wait(ctx); err != nil {
+			return *new(R1), *new(R2), err
+		}
+		return f(ctx)
+	}
+}
@@ -0,0 +1,77 @@
+// Code generated by powerfunc/generator from fakes.go.tmpl. DO NOT EDIT.
+
+package powerfunctest
+
+import (
+	"context"
+
+	"github.com/jonathanmontane/powerfunc"
+)
+
+// StubCtxFuncResult returns a CtxFuncResult returning the outcomes in order, one per
+// call. The last outcome is repeated once they are exhausted, and zero values
+// are returned if there are none.
+func StubCtxFuncResult[R any](outcomes ...Result[R]) powerfunc.CtxFuncResult[R] {
+	s := &script{}
+	return func(ctx context.Context) (R, error) {
+		var out Result[R]
+		if i := s.next(len(outcomes)); i >= 0 {
+			out = outcomes[i]
+		}
+		return out.Value, out.Err
+	}
+}
+
+// SpyCtxFuncResult returns a CtxFuncResult recording the calls of f, along with the
+// Spy holding them.
+func SpyCtxFuncResult[R any](f powerfunc.CtxFuncResult[R]) (powerfunc.CtxFuncResult[R], *Spy) {
+	s := &Spy{}
+	return func(ctx context.Context) (R, error) {
+		call := s.begin(ctx)
+		panicked := true
+		defer func() {
+			s.end(call, panicked)
+		}()
+		r0, err := f(ctx)
+		panicked = false
+		s.fail(call, err)
+		return r0, err
+	}, s
+}
+
+// FailNCtxFuncResult returns a CtxFuncResult returning err for the first n calls,
+// then calling f.
+func FailNCtxFuncResult[R any](n int, err error, f powerfunc.CtxFuncResult[R]) powerfunc.CtxFuncResult[R] {
+	fl := &failer{n: int64(n)}
+	return func(ctx context.Context) (R, error) {
+		if fl.fail() {
+			return *new(R), err
+		}
+		return f(ctx)
+	}
+}
+
+// DelayCtxFuncResult returns a CtxFuncResult waiting for latency before calling f.
+// The options set the clock measuring the latency, see DelayClock.
+// The error of the context is returned if it is done first.
+func DelayCtxFuncResult[R any](latency Latency, f powerfunc.CtxFuncResult[R], opts ...DelayOption) powerfunc.CtxFuncResult[R] {
+	d := newDelay(latency, opts)
+	return func(ctx context.Context) (R, error) {
+		if err := d.wait(ctx); err != nil {
+			return *new(R), err
+		}
+		return f(ctx)
+	}
+}
+
+// GateCtxFuncResult returns a CtxFuncResult blocking until g lets the call through
+// before calling f.
+// The error of the context is returned if it is done first.
+func GateCtxFuncResult[R any](g *Gate, f powerfunc.CtxFuncResult[R]) powerfunc.CtxFuncResult[R] {
+	return func(ctx context.Context) (R, error) {
+		if err := g.wait(ctx); err != nil {
+			return *new(R), err
+		}
+		return f(ctx)
+	}
+}
@@ -0,0 +1,60 @@
+// Code generated by powerfunc/generator from fakes.go.tmpl. DO NOT EDIT.
+
+package powerfunctest
+
+import (
+	"context"
+
+	"github.com/jonathanmontane/powerfunc"
+)
+
+// StubCtxFuncValue returns a CtxFuncValue returning the outcomes in order, one per
+// call. The last outcome is repeated once they are exhausted, and zero values
+// are returned if there are none.
+func StubCtxFuncValue[R any](outcomes ...R) powerfunc.CtxFuncValue[R] {
+	s := &script{}
+	return func(ctx context.Context) R {
+		var out R
+		if i := s.next(len(outcomes)); i >= 0 {
+			out = outcomes[i]
+		}
+		return out
+	}
+}
+
+// SpyCtxFuncValue returns a CtxFuncValue recording the calls of f, along with the
+// Spy holding them.
+func SpyCtxFuncValue[R any](f powerfunc.CtxFuncValue[R]) (powerfunc.CtxFuncValue[R], *Spy) {
+	s := &Spy{}
+	return func(ctx context.Context) R {
+		call := s.begin(ctx)
+		panicked := true
+		defer func() {
+			s.end(call, panicked)
+		}()
+		r0 := f(ctx)
+		panicked = false
+		return r0
+	}, s
+}
+
+// DelayCtxFuncValue returns a CtxFuncValue waiting for latency before calling f.
+// The options set the clock measuring the latency, see DelayClock.
+// f is still called if the context is done first.
+func DelayCtxFuncValue[R any](latency Latency, f powerfunc.CtxFuncValue[R], opts ...DelayOption) powerfunc.CtxFuncValue[R] {
+	d := newDelay(latency, opts)
+	return func(ctx context.Context) R {
+		_ = d.wait(ctx)
+		return f(ctx)
+	}
+}
+
+// GateCtxFuncValue returns a CtxFuncValue blocking until g lets the call through
+// before calling f.
+// f is still called if the context is done first.
+func GateCtxFuncValue[R any](g *Gate, f powerfunc.CtxFuncValue[R]) powerfunc.CtxFuncValue[R] {
+	return func(ctx context.Context) R {
+		_ = g.wait(ctx)
+		return f(ctx)
+	}
+}
@@ -0,0 +1,77 @@
+// Code generated by powerfunc/generator from fakes.go.tmpl. DO NOT EDIT.
+
+package powerfunctest
+
+import (
+	"context"
+
+	"github.com/jonathanmontane/powerfunc"
+)
+
+// StubCtxFuncVariadicError returns a CtxFuncVariadicError returning the outcomes in order, one per
+// call. The last outcome is repeated once they are exhausted, and zero values
+// are returned if there are none.
+func StubCtxFuncVariadicError[V any](outcomes ...error) powerfunc.CtxFuncVariadicError[V] {
+	s := &script{}
+	return func(ctx context.Context, xs ...V) error {
+		var out error
+		if i := s.next(len(outcomes)); i >= 0 {
+			out = outcomes[i]
+		}
+		return out
+	}
+}
+
+// SpyCtxFuncVariadicError returns a CtxFuncVariadicError recording the calls of f, along with the
+// Spy holding them.
+func SpyCtxFuncVariadicError[V any](f powerfunc.CtxFuncVariadicError[V]) (powerfunc.CtxFuncVariadicError[V], *Spy) {
+	s := &Spy{}
+	return func(ctx context.Context, xs ...V) error {
+		call := s.begin(ctx, xs)
+		panicked := true
+		defer func() {
+			s.end(call, panicked)
+		}()
+		err := f(ctx, xs...)
+		panicked = false
+		s.fail(call, err)
+		return err
+	}, s
+}
+
+// FailNCtxFuncVariadicError returns a CtxFuncVariadicError returning err for the first n calls,
+// then calling f.
+func FailNCtxFuncVariadicError[V any](n int, err error, f powerfunc.CtxFuncVariadicError[V]) powerfunc.CtxFuncVariadicError[V] {
+	fl := &failer{n: int64(n)}
+	return func(ctx context.Context, xs ...V) error {
+		if fl.fail() {
+			return err
+		}
+		return f(ctx, xs...)
+	}
+}
+
+// DelayCtxFuncVariadicError returns a CtxFuncVariadicError waiting for latency before calling f.
+// The options set the clock measuring the latency, see DelayClock.
+// The error of the context is returned if it is done first.
+func DelayCtxFuncVariadicError[V any](latency Latency, f powerfunc.CtxFuncVariadicError[V], opts ...DelayOption) powerfunc.CtxFuncVariadicError[V] {
+	d := newDelay(latency, opts)
+	return func(ctx context.Context, xs ...V) error {
+		if err := d.wait(ctx); err != nil {
+			return err
+		}
+		return f(ctx, xs...)
+	}
+}
+
+// GateCtxFuncVariadicError returns a CtxFuncVariadicError blocking until g lets the call through
+// before calling f.
+// The error of the context is returned if it is done first.
+func GateCtxFuncVariadicError[V any](g *Gate, f powerfunc.CtxFuncVariadicError[V]) powerfunc.CtxFuncVariadicError[V] {
+	return func(ctx context.Context, xs ...V) error {
+		if err := g.wait(ctx); err != nil {
+			return err
+		}
+		return f(ctx, xs...)
+	}
+}
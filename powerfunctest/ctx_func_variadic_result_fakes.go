@@ -0,0 +1,77 @@
+// Code generated by powerfunc/generator from fakes.go.tmpl. DO NOT EDIT.
+
+package powerfunctest
+
+import (
+	"context"
+
+	"github.com/jonathanmontane/powerfunc"
+)
+
+// StubCtxFuncVariadicResult returns a CtxFuncVariadicResult returning the outcomes in order, one per
+// call. The last outcome is repeated once they are exhausted, and zero values
+// are returned if there are none.
+func StubCtxFuncVariadicResult[R, V any](outcomes ...Result[R]) powerfunc.CtxFuncVariadicResult[R, V] {
+	s := &script{}
+	return func(ctx context.Context, xs ...V) (R, error) {
+		var out Result[R]
+		if i := s.next(len(outcomes)); i >= 0 {
+			out = outcomes[i]
+		}
+		return out.Value, out.Err
+	}
+}
+
+// SpyCtxFuncVariadicResult returns a CtxFuncVariadicResult recording the calls of f, along with the
+// Spy holding them.
+func SpyCtxFuncVariadicResult[R, V any](f powerfunc.CtxFuncVariadicResult[R, V]) (powerfunc.CtxFuncVariadicResult[R, V], *Spy) {
+	s := &Spy{}
+	return func(ctx context.Context, xs ...V) (R, error) {
+		call := s.begin(ctx, xs)
+		panicked := true
+		defer func() {
+			s.end(call, panicked)
+		}()
+		r0, err := f(ctx, xs...)
+		panicked = false
+		s.fail(call, err)
+		return r0, err
+	}, s
+}
+
+// FailNCtxFuncVariadicResult returns a CtxFuncVariadicResult returning err for the first n calls,
+// then calling f.
+func FailNCtxFuncVariadicResult[R, V any](n int, err error, f powerfunc.CtxFuncVariadicResult[R, V]) powerfunc.CtxFuncVariadicResult[R, V] {
+	fl := &failer{n: int64(n)}
+	return func(ctx context.Context, xs ...V) (R, error) {
+		if fl.fail() {
+			return *new(R), err
+		}
+		return f(ctx, xs...)
+	}
+}
+
+// DelayCtxFuncVariadicResult returns a CtxFuncVariadicResult waiting for latency before calling f.
+// The options set the clock measuring the latency, see DelayClock.
+// The error of the context is returned if it is done first.
+func DelayCtxFuncVariadicResult[R, V any](latency Latency, f powerfunc.CtxFuncVariadicResult[R, V], opts ...DelayOption) powerfunc.CtxFuncVariadicResult[R, V] {
+	d := newDelay(latency, opts)
+	return func(ctx context.Context, xs ...V) (R, error) {
+		if err := d.wait(ctx); err != nil {
+			return *new(R), err
+		}
+		return f(ctx, xs...)
+	}
+}
+
+// GateCtxFuncVariadicResult returns a CtxFuncVariadicResult blocking until g lets the call through
+// before calling f.
+// The error of the context is returned if it is done first.
+func GateCtxFuncVariadicResult[R, V any](g *Gate, f powerfunc.CtxFuncVariadicResult[R, V]) powerfunc.CtxFuncVariadicResult[R, V] {
+	return func(ctx context.Context, xs ...V) (R, error) {
+		if err := g.wait(ctx); err != nil {
+			return *new(R), err
+		}
+		return f(ctx, xs...)
+	}
+}
@@ -0,0 +1,23 @@
+//go:generate go run ../generator -arity 10 -templates ./templates -package powerfunctest -qualifier powerfunc
+
+// Package powerfunctest provides test doubles for the powerfunc function
+// types, generated for every family and arity:
+//
+//   - Stub returns a function returning scripted outcomes, one per call.
+//   - Spy records the calls of a function and offers assertions on them.
+//   - FailN fails the first n calls of a function, then calls it.
+//   - Delay injects latency before every call of a function.
+//   - Gate blocks every call of a function until a Gate lets it through.
+//
+// FakeClock is a powerfunc.Clock controlled by the tests, to drive the
+// time-dependent decorators deterministically.
+//
+// For instance, for a CtxFunc2Result dependency:
+//
+//	fetch := powerfunctest.FailNCtxFunc2Result(2, errTransient,
+//		powerfunctest.StubCtxFunc2Result[User, string, int](powerfunctest.Return(alice)))
+//	fetch, spy := powerfunctest.SpyCtxFunc2Result(fetch)
+//	// ...
+//	spy.AssertCalls(t, 3)
+//	spy.AssertCalledWith(t, "alice", 1)
+package powerfunctest
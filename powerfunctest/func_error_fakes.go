@@ -0,0 +1,71 @@
+// Code generated by powerfunc/generator from fakes.go.tmpl. DO NOT EDIT.
+
+package powerfunctest
+
+import (
+	"context"
+
+	"github.com/jonathanmontane/powerfunc"
+)
+
+// StubFuncError returns a FuncError returning the outcomes in order, one per
+// call. The last outcome is repeated once they are exhausted, and zero values
+// are returned if there are none.
+func StubFuncError(outcomes ...error) powerfunc.FuncError {
+	s := &script{}
+	return func() error {
+		var out error
+		if i := s.next(len(outcomes)); i >= 0 {
+			out = outcomes[i]
+		}
+		return out
+	}
+}
+
+// SpyFuncError returns a FuncError recording the calls of f, along with the
+// Spy holding them.
+func SpyFuncError(f powerfunc.FuncError) (powerfunc.FuncError, *Spy) {
+	s := &Spy{}
+	return func() error {
+		call := s.begin(context.Background())
+		panicked := true
+		defer func() {
+			s.end(call, panicked)
+		}()
+		err := f()
+		panicked = false
+		s.fail(call, err)
+		return err
+	}, s
+}
+
+// FailNFuncError returns a FuncError returning err for the first n calls,
+// then calling f.
+func FailNFuncError(n int, err error, f powerfunc.FuncError) powerfunc.FuncError {
+	fl := &failer{n: int64(n)}
+	return func() error {
+		if fl.fail() {
+			return err
+		}
+		return f()
+	}
+}
+
+// DelayFuncError returns a FuncError waiting for latency before calling f.
+// The options set the clock measuring the latency, see DelayClock.
+func DelayFuncError(latency Latency, f powerfunc.FuncError, opts ...DelayOption) powerfunc.FuncError {
+	d := newDelay(latency, opts)
+	return func() error {
+		_ = d.wait(context.Background())
+		return f()
+	}
+}
+
+// GateFuncError returns a FuncError blocking until g lets the call through
+// before calling f.
+func GateFuncError(g *Gate, f powerfunc.FuncError) powerfunc.FuncError {
+	return func() error {
+		_ = g.wait(context.Background())
+		return f()
+	}
+}
@@ -0,0 +1,43 @@
+// Code generated by powerfunc/generator from fakes.go.tmpl. DO NOT EDIT.
+
+package powerfunctest
+
+import (
+	"context"
+
+	"github.com/jonathanmontane/powerfunc"
+)
+
+// SpyFunc returns a Func recording the calls of f, along with the
+// Spy holding them.
+func SpyFunc(f powerfunc.Func) (powerfunc.Func, *Spy) {
+	s := &Spy{}
+	return func() {
+		call := s.begin(context.Background())
+		panicked := true
+		defer func() {
+			s.end(call, panicked)
+		}()
+		f()
+		panicked = false
+	}, s
+}
+
+// DelayFunc returns a Func waiting for latency before calling f.
+// The options set the clock measuring the latency, see DelayClock.
+func DelayFunc(latency Latency, f powerfunc.Func, opts ...DelayOption) powerfunc.Func {
+	d := newDelay(latency, opts)
+	return func() {
+		_ = d.wait(context.Background())
+		f()
+	}
+}
+
+// GateFunc returns a Func blocking until g lets the call through
+// before calling f.
+func GateFunc(g *Gate, f powerfunc.Func) powerfunc.Func {
+	return func() {
+		_ = g.wait(context.Background())
+		f()
+	}
+}
@@ -0,0 +1,58 @@
+// Code generated by powerfunc/generator from fakes.go.tmpl. DO NOT EDIT.
+
+package powerfunctest
+
+import (
+	"context"
+
+	"github.com/jonathanmontane/powerfunc"
+)
+
+// StubFuncOk returns a FuncOk returning the outcomes in order, one per
+// call. The last outcome is repeated once they are exhausted, and zero values
+// are returned if there are none.
+func StubFuncOk[R any](outcomes ...Option[R]) powerfunc.FuncOk[R] {
+	s := &script{}
+	return func() (R, bool) {
+		var out Option[R]
+		if i := s.next(len(outcomes)); i >= 0 {
+			out = outcomes[i]
+		}
+		return out.Value, out.Ok
+	}
+}
+
+// SpyFuncOk returns a FuncOk recording the calls of f, along with the
+// Spy holding them.
+func SpyFuncOk[R any](f powerfunc.FuncOk[R]) (powerfunc.FuncOk[R], *Spy) {
+	s := &Spy{}
+	return func() (R, bool) {
+		call := s.begin(context.Background())
+		panicked := true
+		defer func() {
+			s.end(call, panicked)
+		}()
+		r0, r1 := f()
+		panicked = false
+		return r0, r1
+	}, s
+}
+
+// DelayFuncOk returns a FuncOk waiting for latency before calling f.
+// The options set the clock measuring the latency, see DelayClock.
+func DelayFuncOk[R any](latency Latency, f powerfunc.FuncOk[R], opts ...DelayOption) powerfunc.FuncOk[R] {
+	d := newDelay(latency, opts)
+	return func() (R, bool) {
+		_ = d.wait(context.Background())
+		return f()
+	}
+}
+
+// GateFuncOk returns a FuncOk blocking until g lets the call through
+// before calling f.
+func GateFuncOk[R any](g *Gate, f powerfunc.FuncOk[R]) powerfunc.FuncOk[R] {
+	return func() (R, bool) {
+		_ = g.wait(context.Background())
+		return f()
+	}
+}
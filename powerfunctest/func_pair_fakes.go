@@ -0,0 +1,58 @@
+// Code generated by powerfunc/generator from fakes.go.tmpl. DO NOT EDIT.
+
+package powerfunctest
+
+import (
+	"context"
+
+	"github.com/jonathanmontane/powerfunc"
+)
+
+// StubFuncPair returns a FuncPair returning the outcomes in order, one per
+// call. The last outcome is repeated once they are exhausted, and zero values
+// are returned if there are none.
+func StubFuncPair[R1, R2 any](outcomes ...Pair[R1, R2]) powerfunc.FuncPair[R1, R2] {
+	s := &script{}
+	return func() (R1, R2) {
+		var out Pair[R1, R2]
+		if i := s.next(len(outcomes)); i >= 0 {
+			out = outcomes[i]
+		}
+		return out.First, out.Second
+	}
+}
+
+// SpyFuncPair returns a FuncPair recording the calls of f, along with the
+// Spy holding them.
+func SpyFuncPair[R1, R2 any](f powerfunc.FuncPair[R1, R2]) (powerfunc.FuncPair[R1, R2], *Spy) {
+	s := &Spy{}
+	return func() (R1, R2) {
+		call := s.begin(context.Background())
+		panicked := true
+		defer func() {
+			s.end(call, panicked)
+		}()
+		r0, r1 := f()
+		panicked = false
+		return r0, r1
+	}, s
+}
+
+// DelayFuncPair returns a FuncPair waiting for latency before calling f.
+// The options set the clock measuring the latency, see DelayClock.
+func DelayFuncPair[R1, R2 any](latency Latency, f powerfunc.FuncPair[R1, R2], opts ...DelayOption) powerfunc.FuncPair[R1, R2] {
+	d := newDelay(latency, opts)
+	return func() (R1, R2) {
+		_ = d.wait(context.Background())
+		return f()
+	}
+}
+
+// GateFuncPair returns a FuncPair blocking until g lets the call through
+// before calling f.
+func GateFuncPair[R1, R2 any](g *Gate, f powerfunc.FuncPair[R1, R2]) powerfunc.FuncPair[R1, R2] {
+	return func() (R1, R2) {
+		_ = g.wait(context.Background())
+		return f()
+	}
+}
@@ -0,0 +1,71 @@
+// Code generated by powerfunc/generator from fakes.go.tmpl. DO NOT EDIT.
+
+package powerfunctest
+
+import (
+	"context"
+
+	"github.com/jonathanmontane/powerfunc"
+)
+
+// StubFuncPairResult returns a FuncPairResult returning the outcomes in order, one per
+// call. The last outcome is repeated once they are exhausted, and zero values
+// are returned if there are none.
+func StubFuncPairResult[R1, R2 any](outcomes ...PairResult[R1, R2]) powerfunc.FuncPairResult[R1, R2] {
+	s := &script{}
+	return func() (R1, R2, error) {
+		var out PairResult[R1, R2]
+		if i := s.next(len(outcomes)); i >= 0 {
+			out = outcomes[i]
+		}
+		return out.First, out.Second, out.Err
+	}
+}
+
+// SpyFuncPairResult returns a FuncPairResult recording the calls of f, along with the
+// Spy holding them.
+func SpyFuncPairResult[R1, R2 any](f powerfunc.FuncPairResult[R1, R2]) (powerfunc.FuncPairResult[R1, R2], *Spy) {
+	s := &Spy{}
+	return func() (R1, R2, error) {
+		call := s.begin(context.Background())
+		panicked := true
+		defer func() {
+			s.end(call, panicked)
+		}()
+		r0, r1, err := f()
+		panicked = false
+		s.fail(call, err)
+		return r0, r1, err
+	}, s
+}
+
+// FailNFuncPairResult returns a FuncPairResult returning err for the first n calls,
+// then calling f.
+func FailNFuncPairResult[R1, R2 any](n int, err error, f powerfunc.FuncPairResult[R1, R2]) powerfunc.FuncPairResult[R1, R2] {
+	fl := &failer{n: int64(n)}
+	return func() (R1, R2, error) {
+		if fl.fail() {
+			return *new(R1), *new(R2), err
+		}
+		return f()
+	}
+}
+
+// DelayFuncPairResult returns a FuncPairResult waiting for latency before calling f.
+// The options set the clock measuring the latency, see DelayClock.
+func DelayFuncPairResult[R1, R2 any](latency Latency, f powerfunc.FuncPairResult[R1, R2], opts ...DelayOption) powerfunc.FuncPairResult[R1, R2] {
+	d := newDelay(latency, opts)
+	return func() (R1, R2, error) {
+		_ = d.wait(context.Background())
+		return f()
+	}
+}
+
+// GateFuncPairResult returns a FuncPairResult blocking until g lets the call through
+// before calling f.
+func GateFuncPairResult[R1, R2 any](g *Gate, f powerfunc.FuncPairResult[R1, R2]) powerfunc.FuncPairResult[R1, R2] {
+	return func() (R1, R2, error) {
+		_ = g.wait(context.Background())
+		return f()
+	}
+}
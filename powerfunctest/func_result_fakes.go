@@ -0,0 +1,71 @@
+// Code generated by powerfunc/generator from fakes.go.tmpl. DO NOT EDIT.
+
+package powerfunctest
+
+import (
+	"context"
+
+	"github.com/jonathanmontane/powerfunc"
+)
+
+// StubFuncResult returns a FuncResult returning the outcomes in order, one per
+// call. The last outcome is repeated once they are exhausted, and zero values
+// are returned if there are none.
+func StubFuncResult[R any](outcomes ...Result[R]) powerfunc.FuncResult[R] {
+	s := &script{}
+	return func() (R, error) {
+		var out Result[R]
+		if i := s.next(len(outcomes)); i >= 0 {
+			out = outcomes[i]
+		}
+		return out.Value, out.Err
+	}
+}
+
+// SpyFuncResult returns a FuncResult recording the calls of f, along with the
+// Spy holding them.
+func SpyFuncResult[R any](f powerfunc.FuncResult[R]) (powerfunc.FuncResult[R], *Spy) {
+	s := &Spy{}
+	return func() (R, error) {
+		call := s.begin(context.Background())
+		panicked := true
+		defer func() {
+			s.end(call, panicked)
+		}()
+		r0, err := f()
+		panicked = false
+		s.fail(call, err)
+		return r0, err
+	}, s
+}
+
+// FailNFuncResult returns a FuncResult returning err for the first n calls,
+// then calling f.
+func FailNFuncResult[R any](n int, err error, f powerfunc.FuncResult[R]) powerfunc.FuncResult[R] {
+	fl := &failer{n: int64(n)}
+	return func() (R, error) {
+		if fl.fail() {
+			return *new(R), err
+		}
+		return f()
+	}
+}
+
+// DelayFuncResult returns a FuncResult waiting for latency before calling f.
+// The options set the clock measuring the latency, see DelayClock.
+func DelayFuncResult[R any](latency Latency, f powerfunc.FuncResult[R], opts ...DelayOption) powerfunc.FuncResult[R] {
+	d := newDelay(latency, opts)
+	return func() (R, error) {
+		_ = d.wait(context.Background())
+		return f()
+	}
+}
+
+// GateFuncResult returns a FuncResult blocking until g lets the call through
+// before calling f.
+func GateFuncResult[R any](g *Gate, f powerfunc.FuncResult[R]) powerfunc.FuncResult[R] {
+	return func() (R, error) {
+		_ = g.wait(context.Background())
+		return f()
+	}
+}
@@ -0,0 +1,58 @@
+// Code generated by powerfunc/generator from fakes.go.tmpl. DO NOT EDIT.
+
+package powerfunctest
+
+import (
+	"context"
+
+	"github.com/jonathanmontane/powerfunc"
+)
+
+// StubFuncValue returns a FuncValue returning the outcomes in order, one per
+// call. The last outcome is repeated once they are exhausted, and zero values
+// are returned if there are none.
+func StubFuncValue[R any](outcomes ...R) powerfunc.FuncValue[R] {
+	s := &script{}
+	return func() R {
+		var out R
+		if i := s.next(len(outcomes)); i >= 0 {
+			out = outcomes[i]
+		}
+		return out
+	}
+}
+
+// SpyFuncValue returns a FuncValue recording the calls of f, along with the
+// Spy holding them.
+func SpyFuncValue[R any](f powerfunc.FuncValue[R]) (powerfunc.FuncValue[R], *Spy) {
+	s := &Spy{}
+	return func() R {
+		call := s.begin(context.Background())
+		panicked := true
+		defer func() {
+			s.end(call, panicked)
+		}()
+		r0 := f()
+		panicked = false
+		return r0
+	}, s
+}
+
+// DelayFuncValue returns a FuncValue waiting for latency before calling f.
+// The options set the clock measuring the latency, see DelayClock.
+func DelayFuncValue[R any](latency Latency, f powerfunc.FuncValue[R], opts ...DelayOption) powerfunc.FuncValue[R] {
+	d := newDelay(latency, opts)
+	return func() R {
+		_ = d.wait(context.Background())
+		return f()
+	}
+}
+
+// GateFuncValue returns a FuncValue blocking until g lets the call through
+// before calling f.
+func GateFuncValue[R any](g *Gate, f powerfunc.FuncValue[R]) powerfunc.FuncValue[R] {
+	return func() R {
+		_ = g.wait(context.Background())
+		return f()
+	}
+}
@@ -0,0 +1,71 @@
+// Code generated by powerfunc/generator from fakes.go.tmpl. DO NOT EDIT.
+
+package powerfunctest
+
+import (
+	"context"
+
+	"github.com/jonathanmontane/powerfunc"
+)
+
+// StubFuncVariadicError returns a FuncVariadicError returning the outcomes in order, one per
+// call. The last outcome is repeated once they are exhausted, and zero values
+// are returned if there are none.
+func StubFuncVariadicError[V any](outcomes ...error) powerfunc.FuncVariadicError[V] {
+	s := &script{}
+	return func(xs ...V) error {
+		var out error
+		if i := s.next(len(outcomes)); i >= 0 {
+			out = outcomes[i]
+		}
+		return out
+	}
+}
+
+// SpyFuncVariadicError returns a FuncVariadicError recording the calls of f, along with the
+// Spy holding them.
+func SpyFuncVariadicError[V any](f powerfunc.FuncVariadicError[V]) (powerfunc.FuncVariadicError[V], *Spy) {
+	s := &Spy{}
+	return func(xs ...V) error {
+		call := s.begin(context.Background(), xs)
+		panicked := true
+		defer func() {
+			s.end(call, panicked)
+		}()
+		err := f(xs...)
+		panicked = false
+		s.fail(call, err)
+		return err
+	}, s
+}
+
+// FailNFuncVariadicError returns a FuncVariadicError returning err for the first n calls,
+// then calling f.
+func FailNFuncVariadicError[V any](n int, err error, f powerfunc.FuncVariadicError[V]) powerfunc.FuncVariadicError[V] {
+	fl := &failer{n: int64(n)}
+	return func(xs ...V) error {
+		if fl.fail() {
+			return err
+		}
+		return f(xs...)
+	}
+}
+
+// DelayFuncVariadicError returns a FuncVariadicError waiting for latency before calling f.
+// The options set the clock measuring the latency, see DelayClock.
+func DelayFuncVariadicError[V any](latency Latency, f powerfunc.FuncVariadicError[V], opts ...DelayOption) powerfunc.FuncVariadicError[V] {
+	d := newDelay(latency, opts)
+	return func(xs ...V) error {
+		_ = d.wait(context.Background())
+		return f(xs...)
+	}
+}
+
+// GateFuncVariadicError returns a FuncVariadicError blocking until g lets the call through
+// before calling f.
+func GateFuncVariadicError[V any](g *Gate, f powerfunc.FuncVariadicError[V]) powerfunc.FuncVariadicError[V] {
+	return func(xs ...V) error {
+		_ = g.wait(context.Background())
+		return f(xs...)
+	}
+}
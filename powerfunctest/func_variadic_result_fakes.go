@@ -0,0 +1,71 @@
+// Code generated by powerfunc/generator from fakes.go.tmpl. DO NOT EDIT.
+
+package powerfunctest
+
+import (
+	"context"
+
+	"github.com/jonathanmontane/powerfunc"
+)
+
+// StubFuncVariadicResult returns a FuncVariadicResult returning the outcomes in order, one per
+// call. The last outcome is repeated once they are exhausted, and zero values
+// are returned if there are none.
+func StubFuncVariadicResult[R, V any](outcomes ...Result[R]) powerfunc.FuncVariadicResult[R, V] {
+	s := &script{}
+	return func(xs ...V) (R, error) {
+		var out Result[R]
+		if i := s.next(len(outcomes)); i >= 0 {
+			out = outcomes[i]
+		}
+		return out.Value, out.Err
+	}
+}
+
+// SpyFuncVariadicResult returns a FuncVariadicResult recording the calls of f, along with the
+// Spy holding them.
+func SpyFuncVariadicResult[R, V any](f powerfunc.FuncVariadicResult[R, V]) (powerfunc.FuncVariadicResult[R, V], *Spy) {
+	s := &Spy{}
+	return func(xs ...V) (R, error) {
+		call := s.begin(context.Background(), xs)
+		panicked := true
+		defer func() {
+			s.end(call, panicked)
+		}()
+		r0, err := f(xs...)
+		panicked = false
+		s.fail(call, err)
+		return r0, err
+	}, s
+}
+
+// FailNFuncVariadicResult returns a FuncVariadicResult returning err for the first n calls,
+// then calling f.
+func FailNFuncVariadicResult[R, V any](n int, err error, f powerfunc.FuncVariadicResult[R, V]) powerfunc.FuncVariadicResult[R, V] {
+	fl := &failer{n: int64(n)}
+	return func(xs ...V) (R, error) {
+		if fl.fail() {
+			return *new(R), err
+		}
+		return f(xs...)
+	}
+}
+
+// DelayFuncVariadicResult returns a FuncVariadicResult waiting for latency before calling f.
+// The options set the clock measuring the latency, see DelayClock.
+func DelayFuncVariadicResult[R, V any](latency Latency, f powerfunc.FuncVariadicResult[R, V], opts ...DelayOption) powerfunc.FuncVariadicResult[R, V] {
+	d := newDelay(latency, opts)
+	return func(xs ...V) (R, error) {
+		_ = d.wait(context.Background())
+		return f(xs...)
+	}
+}
+
+// GateFuncVariadicResult returns a FuncVariadicResult blocking until g lets the call through
+// before calling f.
+func GateFuncVariadicResult[R, V any](g *Gate, f powerfunc.FuncVariadicResult[R, V]) powerfunc.FuncVariadicResult[R, V] {
+	return func(xs ...V) (R, error) {
+		_ = g.wait(context.Background())
+		return f(xs...)
+	}
+}
@@ -0,0 +1,118 @@
+package powerfunctest
+
+import (
+	"context"
+	"sync"
+)
+
+// Gate blocks the calls of gated functions until they are let through, to
+// control the interleaving of concurrent calls in tests.
+// A Gate is closed when created.
+type Gate struct {
+	mu      sync.Mutex
+	open    bool
+	tokens  int
+	waiting int
+	passed  int
+	// changed is closed and replaced every time the state of the gate
+	// changes.
+	changed chan struct{}
+}
+
+// NewGate returns a closed Gate.
+func NewGate() *Gate {
+	return &Gate{changed: make(chan struct{})}
+}
+
+// Open lets every blocked and future call through, until Close is called.
+func (g *Gate) Open() {
+	g.mu.Lock()
+	defer g.mu.Unlock()
+	g.open = true
+	g.notify()
+}
+
+// Close makes future calls block again. Calls let through by Release are
+// not revoked.
+func (g *Gate) Close() {
+	g.mu.Lock()
+	defer g.mu.Unlock()
+	g.open = false
+	g.notify()
+}
+
+// Release lets n calls through, blocked or future ones.
+func (g *Gate) Release(n int) {
+	g.mu.Lock()
+	defer g.mu.Unlock()
+	g.tokens += n
+	g.notify()
+}
+
+// Waiting returns the number of calls currently blocked.
+func (g *Gate) Waiting() int {
+	g.mu.Lock()
+	defer g.mu.Unlock()
+	return g.waiting
+}
+
+// Passed returns the number of calls let through so far.
+func (g *Gate) Passed() int {
+	g.mu.Lock()
+	defer g.mu.Unlock()
+	return g.passed
+}
+
+// WaitFor blocks until at least n calls are blocked, or ctx is done.
+func (g *Gate) WaitFor(ctx context.Context, n int) error {
+	g.mu.Lock()
+	for g.waiting < n {
+		changed := g.changed
+		g.mu.Unlock()
+		select {
+		case <-changed:
+		case <-ctx.Done():
+			return ctx.Err()
+		}
+		g.mu.Lock()
+	}
+	g.mu.Unlock()
+	return nil
+}
+
+// wait blocks until the gate lets the call through, or ctx is done.
+func (g *Gate) wait(ctx context.Context) error {
+	g.mu.Lock()
+	defer g.mu.Unlock()
+
+	g.waiting++
+	g.notify()
+	defer func() {
+		g.waiting--
+		g.notify()
+	}()
+
+	for {
+		if g.open || g.tokens > 0 {
+			if !g.open {
+				g.tokens--
+			}
+			g.passed++
+			return nil
+		}
+		changed := g.changed
+		g.mu.Unlock()
+		select {
+		case <-changed:
+			g.mu.Lock()
+		case <-ctx.Done():
+			g.mu.Lock()
+			return ctx.Err()
+		}
+	}
+}
+
+func (g *Gate) notify() {
+	close(g.changed)
+	g.changed = make(chan struct{})
+}
@@ -0,0 +1,79 @@
+package powerfunctest
+
+import (
+	"context"
+	"math/rand"
+	"sync/atomic"
+	"time"
+
+	"github.com/jonathanmontane/powerfunc"
+)
+
+// Latency returns the latency to inject before a call. call starts at 1.
+type Latency func(call int) time.Duration
+
+// Fixed returns a Latency of d for every call.
+func Fixed(d time.Duration) Latency {
+	return func(int) time.Duration {
+		return d
+	}
+}
+
+// Uniform returns a random Latency in [min, max).
+func Uniform(min, max time.Duration) Latency {
+	return func(int) time.Duration {
+		if max <= min {
+			return min
+		}
+		return min + time.Duration(rand.Int63n(int64(max-min)))
+	}
+}
+
+// Sequence returns the latencies ds in order, one per call, repeating the
+// last one once exhausted.
+func Sequence(ds ...time.Duration) Latency {
+	return func(call int) time.Duration {
+		if len(ds) == 0 {
+			return 0
+		}
+		return ds[min(call, len(ds))-1]
+	}
+}
+
+// DelayOption configures a function returned by a Delay function.
+type DelayOption func(d *delay)
+
+// DelayClock sets the clock measuring the latency, e.g. a FakeClock.
+// Defaults to powerfunc.RealClock.
+func DelayClock(clock powerfunc.Clock) DelayOption {
+	return func(d *delay) {
+		d.clock = clock
+	}
+}
+
+type delay struct {
+	latency Latency
+	clock   powerfunc.Clock
+	calls   atomic.Int64
+}
+
+func newDelay(latency Latency, opts []DelayOption) *delay {
+	d := &delay{latency: latency, clock: powerfunc.RealClock()}
+	for _, opt := range opts {
+		opt(d)
+	}
+	return d
+}
+
+// wait waits for the latency of the next call, or until ctx is done.
+func (d *delay) wait(ctx context.Context) error {
+	call := int(d.calls.Add(1))
+	timer := d.clock.NewTimer(d.latency(call))
+	defer timer.Stop()
+	select {
+	case <-timer.C():
+		return nil
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
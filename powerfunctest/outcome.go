@@ -0,0 +1,78 @@
+package powerfunctest
+
+import "sync/atomic"
+
+// Result is the scripted outcome of a stubbed function returning a value and
+// an error.
+type Result[R any] struct {
+	Value R
+	Err   error
+}
+
+// Return returns a successful Result.
+func Return[R any](v R) Result[R] {
+	return Result[R]{Value: v}
+}
+
+// Fail returns a failed Result.
+func Fail[R any](err error) Result[R] {
+	return Result[R]{Err: err}
+}
+
+// Pair is the scripted outcome of a stubbed function returning two values.
+type Pair[R1, R2 any] struct {
+	First  R1
+	Second R2
+}
+
+// PairResult is the scripted outcome of a stubbed function returning two
+// values and an error.
+type PairResult[R1, R2 any] struct {
+	First  R1
+	Second R2
+	Err    error
+}
+
+// Option is the scripted outcome of a stubbed function returning a value and
+// a boolean.
+type Option[R any] struct {
+	Value R
+	Ok    bool
+}
+
+// Some returns an Option holding v.
+func Some[R any](v R) Option[R] {
+	return Option[R]{Value: v, Ok: true}
+}
+
+// None returns an empty Option.
+func None[R any]() Option[R] {
+	return Option[R]{}
+}
+
+// script picks the outcome of every call of a stub.
+type script struct {
+	calls atomic.Int64
+}
+
+// next returns the index of the outcome of the next call among n outcomes,
+// or -1 if there are none. The last outcome is repeated once the script is
+// exhausted.
+func (s *script) next(n int) int {
+	call := int(s.calls.Add(1)) - 1
+	if n == 0 {
+		return -1
+	}
+	return min(call, n-1)
+}
+
+// failer counts the calls of a FailN function.
+type failer struct {
+	n     int64
+	calls atomic.Int64
+}
+
+// fail reports whether the next call should fail.
+func (f *failer) fail() bool {
+	return f.calls.Add(1) <= f.n
+}
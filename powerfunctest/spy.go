@@ -0,0 +1,187 @@
+package powerfunctest
+
+import (
+	"context"
+	"fmt"
+	"reflect"
+	"sync"
+	"testing"
+	"time"
+)
+
+// Call is a call recorded by a Spy.
+type Call struct {
+	// Args are the arguments of the call, without the context. The variadic
+	// arguments are a single slice.
+	Args []any
+	// Deadline is the deadline of the context of the call, if HasDeadline.
+	Deadline    time.Time
+	HasDeadline bool
+	Start       time.Time
+	// Duration is zero while the call is in progress.
+	Duration time.Duration
+	// Err is the error returned by the call, for the functions returning one.
+	Err error
+	// Panicked reports whether the call panicked.
+	Panicked bool
+}
+
+// Spy records the calls of a spied function.
+type Spy struct {
+	mu            sync.Mutex
+	calls         []*Call
+	inFlight      int
+	maxConcurrent int
+}
+
+// Calls returns a copy of the calls recorded so far, in the order they
+// started.
+func (s *Spy) Calls() []Call {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	calls := make([]Call, len(s.calls))
+	for i, call := range s.calls {
+		calls[i] = *call
+	}
+	return calls
+}
+
+// Count returns the number of calls recorded so far.
+func (s *Spy) Count() int {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return len(s.calls)
+}
+
+// MaxConcurrent returns the maximum number of calls that were in progress
+// at the same time.
+func (s *Spy) MaxConcurrent() int {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.maxConcurrent
+}
+
+// Reset forgets the calls recorded so far.
+func (s *Spy) Reset() {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.calls = nil
+	s.maxConcurrent = s.inFlight
+}
+
+// AssertCalls fails the test if the function was not called exactly n
+// times.
+func (s *Spy) AssertCalls(t testing.TB, n int) {
+	t.Helper()
+	if count := s.Count(); count != n {
+		t.Errorf("expected %d calls, got %d", n, count)
+	}
+}
+
+// AssertNotCalled fails the test if the function was called.
+func (s *Spy) AssertNotCalled(t testing.TB) {
+	t.Helper()
+	s.AssertCalls(t, 0)
+}
+
+// AssertCalledWith fails the test if no call had the arguments args,
+// compared with reflect.DeepEqual.
+func (s *Spy) AssertCalledWith(t testing.TB, args ...any) {
+	t.Helper()
+	calls := s.Calls()
+	for _, call := range calls {
+		if reflect.DeepEqual(call.Args, args) {
+			return
+		}
+	}
+	t.Errorf("expected a call with %s, got %s", formatArgs(args), formatCalls(calls))
+}
+
+// AssertNthCalledWith fails the test if the nth call, starting at 0, did
+// not have the arguments args, compared with reflect.DeepEqual.
+func (s *Spy) AssertNthCalledWith(t testing.TB, n int, args ...any) {
+	t.Helper()
+	calls := s.Calls()
+	if n >= len(calls) {
+		t.Errorf("expected call %d with %s, got %d calls", n, formatArgs(args), len(calls))
+		return
+	}
+	if !reflect.DeepEqual(calls[n].Args, args) {
+		t.Errorf("expected call %d with %s, got %s", n, formatArgs(args), formatArgs(calls[n].Args))
+	}
+}
+
+// AssertDeadline fails the test if the context of the nth call, starting
+// at 0, had no deadline or a deadline further than max after the start of
+// the call.
+func (s *Spy) AssertDeadline(t testing.TB, n int, max time.Duration) {
+	t.Helper()
+	calls := s.Calls()
+	if n >= len(calls) {
+		t.Errorf("expected call %d with a deadline, got %d calls", n, len(calls))
+		return
+	}
+	call := calls[n]
+	if !call.HasDeadline {
+		t.Errorf("expected call %d with a deadline, got none", n)
+		return
+	}
+	if d := call.Deadline.Sub(call.Start); d > max {
+		t.Errorf("expected call %d with a deadline within %s, got %s", n, max, d)
+	}
+}
+
+// AssertMaxConcurrent fails the test if more than n calls were in progress
+// at the same time.
+func (s *Spy) AssertMaxConcurrent(t testing.TB, n int) {
+	t.Helper()
+	if max := s.MaxConcurrent(); max > n {
+		t.Errorf("expected at most %d concurrent calls, got %d", n, max)
+	}
+}
+
+// begin records the start of a call.
+func (s *Spy) begin(ctx context.Context, args ...any) *Call {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	call := &Call{Args: args, Start: time.Now()}
+	call.Deadline, call.HasDeadline = ctx.Deadline()
+	s.calls = append(s.calls, call)
+	s.inFlight++
+	s.maxConcurrent = max(s.maxConcurrent, s.inFlight)
+	return call
+}
+
+// fail records the error returned by call.
+func (s *Spy) fail(call *Call, err error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	call.Err = err
+}
+
+// end records the end of call. It must be deferred, to record panics.
+func (s *Spy) end(call *Call, panicked bool) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.inFlight--
+	call.Duration = time.Since(call.Start)
+	call.Panicked = panicked
+}
+
+func formatArgs(args []any) string {
+	return fmt.Sprintf("%#v", args)
+}
+
+func formatCalls(calls []Call) string {
+	if len(calls) == 0 {
+		return "no calls"
+	}
+	s := ""
+	for i, call := range calls {
+		if i > 0 {
+			s += ", "
+		}
+		s += formatArgs(call.Args)
+	}
+	return s
+}
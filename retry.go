@@ -3,13 +3,188 @@ package powerfunc
 import (
 	"context"
 	"errors"
+	"fmt"
+	"time"
 )
 
-func RetryImmediately(nbAttempts int) func(int, error) bool {
+// RetryImmediately returns a RetryPolicy allowing nbAttempts attempts
+// and never retrying the errors of the context. Without RetryBackoff, the
+// attempts only wait for the delays asked by a RetryAfterError.
+func RetryImmediately(nbAttempts int) RetryPolicy {
 	return func(attempts int, err error) bool {
-		if errors.Is(err, context.Canceled) || errors.Is(err, context.DeadlineExceeded) {
+		if !errors.Is(err, ErrAttemptTimeout) && (errors.Is(err, context.Canceled) || errors.Is(err, context.DeadlineExceeded)) {
 			return false
 		}
 		return attempts < nbAttempts
 	}
 }
+
+// ErrAttemptTimeout is the error of an attempt that exceeded the timeout set
+// by RetryAttemptTimeout. Unlike the error of the context, it is retried by
+// RetryImmediately.
+var ErrAttemptTimeout = errors.New("attempt timed out")
+
+// attemptTimeoutError is the error of an attempt that failed once its own
+// deadline was exceeded. It matches ErrAttemptTimeout and wraps the error of
+// the attempt, unless that error is just the one of the attempt context.
+type attemptTimeoutError struct {
+	timeout time.Duration
+	err     error
+}
+
+func (e *attemptTimeoutError) Error() string {
+	return fmt.Sprintf("%v after %s: %v", ErrAttemptTimeout, e.timeout, e.err)
+}
+
+func (e *attemptTimeoutError) Is(target error) bool {
+	return target == ErrAttemptTimeout
+}
+
+func (e *attemptTimeoutError) Unwrap() error {
+	if e.err == context.DeadlineExceeded {
+		return nil
+	}
+	return e.err
+}
+
+// RetryError is returned by a Retry function when the last attempt failed.
+type RetryError struct {
+	Attempts int
+	// Errors are the errors of every attempt, in order. The error of the
+	// context is appended if it was done while waiting for the next
+	// attempt.
+	Errors []error
+	// Err joins Errors with errors.Join, so that errors.Is and errors.As
+	// reach the error of any attempt.
+	Err     error
+	Elapsed time.Duration
+}
+
+func (e *RetryError) Error() string {
+	return fmt.Sprintf("failed after %d attempts in %s: %v", e.Attempts, e.Elapsed, e.Errors[len(e.Errors)-1])
+}
+
+func (e *RetryError) Unwrap() error {
+	return e.Err
+}
+
+// RetryOption configures a Retry function.
+type RetryOption func(r *retryConfig)
+
+type retryConfig struct {
+	backoff func(attempt int) time.Duration
+	onRetry func(attempt int, err error, nextDelay time.Duration)
+	clock   Clock
+	timeout time.Duration
+}
+
+// RetryBackoff sets the delay before the next attempt. attempt is the
+// number of the attempt that failed, starting at 1.
+// By default, the function is retried immediately.
+// When the error of the attempt is a RetryAfterError asking for a longer
+// delay, that delay is used instead.
+func RetryBackoff(backoff func(attempt int) time.Duration) RetryOption {
+	return func(r *retryConfig) {
+		r.backoff = backoff
+	}
+}
+
+// RetryClock sets the clock of the backoff. Defaults to RealClock.
+func RetryClock(clock Clock) RetryOption {
+	return func(r *retryConfig) {
+		r.clock = clock
+	}
+}
+
+// RetryAttemptTimeout gives every attempt its own deadline, timeout after
+// its start, derived from the context of the call so that the overall
+// deadline of the caller still applies.
+// An attempt failing once its own deadline is exceeded returns an error
+// matching ErrAttemptTimeout, and wrapping the error of the attempt, instead
+// of context.DeadlineExceeded, so that tryAgain can retry it.
+// Only applies to the functions taking a context.
+func RetryAttemptTimeout(timeout time.Duration) RetryOption {
+	return func(r *retryConfig) {
+		r.timeout = timeout
+	}
+}
+
+// OnRetry registers a hook invoked before every retry, with the number of
+// the attempt that failed, its error and the delay before the next attempt.
+func OnRetry(hook func(attempt int, err error, nextDelay time.Duration)) RetryOption {
+	return func(r *retryConfig) {
+		r.onRetry = hook
+	}
+}
+
+// retry calls call until it returns a nil error or tryAgain returns false,
+// waiting for the backoff between attempts, and returns a *RetryError if the
+// last attempt failed.
+// call gets the context of the attempt, derived from ctx.
+func retry(ctx context.Context, tryAgain func(attempts int, err error) bool, opts []RetryOption, call func(ctx context.Context) error) error {
+	cfg := retryConfig{backoff: ConstantBackoff(0), clock: RealClock()}
+	for _, opt := range opts {
+		opt(&cfg)
+	}
+
+	start := cfg.clock.Now()
+	var errs []error
+	fail := func(attempts int) error {
+		return &RetryError{
+			Attempts: attempts,
+			Errors:   errs,
+			Err:      errors.Join(errs...),
+			Elapsed:  cfg.clock.Since(start),
+		}
+	}
+
+	for attempt := 1; ; attempt++ {
+		err := cfg.attempt(ctx, call)
+		if err == nil {
+			return nil
+		}
+		errs = append(errs, err)
+		if !tryAgain(attempt, err) {
+			return fail(attempt)
+		}
+
+		delay := cfg.backoff(attempt)
+		if after, ok := RetryAfter(err); ok && after > delay {
+			delay = after
+		}
+		if cfg.onRetry != nil {
+			cfg.onRetry(attempt, err, delay)
+		}
+		if !sleepUntil(ctx, cfg.clock, cfg.clock.Now().Add(delay)) {
+			errs = append(errs, ctx.Err())
+			return fail(attempt)
+		}
+	}
+}
+
+// attempt calls call with the context of an attempt.
+func (cfg *retryConfig) attempt(ctx context.Context, call func(ctx context.Context) error) error {
+	if cfg.timeout <= 0 {
+		return call(ctx)
+	}
+
+	attemptCtx, cancel := context.WithTimeout(ctx, cfg.timeout)
+	defer cancel()
+	err := call(attemptCtx)
+	if err != nil && ctx.Err() == nil && errors.Is(attemptCtx.Err(), context.DeadlineExceeded) {
+		return &attemptTimeoutError{timeout: cfg.timeout, err: err}
+	}
+	return err
+}
+
+// retryNoContext is retry for the functions without a context. As call
+// cannot observe the deadline of an attempt, RetryAttemptTimeout is ignored.
+func retryNoContext(tryAgain func(attempts int, err error) bool, opts []RetryOption, call func() error) error {
+	opts = append(opts[:len(opts):len(opts)], RetryAttemptTimeout(0))
+	return retry(context.Background(), tryAgain, opts, func(context.Context) error {
+		return call()
+	})
+}
+
+// errNotOk is the error of the attempts of a FuncOk that returned false.
+var errNotOk = errors.New("not ok")
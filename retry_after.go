@@ -0,0 +1,92 @@
+package powerfunc
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// RetryAfterError is an error carrying the delay to wait for before trying
+// again, e.g. from the Retry-After header of a 429 or 503 response.
+// The Retry functions wait for that delay when it is longer than their
+// backoff.
+type RetryAfterError interface {
+	error
+	RetryAfter() time.Duration
+}
+
+// WithRetryAfter wraps err in a RetryAfterError asking to wait for d before
+// trying again. Returns nil if err is nil.
+func WithRetryAfter(err error, d time.Duration) error {
+	if err == nil {
+		return nil
+	}
+	return &retryAfterError{err: err, after: d}
+}
+
+type retryAfterError struct {
+	err   error
+	after time.Duration
+}
+
+func (e *retryAfterError) Error() string {
+	return fmt.Sprintf("%v (retry after %s)", e.err, e.after)
+}
+
+func (e *retryAfterError) Unwrap() error {
+	return e.err
+}
+
+func (e *retryAfterError) RetryAfter() time.Duration {
+	return e.after
+}
+
+// RetryAfter returns the delay of the first RetryAfterError in the tree of
+// err, if any.
+func RetryAfter(err error) (time.Duration, bool) {
+	var ra RetryAfterError
+	if !errors.As(err, &ra) {
+		return 0, false
+	}
+	return ra.RetryAfter(), true
+}
+
+// ParseRetryAfter parses the value of a Retry-After header, either a number
+// of seconds or an HTTP date, into the delay to wait for from now.
+func ParseRetryAfter(value string, now time.Time) (time.Duration, bool) {
+	value = strings.TrimSpace(value)
+	if seconds, err := strconv.Atoi(value); err == nil {
+		if seconds < 0 {
+			return 0, false
+		}
+		return time.Duration(seconds) * time.Second, true
+	}
+	t, err := http.ParseTime(value)
+	if err != nil {
+		return 0, false
+	}
+	return max(t.Sub(now), 0), true
+}
+
+// RetryAfterResponse wraps err in a RetryAfterError with the delay of the
+// Retry-After header of resp, if it has a valid one. Otherwise, err is
+// returned as is.
+func RetryAfterResponse(err error, resp *http.Response) error {
+	return RetryAfterResponseWithClock(err, resp, RealClock())
+}
+
+// RetryAfterResponseWithClock is like RetryAfterResponse, but computes the
+// delay of an HTTP-date header from the current time of clock.
+func RetryAfterResponseWithClock(err error, resp *http.Response, clock Clock) error {
+	if resp == nil {
+		return err
+	}
+	d, ok := ParseRetryAfter(resp.Header.Get("Retry-After"), clock.Now())
+	if !ok {
+		return err
+	}
+	return WithRetryAfter(err, d)
+}
@@ -0,0 +1,131 @@
+package powerfunc
+
+import (
+	"context"
+	"errors"
+	"io"
+	"net"
+	"syscall"
+)
+
+// RetryPolicy is a tryAgain function of the Retry methods, deciding whether
+// to try again after the attempts-th attempt failed with err.
+// Policies are combined with their And, Or and Not methods, e.g.
+//
+//	f.Retry(MaxAttempts(5).And(RetryTransient().Or(RetryOn(ErrThrottled))))
+type RetryPolicy func(attempts int, err error) bool
+
+// RetryIf retries the errors for which pred returns true.
+func RetryIf(pred func(err error) bool) RetryPolicy {
+	return func(_ int, err error) bool {
+		return pred(err)
+	}
+}
+
+// RetryUnless retries the errors for which pred returns false.
+func RetryUnless(pred func(err error) bool) RetryPolicy {
+	return func(_ int, err error) bool {
+		return !pred(err)
+	}
+}
+
+// RetryOn retries the errors matching any of targets with errors.Is.
+func RetryOn(targets ...error) RetryPolicy {
+	return func(_ int, err error) bool {
+		for _, target := range targets {
+			if errors.Is(err, target) {
+				return true
+			}
+		}
+		return false
+	}
+}
+
+// RetryOnType retries the errors with an error of type E in their tree,
+// matched with errors.As.
+func RetryOnType[E error]() RetryPolicy {
+	return func(_ int, err error) bool {
+		var target E
+		return errors.As(err, &target)
+	}
+}
+
+// RetryTransient retries the errors classified as transient by IsTransient.
+func RetryTransient() RetryPolicy {
+	return RetryIf(IsTransient)
+}
+
+// MaxAttempts allows at most n attempts, whatever the error.
+func MaxAttempts(n int) RetryPolicy {
+	return func(attempts int, _ error) bool {
+		return attempts < n
+	}
+}
+
+// And retries when p and all the others do.
+func (p RetryPolicy) And(others ...RetryPolicy) RetryPolicy {
+	return func(attempts int, err error) bool {
+		if !p(attempts, err) {
+			return false
+		}
+		for _, other := range others {
+			if !other(attempts, err) {
+				return false
+			}
+		}
+		return true
+	}
+}
+
+// Or retries when p or any of the others does.
+func (p RetryPolicy) Or(others ...RetryPolicy) RetryPolicy {
+	return func(attempts int, err error) bool {
+		if p(attempts, err) {
+			return true
+		}
+		for _, other := range others {
+			if other(attempts, err) {
+				return true
+			}
+		}
+		return false
+	}
+}
+
+// Not retries when p does not.
+func (p RetryPolicy) Not() RetryPolicy {
+	return func(attempts int, err error) bool {
+		return !p(attempts, err)
+	}
+}
+
+// IsTransient reports whether err is likely to go away if the operation is
+// tried again: network timeouts, reset or refused connections, truncated
+// reads, attempts that exceeded RetryAttemptTimeout, ErrTimeout, and errors
+// with a Temporary() method returning true.
+// The errors of the context are never transient, as retrying with the same
+// context fails the same way.
+func IsTransient(err error) bool {
+	if err == nil {
+		return false
+	}
+	if errors.Is(err, ErrAttemptTimeout) {
+		return true
+	}
+	if errors.Is(err, context.Canceled) || errors.Is(err, context.DeadlineExceeded) {
+		return false
+	}
+	if errors.Is(err, syscall.ECONNRESET) ||
+		errors.Is(err, syscall.ECONNREFUSED) ||
+		errors.Is(err, io.ErrUnexpectedEOF) ||
+		errors.Is(err, ErrTimeout) {
+		return true
+	}
+
+	var netErr net.Error
+	if errors.As(err, &netErr) && netErr.Timeout() {
+		return true
+	}
+	var temporary interface{ Temporary() bool }
+	return errors.As(err, &temporary) && temporary.Temporary()
+}
@@ -0,0 +1,314 @@
+package powerfunc
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"sync"
+	"time"
+)
+
+// MissedRunPolicy decides what a Scheduler does with the runs of a job it
+// could not start on time, for instance because the process was suspended.
+type MissedRunPolicy int
+
+const (
+	// MissedRunOnce runs the job once, however many runs were missed.
+	MissedRunOnce MissedRunPolicy = iota
+	// MissedRunSkip drops the missed runs and waits for the next one.
+	MissedRunSkip
+	// MissedRunAll runs the job once for every missed run.
+	MissedRunAll
+)
+
+// SchedulerOption configures a Scheduler.
+type SchedulerOption func(s *Scheduler)
+
+// SchedulerClock sets the clock of the Scheduler. Defaults to RealClock.
+func SchedulerClock(clock Clock) SchedulerOption {
+	return func(s *Scheduler) {
+		s.clock = clock
+	}
+}
+
+// SchedulerLocation sets the time zone in which the cron expressions without
+// a `CRON_TZ=` prefix are evaluated. Defaults to time.Local.
+func SchedulerLocation(loc *time.Location) SchedulerOption {
+	return func(s *Scheduler) {
+		s.loc = loc
+	}
+}
+
+// SchedulerOnError registers a callback invoked with the name of the job and
+// the error every time a job fails. Panics are reported as a *PanicError.
+func SchedulerOnError(onError func(name string, err error)) SchedulerOption {
+	return func(s *Scheduler) {
+		s.onError = onError
+	}
+}
+
+// SchedulerMissedRunGrace sets how late a run can start before being
+// considered missed. Defaults to one second.
+func SchedulerMissedRunGrace(grace time.Duration) SchedulerOption {
+	return func(s *Scheduler) {
+		s.grace = grace
+	}
+}
+
+// JobOption configures a job registered on a Scheduler.
+type JobOption func(j *schedulerJob)
+
+// JobTimeout bounds every run of the job with CtxFuncError.WithTimeout.
+// When combined with JobRetry, the timeout applies to each attempt instead,
+// with RetryAttemptTimeout, so that the attempts timing out are retried.
+func JobTimeout(timeout time.Duration) JobOption {
+	return func(j *schedulerJob) {
+		j.timeout = timeout
+	}
+}
+
+// JobRetry retries every run of the job with CtxFuncError.Retry.
+func JobRetry(tryAgain func(attempts int, err error) bool, opts ...RetryOption) JobOption {
+	return func(j *schedulerJob) {
+		j.tryAgain = tryAgain
+		j.retryOpts = opts
+	}
+}
+
+// JobAllowOverlap lets a run of the job start while the previous one is
+// still running. By default, such runs are skipped.
+func JobAllowOverlap() JobOption {
+	return func(j *schedulerJob) {
+		j.allowOverlap = true
+	}
+}
+
+// JobMissedRuns sets the MissedRunPolicy of the job.
+// Defaults to MissedRunOnce.
+func JobMissedRuns(policy MissedRunPolicy) JobOption {
+	return func(j *schedulerJob) {
+		j.missed = policy
+	}
+}
+
+type schedulerJob struct {
+	name         string
+	schedule     *CronSchedule
+	f            CtxFuncError
+	timeout      time.Duration
+	tryAgain     func(attempts int, err error) bool
+	retryOpts    []RetryOption
+	allowOverlap bool
+	missed       MissedRunPolicy
+
+	next    time.Time
+	running int
+}
+
+// Scheduler runs named CtxFuncError jobs according to cron expressions.
+type Scheduler struct {
+	clock   Clock
+	loc     *time.Location
+	onError func(name string, err error)
+	grace   time.Duration
+
+	mu   sync.Mutex
+	jobs map[string]*schedulerJob
+	wake chan struct{}
+	wg   sync.WaitGroup
+}
+
+// NewScheduler returns a Scheduler without any job.
+func NewScheduler(opts ...SchedulerOption) *Scheduler {
+	s := &Scheduler{
+		clock: RealClock(),
+		loc:   time.Local,
+		grace: time.Second,
+		jobs:  map[string]*schedulerJob{},
+		wake:  make(chan struct{}, 1),
+	}
+	for _, opt := range opts {
+		opt(s)
+	}
+	return s
+}
+
+// Register adds a job running f according to the cron expression spec.
+// See ParseCron for the supported expressions.
+func (s *Scheduler) Register(name string, spec string, f CtxFuncError, opts ...JobOption) error {
+	schedule, err := ParseCron(spec)
+	if err != nil {
+		return fmt.Errorf("register job %q: %w", name, err)
+	}
+
+	j := &schedulerJob{
+		name:     name,
+		schedule: schedule,
+	}
+	for _, opt := range opts {
+		opt(j)
+	}
+	switch {
+	case j.tryAgain != nil && j.timeout > 0:
+		retryOpts := append(j.retryOpts[:len(j.retryOpts):len(j.retryOpts)], RetryAttemptTimeout(j.timeout))
+		f = f.Retry(j.tryAgain, retryOpts...)
+	case j.tryAgain != nil:
+		f = f.Retry(j.tryAgain, j.retryOpts...)
+	case j.timeout > 0:
+		f = f.WithTimeout(j.timeout)
+	}
+	j.f = f
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if _, ok := s.jobs[name]; ok {
+		return fmt.Errorf("register job %q: already registered", name)
+	}
+	j.next = j.schedule.Next(s.clock.Now().In(s.loc))
+	s.jobs[name] = j
+	s.notify()
+	return nil
+}
+
+// Remove removes a job. A run in progress is not interrupted.
+// Returns false if there is no job with that name.
+func (s *Scheduler) Remove(name string) bool {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	_, ok := s.jobs[name]
+	delete(s.jobs, name)
+	s.notify()
+	return ok
+}
+
+// Next returns the time of the next run of a job.
+func (s *Scheduler) Next(name string) (time.Time, bool) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	j, ok := s.jobs[name]
+	if !ok {
+		return time.Time{}, false
+	}
+	return j.next, true
+}
+
+// Jobs returns the names of the registered jobs, sorted.
+func (s *Scheduler) Jobs() []string {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	names := make([]string, 0, len(s.jobs))
+	for name := range s.jobs {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
+
+// Run runs the jobs when they are due, until ctx is done.
+// The jobs receive ctx, and Run waits for the runs in progress to return
+// before returning.
+func (s *Scheduler) Run(ctx context.Context) error {
+	for {
+		s.mu.Lock()
+		var next time.Time
+		for _, j := range s.jobs {
+			if !j.next.IsZero() && (next.IsZero() || j.next.Before(next)) {
+				next = j.next
+			}
+		}
+		s.mu.Unlock()
+
+		var timer Timer
+		var due <-chan time.Time
+		if !next.IsZero() {
+			timer = s.clock.NewTimer(next.Sub(s.clock.Now()))
+			due = timer.C()
+		}
+
+		select {
+		case <-ctx.Done():
+		case <-s.wake:
+		case <-due:
+			s.startDue(ctx, &s.wg)
+		}
+		if timer != nil {
+			timer.Stop()
+		}
+		if ctx.Err() != nil {
+			s.wg.Wait()
+			return nil
+		}
+	}
+}
+
+// RunPending starts the jobs that are due at the current time of the clock,
+// and waits for them to return.
+// Useful with a fake Clock, to run the jobs deterministically.
+func (s *Scheduler) RunPending(ctx context.Context) {
+	var wg sync.WaitGroup
+	s.startDue(ctx, &wg)
+	wg.Wait()
+}
+
+func (s *Scheduler) notify() {
+	select {
+	case s.wake <- struct{}{}:
+	default:
+	}
+}
+
+func (s *Scheduler) startDue(ctx context.Context, wg *sync.WaitGroup) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	now := s.clock.Now()
+
+	for _, j := range s.jobs {
+		if j.next.IsZero() || j.next.After(now) {
+			continue
+		}
+
+		missed := 0
+		last := j.next
+		for !j.next.IsZero() && !j.next.After(now) {
+			missed++
+			last = j.next
+			j.next = j.schedule.Next(j.next)
+		}
+
+		runs := 0
+		switch j.missed {
+		case MissedRunOnce:
+			runs = 1
+		case MissedRunSkip:
+			if now.Sub(last) <= s.grace {
+				runs = 1
+			}
+		case MissedRunAll:
+			runs = missed
+		}
+		if runs == 0 || (j.running > 0 && !j.allowOverlap) {
+			continue
+		}
+
+		j.running++
+		wg.Add(1)
+		go func(j *schedulerJob, runs int) {
+			defer wg.Done()
+			for i := 0; i < runs && ctx.Err() == nil; i++ {
+				s.runJob(ctx, j)
+			}
+			s.mu.Lock()
+			j.running--
+			s.mu.Unlock()
+		}(j, runs)
+	}
+}
+
+func (s *Scheduler) runJob(ctx context.Context, j *schedulerJob) {
+	err := callProtected(func() error {
+		return j.f(ctx)
+	})
+	if err != nil && s.onError != nil {
+		s.onError(j.name, err)
+	}
+}
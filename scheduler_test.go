@@ -0,0 +1,185 @@
+package powerfunc_test
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/jonathanmontane/powerfunc"
+	"github.com/jonathanmontane/powerfunc/powerfunctest"
+)
+
+var schedulerStart = time.Date(2024, 1, 1, 0, 0, 30, 0, time.UTC)
+
+func newTestScheduler(opts ...powerfunc.SchedulerOption) (*powerfunc.Scheduler, *powerfunctest.FakeClock) {
+	clock := powerfunctest.NewFakeClock(schedulerStart)
+	opts = append([]powerfunc.SchedulerOption{
+		powerfunc.SchedulerClock(clock),
+		powerfunc.SchedulerLocation(time.UTC),
+	}, opts...)
+	return powerfunc.NewScheduler(opts...), clock
+}
+
+func TestSchedulerRun(t *testing.T) {
+	s, clock := newTestScheduler()
+	runs := make(chan time.Time)
+	err := s.Register("tick", "* * * * *", func(ctx context.Context) error {
+		runs <- clock.Now()
+		return nil
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	done := make(chan error)
+	go func() {
+		done <- s.Run(ctx)
+	}()
+
+	for _, want := range []time.Time{
+		time.Date(2024, 1, 1, 0, 1, 0, 0, time.UTC),
+		time.Date(2024, 1, 1, 0, 2, 0, 0, time.UTC),
+	} {
+		clock.BlockUntil(1)
+		go clock.Set(want)
+		if got := <-runs; !got.Equal(want) {
+			t.Errorf("run at %s, want %s", got, want)
+		}
+	}
+
+	cancel()
+	if err := <-done; err != nil {
+		t.Errorf("Run: %v", err)
+	}
+}
+
+func TestSchedulerMissedRuns(t *testing.T) {
+	tests := []struct {
+		policy powerfunc.MissedRunPolicy
+		late   time.Duration
+		want   int32
+	}{
+		{powerfunc.MissedRunOnce, 0, 1},
+		{powerfunc.MissedRunOnce, 5*time.Minute + 30*time.Second, 1},
+		{powerfunc.MissedRunAll, 5 * time.Minute, 6},
+		{powerfunc.MissedRunSkip, 5 * time.Minute, 1},
+		{powerfunc.MissedRunSkip, 5*time.Minute + 30*time.Second, 0},
+	}
+	for _, tt := range tests {
+		s, clock := newTestScheduler()
+		var runs atomic.Int32
+		err := s.Register("job", "* * * * *", func(ctx context.Context) error {
+			runs.Add(1)
+			return nil
+		}, powerfunc.JobMissedRuns(tt.policy))
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		next, _ := s.Next("job")
+		clock.Set(next.Add(tt.late))
+		s.RunPending(context.Background())
+		if got := runs.Load(); got != tt.want {
+			t.Errorf("policy %d, %s late: %d runs, want %d", tt.policy, tt.late, got, tt.want)
+		}
+	}
+}
+
+func TestSchedulerSkipsOverlappingRuns(t *testing.T) {
+	s, clock := newTestScheduler()
+	gate := powerfunctest.NewGate()
+	var runs atomic.Int32
+	err := s.Register("slow", "* * * * *", powerfunctest.GateCtxFuncError(gate, func(ctx context.Context) error {
+		runs.Add(1)
+		return nil
+	}))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var wg sync.WaitGroup
+	wg.Add(1)
+	clock.Advance(time.Minute)
+	go func() {
+		defer wg.Done()
+		s.RunPending(context.Background())
+	}()
+	if err := gate.WaitFor(context.Background(), 1); err != nil {
+		t.Fatal(err)
+	}
+
+	clock.Advance(time.Minute)
+	s.RunPending(context.Background())
+	gate.Open()
+	wg.Wait()
+	if got := runs.Load(); got != 1 {
+		t.Errorf("%d runs, want 1", got)
+	}
+}
+
+func TestSchedulerJobTimeoutWithRetry(t *testing.T) {
+	var errs []error
+	s, clock := newTestScheduler(powerfunc.SchedulerOnError(func(name string, err error) {
+		errs = append(errs, err)
+	}))
+	var attempts atomic.Int32
+	err := s.Register("stuck", "* * * * *", func(ctx context.Context) error {
+		attempts.Add(1)
+		<-ctx.Done()
+		return ctx.Err()
+	}, powerfunc.JobTimeout(10*time.Millisecond), powerfunc.JobRetry(powerfunc.RetryImmediately(3)))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	clock.Advance(time.Minute)
+	s.RunPending(context.Background())
+	if got := attempts.Load(); got != 3 {
+		t.Errorf("%d attempts, want 3", got)
+	}
+	if len(errs) != 1 {
+		t.Fatalf("%d errors, want 1", len(errs))
+	}
+	var retryErr *powerfunc.RetryError
+	if !errors.As(errs[0], &retryErr) || retryErr.Attempts != 3 {
+		t.Errorf("error %v, want a *RetryError after 3 attempts", errs[0])
+	}
+	if !errors.Is(errs[0], powerfunc.ErrAttemptTimeout) {
+		t.Errorf("error %v, want ErrAttemptTimeout", errs[0])
+	}
+}
+
+func TestSchedulerRegister(t *testing.T) {
+	s, _ := newTestScheduler()
+	noop := powerfunc.CtxFuncError(func(ctx context.Context) error { return nil })
+
+	if err := s.Register("b", "0 * * * *", noop); err != nil {
+		t.Fatal(err)
+	}
+	if err := s.Register("a", "@daily", noop); err != nil {
+		t.Fatal(err)
+	}
+	if err := s.Register("a", "@daily", noop); err == nil {
+		t.Error("registering a job twice: expected an error")
+	}
+	if err := s.Register("c", "not cron", noop); err == nil {
+		t.Error("registering an invalid expression: expected an error")
+	}
+
+	if got := s.Jobs(); len(got) != 2 || got[0] != "a" || got[1] != "b" {
+		t.Errorf("Jobs() = %v, want [a b]", got)
+	}
+	if next, ok := s.Next("b"); !ok || !next.Equal(time.Date(2024, 1, 1, 1, 0, 0, 0, time.UTC)) {
+		t.Errorf("Next(b) = %s, %t", next, ok)
+	}
+	if !s.Remove("b") || s.Remove("b") {
+		t.Error("Remove(b) should only succeed once")
+	}
+	if _, ok := s.Next("b"); ok {
+		t.Error("Next(b) after Remove: expected no job")
+	}
+}
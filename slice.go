@@ -0,0 +1,134 @@
+package powerfunc
+
+import (
+	"context"
+	"fmt"
+	"runtime"
+	"sync"
+)
+
+// SliceOption configures MapSlice and ForEach.
+type SliceOption func(s *sliceConfig)
+
+type sliceConfig struct {
+	concurrency int
+	collect     bool
+	progress    func(done, total int)
+}
+
+// SliceConcurrency sets the number of items processed concurrently.
+// Defaults to runtime.GOMAXPROCS(0).
+func SliceConcurrency(n int) SliceOption {
+	return func(s *sliceConfig) {
+		s.concurrency = n
+	}
+}
+
+// SliceCollectErrors makes every item be processed even if some fail, and
+// the errors of all the failed items be returned joined with errors.Join.
+// By default, the first error cancels the context of the other items and no
+// new item is started.
+func SliceCollectErrors() SliceOption {
+	return func(s *sliceConfig) {
+		s.collect = true
+	}
+}
+
+// SliceProgress registers a callback invoked every time an item is done,
+// with the number of items done so far and the total number of items.
+// The items skipped after the context is done are not counted, so done can
+// stay below total. Calls to progress are serialized.
+func SliceProgress(progress func(done, total int)) SliceOption {
+	return func(s *sliceConfig) {
+		s.progress = progress
+	}
+}
+
+// ItemError is the error of a single item processed by MapSlice or ForEach.
+type ItemError struct {
+	Index int
+	Err   error
+}
+
+func (e *ItemError) Error() string {
+	return fmt.Sprintf("item %d: %v", e.Index, e.Err)
+}
+
+func (e *ItemError) Unwrap() error {
+	return e.Err
+}
+
+// MapSlice applies f to every item of in concurrently, and returns the
+// results in the order of in.
+// Errors are returned as *ItemError. When an error is returned, the results
+// of the items that failed or were not processed are zero values.
+func MapSlice[B, A any](ctx context.Context, f CtxFunc1Result[B, A], in []A, opts ...SliceOption) ([]B, error) {
+	out := make([]B, len(in))
+	err := ForEach(ctx, func(ctx context.Context, i int) error {
+		v, err := f(ctx, in[i])
+		if err != nil {
+			return err
+		}
+		out[i] = v
+		return nil
+	}, indexes(len(in)), opts...)
+	return out, err
+}
+
+// ForEach calls f with every item of in concurrently.
+// Errors are returned as *ItemError.
+func ForEach[A any](ctx context.Context, f CtxFunc1Error[A], in []A, opts ...SliceOption) error {
+	cfg := sliceConfig{concurrency: runtime.GOMAXPROCS(0)}
+	for _, opt := range opts {
+		opt(&cfg)
+	}
+
+	groupOpts := []GroupOption{GroupLimit(cfg.concurrency)}
+	if cfg.collect {
+		groupOpts = append(groupOpts, GroupCollectErrors())
+	}
+	g, gctx := NewGroup(ctx, groupOpts...)
+
+	var mu sync.Mutex
+	done := 0
+	for i, item := range in {
+		if gctx.Err() != nil {
+			break
+		}
+		i, item := i, item
+		g.Go(func(ctx context.Context) error {
+			if ctx.Err() != nil {
+				// Stopped while waiting for a slot: not processed.
+				return nil
+			}
+			defer func() {
+				if cfg.progress != nil {
+					mu.Lock()
+					defer mu.Unlock()
+					done++
+					cfg.progress(done, len(in))
+				}
+			}()
+			err := callProtected(func() error {
+				return f(ctx, item)
+			})
+			if err != nil {
+				return &ItemError{Index: i, Err: err}
+			}
+			return nil
+		})
+	}
+
+	if err := g.Wait(); err != nil {
+		return err
+	}
+	return ctx.Err()
+}
+
+func indexes(n int) []int {
+	idx := make([]int, n)
+	for i := range idx {
+		idx[i] = i
+	}
+	return idx
+}
@@ -0,0 +1,234 @@
+package powerfunc
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sync"
+	"time"
+)
+
+// ErrTooManyRestarts is returned by a supervisor that restarted its
+// children more often than its restart intensity allows.
+var ErrTooManyRestarts = errors.New("too many restarts")
+
+// SupervisionStrategy decides which children a supervisor restarts when one
+// of them fails.
+type SupervisionStrategy int
+
+const (
+	// OneForOne only restarts the child that failed.
+	OneForOne SupervisionStrategy = iota
+	// OneForAll cancels all the children and restarts them when one fails.
+	OneForAll
+)
+
+// SuperviseOption configures a supervisor.
+type SuperviseOption func(s *supervisor)
+
+// SuperviseBackoff sets the delay before a restart.
+// restarts is the number of restarts within the intensity window, starting
+// at 1. Defaults to ExponentialBackoff(100*time.Millisecond, 10*time.Second).
+func SuperviseBackoff(backoff func(restarts int) time.Duration) SuperviseOption {
+	return func(s *supervisor) {
+		s.backoff = backoff
+	}
+}
+
+// SuperviseIntensity makes the supervisor give up with ErrTooManyRestarts
+// when more than maxRestarts restarts happen within window.
+// Defaults to 5 restarts within 10 seconds.
+func SuperviseIntensity(maxRestarts int, window time.Duration) SuperviseOption {
+	return func(s *supervisor) {
+		s.maxRestarts = maxRestarts
+		s.window = window
+	}
+}
+
+// SupervisePermanent makes the supervisor restart a child that returned a
+// nil error as well. By default, such a child is considered done.
+func SupervisePermanent() SuperviseOption {
+	return func(s *supervisor) {
+		s.permanent = true
+	}
+}
+
+// SuperviseStrategy sets the SupervisionStrategy of a group of children.
+// Defaults to OneForOne.
+func SuperviseStrategy(strategy SupervisionStrategy) SuperviseOption {
+	return func(s *supervisor) {
+		s.strategy = strategy
+	}
+}
+
+// SuperviseOnError registers a callback invoked with every error returned
+// by a child before it is restarted. Panics are reported as a *PanicError.
+func SuperviseOnError(onError func(err error)) SuperviseOption {
+	return func(s *supervisor) {
+		s.onError = onError
+	}
+}
+
+// SuperviseClock sets the clock of the supervisor, used for the backoff and
+// the restart intensity. Defaults to RealClock.
+func SuperviseClock(clock Clock) SuperviseOption {
+	return func(s *supervisor) {
+		s.clock = clock
+	}
+}
+
+type supervisor struct {
+	backoff     func(restarts int) time.Duration
+	maxRestarts int
+	window      time.Duration
+	permanent   bool
+	strategy    SupervisionStrategy
+	onError     func(err error)
+	clock       Clock
+
+	mu       sync.Mutex
+	restarts []time.Time
+}
+
+// Supervise returns a runner keeping f alive: f is restarted with a backoff
+// when it returns an error or panics, until its context is done.
+// The runner returns nil once the context is done or f returns a nil error,
+// and an error wrapping ErrTooManyRestarts and the last error of f if f
+// fails more often than the restart intensity allows.
+func Supervise(f CtxFuncError, opts ...SuperviseOption) CtxFuncError {
+	return SuperviseGroup([]CtxFuncError{f}, opts...)
+}
+
+// SuperviseGroup returns a runner keeping a group of children alive,
+// restarting them according to the SupervisionStrategy.
+// The restart intensity is shared by all the children. When it is exceeded,
+// all the children are cancelled and the runner returns an error wrapping
+// ErrTooManyRestarts.
+func SuperviseGroup(children []CtxFuncError, opts ...SuperviseOption) CtxFuncError {
+	return func(ctx context.Context) error {
+		s := &supervisor{
+			backoff:     ExponentialBackoff(100*time.Millisecond, 10*time.Second),
+			maxRestarts: 5,
+			window:      10 * time.Second,
+			clock:       RealClock(),
+		}
+		for _, opt := range opts {
+			opt(s)
+		}
+
+		if s.strategy == OneForAll {
+			return s.oneForAll(ctx, children)
+		}
+		return s.oneForOne(ctx, children)
+	}
+}
+
+func (s *supervisor) oneForOne(ctx context.Context, children []CtxFuncError) error {
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	var wg sync.WaitGroup
+	var once sync.Once
+	var giveUp error
+	for _, child := range children {
+		wg.Add(1)
+		go func(child CtxFuncError) {
+			defer wg.Done()
+			if err := s.keepAlive(ctx, child); err != nil {
+				once.Do(func() {
+					giveUp = err
+					cancel()
+				})
+			}
+		}(child)
+	}
+	wg.Wait()
+	return giveUp
+}
+
+func (s *supervisor) keepAlive(ctx context.Context, child CtxFuncError) error {
+	for {
+		err := callProtected(func() error {
+			return child(ctx)
+		})
+		if ctx.Err() != nil || (err == nil && !s.permanent) {
+			return nil
+		}
+
+		delay, giveUp := s.restart(err)
+		if giveUp != nil {
+			return giveUp
+		}
+		if !sleepUntil(ctx, s.clock, s.clock.Now().Add(delay)) {
+			return nil
+		}
+	}
+}
+
+func (s *supervisor) oneForAll(ctx context.Context, children []CtxFuncError) error {
+	for {
+		childCtx, cancel := context.WithCancel(ctx)
+		var wg sync.WaitGroup
+		var once sync.Once
+		failed := false
+		var failure error
+		for _, child := range children {
+			wg.Add(1)
+			go func(child CtxFuncError) {
+				defer wg.Done()
+				err := callProtected(func() error {
+					return child(childCtx)
+				})
+				if childCtx.Err() != nil || (err == nil && !s.permanent) {
+					return
+				}
+				once.Do(func() {
+					failed = true
+					failure = err
+					cancel()
+				})
+			}(child)
+		}
+		wg.Wait()
+		cancel()
+
+		if ctx.Err() != nil || !failed {
+			return nil
+		}
+
+		delay, giveUp := s.restart(failure)
+		if giveUp != nil {
+			return giveUp
+		}
+		if !sleepUntil(ctx, s.clock, s.clock.Now().Add(delay)) {
+			return nil
+		}
+	}
+}
+
+// restart records a restart caused by err, and returns the delay before the
+// restart, or the error to return if the restart intensity is exceeded.
+func (s *supervisor) restart(err error) (time.Duration, error) {
+	if err != nil && s.onError != nil {
+		s.onError(err)
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	now := s.clock.Now()
+	recent := s.restarts[:0]
+	for _, t := range s.restarts {
+		if now.Sub(t) < s.window {
+			recent = append(recent, t)
+		}
+	}
+	s.restarts = append(recent, now)
+
+	if len(s.restarts) > s.maxRestarts {
+		if err == nil {
+			return 0, ErrTooManyRestarts
+		}
+		return 0, fmt.Errorf("%w: %w", ErrTooManyRestarts, err)
+	}
+	return s.backoff(len(s.restarts)), nil
+}
@@ -0,0 +1,147 @@
+package powerfunc
+
+import (
+	"errors"
+	"fmt"
+	"sync/atomic"
+	"time"
+)
+
+// ErrTimeout is matched, with errors.Is, by the *TimeoutError returned by
+// the WithTimeout methods of the functions without a context.
+var ErrTimeout = errors.New("timeout")
+
+// TimeoutError is returned by the WithTimeout methods of the functions
+// without a context when the call did not return in time.
+type TimeoutError struct {
+	// Duration is the timeout that was exceeded.
+	Duration time.Duration
+}
+
+func (e *TimeoutError) Error() string {
+	return fmt.Sprintf("timeout after %s", e.Duration)
+}
+
+// Is makes a *TimeoutError match ErrTimeout.
+func (e *TimeoutError) Is(target error) bool {
+	return target == ErrTimeout
+}
+
+// Timeout reports that the error is a timeout.
+func (e *TimeoutError) Timeout() bool {
+	return true
+}
+
+// AbandonedStats counts the goroutines abandoned by the WithTimeout methods
+// of the functions without a context.
+type AbandonedStats struct {
+	// Running is the number of abandoned calls that have not returned yet.
+	// A steadily growing number is a leak.
+	Running int64
+	// Total is the number of calls abandoned since the start of the process.
+	Total int64
+}
+
+var abandonedRunning, abandonedTotal atomic.Int64
+
+// Abandoned returns the counts of the goroutines abandoned by the
+// WithTimeout methods of the functions without a context.
+func Abandoned() AbandonedStats {
+	return AbandonedStats{
+		Running: abandonedRunning.Load(),
+		Total:   abandonedTotal.Load(),
+	}
+}
+
+// TimeoutOption configures the WithTimeout method of a function without a
+// context.
+type TimeoutOption func(t *timeoutConfig)
+
+type timeoutConfig struct {
+	onAbandon    func()
+	onLateReturn func(elapsed time.Duration)
+	clock        Clock
+}
+
+// TimeoutOnAbandon registers a hook invoked every time a call is abandoned.
+func TimeoutOnAbandon(hook func()) TimeoutOption {
+	return func(t *timeoutConfig) {
+		t.onAbandon = hook
+	}
+}
+
+// TimeoutOnLateReturn registers a hook invoked when an abandoned call
+// eventually returns, with the total duration of the call.
+func TimeoutOnLateReturn(hook func(elapsed time.Duration)) TimeoutOption {
+	return func(t *timeoutConfig) {
+		t.onLateReturn = hook
+	}
+}
+
+// TimeoutClock sets the clock of the timeout. Defaults to RealClock.
+func TimeoutClock(clock Clock) TimeoutOption {
+	return func(t *timeoutConfig) {
+		t.clock = clock
+	}
+}
+
+// callWithTimeout runs call in its own goroutine, and returns a
+// *TimeoutError if it does not return within timeout, abandoning it.
+// A panic of call is propagated to the caller, unless call was abandoned, in
+// which case it is dropped.
+func callWithTimeout(timeout time.Duration, opts []TimeoutOption, call func()) error {
+	cfg := timeoutConfig{clock: RealClock()}
+	for _, opt := range opts {
+		opt(&cfg)
+	}
+
+	start := cfg.clock.Now()
+	done := make(chan any, 1)
+	var state atomic.Int32 // callRunning, callReturned or callAbandoned.
+	go func() {
+		defer func() {
+			r := recover()
+			if state.CompareAndSwap(callRunning, callReturned) {
+				done <- r
+				return
+			}
+			abandonedRunning.Add(-1)
+			if cfg.onLateReturn != nil {
+				cfg.onLateReturn(cfg.clock.Since(start))
+			}
+		}()
+		call()
+	}()
+
+	timer := cfg.clock.NewTimer(timeout)
+	defer timer.Stop()
+	select {
+	case r := <-done:
+		if r != nil {
+			panic(r)
+		}
+		return nil
+	case <-timer.C():
+	}
+
+	abandonedRunning.Add(1)
+	if !state.CompareAndSwap(callRunning, callAbandoned) {
+		// Returned in the meantime: not abandoned after all.
+		abandonedRunning.Add(-1)
+		if r := <-done; r != nil {
+			panic(r)
+		}
+		return nil
+	}
+	abandonedTotal.Add(1)
+	if cfg.onAbandon != nil {
+		cfg.onAbandon()
+	}
+	return &TimeoutError{Duration: timeout}
+}
+
+const (
+	callRunning int32 = iota
+	callReturned
+	callAbandoned
+)